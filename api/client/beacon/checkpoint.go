@@ -10,6 +10,7 @@ import (
 	base "github.com/prysmaticlabs/prysm/v5/api/client"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
@@ -136,6 +137,8 @@ type WeakSubjectivityData struct {
 	BlockRoot [32]byte
 	StateRoot [32]byte
 	Epoch     primitives.Epoch
+	// Period is the weak subjectivity period in epochs. It is only known when the checkpoint is computed from a state.
+	Period primitives.Epoch
 }
 
 // CheckpointString returns the standard string representation of a Checkpoint.
@@ -252,25 +255,98 @@ func computeBackwardsCompatible(ctx context.Context, client *Client) (*WeakSubje
 // this method downloads the head state, which can be used to find the correct chain config
 // and use prysm's helper methods to compute the latest weak subjectivity epoch.
 func getWeakSubjectivityEpochFromHead(ctx context.Context, client *Client) (primitives.Epoch, error) {
-	headBytes, err := client.GetState(ctx, IdHead)
+	headState, cfg, err := getHeadState(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+	epoch, err := helpers.LatestWeakSubjectivityEpoch(ctx, headState, cfg)
+	if err != nil {
+		return 0, errors.Wrap(err, "error computing the weak subjectivity epoch from head state")
+	}
+
+	log.Printf("(computed client-side) weak subjectivity epoch = %d", epoch)
+	return epoch, nil
+}
+
+// GetWeakSubjectivityPeriod downloads the head state of the beacon node to compute the current weak subjectivity
+// period, in epochs.
+func GetWeakSubjectivityPeriod(ctx context.Context, client *Client) (primitives.Epoch, error) {
+	headState, cfg, err := getHeadState(ctx, client)
 	if err != nil {
 		return 0, err
 	}
+	period, err := helpers.ComputeWeakSubjectivityPeriod(ctx, headState, cfg)
+	if err != nil {
+		return 0, errors.Wrap(err, "error computing the weak subjectivity period from head state")
+	}
+	return period, nil
+}
+
+func getHeadState(ctx context.Context, client *Client) (state.BeaconState, *params.BeaconChainConfig, error) {
+	headBytes, err := client.GetState(ctx, IdHead)
+	if err != nil {
+		return nil, nil, err
+	}
 	vu, err := detect.FromState(headBytes)
 	if err != nil {
-		return 0, errors.Wrap(err, "error detecting chain config for beacon state")
+		return nil, nil, errors.Wrap(err, "error detecting chain config for beacon state")
 	}
 	log.Printf("detected supported config in remote head state, name=%s, fork=%s", vu.Config.ConfigName, version.String(vu.Fork))
 	headState, err := vu.UnmarshalBeaconState(headBytes)
 	if err != nil {
-		return 0, errors.Wrap(err, "error unmarshaling state to correct version")
+		return nil, nil, errors.Wrap(err, "error unmarshaling state to correct version")
 	}
+	return headState, vu.Config, nil
+}
 
-	epoch, err := helpers.LatestWeakSubjectivityEpoch(ctx, headState, vu.Config)
+// WeakSubjectivityFromState computes the latest weak subjectivity checkpoint known to the state, along with the
+// weak subjectivity period. The state must still hold the block root of the first slot of the checkpoint epoch
+// in its block roots, so it should be a recent head or finalized state.
+func WeakSubjectivityFromState(ctx context.Context, st state.BeaconState, cfg *params.BeaconChainConfig) (*WeakSubjectivityData, error) {
+	period, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, cfg)
 	if err != nil {
-		return 0, errors.Wrap(err, "error computing the weak subjectivity epoch from head state")
+		return nil, errors.Wrap(err, "error computing the weak subjectivity period")
+	}
+	epoch, err := helpers.LatestWeakSubjectivityEpoch(ctx, st, cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "error computing the weak subjectivity epoch")
+	}
+	slot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error computing first slot of epoch=%d", epoch)
 	}
 
-	log.Printf("(computed client-side) weak subjectivity epoch = %d", epoch)
-	return epoch, nil
+	ws := &WeakSubjectivityData{Epoch: epoch, Period: period}
+	switch {
+	case slot == st.Slot():
+		sr, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "error computing hash_tree_root of state")
+		}
+		// The state root of the latest block header is only filled in by the next slot transition.
+		h := st.LatestBlockHeader()
+		if bytesutil.ZeroRoot(h.StateRoot) {
+			h.StateRoot = sr[:]
+		}
+		br, err := h.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "error while computing block root using state data")
+		}
+		ws.BlockRoot, ws.StateRoot = br, sr
+	case slot < st.Slot() && st.Slot() <= slot+cfg.SlotsPerHistoricalRoot:
+		idx := uint64(slot % cfg.SlotsPerHistoricalRoot)
+		br, err := st.BlockRootAtIndex(idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading block root of slot %d", slot)
+		}
+		sr, err := st.StateRootAtIndex(idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading state root of slot %d", slot)
+		}
+		ws.BlockRoot, ws.StateRoot = bytesutil.ToBytes32(br), bytesutil.ToBytes32(sr)
+	default:
+		return nil, errors.Errorf("state at slot %d does not hold the block root of weak subjectivity epoch %d, "+
+			"use a state between slots %d and %d", st.Slot(), epoch, slot, slot+cfg.SlotsPerHistoricalRoot)
+	}
+	return ws, nil
 }
@@ -321,6 +321,30 @@ func TestGetWeakSubjectivityEpochFromHead(t *testing.T) {
 	require.Equal(t, expectedEpoch, actualEpoch)
 }
 
+func TestWeakSubjectivityFromState(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.MainnetConfig()
+	st, expectedEpoch := defaultTestHeadState(t, cfg)
+	wsSlot, err := slots.EpochStart(expectedEpoch)
+	require.NoError(t, err)
+	idx := uint64(wsSlot % cfg.SlotsPerHistoricalRoot)
+	require.NoError(t, st.UpdateBlockRootAtIndex(idx, [32]byte{'b'}))
+	require.NoError(t, st.UpdateStateRootAtIndex(idx, [32]byte{'s'}))
+
+	ws, err := WeakSubjectivityFromState(ctx, st, cfg)
+	require.NoError(t, err)
+	require.Equal(t, expectedEpoch, ws.Epoch)
+	require.Equal(t, [32]byte{'b'}, ws.BlockRoot)
+	require.Equal(t, [32]byte{'s'}, ws.StateRoot)
+	require.NotEqual(t, primitives.Epoch(0), ws.Period)
+	require.Equal(t, fmt.Sprintf("%#x:%d", [32]byte{'b'}, expectedEpoch), ws.CheckpointString())
+
+	// The block roots of the state no longer cover the checkpoint epoch.
+	require.NoError(t, st.SetSlot(wsSlot+cfg.SlotsPerHistoricalRoot+1))
+	_, err = WeakSubjectivityFromState(ctx, st, cfg)
+	require.ErrorContains(t, "does not hold the block root", err)
+}
+
 func forkForEpoch(cfg *params.BeaconChainConfig, epoch primitives.Epoch) (*ethpb.Fork, error) {
 	os := forks.NewOrderedSchedule(cfg)
 	currentVersion, err := os.VersionForEpoch(epoch)
@@ -26,15 +26,18 @@ import (
 )
 
 const (
-	getSignedBlockPath       = "/eth/v2/beacon/blocks"
-	getBlockRootPath         = "/eth/v1/beacon/blocks/{{.Id}}/root"
-	getForkForStatePath      = "/eth/v1/beacon/states/{{.Id}}/fork"
-	getWeakSubjectivityPath  = "/prysm/v1/beacon/weak_subjectivity"
-	getForkSchedulePath      = "/eth/v1/config/fork_schedule"
-	getConfigSpecPath        = "/eth/v1/config/spec"
-	getStatePath             = "/eth/v2/debug/beacon/states"
-	getNodeVersionPath       = "/eth/v1/node/version"
-	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getSignedBlockPath         = "/eth/v2/beacon/blocks"
+	getBlockRootPath           = "/eth/v1/beacon/blocks/{{.Id}}/root"
+	getStateRootPath           = "/eth/v1/beacon/states/{{.Id}}/root"
+	getForkForStatePath        = "/eth/v1/beacon/states/{{.Id}}/fork"
+	getFinalityCheckpointsPath = "/eth/v1/beacon/states/{{.Id}}/finality_checkpoints"
+	getWeakSubjectivityPath    = "/prysm/v1/beacon/weak_subjectivity"
+	getForkSchedulePath        = "/eth/v1/config/fork_schedule"
+	getConfigSpecPath          = "/eth/v1/config/spec"
+	getStatePath               = "/eth/v2/debug/beacon/states"
+	getNodeVersionPath         = "/eth/v1/node/version"
+	changeBLStoExecutionPath   = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getDepositSnapshotPath     = "/eth/v1/beacon/deposit_snapshot"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -136,6 +139,30 @@ func (c *Client) GetBlockRoot(ctx context.Context, blockId StateOrBlockId) ([32]
 	return bytesutil.ToBytes32(rs), nil
 }
 
+var getStateRootTpl = idTemplate(getStateRootPath)
+
+// GetStateRoot retrieves the hash_tree_root of the BeaconState for the given state id.
+// State identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded stateRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetStateRoot(ctx context.Context, stateId StateOrBlockId) ([32]byte, error) {
+	rootPath := getStateRootTpl(stateId)
+	b, err := c.Get(ctx, rootPath)
+	if err != nil {
+		return [32]byte{}, errors.Wrapf(err, "error requesting state root by id = %s", stateId)
+	}
+	jsonr := &struct{ Data struct{ Root string } }{}
+	err = json.Unmarshal(b, jsonr)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "error decoding json data from get state root response")
+	}
+	rs, err := hexutil.Decode(jsonr.Data.Root)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, fmt.Sprintf("error decoding hex-encoded value %s", jsonr.Data.Root))
+	}
+	return bytesutil.ToBytes32(rs), nil
+}
+
 var getForkTpl = idTemplate(getForkForStatePath)
 
 // GetFork queries the Beacon Node API for the Fork from the state identified by stateId.
@@ -157,6 +184,27 @@ func (c *Client) GetFork(ctx context.Context, stateId StateOrBlockId) (*ethpb.Fo
 	return fr.ToConsensus()
 }
 
+var getFinalityCheckpointsTpl = idTemplate(getFinalityCheckpointsPath)
+
+// GetFinalityCheckpoints queries the Beacon Node API for the finalized checkpoint of the state identified by stateId.
+// State identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded stateRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetFinalityCheckpoints(ctx context.Context, stateId StateOrBlockId) (*ethpb.Checkpoint, error) {
+	body, err := c.Get(ctx, getFinalityCheckpointsTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting finality checkpoints by state id = %s", stateId)
+	}
+	resp := &structs.GetFinalityCheckpointsResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetFinalityCheckpoints")
+	}
+	if resp.Data == nil || resp.Data.Finalized == nil {
+		return nil, errors.New("no finalized checkpoint in GetFinalityCheckpoints response")
+	}
+	return resp.Data.Finalized.ToConsensus()
+}
+
 // GetForkSchedule retrieve all forks, past present and future, of which this node is aware.
 func (c *Client) GetForkSchedule(ctx context.Context) (forks.OrderedSchedule, error) {
 	body, err := c.Get(ctx, getForkSchedulePath)
@@ -246,6 +294,19 @@ func (c *Client) GetState(ctx context.Context, stateId StateOrBlockId) ([]byte,
 	return b, nil
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 deposit tree snapshot of the finalized deposits.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	b, err := c.Get(ctx, getDepositSnapshotPath, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	snapshot := &ethpb.DepositSnapshot{}
+	if err := snapshot.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling deposit snapshot")
+	}
+	return snapshot, nil
+}
+
 // GetWeakSubjectivity calls a proposed API endpoint that is unique to prysm
 // This api method does the following:
 // - computes weak subjectivity epoch
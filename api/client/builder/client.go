@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"text/template"
 
 	"github.com/pkg/errors"
@@ -51,6 +52,14 @@ func WithObserver(m observer) ClientOpt {
 	}
 }
 
+// WithSSZ configures the client to prefer SSZ encoded request and response bodies. Relays which do not
+// support SSZ are transparently served JSON instead.
+func WithSSZ() ClientOpt {
+	return func(c *Client) {
+		c.sszEnabled.Store(true)
+	}
+}
+
 type requestLogger struct{}
 
 func (*requestLogger) observe(r *http.Request) (e error) {
@@ -97,6 +106,8 @@ type Client struct {
 	hc      *http.Client
 	baseURL *url.URL
 	obvs    []observer
+	// sszEnabled is cleared if the relay rejects an SSZ request body, so that later requests go straight to JSON.
+	sszEnabled atomic.Bool
 }
 
 // NewClient constructs a new client with the provided options (ex WithTimeout).
@@ -138,7 +149,7 @@ func (c *Client) NodeURL() string {
 type reqOption func(*http.Request)
 
 // do is a generic, opinionated request function to reduce boilerplate amongst the methods in this package api/client/builder.
-func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, err error) {
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, header http.Header, err error) {
 	ctx, span := trace.StartSpan(ctx, "builder.client.do")
 	defer func() {
 		tracing.AnnotateError(span, err)
@@ -173,6 +184,7 @@ func (c *Client) do(ctx context.Context, method string, path string, body io.Rea
 			log.WithError(closeErr).Error("Failed to close response body")
 		}
 	}()
+	header = r.Header
 	if r.StatusCode != http.StatusOK {
 		err = non200Err(r)
 		return
@@ -211,10 +223,23 @@ func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash
 	if err != nil {
 		return nil, err
 	}
-	hb, err := c.do(ctx, http.MethodGet, path, nil)
+	var getOpts []reqOption
+	if c.sszEnabled.Load() {
+		getOpts = append(getOpts, func(r *http.Request) {
+			r.Header.Set("Accept", sszAcceptHeader)
+		})
+	}
+	hb, header, err := c.do(ctx, http.MethodGet, path, nil, getOpts...)
 	if err != nil {
 		return nil, err
 	}
+	if isSSZResponse(header) {
+		bid, err := signedBidFromSSZ(header.Get(api.VersionHeader), hb)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader ssz response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
+		}
+		return bid, nil
+	}
 	v := &VersionResponse{}
 	if err := json.Unmarshal(hb, v); err != nil {
 		return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
@@ -278,7 +303,7 @@ func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValid
 		return err
 	}
 
-	_, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body))
+	_, _, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
@@ -295,6 +320,15 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 		return nil, nil, errNotBlinded
 	}
 
+	if c.sszEnabled.Load() {
+		ed, bundle, err := c.submitBlindedBlockSSZ(ctx, sb)
+		if !errors.Is(err, ErrUnsupportedMediaType) {
+			return ed, bundle, err
+		}
+		log.WithError(err).Warn("Builder relay does not accept SSZ encoded blinded blocks, falling back to JSON")
+		c.sszEnabled.Store(false)
+	}
+
 	// massage the proto struct type data into the api response type.
 	mj, err := structs.SignedBeaconBlockMessageJsoner(sb)
 	if err != nil {
@@ -312,10 +346,15 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	}
 	// post the blinded block - the execution payload response should contain the unblinded payload, along with the
 	// blobs bundle if it is post deneb.
-	rb, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
+	rb, _, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "error posting the blinded block to the builder api")
 	}
+	return parseExecutionPayloadResponse(sb, rb)
+}
+
+// parseExecutionPayloadResponse decodes the JSON response to a blinded block submission.
+func parseExecutionPayloadResponse(sb interfaces.ReadOnlySignedBeaconBlock, rb []byte) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	// ExecutionPayloadResponse parses just the outer container and the Value key, enabling it to use the .Value
 	// key to determine which underlying data type to use to finish the unmarshaling.
 	ep := &ExecutionPayloadResponse{}
@@ -350,11 +389,44 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	return ed, nil, nil
 }
 
+// submitBlindedBlockSSZ posts the ssz encoded blinded block to the builder. The relay may still answer with a
+// JSON body, which is handled the same way as a JSON request would be.
+func (c *Client) submitBlindedBlockSSZ(ctx context.Context, sb interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	body, err := sb.MarshalSSZ()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error marshaling blinded block post request to ssz")
+	}
+	postOpts := func(r *http.Request) {
+		r.Header.Add("Eth-Consensus-Version", version.String(sb.Version()))
+		r.Header.Set("Content-Type", api.OctetStreamMediaType)
+		r.Header.Set("Accept", sszAcceptHeader)
+	}
+	rb, header, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error posting the ssz blinded block to the builder api")
+	}
+	if !isSSZResponse(header) {
+		return parseExecutionPayloadResponse(sb, rb)
+	}
+	if v := header.Get(api.VersionHeader); v != "" && strings.ToLower(v) != version.String(sb.Version()) {
+		return nil, nil, errors.Wrapf(errResponseVersionMismatch, "req=%s, recv=%s", version.String(sb.Version()), strings.ToLower(v))
+	}
+	ed, bundle, err := payloadFromSSZ(sb.Version(), rb)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error unmarshaling the builder ssz execution payload response")
+	}
+	return ed, bundle, nil
+}
+
+func isSSZResponse(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), api.OctetStreamMediaType)
+}
+
 // Status asks the remote builder server for a health check. A response of 200 with an empty body is the success/healthy
 // response, and an error response may have an error message. This method will return a nil value for error in the
 // happy path, and an error with information about the server response body for a non-200 response.
 func (c *Client) Status(ctx context.Context) error {
-	_, err := c.do(ctx, http.MethodGet, getStatus, nil)
+	_, _, err := c.do(ctx, http.MethodGet, getStatus, nil)
 	return err
 }
 
@@ -384,6 +456,9 @@ func non200Err(response *http.Response) error {
 			return errors.Wrap(jsonErr, "unable to read response body")
 		}
 		return errors.Wrap(ErrNotFound, errMessage.Message)
+	case http.StatusUnsupportedMediaType:
+		log.WithError(ErrUnsupportedMediaType).Debug(msg)
+		return ErrUnsupportedMediaType
 	case http.StatusInternalServerError:
 		log.WithError(ErrNotOK).Debug(msg)
 		if jsonErr := json.Unmarshal(bodyBytes, &errMessage); jsonErr != nil {
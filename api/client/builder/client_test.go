@@ -13,6 +13,7 @@ import (
 
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -20,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	log "github.com/sirupsen/logrus"
@@ -311,6 +313,82 @@ func TestClient_GetHeader(t *testing.T) {
 		_, err := c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
 		require.ErrorContains(t, "unsupported header version", err)
 	})
+	t.Run("capella ssz", func(t *testing.T) {
+		bid := &eth.BuilderBidCapella{
+			Header: &v1.ExecutionPayloadHeaderCapella{
+				ParentHash:       make([]byte, fieldparams.RootLength),
+				FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+				StateRoot:        make([]byte, fieldparams.RootLength),
+				ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+				LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+				PrevRandao:       make([]byte, fieldparams.RootLength),
+				BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+				BlockHash:        make([]byte, fieldparams.RootLength),
+				TransactionsRoot: make([]byte, fieldparams.RootLength),
+				WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+				GasUsed:          1,
+			},
+			Value:  bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+			Pubkey: pubkey,
+		}
+		msg, err := bid.MarshalSSZ()
+		require.NoError(t, err)
+		sig := bytesutil.PadTo([]byte{2}, fieldparams.BLSSignatureLength)
+		rb := append([]byte{100, 0, 0, 0}, sig...)
+		rb = append(rb, msg...)
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, expectedPath, r.URL.Path)
+				require.Equal(t, sszAcceptHeader, r.Header.Get("Accept"))
+				header := http.Header{}
+				header.Set("Content-Type", "application/octet-stream")
+				header.Set("Eth-Consensus-Version", "capella")
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     header,
+					Body:       io.NopCloser(bytes.NewBuffer(rb)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:      hc,
+			baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+		}
+		c.sszEnabled.Store(true)
+		h, err := c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
+		require.NoError(t, err)
+		require.Equal(t, version.Capella, h.Version())
+		require.DeepEqual(t, sig, h.Signature())
+		got, err := h.Message()
+		require.NoError(t, err)
+		require.DeepEqual(t, pubkey, got.Pubkey())
+		gotHeader, err := got.Header()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), gotHeader.GasUsed())
+	})
+	t.Run("ssz malformed offset", func(t *testing.T) {
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				header := http.Header{}
+				header.Set("Content-Type", "application/octet-stream")
+				header.Set("Eth-Consensus-Version", "capella")
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     header,
+					Body:       io.NopCloser(bytes.NewBuffer([]byte{1, 0, 0, 0})),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:      hc,
+			baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+		}
+		c.sszEnabled.Store(true)
+		_, err := c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
+		require.ErrorIs(t, err, errInvalidSSZOffset)
+	})
 }
 
 func TestSubmitBlindedBlock(t *testing.T) {
@@ -414,6 +492,95 @@ func TestSubmitBlindedBlock(t *testing.T) {
 		assert.Equal(t, uint64(1), withdrawals[0].Amount)
 		require.NotNil(t, blobBundle)
 	})
+	t.Run("capella ssz", func(t *testing.T) {
+		payload := &v1.ExecutionPayloadCapella{
+			ParentHash:    make([]byte, fieldparams.RootLength),
+			FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:     make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+			LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:    make([]byte, fieldparams.RootLength),
+			BaseFeePerGas: make([]byte, fieldparams.RootLength),
+			BlockHash:     make([]byte, fieldparams.RootLength),
+			GasLimit:      1,
+			Withdrawals: []*v1.Withdrawal{{
+				Index:          1,
+				ValidatorIndex: 1,
+				Address:        ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943"),
+				Amount:         1,
+			}},
+		}
+		rb, err := payload.MarshalSSZ()
+		require.NoError(t, err)
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, postBlindedBeaconBlockPath, r.URL.Path)
+				require.Equal(t, "capella", r.Header.Get("Eth-Consensus-Version"))
+				require.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+				require.Equal(t, sszAcceptHeader, r.Header.Get("Accept"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				block := &eth.SignedBlindedBeaconBlockCapella{}
+				require.NoError(t, block.UnmarshalSSZ(body))
+				require.DeepEqual(t, testSignedBlindedBeaconBlockCapella(t), block)
+				header := http.Header{}
+				header.Set("Content-Type", "application/octet-stream")
+				header.Set("Eth-Consensus-Version", "capella")
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     header,
+					Body:       io.NopCloser(bytes.NewBuffer(rb)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:      hc,
+			baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+		}
+		c.sszEnabled.Store(true)
+		sbb, err := blocks.NewSignedBeaconBlock(testSignedBlindedBeaconBlockCapella(t))
+		require.NoError(t, err)
+		ep, _, err := c.SubmitBlindedBlock(ctx, sbb)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), ep.GasLimit())
+		withdrawals, err := ep.Withdrawals()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(withdrawals))
+		assert.DeepEqual(t, ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943"), withdrawals[0].Address)
+	})
+	t.Run("ssz unsupported, falls back to json", func(t *testing.T) {
+		calls := 0
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				calls++
+				if r.Header.Get("Content-Type") == "application/octet-stream" {
+					return &http.Response{
+						StatusCode: http.StatusUnsupportedMediaType,
+						Body:       io.NopCloser(bytes.NewBuffer(nil)),
+						Request:    r.Clone(ctx),
+					}, nil
+				}
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(testExampleExecutionPayloadCapella)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:      hc,
+			baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+		}
+		c.sszEnabled.Store(true)
+		sbb, err := blocks.NewSignedBeaconBlock(testSignedBlindedBeaconBlockCapella(t))
+		require.NoError(t, err)
+		_, _, err = c.SubmitBlindedBlock(ctx, sbb)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+		require.Equal(t, false, c.sszEnabled.Load())
+	})
 	t.Run("mismatched versions, expected bellatrix got capella", func(t *testing.T) {
 		hc := &http.Client{
 			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
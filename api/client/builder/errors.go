@@ -15,3 +15,7 @@ var ErrBadRequest = errors.Wrap(ErrNotOK, "recv 400 BadRequest response from API
 // ErrNoContent specifically means that a '204 - No Content' response was received from the API.
 // Typically, a 204 is a success but in this case for the Header API means No header is available
 var ErrNoContent = errors.New("recv 204 no content response from API, No header is available")
+
+// ErrUnsupportedMediaType specifically means that a '415 - Unsupported Media Type' response was received from the API.
+// This is returned by relays which do not accept SSZ encoded request bodies.
+var ErrUnsupportedMediaType = errors.Wrap(ErrNotOK, "recv 415 UnsupportedMediaType response from API")
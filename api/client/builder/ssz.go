@@ -0,0 +1,121 @@
+package builder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// sszAcceptHeader asks the relay for an SSZ encoded response, while still accepting JSON from relays that
+// do not support SSZ.
+const sszAcceptHeader = "application/octet-stream;q=1.0,application/json;q=0.9"
+
+const bytesPerOffset = 4
+
+var errInvalidSSZOffset = errors.New("invalid ssz offset")
+
+// readOffset reads a little-endian ssz offset at the given position and checks it is within bounds.
+func readOffset(buf []byte, pos int, min int) (int, error) {
+	if len(buf) < pos+bytesPerOffset {
+		return 0, errors.Wrapf(errInvalidSSZOffset, "buffer of size %d too small to read offset at %d", len(buf), pos)
+	}
+	o := int(binary.LittleEndian.Uint32(buf[pos : pos+bytesPerOffset]))
+	if o < min || o > len(buf) {
+		return 0, errors.Wrapf(errInvalidSSZOffset, "offset %d out of range [%d, %d]", o, min, len(buf))
+	}
+	return o, nil
+}
+
+// splitSignedContainer splits an ssz encoded container of the form {message: <variable size>, signature: BLSSignature}
+// into the message bytes and the signature.
+func splitSignedContainer(buf []byte) ([]byte, []byte, error) {
+	fixed := bytesPerOffset + fieldparams.BLSSignatureLength
+	o, err := readOffset(buf, 0, fixed)
+	if err != nil {
+		return nil, nil, err
+	}
+	if o != fixed {
+		return nil, nil, errors.Wrapf(errInvalidSSZOffset, "expected message offset %d, got %d", fixed, o)
+	}
+	return buf[o:], buf[bytesPerOffset:fixed], nil
+}
+
+// signedBidFromSSZ decodes an ssz encoded SignedBuilderBid for the given fork version name.
+func signedBidFromSSZ(ver string, buf []byte) (SignedBid, error) {
+	msg, sig, err := splitSignedContainer(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signed builder bid")
+	}
+	switch strings.ToLower(ver) {
+	case version.String(version.Deneb):
+		bid := &ethpb.BuilderBidDeneb{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal deneb builder bid")
+		}
+		return WrappedSignedBuilderBidDeneb(&ethpb.SignedBuilderBidDeneb{Message: bid, Signature: sig})
+	case version.String(version.Capella):
+		bid := &ethpb.BuilderBidCapella{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal capella builder bid")
+		}
+		return WrappedSignedBuilderBidCapella(&ethpb.SignedBuilderBidCapella{Message: bid, Signature: sig})
+	case version.String(version.Bellatrix):
+		bid := &ethpb.BuilderBid{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal bellatrix builder bid")
+		}
+		return WrappedSignedBuilderBid(&ethpb.SignedBuilderBid{Message: bid, Signature: sig})
+	default:
+		return nil, fmt.Errorf("unsupported header version %s", strings.ToLower(ver))
+	}
+}
+
+// payloadFromSSZ decodes the ssz encoded response to a blinded block submission. Pre-deneb the response is a bare
+// execution payload, from deneb onwards it is an ExecutionPayloadAndBlobsBundle container.
+func payloadFromSSZ(ver int, buf []byte) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	switch ver {
+	case version.Bellatrix:
+		p := &v1.ExecutionPayload{}
+		if err := p.UnmarshalSSZ(buf); err != nil {
+			return nil, nil, errors.Wrap(err, "could not unmarshal bellatrix execution payload")
+		}
+		ed, err := blocks.WrappedExecutionPayload(p)
+		return ed, nil, err
+	case version.Capella:
+		p := &v1.ExecutionPayloadCapella{}
+		if err := p.UnmarshalSSZ(buf); err != nil {
+			return nil, nil, errors.Wrap(err, "could not unmarshal capella execution payload")
+		}
+		ed, err := blocks.WrappedExecutionPayloadCapella(p)
+		return ed, nil, err
+	case version.Deneb:
+		po, err := readOffset(buf, 0, 2*bytesPerOffset)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not read execution payload offset")
+		}
+		bo, err := readOffset(buf, bytesPerOffset, po)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not read blobs bundle offset")
+		}
+		p := &v1.ExecutionPayloadDeneb{}
+		if err := p.UnmarshalSSZ(buf[po:bo]); err != nil {
+			return nil, nil, errors.Wrap(err, "could not unmarshal deneb execution payload")
+		}
+		bundle := &v1.BlobsBundle{}
+		if err := bundle.UnmarshalSSZ(buf[bo:]); err != nil {
+			return nil, nil, errors.Wrap(err, "could not unmarshal blobs bundle")
+		}
+		ed, err := blocks.WrappedExecutionPayloadDeneb(p)
+		return ed, bundle, err
+	default:
+		return nil, nil, fmt.Errorf("unsupported payload version %s", version.String(ver))
+	}
+}
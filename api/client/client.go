@@ -2,12 +2,12 @@ package client
 
 import (
 	"context"
-	"io"
 	"net"
 	"net/http"
 	"net/url"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/stream"
 )
 
 const (
@@ -97,7 +97,11 @@ func (c *Client) Get(ctx context.Context, path string, opts ...ReqOption) ([]byt
 	if r.StatusCode != http.StatusOK {
 		return nil, Non200Err(r)
 	}
-	b, err := io.ReadAll(io.LimitReader(r.Body, c.maxBodySize))
+	// Large bodies such as states are rejected as soon as they go over the max body size, rather than truncated.
+	if err := stream.CheckSize(r.ContentLength, uint64(c.maxBodySize)); err != nil {
+		return nil, errors.Wrap(err, "http response body is too large")
+	}
+	b, err := stream.ReadAll(r.Body, uint64(c.maxBodySize))
 	if err != nil {
 		return nil, errors.Wrap(err, "error reading http response body")
 	}
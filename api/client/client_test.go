@@ -1,9 +1,13 @@
 package client
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/stream"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
@@ -46,3 +50,22 @@ func TestBaseURL(t *testing.T) {
 	require.Equal(t, "www.offchainlabs.com", cl.BaseURL().Hostname())
 	require.Equal(t, "3500", cl.BaseURL().Port())
 }
+
+func TestGet_MaxBodySize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write(make([]byte, 100))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	cl, err := NewClient(srv.URL, WithMaxBodySize(100))
+	require.NoError(t, err)
+	b, err := cl.Get(context.Background(), "/")
+	require.NoError(t, err)
+	require.Equal(t, 100, len(b))
+
+	cl, err = NewClient(srv.URL, WithMaxBodySize(99))
+	require.NoError(t, err)
+	_, err = cl.Get(context.Background(), "/")
+	require.ErrorIs(t, err, stream.ErrTooLarge)
+}
@@ -10,6 +10,8 @@ const (
 	JsonMediaType                 = "application/json"
 	OctetStreamMediaType          = "application/octet-stream"
 	EventStreamMediaType          = "text/event-stream"
+	GraphvizMediaType             = "text/vnd.graphviz"
+	CsvMediaType                  = "text/csv"
 	KeepAlive                     = "keep-alive"
 )
 
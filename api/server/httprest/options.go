@@ -27,6 +27,24 @@ func WithHTTPAddr(addr string) Option {
 	}
 }
 
+// WithAdditionalHTTPAddrs sets the full addresses ( host and port ) of the other interfaces the server listens on,
+// along with the address set by WithHTTPAddr.
+func WithAdditionalHTTPAddrs(addrs []string) Option {
+	return func(g *Server) error {
+		g.cfg.additionalAddrs = addrs
+		return nil
+	}
+}
+
+// WithTLS serves HTTPS on all the addresses of the server, with the given certificate and key files.
+func WithTLS(certFile, keyFile string) Option {
+	return func(g *Server) error {
+		g.cfg.tlsCertFile = certFile
+		g.cfg.tlsKeyFile = keyFile
+		return nil
+	}
+}
+
 // WithRouter sets the internal router of the server, this is required.
 func WithRouter(r *http.ServeMux) Option {
 	return func(g *Server) error {
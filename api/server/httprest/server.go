@@ -14,16 +14,20 @@ var _ runtime.Service = (*Server)(nil)
 
 // Config parameters for setting up the http-rest service.
 type config struct {
-	httpAddr    string
-	middlewares []middleware.Middleware
-	router      http.Handler
-	timeout     time.Duration
+	httpAddr        string
+	additionalAddrs []string
+	tlsCertFile     string
+	tlsKeyFile      string
+	middlewares     []middleware.Middleware
+	router          http.Handler
+	timeout         time.Duration
 }
 
 // Server serves HTTP traffic.
 type Server struct {
 	cfg          *config
 	server       *http.Server
+	additional   []*http.Server
 	cancel       context.CancelFunc
 	ctx          context.Context
 	startFailure error
@@ -50,11 +54,21 @@ func New(ctx context.Context, opts ...Option) (*Server, error) {
 		defaultReadHeaderTimeout = g.cfg.timeout
 		handler = http.TimeoutHandler(handler, g.cfg.timeout, "request timed out")
 	}
+	if (g.cfg.tlsCertFile == "") != (g.cfg.tlsKeyFile == "") {
+		return nil, errors.New("both a TLS certificate and key are required to serve HTTPS")
+	}
 	g.server = &http.Server{
 		Addr:              g.cfg.httpAddr,
 		Handler:           handler,
 		ReadHeaderTimeout: defaultReadHeaderTimeout,
 	}
+	for _, addr := range g.cfg.additionalAddrs {
+		g.additional = append(g.additional, &http.Server{
+			Addr:              addr,
+			Handler:           handler,
+			ReadHeaderTimeout: defaultReadHeaderTimeout,
+		})
+	}
 
 	return g, nil
 }
@@ -63,14 +77,25 @@ func New(ctx context.Context, opts ...Option) (*Server, error) {
 func (g *Server) Start() {
 	g.ctx, g.cancel = context.WithCancel(g.ctx)
 
-	go func() {
-		log.WithField("address", g.cfg.httpAddr).Info("Starting HTTP server")
-		if err := g.server.ListenAndServe(); err != http.ErrServerClosed {
-			log.WithError(err).Error("Failed to start HTTP server")
-			g.startFailure = err
-			return
-		}
-	}()
+	for _, srv := range append([]*http.Server{g.server}, g.additional...) {
+		go g.serve(srv)
+	}
+}
+
+// serve listens on the address of the server, over TLS when a certificate is configured.
+func (g *Server) serve(srv *http.Server) {
+	var err error
+	if g.cfg.tlsCertFile != "" {
+		log.WithField("address", srv.Addr).Info("Starting HTTPS server")
+		err = srv.ListenAndServeTLS(g.cfg.tlsCertFile, g.cfg.tlsKeyFile)
+	} else {
+		log.WithField("address", srv.Addr).Info("Starting HTTP server")
+		err = srv.ListenAndServe()
+	}
+	if err != http.ErrServerClosed {
+		log.WithError(err).WithField("address", srv.Addr).Error("Failed to start HTTP server")
+		g.startFailure = err
+	}
 }
 
 // Status of the HTTP server. Returns an error if this service is unhealthy.
@@ -86,11 +111,13 @@ func (g *Server) Stop() error {
 	if g.server != nil {
 		shutdownCtx, shutdownCancel := context.WithTimeout(g.ctx, 2*time.Second)
 		defer shutdownCancel()
-		if err := g.server.Shutdown(shutdownCtx); err != nil {
-			if errors.Is(err, context.DeadlineExceeded) {
-				log.Warn("Existing connections terminated")
-			} else {
-				log.WithError(err).Error("Failed to gracefully shut down server")
+		for _, srv := range append([]*http.Server{g.server}, g.additional...) {
+			if err := srv.Shutdown(shutdownCtx); err != nil {
+				if errors.Is(err, context.DeadlineExceeded) {
+					log.Warn("Existing connections terminated")
+				} else {
+					log.WithError(err).Error("Failed to gracefully shut down server")
+				}
 			}
 		}
 	}
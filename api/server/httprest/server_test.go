@@ -69,3 +69,28 @@ func TestServer_NilHandler_NotFoundHandlerRegistered(t *testing.T) {
 	g.cfg.router.ServeHTTP(writer, &http.Request{Method: "GET", Host: "localhost", URL: &url.URL{Path: "/foo"}})
 	assert.Equal(t, http.StatusNotFound, writer.Code)
 }
+
+func TestServer_AdditionalHTTPAddrs(t *testing.T) {
+	opts := []Option{
+		WithHTTPAddr("127.0.0.1:7500"),
+		WithAdditionalHTTPAddrs([]string{"10.0.0.1:7500", "10.0.0.2:7600"}),
+		WithRouter(http.NewServeMux()),
+	}
+
+	g, err := New(context.Background(), opts...)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(g.additional))
+	assert.Equal(t, "10.0.0.1:7500", g.additional[0].Addr)
+	assert.Equal(t, "10.0.0.2:7600", g.additional[1].Addr)
+}
+
+func TestServer_TLSRequiresCertAndKey(t *testing.T) {
+	opts := []Option{
+		WithHTTPAddr("127.0.0.1:7500"),
+		WithRouter(http.NewServeMux()),
+		WithTLS("cert.pem", ""),
+	}
+
+	_, err := New(context.Background(), opts...)
+	require.ErrorContains(t, "both a TLS certificate and key", err)
+}
@@ -341,6 +341,14 @@ func (a *AggregateAttestationAndProof) ToConsensus() (*eth.AggregateAttestationA
 	}, nil
 }
 
+func AggregateAttAndProofFromConsensus(a *eth.AggregateAttestationAndProof) *AggregateAttestationAndProof {
+	return &AggregateAttestationAndProof{
+		AggregatorIndex: fmt.Sprintf("%d", a.AggregatorIndex),
+		Aggregate:       AttFromConsensus(a.Aggregate),
+		SelectionProof:  hexutil.Encode(a.SelectionProof),
+	}
+}
+
 func (s *SignedAggregateAttestationAndProofElectra) ToConsensus() (*eth.SignedAggregateAttestationAndProofElectra, error) {
 	msg, err := s.Message.ToConsensus()
 	if err != nil {
@@ -377,6 +385,14 @@ func (a *AggregateAttestationAndProofElectra) ToConsensus() (*eth.AggregateAttes
 	}, nil
 }
 
+func AggregateAttAndProofElectraFromConsensus(a *eth.AggregateAttestationAndProofElectra) *AggregateAttestationAndProofElectra {
+	return &AggregateAttestationAndProofElectra{
+		AggregatorIndex: fmt.Sprintf("%d", a.AggregatorIndex),
+		Aggregate:       AttElectraFromConsensus(a.Aggregate),
+		SelectionProof:  hexutil.Encode(a.SelectionProof),
+	}
+}
+
 func (a *Attestation) ToConsensus() (*eth.Attestation, error) {
 	aggBits, err := hexutil.Decode(a.AggregationBits)
 	if err != nil {
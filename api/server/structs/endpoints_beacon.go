@@ -250,3 +250,63 @@ type ChainHead struct {
 	PreviousJustifiedBlockRoot string `json:"previous_justified_block_root"`
 	OptimisticStatus           bool   `json:"optimistic_status"`
 }
+
+type GetPendingExecutionRequestsResponse struct {
+	ExecutionOptimistic bool                      `json:"execution_optimistic"`
+	Finalized           bool                      `json:"finalized"`
+	Data                *PendingExecutionRequests `json:"data"`
+}
+
+type PendingExecutionRequests struct {
+	DepositRequestsStartIndex     string                         `json:"deposit_requests_start_index"`
+	DepositBalanceToConsume       string                         `json:"deposit_balance_to_consume"`
+	ExitBalanceToConsume          string                         `json:"exit_balance_to_consume"`
+	EarliestExitEpoch             string                         `json:"earliest_exit_epoch"`
+	ConsolidationBalanceToConsume string                         `json:"consolidation_balance_to_consume"`
+	EarliestConsolidationEpoch    string                         `json:"earliest_consolidation_epoch"`
+	Deposits                      []*PendingDepositRequest       `json:"deposits"`
+	Withdrawals                   []*PendingWithdrawalRequest    `json:"withdrawals"`
+	Consolidations                []*PendingConsolidationRequest `json:"consolidations"`
+}
+
+type PendingDepositRequest struct {
+	Pubkey                string `json:"pubkey"`
+	ValidatorIndex        string `json:"validator_index,omitempty"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Slot                  string `json:"slot"`
+	Source                string `json:"source"`
+	Status                string `json:"status"`
+}
+
+type PendingWithdrawalRequest struct {
+	ValidatorIndex    string `json:"validator_index"`
+	Amount            string `json:"amount"`
+	WithdrawableEpoch string `json:"withdrawable_epoch"`
+	Status            string `json:"status"`
+}
+
+type PendingConsolidationRequest struct {
+	SourceIndex             string `json:"source_index"`
+	TargetIndex             string `json:"target_index"`
+	SourceWithdrawableEpoch string `json:"source_withdrawable_epoch"`
+	Status                  string `json:"status"`
+}
+
+type GetBlockChildrenResponse struct {
+	ExecutionOptimistic bool             `json:"execution_optimistic"`
+	Finalized           bool             `json:"finalized"`
+	Data                []*BlockRelative `json:"data"`
+}
+
+type GetBlockAncestorResponse struct {
+	ExecutionOptimistic bool           `json:"execution_optimistic"`
+	Finalized           bool           `json:"finalized"`
+	Data                *BlockRelative `json:"data"`
+}
+
+type BlockRelative struct {
+	Root      string `json:"root"`
+	Slot      string `json:"slot"`
+	Canonical bool   `json:"canonical"`
+}
@@ -16,3 +16,20 @@ type GetForkScheduleResponse struct {
 type GetSpecResponse struct {
 	Data interface{} `json:"data"`
 }
+
+type GetResolvedConfigResponse struct {
+	Data *ResolvedConfig `json:"data"`
+}
+
+type ResolvedConfig struct {
+	ConfigName  string            `json:"config_name"`
+	Spec        map[string]string `json:"spec"`
+	MainnetDiff []*ConfigDiff     `json:"mainnet_diff"`
+	Features    map[string]string `json:"features"`
+}
+
+type ConfigDiff struct {
+	Name         string `json:"name"`
+	Value        string `json:"value"`
+	MainnetValue string `json:"mainnet_value"`
+}
@@ -55,3 +55,155 @@ type ForkChoiceNodeExtraData struct {
 	ExecutionOptimistic      bool   `json:"execution_optimistic"`
 	TimeStamp                string `json:"timestamp"`
 }
+
+type BadBlocksResponse struct {
+	Data []*BadBlock `json:"data"`
+}
+
+type BadBlock struct {
+	BlockRoot string `json:"block_root"`
+	Reason    string `json:"reason"`
+	MarkedAt  string `json:"marked_at"`
+}
+
+type AddBadBlockRequest struct {
+	BlockRoot string `json:"block_root"`
+}
+
+type PendingSlashingsResponse struct {
+	Data []*PendingSlashing `json:"data"`
+}
+
+type PendingSlashing struct {
+	SlashingRoot string          `json:"slashing_root"`
+	Kind         string          `json:"kind"`
+	Slashing     json.RawMessage `json:"slashing"`
+	DetectedAt   string          `json:"detected_at"`
+}
+
+type DepositCheckResponse struct {
+	Data *DepositCheck `json:"data"`
+}
+
+type DepositCheck struct {
+	Consistent        bool     `json:"consistent"`
+	DepositCount      string   `json:"deposit_count"`
+	TreeDepositCount  string   `json:"tree_deposit_count"`
+	StateDepositCount string   `json:"state_deposit_count"`
+	LastBlock         string   `json:"last_block"`
+	Problems          []string `json:"problems"`
+}
+
+type RepairDepositsRequest struct {
+	FromBlock string `json:"from_block"`
+	ToBlock   string `json:"to_block"`
+}
+
+type RepairDepositsResponse struct {
+	Data *DepositRepair `json:"data"`
+}
+
+type DepositRepair struct {
+	FromBlock       string `json:"from_block"`
+	ToBlock         string `json:"to_block"`
+	LogsScanned     string `json:"logs_scanned"`
+	RemovedDeposits string `json:"removed_deposits"`
+	AddedDeposits   string `json:"added_deposits"`
+	DepositCount    string `json:"deposit_count"`
+}
+
+type RegenerateStateResponse struct {
+	Data *StateRegeneration `json:"data"`
+}
+
+type StateRegeneration struct {
+	BlockRoot      string   `json:"block_root"`
+	Slot           string   `json:"slot"`
+	StateRoot      string   `json:"state_root"`
+	BaseRoot       string   `json:"base_root"`
+	BaseSlot       string   `json:"base_slot"`
+	ReplayedBlocks string   `json:"replayed_blocks"`
+	Replaced       []string `json:"replaced"`
+	Corrupted      []string `json:"corrupted"`
+}
+
+type HeadSnapshotResponse struct {
+	Data *HeadSnapshot `json:"data"`
+}
+
+type HeadSnapshot struct {
+	Version   string `json:"version"`
+	Slot      string `json:"slot"`
+	BlockRoot string `json:"block_root"`
+	StateRoot string `json:"state_root"`
+	Dir       string `json:"dir"`
+	BlockFile string `json:"block_file"`
+	StateFile string `json:"state_file"`
+}
+
+type DebugPeersResponse struct {
+	Data []*DebugPeer `json:"data"`
+}
+
+type DebugPeerResponse struct {
+	Data *DebugPeer `json:"data"`
+}
+
+type DebugPeer struct {
+	PeerId             string          `json:"peer_id"`
+	Enr                string          `json:"enr"`
+	ListeningAddresses []string        `json:"listening_addresses"`
+	Direction          string          `json:"direction"`
+	State              string          `json:"state"`
+	AgentVersion       string          `json:"agent_version"`
+	ProtocolVersion    string          `json:"protocol_version"`
+	Protocols          []string        `json:"protocols"`
+	FaultCount         string          `json:"fault_count"`
+	LatencyMs          string          `json:"latency_ms"`
+	Metadata           *DebugPeerMeta  `json:"metadata"`
+	Status             *DebugPeerChain `json:"status"`
+	StatusUpdatedAt    string          `json:"status_updated_at"`
+	Score              *DebugPeerScore `json:"score"`
+}
+
+type DebugPeerMeta struct {
+	SeqNumber string `json:"seq_number"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets,omitempty"`
+}
+
+type DebugPeerChain struct {
+	ForkDigest     string `json:"fork_digest"`
+	FinalizedRoot  string `json:"finalized_root"`
+	FinalizedEpoch string `json:"finalized_epoch"`
+	HeadRoot       string `json:"head_root"`
+	HeadSlot       string `json:"head_slot"`
+}
+
+type DebugPeerScore struct {
+	Overall          string                      `json:"overall"`
+	ProcessedBlocks  string                      `json:"processed_blocks"`
+	BlockProvider    string                      `json:"block_provider"`
+	Gossip           string                      `json:"gossip"`
+	BehaviourPenalty string                      `json:"behaviour_penalty"`
+	ValidationError  string                      `json:"validation_error"`
+	Topics           map[string]*DebugTopicScore `json:"topics"`
+}
+
+type DebugTopicScore struct {
+	TimeInMesh               string `json:"time_in_mesh"`
+	FirstMessageDeliveries   string `json:"first_message_deliveries"`
+	MeshMessageDeliveries    string `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries string `json:"invalid_message_deliveries"`
+}
+
+type InclusionSlotResponse struct {
+	Data *InclusionSlot `json:"data"`
+}
+
+type InclusionSlot struct {
+	ValidatorIndex string `json:"validator_index"`
+	Slot           string `json:"slot"`
+	Included       bool   `json:"included"`
+	InclusionSlot  string `json:"inclusion_slot"`
+}
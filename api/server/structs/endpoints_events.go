@@ -46,6 +46,13 @@ type ChainReorgEvent struct {
 	NewHeadState        string `json:"new_head_state"`
 	Epoch               string `json:"epoch"`
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	// The fields below are Prysm specific additions to the chain_reorg event.
+	Distance           string `json:"distance,omitempty"`
+	CommonAncestorRoot string `json:"common_ancestor_root,omitempty"`
+	OldHeadProposer    string `json:"old_head_proposer,omitempty"`
+	NewHeadProposer    string `json:"new_head_proposer,omitempty"`
+	OldHeadWeight      string `json:"old_head_weight,omitempty"`
+	NewHeadWeight      string `json:"new_head_weight,omitempty"`
 }
 
 type PayloadAttributesEvent struct {
@@ -91,6 +98,36 @@ type BlobSidecarEvent struct {
 	VersionedHash string `json:"versioned_hash"`
 }
 
+// DataAvailabilityEvent is a Prysm specific event describing how the blob sidecars of a block became available.
+// Times are unix timestamps in milliseconds.
+type DataAvailabilityEvent struct {
+	Slot                      string                          `json:"slot"`
+	BlockRoot                 string                          `json:"block_root"`
+	BlobsExpected             string                          `json:"blobs_expected"`
+	Sidecars                  []*DataAvailabilitySidecarEvent `json:"sidecars"`
+	CompletedTime             string                          `json:"completed_time"`
+	BeforeAttestationDeadline bool                            `json:"before_attestation_deadline"`
+}
+
+type DataAvailabilitySidecarEvent struct {
+	Index  string `json:"index"`
+	Source string `json:"source"`
+	// ArrivalTime is empty when the arrival of the sidecar is unknown.
+	ArrivalTime string `json:"arrival_time"`
+}
+
+// ProposerLookaheadEvent is a Prysm specific event carrying the proposer schedule of the next epoch.
+type ProposerLookaheadEvent struct {
+	Epoch         string                   `json:"epoch"`
+	DependentRoot string                   `json:"dependent_root"`
+	Proposers     []*ProposerLookaheadSlot `json:"proposers"`
+}
+
+type ProposerLookaheadSlot struct {
+	Slot           string `json:"slot"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
 type LightClientFinalityUpdateEvent struct {
 	Version string                     `json:"version"`
 	Data    *LightClientFinalityUpdate `json:"data"`
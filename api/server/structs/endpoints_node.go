@@ -68,6 +68,142 @@ type AddrRequest struct {
 	Addr string `json:"addr"`
 }
 
+type LogLevelsResponse struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+}
+
+type SetLogLevelsRequest struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+}
+
+type HealthDetailsResponse struct {
+	Data *HealthDetails `json:"data"`
+}
+
+type HealthDetails struct {
+	Status     string             `json:"status"`
+	Components []*ComponentHealth `json:"components"`
+}
+
+type ComponentHealth struct {
+	Name    string            `json:"name"`
+	Status  string            `json:"status"`
+	Reasons []string          `json:"reasons,omitempty"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+type DiskUsageResponse struct {
+	Data *DiskUsage `json:"data"`
+}
+
+type DiskUsage struct {
+	DataDirBytes          string            `json:"data_dir_bytes"`
+	ThresholdBytes        string            `json:"threshold_bytes"`
+	GrowthBytesPerSecond  map[string]string `json:"growth_bytes_per_second"`
+	SecondsUntilThreshold string            `json:"seconds_until_threshold"`
+	ThresholdTime         string            `json:"threshold_time"`
+	LastPruned            string            `json:"last_pruned"`
+	Time                  string            `json:"time"`
+}
+
+type MaintenanceResponse struct {
+	Data *Maintenance `json:"data"`
+}
+
+type Maintenance struct {
+	Phase    string `json:"phase"`
+	Since    string `json:"since"`
+	InFlight string `json:"in_flight"`
+}
+
+type RuntimeConfigResponse struct {
+	Data []*RuntimeParameter `json:"data"`
+}
+
+type RuntimeParameter struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Value       string `json:"value"`
+	Default     string `json:"default"`
+	Overridden  bool   `json:"overridden"`
+}
+
+type HealthScoreResponse struct {
+	Data *HealthScore `json:"data"`
+}
+
+type HealthScore struct {
+	Score      string                  `json:"score"`
+	Components []*HealthScoreComponent `json:"components"`
+	Time       string                  `json:"time"`
+}
+
+type HealthScoreComponent struct {
+	Name      string            `json:"name"`
+	Score     string            `json:"score"`
+	Weight    string            `json:"weight"`
+	Available bool              `json:"available"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+type FinalityStallResponse struct {
+	Data *FinalityStall `json:"data"`
+}
+
+type FinalityStall struct {
+	FinalizedEpoch      string `json:"finalized_epoch"`
+	EpochsSinceFinality string `json:"epochs_since_finality"`
+	StallSeconds        string `json:"stall_seconds"`
+	SafeMode            bool   `json:"safe_mode"`
+	SafeModeSince       string `json:"safe_mode_since,omitempty"`
+}
+
+type OptimisticStatusResponse struct {
+	Data *OptimisticStatus `json:"data"`
+}
+
+type OptimisticStatus struct {
+	Optimistic         bool   `json:"optimistic"`
+	OptimisticSince    string `json:"optimistic_since,omitempty"`
+	OptimisticSeconds  string `json:"optimistic_seconds"`
+	HeadRoot           string `json:"head_root"`
+	HeadSlot           string `json:"head_slot"`
+	PayloadBlockHash   string `json:"payload_block_hash,omitempty"`
+	PayloadBlockNumber string `json:"payload_block_number"`
+	MaxWindowSeconds   string `json:"max_window_seconds"`
+	WindowExceeded     bool   `json:"window_exceeded"`
+	ServeDuties        bool   `json:"serve_duties"`
+}
+
 type PeersResponse struct {
 	Peers []*Peer `json:"peers"`
 }
+
+type NodeIdentityResponse struct {
+	Data *NodeIdentity `json:"data"`
+}
+
+type NodeIdentity struct {
+	PeerId             string                `json:"peer_id"`
+	Enr                string                `json:"enr"`
+	Enode              string                `json:"enode"`
+	P2PAddresses       []string              `json:"p2p_addresses"`
+	DiscoveryAddresses []string              `json:"discovery_addresses"`
+	ExternalAddresses  []string              `json:"external_addresses"`
+	Ports              *NodePorts            `json:"ports"`
+	Metadata           *NodeIdentityMetadata `json:"metadata"`
+}
+
+type NodePorts struct {
+	Tcp  string `json:"tcp"`
+	Udp  string `json:"udp"`
+	Quic string `json:"quic"`
+}
+
+type NodeIdentityMetadata struct {
+	SeqNumber string `json:"seq_number"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets"`
+}
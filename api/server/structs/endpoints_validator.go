@@ -139,6 +139,40 @@ type ValidatorParticipation struct {
 	PreviousEpochHeadAttestingGwei   string `json:"previous_epoch_head_attesting_gwei"`
 }
 
+type GetParticipationHistoryResponse struct {
+	StartEpoch string                `json:"start_epoch"`
+	EndEpoch   string                `json:"end_epoch"`
+	Data       []*EpochParticipation `json:"data"`
+}
+
+type EpochParticipation struct {
+	Epoch      string `json:"epoch"`
+	ActiveGwei string `json:"active_gwei"`
+	SourceGwei string `json:"source_gwei"`
+	TargetGwei string `json:"target_gwei"`
+	HeadGwei   string `json:"head_gwei"`
+	SourceRate string `json:"source_rate"`
+	TargetRate string `json:"target_rate"`
+	HeadRate   string `json:"head_rate"`
+}
+
+type GetProposalReportResponse struct {
+	StartSlot string             `json:"start_slot"`
+	EndSlot   string             `json:"end_slot"`
+	Total     *ProposalSummary   `json:"total"`
+	Sources   []*ProposalSummary `json:"sources"`
+}
+
+type ProposalSummary struct {
+	Source              string `json:"source,omitempty"`
+	Relay               string `json:"relay,omitempty"`
+	Proposals           string `json:"proposals"`
+	ExecutionValueGwei  string `json:"execution_value_gwei"`
+	ConsensusRewardGwei string `json:"consensus_reward_gwei"`
+	BidValueGwei        string `json:"bid_value_gwei"`
+	LostBidValueGwei    string `json:"lost_bid_value_gwei"`
+}
+
 type ActiveSetChanges struct {
 	Epoch               string   `json:"epoch"`
 	ActivatedPublicKeys []string `json:"activated_public_keys"`
@@ -150,3 +184,145 @@ type ActiveSetChanges struct {
 	EjectedPublicKeys   []string `json:"ejected_public_keys"`
 	EjectedIndices      []string `json:"ejected_indices"`
 }
+
+type GetSlashingHistoryResponse struct {
+	ValidatorIndex string                      `json:"validator_index"`
+	StartEpoch     string                      `json:"start_epoch"`
+	EndEpoch       string                      `json:"end_epoch"`
+	Attestations   []*SlasherAttestationRecord `json:"attestations"`
+	Proposals      []*SlasherProposalRecord    `json:"proposals"`
+	Offenses       []*SlasherOffense           `json:"offenses"`
+}
+
+type GetBalanceHistoryResponse struct {
+	ValidatorIndex string             `json:"validator_index"`
+	StartEpoch     string             `json:"start_epoch"`
+	EndEpoch       string             `json:"end_epoch"`
+	Balances       []*ArchivedBalance `json:"balances"`
+}
+
+type ArchivedBalance struct {
+	Epoch   string `json:"epoch"`
+	Balance string `json:"balance"`
+	// Change is empty when the balance of the previous epoch was not archived.
+	Change string `json:"change,omitempty"`
+}
+
+type SlasherAttestationRecord struct {
+	SourceEpoch string `json:"source_epoch"`
+	TargetEpoch string `json:"target_epoch"`
+	DataRoot    string `json:"data_root"`
+}
+
+type SlasherProposalRecord struct {
+	Slot       string `json:"slot"`
+	HeaderRoot string `json:"header_root"`
+}
+
+type SlasherOffense struct {
+	Kind         string `json:"kind"`
+	Epoch        string `json:"epoch"`
+	SlashingRoot string `json:"slashing_root"`
+	DetectedAt   string `json:"detected_at"`
+}
+
+type GetExecutionRequestsResponse struct {
+	ValidatorIndex           string                          `json:"validator_index"`
+	Epoch                    string                          `json:"epoch"`
+	ExitEpoch                string                          `json:"exit_epoch,omitempty"`
+	WithdrawableEpoch        string                          `json:"withdrawable_epoch,omitempty"`
+	PendingBalanceToWithdraw string                          `json:"pending_balance_to_withdraw"`
+	PartialWithdrawals       []*PartialWithdrawalRequestInfo `json:"partial_withdrawals"`
+	Consolidations           []*ConsolidationRequestInfo     `json:"consolidations"`
+}
+
+type PartialWithdrawalRequestInfo struct {
+	Amount            string `json:"amount"`
+	WithdrawableEpoch string `json:"withdrawable_epoch"`
+	Status            string `json:"status"`
+}
+
+type ConsolidationRequestInfo struct {
+	SourceIndex       string `json:"source_index"`
+	TargetIndex       string `json:"target_index"`
+	WithdrawableEpoch string `json:"withdrawable_epoch"`
+	Status            string `json:"status"`
+}
+
+type ValidatorQueuesResponse struct {
+	Epoch                  string         `json:"epoch"`
+	ChurnUnit              string         `json:"churn_unit"`
+	ActivationChurnLimit   string         `json:"activation_churn_limit"`
+	ExitChurnLimit         string         `json:"exit_churn_limit"`
+	ActivationQueueLength  string         `json:"activation_queue_length"`
+	ActivationQueueBalance string         `json:"activation_queue_balance"`
+	ExitQueueLength        string         `json:"exit_queue_length"`
+	ExitQueueBalance       string         `json:"exit_queue_balance"`
+	Activation             *QueueEstimate `json:"activation"`
+	Exit                   *QueueEstimate `json:"exit"`
+}
+
+type QueueEstimate struct {
+	Epoch       string `json:"epoch"`
+	WaitEpochs  string `json:"wait_epochs"`
+	WaitSeconds string `json:"wait_seconds"`
+	Time        string `json:"time"`
+}
+
+type GetProposerLookaheadResponse struct {
+	Data []*ProposerLookahead `json:"data"`
+}
+
+type ProposerLookahead struct {
+	Epoch         string          `json:"epoch"`
+	DependentRoot string          `json:"dependent_root,omitempty"`
+	Final         bool            `json:"final"`
+	Proposers     []*ProposerDuty `json:"proposers"`
+}
+
+type GetCommitteeAssignmentsResponse struct {
+	Epoch string                 `json:"epoch"`
+	Data  []*CommitteeAssignment `json:"data"`
+}
+
+type CommitteeAssignment struct {
+	ValidatorIndex          string `json:"validator_index"`
+	Slot                    string `json:"slot"`
+	CommitteeIndex          string `json:"committee_index"`
+	CommitteeLength         string `json:"committee_length"`
+	CommitteesAtSlot        string `json:"committees_at_slot"`
+	ValidatorCommitteeIndex string `json:"validator_committee_index"`
+}
+
+type MonitoredValidatorsResponse struct {
+	Indices []string `json:"indices"`
+}
+
+type MonitoredValidatorsRequest struct {
+	Indices []string `json:"indices"`
+}
+
+type AggregateSelectionRequest struct {
+	Slot           string `json:"slot"`
+	CommitteeIndex string `json:"committee_index"`
+	Pubkey         string `json:"pubkey"`
+	SelectionProof string `json:"selection_proof"`
+}
+
+type AggregateAndProofResponse struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type RelayRegistrationsResponse struct {
+	Data []*RelayRegistration `json:"data"`
+}
+
+type RelayRegistration struct {
+	Relay          string `json:"relay"`
+	Pubkey         string `json:"pubkey"`
+	FeeRecipient   string `json:"fee_recipient"`
+	GasLimit       string `json:"gas_limit"`
+	Timestamp      string `json:"timestamp"`
+	LastRegistered string `json:"last_registered"`
+}
@@ -0,0 +1,94 @@
+package alerts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Event is a kind of condition which raises an alert.
+type Event string
+
+const (
+	// MissedProposal is raised when a tracked validator did not propose the block of its slot.
+	MissedProposal Event = "missed-proposal"
+	// AttestationInclusionStreak is raised when none of the attestations of a tracked validator
+	// were included for several epochs in a row.
+	AttestationInclusionStreak Event = "attestation-inclusion-streak"
+	// FinalityStall is raised when the chain has not finalized for several epochs.
+	FinalityStall Event = "finality-stall"
+	// LowPeerCount is raised when the node is connected to too few peers.
+	LowPeerCount Event = "low-peer-count"
+	// DiskNearlyFull is raised when the disk of the data directory is nearly full.
+	DiskNearlyFull Event = "disk-nearly-full"
+	// CheckpointDivergence is raised when the finalized chain of the node diverges from the finalized
+	// checkpoint a quorum of independent checkpoint providers agree on.
+	CheckpointDivergence Event = "checkpoint-divergence"
+	// FeeRecipientMismatch is raised when the payload of a block proposed by an attached validator does not pay
+	// its configured fee recipient.
+	FeeRecipientMismatch Event = "fee-recipient-mismatch"
+	// OptimisticWindow is raised when the head of the node has been optimistic for longer than the maximum
+	// optimistic window.
+	OptimisticWindow Event = "optimistic-window"
+)
+
+// Events lists all the events which can raise an alert.
+var Events = []Event{MissedProposal, AttestationInclusionStreak, FinalityStall, LowPeerCount, DiskNearlyFull, CheckpointDivergence, FeeRecipientMismatch, OptimisticWindow}
+
+// ParseEvents parses the names of events, as given on the command line.
+func ParseEvents(names []string) ([]Event, error) {
+	events := make([]Event, 0, len(names))
+	for _, name := range names {
+		e := Event(strings.TrimSpace(name))
+		found := false
+		for _, known := range Events {
+			if e == known {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("unknown alert event %q", name)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// Severity is how urgent an alert is. The values are the ones of the PagerDuty events API.
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityError    Severity = "error"
+	SeverityWarning  Severity = "warning"
+	SeverityInfo     Severity = "info"
+)
+
+// Alert is a notification sent to the operator.
+type Alert struct {
+	Event Event
+	// Key tells apart the alerts of a same event, e.g. the index of the validator which missed its duties.
+	Key      string
+	Severity Severity
+	Summary  string
+	Details  map[string]string
+	// Resolved is set when the condition which raised the alert has cleared.
+	Resolved bool
+	Time     time.Time
+}
+
+// DedupKey identifies the alert, so that an alert which is resolved matches the one which was raised.
+func (a *Alert) DedupKey() string {
+	if a.Key == "" {
+		return string(a.Event)
+	}
+	return fmt.Sprintf("%s/%s", a.Event, a.Key)
+}
+
+// Alerter raises and resolves alerts.
+type Alerter interface {
+	Notify(a *Alert)
+}
@@ -0,0 +1,12 @@
+/*
+Package alerts defines a runtime service which notifies the operator of a beacon
+node, through a webhook, when something needs their attention: a tracked validator
+missed a proposal or failed to get its attestations included for several epochs,
+the chain stopped finalizing, the node lost its peers, its disk is nearly full or
+its finalized chain diverges from the one of independent checkpoint providers.
+
+The payload posted to the webhook is compatible with both Slack incoming webhooks
+and the PagerDuty events API. Every alert is sent once when it is raised, and once
+more when the condition which caused it clears.
+*/
+package alerts
@@ -0,0 +1,5 @@
+package alerts
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "alerts")
@@ -0,0 +1,14 @@
+package alerts
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var alertsSentCount = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "alerts_sent_total",
+		Help: "The number of alerts sent to the webhook, by event and result",
+	},
+	[]string{"event", "result"},
+)
@@ -0,0 +1,315 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// queueSize is the number of alerts waiting to be sent, past which new alerts are dropped.
+const queueSize = 64
+
+// oneShotEvents are raised once for a slot and never resolved. Their alerts are forgotten once they expire, when the
+// slot is too old for the alert to be raised again.
+var oneShotEvents = map[Event]bool{
+	MissedProposal:       true,
+	FeeRecipientMismatch: true,
+}
+
+// activeAlert is an alert which was raised and not resolved yet.
+type activeAlert struct {
+	event  Event
+	raised time.Time
+}
+
+// Config contains the dependencies of the alerts service and the thresholds of the conditions it checks.
+type Config struct {
+	Notifier            Notifier
+	Events              []Event
+	InitialSyncComplete chan struct{}
+	ClockWaiter         startup.ClockWaiter
+	FinalizationFetcher blockchain.FinalizationFetcher
+	PeersProvider       p2p.PeersProvider
+	// OptimisticStatusFetcher reports how long the head has been optimistic, against the maximum optimistic window.
+	OptimisticStatusFetcher blockchain.OptimisticStatusFetcher
+	// DataDir is the directory whose disk usage is checked.
+	DataDir string
+	// MinPeers is the number of connected peers below which the peer count is low.
+	MinPeers int
+	// FinalityStallEpochs is the number of epochs since the finalized checkpoint past which finality has stalled.
+	FinalityStallEpochs primitives.Epoch
+	// MinDiskFreePercent is the percentage of free disk space below which the disk is nearly full.
+	MinDiskFreePercent uint64
+}
+
+// Service checks the health of the node every slot, and sends the alerts raised by these checks, or
+// by other services, to the notifier.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+	events map[Event]bool
+	queue  chan *Alert
+
+	// Locks access to active.
+	sync.Mutex
+	active map[string]activeAlert
+}
+
+var _ Alerter = (*Service)(nil)
+
+// NewService returns an alerts service sending the alerts of the configured events.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	events := make(map[Event]bool, len(cfg.Events))
+	for _, e := range cfg.Events {
+		events[e] = true
+	}
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+		events: events,
+		queue:  make(chan *Alert, queueSize),
+		active: make(map[string]activeAlert),
+	}
+}
+
+// Start the alerts service.
+func (s *Service) Start() {
+	events := make([]Event, 0, len(s.events))
+	for _, e := range Events {
+		if s.events[e] {
+			events = append(events, e)
+		}
+	}
+	log.WithField("events", events).Info("Starting service")
+	go s.send()
+	go s.run()
+}
+
+// Stop the alerts service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the alerts service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Enabled reports whether alerts are sent for the event.
+func (s *Service) Enabled(e Event) bool {
+	return s.events[e]
+}
+
+// Notify queues the alert to be sent. An alert is only sent when it is raised for the first time, or
+// when it resolves an alert which was raised before.
+func (s *Service) Notify(a *Alert) {
+	if !s.Enabled(a.Event) {
+		return
+	}
+	if a.Time.IsZero() {
+		a.Time = time.Now()
+	}
+	key := a.DedupKey()
+	s.Lock()
+	if _, ok := s.active[key]; ok != a.Resolved {
+		s.Unlock()
+		return
+	}
+	if a.Resolved {
+		delete(s.active, key)
+	} else {
+		s.active[key] = activeAlert{event: a.Event, raised: a.Time}
+	}
+	s.Unlock()
+
+	select {
+	case s.queue <- a:
+	default:
+		log.WithFields(logrus.Fields{
+			"event":   a.Event,
+			"summary": a.Summary,
+		}).Warn("Too many alerts waiting to be sent, dropping alert")
+	}
+}
+
+// expire forgets the alerts of one-shot events raised more than two epochs ago. The alerts of a slot are raised
+// within an epoch of it, so they cannot be raised again.
+func (s *Service) expire(now time.Time) {
+	cfg := params.BeaconConfig()
+	expiry := time.Duration(2*uint64(cfg.SlotsPerEpoch)*cfg.SecondsPerSlot) * time.Second
+	s.Lock()
+	defer s.Unlock()
+	for key, a := range s.active {
+		if oneShotEvents[a.event] && now.Sub(a.raised) >= expiry {
+			delete(s.active, key)
+		}
+	}
+}
+
+// send delivers the queued alerts to the notifier.
+func (s *Service) send() {
+	for {
+		select {
+		case a := <-s.queue:
+			fields := logrus.Fields{
+				"event":    a.Event,
+				"severity": a.Severity,
+				"resolved": a.Resolved,
+			}
+			if err := s.cfg.Notifier.Send(s.ctx, a); err != nil {
+				alertsSentCount.WithLabelValues(string(a.Event), "failure").Inc()
+				log.WithError(err).WithFields(fields).Error("Could not send alert")
+				continue
+			}
+			alertsSentCount.WithLabelValues(string(a.Event), "success").Inc()
+			log.WithFields(fields).Info(a.Summary)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// run checks the health of the node at every slot once the node is synced.
+func (s *Service) run() {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not wait for clock")
+		return
+	}
+	select {
+	case <-s.cfg.InitialSyncComplete:
+	case <-s.ctx.Done():
+		return
+	}
+
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			s.checkFinality(slot)
+			s.checkOptimistic()
+			s.checkPeers()
+			s.checkDisk()
+			s.expire(time.Now())
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// checkFinality raises an alert when the chain has not finalized for too many epochs.
+func (s *Service) checkFinality(slot primitives.Slot) {
+	if !s.Enabled(FinalityStall) {
+		return
+	}
+	finalized := s.cfg.FinalizationFetcher.FinalizedCheckpt()
+	if finalized == nil {
+		return
+	}
+	epoch := slots.ToEpoch(slot)
+	var since primitives.Epoch
+	if epoch > finalized.Epoch {
+		since = epoch - finalized.Epoch
+	}
+	// The alert escalates the longer the chain does not finalize, each severity being raised and resolved on its own.
+	for _, level := range []struct {
+		severity Severity
+		epochs   primitives.Epoch
+	}{
+		{SeverityWarning, s.cfg.FinalityStallEpochs},
+		{SeverityError, 2 * s.cfg.FinalityStallEpochs},
+		{SeverityCritical, 4 * s.cfg.FinalityStallEpochs},
+	} {
+		s.Notify(&Alert{
+			Event:    FinalityStall,
+			Key:      string(level.severity),
+			Severity: level.severity,
+			Summary:  fmt.Sprintf("Chain has not finalized for %d epochs", since),
+			Details: map[string]string{
+				"currentEpoch":   fmt.Sprintf("%d", epoch),
+				"finalizedEpoch": fmt.Sprintf("%d", finalized.Epoch),
+			},
+			Resolved: since <= level.epochs,
+		})
+	}
+}
+
+// checkOptimistic raises an alert when the head has been optimistic for longer than the maximum optimistic window.
+func (s *Service) checkOptimistic() {
+	if !s.Enabled(OptimisticWindow) {
+		return
+	}
+	st := s.cfg.OptimisticStatusFetcher.OptimisticStatus()
+	s.Notify(&Alert{
+		Event:    OptimisticWindow,
+		Severity: SeverityError,
+		Summary:  fmt.Sprintf("Head has been optimistic for %s", st.Duration.Truncate(time.Second)),
+		Details: map[string]string{
+			"headSlot":           fmt.Sprintf("%d", st.HeadSlot),
+			"headRoot":           fmt.Sprintf("%#x", st.HeadRoot),
+			"payloadBlockHash":   fmt.Sprintf("%#x", st.PayloadBlockHash),
+			"payloadBlockNumber": fmt.Sprintf("%d", st.PayloadBlockNumber),
+			"maxWindow":          st.MaxWindow.String(),
+		},
+		Resolved: !st.WindowExceeded,
+	})
+}
+
+// checkPeers raises an alert when the node is connected to too few peers.
+func (s *Service) checkPeers() {
+	if !s.Enabled(LowPeerCount) {
+		return
+	}
+	connected := len(s.cfg.PeersProvider.Peers().Connected())
+	s.Notify(&Alert{
+		Event:    LowPeerCount,
+		Severity: SeverityWarning,
+		Summary:  fmt.Sprintf("Beacon node is connected to %d peers", connected),
+		Details: map[string]string{
+			"connectedPeers": fmt.Sprintf("%d", connected),
+			"minPeers":       fmt.Sprintf("%d", s.cfg.MinPeers),
+		},
+		Resolved: connected >= s.cfg.MinPeers,
+	})
+}
+
+// checkDisk raises an alert when the disk of the data directory is nearly full.
+func (s *Service) checkDisk() {
+	if !s.Enabled(DiskNearlyFull) {
+		return
+	}
+	free, total, err := file.DiskUsage(s.cfg.DataDir)
+	if err != nil {
+		log.WithError(err).Debug("Could not get disk usage")
+		return
+	}
+	if total == 0 {
+		return
+	}
+	freePercent := free * 100 / total
+	s.Notify(&Alert{
+		Event:    DiskNearlyFull,
+		Severity: SeverityError,
+		Summary:  fmt.Sprintf("Disk of the data directory is %d%% free", freePercent),
+		Details: map[string]string{
+			"dataDir":   s.cfg.DataDir,
+			"freeBytes": fmt.Sprintf("%d", free),
+		},
+		Resolved: freePercent >= s.cfg.MinDiskFreePercent,
+	})
+}
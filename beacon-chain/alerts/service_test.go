@@ -0,0 +1,198 @@
+package alerts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corenet "github.com/libp2p/go-libp2p/core/network"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func queued(s *Service) []*Alert {
+	var alerts []*Alert
+	for {
+		select {
+		case a := <-s.queue:
+			alerts = append(alerts, a)
+		default:
+			return alerts
+		}
+	}
+}
+
+func TestService_Notify(t *testing.T) {
+	s := NewService(context.Background(), &Config{Events: []Event{MissedProposal, LowPeerCount}})
+
+	t.Run("event not enabled", func(t *testing.T) {
+		s.Notify(&Alert{Event: FinalityStall})
+		assert.Equal(t, 0, len(queued(s)))
+	})
+	t.Run("raised once", func(t *testing.T) {
+		s.Notify(&Alert{Event: MissedProposal, Key: "1/10"})
+		s.Notify(&Alert{Event: MissedProposal, Key: "1/10"})
+		s.Notify(&Alert{Event: MissedProposal, Key: "1/11"})
+		alerts := queued(s)
+		require.Equal(t, 2, len(alerts))
+		assert.Equal(t, "missed-proposal/1/10", alerts[0].DedupKey())
+		assert.Equal(t, "missed-proposal/1/11", alerts[1].DedupKey())
+		assert.Equal(t, false, alerts[0].Time.IsZero())
+	})
+	t.Run("resolved once raised", func(t *testing.T) {
+		s.Notify(&Alert{Event: LowPeerCount, Resolved: true})
+		assert.Equal(t, 0, len(queued(s)))
+		s.Notify(&Alert{Event: LowPeerCount})
+		s.Notify(&Alert{Event: LowPeerCount, Resolved: true})
+		s.Notify(&Alert{Event: LowPeerCount, Resolved: true})
+		alerts := queued(s)
+		require.Equal(t, 2, len(alerts))
+		assert.Equal(t, false, alerts[0].Resolved)
+		assert.Equal(t, true, alerts[1].Resolved)
+	})
+}
+
+func TestService_Expire(t *testing.T) {
+	s := NewService(context.Background(), &Config{Events: []Event{MissedProposal, LowPeerCount}})
+	raised := time.Unix(1700000000, 0)
+	s.Notify(&Alert{Event: MissedProposal, Key: "1/10", Time: raised})
+	s.Notify(&Alert{Event: LowPeerCount, Time: raised})
+	require.Equal(t, 2, len(queued(s)))
+
+	expiry := time.Duration(2*uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	s.expire(raised.Add(expiry - time.Second))
+	assert.Equal(t, 2, len(s.active))
+
+	// The missed proposal is forgotten, while the low peer count stays active until it is resolved.
+	s.expire(raised.Add(expiry))
+	require.Equal(t, 1, len(s.active))
+	_, ok := s.active[string(LowPeerCount)]
+	assert.Equal(t, true, ok)
+	s.Notify(&Alert{Event: LowPeerCount})
+	assert.Equal(t, 0, len(queued(s)))
+}
+
+func TestService_CheckFinality(t *testing.T) {
+	chain := &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 10}}
+	s := NewService(context.Background(), &Config{
+		Events:              []Event{FinalityStall},
+		FinalizationFetcher: chain,
+		FinalityStallEpochs: 4,
+	})
+
+	s.checkFinality(14 * 32)
+	assert.Equal(t, 0, len(queued(s)))
+
+	s.checkFinality(15 * 32)
+	alerts := queued(s)
+	require.Equal(t, 1, len(alerts))
+	assert.Equal(t, FinalityStall, alerts[0].Event)
+	assert.Equal(t, "Chain has not finalized for 5 epochs", alerts[0].Summary)
+	assert.Equal(t, SeverityWarning, alerts[0].Severity)
+
+	// The alert escalates as the stall goes on.
+	s.checkFinality(18 * 32)
+	assert.Equal(t, 0, len(queued(s)))
+	s.checkFinality(27 * 32)
+	alerts = queued(s)
+	require.Equal(t, 2, len(alerts))
+	assert.Equal(t, SeverityError, alerts[0].Severity)
+	assert.Equal(t, SeverityCritical, alerts[1].Severity)
+
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 26}
+	s.checkFinality(28 * 32)
+	alerts = queued(s)
+	require.Equal(t, 3, len(alerts))
+	for _, a := range alerts {
+		assert.Equal(t, true, a.Resolved)
+	}
+}
+
+type mockOptimisticStatus struct {
+	status *blockchain.OptimisticStatus
+}
+
+func (m *mockOptimisticStatus) OptimisticStatus() *blockchain.OptimisticStatus { return m.status }
+
+func TestService_CheckOptimistic(t *testing.T) {
+	fetcher := &mockOptimisticStatus{status: &blockchain.OptimisticStatus{
+		Optimistic: true,
+		Duration:   10 * time.Minute,
+		MaxWindow:  30 * time.Minute,
+	}}
+	s := NewService(context.Background(), &Config{
+		Events:                  []Event{OptimisticWindow},
+		OptimisticStatusFetcher: fetcher,
+	})
+
+	s.checkOptimistic()
+	assert.Equal(t, 0, len(queued(s)))
+
+	fetcher.status.Duration = time.Hour
+	fetcher.status.WindowExceeded = true
+	fetcher.status.PayloadBlockNumber = 100
+	s.checkOptimistic()
+	alerts := queued(s)
+	require.Equal(t, 1, len(alerts))
+	assert.Equal(t, OptimisticWindow, alerts[0].Event)
+	assert.Equal(t, "Head has been optimistic for 1h0m0s", alerts[0].Summary)
+	assert.Equal(t, "100", alerts[0].Details["payloadBlockNumber"])
+
+	fetcher.status = &blockchain.OptimisticStatus{MaxWindow: 30 * time.Minute}
+	s.checkOptimistic()
+	alerts = queued(s)
+	require.Equal(t, 1, len(alerts))
+	assert.Equal(t, true, alerts[0].Resolved)
+}
+
+func TestService_CheckPeers(t *testing.T) {
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	s := NewService(context.Background(), &Config{
+		Events:        []Event{LowPeerCount},
+		PeersProvider: peerFetcher,
+		MinPeers:      2,
+	})
+
+	s.checkPeers()
+	alerts := queued(s)
+	require.Equal(t, 1, len(alerts))
+	assert.Equal(t, "0", alerts[0].Details["connectedPeers"])
+
+	for _, id := range libp2ptest.GeneratePeerIDs(2) {
+		peerFetcher.Peers().Add(nil, id, nil, corenet.DirOutbound)
+		peerFetcher.Peers().SetConnectionState(id, peers.Connected)
+	}
+	s.checkPeers()
+	alerts = queued(s)
+	require.Equal(t, 1, len(alerts))
+	assert.Equal(t, true, alerts[0].Resolved)
+}
+
+func TestService_CheckDisk(t *testing.T) {
+	s := NewService(context.Background(), &Config{
+		Events:             []Event{DiskNearlyFull},
+		DataDir:            t.TempDir(),
+		MinDiskFreePercent: 101,
+	})
+	s.checkDisk()
+	alerts := queued(s)
+	require.Equal(t, 1, len(alerts))
+	assert.Equal(t, DiskNearlyFull, alerts[0].Event)
+}
+
+func TestParseEvents(t *testing.T) {
+	events, err := ParseEvents([]string{"missed-proposal", " disk-nearly-full"})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []Event{MissedProposal, DiskNearlyFull}, events)
+
+	_, err = ParseEvents([]string{"missed-attestation"})
+	require.ErrorContains(t, "unknown alert event", err)
+}
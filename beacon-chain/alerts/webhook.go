@@ -0,0 +1,113 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// Notifier delivers alerts to the operator.
+type Notifier interface {
+	Send(ctx context.Context, a *Alert) error
+}
+
+// Webhook posts alerts to a URL. The payload carries a text field, which is what Slack incoming
+// webhooks display, along with the fields of the PagerDuty events API.
+type Webhook struct {
+	url        string
+	routingKey string
+	source     string
+	client     *http.Client
+}
+
+// NewWebhook returns a notifier posting alerts to the URL. The routing key is the integration key of
+// PagerDuty, which is left out of the payload when empty. The source names the node sending the alerts.
+func NewWebhook(url, routingKey, source string) *Webhook {
+	return &Webhook{
+		url:        url,
+		routingKey: routingKey,
+		source:     source,
+		client:     &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+type webhookPayload struct {
+	Text        string            `json:"text"`
+	RoutingKey  string            `json:"routing_key,omitempty"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      Severity          `json:"severity"`
+	Timestamp     string            `json:"timestamp"`
+	Component     string            `json:"component"`
+	Class         Event             `json:"class"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+func (w *Webhook) payload(a *Alert) *webhookPayload {
+	action := "trigger"
+	text := fmt.Sprintf("[%s] %s", a.Severity, a.Summary)
+	if a.Resolved {
+		action = "resolve"
+		text = fmt.Sprintf("[resolved] %s", a.Summary)
+	}
+	return &webhookPayload{
+		Text:        text,
+		RoutingKey:  w.routingKey,
+		EventAction: action,
+		DedupKey:    a.DedupKey(),
+		Payload: &pagerDutyPayload{
+			Summary:       a.Summary,
+			Source:        w.source,
+			Severity:      a.Severity,
+			Timestamp:     a.Time.UTC().Format(time.RFC3339),
+			Component:     "beacon-node",
+			Class:         a.Event,
+			CustomDetails: a.Details,
+		},
+	}
+}
+
+// Send posts the alert to the webhook.
+func (w *Webhook) Send(ctx context.Context, a *Alert) error {
+	body, err := json.Marshal(w.payload(a))
+	if err != nil {
+		return errors.Wrap(err, "could not marshal alert")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create webhook request")
+	}
+	req.Header.Set("Content-Type", api.JsonMediaType)
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not post alert")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if err != nil {
+			return errors.Wrapf(err, "webhook returned status %d", resp.StatusCode)
+		}
+		return errors.Errorf("webhook returned status %d: %s", resp.StatusCode, string(msg))
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestWebhook_Send(t *testing.T) {
+	var payloads []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var p map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&p))
+		payloads = append(payloads, p)
+	}))
+	defer srv.Close()
+
+	w := NewWebhook(srv.URL, "key", "node-1")
+	a := &Alert{
+		Event:    LowPeerCount,
+		Severity: SeverityWarning,
+		Summary:  "Beacon node is connected to 1 peers",
+		Details:  map[string]string{"connectedPeers": "1"},
+		Time:     time.Unix(1700000000, 0),
+	}
+	require.NoError(t, w.Send(context.Background(), a))
+	a.Resolved = true
+	require.NoError(t, w.Send(context.Background(), a))
+
+	require.Equal(t, 2, len(payloads))
+	assert.Equal(t, "[warning] Beacon node is connected to 1 peers", payloads[0]["text"])
+	assert.Equal(t, "key", payloads[0]["routing_key"])
+	assert.Equal(t, "trigger", payloads[0]["event_action"])
+	assert.Equal(t, "low-peer-count", payloads[0]["dedup_key"])
+	p, ok := payloads[0]["payload"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "node-1", p["source"])
+	assert.Equal(t, "warning", p["severity"])
+	assert.Equal(t, "2023-11-14T22:13:20Z", p["timestamp"])
+	assert.DeepEqual(t, map[string]interface{}{"connectedPeers": "1"}, p["custom_details"])
+	assert.Equal(t, "[resolved] Beacon node is connected to 1 peers", payloads[1]["text"])
+	assert.Equal(t, "resolve", payloads[1]["event_action"])
+}
+
+func TestWebhook_Send_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid routing key", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	w := NewWebhook(srv.URL, "", "node-1")
+	err := w.Send(context.Background(), &Alert{Event: FinalityStall})
+	require.ErrorContains(t, "webhook returned status 400: invalid routing key", err)
+}
@@ -0,0 +1,11 @@
+/*
+Package balancearchive defines an optional runtime service which archives the
+balances of all the validators at the start of every epoch, so that the balance
+and reward history of a validator is served without replaying states. The
+balances are kept in a columnar store with a file per epoch, in which the
+balances of consecutive validators are compressed in independent chunks, so
+that reading the history of a validator only decompresses its chunk of each
+epoch. The change of a balance from one epoch to the next is its rewards and
+penalties, along with its deposits and withdrawals.
+*/
+package balancearchive
@@ -0,0 +1,5 @@
+package balancearchive
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "balance-archive")
@@ -0,0 +1,11 @@
+package balancearchive
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var lastArchivedEpochGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "balance_archive_last_epoch",
+	Help: "The last epoch whose validator balances were archived.",
+})
@@ -0,0 +1,268 @@
+package balancearchive
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Config contains the dependencies and the settings of the balance archive.
+type Config struct {
+	ClockWaiter   startup.ClockWaiter
+	HeadFetcher   blockchain.HeadFetcher
+	StateNotifier statefeed.Notifier
+	// ReplayerBuilder regenerates the canonical state at the start of the archived epochs.
+	ReplayerBuilder stategen.ReplayerBuilder
+	// Dir is the directory the balances are archived in.
+	Dir string
+	// RetentionEpochs is the number of epochs the balances are kept for, 0 keeping them forever.
+	RetentionEpochs primitives.Epoch
+}
+
+// Reader reads the history of the balances of the validators from the archive.
+type Reader interface {
+	BalanceHistory(idx primitives.ValidatorIndex, start, end primitives.Epoch) ([]*BalanceRecord, error)
+	ArchivedRange() (first, last primitives.Epoch, ok bool)
+}
+
+// BalanceRecord is the balance of a validator at the start of an epoch.
+type BalanceRecord struct {
+	Epoch   primitives.Epoch
+	Balance uint64
+	// Change is the change of the balance since the start of the previous epoch, which is set when the balance
+	// of the previous epoch is archived too. It accounts for the rewards and penalties of the validator, as well
+	// as for its deposits and withdrawals.
+	Change    int64
+	HasChange bool
+}
+
+// Service archives the balances of all the validators at the start of every epoch.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+	store  *Store
+
+	// Locks access to the range of archived epochs.
+	sync.RWMutex
+	archived bool
+	first    primitives.Epoch
+	last     primitives.Epoch
+}
+
+var _ Reader = (*Service)(nil)
+
+// NewService returns a balance archive service, archiving the balances in the configured directory.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	store, err := NewStore(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+	epochs, err := store.Epochs()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list archived epochs")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+		store:  store,
+	}
+	if len(epochs) > 0 {
+		s.archived = true
+		s.first = epochs[0]
+		s.last = epochs[len(epochs)-1]
+	}
+	return s, nil
+}
+
+// Start the balance archive service.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the balance archive service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the balance archive service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// ArchivedRange returns the first and the last archived epochs, or false if no epoch was archived yet.
+func (s *Service) ArchivedRange() (first, last primitives.Epoch, ok bool) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.first, s.last, s.archived
+}
+
+// BalanceHistory returns the balances of the validator at the start of the archived epochs from start to end,
+// both included. Epochs which were not archived, or at which the validator did not exist, are skipped.
+func (s *Service) BalanceHistory(idx primitives.ValidatorIndex, start, end primitives.Epoch) ([]*BalanceRecord, error) {
+	records := make([]*BalanceRecord, 0)
+	var previous uint64
+	hasPrevious := false
+	if start > 0 {
+		var err error
+		previous, hasPrevious, err = s.store.Balance(start-1, idx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for epoch := start; epoch <= end; epoch++ {
+		balance, ok, err := s.store.Balance(epoch, idx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			hasPrevious = false
+			continue
+		}
+		record := &BalanceRecord{Epoch: epoch, Balance: balance}
+		if hasPrevious {
+			record.Change = int64(balance) - int64(previous) // lint:ignore uintcast -- Balances fit in an int64.
+			record.HasChange = true
+		}
+		records = append(records, record)
+		previous, hasPrevious = balance, true
+	}
+	return records, nil
+}
+
+func (s *Service) run() {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not wait for clock")
+		return
+	}
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if err := s.archive(slot); err != nil {
+				log.WithError(err).Error("Could not archive balances")
+			}
+		case e := <-stateChannel:
+			if e.Type != statefeed.Reorg {
+				continue
+			}
+			data, ok := e.Data.(*statefeed.ReorgData)
+			if !ok {
+				log.Error("Event feed data is not of type *statefeed.ReorgData")
+				continue
+			}
+			if err := s.rearchive(data.Event.Slot, data.Event.Depth); err != nil {
+				log.WithError(err).Error("Could not archive balances again after reorg")
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state events")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// archive the balances at the start of the epoch of the slot, unless they were already archived.
+func (s *Service) archive(slot primitives.Slot) error {
+	epoch := slots.ToEpoch(slot)
+	if _, last, ok := s.ArchivedRange(); ok && last >= epoch {
+		return nil
+	}
+	// The balances of the past epochs can not be regenerated while the node is syncing.
+	if slots.ToEpoch(s.cfg.HeadFetcher.HeadSlot())+1 < epoch {
+		return nil
+	}
+	return s.archiveEpoch(epoch)
+}
+
+// rearchive archives again the balances of the archived epochs which start after the common ancestor of a reorg,
+// as the blocks they include may have changed. The common ancestor is at least depth slots before the new head.
+func (s *Service) rearchive(headSlot primitives.Slot, depth uint64) error {
+	first, last, ok := s.ArchivedRange()
+	if !ok {
+		return nil
+	}
+	var ancestor primitives.Slot
+	if uint64(headSlot) > depth {
+		ancestor = headSlot - primitives.Slot(depth)
+	}
+	for epoch := max(first, slots.ToEpoch(ancestor)+1); epoch <= last; epoch++ {
+		if err := s.archiveEpoch(epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveEpoch archives the balances of the canonical state at the start of the epoch, before its first block.
+func (s *Service) archiveEpoch(epoch primitives.Epoch) error {
+	epochStart, err := slots.EpochStart(epoch)
+	if err != nil {
+		return err
+	}
+	st, err := s.cfg.ReplayerBuilder.ReplayerForSlot(slots.PrevSlot(epochStart)).ReplayToSlot(s.ctx, epochStart)
+	if err != nil {
+		return errors.Wrapf(err, "could not replay state up to slot %d", epochStart)
+	}
+	if st == nil || st.IsNil() {
+		return errors.Errorf("state at slot %d is nil", epochStart)
+	}
+
+	if err := s.store.Save(epoch, st.Balances()); err != nil {
+		return err
+	}
+	s.Lock()
+	if !s.archived {
+		s.first = epoch
+	}
+	s.archived = true
+	s.last = max(s.last, epoch)
+	last := s.last
+	s.Unlock()
+	lastArchivedEpochGauge.Set(float64(last))
+	log.WithFields(logrus.Fields{
+		"epoch":      epoch,
+		"validators": st.NumValidators(),
+	}).Debug("Archived balances")
+
+	return s.prune(epoch)
+}
+
+// prune the balances older than the retention period, which ends at the epoch.
+func (s *Service) prune(epoch primitives.Epoch) error {
+	if s.cfg.RetentionEpochs == 0 || epoch < s.cfg.RetentionEpochs {
+		return nil
+	}
+	before := epoch + 1 - s.cfg.RetentionEpochs
+	if first, _, _ := s.ArchivedRange(); first >= before {
+		return nil
+	}
+	pruned, err := s.store.Prune(before)
+	if err != nil {
+		return errors.Wrap(err, "could not prune balance archive")
+	}
+	s.Lock()
+	s.first = max(s.first, before)
+	s.Unlock()
+	log.WithField("prunedEpochs", pruned).Debug("Pruned balance archive")
+	return nil
+}
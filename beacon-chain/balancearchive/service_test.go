@@ -0,0 +1,107 @@
+package balancearchive
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	mockstategen "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestService_ArchiveBalanceHistory(t *testing.T) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	st, _ := util.DeterministicGenesisState(t, 8)
+	require.NoError(t, st.SetSlot(slotsPerEpoch))
+	chain := &mock.ChainService{State: st}
+	replayer := mockstategen.NewReplayerBuilder()
+	dir := t.TempDir()
+	s, err := NewService(ctx, &Config{HeadFetcher: chain, ReplayerBuilder: replayer, Dir: dir, RetentionEpochs: 2})
+	require.NoError(t, err)
+	_, _, ok := s.ArchivedRange()
+	require.Equal(t, false, ok)
+
+	// Archive epochs 1 to 3, with the validator gaining 1 Gwei per epoch.
+	for epoch := primitives.Epoch(1); epoch <= 3; epoch++ {
+		require.NoError(t, st.SetSlot(primitives.Slot(epoch)*slotsPerEpoch))
+		epochState := st.Copy()
+		require.NoError(t, epochState.UpdateBalancesAtIndex(1, 32_000_000_000+uint64(epoch)))
+		replayer.SetMockStateForSlot(epochState, primitives.Slot(epoch)*slotsPerEpoch-1)
+		require.NoError(t, s.archive(primitives.Slot(epoch)*slotsPerEpoch+1))
+		// Epochs are archived once.
+		require.NoError(t, epochState.UpdateBalancesAtIndex(1, 0))
+		require.NoError(t, s.archive(primitives.Slot(epoch)*slotsPerEpoch+2))
+	}
+
+	// Epoch 1 was pruned.
+	first, last, ok := s.ArchivedRange()
+	require.Equal(t, true, ok)
+	assert.Equal(t, primitives.Epoch(2), first)
+	assert.Equal(t, primitives.Epoch(3), last)
+
+	records, err := s.BalanceHistory(1, 0, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.DeepEqual(t, &BalanceRecord{Epoch: 2, Balance: 32_000_000_002}, records[0])
+	assert.DeepEqual(t, &BalanceRecord{Epoch: 3, Balance: 32_000_000_003, Change: 1, HasChange: true}, records[1])
+
+	// The change of the first epoch of the range is computed from the epoch before it.
+	records, err = s.BalanceHistory(1, 3, 3)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.DeepEqual(t, &BalanceRecord{Epoch: 3, Balance: 32_000_000_003, Change: 1, HasChange: true}, records[0])
+
+	// The archived range is recovered after a restart.
+	restarted, err := NewService(ctx, &Config{HeadFetcher: chain, ReplayerBuilder: replayer, Dir: dir})
+	require.NoError(t, err)
+	first, last, ok = restarted.ArchivedRange()
+	require.Equal(t, true, ok)
+	assert.Equal(t, primitives.Epoch(2), first)
+	assert.Equal(t, primitives.Epoch(3), last)
+}
+
+func TestService_ArchiveSkipsWhileSyncing(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 8)
+	s, err := NewService(context.Background(), &Config{HeadFetcher: &mock.ChainService{State: st}, Dir: t.TempDir()})
+	require.NoError(t, err)
+	require.NoError(t, s.archive(primitives.Slot(10*params.BeaconConfig().SlotsPerEpoch)))
+	_, _, ok := s.ArchivedRange()
+	assert.Equal(t, false, ok)
+}
+
+func TestService_RearchiveAfterReorg(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	st, _ := util.DeterministicGenesisState(t, 8)
+	require.NoError(t, st.SetSlot(3*slotsPerEpoch))
+	replayer := mockstategen.NewReplayerBuilder()
+	s, err := NewService(context.Background(), &Config{HeadFetcher: &mock.ChainService{State: st}, ReplayerBuilder: replayer, Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	setBalance := func(epoch primitives.Epoch, balance uint64) {
+		epochState := st.Copy()
+		require.NoError(t, epochState.SetSlot(primitives.Slot(epoch)*slotsPerEpoch))
+		require.NoError(t, epochState.UpdateBalancesAtIndex(1, balance))
+		replayer.SetMockStateForSlot(epochState, primitives.Slot(epoch)*slotsPerEpoch-1)
+	}
+	for epoch := primitives.Epoch(1); epoch <= 3; epoch++ {
+		setBalance(epoch, 100+uint64(epoch))
+		require.NoError(t, s.archive(primitives.Slot(epoch)*slotsPerEpoch))
+	}
+
+	// The common ancestor of the reorg is in epoch 1, so that the balances of epochs 2 and 3 are archived again.
+	for epoch := primitives.Epoch(1); epoch <= 3; epoch++ {
+		setBalance(epoch, 200+uint64(epoch))
+	}
+	require.NoError(t, s.rearchive(3*slotsPerEpoch+5, uint64(2*slotsPerEpoch)))
+	records, err := s.BalanceHistory(1, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, uint64(101), records[0].Balance)
+	assert.Equal(t, uint64(202), records[1].Balance)
+	assert.Equal(t, uint64(203), records[2].Balance)
+}
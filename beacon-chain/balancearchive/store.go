@@ -0,0 +1,166 @@
+package balancearchive
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	// chunkSize is the number of validators whose balances are compressed together.
+	chunkSize = 4096
+	// The header of an epoch file holds the number of validators and the number of chunks, followed by the offset
+	// and the length of each chunk in the body of the file.
+	headerSize     = 8 + 4
+	chunkIndexSize = 8 + 4
+	fileExtension  = ".bal"
+)
+
+// Store is a columnar store of the balances of all the validators at each epoch, with a file per epoch. The
+// balances of an epoch are split into chunks of consecutive validators which are compressed independently, so
+// that the balance of a validator is read without decompressing the balances of all the others.
+type Store struct {
+	dir string
+}
+
+// NewStore returns a store of the balances in the directory, which is created if needed.
+func NewStore(dir string) (*Store, error) {
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "could not create balance archive directory %s", dir)
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Save the balances of all the validators at the epoch, replacing the balances saved before for the epoch.
+func (s *Store) Save(epoch primitives.Epoch, balances []uint64) error {
+	chunks := (len(balances) + chunkSize - 1) / chunkSize
+	header := make([]byte, headerSize+chunks*chunkIndexSize)
+	binary.LittleEndian.PutUint64(header, uint64(len(balances)))
+	binary.LittleEndian.PutUint32(header[8:], uint32(chunks))
+
+	var body []byte
+	raw := make([]byte, chunkSize*8)
+	for i := 0; i < chunks; i++ {
+		chunk := balances[i*chunkSize : min((i+1)*chunkSize, len(balances))]
+		for j, b := range chunk {
+			binary.LittleEndian.PutUint64(raw[j*8:], b)
+		}
+		compressed := snappy.Encode(nil, raw[:len(chunk)*8])
+		index := header[headerSize+i*chunkIndexSize:]
+		binary.LittleEndian.PutUint64(index, uint64(len(body)))
+		binary.LittleEndian.PutUint32(index[8:], uint32(len(compressed)))
+		body = append(body, compressed...)
+	}
+
+	// Write to a temporary file first, so that a crash never leaves a partially written epoch behind.
+	path := s.path(epoch)
+	tmp := path + ".tmp"
+	if err := file.WriteFile(tmp, append(header, body...)); err != nil {
+		return errors.Wrapf(err, "could not write balances of epoch %d", epoch)
+	}
+	return os.Rename(tmp, path)
+}
+
+// Balance returns the balance of the validator at the epoch. It returns false when the balances of the epoch
+// were not archived, or when the validator did not exist yet.
+func (s *Store) Balance(epoch primitives.Epoch, idx primitives.ValidatorIndex) (uint64, bool, error) {
+	f, err := os.Open(s.path(epoch))
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close balance archive file")
+		}
+	}()
+
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(f, header); err != nil {
+		return 0, false, errors.Wrapf(err, "could not read header of epoch %d", epoch)
+	}
+	count := binary.LittleEndian.Uint64(header)
+	chunks := binary.LittleEndian.Uint32(header[8:])
+	if uint64(idx) >= count {
+		return 0, false, nil
+	}
+	chunk := uint64(idx) / chunkSize
+	if chunk >= uint64(chunks) {
+		return 0, false, fmt.Errorf("corrupted balances of epoch %d: %d chunks for %d validators", epoch, chunks, count)
+	}
+	index := make([]byte, chunkIndexSize)
+	if _, err := f.ReadAt(index, int64(headerSize+chunk*chunkIndexSize)); err != nil {
+		return 0, false, errors.Wrapf(err, "could not read chunk index of epoch %d", epoch)
+	}
+	offset := int64(headerSize) + int64(chunks)*chunkIndexSize + int64(binary.LittleEndian.Uint64(index))
+	compressed := make([]byte, binary.LittleEndian.Uint32(index[8:]))
+	if _, err := f.ReadAt(compressed, offset); err != nil {
+		return 0, false, errors.Wrapf(err, "could not read chunk of epoch %d", epoch)
+	}
+	raw, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "could not decompress chunk of epoch %d", epoch)
+	}
+	pos := (uint64(idx) % chunkSize) * 8
+	if pos+8 > uint64(len(raw)) {
+		return 0, false, fmt.Errorf("corrupted balances of epoch %d: chunk too short", epoch)
+	}
+	return binary.LittleEndian.Uint64(raw[pos:]), true, nil
+}
+
+// Epochs returns the archived epochs, in increasing order.
+func (s *Store) Epochs() ([]primitives.Epoch, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	epochs := make([]primitives.Epoch, 0, len(entries))
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, fileExtension) {
+			continue
+		}
+		epoch, err := strconv.ParseUint(strings.TrimSuffix(name, fileExtension), 10, 64)
+		if err != nil {
+			continue
+		}
+		epochs = append(epochs, primitives.Epoch(epoch))
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+	return epochs, nil
+}
+
+// Prune deletes the balances of the epochs before the given one, and returns the number of epochs deleted.
+func (s *Store) Prune(before primitives.Epoch) (int, error) {
+	epochs, err := s.Epochs()
+	if err != nil {
+		return 0, err
+	}
+	pruned := 0
+	for _, epoch := range epochs {
+		if epoch >= before {
+			break
+		}
+		if err := os.Remove(s.path(epoch)); err != nil {
+			return pruned, errors.Wrapf(err, "could not delete balances of epoch %d", epoch)
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+func (s *Store) path(epoch primitives.Epoch) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%012d%s", epoch, fileExtension))
+}
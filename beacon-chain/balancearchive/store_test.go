@@ -0,0 +1,65 @@
+package balancearchive
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_SaveBalance(t *testing.T) {
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+
+	// Span several chunks, with a partial last chunk.
+	balances := make([]uint64, 2*chunkSize+10)
+	for i := range balances {
+		balances[i] = 32_000_000_000 + uint64(i)
+	}
+	require.NoError(t, store.Save(3, balances))
+
+	for _, idx := range []primitives.ValidatorIndex{0, chunkSize - 1, chunkSize, 2 * chunkSize, 2*chunkSize + 9} {
+		balance, ok, err := store.Balance(3, idx)
+		require.NoError(t, err)
+		require.Equal(t, true, ok)
+		assert.Equal(t, balances[idx], balance)
+	}
+
+	// The validator did not exist yet.
+	_, ok, err := store.Balance(3, 2*chunkSize+10)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+	// The epoch was not archived.
+	_, ok, err = store.Balance(4, 0)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	// Saving the epoch again replaces its balances.
+	require.NoError(t, store.Save(3, []uint64{1}))
+	balance, ok, err := store.Balance(3, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(1), balance)
+	_, ok, err = store.Balance(3, 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+}
+
+func TestStore_EpochsPrune(t *testing.T) {
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+	for _, epoch := range []primitives.Epoch{12, 2, 1000, 5} {
+		require.NoError(t, store.Save(epoch, []uint64{uint64(epoch)}))
+	}
+	epochs, err := store.Epochs()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []primitives.Epoch{2, 5, 12, 1000}, epochs)
+
+	pruned, err := store.Prune(12)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pruned)
+	epochs, err = store.Epochs()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []primitives.Epoch{12, 1000}, epochs)
+}
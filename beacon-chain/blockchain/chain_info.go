@@ -51,6 +51,7 @@ type ForkchoiceFetcher interface {
 	ProposerBoost() [32]byte
 	RecentBlockSlot(root [32]byte) (primitives.Slot, error)
 	IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, error)
+	Children(root [32]byte) ([][32]byte, []primitives.Slot, error)
 }
 
 // TimeFetcher retrieves the Ethereum consensus data that's related to time.
@@ -72,6 +73,7 @@ type HeadFetcher interface {
 	HeadBlock(ctx context.Context) (interfaces.ReadOnlySignedBeaconBlock, error)
 	HeadState(ctx context.Context) (state.BeaconState, error)
 	HeadStateReadOnly(ctx context.Context) (state.ReadOnlyBeaconState, error)
+	HeadRootAndStateReadOnly(ctx context.Context) ([32]byte, state.ReadOnlyBeaconState, error)
 	HeadValidatorsIndices(ctx context.Context, epoch primitives.Epoch) ([]primitives.ValidatorIndex, error)
 	HeadGenesisValidatorsRoot() [32]byte
 	HeadETH1Data() *ethpb.Eth1Data
@@ -235,6 +237,30 @@ func (s *Service) HeadStateReadOnly(ctx context.Context) (state.ReadOnlyBeaconSt
 	return s.cfg.StateGen.StateByRoot(ctx, s.headRoot())
 }
 
+// HeadRootAndStateReadOnly returns the root and the read only state of the head of the chain, read
+// under the same lock so that the state is the one of the root. The same restrictions as for
+// HeadStateReadOnly apply to the returned state.
+func (s *Service) HeadRootAndStateReadOnly(ctx context.Context) ([32]byte, state.ReadOnlyBeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.HeadRootAndStateReadOnly")
+	defer span.End()
+	s.headLock.RLock()
+	defer s.headLock.RUnlock()
+
+	ok := s.hasHeadState()
+	span.SetAttributes(trace.BoolAttribute("cache_hit", ok))
+
+	root := s.headRoot()
+	if ok {
+		return root, s.headStateReadOnly(ctx), nil
+	}
+
+	st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	return root, st, nil
+}
+
 // HeadValidatorsIndices returns a list of active validator indices from the head view of a given epoch.
 func (s *Service) HeadValidatorsIndices(ctx context.Context, epoch primitives.Epoch) ([]primitives.ValidatorIndex, error) {
 	s.headLock.RLock()
@@ -0,0 +1,53 @@
+package blockchain
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// runCommitteePrecompute makes sure, from the midpoint of every epoch on, that the committees of
+// the next epoch are in the committee cache. The shuffle of the next epoch is known as soon as the
+// epoch starts, so computing it early keeps the first slot duty queries of the next epoch from
+// blocking on a full shuffle computation, including after a restart or a cache eviction.
+func (s *Service) runCommitteePrecompute() {
+	if err := s.waitForSync(); err != nil {
+		log.WithError(err).Error("failed to wait for initial sync")
+		return
+	}
+
+	ticker := slots.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			s.precomputeNextEpochCommittees(slot)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+func (s *Service) precomputeNextEpochCommittees(slot primitives.Slot) {
+	if slot%params.BeaconConfig().SlotsPerEpoch < params.BeaconConfig().SlotsPerEpoch/2 {
+		return
+	}
+	s.headLock.RLock()
+	if !s.hasHeadState() {
+		s.headLock.RUnlock()
+		return
+	}
+	st := s.headStateReadOnly(s.ctx)
+	s.headLock.RUnlock()
+
+	// The next epoch seed is only available from a head state of the current epoch.
+	if coreTime.CurrentEpoch(st) != slots.ToEpoch(slot) {
+		return
+	}
+	if err := helpers.UpdateCommitteeCache(s.ctx, st, coreTime.NextEpoch(st)); err != nil {
+		log.WithError(err).Warn("Could not precompute next epoch committees")
+	}
+}
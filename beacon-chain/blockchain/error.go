@@ -118,3 +118,16 @@ func InvalidAncestorRoots(e error) [][32]byte {
 	}
 	return d.InvalidAncestorRoots()
 }
+
+// IsInvalidPayload returns true if the block was deemed invalid because the execution engine
+// returned an INVALID or INVALID_BLOCK_HASH status for its payload.
+func IsInvalidPayload(e error) bool {
+	var d invalidBlock
+	for errors.As(e, &d) {
+		if d.error == ErrInvalidPayload.error || d.error == ErrInvalidBlockHashPayloadStatus.error {
+			return true
+		}
+		e = d.error
+	}
+	return false
+}
@@ -42,3 +42,15 @@ func TestInvalidRoots(t *testing.T) {
 	require.Equal(t, [32]byte{'a'}, InvalidBlockRoot(newErr))
 	require.DeepEqual(t, roots, InvalidAncestorRoots(newErr))
 }
+
+func TestIsInvalidPayload(t *testing.T) {
+	require.Equal(t, true, IsInvalidPayload(ErrInvalidPayload))
+	require.Equal(t, true, IsInvalidPayload(ErrInvalidBlockHashPayloadStatus))
+	err := invalidBlock{error: ErrInvalidPayload, root: [32]byte{'a'}}
+	require.Equal(t, true, IsInvalidPayload(errors.Wrap(err, "wrap me")))
+
+	require.Equal(t, false, IsInvalidPayload(nil))
+	require.Equal(t, false, IsInvalidPayload(ErrUndefinedExecutionEngineError))
+	require.Equal(t, false, IsInvalidPayload(invalidBlock{error: errors.New("bad state transition")}))
+	require.Equal(t, false, IsInvalidPayload(ErrNotDescendantOfFinalized))
+}
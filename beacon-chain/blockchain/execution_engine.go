@@ -418,6 +418,9 @@ func (s *Service) getPayloadAttribute(ctx context.Context, st state.BeaconState,
 // removeInvalidBlockAndState removes the invalid block, blob and its corresponding state from the cache and DB.
 func (s *Service) removeInvalidBlockAndState(ctx context.Context, blkRoots [][32]byte) error {
 	for _, root := range blkRoots {
+		if s.cfg.BadBlockCache != nil {
+			s.cfg.BadBlockCache.Add(root, cache.BadBlockExecutionInvalid)
+		}
 		if err := s.cfg.StateGen.DeleteStateFromCaches(ctx, root); err != nil {
 			return err
 		}
@@ -1054,8 +1054,14 @@ func TestService_removeInvalidBlockAndState(t *testing.T) {
 	}))
 	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, st, r2))
 
+	service.cfg.BadBlockCache = cache.NewBadBlockCache(cache.BadBlockCacheSize)
 	require.NoError(t, service.removeInvalidBlockAndState(ctx, [][32]byte{r1, r2}))
 
+	entries := service.cfg.BadBlockCache.Entries()
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, r2, entries[0].Root)
+	require.Equal(t, r1, entries[1].Root)
+	require.Equal(t, cache.BadBlockExecutionInvalid, entries[0].Reason)
 	require.Equal(t, false, service.hasBlock(ctx, r1))
 	require.Equal(t, false, service.hasBlock(ctx, r2))
 	require.Equal(t, false, service.cfg.BeaconDB.HasStateSummary(ctx, r1))
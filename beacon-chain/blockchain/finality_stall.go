@@ -0,0 +1,118 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// FinalityStallFetcher reports how long the chain has not finalized, and whether the node is in safe mode.
+type FinalityStallFetcher interface {
+	FinalityStall() *FinalityStall
+}
+
+// FinalityStall describes how long the chain has not finalized.
+type FinalityStall struct {
+	FinalizedEpoch      primitives.Epoch
+	EpochsSinceFinality primitives.Epoch
+	// Duration is the time elapsed since the chain should have finalized the epoch after the finalized one, zero
+	// when the chain finalizes normally.
+	Duration time.Duration
+	// SafeMode is true while the node is in safe mode, since SafeModeSince.
+	SafeMode      bool
+	SafeModeSince time.Time
+}
+
+// safeMode tracks whether the node is in safe mode because finality has stalled for too long. In safe mode:
+//   - hot states are saved to the DB, so that they can be regenerated from disk instead of being kept in memory,
+//   - the hot state cache is capped, and the committee cache is not expanded for non-finality,
+//   - the disk usage pruning of states is skipped, as the states may be needed to recover from the stall.
+type safeMode struct {
+	sync.RWMutex
+	enabled bool
+	since   time.Time
+}
+
+// FinalityStall returns how long the chain has not finalized, and whether the node is in safe mode.
+func (s *Service) FinalityStall() *FinalityStall {
+	finalized := s.FinalizedCheckpt()
+	currentEpoch := slots.ToEpoch(s.CurrentSlot())
+	stall := &FinalityStall{FinalizedEpoch: finalized.Epoch}
+	if currentEpoch > finalized.Epoch {
+		stall.EpochsSinceFinality = currentEpoch - finalized.Epoch
+	}
+	stall.Duration = s.finalityStallDuration(finalized.Epoch, stall.EpochsSinceFinality)
+	s.safeMode.RLock()
+	stall.SafeMode = s.safeMode.enabled
+	stall.SafeModeSince = s.safeMode.since
+	s.safeMode.RUnlock()
+	return stall
+}
+
+// InSafeMode returns true while the node is in safe mode because finality has stalled.
+func (s *Service) InSafeMode() bool {
+	s.safeMode.RLock()
+	defer s.safeMode.RUnlock()
+	return s.safeMode.enabled
+}
+
+// checkFinalityStall reports the epochs since finality, and enters safe mode once there have been
+// `SafeModeStallEpochs` epochs of non-finality, or exits it once the chain finalizes again.
+// Requires a read lock on forkchoice
+func (s *Service) checkFinalityStall(ctx context.Context) error {
+	currentEpoch := slots.ToEpoch(s.CurrentSlot())
+	// Prevent `sinceFinality` going underflow.
+	var sinceFinality primitives.Epoch
+	finalized := s.cfg.ForkChoiceStore.FinalizedCheckpoint()
+	if finalized == nil {
+		return errNilFinalizedInStore
+	}
+	if currentEpoch > finalized.Epoch {
+		sinceFinality = currentEpoch - finalized.Epoch
+	}
+	epochsSinceFinality.Set(float64(sinceFinality))
+	finalityStallSeconds.Set(s.finalityStallDuration(finalized.Epoch, sinceFinality).Seconds())
+
+	stalled := s.cfg.SafeModeStallEpochs > 0 && sinceFinality >= s.cfg.SafeModeStallEpochs
+	s.safeMode.Lock()
+	defer s.safeMode.Unlock()
+	if stalled == s.safeMode.enabled {
+		return nil
+	}
+	fields := logrus.Fields{
+		"finalizedEpoch":      finalized.Epoch,
+		"epochsSinceFinality": sinceFinality,
+	}
+	s.safeMode.enabled = stalled
+	if stalled {
+		s.safeMode.since = time.Now()
+		safeModeGauge.Set(1)
+		log.WithFields(fields).Warn("Finality has stalled, entering safe mode")
+		s.cfg.StateGen.EnableSaveHotStateToDB(ctx)
+		s.cfg.StateGen.CapHotStateCache(ctx, true)
+		return nil
+	}
+	s.safeMode.since = time.Time{}
+	safeModeGauge.Set(0)
+	log.WithFields(fields).Info("Chain is finalizing again, exiting safe mode")
+	s.cfg.StateGen.CapHotStateCache(ctx, false)
+	return nil
+}
+
+// finalityStallDuration returns the time elapsed since the chain should have finalized the epoch after the finalized
+// one. The chain normally finalizes the epoch two epochs before the current one, so that it should have finalized
+// the epoch after the finalized one at the start of the third epoch after it.
+func (s *Service) finalityStallDuration(finalizedEpoch, sinceFinality primitives.Epoch) time.Duration {
+	if sinceFinality <= 2 {
+		return 0
+	}
+	start, err := slots.EpochStart(finalizedEpoch + 3)
+	if err != nil {
+		return 0
+	}
+	return max(time.Since(slots.StartTime(uint64(s.genesisTime.Unix()), start)), 0)
+}
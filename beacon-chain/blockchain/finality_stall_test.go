@@ -0,0 +1,47 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestCheckFinalityStall_SafeMode(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s, _ := minimalTestService(t, WithSafeModeStallEpochs(16))
+	st := params.BeaconConfig().SlotsPerEpoch.Mul(16)
+	s.genesisTime = time.Now().Add(time.Duration(-1*int64(st)*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second)
+
+	require.NoError(t, s.checkFinalityStall(context.Background()))
+	assert.LogsContain(t, hook, "Finality has stalled, entering safe mode")
+	assert.LogsContain(t, hook, "Entering mode to save hot states in DB")
+	assert.Equal(t, true, s.InSafeMode())
+	stall := s.FinalityStall()
+	assert.Equal(t, true, stall.SafeMode)
+	assert.Equal(t, uint64(16), uint64(stall.EpochsSinceFinality))
+	assert.Equal(t, true, stall.Duration > 0)
+
+	s.genesisTime = time.Now()
+	require.NoError(t, s.checkFinalityStall(context.Background()))
+	assert.LogsContain(t, hook, "Chain is finalizing again, exiting safe mode")
+	assert.Equal(t, false, s.InSafeMode())
+	stall = s.FinalityStall()
+	assert.Equal(t, false, stall.SafeMode)
+	assert.Equal(t, time.Duration(0), stall.Duration)
+}
+
+func TestCheckFinalityStall_Disabled(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s, _ := minimalTestService(t)
+	st := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epochsSinceFinalitySaveHotStateDB))
+	s.genesisTime = time.Now().Add(time.Duration(-1*int64(st)*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second)
+
+	require.NoError(t, s.checkFinalityStall(context.Background()))
+	assert.LogsDoNotContain(t, hook, "entering safe mode")
+	assert.Equal(t, false, s.InSafeMode())
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	coreblocks "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
@@ -20,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/math"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
@@ -86,6 +88,7 @@ func (s *Service) saveHead(ctx context.Context, newHeadRoot [32]byte, headBlock
 		return errors.Wrap(err, "could not get old head block")
 	}
 	oldStateRoot := oldHeadBlock.Block().StateRoot()
+	oldHeadProposer := oldHeadBlock.Block().ProposerIndex()
 	s.headLock.RUnlock()
 	headSlot := s.HeadSlot()
 	newHeadSlot := headBlock.Block().Slot()
@@ -100,7 +103,9 @@ func (s *Service) saveHead(ctx context.Context, newHeadRoot [32]byte, headBlock
 	if err != nil {
 		log.WithError(err).Error("could not check if node is optimistically synced")
 	}
-	if headBlock.Block().ParentRoot() != oldHeadRoot {
+	isReorg := headBlock.Block().ParentRoot() != oldHeadRoot
+	s.reorgsPerEpoch.record(slots.ToEpoch(newHeadSlot), isReorg)
+	if isReorg {
 		// A chain re-org occurred, so we fire an event notifying the rest of the services.
 		commonRoot, forkSlot, err := s.cfg.ForkChoiceStore.CommonAncestor(ctx, oldHeadRoot, newHeadRoot)
 		if err != nil {
@@ -117,13 +122,16 @@ func (s *Service) saveHead(ctx context.Context, newHeadRoot [32]byte, headBlock
 		if err != nil {
 			log.WithField("root", fmt.Sprintf("%#x", newHeadRoot)).Warn("could not determine node weight")
 		}
+		newHeadProposer := headBlock.Block().ProposerIndex()
 		log.WithFields(logrus.Fields{
 			"newSlot":            fmt.Sprintf("%d", newHeadSlot),
 			"newRoot":            fmt.Sprintf("%#x", newHeadRoot),
 			"newWeight":          newWeight,
+			"newProposer":        newHeadProposer,
 			"oldSlot":            fmt.Sprintf("%d", headSlot),
 			"oldRoot":            fmt.Sprintf("%#x", oldHeadRoot),
 			"oldWeight":          oldWeight,
+			"oldProposer":        oldHeadProposer,
 			"commonAncestorRoot": fmt.Sprintf("%#x", commonRoot),
 			"distance":           dis,
 			"depth":              dep,
@@ -133,19 +141,27 @@ func (s *Service) saveHead(ctx context.Context, newHeadRoot [32]byte, headBlock
 
 		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
 			Type: statefeed.Reorg,
-			Data: &ethpbv1.EventChainReorg{
-				Slot:                newHeadSlot,
-				Depth:               math.Max(uint64(headSlot-forkSlot), uint64(newHeadSlot-forkSlot)),
-				OldHeadBlock:        oldHeadRoot[:],
-				NewHeadBlock:        newHeadRoot[:],
-				OldHeadState:        oldStateRoot[:],
-				NewHeadState:        newStateRoot[:],
-				Epoch:               slots.ToEpoch(newHeadSlot),
-				ExecutionOptimistic: isOptimistic,
+			Data: &statefeed.ReorgData{
+				Event: &ethpbv1.EventChainReorg{
+					Slot:                newHeadSlot,
+					Depth:               dep,
+					OldHeadBlock:        oldHeadRoot[:],
+					NewHeadBlock:        newHeadRoot[:],
+					OldHeadState:        oldStateRoot[:],
+					NewHeadState:        newStateRoot[:],
+					Epoch:               slots.ToEpoch(newHeadSlot),
+					ExecutionOptimistic: isOptimistic,
+				},
+				Distance:           uint64(dis),
+				CommonAncestorRoot: commonRoot,
+				OldHeadProposer:    oldHeadProposer,
+				NewHeadProposer:    newHeadProposer,
+				OldHeadWeight:      oldWeight,
+				NewHeadWeight:      newWeight,
 			},
 		})
 
-		if err := s.saveOrphanedOperations(ctx, oldHeadRoot, newHeadRoot); err != nil {
+		if err := s.saveOrphanedOperations(ctx, oldHeadRoot, newHeadRoot, headState); err != nil {
 			return err
 		}
 		reorgCount.Inc()
@@ -221,6 +237,7 @@ func (s *Service) setHead(newHead *head) error {
 		optimistic: newHead.optimistic,
 		slot:       newHead.slot,
 	}
+	s.optimisticWindow.update(newHead.optimistic)
 	return nil
 }
 
@@ -242,6 +259,7 @@ func (s *Service) setHeadInitialSync(root [32]byte, block interfaces.ReadOnlySig
 		state:      state,
 		optimistic: optimistic,
 	}
+	s.optimisticWindow.update(optimistic)
 	return nil
 }
 
@@ -374,9 +392,11 @@ func (s *Service) notifyNewHeadEvent(
 	return nil
 }
 
-// This saves the Attestations and BLSToExecChanges between `orphanedRoot` and the common ancestor root that is derived using `newHeadRoot`.
+// This saves the Attestations, slashings, exits and BLSToExecChanges between `orphanedRoot` and the common ancestor root
+// that is derived using `newHeadRoot`. Slashings, exits and BLSToExecChanges are only re-inserted into their pools if
+// they are still valid on top of `newHeadState`.
 // It also filters out the attestations that is one epoch older as a defense so invalid attestations don't flow into the attestation pool.
-func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]byte, newHeadRoot [32]byte) error {
+func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]byte, newHeadRoot [32]byte, newHeadState state.ReadOnlyBeaconState) error {
 	commonAncestorRoot, _, err := s.cfg.ForkChoiceStore.CommonAncestor(ctx, newHeadRoot, orphanedRoot)
 	switch {
 	// Exit early if there's no common ancestor and root doesn't exist, there would be nothing to save.
@@ -414,19 +434,29 @@ func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]b
 				}
 			}
 			saveOrphanedAttCount.Inc()
+			recoveredOperationsCount.WithLabelValues("attestation").Inc()
 		}
 		for _, as := range orphanedBlk.Block().Body().AttesterSlashings() {
-			if err := s.cfg.SlashingPool.InsertAttesterSlashing(ctx, s.headStateReadOnly(ctx), as); err != nil {
-				log.WithError(err).Error("Could not insert reorg attester slashing")
+			if err := s.cfg.SlashingPool.InsertAttesterSlashing(ctx, newHeadState, as); err != nil {
+				log.WithError(err).Debug("Could not insert reorg attester slashing")
+				continue
 			}
+			recoveredOperationsCount.WithLabelValues("attester_slashing").Inc()
 		}
 		for _, vs := range orphanedBlk.Block().Body().ProposerSlashings() {
-			if err := s.cfg.SlashingPool.InsertProposerSlashing(ctx, s.headStateReadOnly(ctx), vs); err != nil {
-				log.WithError(err).Error("Could not insert reorg proposer slashing")
+			if err := s.cfg.SlashingPool.InsertProposerSlashing(ctx, newHeadState, vs); err != nil {
+				log.WithError(err).Debug("Could not insert reorg proposer slashing")
+				continue
 			}
+			recoveredOperationsCount.WithLabelValues("proposer_slashing").Inc()
 		}
 		for _, v := range orphanedBlk.Block().Body().VoluntaryExits() {
+			if err := verifyOrphanedExit(newHeadState, v); err != nil {
+				log.WithError(err).Debug("Could not insert reorg voluntary exit")
+				continue
+			}
 			s.cfg.ExitPool.InsertVoluntaryExit(v)
+			recoveredOperationsCount.WithLabelValues("voluntary_exit").Inc()
 		}
 		if orphanedBlk.Version() >= version.Capella {
 			changes, err := orphanedBlk.Block().Body().BLSToExecutionChanges()
@@ -434,7 +464,12 @@ func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]b
 				return errors.Wrap(err, "could not get BLSToExecutionChanges")
 			}
 			for _, c := range changes {
+				if _, err := coreblocks.ValidateBLSToExecutionChange(newHeadState, c); err != nil {
+					log.WithError(err).Debug("Could not insert reorg BLS to execution change")
+					continue
+				}
 				s.cfg.BLSToExecPool.InsertBLSToExecChange(c)
+				recoveredOperationsCount.WithLabelValues("bls_to_execution_change").Inc()
 			}
 		}
 		parentRoot := orphanedBlk.Block().ParentRoot()
@@ -442,3 +477,15 @@ func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]b
 	}
 	return nil
 }
+
+// verifyOrphanedExit checks that a voluntary exit from an orphaned block can still be included on top of the new head.
+func verifyOrphanedExit(st state.ReadOnlyBeaconState, exit *ethpb.SignedVoluntaryExit) error {
+	if exit == nil || exit.Exit == nil {
+		return errors.New("nil exit")
+	}
+	val, err := st.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+	return coreblocks.VerifyExitAndSignature(val, st, exit)
+}
@@ -148,6 +148,8 @@ func TestSaveHead_Different_Reorg(t *testing.T) {
 	require.LogsContain(t, hook, "Chain reorg occurred")
 	require.LogsContain(t, hook, "distance=1")
 	require.LogsContain(t, hook, "depth=1")
+	require.LogsContain(t, hook, "oldProposer=")
+	require.LogsContain(t, hook, "newProposer=")
 }
 
 func Test_notifyNewHeadEvent(t *testing.T) {
@@ -256,6 +258,11 @@ func TestRetrieveHead_ReadOnly(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, rOnlyState, service.head.state, "Head is not the same object")
+
+	root, rOnlyState, err := service.HeadRootAndStateReadOnly(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, newRoot, root)
+	assert.Equal(t, rOnlyState, service.head.state, "Head is not the same object")
 }
 
 func TestSaveOrphanedAtts(t *testing.T) {
@@ -310,7 +317,7 @@ func TestSaveOrphanedAtts(t *testing.T) {
 		util.SaveBlock(t, ctx, beaconDB, blk)
 	}
 
-	require.NoError(t, service.saveOrphanedOperations(ctx, r3, r4))
+	require.NoError(t, service.saveOrphanedOperations(ctx, r3, r4, st))
 	require.Equal(t, 3, service.cfg.AttPool.AggregatedAttestationCount())
 	wantAtts := []ethpb.Att{
 		blk3.Block.Body.Attestations[0],
@@ -387,7 +394,7 @@ func TestSaveOrphanedOps(t *testing.T) {
 		util.SaveBlock(t, ctx, beaconDB, blk)
 	}
 
-	require.NoError(t, service.saveOrphanedOperations(ctx, r3, r4))
+	require.NoError(t, service.saveOrphanedOperations(ctx, r3, r4, st))
 	require.Equal(t, 3, service.cfg.AttPool.AggregatedAttestationCount())
 	wantAtts := []ethpb.Att{
 		blk3.Block.Body.Attestations[0],
@@ -406,6 +413,68 @@ func TestSaveOrphanedOps(t *testing.T) {
 	require.Equal(t, 1, len(exits))
 }
 
+func TestSaveOrphanedOps_SkipsExitInvalidOnNewHead(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig()
+	config.ShardCommitteePeriod = 0
+	params.OverrideBeaconConfig(config)
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+	service.genesisTime = time.Now().Add(time.Duration(-10*int64(1)*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second)
+
+	// Chain setup
+	// 0 -- 1
+	//  \-2
+	st, keys := util.DeterministicGenesisState(t, 64)
+	blkG, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), 0)
+	assert.NoError(t, err)
+	util.SaveBlock(t, ctx, service.cfg.BeaconDB, blkG)
+	rG, err := blkG.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	blkConfig := util.DefaultBlockGenConfig()
+	blkConfig.NumVoluntaryExits = 1
+	blk1, err := util.GenerateFullBlock(st, keys, blkConfig, 1)
+	assert.NoError(t, err)
+	blk1.Block.ParentRoot = rG[:]
+	r1, err := blk1.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	blk2 := util.NewBeaconBlock()
+	blk2.Block.Slot = 2
+	blk2.Block.ParentRoot = rG[:]
+	r2, err := blk2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	ojc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	ofc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+
+	for _, blk := range []*ethpb.SignedBeaconBlock{blkG, blk1, blk2} {
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		state, blkRoot, err := prepareForkchoiceState(ctx, blk.Block.Slot, r, bytesutil.ToBytes32(blk.Block.ParentRoot), [32]byte{}, ojc, ofc)
+		require.NoError(t, err)
+		require.NoError(t, service.cfg.ForkChoiceStore.InsertNode(ctx, state, blkRoot))
+		util.SaveBlock(t, ctx, beaconDB, blk)
+	}
+
+	// The exiting validator has already initiated its exit on the new head.
+	require.Equal(t, 1, len(blk1.Block.Body.VoluntaryExits))
+	newHeadState := st.Copy()
+	idx := blk1.Block.Body.VoluntaryExits[0].Exit.ValidatorIndex
+	val, err := newHeadState.ValidatorAtIndex(idx)
+	require.NoError(t, err)
+	val.ExitEpoch = 10
+	require.NoError(t, newHeadState.UpdateValidatorAtIndex(idx, val))
+
+	require.NoError(t, service.saveOrphanedOperations(ctx, r1, r2, newHeadState))
+	require.Equal(t, 1, service.cfg.AttPool.AggregatedAttestationCount())
+	exits, err := service.cfg.ExitPool.PendingExits()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(exits))
+}
+
 func TestSaveOrphanedAtts_CanFilter(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
@@ -457,7 +526,7 @@ func TestSaveOrphanedAtts_CanFilter(t *testing.T) {
 		util.SaveBlock(t, ctx, beaconDB, blk)
 	}
 
-	require.NoError(t, service.saveOrphanedOperations(ctx, r2, r4))
+	require.NoError(t, service.saveOrphanedOperations(ctx, r2, r4, st))
 	require.Equal(t, 1, service.cfg.AttPool.AggregatedAttestationCount())
 	pending, err := service.cfg.BLSToExecPool.PendingBLSToExecChanges()
 	require.NoError(t, err)
@@ -515,7 +584,7 @@ func TestSaveOrphanedAtts_DoublyLinkedTrie(t *testing.T) {
 		util.SaveBlock(t, ctx, beaconDB, blk)
 	}
 
-	require.NoError(t, service.saveOrphanedOperations(ctx, r3, r4))
+	require.NoError(t, service.saveOrphanedOperations(ctx, r3, r4, st))
 	require.Equal(t, 3, service.cfg.AttPool.AggregatedAttestationCount())
 	wantAtts := []ethpb.Att{
 		blk3.Block.Body.Attestations[0],
@@ -574,7 +643,7 @@ func TestSaveOrphanedAtts_CanFilter_DoublyLinkedTrie(t *testing.T) {
 		util.SaveBlock(t, ctx, beaconDB, blk)
 	}
 
-	require.NoError(t, service.saveOrphanedOperations(ctx, r2, r4))
+	require.NoError(t, service.saveOrphanedOperations(ctx, r2, r4, st))
 	require.Equal(t, 0, service.cfg.AttPool.AggregatedAttestationCount())
 }
 
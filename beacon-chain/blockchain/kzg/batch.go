@@ -0,0 +1,152 @@
+package kzg
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+)
+
+const (
+	// batchWindow is how long the first sidecar of a batch waits for other ones to join it.
+	batchWindow = 5 * time.Millisecond
+	// batchLimit is the number of sidecars after which a batch is verified without waiting further.
+	batchLimit = 32
+	// minChunkSize is the smallest number of sidecars verified together by a worker,
+	// below which spreading a batch over more workers is not worth it.
+	minChunkSize = 2
+)
+
+var (
+	kzgBatchSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "kzg_verification_batch_size",
+		Help:    "The number of sidecars whose KZG proofs are verified in a single batch.",
+		Buckets: []float64{1, 2, 4, 6, 8, 16, 32},
+	})
+	kzgBatchSplits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "kzg_verification_batch_splits_total",
+		Help: "Count the number of KZG verification batches split in two after failing to verify.",
+	})
+)
+
+type sidecarVerification struct {
+	sidecar blocks.ROBlob
+	resChan chan error
+}
+
+// batchVerifier collects the sidecars submitted within a short window and verifies their KZG proofs
+// together on a pool of workers.
+type batchVerifier struct {
+	once    sync.Once
+	verify  func(...blocks.ROBlob) error
+	workers int
+	window  time.Duration
+	limit   int
+	pending chan *sidecarVerification
+}
+
+var gossipVerifier = newBatchVerifier(Verify, runtime.GOMAXPROCS(0))
+
+func newBatchVerifier(verify func(...blocks.ROBlob) error, workers int) *batchVerifier {
+	return &batchVerifier{
+		verify:  verify,
+		workers: max(1, workers),
+		window:  batchWindow,
+		limit:   batchLimit,
+		pending: make(chan *sidecarVerification, batchLimit),
+	}
+}
+
+// VerifyBatched verifies the KZG proofs of the given sidecars. Sidecars submitted at about the same
+// time, such as the ones of a block with many blobs arriving over gossip, are verified as a single
+// batch, which is much cheaper than verifying them one by one. If a batch fails, it is split until
+// the invalid sidecars are isolated, so that the result of a call only depends on its own sidecars.
+func VerifyBatched(sidecars ...blocks.ROBlob) error {
+	return gossipVerifier.verifySidecars(sidecars)
+}
+
+func (b *batchVerifier) verifySidecars(sidecars []blocks.ROBlob) error {
+	if len(sidecars) == 0 {
+		return nil
+	}
+	b.once.Do(b.start)
+	// The result channel is buffered so that the workers never block on the results.
+	resChan := make(chan error, len(sidecars))
+	for _, sc := range sidecars {
+		b.pending <- &sidecarVerification{sidecar: sc, resChan: resChan}
+	}
+	var err error
+	for range sidecars {
+		if verr := <-resChan; verr != nil && err == nil {
+			err = verr
+		}
+	}
+	return err
+}
+
+func (b *batchVerifier) start() {
+	chunks := make(chan []*sidecarVerification, b.workers)
+	for i := 0; i < b.workers; i++ {
+		go b.worker(chunks)
+	}
+	go b.collect(chunks)
+}
+
+// collect gathers the pending sidecars into batches, and spreads every batch over the workers.
+func (b *batchVerifier) collect(chunks chan<- []*sidecarVerification) {
+	for {
+		batch := []*sidecarVerification{<-b.pending}
+		timer := time.NewTimer(b.window)
+	fill:
+		for len(batch) < b.limit {
+			select {
+			case v := <-b.pending:
+				batch = append(batch, v)
+			case <-timer.C:
+				break fill
+			}
+		}
+		timer.Stop()
+
+		kzgBatchSize.Observe(float64(len(batch)))
+		chunkSize := max(minChunkSize, (len(batch)+b.workers-1)/b.workers)
+		for len(batch) > 0 {
+			n := min(chunkSize, len(batch))
+			chunks <- batch[:n]
+			batch = batch[n:]
+		}
+	}
+}
+
+func (b *batchVerifier) worker(chunks <-chan []*sidecarVerification) {
+	for chunk := range chunks {
+		b.verifyChunk(chunk)
+	}
+}
+
+// verifyChunk verifies the sidecars of the chunk together. If that fails, the chunk is split in
+// two halves which are verified separately, until the invalid sidecars are isolated.
+func (b *batchVerifier) verifyChunk(chunk []*sidecarVerification) {
+	if len(chunk) == 0 {
+		return
+	}
+	sidecars := make([]blocks.ROBlob, len(chunk))
+	for i, v := range chunk {
+		sidecars[i] = v.sidecar
+	}
+	err := b.verify(sidecars...)
+	if err == nil || len(chunk) == 1 {
+		for _, v := range chunk {
+			v.resChan <- err
+		}
+		return
+	}
+
+	kzgBatchSplits.Inc()
+	mid := len(chunk) / 2
+	b.verifyChunk(chunk[:mid])
+	b.verifyChunk(chunk[mid:])
+}
@@ -0,0 +1,87 @@
+package kzg
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestBatchVerifier_IsolatesInvalidSidecars(t *testing.T) {
+	errInvalid := errors.New("invalid proof")
+	var (
+		lock    sync.Mutex
+		batches [][]uint64
+	)
+	verify := func(sidecars ...blocks.ROBlob) error {
+		indices := make([]uint64, len(sidecars))
+		for i, sc := range sidecars {
+			indices[i] = sc.Index
+		}
+		lock.Lock()
+		batches = append(batches, indices)
+		lock.Unlock()
+		for _, sc := range sidecars {
+			if sc.Index == 3 {
+				return errInvalid
+			}
+		}
+		return nil
+	}
+
+	sidecars := make([]blocks.ROBlob, 6)
+	for i := range sidecars {
+		sc, err := blocks.NewROBlobWithRoot(&ethpb.BlobSidecar{Index: uint64(i)}, [32]byte{})
+		require.NoError(t, err)
+		sidecars[i] = sc
+	}
+
+	// The window never ends before the batch is full, so that the sidecars submitted concurrently are
+	// always verified as a single batch, spread over the two workers.
+	b := newBatchVerifier(verify, 2)
+	b.window = time.Hour
+	b.limit = len(sidecars)
+	errs := make([]error, len(sidecars))
+	var wg sync.WaitGroup
+	for i := range sidecars {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = b.verifySidecars(sidecars[i : i+1])
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if i == 3 {
+			require.ErrorIs(t, err, errInvalid)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	// The batch is verified in two chunks of three sidecars, and the chunk of the invalid sidecar is
+	// split until the invalid sidecar is verified alone.
+	full, isolated := 0, false
+	for _, batch := range batches {
+		if len(batch) == 3 {
+			full++
+		}
+		if len(batch) == 1 && batch[0] == 3 {
+			isolated = true
+		}
+	}
+	require.Equal(t, 2, full)
+	require.Equal(t, true, isolated)
+
+	require.ErrorIs(t, b.verifySidecars(sidecars), errInvalid)
+	require.NoError(t, b.verifySidecars(nil))
+
+	// A batch is verified once the window ends even if it is not full.
+	b = newBatchVerifier(verify, 2)
+	b.window = 0
+	require.NoError(t, b.verifySidecars(sidecars[:3]))
+	require.ErrorIs(t, b.verifySidecars(sidecars[2:4]), errInvalid)
+}
@@ -2,6 +2,7 @@ package blockchain
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -107,6 +108,10 @@ var (
 		Name: "beacon_prev_epoch_head_gwei",
 		Help: "The total amount of ether, in gwei, that has been used in voting attestation head of previous epoch",
 	})
+	prevEpochParticipationRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "beacon_prev_epoch_participation_rate",
+		Help: "The share of the active balance of previous epoch which voted for the correct source, target and head",
+	}, []string{"vote"})
 	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "beacon_reorgs_total",
 		Help: "Count the number of times beacon chain has a reorg",
@@ -127,6 +132,10 @@ var (
 		Name: "saved_orphaned_att_total",
 		Help: "Count the number of times an orphaned attestation is saved",
 	})
+	recoveredOperationsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "reorg_recovered_operations_total",
+		Help: "Count the number of operations from orphaned blocks re-inserted into the operation pools, by type",
+	}, []string{"type"})
 	attestationInclusionDelay = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "attestation_inclusion_delay_slots",
@@ -186,6 +195,29 @@ var (
 		Name: "da_waited_time_milliseconds",
 		Help: "Total time spent waiting for a data availability check in ReceiveBlock()",
 	})
+	daCheckSidecarsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "da_check_sidecars_total",
+		Help: "Count the number of sidecars needed by the data availability checks of blocks, by the way they were obtained",
+	}, []string{"source"})
+	daCheckSidecarArrivalTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "da_check_sidecar_arrival_seconds",
+			Help:    "Captures the time between the start of the slot and the arrival of the sidecars needed by data availability checks, by the way they were obtained",
+			Buckets: []float64{0.5, 1, 2, 3, 4, 6, 8, 12, 24},
+		},
+		[]string{"source"},
+	)
+	daCheckCompletionTime = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "da_check_completion_seconds",
+			Help:    "Captures the time between the start of the slot and the availability of all the sidecars of its block",
+			Buckets: []float64{0.5, 1, 2, 3, 4, 6, 8, 12, 24},
+		},
+	)
+	daCheckCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "da_checks_total",
+		Help: "Count the number of data availability checks of blocks with blobs, by outcome: before_deadline and after_deadline relative to the attestation deadline, or failed",
+	}, []string{"outcome"})
 	processAttsElapsedTime = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "process_attestations_milliseconds",
@@ -221,8 +253,58 @@ var (
 			Buckets: []float64{1, 2, 4, 8, 16, 32},
 		},
 	)
+	reorgsPerEpochHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "reorgs_per_epoch",
+			Help:    "Captures the number of reorgs in each epoch, observed once the head has moved to a later epoch",
+			Buckets: []float64{0, 1, 2, 4, 8, 16},
+		},
+	)
+	epochsSinceFinality = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "epochs_since_finality",
+		Help: "The number of epochs between the current epoch and the finalized checkpoint",
+	})
+	finalityStallSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "finality_stall_seconds",
+		Help: "The time elapsed since the chain should have finalized the epoch after the finalized checkpoint",
+	})
+	safeModeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "safe_mode",
+		Help: "1 while the node is in safe mode because finality has stalled, 0 otherwise",
+	})
+	optimisticHeadSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "optimistic_head_seconds",
+		Help: "The time the head of the node has been optimistic, 0 when the head is valid",
+	})
 )
 
+// reorgEpochCounter counts the reorgs of the epoch the head is in, and reports the count to reorgsPerEpochHistogram
+// when the head moves to a later epoch.
+type reorgEpochCounter struct {
+	sync.Mutex
+	started bool
+	epoch   primitives.Epoch
+	count   uint64
+}
+
+// record accounts for a head update in the given epoch.
+func (c *reorgEpochCounter) record(epoch primitives.Epoch, reorg bool) {
+	c.Lock()
+	defer c.Unlock()
+	if !c.started {
+		c.started = true
+		c.epoch = epoch
+	}
+	if epoch > c.epoch {
+		reorgsPerEpochHistogram.Observe(float64(c.count))
+		c.epoch = epoch
+		c.count = 0
+	}
+	if reorg {
+		c.count++
+	}
+}
+
 // reportSlotMetrics reports slot related metrics.
 func reportSlotMetrics(stateSlot, headSlot, clockSlot primitives.Slot, finalizedCheckpoint *ethpb.Checkpoint) {
 	clockTimeSlot.Set(float64(clockSlot))
@@ -234,8 +316,9 @@ func reportSlotMetrics(stateSlot, headSlot, clockSlot primitives.Slot, finalized
 	}
 }
 
-// reportEpochMetrics reports epoch related metrics.
-func reportEpochMetrics(ctx context.Context, postState, headState state.BeaconState) error {
+// reportEpochMetrics reports epoch related metrics, and returns the balances which attested in the previous epoch
+// of the head state.
+func reportEpochMetrics(ctx context.Context, postState, headState state.BeaconState) (*precompute.Balance, error) {
 	currentEpoch := primitives.Epoch(postState.Slot() / params.BeaconConfig().SlotsPerEpoch)
 
 	// Validator instances
@@ -334,29 +417,35 @@ func reportEpochMetrics(ctx context.Context, postState, headState state.BeaconSt
 	if headState.Version() == version.Phase0 {
 		v, b, err = precompute.New(ctx, headState)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		_, b, err = precompute.ProcessAttestations(ctx, headState, v, b)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else if headState.Version() >= version.Altair {
 		v, b, err = altair.InitializePrecomputeValidators(ctx, headState)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		_, b, err = altair.ProcessEpochParticipation(ctx, headState, b, v)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
-		return errors.Errorf("invalid state type provided: %T", headState.ToProtoUnsafe())
+		return nil, errors.Errorf("invalid state type provided: %T", headState.ToProtoUnsafe())
 	}
 
 	prevEpochActiveBalances.Set(float64(b.ActivePrevEpoch))
 	prevEpochSourceBalances.Set(float64(b.PrevEpochAttested))
 	prevEpochTargetBalances.Set(float64(b.PrevEpochTargetAttested))
 	prevEpochHeadBalances.Set(float64(b.PrevEpochHeadAttested))
+	if b.ActivePrevEpoch > 0 {
+		active := float64(b.ActivePrevEpoch)
+		prevEpochParticipationRate.WithLabelValues("source").Set(float64(b.PrevEpochAttested) / active)
+		prevEpochParticipationRate.WithLabelValues("target").Set(float64(b.PrevEpochTargetAttested) / active)
+		prevEpochParticipationRate.WithLabelValues("head").Set(float64(b.PrevEpochHeadAttested) / active)
+	}
 
 	refMap := postState.FieldReferencesCount()
 	for name, val := range refMap {
@@ -364,7 +453,7 @@ func reportEpochMetrics(ctx context.Context, postState, headState state.BeaconSt
 	}
 	postState.RecordStateMetrics()
 
-	return nil
+	return b, nil
 }
 
 func reportAttestationInclusion(blk interfaces.ReadOnlyBeaconBlock) {
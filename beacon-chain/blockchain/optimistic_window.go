@@ -0,0 +1,120 @@
+package blockchain
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/sirupsen/logrus"
+)
+
+// OptimisticStatusFetcher reports how long the head of the node has been optimistic, and on which payload.
+type OptimisticStatusFetcher interface {
+	OptimisticStatus() *OptimisticStatus
+}
+
+// OptimisticStatus describes how long the head of the node has been optimistic.
+type OptimisticStatus struct {
+	Optimistic bool
+	// Since is the time at which the head became optimistic, zero when the head is not optimistic.
+	Since    time.Time
+	Duration time.Duration
+	HeadRoot [32]byte
+	HeadSlot primitives.Slot
+	// PayloadBlockHash and PayloadBlockNumber identify the execution payload of the head, they are empty before
+	// Bellatrix.
+	PayloadBlockHash   []byte
+	PayloadBlockNumber uint64
+	// MaxWindow is the configured maximum optimistic window, zero when unbounded. WindowExceeded is true once the
+	// head has been optimistic for longer than it.
+	MaxWindow      time.Duration
+	WindowExceeded bool
+}
+
+// optimisticWindow tracks since when the head of the node has been optimistic.
+type optimisticWindow struct {
+	sync.RWMutex
+	since    time.Time
+	exceeded bool
+}
+
+// update records whether the new head is optimistic, starting the optimistic window when the head becomes
+// optimistic and closing it once the head is valid again.
+func (w *optimisticWindow) update(optimistic bool) {
+	w.Lock()
+	defer w.Unlock()
+	if !optimistic {
+		if !w.since.IsZero() {
+			log.WithField("duration", time.Since(w.since)).Info("Head is no longer optimistic")
+		}
+		w.since = time.Time{}
+		w.exceeded = false
+		return
+	}
+	if w.since.IsZero() {
+		w.since = time.Now()
+	}
+}
+
+// OptimisticStatus returns how long the head of the node has been optimistic, and on which payload.
+func (s *Service) OptimisticStatus() *OptimisticStatus {
+	st := &OptimisticStatus{MaxWindow: s.cfg.MaxOptimisticWindow}
+	s.headLock.RLock()
+	if s.head != nil {
+		st.Optimistic = s.head.optimistic
+		st.HeadRoot = s.head.root
+		st.HeadSlot = s.headSlot()
+		st.PayloadBlockHash, st.PayloadBlockNumber = headPayload(s.head.block)
+	}
+	s.headLock.RUnlock()
+	s.optimisticWindow.RLock()
+	since := s.optimisticWindow.since
+	s.optimisticWindow.RUnlock()
+	if st.Optimistic && !since.IsZero() {
+		st.Since = since
+		st.Duration = time.Since(since)
+		st.WindowExceeded = st.MaxWindow > 0 && st.Duration > st.MaxWindow
+	}
+	return st
+}
+
+// checkOptimisticWindow reports how long the head has been optimistic, and logs an error once per optimistic
+// episode when the head has been optimistic for longer than `MaxOptimisticWindow`.
+func (s *Service) checkOptimisticWindow() {
+	st := s.OptimisticStatus()
+	optimisticHeadSeconds.Set(st.Duration.Seconds())
+	if !st.WindowExceeded {
+		return
+	}
+	s.optimisticWindow.Lock()
+	defer s.optimisticWindow.Unlock()
+	if s.optimisticWindow.exceeded {
+		return
+	}
+	s.optimisticWindow.exceeded = true
+	log.WithFields(logrus.Fields{
+		"headSlot":           st.HeadSlot,
+		"headRoot":           fmt.Sprintf("%#x", bytesutil.Trunc(st.HeadRoot[:])),
+		"payloadBlockHash":   fmt.Sprintf("%#x", bytesutil.Trunc(st.PayloadBlockHash)),
+		"payloadBlockNumber": st.PayloadBlockNumber,
+		"duration":           st.Duration,
+		"maxWindow":          st.MaxWindow,
+	}).Error("Head has been optimistic for longer than the maximum optimistic window, check the execution client")
+}
+
+// headPayload returns the block hash and number of the execution payload of the given block, or empty values if
+// the block has no execution payload.
+func headPayload(b interfaces.ReadOnlySignedBeaconBlock) ([]byte, uint64) {
+	if b == nil || b.IsNil() || b.Version() < version.Bellatrix {
+		return nil, 0
+	}
+	payload, err := b.Block().Body().Execution()
+	if err != nil || payload == nil || payload.IsNil() {
+		return nil, 0
+	}
+	return bytesutil.SafeCopyBytes(payload.BlockHash()), payload.BlockNumber()
+}
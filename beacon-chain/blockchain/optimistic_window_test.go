@@ -0,0 +1,54 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestOptimisticStatus(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s, _ := minimalTestService(t, WithMaxOptimisticWindow(time.Minute))
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 10
+	hash := [32]byte{'a'}
+	b.Block.Body.ExecutionPayload.BlockHash = hash[:]
+	b.Block.Body.ExecutionPayload.BlockNumber = 100
+	wb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	require.NoError(t, s.setHead(&head{root: [32]byte{'r'}, block: wb, state: st, slot: 10, optimistic: true}))
+	status := s.OptimisticStatus()
+	assert.Equal(t, true, status.Optimistic)
+	assert.Equal(t, false, status.Since.IsZero())
+	assert.Equal(t, [32]byte{'r'}, status.HeadRoot)
+	assert.Equal(t, uint64(10), uint64(status.HeadSlot))
+	assert.DeepEqual(t, hash[:], status.PayloadBlockHash)
+	assert.Equal(t, uint64(100), status.PayloadBlockNumber)
+	assert.Equal(t, false, status.WindowExceeded)
+	s.checkOptimisticWindow()
+	assert.LogsDoNotContain(t, hook, "longer than the maximum optimistic window")
+
+	// A new optimistic head does not restart the window.
+	s.optimisticWindow.since = time.Now().Add(-time.Hour)
+	require.NoError(t, s.setHead(&head{root: [32]byte{'s'}, block: wb, state: st, slot: 11, optimistic: true}))
+	status = s.OptimisticStatus()
+	assert.Equal(t, true, status.Duration > time.Minute)
+	assert.Equal(t, true, status.WindowExceeded)
+	s.checkOptimisticWindow()
+	assert.LogsContain(t, hook, "longer than the maximum optimistic window")
+
+	require.NoError(t, s.setHead(&head{root: [32]byte{'t'}, block: wb, state: st, slot: 12}))
+	status = s.OptimisticStatus()
+	assert.Equal(t, false, status.Optimistic)
+	assert.Equal(t, true, status.Since.IsZero())
+	assert.Equal(t, time.Duration(0), status.Duration)
+	assert.Equal(t, false, status.WindowExceeded)
+	assert.LogsContain(t, hook, "Head is no longer optimistic")
+}
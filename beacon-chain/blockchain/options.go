@@ -1,6 +1,8 @@
 package blockchain
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -16,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
@@ -85,6 +88,15 @@ func WithTrackedValidatorsCache(c *cache.TrackedValidatorsCache) Option {
 	}
 }
 
+// WithBadBlockCache sets the cache of blocks deemed invalid, where the blocks fork choice finds to have an invalid
+// execution payload are marked.
+func WithBadBlockCache(c *cache.BadBlockCache) Option {
+	return func(s *Service) error {
+		s.cfg.BadBlockCache = c
+		return nil
+	}
+}
+
 // WithAttestationPool for attestation lifecycle after chain inclusion.
 func WithAttestationPool(p attestations.Pool) Option {
 	return func(s *Service) error {
@@ -199,6 +211,24 @@ func WithBlobStorage(b *filesystem.BlobStorage) Option {
 	}
 }
 
+// WithSafeModeStallEpochs sets the number of epochs without finality after which the node enters safe mode, zero
+// disables safe mode.
+func WithSafeModeStallEpochs(epochs primitives.Epoch) Option {
+	return func(s *Service) error {
+		s.cfg.SafeModeStallEpochs = epochs
+		return nil
+	}
+}
+
+// WithMaxOptimisticWindow sets the duration the head may stay optimistic before an error is reported, zero disables
+// the check.
+func WithMaxOptimisticWindow(d time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.MaxOptimisticWindow = d
+		return nil
+	}
+}
+
 func WithSyncChecker(checker Checker) Option {
 	return func(s *Service) error {
 		s.cfg.SyncChecker = checker
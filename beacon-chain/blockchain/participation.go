@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// participationHistoryEpochs is the number of epochs the participation is kept for.
+const participationHistoryEpochs = 4096
+
+// ParticipationFetcher returns the attestation participation of the past epochs of the canonical chain.
+type ParticipationFetcher interface {
+	ParticipationHistory(start, end primitives.Epoch) []*EpochParticipation
+}
+
+// EpochParticipation is the balance of the validators which were active in an epoch, and of those which attested to
+// the correct source, target and head in the epoch.
+type EpochParticipation struct {
+	Epoch      primitives.Epoch
+	ActiveGwei uint64
+	SourceGwei uint64
+	TargetGwei uint64
+	HeadGwei   uint64
+}
+
+// SourceRate returns the share of the active balance which attested to the correct source.
+func (p *EpochParticipation) SourceRate() float64 {
+	return p.rate(p.SourceGwei)
+}
+
+// TargetRate returns the share of the active balance which attested to the correct target.
+func (p *EpochParticipation) TargetRate() float64 {
+	return p.rate(p.TargetGwei)
+}
+
+// HeadRate returns the share of the active balance which attested to the correct head.
+func (p *EpochParticipation) HeadRate() float64 {
+	return p.rate(p.HeadGwei)
+}
+
+func (p *EpochParticipation) rate(gwei uint64) float64 {
+	if p.ActiveGwei == 0 {
+		return 0
+	}
+	return float64(gwei) / float64(p.ActiveGwei)
+}
+
+// participationHistory keeps the participation of the last epochs, in increasing order of epochs.
+type participationHistory struct {
+	sync.RWMutex
+	epochs []*EpochParticipation
+}
+
+// record the participation of the previous epoch computed from the balances of the head state. The participation
+// recorded before for the epoch, or for later epochs of a previous head, is replaced.
+func (h *participationHistory) record(epoch primitives.Epoch, b *precompute.Balance) {
+	h.Lock()
+	defer h.Unlock()
+	i := sort.Search(len(h.epochs), func(i int) bool { return h.epochs[i].Epoch >= epoch })
+	h.epochs = append(h.epochs[:i], &EpochParticipation{
+		Epoch:      epoch,
+		ActiveGwei: b.ActivePrevEpoch,
+		SourceGwei: b.PrevEpochAttested,
+		TargetGwei: b.PrevEpochTargetAttested,
+		HeadGwei:   b.PrevEpochHeadAttested,
+	})
+	if len(h.epochs) > participationHistoryEpochs {
+		h.epochs = h.epochs[len(h.epochs)-participationHistoryEpochs:]
+	}
+}
+
+// ParticipationHistory returns the attestation participation of the recorded epochs from start to end, both
+// included. The participation of an epoch is recorded once the head reaches the next epoch.
+func (s *Service) ParticipationHistory(start, end primitives.Epoch) []*EpochParticipation {
+	h := &s.participationHistory
+	h.RLock()
+	defer h.RUnlock()
+	i := sort.Search(len(h.epochs), func(i int) bool { return h.epochs[i].Epoch >= start })
+	participation := make([]*EpochParticipation, 0)
+	for ; i < len(h.epochs) && h.epochs[i].Epoch <= end; i++ {
+		p := *h.epochs[i]
+		participation = append(participation, &p)
+	}
+	return participation
+}
@@ -0,0 +1,46 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestParticipationHistory(t *testing.T) {
+	s := &Service{}
+	for epoch := primitives.Epoch(1); epoch <= 5; epoch++ {
+		s.participationHistory.record(epoch, &precompute.Balance{
+			ActivePrevEpoch:         100,
+			PrevEpochAttested:       90,
+			PrevEpochTargetAttested: 80,
+			PrevEpochHeadAttested:   uint64(epoch),
+		})
+	}
+
+	history := s.ParticipationHistory(2, 3)
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, primitives.Epoch(2), history[0].Epoch)
+	assert.Equal(t, 0.9, history[0].SourceRate())
+	assert.Equal(t, 0.8, history[0].TargetRate())
+	assert.Equal(t, 0.02, history[0].HeadRate())
+	assert.Equal(t, primitives.Epoch(3), history[1].Epoch)
+
+	// A new head replaces the participation of the epoch and of the later epochs.
+	s.participationHistory.record(4, &precompute.Balance{ActivePrevEpoch: 100, PrevEpochHeadAttested: 50})
+	history = s.ParticipationHistory(0, 10)
+	require.Equal(t, 4, len(history))
+	assert.Equal(t, primitives.Epoch(4), history[3].Epoch)
+	assert.Equal(t, 0.5, history[3].HeadRate())
+
+	// Only the last epochs are kept.
+	for epoch := primitives.Epoch(5); epoch < participationHistoryEpochs+10; epoch++ {
+		s.participationHistory.record(epoch, &precompute.Balance{})
+	}
+	history = s.ParticipationHistory(0, participationHistoryEpochs+10)
+	require.Equal(t, participationHistoryEpochs, len(history))
+	assert.Equal(t, primitives.Epoch(10), history[0].Epoch)
+	assert.Equal(t, 0., history[0].TargetRate())
+}
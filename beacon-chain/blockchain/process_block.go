@@ -7,6 +7,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
@@ -310,6 +312,9 @@ func (s *Service) updateEpochBoundaryCaches(ctx context.Context, st state.Beacon
 	if err := helpers.UpdateProposerIndicesInCache(ctx, st, e); err != nil {
 		return errors.Wrap(err, "could not update proposer index cache")
 	}
+	if err := s.notifyProposerLookahead(ctx, st); err != nil {
+		log.WithError(err).Error("Could not notify proposer lookahead")
+	}
 	go func(ep primitives.Epoch) {
 		// Use a custom deadline here, since this method runs asynchronously.
 		// We ignore the parent method's context and instead create a new one
@@ -558,6 +563,8 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 	}
 	// If there are no missing indices, all BlobSidecars are available.
 	if len(missing) == 0 {
+		s.reportDataAvailability(root, block.Slot(), expected)
+		s.blobNotifiers.delete(root)
 		return nil
 	}
 
@@ -588,14 +595,54 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 				continue
 			}
 			// Once all sidecars have been observed, clean up the notification channel.
+			s.reportDataAvailability(root, block.Slot(), expected)
 			s.blobNotifiers.delete(root)
 			return nil
 		case <-ctx.Done():
+			daCheckCount.WithLabelValues("failed").Inc()
 			return errors.Wrapf(ctx.Err(), "context deadline waiting for blob sidecars slot: %d, BlockRoot: %#x", block.Slot(), root)
 		}
 	}
 }
 
+// reportDataAvailability reports how the blob sidecars of the block became available, in metrics and as a
+// DataAvailabilityChecked event. Sidecars which were not received since the node started have an unknown source.
+func (s *Service) reportDataAvailability(root [32]byte, slot primitives.Slot, expected int) {
+	completed := time.Now()
+	slotStart := slots.BeginsAt(slot, s.genesisTime)
+	cfg := params.BeaconConfig()
+	deadline := slotStart.Add(time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second)
+	arrivals := s.blobNotifiers.arrivalsForRoot(root)
+	sidecars := make([]statefeed.DataAvailabilitySidecar, expected)
+	for i := range sidecars {
+		// expected is bounded by the size of arrivals by missingIndices.
+		a := arrivals[i]
+		source := a.source.String()
+		sidecars[i] = statefeed.DataAvailabilitySidecar{Index: uint64(i), Source: source, Arrival: a.at}
+		daCheckSidecarsCount.WithLabelValues(source).Inc()
+		if !a.at.IsZero() {
+			daCheckSidecarArrivalTime.WithLabelValues(source).Observe(a.at.Sub(slotStart).Seconds())
+		}
+	}
+	beforeDeadline := completed.Before(deadline)
+	daCheckCompletionTime.Observe(completed.Sub(slotStart).Seconds())
+	if beforeDeadline {
+		daCheckCount.WithLabelValues("before_deadline").Inc()
+	} else {
+		daCheckCount.WithLabelValues("after_deadline").Inc()
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.DataAvailabilityChecked,
+		Data: &statefeed.DataAvailabilityCheckedData{
+			Slot:                      slot,
+			BlockRoot:                 root,
+			Sidecars:                  sidecars,
+			Completed:                 completed,
+			BeforeAttestationDeadline: beforeDeadline,
+		},
+	})
+}
+
 func daCheckLogFields(root [32]byte, slot primitives.Slot, expected, missing int) logrus.Fields {
 	return logrus.Fields{
 		"slot":          slot,
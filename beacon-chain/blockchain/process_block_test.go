@@ -14,6 +14,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
@@ -2790,3 +2792,48 @@ func TestSaveLightClientBootstrap(t *testing.T) {
 		reset()
 	})
 }
+
+func TestReportDataAvailability(t *testing.T) {
+	s, _ := minimalTestService(t)
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	// Slot 10 starts now.
+	s.genesisTime = time.Now().Add(-10 * secondsPerSlot)
+	events := make(chan *feed.Event, 1)
+	sub := s.cfg.StateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	t.Run("before attestation deadline", func(t *testing.T) {
+		root := [32]byte{'a'}
+		s.blobNotifiers.notifyIndex(root, 0, das.SourceGossip)
+		s.blobNotifiers.notifyIndex(root, 1, das.SourceReqResp)
+		s.reportDataAvailability(root, 10, 3)
+
+		e := <-events
+		require.Equal(t, feed.EventType(statefeed.DataAvailabilityChecked), e.Type)
+		data, ok := e.Data.(*statefeed.DataAvailabilityCheckedData)
+		require.Equal(t, true, ok)
+		assert.Equal(t, primitives.Slot(10), data.Slot)
+		assert.Equal(t, root, data.BlockRoot)
+		assert.Equal(t, true, data.BeforeAttestationDeadline)
+		require.Equal(t, 3, len(data.Sidecars))
+		assert.Equal(t, "gossip", data.Sidecars[0].Source)
+		assert.Equal(t, false, data.Sidecars[0].Arrival.IsZero())
+		assert.Equal(t, "req_resp", data.Sidecars[1].Source)
+		// The last sidecar was not received since the node started.
+		assert.Equal(t, uint64(2), data.Sidecars[2].Index)
+		assert.Equal(t, "unknown", data.Sidecars[2].Source)
+		assert.Equal(t, true, data.Sidecars[2].Arrival.IsZero())
+	})
+	t.Run("after attestation deadline", func(t *testing.T) {
+		root := [32]byte{'b'}
+		s.blobNotifiers.notifyIndex(root, 0, das.SourceExecution)
+		s.reportDataAvailability(root, 9, 1)
+
+		e := <-events
+		data, ok := e.Data.(*statefeed.DataAvailabilityCheckedData)
+		require.Equal(t, true, ok)
+		assert.Equal(t, false, data.BeforeAttestationDeadline)
+		require.Equal(t, 1, len(data.Sidecars))
+		assert.Equal(t, "execution", data.Sidecars[0].Source)
+	})
+}
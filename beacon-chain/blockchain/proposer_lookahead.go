@@ -0,0 +1,65 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// proposerLookahead remembers the last proposer schedule sent in a ProposerLookahead event, so that the schedule of
+// an epoch is only sent again when its dependent root changes.
+type proposerLookahead struct {
+	sync.Mutex
+	epoch         primitives.Epoch
+	dependentRoot [32]byte
+}
+
+// notifyProposerLookahead sends a ProposerLookahead event with the proposer schedule of the epoch of the given state,
+// which is the state advanced to the start of the next epoch at the epoch boundary.
+func (s *Service) notifyProposerLookahead(ctx context.Context, st state.ReadOnlyBeaconState) error {
+	e := slots.ToEpoch(st.Slot())
+	if e == 0 {
+		return nil
+	}
+	start, err := slots.EpochStart(e)
+	if err != nil {
+		return err
+	}
+	r, err := helpers.BlockRootAtSlot(st, start-1)
+	if err != nil {
+		return errors.Wrap(err, "could not get dependent root")
+	}
+	dependentRoot := [32]byte(r)
+
+	s.proposerLookahead.Lock()
+	defer s.proposerLookahead.Unlock()
+	if s.proposerLookahead.epoch == e && s.proposerLookahead.dependentRoot == dependentRoot {
+		return nil
+	}
+	indices, err := helpers.ActiveValidatorIndices(ctx, st, e)
+	if err != nil {
+		return errors.Wrap(err, "could not get active validator indices")
+	}
+	proposers, err := helpers.PrecomputeProposerIndices(st, indices, e)
+	if err != nil {
+		return errors.Wrap(err, "could not compute proposer indices")
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.ProposerLookahead,
+		Data: &statefeed.ProposerLookaheadData{
+			Epoch:         e,
+			DependentRoot: dependentRoot,
+			Proposers:     proposers,
+		},
+	})
+	s.proposerLookahead.epoch = e
+	s.proposerLookahead.dependentRoot = dependentRoot
+	return nil
+}
@@ -0,0 +1,58 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestNotifyProposerLookahead(t *testing.T) {
+	ctx := context.Background()
+	s, _ := minimalTestService(t)
+	events := make(chan *feed.Event, 1)
+	sub := s.cfg.StateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	st, _ := util.DeterministicGenesisState(t, 64)
+	spe := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(2*spe))
+	dependentRoot := [32]byte{'a'}
+	require.NoError(t, st.UpdateBlockRootAtIndex(uint64(2*spe-1), dependentRoot))
+
+	require.NoError(t, s.notifyProposerLookahead(ctx, st))
+	e := <-events
+	require.Equal(t, feed.EventType(statefeed.ProposerLookahead), e.Type)
+	data, ok := e.Data.(*statefeed.ProposerLookaheadData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, primitives.Epoch(2), data.Epoch)
+	assert.Equal(t, dependentRoot, data.DependentRoot)
+	indices, err := helpers.ActiveValidatorIndices(ctx, st, 2)
+	require.NoError(t, err)
+	want, err := helpers.PrecomputeProposerIndices(st, indices, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, data.Proposers)
+
+	// The same schedule is not sent twice.
+	require.NoError(t, s.notifyProposerLookahead(ctx, st))
+	select {
+	case e := <-events:
+		t.Fatalf("Unexpected event %v", e.Type)
+	default:
+	}
+
+	// The schedule is sent again when the dependent root is reorged.
+	require.NoError(t, st.UpdateBlockRootAtIndex(uint64(2*spe-1), [32]byte{'b'}))
+	require.NoError(t, s.notifyProposerLookahead(ctx, st))
+	e = <-events
+	data, ok = e.Data.(*statefeed.ProposerLookaheadData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, [32]byte{'b'}, data.DependentRoot)
+}
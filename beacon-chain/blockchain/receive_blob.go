@@ -3,21 +3,23 @@ package blockchain
 import (
 	"context"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 )
 
 // SendNewBlobEvent sends a message to the BlobNotifier channel that the blob
 // for the block root `root` is ready in the database
-func (s *Service) sendNewBlobEvent(root [32]byte, index uint64) {
-	s.blobNotifiers.notifyIndex(root, index)
+func (s *Service) sendNewBlobEvent(root [32]byte, index uint64, source das.Source) {
+	s.blobNotifiers.notifyIndex(root, index, source)
 }
 
-// ReceiveBlob saves the blob to database and sends the new event
-func (s *Service) ReceiveBlob(ctx context.Context, b blocks.VerifiedROBlob) error {
+// ReceiveBlob saves the blob to database and sends the new event.
+// The source of the blob is recorded to report how the data of its block became available.
+func (s *Service) ReceiveBlob(ctx context.Context, b blocks.VerifiedROBlob, source das.Source) error {
 	if err := s.blobStorage.Save(b); err != nil {
 		return err
 	}
 
-	s.sendNewBlobEvent(b.BlockRoot(), b.Index)
+	s.sendNewBlobEvent(b.BlockRoot(), b.Index, source)
 	return nil
 }
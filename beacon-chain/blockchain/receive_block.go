@@ -48,7 +48,7 @@ type BlockReceiver interface {
 // BlobReceiver interface defines the methods of chain service for receiving new
 // blobs
 type BlobReceiver interface {
-	ReceiveBlob(context.Context, blocks.VerifiedROBlob) error
+	ReceiveBlob(context.Context, blocks.VerifiedROBlob, das.Source) error
 }
 
 // SlashingReceiver interface defines the methods of chain service for receiving validated slashing over the wire.
@@ -129,6 +129,14 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 		log.WithError(err).Error("Could not prune canonical objects from pool ")
 	}
 
+	// Has finality stalled for long enough to enter safe mode?
+	if err := s.checkFinalityStall(ctx); err != nil {
+		return err
+	}
+
+	// Has the head been optimistic for longer than allowed?
+	s.checkOptimisticWindow()
+
 	// Have we been finalizing? Should we start saving hot states to db?
 	if err := s.checkSaveHotStateDB(ctx); err != nil {
 		return err
@@ -165,8 +173,11 @@ func (s *Service) updateCheckpoints(
 		if err != nil {
 			return errors.Wrap(err, "could not get head state")
 		}
-		if err := reportEpochMetrics(ctx, postState, headSt); err != nil {
+		b, err := reportEpochMetrics(ctx, postState, headSt)
+		if err != nil {
 			log.WithError(err).Error("could not report epoch metrics")
+		} else {
+			s.participationHistory.record(coreTime.PrevEpoch(headSt), b)
 		}
 	}
 	if err := s.updateJustificationOnBlock(ctx, preState, postState, cp.j); err != nil {
@@ -427,7 +438,8 @@ func (s *Service) checkSaveHotStateDB(ctx context.Context) error {
 		sinceFinality = currentEpoch - finalized.Epoch
 	}
 
-	if sinceFinality >= epochsSinceFinalitySaveHotStateDB {
+	// Hot states are saved to the DB earlier in safe mode.
+	if sinceFinality >= epochsSinceFinalitySaveHotStateDB || s.InSafeMode() {
 		s.cfg.StateGen.EnableSaveHotStateToDB(ctx)
 		return nil
 	}
@@ -447,7 +459,8 @@ func (s *Service) handleCaches() error {
 		sinceFinality = currentEpoch - finalized.Epoch
 	}
 
-	if sinceFinality >= epochsSinceFinalityExpandCache {
+	// The committee cache is not expanded in safe mode, to cap its memory usage.
+	if sinceFinality >= epochsSinceFinalityExpandCache && !s.InSafeMode() {
 		helpers.ExpandCommitteeCache()
 		return nil
 	}
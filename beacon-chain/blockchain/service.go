@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
@@ -37,6 +38,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -65,6 +67,11 @@ type Service struct {
 	blobNotifiers        *blobNotifierMap
 	blockBeingSynced     *currentlySyncingBlock
 	blobStorage          *filesystem.BlobStorage
+	reorgsPerEpoch       reorgEpochCounter
+	proposerLookahead    proposerLookahead
+	safeMode             safeMode
+	optimisticWindow     optimisticWindow
+	participationHistory participationHistory
 }
 
 // config options for the service.
@@ -75,6 +82,7 @@ type config struct {
 	DepositCache            cache.DepositCache
 	PayloadIDCache          *cache.PayloadIDCache
 	TrackedValidatorsCache  *cache.TrackedValidatorsCache
+	BadBlockCache           *cache.BadBlockCache
 	AttPool                 attestations.Pool
 	ExitPool                voluntaryexits.PoolManager
 	SlashingPool            slashings.PoolManager
@@ -91,6 +99,8 @@ type config struct {
 	FinalizedStateAtStartUp state.BeaconState
 	ExecutionEngineCaller   execution.EngineCaller
 	SyncChecker             Checker
+	SafeModeStallEpochs     primitives.Epoch
+	MaxOptimisticWindow     time.Duration
 }
 
 // Checker is an interface used to determine if a node is in initial sync
@@ -105,11 +115,18 @@ type blobNotifierMap struct {
 	sync.RWMutex
 	notifiers map[[32]byte]chan uint64
 	seenIndex map[[32]byte][fieldparams.MaxBlobsPerBlock]bool
+	arrivals  map[[32]byte][fieldparams.MaxBlobsPerBlock]blobArrival
 }
 
-// notifyIndex notifies a blob by its index for a given root.
+// blobArrival records when and from where a blob was received.
+type blobArrival struct {
+	source das.Source
+	at     time.Time
+}
+
+// notifyIndex notifies a blob by its index for a given root, received from the given source.
 // It uses internal maps to keep track of seen indices and notifier channels.
-func (bn *blobNotifierMap) notifyIndex(root [32]byte, idx uint64) {
+func (bn *blobNotifierMap) notifyIndex(root [32]byte, idx uint64, source das.Source) {
 	if idx >= fieldparams.MaxBlobsPerBlock {
 		return
 	}
@@ -122,6 +139,9 @@ func (bn *blobNotifierMap) notifyIndex(root [32]byte, idx uint64) {
 	}
 	seen[idx] = true
 	bn.seenIndex[root] = seen
+	arrivals := bn.arrivals[root]
+	arrivals[idx] = blobArrival{source: source, at: time.Now()}
+	bn.arrivals[root] = arrivals
 
 	// Retrieve or create the notifier channel for the given root.
 	c, ok := bn.notifiers[root]
@@ -146,11 +166,19 @@ func (bn *blobNotifierMap) forRoot(root [32]byte) chan uint64 {
 	return c
 }
 
+// arrivalsForRoot returns the arrival of each blob notified for the given root.
+func (bn *blobNotifierMap) arrivalsForRoot(root [32]byte) [fieldparams.MaxBlobsPerBlock]blobArrival {
+	bn.RLock()
+	defer bn.RUnlock()
+	return bn.arrivals[root]
+}
+
 func (bn *blobNotifierMap) delete(root [32]byte) {
 	bn.Lock()
 	defer bn.Unlock()
 	delete(bn.seenIndex, root)
 	delete(bn.notifiers, root)
+	delete(bn.arrivals, root)
 }
 
 // NewService instantiates a new block service instance that will
@@ -167,6 +195,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	bn := &blobNotifierMap{
 		notifiers: make(map[[32]byte]chan uint64),
 		seenIndex: make(map[[32]byte][fieldparams.MaxBlobsPerBlock]bool),
+		arrivals:  make(map[[32]byte][fieldparams.MaxBlobsPerBlock]blobArrival),
 	}
 	srv := &Service{
 		ctx:                  ctx,
@@ -209,6 +238,7 @@ func (s *Service) Start() {
 	}
 	s.spawnProcessAttestationsRoutine()
 	go s.runLateBlockTasks()
+	go s.runCommitteePrecompute()
 }
 
 // Stop the blockchain service's main event loop and associated goroutines.
@@ -219,11 +249,17 @@ func (s *Service) Stop() error {
 	s.headLock.RLock()
 	if s.cfg.StateGen != nil && s.head != nil && s.head.state != nil {
 		r := s.head.state.FinalizedCheckpoint().Root
+		headRoot, headState := s.head.root, s.head.state.Copy()
 		s.headLock.RUnlock()
 		// Save the last finalized state so that starting up in the following run will be much faster.
 		if err := s.cfg.StateGen.ForceCheckpoint(s.ctx, r); err != nil {
 			return err
 		}
+		// Save the head state as well so the next run can resume from the head instead of
+		// replaying every block since the last finalized checkpoint.
+		if err := s.saveShutdownHead(s.ctx, headRoot, headState); err != nil {
+			log.WithError(err).Error("Could not save head state before shutdown")
+		}
 	} else {
 		s.headLock.RUnlock()
 	}
@@ -329,6 +365,9 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 		// Exit run time if the node failed to verify weak subjectivity checkpoint.
 		return errors.Wrap(err, "could not verify initial checkpoint provided for chain sync")
 	}
+	if err := s.resumeFromShutdownHead(s.ctx); err != nil {
+		log.WithError(err).Warn("Could not resume from the head saved at shutdown, starting from the finalized checkpoint")
+	}
 
 	vr := bytesutil.ToBytes32(saved.GenesisValidatorsRoot())
 	if err := s.clockSetter.SetClock(startup.NewClock(s.genesisTime, vr)); err != nil {
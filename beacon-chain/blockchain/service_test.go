@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
@@ -589,6 +590,7 @@ func TestNotifyIndex(t *testing.T) {
 	bn := &blobNotifierMap{
 		seenIndex: make(map[[32]byte][fieldparams.MaxBlobsPerBlock]bool),
 		notifiers: make(map[[32]byte]chan uint64),
+		arrivals:  make(map[[32]byte][fieldparams.MaxBlobsPerBlock]blobArrival),
 	}
 
 	// Sample root and index
@@ -596,10 +598,13 @@ func TestNotifyIndex(t *testing.T) {
 	copy(root[:], "exampleRoot")
 
 	// Test notifying a new index
-	bn.notifyIndex(root, 1)
+	bn.notifyIndex(root, 1, das.SourceGossip)
 	if !bn.seenIndex[root][1] {
 		t.Errorf("Index was not marked as seen")
 	}
+	if bn.arrivalsForRoot(root)[1].source != das.SourceGossip {
+		t.Errorf("Arrival source was not recorded")
+	}
 
 	// Test that a new channel is created
 	if _, ok := bn.notifiers[root]; !ok {
@@ -607,13 +612,16 @@ func TestNotifyIndex(t *testing.T) {
 	}
 
 	// Test notifying an already seen index
-	bn.notifyIndex(root, 1)
+	bn.notifyIndex(root, 1, das.SourceReqResp)
 	if len(bn.notifiers[root]) > 1 {
 		t.Errorf("Notifier channel should not receive multiple messages for the same index")
 	}
+	if bn.arrivalsForRoot(root)[1].source != das.SourceGossip {
+		t.Errorf("Arrival of an already seen index should not be overwritten")
+	}
 
 	// Test notifying a new index again
-	bn.notifyIndex(root, 2)
+	bn.notifyIndex(root, 2, das.SourceExecution)
 	if !bn.seenIndex[root][2] {
 		t.Errorf("Index was not marked as seen")
 	}
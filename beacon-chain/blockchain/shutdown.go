@@ -0,0 +1,123 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// saveShutdownHead persists the head state and head root on shutdown, so that the next run
+// can rebuild fork choice and the head from it rather than from the finalized checkpoint.
+func (s *Service) saveShutdownHead(ctx context.Context, root [32]byte, st state.BeaconState) error {
+	finalized := st.FinalizedCheckpoint()
+	if finalized == nil || root == s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root)) {
+		return nil
+	}
+	if !s.cfg.BeaconDB.HasState(ctx, root) {
+		if err := s.cfg.BeaconDB.SaveState(ctx, st, root); err != nil {
+			return errors.Wrap(err, "could not save head state")
+		}
+		if err := s.cfg.BeaconDB.SaveShutdownHeadRoot(ctx, root); err != nil {
+			return errors.Wrap(err, "could not save shutdown head root")
+		}
+	}
+	if err := s.cfg.BeaconDB.SaveHeadBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save head root")
+	}
+	log.WithFields(logrus.Fields{
+		"root": fmt.Sprintf("%#x", root),
+		"slot": st.Slot(),
+	}).Info("Saved head state for the next startup")
+	return nil
+}
+
+// resumeFromShutdownHead restores the head saved by saveShutdownHead. The blocks between the
+// finalized checkpoint and the saved head are inserted into fork choice and the saved state
+// becomes the head state. It is a no-op when the database holds no such state. The saved
+// state is only deleted from the database after resuming from it, and only when it was
+// written on shutdown.
+// Caller of the method MUST acquire a lock on forkchoice.
+func (s *Service) resumeFromShutdownHead(ctx context.Context) error {
+	headBlock, err := s.cfg.BeaconDB.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if err := blocks.BeaconBlockIsNil(headBlock); err != nil {
+		return nil
+	}
+	root, err := headBlock.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get head block root")
+	}
+	if s.cfg.ForkChoiceStore.HasNode(root) || !s.cfg.BeaconDB.HasState(ctx, root) {
+		return nil
+	}
+	st, err := s.cfg.BeaconDB.State(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if st == nil || st.IsNil() {
+		return nil
+	}
+	if err := s.fillInForkChoiceMissingBlocks(ctx, headBlock, st.FinalizedCheckpoint(), st.CurrentJustifiedCheckpoint()); err != nil {
+		return errors.Wrap(err, "could not insert blocks to forkchoice")
+	}
+	roblock, err := blocks.NewROBlockWithRoot(headBlock, root)
+	if err != nil {
+		return err
+	}
+	if err := s.cfg.ForkChoiceStore.InsertNode(ctx, st, roblock); err != nil {
+		return errors.Wrap(err, "could not insert head block to forkchoice")
+	}
+	if err := s.cfg.StateGen.SaveState(ctx, root, st); err != nil {
+		return errors.Wrap(err, "could not save head state to cache")
+	}
+	optimistic, err := s.cfg.ForkChoiceStore.IsOptimistic(root)
+	if err != nil {
+		return errors.Wrap(err, "could not check if head is optimistic")
+	}
+	if err := s.setHead(&head{
+		root,
+		headBlock,
+		st,
+		headBlock.Block().Slot(),
+		optimistic,
+	}); err != nil {
+		return errors.Wrap(err, "could not set head")
+	}
+	log.WithFields(logrus.Fields{
+		"root": fmt.Sprintf("%#x", root),
+		"slot": headBlock.Block().Slot(),
+	}).Info("Resumed from the head saved at shutdown")
+	s.deleteShutdownHeadState(ctx, root)
+	return nil
+}
+
+// deleteShutdownHeadState deletes the head state of the given root once the node resumed from it, if
+// saveShutdownHead wrote it only for this purpose. From then on it lives in the stategen caches like
+// any other hot state.
+func (s *Service) deleteShutdownHeadState(ctx context.Context, root [32]byte) {
+	saved, err := s.cfg.BeaconDB.ShutdownHeadRoot(ctx)
+	if errors.Is(err, db.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		log.WithError(err).Debug("Could not get shutdown head root")
+		return
+	}
+	if saved == root {
+		if err := s.cfg.BeaconDB.DeleteState(ctx, root); err != nil {
+			log.WithError(err).Debug("Could not delete head state saved at shutdown")
+			return
+		}
+	}
+	if err := s.cfg.BeaconDB.DeleteShutdownHeadRoot(ctx); err != nil {
+		log.WithError(err).Debug("Could not delete shutdown head root")
+	}
+}
@@ -0,0 +1,100 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestService_ResumeFromShutdownHead(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx, beaconDB := tr.ctx, tr.db
+
+	gs, _ := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, service.saveGenesisData(ctx, gs))
+
+	parent := service.originBlockRoot
+	roots := make([][32]byte, 0, 3)
+	for i := 1; i <= 3; i++ {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = primitives.Slot(i)
+		blk.Block.ParentRoot = parent[:]
+		util.SaveBlock(t, ctx, beaconDB, blk)
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, r)
+		parent = r
+	}
+	headRoot := roots[2]
+	headState := gs.Copy()
+	require.NoError(t, headState.SetSlot(3))
+
+	require.NoError(t, service.saveShutdownHead(ctx, headRoot, headState))
+	require.Equal(t, true, beaconDB.HasState(ctx, headRoot))
+	saved, err := beaconDB.ShutdownHeadRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, headRoot, saved)
+
+	service.cfg.ForkChoiceStore.Lock()
+	err = service.resumeFromShutdownHead(ctx)
+	service.cfg.ForkChoiceStore.Unlock()
+	require.NoError(t, err)
+
+	for _, r := range roots {
+		assert.Equal(t, true, service.cfg.ForkChoiceStore.HasNode(r))
+	}
+	assert.Equal(t, headRoot, service.headRoot())
+	assert.Equal(t, primitives.Slot(3), service.HeadSlot())
+	assert.Equal(t, false, beaconDB.HasState(ctx, headRoot), "shutdown head state should be removed from the db")
+	_, err = beaconDB.ShutdownHeadRoot(ctx)
+	require.ErrorIs(t, err, db.ErrNotFound)
+}
+
+func TestService_ResumeFromShutdownHead_KeepsExistingState(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx, beaconDB := tr.ctx, tr.db
+
+	gs, _ := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, service.saveGenesisData(ctx, gs))
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 1
+	blk.Block.ParentRoot = service.originBlockRoot[:]
+	util.SaveBlock(t, ctx, beaconDB, blk)
+	headRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headState := gs.Copy()
+	require.NoError(t, headState.SetSlot(1))
+
+	// The state was in the db before shutdown, so it is not the shutdown path's to delete.
+	require.NoError(t, beaconDB.SaveState(ctx, headState, headRoot))
+	require.NoError(t, service.saveShutdownHead(ctx, headRoot, headState))
+	_, err = beaconDB.ShutdownHeadRoot(ctx)
+	require.ErrorIs(t, err, db.ErrNotFound)
+
+	service.cfg.ForkChoiceStore.Lock()
+	err = service.resumeFromShutdownHead(ctx)
+	service.cfg.ForkChoiceStore.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, headRoot, service.headRoot())
+	assert.Equal(t, true, beaconDB.HasState(ctx, headRoot))
+}
+
+func TestService_ResumeFromShutdownHead_NothingSaved(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx := tr.ctx
+
+	gs, _ := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, service.saveGenesisData(ctx, gs))
+
+	service.cfg.ForkChoiceStore.Lock()
+	err := service.resumeFromShutdownHead(ctx)
+	service.cfg.ForkChoiceStore.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, service.originBlockRoot, service.headRoot())
+	assert.Equal(t, 1, service.cfg.ForkChoiceStore.NodeCount())
+}
@@ -82,6 +82,11 @@ func (s *ChainService) Ancestor(ctx context.Context, root []byte, slot primitive
 	return r[:], err
 }
 
+// Children mocks the same method in the chain service.
+func (s *ChainService) Children(root [32]byte) ([][32]byte, []primitives.Slot, error) {
+	return s.ForkChoiceStore.Children(root)
+}
+
 // StateNotifier mocks the same method in the chain service.
 func (s *ChainService) StateNotifier() statefeed.Notifier {
 	if s.stateNotifier == nil {
@@ -363,6 +368,15 @@ func (s *ChainService) HeadStateReadOnly(context.Context) (state.ReadOnlyBeaconS
 	return s.State, nil
 }
 
+// HeadRootAndStateReadOnly mocks HeadRootAndStateReadOnly method in chain service.
+func (s *ChainService) HeadRootAndStateReadOnly(ctx context.Context) ([32]byte, state.ReadOnlyBeaconState, error) {
+	root, err := s.HeadRoot(ctx)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	return bytesutil.ToBytes32(root), s.State, nil
+}
+
 // CurrentFork mocks HeadState method in chain service.
 func (s *ChainService) CurrentFork() *ethpb.Fork {
 	return s.Fork
@@ -697,7 +711,7 @@ func (c *ChainService) BlockBeingSynced(root [32]byte) bool {
 }
 
 // ReceiveBlob implements the same method in the chain service
-func (c *ChainService) ReceiveBlob(_ context.Context, b blocks.VerifiedROBlob) error {
+func (c *ChainService) ReceiveBlob(_ context.Context, b blocks.VerifiedROBlob, _ das.Source) error {
 	c.Blobs = append(c.Blobs, b)
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/urfave/cli/v2"
 )
 
@@ -17,7 +18,11 @@ func FlagOptions(c *cli.Context) ([]Option, error) {
 	var client *builder.Client
 	if endpoint != "" {
 		var err error
-		client, err = builder.NewClient(endpoint)
+		var clientOpts []builder.ClientOpt
+		if features.Get().EnableBuilderSSZ {
+			clientOpts = append(clientOpts, builder.WithSSZ())
+		}
+		client, err = builder.NewClient(endpoint, clientOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,70 @@
+package builder
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// RegistrationHistory reports the last validator registrations which successfully reached each relay.
+type RegistrationHistory interface {
+	LastRegistrations() []*RelayRegistration
+}
+
+// RelayRegistration is the last registration of a validator which was successfully sent to a relay.
+type RelayRegistration struct {
+	Relay        string
+	Registration *ethpb.ValidatorRegistrationV1
+	Time         time.Time
+}
+
+// relayRegistrations tracks the last successful registration of each validator with each relay.
+type relayRegistrations struct {
+	sync.RWMutex
+	byRelay map[string]map[[fieldparams.BLSPubkeyLength]byte]*RelayRegistration
+}
+
+// record the registrations as successfully sent to the relay at the given time.
+func (r *relayRegistrations) record(relay string, regs []*ethpb.SignedValidatorRegistrationV1, t time.Time) {
+	r.Lock()
+	defer r.Unlock()
+	if r.byRelay == nil {
+		r.byRelay = make(map[string]map[[fieldparams.BLSPubkeyLength]byte]*RelayRegistration)
+	}
+	byPubkey, ok := r.byRelay[relay]
+	if !ok {
+		byPubkey = make(map[[fieldparams.BLSPubkeyLength]byte]*RelayRegistration)
+		r.byRelay[relay] = byPubkey
+	}
+	for _, reg := range regs {
+		byPubkey[bytesutil.ToBytes48(reg.Message.Pubkey)] = &RelayRegistration{
+			Relay:        relay,
+			Registration: reg.Message,
+			Time:         t,
+		}
+	}
+}
+
+// LastRegistrations returns the last successful registration of each validator with each relay, sorted by relay
+// and by public key.
+func (s *Service) LastRegistrations() []*RelayRegistration {
+	s.relayRegistrations.RLock()
+	defer s.relayRegistrations.RUnlock()
+	var regs []*RelayRegistration
+	for _, byPubkey := range s.relayRegistrations.byRelay {
+		for _, reg := range byPubkey {
+			regs = append(regs, reg)
+		}
+	}
+	sort.Slice(regs, func(i, j int) bool {
+		if regs[i].Relay != regs[j].Relay {
+			return regs[i].Relay < regs[j].Relay
+		}
+		return string(regs[i].Registration.Pubkey) < string(regs[j].Registration.Pubkey)
+	})
+	return regs
+}
@@ -3,6 +3,8 @@ package builder
 import (
 	"context"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -20,6 +22,8 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+var _ RegistrationHistory = (*Service)(nil)
+
 // ErrNoBuilder is used when builder endpoint is not configured.
 var ErrNoBuilder = errors.New("builder endpoint not configured")
 
@@ -30,6 +34,9 @@ type BlockBuilder interface {
 	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
 	Configured() bool
+	Enabled() bool
+	RelayStatus() error
+	RelayURL() string
 }
 
 // config defines a config struct for dependencies into the service.
@@ -46,6 +53,11 @@ type Service struct {
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
+	relayStatusLock   sync.RWMutex
+	relayStatusErr    error
+	disabled          atomic.Bool
+	// Tracks the registrations which reached the relays, so that operators can verify them.
+	relayRegistrations relayRegistrations
 }
 
 // NewService instantiates a new service.
@@ -167,6 +179,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	if err := s.c.RegisterValidator(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
+	s.relayRegistrations.record(s.c.NodeURL(), valid, time.Now())
 
 	if len(indexToRegistration) != len(msgs) {
 		return errors.New("ids and registrations must be the same length")
@@ -196,6 +209,32 @@ func (s *Service) Configured() bool {
 	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
 }
 
+// Enabled returns false when the use of the builder for block proposals was disabled at runtime.
+func (s *Service) Enabled() bool {
+	return !s.disabled.Load()
+}
+
+// SetEnabled enables or disables the use of the builder for block proposals at runtime. Validator registrations
+// are still sent to the builder while it is disabled, so that it can be enabled again at any time.
+func (s *Service) SetEnabled(enabled bool) {
+	s.disabled.Store(!enabled)
+}
+
+// RelayStatus returns the error of the last call to the status endpoint of the builder relay network, if it failed.
+func (s *Service) RelayStatus() error {
+	s.relayStatusLock.RLock()
+	defer s.relayStatusLock.RUnlock()
+	return s.relayStatusErr
+}
+
+// RelayURL returns the URL of the builder relay, or an empty string if no builder is configured.
+func (s *Service) RelayURL() string {
+	if !s.Configured() {
+		return ""
+	}
+	return s.c.NodeURL()
+}
+
 func (s *Service) pollRelayerStatus(ctx context.Context) {
 	ticker := time.NewTicker(time.Minute)
 	defer ticker.Stop()
@@ -203,9 +242,13 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 		select {
 		case <-ticker.C:
 			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
+				err := s.c.Status(ctx)
+				if err != nil {
 					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
+				s.relayStatusLock.Lock()
+				s.relayStatusErr = err
+				s.relayStatusLock.Unlock()
 			}
 		case <-ctx.Done():
 			return
@@ -54,6 +54,29 @@ func Test_RegisterValidator_WithCache(t *testing.T) {
 	require.DeepEqual(t, reg, registration)
 }
 
+func Test_RegisterValidator_LastRegistrations(t *testing.T) {
+	ctx := context.Background()
+	headFetcher := &blockchainTesting.ChainService{}
+	builder := buildertesting.NewClient()
+	s, err := NewService(ctx, WithRegistrationCache(), WithHeadFetcher(headFetcher), WithBuilderClient(&builder))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(s.LastRegistrations()))
+
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	var feeRecipient [20]byte
+	reg := &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], GasLimit: 1, FeeRecipient: feeRecipient[:]}
+	require.NoError(t, s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: reg}}))
+	reg = &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], GasLimit: 2, FeeRecipient: feeRecipient[:]}
+	require.NoError(t, s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: reg}}))
+
+	// Only the last registration of each validator is kept.
+	regs := s.LastRegistrations()
+	require.Equal(t, 1, len(regs))
+	assert.Equal(t, builder.NodeURL(), regs[0].Relay)
+	assert.DeepEqual(t, reg, regs[0].Registration)
+	assert.Equal(t, false, regs[0].Time.IsZero())
+}
+
 func Test_BuilderMethodsWithouClient(t *testing.T) {
 	s, err := NewService(context.Background())
 	require.NoError(t, err)
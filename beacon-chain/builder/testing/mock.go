@@ -25,6 +25,7 @@ type Config struct {
 // MockBuilderService to mock builder.
 type MockBuilderService struct {
 	HasConfigured         bool
+	Disabled              bool
 	Payload               *v1.ExecutionPayload
 	PayloadCapella        *v1.ExecutionPayloadCapella
 	PayloadDeneb          *v1.ExecutionPayloadDeneb
@@ -36,6 +37,8 @@ type MockBuilderService struct {
 	RegistrationCache     *cache.RegistrationCache
 	ErrGetHeader          error
 	ErrRegisterValidator  error
+	ErrRelayStatus        error
+	URL                   string
 	Cfg                   *Config
 }
 
@@ -44,6 +47,21 @@ func (s *MockBuilderService) Configured() bool {
 	return s.HasConfigured
 }
 
+// Enabled for mocking.
+func (s *MockBuilderService) Enabled() bool {
+	return !s.Disabled
+}
+
+// RelayStatus for mocking.
+func (s *MockBuilderService) RelayStatus() error {
+	return s.ErrRelayStatus
+}
+
+// RelayURL for mocking.
+func (s *MockBuilderService) RelayURL() string {
+	return s.URL
+}
+
 // SubmitBlindedBlock for mocking.
 func (s *MockBuilderService) SubmitBlindedBlock(_ context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	switch b.Version() {
@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+)
+
+// BadBlockCacheSize is the default number of bad blocks kept in the cache.
+const BadBlockCacheSize = 1000
+
+// BadBlockReason describes why a block was marked as bad.
+type BadBlockReason string
+
+const (
+	// BadBlockExecutionInvalid is used for blocks whose payload was deemed INVALID by the execution engine.
+	BadBlockExecutionInvalid BadBlockReason = "execution_invalid"
+	// BadBlockConsensusInvalid is used for blocks that failed consensus validation or the state transition.
+	BadBlockConsensusInvalid BadBlockReason = "consensus_invalid"
+	// BadBlockInvalidParent is used for blocks that descend from a bad block.
+	BadBlockInvalidParent BadBlockReason = "invalid_parent"
+	// BadBlockManual is used for blocks marked as bad through the API.
+	BadBlockManual BadBlockReason = "manual"
+)
+
+// BadBlock is an entry of the bad block cache.
+type BadBlock struct {
+	Root     [32]byte
+	Reason   BadBlockReason
+	MarkedAt time.Time
+}
+
+// BadBlockCache keeps track of the most recent blocks that were deemed invalid, so that the node
+// does not process them or their descendants again.
+type BadBlockCache struct {
+	cache *lru.Cache
+}
+
+// NewBadBlockCache creates a bad block cache holding up to size entries.
+func NewBadBlockCache(size int) *BadBlockCache {
+	return &BadBlockCache{cache: lruwrpr.New(size)}
+}
+
+// Add marks the given block root as bad for the given reason. The reason of an existing entry is overwritten.
+func (c *BadBlockCache) Add(root [32]byte, reason BadBlockReason) {
+	c.cache.Add(root, &BadBlock{Root: root, Reason: reason, MarkedAt: time.Now()})
+}
+
+// Has returns true if the block root is marked as bad. Lookups do not refresh the entry, so the
+// oldest marked blocks are evicted first.
+func (c *BadBlockCache) Has(root [32]byte) bool {
+	return c.cache.Contains(root)
+}
+
+// Remove clears the given block root from the cache, returning true if it was present.
+func (c *BadBlockCache) Remove(root [32]byte) bool {
+	return c.cache.Remove(root)
+}
+
+// Len returns the number of blocks marked as bad.
+func (c *BadBlockCache) Len() int {
+	return c.cache.Len()
+}
+
+// Entries returns a copy of all the cache entries, ordered from the most recently marked.
+func (c *BadBlockCache) Entries() []BadBlock {
+	keys := c.cache.Keys()
+	entries := make([]BadBlock, 0, len(keys))
+	// Keys are ordered from the oldest entry.
+	for i := len(keys) - 1; i >= 0; i-- {
+		v, ok := c.cache.Peek(keys[i])
+		if !ok {
+			continue
+		}
+		entries = append(entries, *v.(*BadBlock))
+	}
+	return entries
+}
@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestBadBlockCache(t *testing.T) {
+	c := NewBadBlockCache(2)
+	r1, r2, r3 := [32]byte{1}, [32]byte{2}, [32]byte{3}
+	require.Equal(t, false, c.Has(r1))
+
+	c.Add(r1, BadBlockExecutionInvalid)
+	c.Add(r2, BadBlockConsensusInvalid)
+	require.Equal(t, true, c.Has(r1))
+	require.Equal(t, true, c.Has(r2))
+
+	entries := c.Entries()
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, r2, entries[0].Root)
+	require.Equal(t, BadBlockConsensusInvalid, entries[0].Reason)
+	require.Equal(t, r1, entries[1].Root)
+	require.Equal(t, BadBlockExecutionInvalid, entries[1].Reason)
+
+	// The oldest entry is evicted even though it was looked up.
+	c.Add(r3, BadBlockInvalidParent)
+	require.Equal(t, false, c.Has(r1))
+	require.Equal(t, 2, c.Len())
+
+	require.Equal(t, true, c.Remove(r2))
+	require.Equal(t, false, c.Remove(r2))
+	require.Equal(t, false, c.Has(r2))
+	require.Equal(t, 1, c.Len())
+}
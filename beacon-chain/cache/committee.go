@@ -9,10 +9,9 @@ import (
 	"sync"
 	"time"
 
-	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/cache/sized"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
@@ -20,14 +19,9 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-const (
-	// maxCommitteesCacheSize defines the max number of shuffled committees on per randao basis can cache.
-	// Due to reorgs and long finality, it's good to keep the old cache around for quickly switch over.
-	maxCommitteesCacheSize = int(4)
-	// expandedCommitteeCacheSize defines the expanded size of the committee cache in the event we
-	// do not have finality to deal with long forks better.
-	expandedCommitteeCacheSize = int(32)
-)
+// expandedCommitteeCacheFactor defines how many times the committee cache limit is expanded in the event we
+// do not have finality to deal with long forks better.
+const expandedCommitteeCacheFactor = uint64(8)
 
 var (
 	// CommitteeCacheMiss tracks the number of committee requests that aren't present in the cache.
@@ -44,10 +38,11 @@ var (
 
 // CommitteeCache is a struct with 1 queue for looking up shuffled indices list by seed.
 type CommitteeCache struct {
-	CommitteeCache *lru.Cache
+	CommitteeCache *sized.Cache[string, *Committees]
 	lock           sync.RWMutex
 	inProgress     map[string]bool
-	size           int
+	maxBytes       uint64
+	expanded       bool
 }
 
 // committeeKeyFn takes the seed as the key to retrieve shuffled indices of a committee in a given epoch.
@@ -59,9 +54,14 @@ func committeeKeyFn(obj interface{}) (string, error) {
 	return key(info.Seed), nil
 }
 
+// committeesSize estimates the memory used by the shuffled committees of a seed.
+func committeesSize(c *Committees) uint64 {
+	return uint64(len(c.ShuffledIndices)+len(c.SortedIndices))*8 + 64
+}
+
 // NewCommitteesCache creates a new committee cache for storing/accessing shuffled indices of a committee.
 func NewCommitteesCache() *CommitteeCache {
-	cc := &CommitteeCache{}
+	cc := &CommitteeCache{maxBytes: DefaultCommitteeCacheSize}
 	cc.Clear()
 	return cc
 }
@@ -70,9 +70,20 @@ func NewCommitteesCache() *CommitteeCache {
 func (c *CommitteeCache) Clear() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	c.CommitteeCache = lruwrpr.New(maxCommitteesCacheSize)
+	c.CommitteeCache = sized.New[string, *Committees]("committee", c.maxBytes, committeesSize)
 	c.inProgress = make(map[string]bool)
-	c.size = maxCommitteesCacheSize
+	c.expanded = false
+}
+
+// SetMaxBytes sets the memory limit of the committee cache, in bytes, which is expanded when we do not have finality.
+func (c *CommitteeCache) SetMaxBytes(maxBytes uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.maxBytes = maxBytes
+	if c.expanded {
+		maxBytes *= expandedCommitteeCacheFactor
+	}
+	c.CommitteeCache.Resize(maxBytes)
 }
 
 // ExpandCommitteeCache expands the size of the committee cache.
@@ -80,12 +91,12 @@ func (c *CommitteeCache) ExpandCommitteeCache() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.size == expandedCommitteeCacheSize {
+	if c.expanded {
 		return
 	}
-	c.CommitteeCache.Resize(expandedCommitteeCacheSize)
-	c.size = expandedCommitteeCacheSize
-	log.Warnf("Expanding committee cache size from %d to %d", maxCommitteesCacheSize, expandedCommitteeCacheSize)
+	c.CommitteeCache.Resize(c.maxBytes * expandedCommitteeCacheFactor)
+	c.expanded = true
+	log.Warnf("Expanding committee cache size from %d to %d bytes", c.maxBytes, c.maxBytes*expandedCommitteeCacheFactor)
 }
 
 // CompressCommitteeCache compresses the size of the committee cache.
@@ -93,12 +104,12 @@ func (c *CommitteeCache) CompressCommitteeCache() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.size == maxCommitteesCacheSize {
+	if !c.expanded {
 		return
 	}
-	c.CommitteeCache.Resize(maxCommitteesCacheSize)
-	c.size = maxCommitteesCacheSize
-	log.Warnf("Reducing committee cache size from %d to %d", expandedCommitteeCacheSize, maxCommitteesCacheSize)
+	c.CommitteeCache.Resize(c.maxBytes)
+	c.expanded = false
+	log.Warnf("Reducing committee cache size from %d to %d bytes", c.maxBytes*expandedCommitteeCacheFactor, c.maxBytes)
 }
 
 // Committee fetches the shuffled indices by slot and committee index. Every list of indices
@@ -108,7 +119,7 @@ func (c *CommitteeCache) Committee(ctx context.Context, slot primitives.Slot, se
 		return nil, err
 	}
 
-	obj, exists := c.CommitteeCache.Get(key(seed))
+	item, exists := c.CommitteeCache.Get(key(seed))
 	if exists {
 		CommitteeCacheHit.Inc()
 	} else {
@@ -116,11 +127,6 @@ func (c *CommitteeCache) Committee(ctx context.Context, slot primitives.Slot, se
 		return nil, nil
 	}
 
-	item, ok := obj.(*Committees)
-	if !ok {
-		return nil, ErrNotCommittee
-	}
-
 	committeeCountPerSlot := uint64(1)
 	if item.CommitteeCount/uint64(params.BeaconConfig().SlotsPerEpoch) > 1 {
 		committeeCountPerSlot = item.CommitteeCount / uint64(params.BeaconConfig().SlotsPerEpoch)
@@ -140,7 +146,7 @@ func (c *CommitteeCache) Committee(ctx context.Context, slot primitives.Slot, se
 }
 
 // AddCommitteeShuffledList adds Committee shuffled list object to the cache. T
-// his method also trims the least recently list if the cache size has ready the max cache size limit.
+// his method also trims the least recently lists if the cache has reached its memory limit.
 func (c *CommitteeCache) AddCommitteeShuffledList(ctx context.Context, committees *Committees) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -151,7 +157,7 @@ func (c *CommitteeCache) AddCommitteeShuffledList(ctx context.Context, committee
 	if err != nil {
 		return err
 	}
-	_ = c.CommitteeCache.Add(key, committees)
+	c.CommitteeCache.Add(key, committees)
 	return nil
 }
 
@@ -160,8 +166,7 @@ func (c *CommitteeCache) ActiveIndices(ctx context.Context, seed [32]byte) ([]pr
 	if err := c.checkInProgress(ctx, seed); err != nil {
 		return nil, err
 	}
-	obj, exists := c.CommitteeCache.Get(key(seed))
-
+	item, exists := c.CommitteeCache.Get(key(seed))
 	if exists {
 		CommitteeCacheHit.Inc()
 	} else {
@@ -169,11 +174,6 @@ func (c *CommitteeCache) ActiveIndices(ctx context.Context, seed [32]byte) ([]pr
 		return nil, nil
 	}
 
-	item, ok := obj.(*Committees)
-	if !ok {
-		return nil, ErrNotCommittee
-	}
-
 	return item.SortedIndices, nil
 }
 
@@ -183,7 +183,7 @@ func (c *CommitteeCache) ActiveIndicesCount(ctx context.Context, seed [32]byte)
 		return 0, err
 	}
 
-	obj, exists := c.CommitteeCache.Get(key(seed))
+	item, exists := c.CommitteeCache.Get(key(seed))
 	if exists {
 		CommitteeCacheHit.Inc()
 	} else {
@@ -191,18 +191,12 @@ func (c *CommitteeCache) ActiveIndicesCount(ctx context.Context, seed [32]byte)
 		return 0, nil
 	}
 
-	item, ok := obj.(*Committees)
-	if !ok {
-		return 0, ErrNotCommittee
-	}
-
 	return len(item.SortedIndices), nil
 }
 
 // HasEntry returns true if the committee cache has a value.
 func (c *CommitteeCache) HasEntry(seed string) bool {
-	_, ok := c.CommitteeCache.Get(seed)
-	return ok
+	return c.CommitteeCache.Contains(seed)
 }
 
 // MarkInProgress a request so that any other similar requests will block on
@@ -75,6 +75,11 @@ func (c *FakeCommitteeCache) Clear() {
 	return
 }
 
+// SetMaxBytes is a stub.
+func (c *FakeCommitteeCache) SetMaxBytes(uint64) {
+	return
+}
+
 func (c *FakeCommitteeCache) ExpandCommitteeCache() {
 	return
 }
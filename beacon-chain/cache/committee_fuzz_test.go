@@ -35,7 +35,7 @@ func TestCommitteeCache_FuzzCommitteesByEpoch(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	assert.Equal(t, maxCommitteesCacheSize, len(cache.CommitteeCache.Keys()), "Incorrect key size")
+	assert.Equal(t, true, cache.CommitteeCache.Bytes() <= cache.CommitteeCache.MaxBytes(), "Cache exceeds its size limit")
 }
 
 func TestCommitteeCache_FuzzActiveIndices(t *testing.T) {
@@ -52,5 +52,5 @@ func TestCommitteeCache_FuzzActiveIndices(t *testing.T) {
 		assert.DeepEqual(t, c.SortedIndices, indices)
 	}
 
-	assert.Equal(t, maxCommitteesCacheSize, len(cache.CommitteeCache.Keys()), "Incorrect key size")
+	assert.Equal(t, true, cache.CommitteeCache.Bytes() <= cache.CommitteeCache.MaxBytes(), "Cache exceeds its size limit")
 }
@@ -1116,6 +1116,81 @@ func TestDepositMap_WorksCorrectly(t *testing.T) {
 	assert.DeepEqual(t, nilDep, dep)
 }
 
+func TestInsertSnapshot(t *testing.T) {
+	ctx := context.Background()
+	deps, _, err := util.DeterministicDepositsAndKeys(6)
+	require.NoError(t, err)
+	full := NewDepositTree()
+	snapshotTree := NewDepositTree()
+	for i, d := range deps {
+		rt, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, full.Insert(rt[:], i))
+		if i < 4 {
+			require.NoError(t, snapshotTree.Insert(rt[:], i))
+		}
+	}
+	require.NoError(t, snapshotTree.Finalize(3, [32]byte{'a'}, 10))
+	snapshot, err := snapshotTree.ToProto()
+	require.NoError(t, err)
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertSnapshot(ctx, snapshot))
+	require.ErrorContains(t, "non-empty cache", dc.InsertSnapshot(ctx, snapshot))
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(10))
+	assert.Equal(t, uint64(4), count)
+	assert.DeepEqual(t, bytesutil.ToBytes32(snapshot.DepositRoot), root)
+
+	// Only the deposits following the snapshot are inserted.
+	require.ErrorContains(t, "wanted deposit with index 4", dc.InsertDeposit(ctx, deps[0], 1, 0, [32]byte{}))
+	for i := 4; i < 6; i++ {
+		require.NoError(t, dc.InsertDeposit(ctx, deps[i], uint64(10+i), int64(i), [32]byte{byte(i)}))
+	}
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(14))
+	assert.Equal(t, uint64(5), count)
+	assert.DeepEqual(t, [32]byte{4}, root)
+
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 5, [32]byte{'b'}, 15))
+	fd, err := dc.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), fd.MerkleTrieIndex())
+	got, err := fd.Deposits().HashTreeRoot()
+	require.NoError(t, err)
+	want, err := full.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	require.NoError(t, dc.PruneProofs(ctx, 5))
+}
+
+func TestTruncateDeposits(t *testing.T) {
+	ctx := context.Background()
+	deps, _, err := util.DeterministicDepositsAndKeys(5)
+	require.NoError(t, err)
+	dc, err := New()
+	require.NoError(t, err)
+	for i, d := range deps {
+		require.NoError(t, dc.InsertDeposit(ctx, d, uint64(10+i), int64(i), [32]byte{byte(i)}))
+		dc.InsertPendingDeposit(ctx, d, uint64(10+i), int64(i), [32]byte{byte(i)})
+	}
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 1, [32]byte{'a'}, 11))
+
+	require.ErrorContains(t, "cannot remove finalized deposit 1", dc.TruncateDeposits(ctx, 1))
+	require.NoError(t, dc.TruncateDeposits(ctx, 3))
+	assert.Equal(t, 3, len(dc.AllDepositContainers(ctx)))
+	assert.Equal(t, 3, len(dc.PendingContainers(ctx, nil)))
+	dep, _ := dc.DepositByPubkey(ctx, deps[3].Data.PublicKey)
+	assert.Equal(t, true, dep == nil)
+	dep, _ = dc.DepositByPubkey(ctx, deps[2].Data.PublicKey)
+	assert.Equal(t, true, dep != nil)
+
+	// The removed deposits can be inserted again.
+	require.NoError(t, dc.InsertDeposit(ctx, deps[4], 20, 3, [32]byte{'b'}))
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(20))
+	assert.Equal(t, uint64(4), count)
+	assert.DeepEqual(t, [32]byte{'b'}, root)
+}
+
 func makeDepositProof() [][]byte {
 	proof := make([][]byte, int(params.BeaconConfig().DepositContractTreeDepth)+1)
 	for i := range proof {
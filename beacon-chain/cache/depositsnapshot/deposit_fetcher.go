@@ -31,6 +31,10 @@ type Cache struct {
 	finalizedDeposits finalizedDepositsContainer
 	depositsByKey     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
 	depositsLock      sync.RWMutex
+	// snapshotDepositCount is the number of deposits of the snapshot the cache was initialized from, which are
+	// not held in deposits.
+	snapshotDepositCount int64
+	snapshotDepositRoot  [32]byte
 }
 
 // finalizedDepositsContainer stores the trie of deposits that have been included
@@ -134,9 +138,12 @@ func (c *Cache) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		if c.snapshotDepositCount > 0 {
+			return uint64(c.snapshotDepositCount), c.snapshotDepositRoot
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
+	return uint64(c.snapshotDepositCount) + uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
 }
 
 // FinalizedDeposits returns the finalized deposits trie.
@@ -185,6 +192,8 @@ func (c *Cache) PruneProofs(ctx context.Context, untilDepositIndex int64) error
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
+	// The deposits of the snapshot the cache was initialized from have no proof to prune.
+	untilDepositIndex -= c.snapshotDepositCount
 	if untilDepositIndex >= int64(len(c.deposits)) {
 		untilDepositIndex = int64(len(c.deposits) - 1)
 	}
@@ -43,8 +43,8 @@ func (c *Cache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNum ui
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if int(index) != len(c.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(c.deposits), index)
+	if wanted := c.snapshotDepositCount + int64(len(c.deposits)); index != wanted {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", wanted, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Index >= index })
@@ -106,8 +106,8 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(c.deposits) <= int(eth1DepositIndex) {
-		eth1DepositIndex = int64(len(c.deposits)) - 1
+	if lastIndex := c.snapshotDepositCount + int64(len(c.deposits)) - 1; lastIndex < eth1DepositIndex {
+		eth1DepositIndex = lastIndex
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -139,3 +139,64 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	return nil
 }
+
+// InsertSnapshot initializes the finalized deposits of an empty cache from an EIP-4881 deposit snapshot. The cache
+// then only holds the deposits following the snapshot.
+func (c *Cache) InsertSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	_, span := trace.StartSpan(ctx, "Cache.InsertSnapshot")
+	defer span.End()
+
+	tree, err := DepositTreeFromSnapshotProto(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "could not build deposit tree from snapshot")
+	}
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+
+	if len(c.deposits) != 0 || c.finalizedDeposits.MerkleTrieIndex() != -1 {
+		return errors.New("cannot insert a deposit snapshot in a non-empty cache")
+	}
+	c.finalizedDeposits = toFinalizedDepositsContainer(tree, int64(tree.depositCount)-1)
+	c.snapshotDepositCount = int64(tree.depositCount)
+	c.snapshotDepositRoot = bytesutil.ToBytes32(snapshot.DepositRoot)
+	return nil
+}
+
+// TruncateDeposits removes the deposits from the given index onwards, so that they can be inserted again, e.g.
+// after they were found to not match the logs of the deposit contract. Finalized deposits can not be removed.
+func (c *Cache) TruncateDeposits(ctx context.Context, fromIndex int64) error {
+	_, span := trace.StartSpan(ctx, "Cache.TruncateDeposits")
+	defer span.End()
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+
+	if fromIndex <= c.finalizedDeposits.MerkleTrieIndex() {
+		return errors.Errorf("cannot remove finalized deposit %d", fromIndex)
+	}
+	heightIdx := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Index >= fromIndex })
+	for _, ctr := range c.deposits[heightIdx:] {
+		pubkey := bytesutil.ToBytes48(ctr.Deposit.Data.PublicKey)
+		kept := c.depositsByKey[pubkey][:0]
+		for _, d := range c.depositsByKey[pubkey] {
+			if d.Index < fromIndex {
+				kept = append(kept, d)
+			}
+		}
+		if len(kept) == 0 {
+			delete(c.depositsByKey, pubkey)
+		} else {
+			c.depositsByKey[pubkey] = kept
+		}
+	}
+	c.deposits = c.deposits[:heightIdx]
+
+	pending := make([]*ethpb.DepositContainer, 0, len(c.pendingDeposits))
+	for _, dp := range c.pendingDeposits {
+		if dp.Index < fromIndex {
+			pending = append(pending, dp)
+		}
+	}
+	c.pendingDeposits = pending
+	pendingDepositsCount.Set(float64(len(c.pendingDeposits)))
+	return nil
+}
@@ -10,8 +10,7 @@ var (
 	// ErrNotFound for cache fetches that return a nil value.
 	ErrNotFound = errors.New("not found in cache")
 	// ErrNonExistingSyncCommitteeKey when sync committee key (root) does not exist in cache.
-	ErrNonExistingSyncCommitteeKey   = errors.New("does not exist sync committee key")
-	errNotSyncCommitteeIndexPosition = errors.New("not syncCommitteeIndexPosition struct")
+	ErrNonExistingSyncCommitteeKey = errors.New("does not exist sync committee key")
 	// ErrNotFoundRegistration when validator registration does not exist in cache.
 	ErrNotFoundRegistration = errors.Wrap(ErrNotFound, "no validator registered")
 
@@ -0,0 +1,13 @@
+package cache
+
+// Default memory limits of the caches, in bytes. The limits do not depend on the size of the validator set, so that
+// the memory used by the node stays predictable.
+const (
+	// DefaultCommitteeCacheSize is the default limit of the shuffled committees cached on a per randao basis.
+	// Due to reorgs and long finality, it's good to keep the old cache around for quickly switch over.
+	DefaultCommitteeCacheSize = uint64(128 << 20)
+	// DefaultProposerIndicesCacheSize is the default limit of the proposer indices cached per epoch and state root.
+	DefaultProposerIndicesCacheSize = uint64(1 << 20)
+	// DefaultSyncCommitteeCacheSize is the default limit of the validator positions in the sync committees.
+	DefaultSyncCommitteeCacheSize = uint64(1 << 20)
+)
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"sync"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 )
@@ -14,12 +15,16 @@ type RootToPayloadIDMap map[[32]byte]primitives.PayloadID
 // given slot and with the given head root.
 type PayloadIDCache struct {
 	slotToPayloadID map[primitives.Slot]RootToPayloadIDMap
+	preparedAt      map[primitives.PayloadID]time.Time
 	sync.Mutex
 }
 
 // NewPayloadIDCache returns a new payload ID cache
 func NewPayloadIDCache() *PayloadIDCache {
-	return &PayloadIDCache{slotToPayloadID: make(map[primitives.Slot]RootToPayloadIDMap)}
+	return &PayloadIDCache{
+		slotToPayloadID: make(map[primitives.Slot]RootToPayloadIDMap),
+		preparedAt:      make(map[primitives.PayloadID]time.Time),
+	}
 }
 
 // PayloadID returns the payload ID for the given slot and parent block root
@@ -50,13 +55,31 @@ func (p *PayloadIDCache) Set(slot primitives.Slot, root [32]byte, pid primitives
 		inner = make(RootToPayloadIDMap)
 		p.slotToPayloadID[slot] = inner
 	}
+	if old, ok := inner[root]; ok {
+		delete(p.preparedAt, old)
+	}
 	inner[root] = pid
+	if pid != (primitives.PayloadID{}) {
+		p.preparedAt[pid] = time.Now()
+	}
+}
+
+// PreparedAt returns the time at which the given payload ID was added to the cache, which is
+// roughly when the execution client started building the payload.
+func (p *PayloadIDCache) PreparedAt(pid primitives.PayloadID) (time.Time, bool) {
+	p.Lock()
+	defer p.Unlock()
+	t, ok := p.preparedAt[pid]
+	return t, ok
 }
 
 // Prune prunes old payload IDs. Requires a Lock in the cache
 func (p *PayloadIDCache) prune(slot primitives.Slot) {
-	for key := range p.slotToPayloadID {
+	for key, inner := range p.slotToPayloadID {
 		if key < slot {
+			for _, pid := range inner {
+				delete(p.preparedAt, pid)
+			}
 			delete(p.slotToPayloadID, key)
 		}
 	}
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -59,3 +60,33 @@ func TestValidatorPayloadIDsCache_GetAndSaveValidatorPayloadIDs(t *testing.T) {
 	require.Equal(t, false, ok)
 	require.Equal(t, primitives.PayloadID{}, p)
 }
+
+func TestPayloadIDCache_PreparedAt(t *testing.T) {
+	cache := NewPayloadIDCache()
+	pid := primitives.PayloadID{1, 2, 3}
+	_, ok := cache.PreparedAt(pid)
+	require.Equal(t, false, ok)
+
+	before := time.Now()
+	cache.Set(1, [32]byte{1}, pid)
+	at, ok := cache.PreparedAt(pid)
+	require.Equal(t, true, ok)
+	require.Equal(t, false, at.Before(before))
+
+	// Empty payload IDs are not tracked.
+	cache.Set(1, [32]byte{2}, primitives.PayloadID{})
+	_, ok = cache.PreparedAt(primitives.PayloadID{})
+	require.Equal(t, false, ok)
+
+	// Replacing the payload ID for a root forgets the old one.
+	newPid := primitives.PayloadID{4, 5, 6}
+	cache.Set(1, [32]byte{1}, newPid)
+	_, ok = cache.PreparedAt(pid)
+	require.Equal(t, false, ok)
+	_, ok = cache.PreparedAt(newPid)
+	require.Equal(t, true, ok)
+
+	cache.prune(2)
+	_, ok = cache.PreparedAt(newPid)
+	require.Equal(t, false, ok)
+}
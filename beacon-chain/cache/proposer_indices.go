@@ -8,6 +8,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/cache/sized"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 )
@@ -42,28 +43,36 @@ var (
 // root would be for slot 32 if present.
 type ProposerIndicesCache struct {
 	sync.Mutex
-	indices map[primitives.Epoch]map[[32]byte][fieldparams.SlotsPerEpoch]primitives.ValidatorIndex
+	indices *sized.Cache[proposerIndicesKey, [fieldparams.SlotsPerEpoch]primitives.ValidatorIndex]
 	rootMap map[forkchoicetypes.Checkpoint][32]byte // A map from checkpoint root to state root
 }
 
+type proposerIndicesKey struct {
+	epoch primitives.Epoch
+	root  [32]byte
+}
+
+// proposerIndicesSize is the memory used by the proposer indices of an epoch, along with their key.
+func proposerIndicesSize([fieldparams.SlotsPerEpoch]primitives.ValidatorIndex) uint64 {
+	return fieldparams.SlotsPerEpoch*8 + 40
+}
+
 // NewProposerIndicesCache returns a newly created cache
 func NewProposerIndicesCache() *ProposerIndicesCache {
 	return &ProposerIndicesCache{
-		indices: make(map[primitives.Epoch]map[[32]byte][fieldparams.SlotsPerEpoch]primitives.ValidatorIndex),
+		indices: sized.New[proposerIndicesKey, [fieldparams.SlotsPerEpoch]primitives.ValidatorIndex]("proposer_indices", DefaultProposerIndicesCacheSize, proposerIndicesSize),
 		rootMap: make(map[forkchoicetypes.Checkpoint][32]byte),
 	}
 }
 
+// SetMaxBytes sets the memory limit of the proposer indices, in bytes.
+func (p *ProposerIndicesCache) SetMaxBytes(maxBytes uint64) {
+	p.indices.Resize(maxBytes)
+}
+
 // ProposerIndices returns the proposer indices (safe) for the given root
 func (p *ProposerIndicesCache) ProposerIndices(epoch primitives.Epoch, root [32]byte) ([fieldparams.SlotsPerEpoch]primitives.ValidatorIndex, bool) {
-	p.Lock()
-	defer p.Unlock()
-	inner, ok := p.indices[epoch]
-	if !ok {
-		ProposerIndicesCacheMiss.Inc()
-		return [fieldparams.SlotsPerEpoch]primitives.ValidatorIndex{}, false
-	}
-	indices, exists := inner[root]
+	indices, exists := p.indices.Get(proposerIndicesKey{epoch: epoch, root: root})
 	if exists {
 		ProposerIndicesCacheHit.Inc()
 	} else {
@@ -74,13 +83,11 @@ func (p *ProposerIndicesCache) ProposerIndices(epoch primitives.Epoch, root [32]
 
 // Prune resets the ProposerIndicesCache to its initial state
 func (p *ProposerIndicesCache) Prune(epoch primitives.Epoch) {
+	p.indices.RemoveFunc(func(k proposerIndicesKey, _ [fieldparams.SlotsPerEpoch]primitives.ValidatorIndex) bool {
+		return k.epoch < epoch
+	})
 	p.Lock()
 	defer p.Unlock()
-	for key := range p.indices {
-		if key < epoch {
-			delete(p.indices, key)
-		}
-	}
 	for key := range p.rootMap {
 		if key.Epoch+1 < epoch {
 			delete(p.rootMap, key)
@@ -90,15 +97,7 @@ func (p *ProposerIndicesCache) Prune(epoch primitives.Epoch) {
 
 // Set sets the proposer indices for the given root as key
 func (p *ProposerIndicesCache) Set(epoch primitives.Epoch, root [32]byte, indices [fieldparams.SlotsPerEpoch]primitives.ValidatorIndex) {
-	p.Lock()
-	defer p.Unlock()
-
-	inner, ok := p.indices[epoch]
-	if !ok {
-		inner = make(map[[32]byte][fieldparams.SlotsPerEpoch]primitives.ValidatorIndex)
-		p.indices[epoch] = inner
-	}
-	inner[root] = indices
+	p.indices.Add(proposerIndicesKey{epoch: epoch, root: root}, indices)
 }
 
 // SetCheckpoint updates the map from checkpoints to state roots
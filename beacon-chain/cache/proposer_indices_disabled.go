@@ -43,6 +43,9 @@ func (c *FakeProposerIndicesCache) UnsafeProposerIndices(_ primitives.Epoch, _ [
 	return [fieldparams.SlotsPerEpoch]primitives.ValidatorIndex{}, false
 }
 
+// SetMaxBytes is a stub.
+func (p *FakeProposerIndicesCache) SetMaxBytes(uint64) {}
+
 // Prune is a stub.
 func (p *FakeProposerIndicesCache) Prune(epoch primitives.Epoch) {}
 
@@ -103,3 +103,18 @@ func TestProposerCache_CheckpointAndPrune(t *testing.T) {
 	require.Equal(t, true, ok)
 	require.Equal(t, indices, received)
 }
+
+func TestProposerCache_SetMaxBytes(t *testing.T) {
+	cache := NewProposerIndicesCache()
+	indices := [fieldparams.SlotsPerEpoch]primitives.ValidatorIndex{}
+	cache.SetMaxBytes(2 * proposerIndicesSize(indices))
+	for i := 1; i < 4; i++ {
+		cache.Set(primitives.Epoch(i), [32]byte{byte(i)}, indices)
+	}
+	_, ok := cache.ProposerIndices(1, [32]byte{1})
+	require.Equal(t, false, ok)
+	_, ok = cache.ProposerIndices(2, [32]byte{2})
+	require.Equal(t, true, ok)
+	_, ok = cache.ProposerIndices(3, [32]byte{3})
+	require.Equal(t, true, ok)
+}
@@ -9,15 +9,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/cache/sized"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	log "github.com/sirupsen/logrus"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
-	maxSyncCommitteeSize = uint64(3) // Allows 3 forks to happen around `EPOCHS_PER_SYNC_COMMITTEE_PERIOD` boundary.
-
 	// SyncCommitteeCacheMiss tracks the number of committee requests that aren't present in the cache.
 	SyncCommitteeCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "sync_committee_index_cache_miss_total",
@@ -30,12 +28,14 @@ var (
 	})
 )
 
-// SyncCommitteeCache utilizes a FIFO cache to sufficiently cache validator position within sync committee.
+// SyncCommitteeCache utilizes a LRU cache bounded in bytes to sufficiently cache validator position within sync
+// committee, allowing a few forks to happen around `EPOCHS_PER_SYNC_COMMITTEE_PERIOD` boundary.
 // It is thread safe with concurrent read write.
 type SyncCommitteeCache struct {
-	cache   *cache.FIFO
-	lock    sync.RWMutex
-	cleared *atomic.Uint64
+	cache    *sized.Cache[[32]byte, *syncCommitteeIndexPosition]
+	lock     sync.RWMutex
+	cleared  *atomic.Uint64
+	maxBytes uint64
 }
 
 // Index position of all validators in sync committee where `currentSyncCommitteeRoot` is the
@@ -52,9 +52,20 @@ type positionInCommittee struct {
 	nextPeriod    []primitives.CommitteeIndex
 }
 
+// syncCommitteeIndexPositionSize estimates the memory used by the positions of the validators in the sync
+// committees, along with their key.
+func syncCommitteeIndexPositionSize(p *syncCommitteeIndexPosition) uint64 {
+	size := uint64(64)
+	for _, pos := range p.vIndexToPositionMap {
+		// Map entry, pointer and the two slice headers.
+		size += 80 + uint64(len(pos.currentPeriod)+len(pos.nextPeriod))*8
+	}
+	return size
+}
+
 // NewSyncCommittee initializes and returns a new SyncCommitteeCache.
 func NewSyncCommittee() *SyncCommitteeCache {
-	c := &SyncCommitteeCache{cleared: &atomic.Uint64{}}
+	c := &SyncCommitteeCache{cleared: &atomic.Uint64{}, maxBytes: DefaultSyncCommitteeCacheSize}
 	c.Clear()
 	return c
 }
@@ -64,7 +75,15 @@ func (s *SyncCommitteeCache) Clear() {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	s.cleared.Add(1)
-	s.cache = cache.NewFIFO(keyFn)
+	s.cache = sized.New[[32]byte, *syncCommitteeIndexPosition]("sync_committee", s.maxBytes, syncCommitteeIndexPositionSize)
+}
+
+// SetMaxBytes sets the memory limit of the sync committee cache, in bytes.
+func (s *SyncCommitteeCache) SetMaxBytes(maxBytes uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.maxBytes = maxBytes
+	s.cache.Resize(maxBytes)
 }
 
 // CurrentPeriodIndexPosition returns current period index position of a validator index with respect with
@@ -109,18 +128,11 @@ func (s *SyncCommitteeCache) NextPeriodIndexPosition(root [32]byte, valIdx primi
 func (s *SyncCommitteeCache) idxPositionInCommittee(
 	root [32]byte, valIdx primitives.ValidatorIndex,
 ) (*positionInCommittee, error) {
-	obj, exists, err := s.cache.GetByKey(key(root))
-	if err != nil {
-		return nil, err
-	}
+	item, exists := s.cache.Get(root)
 	if !exists {
 		SyncCommitteeCacheMiss.Inc()
 		return nil, ErrNonExistingSyncCommitteeKey
 	}
-	item, ok := obj.(*syncCommitteeIndexPosition)
-	if !ok {
-		return nil, errNotSyncCommitteeIndexPosition
-	}
 	idxInCommittee, ok := item.vIndexToPositionMap[valIdx]
 	if !ok {
 		SyncCommitteeCacheMiss.Inc()
@@ -182,25 +194,10 @@ func (s *SyncCommitteeCache) UpdatePositionsInCommittee(syncCommitteeBoundaryRoo
 		return nil
 	}
 
-	if err := s.cache.Add(&syncCommitteeIndexPosition{
+	s.cache.Add(syncCommitteeBoundaryRoot, &syncCommitteeIndexPosition{
 		currentSyncCommitteeRoot: syncCommitteeBoundaryRoot,
 		vIndexToPositionMap:      positionsMap,
-	}); err != nil {
-		return err
-	}
-	trim(s.cache, maxSyncCommitteeSize)
+	})
 
 	return nil
 }
-
-// Given the `syncCommitteeIndexPosition` object, this returns the key of the object.
-// The key is the `currentSyncCommitteeRoot` within the field.
-// Error gets returned if input does not comply with `currentSyncCommitteeRoot` object.
-func keyFn(obj interface{}) (string, error) {
-	info, ok := obj.(*syncCommitteeIndexPosition)
-	if !ok {
-		return "", errNotSyncCommitteeIndexPosition
-	}
-
-	return string(info.currentSyncCommitteeRoot[:]), nil
-}
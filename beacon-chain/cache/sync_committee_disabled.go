@@ -31,6 +31,11 @@ func (s *FakeSyncCommitteeCache) UpdatePositionsInCommittee(syncCommitteeBoundar
 	return nil
 }
 
+// SetMaxBytes -- fake.
+func (s *FakeSyncCommitteeCache) SetMaxBytes(uint64) {
+	return
+}
+
 // Clear -- fake.
 func (s *FakeSyncCommitteeCache) Clear() {
 	return
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+var (
+	// SyncContributionCacheMiss tracks the number of contributions aggregated from scratch.
+	SyncContributionCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "sync_contribution_cache_miss_total",
+		Help: "The number of sync committee contributions aggregated from scratch.",
+	})
+	// SyncContributionCacheHit tracks the number of contributions built upon a cached partial aggregate.
+	SyncContributionCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "sync_contribution_cache_hit_total",
+		Help: "The number of sync committee contributions built upon a cached partial aggregate.",
+	})
+)
+
+// SyncContribution is the partial aggregate of the sync committee messages of a subcommittee.
+type SyncContribution struct {
+	// Signature is nil until a message of the subcommittee is aggregated.
+	Signature bls.Signature
+	Bits      []byte
+	// Aggregated holds the validators whose messages were processed, whether or not they are in the subcommittee.
+	Aggregated map[primitives.ValidatorIndex]bool
+}
+
+// Copy returns a deep copy of the contribution, except for the immutable signature.
+func (c *SyncContribution) Copy() *SyncContribution {
+	aggregated := make(map[primitives.ValidatorIndex]bool, len(c.Aggregated))
+	for idx := range c.Aggregated {
+		aggregated[idx] = true
+	}
+	return &SyncContribution{
+		Signature:  c.Signature,
+		Bits:       bytesutil.SafeCopyBytes(c.Bits),
+		Aggregated: aggregated,
+	}
+}
+
+type syncContributionKey struct {
+	slot      primitives.Slot
+	subnetId  uint64
+	blockRoot [32]byte
+}
+
+// SyncContributionCache caches the partial aggregates of the sync committee messages of each subcommittee, so that
+// the aggregators of a subcommittee reuse the aggregation work and only aggregate the messages received since.
+// Only the contributions of the latest slot are kept.
+type SyncContributionCache struct {
+	lock          sync.Mutex
+	slot          primitives.Slot
+	contributions map[syncContributionKey]*SyncContribution
+}
+
+// NewSyncContributionCache creates a new sync contribution cache.
+func NewSyncContributionCache() *SyncContributionCache {
+	return &SyncContributionCache{contributions: make(map[syncContributionKey]*SyncContribution)}
+}
+
+// Get returns a copy of the cached contribution of the subcommittee to the block root at the slot, or nil.
+func (c *SyncContributionCache) Get(slot primitives.Slot, subnetId uint64, blockRoot []byte) *SyncContribution {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	contribution, ok := c.contributions[syncContributionKey{slot: slot, subnetId: subnetId, blockRoot: bytesutil.ToBytes32(blockRoot)}]
+	if !ok {
+		SyncContributionCacheMiss.Inc()
+		return nil
+	}
+	SyncContributionCacheHit.Inc()
+	return contribution.Copy()
+}
+
+// Put caches the contribution of the subcommittee to the block root at the slot. Contributions of earlier slots are
+// evicted, and contributions of a slot older than the latest one are ignored.
+func (c *SyncContributionCache) Put(slot primitives.Slot, subnetId uint64, blockRoot []byte, contribution *SyncContribution) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if slot < c.slot {
+		return
+	}
+	if slot > c.slot {
+		c.slot = slot
+		c.contributions = make(map[syncContributionKey]*SyncContribution)
+	}
+	key := syncContributionKey{slot: slot, subnetId: subnetId, blockRoot: bytesutil.ToBytes32(blockRoot)}
+	existing, ok := c.contributions[key]
+	// Keep the most complete aggregate when concurrent aggregators race.
+	if ok && len(existing.Aggregated) > len(contribution.Aggregated) {
+		return
+	}
+	c.contributions[key] = contribution.Copy()
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSyncContributionCache_PutGet(t *testing.T) {
+	c := NewSyncContributionCache()
+	root := [32]byte{'a'}
+	assert.Equal(t, (*SyncContribution)(nil), c.Get(1, 0, root[:]))
+
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	contribution := &SyncContribution{
+		Signature:  key.Sign([]byte{'a'}),
+		Bits:       []byte{0b1},
+		Aggregated: map[primitives.ValidatorIndex]bool{3: true},
+	}
+	c.Put(1, 0, root[:], contribution)
+	got := c.Get(1, 0, root[:])
+	require.NotNil(t, got)
+	assert.DeepEqual(t, contribution.Bits, got.Bits)
+	assert.DeepEqual(t, contribution.Aggregated, got.Aggregated)
+	assert.Equal(t, contribution.Signature, got.Signature)
+	assert.Equal(t, (*SyncContribution)(nil), c.Get(1, 1, root[:]))
+	assert.Equal(t, (*SyncContribution)(nil), c.Get(1, 0, make([]byte, 32)))
+
+	// Modifying the returned contribution leaves the cache untouched.
+	got.Bits[0] = 0b11
+	got.Aggregated[4] = true
+	got = c.Get(1, 0, root[:])
+	assert.DeepEqual(t, []byte{0b1}, got.Bits)
+	assert.Equal(t, 1, len(got.Aggregated))
+}
+
+func TestSyncContributionCache_KeepsMostComplete(t *testing.T) {
+	c := NewSyncContributionCache()
+	root := [32]byte{'a'}
+	c.Put(1, 0, root[:], &SyncContribution{Bits: []byte{0b11}, Aggregated: map[primitives.ValidatorIndex]bool{1: true, 2: true}})
+	c.Put(1, 0, root[:], &SyncContribution{Bits: []byte{0b1}, Aggregated: map[primitives.ValidatorIndex]bool{1: true}})
+	assert.DeepEqual(t, []byte{0b11}, c.Get(1, 0, root[:]).Bits)
+}
+
+func TestSyncContributionCache_EvictsEarlierSlots(t *testing.T) {
+	c := NewSyncContributionCache()
+	root := [32]byte{'a'}
+	c.Put(1, 0, root[:], &SyncContribution{Bits: []byte{0b1}, Aggregated: map[primitives.ValidatorIndex]bool{}})
+	c.Put(2, 0, root[:], &SyncContribution{Bits: []byte{0b1}, Aggregated: map[primitives.ValidatorIndex]bool{}})
+	assert.Equal(t, (*SyncContribution)(nil), c.Get(1, 0, root[:]))
+	require.NotNil(t, c.Get(2, 0, root[:]))
+
+	// Contributions of an earlier slot are ignored.
+	c.Put(1, 0, root[:], &SyncContribution{Bits: []byte{0b1}, Aggregated: map[primitives.ValidatorIndex]bool{}})
+	assert.Equal(t, (*SyncContribution)(nil), c.Get(1, 0, root[:]))
+}
@@ -0,0 +1,8 @@
+/*
+Package clockskew defines a runtime service which estimates the skew of the local clock, from the arrival times of
+the gossip blocks relative to the start of their slots and, optionally, from NTP queries. A node whose clock is
+off by more than a few seconds performs its duties at the wrong time, which can get its validators penalized or,
+at worst, slashed, so the service warns loudly when the skew exceeds a threshold and can hold the duties served by
+the node until the clock is fixed.
+*/
+package clockskew
@@ -0,0 +1,5 @@
+package clockskew
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "clock-skew")
@@ -0,0 +1,29 @@
+package clockskew
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	skewGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "clock_skew_seconds",
+		Help: "The estimated skew of the local clock, positive when it is ahead, by source of the estimate",
+	}, []string{"source"})
+	skewEstimateGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "clock_skew_estimate_seconds",
+		Help: "The estimated skew of the local clock used to check the threshold, positive when it is ahead",
+	})
+	skewExceededGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "clock_skew_threshold_exceeded",
+		Help: "1 when the estimated skew of the local clock exceeds the threshold, 0 otherwise",
+	})
+	ntpQueriesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "clock_skew_ntp_queries_total",
+		Help: "The number of NTP queries made to estimate the skew of the local clock, by result",
+	}, []string{"result"})
+	heldDutiesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "clock_skew_held_duties_total",
+		Help: "The number of duty requests rejected because the skew of the local clock exceeds the threshold",
+	})
+)
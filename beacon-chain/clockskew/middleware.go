@@ -0,0 +1,70 @@
+package clockskew
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const heldMessage = "Duties are held because the clock of the node is skewed"
+
+// dutyPaths are the prefixes of the HTTP endpoints producing the data a validator signs.
+var dutyPaths = []string{
+	"/eth/v1/validator/attestation_data",
+	"/eth/v1/validator/aggregate_attestation",
+	"/eth/v2/validator/aggregate_attestation",
+	"/eth/v1/validator/sync_committee_contribution",
+	"/eth/v2/validator/blocks/",
+	"/eth/v3/validator/blocks/",
+	"/eth/v1/validator/blinded_blocks/",
+}
+
+// dutyMethods are the gRPC methods producing the data a validator signs.
+var dutyMethods = map[string]bool{
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/GetBeaconBlock":                       true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/GetAttestationData":                   true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/SubmitAggregateSelectionProof":        true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/SubmitAggregateSelectionProofElectra": true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/GetSyncMessageBlockRoot":              true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/GetSyncCommitteeContribution":         true,
+}
+
+// Middleware rejects the HTTP duty requests with a 503 status while the duties are held.
+func (s *Service) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.HoldingDuties() && isDutyPath(r.URL.Path) {
+			heldDutiesCounter.Inc()
+			httputil.HandleError(w, heldMessage, http.StatusServiceUnavailable)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// UnaryServerInterceptor rejects the gRPC duty calls while the duties are held.
+func (s *Service) UnaryServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if dutyMethods[info.FullMethod] && s.HoldingDuties() {
+		heldDutiesCounter.Inc()
+		return nil, status.Error(codes.Unavailable, heldMessage)
+	}
+	return handler(ctx, req)
+}
+
+func isDutyPath(path string) bool {
+	for _, p := range dutyPaths {
+		if strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+	return false
+}
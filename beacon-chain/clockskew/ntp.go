@@ -0,0 +1,83 @@
+package clockskew
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ntpEpochOffset is the number of seconds from the NTP epoch, in 1900, to the Unix epoch.
+	ntpEpochOffset = 2208988800
+	ntpPacketSize  = 48
+	ntpPort        = "123"
+	// ntpClientHeader is the leap indicator 0, the version 4 and the client mode.
+	ntpClientHeader = 0x23
+	ntpServerMode   = 4
+)
+
+// queryNTP returns the skew of the local clock measured against the NTP server, positive when the local clock is
+// ahead. The server is a host, with an optional port.
+func queryNTP(ctx context.Context, server string, timeout time.Duration) (time.Duration, error) {
+	addr := server
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		addr = net.JoinHostPort(server, ntpPort)
+	}
+	d := &net.Dialer{Timeout: timeout}
+	conn, err := d.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not dial NTP server %s", server)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	req := make([]byte, ntpPacketSize)
+	req[0] = ntpClientHeader
+	sent := time.Now()
+	// The server echoes the transmit timestamp of the request as the origin timestamp of the response.
+	putNTPTime(req[40:48], sent)
+	if _, err := conn.Write(req); err != nil {
+		return 0, errors.Wrapf(err, "could not query NTP server %s", server)
+	}
+	resp := make([]byte, ntpPacketSize)
+	n, err := conn.Read(resp)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not read response of NTP server %s", server)
+	}
+	received := time.Now()
+	if n < ntpPacketSize {
+		return 0, errors.Errorf("short response of NTP server %s", server)
+	}
+	if resp[0]&0x7 != ntpServerMode {
+		return 0, errors.Errorf("unexpected mode %d in response of NTP server %s", resp[0]&0x7, server)
+	}
+	if stratum := resp[1]; stratum == 0 || stratum > 15 {
+		return 0, errors.Errorf("NTP server %s is not synchronized", server)
+	}
+	if !bytes.Equal(resp[24:32], req[40:48]) {
+		return 0, errors.Errorf("response of NTP server %s does not match the request", server)
+	}
+	serverReceived, serverSent := ntpTime(resp[32:40]), ntpTime(resp[40:48])
+	// The offset of the server clock is averaged over both legs, so that the network delay cancels out.
+	offset := (serverReceived.Sub(sent) + serverSent.Sub(received)) / 2
+	return -offset, nil
+}
+
+func putNTPTime(b []byte, t time.Time) {
+	binary.BigEndian.PutUint32(b[0:4], uint32(t.Unix()+ntpEpochOffset))
+	binary.BigEndian.PutUint32(b[4:8], uint32((uint64(t.Nanosecond())<<32)/uint64(time.Second)))
+}
+
+func ntpTime(b []byte) time.Time {
+	secs := int64(binary.BigEndian.Uint32(b[0:4])) - ntpEpochOffset
+	nanos := (uint64(binary.BigEndian.Uint32(b[4:8])) * uint64(time.Second)) >> 32
+	return time.Unix(secs, int64(nanos))
+}
@@ -0,0 +1,69 @@
+package clockskew
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// serveNTP answers a single NTP request with a clock offset by the given duration.
+func serveNTP(t *testing.T, offset time.Duration, stratum byte) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+	go func() {
+		req := make([]byte, ntpPacketSize)
+		_, addr, err := conn.ReadFrom(req)
+		if err != nil {
+			return
+		}
+		resp := make([]byte, ntpPacketSize)
+		resp[0] = 0x24 // Version 4, server mode.
+		resp[1] = stratum
+		copy(resp[24:32], req[40:48])
+		putNTPTime(resp[32:40], time.Now().Add(offset))
+		putNTPTime(resp[40:48], time.Now().Add(offset))
+		_, _ = conn.WriteTo(resp, addr)
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestQueryNTP(t *testing.T) {
+	server := serveNTP(t, -3*time.Second, 2)
+	skew, err := queryNTP(context.Background(), server, time.Second)
+	require.NoError(t, err)
+	// The local clock is 3 seconds ahead of the server, give or take the time of the exchange.
+	assert.Equal(t, true, skew > 2900*time.Millisecond && skew < 3100*time.Millisecond, "unexpected skew %v", skew)
+}
+
+func TestQueryNTP_Unsynchronized(t *testing.T) {
+	server := serveNTP(t, 0, 0)
+	_, err := queryNTP(context.Background(), server, time.Second)
+	assert.ErrorContains(t, "is not synchronized", err)
+}
+
+func TestNTPTime(t *testing.T) {
+	now := time.Unix(1700000000, 123456789)
+	b := make([]byte, 8)
+	putNTPTime(b, now)
+	assert.Equal(t, true, ntpTime(b).Sub(now).Abs() < time.Microsecond)
+}
+
+func TestService_NTPEstimate(t *testing.T) {
+	s := NewService(context.Background(), &Config{
+		NTPServers: []string{serveNTP(t, 2*time.Second, 1)},
+		Threshold:  time.Second,
+		HoldDuties: true,
+	})
+	s.check(time.Now())
+	require.NotNil(t, s.Estimate())
+	assert.Equal(t, SourceNTP, s.Estimate().Source)
+	assert.Equal(t, true, s.Estimate().Skew < -1900*time.Millisecond)
+	assert.Equal(t, true, s.HoldingDuties())
+}
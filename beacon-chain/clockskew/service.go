@@ -0,0 +1,233 @@
+package clockskew
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// arrivalWindow is the number of the latest gossip block arrivals the skew is estimated from.
+	arrivalWindow = 64
+	// minArrivals is the number of gossip block arrivals needed to estimate the skew from them.
+	minArrivals = 16
+	// arrivalPercentile of the arrivals is the one of the blocks which propagated the fastest, leaving out the
+	// outliers, such as the blocks of proposers with a skewed clock.
+	arrivalPercentile = 0.1
+	// blockPropagation is the expected time for the fastest blocks to reach the node after the start of their slot.
+	blockPropagation = 250 * time.Millisecond
+	ntpTimeout       = 5 * time.Second
+)
+
+// Sources of the skew estimates.
+const (
+	SourceNTP    = "ntp"
+	SourceBlocks = "blocks"
+)
+
+// ArrivalRecorder records the arrival times of the gossip blocks, relative to the start of their slots.
+type ArrivalRecorder interface {
+	RecordBlockArrival(sinceSlotStart time.Duration)
+}
+
+// Config of the clock skew service.
+type Config struct {
+	// NTPServers are queried to estimate the skew, which is estimated from the gossip block arrivals only when empty
+	// or when none of them answers.
+	NTPServers []string
+	// Threshold is the skew, either way, above which the service warns and holds the duties if enabled.
+	Threshold time.Duration
+	// HoldDuties makes the node reject the duty requests of its validators while the skew exceeds the threshold.
+	HoldDuties bool
+	// CheckInterval is the time between two estimates of the skew.
+	CheckInterval time.Duration
+}
+
+// Estimate of the skew of the local clock.
+type Estimate struct {
+	// Skew is positive when the local clock is ahead.
+	Skew   time.Duration
+	Source string
+	Time   time.Time
+}
+
+// Service estimates the skew of the local clock.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// Locks access to arrivals, next, latest and exceeded.
+	sync.RWMutex
+	arrivals []time.Duration
+	next     int
+	latest   *Estimate
+	exceeded bool
+}
+
+var _ ArrivalRecorder = (*Service)(nil)
+
+// NewService returns a clock skew service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:      cfg,
+		ctx:      ctx,
+		cancel:   cancel,
+		arrivals: make([]time.Duration, 0, arrivalWindow),
+	}
+}
+
+// Start the clock skew service.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"ntpServers": s.cfg.NTPServers,
+		"threshold":  s.cfg.Threshold,
+		"holdDuties": s.cfg.HoldDuties,
+	}).Info("Starting service")
+	go s.run()
+}
+
+// Stop the clock skew service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the clock skew service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// RecordBlockArrival records the arrival time of a gossip block, relative to the start of its slot.
+func (s *Service) RecordBlockArrival(sinceSlotStart time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+	if len(s.arrivals) < arrivalWindow {
+		s.arrivals = append(s.arrivals, sinceSlotStart)
+		return
+	}
+	s.arrivals[s.next] = sinceSlotStart
+	s.next = (s.next + 1) % arrivalWindow
+}
+
+// Estimate returns the latest estimate of the skew, or nil if it could not be estimated yet.
+func (s *Service) Estimate() *Estimate {
+	s.RLock()
+	defer s.RUnlock()
+	return s.latest
+}
+
+// HoldingDuties returns true when the duties are held because the skew exceeds the threshold.
+func (s *Service) HoldingDuties() bool {
+	if !s.cfg.HoldDuties {
+		return false
+	}
+	s.RLock()
+	defer s.RUnlock()
+	return s.exceeded
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.check(time.Now())
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// check estimates the skew, preferably from the NTP servers, and compares it to the threshold.
+func (s *Service) check(now time.Time) {
+	estimate := s.ntpEstimate(now)
+	if estimate == nil {
+		estimate = s.blocksEstimate(now)
+	}
+	if estimate == nil {
+		return
+	}
+	skewEstimateGauge.Set(estimate.Skew.Seconds())
+	exceeded := estimate.Skew > s.cfg.Threshold || estimate.Skew < -s.cfg.Threshold
+
+	s.Lock()
+	wasExceeded := s.exceeded
+	s.latest = estimate
+	s.exceeded = exceeded
+	s.Unlock()
+
+	fields := logrus.Fields{
+		"skew":      estimate.Skew,
+		"source":    estimate.Source,
+		"threshold": s.cfg.Threshold,
+	}
+	switch {
+	case exceeded:
+		skewExceededGauge.Set(1)
+		msg := "Local clock is skewed, validators risk missing their duties or being slashed: check the time synchronization of the host"
+		if s.cfg.HoldDuties {
+			msg += ", duties are held until it is fixed"
+		}
+		log.WithFields(fields).Error(msg)
+	case wasExceeded:
+		skewExceededGauge.Set(0)
+		log.WithFields(fields).Info("Local clock is back within the skew threshold")
+	default:
+		skewExceededGauge.Set(0)
+	}
+}
+
+// ntpEstimate returns the median of the skews measured against the NTP servers, or nil if none answered.
+func (s *Service) ntpEstimate(now time.Time) *Estimate {
+	if len(s.cfg.NTPServers) == 0 {
+		return nil
+	}
+	skews := make([]time.Duration, 0, len(s.cfg.NTPServers))
+	for _, server := range s.cfg.NTPServers {
+		skew, err := queryNTP(s.ctx, server, ntpTimeout)
+		if err != nil {
+			ntpQueriesCounter.WithLabelValues("failure").Inc()
+			log.WithError(err).Debug("Could not query NTP server")
+			continue
+		}
+		ntpQueriesCounter.WithLabelValues("success").Inc()
+		skews = append(skews, skew)
+	}
+	if len(skews) == 0 {
+		log.WithField("ntpServers", s.cfg.NTPServers).Warn("None of the NTP servers answered, estimating the clock skew from the block arrivals")
+		return nil
+	}
+	skew := percentile(skews, 0.5)
+	skewGauge.WithLabelValues(SourceNTP).Set(skew.Seconds())
+	return &Estimate{Skew: skew, Source: SourceNTP, Time: now}
+}
+
+// blocksEstimate returns the skew estimated from the arrival times of the fastest gossip blocks, or nil if not
+// enough blocks arrived yet. A block is published at the start of its slot, so that its arrival time relative to
+// the start of the slot is its propagation time plus the skew of the local clock.
+func (s *Service) blocksEstimate(now time.Time) *Estimate {
+	s.RLock()
+	arrivals := make([]time.Duration, len(s.arrivals))
+	copy(arrivals, s.arrivals)
+	s.RUnlock()
+	if len(arrivals) < minArrivals {
+		return nil
+	}
+	skew := percentile(arrivals, arrivalPercentile) - blockPropagation
+	skewGauge.WithLabelValues(SourceBlocks).Set(skew.Seconds())
+	return &Estimate{Skew: skew, Source: SourceBlocks, Time: now}
+}
+
+// percentile sorts the durations in place and returns the one at the percentile.
+func percentile(durations []time.Duration, p float64) time.Duration {
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+	return durations[int(p*float64(len(durations)-1))]
+}
@@ -0,0 +1,81 @@
+package clockskew
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_BlocksEstimate(t *testing.T) {
+	s := NewService(context.Background(), &Config{Threshold: time.Second, HoldDuties: true})
+	now := time.Now()
+
+	for i := 0; i < minArrivals-1; i++ {
+		s.RecordBlockArrival(3 * time.Second)
+	}
+	s.check(now)
+	assert.Equal(t, (*Estimate)(nil), s.Estimate())
+	assert.Equal(t, false, s.HoldingDuties())
+
+	// The clock is ahead by 3 seconds minus the expected propagation.
+	s.RecordBlockArrival(3 * time.Second)
+	s.check(now)
+	require.NotNil(t, s.Estimate())
+	assert.Equal(t, 3*time.Second-blockPropagation, s.Estimate().Skew)
+	assert.Equal(t, SourceBlocks, s.Estimate().Source)
+	assert.Equal(t, true, s.HoldingDuties())
+
+	// The window only keeps the latest arrivals.
+	for i := 0; i < arrivalWindow; i++ {
+		s.RecordBlockArrival(time.Duration(i) * 10 * time.Millisecond)
+	}
+	assert.Equal(t, arrivalWindow, len(s.arrivals))
+	s.check(now)
+	assert.Equal(t, 60*time.Millisecond-blockPropagation, s.Estimate().Skew)
+	assert.Equal(t, false, s.HoldingDuties())
+}
+
+func TestService_HoldDutiesDisabled(t *testing.T) {
+	s := NewService(context.Background(), &Config{Threshold: time.Second})
+	for i := 0; i < minArrivals; i++ {
+		s.RecordBlockArrival(-5 * time.Second)
+	}
+	s.check(time.Now())
+	assert.Equal(t, -5*time.Second-blockPropagation, s.Estimate().Skew)
+	assert.Equal(t, false, s.HoldingDuties())
+}
+
+func TestService_Middleware(t *testing.T) {
+	s := NewService(context.Background(), &Config{Threshold: time.Second, HoldDuties: true})
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(path string) int {
+		writer := httptest.NewRecorder()
+		s.Middleware(next).ServeHTTP(writer, httptest.NewRequest(http.MethodGet, "http://example.com"+path, nil))
+		return writer.Code
+	}
+	assert.Equal(t, http.StatusOK, serve("/eth/v1/validator/attestation_data"))
+
+	s.exceeded = true
+	assert.Equal(t, http.StatusServiceUnavailable, serve("/eth/v1/validator/attestation_data"))
+	assert.Equal(t, http.StatusServiceUnavailable, serve("/eth/v3/validator/blocks/123"))
+	assert.Equal(t, http.StatusOK, serve("/eth/v1/node/health"))
+
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	_, err := s.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetAttestationData"}, handler)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	resp, err := s.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetDuties"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
@@ -120,6 +120,28 @@ func VerifyBlockSignatureUsingCurrentFork(beaconState state.ReadOnlyBeaconState,
 	})
 }
 
+// BlockSignatureBatchUsingCurrentFork retrieves the proposer signature batch of a beacon block. Like
+// VerifyBlockSignatureUsingCurrentFork, the fork data is retrieved via the epoch of the block instead of the state.
+func BlockSignatureBatchUsingCurrentFork(beaconState state.ReadOnlyBeaconState, blk interfaces.ReadOnlySignedBeaconBlock, blkRoot [32]byte) (*bls.SignatureBatch, error) {
+	currentEpoch := slots.ToEpoch(blk.Block().Slot())
+	fork, err := forks.Fork(currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := signing.Domain(fork, currentEpoch, params.BeaconConfig().DomainBeaconProposer, beaconState.GenesisValidatorsRoot())
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := beaconState.ValidatorAtIndex(blk.Block().ProposerIndex())
+	if err != nil {
+		return nil, err
+	}
+	sig := blk.Signature()
+	return signing.BlockSignatureBatch(proposer.PublicKey, sig[:], domain, func() ([32]byte, error) {
+		return blkRoot, nil
+	})
+}
+
 // BlockSignatureBatch retrieves the block signature batch from the provided block and its corresponding state.
 func BlockSignatureBatch(beaconState state.ReadOnlyBeaconState,
 	proposerIndex primitives.ValidatorIndex,
@@ -88,4 +88,10 @@ func TestVerifyBlockSignatureUsingCurrentFork(t *testing.T) {
 	wsb, err := consensusblocks.NewSignedBeaconBlock(altairBlk)
 	require.NoError(t, err)
 	assert.NoError(t, blocks.VerifyBlockSignatureUsingCurrentFork(bState, wsb, blkRoot))
+
+	set, err := blocks.BlockSignatureBatchUsingCurrentFork(bState, wsb, blkRoot)
+	require.NoError(t, err)
+	verified, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, verified)
 }
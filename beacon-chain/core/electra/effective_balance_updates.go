@@ -1,12 +1,10 @@
 package electra
 
 import (
-	"fmt"
-
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
-	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 // ProcessEffectiveBalanceUpdates processes effective balance updates during epoch processing.
@@ -36,30 +34,18 @@ func ProcessEffectiveBalanceUpdates(st state.BeaconState) error {
 	downwardThreshold := hysteresisInc * params.BeaconConfig().HysteresisDownwardMultiplier
 	upwardThreshold := hysteresisInc * params.BeaconConfig().HysteresisUpwardMultiplier
 
-	bals := st.Balances()
-
 	// Update effective balances with hysteresis.
-	validatorFunc := func(idx int, val state.ReadOnlyValidator) (newVal *ethpb.Validator, err error) {
-		if val == nil {
-			return nil, fmt.Errorf("validator %d is nil in state", idx)
-		}
-		if idx >= len(bals) {
-			return nil, fmt.Errorf("validator index exceeds validator length in state %d >= %d", idx, len(st.Balances()))
-		}
-		balance := bals[idx]
-
+	newEffectiveBalance := func(val state.ReadOnlyValidator, balance uint64) (uint64, bool) {
 		effectiveBalanceLimit := params.BeaconConfig().MinActivationBalance
 		if helpers.HasCompoundingWithdrawalCredential(val) {
 			effectiveBalanceLimit = params.BeaconConfig().MaxEffectiveBalanceElectra
 		}
 
 		if balance+downwardThreshold < val.EffectiveBalance() || val.EffectiveBalance()+upwardThreshold < balance {
-			effectiveBal := min(balance-balance%effBalanceInc, effectiveBalanceLimit)
-			newVal = val.Copy()
-			newVal.EffectiveBalance = effectiveBal
+			return min(balance-balance%effBalanceInc, effectiveBalanceLimit), true
 		}
-		return newVal, nil
+		return 0, false
 	}
 
-	return st.ApplyToEveryValidator(validatorFunc)
+	return precompute.ApplyEffectiveBalanceUpdates(st, newEffectiveBalance)
 }
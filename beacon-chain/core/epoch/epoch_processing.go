@@ -10,6 +10,7 @@ import (
 	"sort"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/validators"
@@ -265,32 +266,19 @@ func ProcessEffectiveBalanceUpdates(st state.BeaconState) (state.BeaconState, er
 	downwardThreshold := hysteresisInc * params.BeaconConfig().HysteresisDownwardMultiplier
 	upwardThreshold := hysteresisInc * params.BeaconConfig().HysteresisUpwardMultiplier
 
-	bals := st.Balances()
-
 	// Update effective balances with hysteresis.
-	validatorFunc := func(idx int, val state.ReadOnlyValidator) (newVal *ethpb.Validator, err error) {
-		if val == nil {
-			return nil, fmt.Errorf("validator %d is nil in state", idx)
-		}
-		if idx >= len(bals) {
-			return nil, fmt.Errorf("validator index exceeds validator length in state %d >= %d", idx, len(st.Balances()))
-		}
-		balance := bals[idx]
-
+	newEffectiveBalance := func(val state.ReadOnlyValidator, balance uint64) (uint64, bool) {
 		if balance+downwardThreshold < val.EffectiveBalance() || val.EffectiveBalance()+upwardThreshold < balance {
 			effectiveBal := maxEffBalance
 			if effectiveBal > balance-balance%effBalanceInc {
 				effectiveBal = balance - balance%effBalanceInc
 			}
-			if effectiveBal != val.EffectiveBalance() {
-				newVal = val.Copy()
-				newVal.EffectiveBalance = effectiveBal
-			}
+			return effectiveBal, effectiveBal != val.EffectiveBalance()
 		}
-		return
+		return 0, false
 	}
 
-	if err := st.ApplyToEveryValidator(validatorFunc); err != nil {
+	if err := precompute.ApplyEffectiveBalanceUpdates(st, newEffectiveBalance); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,90 @@
+package precompute
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"golang.org/x/sync/errgroup"
+)
+
+// minValidatorsToParallelize is the registry size from which per validator
+// epoch processing is split across goroutines. Below it, the cost of
+// starting them outweighs the gain.
+const minValidatorsToParallelize = 16384
+
+// ForEachValidatorChunk splits the validator indices [0, n) into contiguous
+// chunks, one per available CPU, and calls f concurrently on each chunk with
+// its bounds [start, end). f must only write to the indices of its own chunk.
+// Small registries are processed as a single chunk on the calling goroutine.
+// The first error returned by f is returned.
+func ForEachValidatorChunk(n int, f func(start, end int) error) error {
+	workers := runtime.GOMAXPROCS(0)
+	if n < minValidatorsToParallelize || workers == 1 {
+		return f(0, n)
+	}
+	chunkSize := (n + workers - 1) / workers
+	var g errgroup.Group
+	for start := 0; start < n; start += chunkSize {
+		end := min(start+chunkSize, n)
+		g.Go(func() error {
+			return f(start, end)
+		})
+	}
+	return g.Wait()
+}
+
+type effectiveBalanceUpdate struct {
+	idx primitives.ValidatorIndex
+	val state.ReadOnlyValidator
+	bal uint64
+}
+
+// ApplyEffectiveBalanceUpdates calls newEffectiveBalance concurrently for every validator with its
+// current balance, and writes the validators whose effective balance changes back to the state.
+// newEffectiveBalance returns the new effective balance of the validator and whether it must be updated.
+func ApplyEffectiveBalanceUpdates(
+	st state.BeaconState,
+	newEffectiveBalance func(val state.ReadOnlyValidator, balance uint64) (uint64, bool),
+) error {
+	bals := st.Balances()
+	numVals := st.NumValidators()
+	if numVals > len(bals) {
+		return fmt.Errorf("state has %d validators but only %d balances", numVals, len(bals))
+	}
+
+	var lock sync.Mutex
+	var updates []effectiveBalanceUpdate
+	err := ForEachValidatorChunk(numVals, func(start, end int) error {
+		var chunkUpdates []effectiveBalanceUpdate
+		for i := start; i < end; i++ {
+			idx := primitives.ValidatorIndex(i)
+			val, err := st.ValidatorAtIndexReadOnly(idx)
+			if err != nil {
+				return errors.Wrapf(err, "could not get validator %d", i)
+			}
+			if bal, ok := newEffectiveBalance(val, bals[i]); ok {
+				chunkUpdates = append(chunkUpdates, effectiveBalanceUpdate{idx: idx, val: val, bal: bal})
+			}
+		}
+		lock.Lock()
+		updates = append(updates, chunkUpdates...)
+		lock.Unlock()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, u := range updates {
+		newVal := u.val.Copy()
+		newVal.EffectiveBalance = u.bal
+		if err := st.UpdateValidatorAtIndex(u.idx, newVal); err != nil {
+			return errors.Wrapf(err, "could not update validator %d", u.idx)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package precompute_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestForEachValidatorChunk(t *testing.T) {
+	for _, n := range []int{0, 1, 1000, 100_003} {
+		visits := make([]int32, n)
+		require.NoError(t, precompute.ForEachValidatorChunk(n, func(start, end int) error {
+			for i := start; i < end; i++ {
+				atomic.AddInt32(&visits[i], 1)
+			}
+			return nil
+		}))
+		for i, v := range visits {
+			require.Equal(t, int32(1), v, "index %d visited %d times", i, v)
+		}
+	}
+
+	wantErr := errors.New("chunk failed")
+	err := precompute.ForEachValidatorChunk(100_000, func(start, end int) error {
+		if start == 0 {
+			return wantErr
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, wantErr)
+}
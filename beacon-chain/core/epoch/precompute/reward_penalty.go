@@ -42,18 +42,24 @@ func ProcessRewardsAndPenaltiesPrecompute(
 		return nil, errors.Wrap(err, "could not get proposer attestation delta")
 	}
 	validatorBals := state.Balances()
-	for i := 0; i < numOfVals; i++ {
-		vp[i].BeforeEpochTransitionBalance = validatorBals[i]
-
-		// Compute the post balance of the validator after accounting for the
-		// attester and proposer rewards and penalties.
-		validatorBals[i], err = helpers.IncreaseBalanceWithVal(validatorBals[i], attsRewards[i]+proposerRewards[i])
-		if err != nil {
-			return nil, err
+	err = ForEachValidatorChunk(numOfVals, func(start, end int) error {
+		for i := start; i < end; i++ {
+			vp[i].BeforeEpochTransitionBalance = validatorBals[i]
+
+			// Compute the post balance of the validator after accounting for the
+			// attester and proposer rewards and penalties.
+			bal, err := helpers.IncreaseBalanceWithVal(validatorBals[i], attsRewards[i]+proposerRewards[i])
+			if err != nil {
+				return err
+			}
+			validatorBals[i] = helpers.DecreaseBalanceWithVal(bal, attsPenalties[i])
+
+			vp[i].AfterEpochTransitionBalance = validatorBals[i]
 		}
-		validatorBals[i] = helpers.DecreaseBalanceWithVal(validatorBals[i], attsPenalties[i])
-
-		vp[i].AfterEpochTransitionBalance = validatorBals[i]
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if err := state.SetBalances(validatorBals); err != nil {
@@ -73,8 +79,14 @@ func AttestationsDelta(state state.ReadOnlyBeaconState, pBal *Balance, vp []*Val
 	finalizedEpoch := state.FinalizedCheckpointEpoch()
 
 	sqrtActiveCurrentEpoch := math.CachedSquareRoot(pBal.ActiveCurrentEpoch)
-	for i, v := range vp {
-		rewards[i], penalties[i] = attestationDelta(pBal, sqrtActiveCurrentEpoch, v, prevEpoch, finalizedEpoch)
+	err := ForEachValidatorChunk(len(vp), func(start, end int) error {
+		for i := start; i < end; i++ {
+			rewards[i], penalties[i] = attestationDelta(pBal, sqrtActiveCurrentEpoch, vp[i], prevEpoch, finalizedEpoch)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 	return rewards, penalties, nil
 }
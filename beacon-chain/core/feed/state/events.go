@@ -8,6 +8,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 )
 
 const (
@@ -33,6 +34,10 @@ const (
 	LightClientOptimisticUpdate
 	// PayloadAttributes events are fired upon a missed slot or new head.
 	PayloadAttributes
+	// DataAvailabilityChecked is sent when the blob sidecars of a block received in regular sync are all available.
+	DataAvailabilityChecked
+	// ProposerLookahead is sent when the proposer schedule of the next epoch is known.
+	ProposerLookahead
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -49,6 +54,60 @@ type BlockProcessedData struct {
 	Optimistic bool
 }
 
+// ReorgData is the data sent with Reorg events. It carries the standard chain_reorg event along with
+// additional details about the reorg, useful for analyzing why it happened.
+type ReorgData struct {
+	// Event is the chain_reorg event as defined by the beacon API.
+	Event *ethpbv1.EventChainReorg
+	// Distance is the number of blocks between the old head and the new head.
+	Distance uint64
+	// CommonAncestorRoot is the root of the latest block shared by the old and new chains.
+	CommonAncestorRoot [32]byte
+	// OldHeadProposer is the proposer of the old head block.
+	OldHeadProposer primitives.ValidatorIndex
+	// NewHeadProposer is the proposer of the new head block.
+	NewHeadProposer primitives.ValidatorIndex
+	// OldHeadWeight is the fork choice weight of the old head at the time of the reorg.
+	OldHeadWeight uint64
+	// NewHeadWeight is the fork choice weight of the new head at the time of the reorg.
+	NewHeadWeight uint64
+}
+
+// DataAvailabilityCheckedData is the data sent with DataAvailabilityChecked events. It describes how the blob
+// sidecars committed to by a block became available.
+type DataAvailabilityCheckedData struct {
+	// Slot is the slot of the block.
+	Slot primitives.Slot
+	// BlockRoot is the root of the block.
+	BlockRoot [32]byte
+	// Sidecars describes the arrival of each blob sidecar committed to by the block, in index order.
+	Sidecars []DataAvailabilitySidecar
+	// Completed is the time at which all the sidecars were available.
+	Completed time.Time
+	// BeforeAttestationDeadline is true if all the sidecars were available before the attestation deadline of the slot.
+	BeforeAttestationDeadline bool
+}
+
+// DataAvailabilitySidecar describes the arrival of a sidecar needed for the data availability of a block.
+type DataAvailabilitySidecar struct {
+	// Index is the index of the sidecar in the block.
+	Index uint64
+	// Source is the way the sidecar was obtained, such as gossip, req_resp or execution.
+	Source string
+	// Arrival is the time at which the sidecar was received, zero if it is unknown.
+	Arrival time.Time
+}
+
+// ProposerLookaheadData is the data sent with ProposerLookahead events.
+type ProposerLookaheadData struct {
+	// Epoch is the epoch of the proposer schedule.
+	Epoch primitives.Epoch
+	// DependentRoot is the root of the last block before the epoch, the schedule changes if it is reorged.
+	DependentRoot [32]byte
+	// Proposers are the indices of the proposers of each slot of the epoch, in slot order.
+	Proposers []primitives.ValidatorIndex
+}
+
 // ChainStartedData is the data sent with ChainStarted events.
 type ChainStartedData struct {
 	// StartTime is the time at which the chain started.
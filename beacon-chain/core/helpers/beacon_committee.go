@@ -23,6 +23,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
 )
 
 var (
@@ -107,6 +108,16 @@ func BeaconCommittees(ctx context.Context, state state.ReadOnlyBeaconState, slot
 			committees[idx] = committee
 			continue
 		}
+		if idx == 0 {
+			committee, err = committeeFromPersistedShuffle(ctx, slot, seed, idx)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not interface with committee cache")
+			}
+			if committee != nil {
+				committees[idx] = committee
+				continue
+			}
+		}
 
 		if len(activeIndices) == 0 {
 			activeIndices, err = ActiveValidatorIndices(ctx, state, epoch)
@@ -155,6 +166,13 @@ func BeaconCommitteeFromState(ctx context.Context, state state.ReadOnlyBeaconSta
 	if committee != nil {
 		return committee, nil
 	}
+	committee, err = committeeFromPersistedShuffle(ctx, slot, seed, committeeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not interface with committee cache")
+	}
+	if committee != nil {
+		return committee, nil
+	}
 
 	activeIndices, err := ActiveValidatorIndices(ctx, state, epoch)
 	if err != nil {
@@ -196,6 +214,13 @@ func BeaconCommittee(
 	if committee != nil {
 		return committee, nil
 	}
+	committee, err = committeeFromPersistedShuffle(ctx, slot, seed, committeeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not interface with committee cache")
+	}
+	if committee != nil {
+		return committee, nil
+	}
 
 	committeesPerSlot := SlotCommitteeCount(uint64(len(validatorIndices)))
 
@@ -384,11 +409,26 @@ func UpdateCommitteeCache(ctx context.Context, state state.ReadOnlyBeaconState,
 	if committeeCache.HasEntry(string(seed[:])) {
 		return nil
 	}
+	found, err := loadPersistedShuffle(ctx, seed)
+	if err != nil {
+		log.WithError(err).Debug("Could not load persisted committee shuffle")
+	}
+	if found {
+		return nil
+	}
 	shuffledIndices, err := ShuffledIndices(state, e)
 	if err != nil {
 		return err
 	}
+	if err := addShuffleToCommitteeCache(ctx, seed, shuffledIndices); err != nil {
+		return err
+	}
+	persistShuffle(seed, shuffledIndices)
+	return nil
+}
 
+// addShuffleToCommitteeCache adds the shuffled indices of a seed to the committee cache.
+func addShuffleToCommitteeCache(ctx context.Context, seed [32]byte, shuffledIndices []primitives.ValidatorIndex) error {
 	count := SlotCommitteeCount(uint64(len(shuffledIndices)))
 
 	// Store the sorted indices as well as shuffled indices. In current spec,
@@ -400,15 +440,12 @@ func UpdateCommitteeCache(ctx context.Context, state state.ReadOnlyBeaconState,
 		return sortedIndices[i] < sortedIndices[j]
 	})
 
-	if err := committeeCache.AddCommitteeShuffledList(ctx, &cache.Committees{
+	return committeeCache.AddCommitteeShuffledList(ctx, &cache.Committees{
 		ShuffledIndices: shuffledIndices,
 		CommitteeCount:  uint64(params.BeaconConfig().SlotsPerEpoch.Mul(count)),
 		Seed:            seed,
 		SortedIndices:   sortedIndices,
-	}); err != nil {
-		return err
-	}
-	return nil
+	})
 }
 
 // UpdateProposerIndicesInCache updates proposer indices entry of the committee cache.
@@ -480,6 +517,13 @@ func CompressCommitteeCache() {
 	committeeCache.CompressCommitteeCache()
 }
 
+// SetCacheSizes sets the memory limits, in bytes, of the committee, proposer indices and sync committee caches.
+func SetCacheSizes(committee, proposerIndices, syncCommittee uint64) {
+	committeeCache.SetMaxBytes(committee)
+	proposerIndicesCache.SetMaxBytes(proposerIndices)
+	syncCommitteeCache.SetMaxBytes(syncCommittee)
+}
+
 // ClearCache clears the beacon committee cache and sync committee cache.
 func ClearCache() {
 	committeeCache.Clear()
@@ -3,6 +3,7 @@ package helpers_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"strconv"
 	"testing"
 
@@ -447,6 +448,58 @@ func TestUpdateCommitteeCache_CanUpdate(t *testing.T) {
 	assert.Equal(t, params.BeaconConfig().TargetCommitteeSize, uint64(len(indices)), "Did not save correct indices lengths")
 }
 
+type mapShuffleStore map[[32]byte][]primitives.ValidatorIndex
+
+func (m mapShuffleStore) ShuffledIndices(seed [32]byte) ([]primitives.ValidatorIndex, error) {
+	indices, ok := m[seed]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return indices, nil
+}
+
+func (m mapShuffleStore) SaveShuffledIndices(seed [32]byte, indices []primitives.ValidatorIndex) error {
+	m[seed] = indices
+	return nil
+}
+
+func TestUpdateCommitteeCache_PersistsShuffle(t *testing.T) {
+	helpers.ClearCache()
+	store := mapShuffleStore{}
+	helpers.SetShuffleStore(store)
+	t.Cleanup(func() { helpers.SetShuffleStore(nil) })
+
+	validators := make([]*ethpb.Validator, params.BeaconConfig().MinGenesisActiveValidatorCount)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance: 1,
+		}
+	}
+	state, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{
+		Validators:  validators,
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	})
+	require.NoError(t, err)
+	e := time.CurrentEpoch(state)
+	require.NoError(t, helpers.UpdateCommitteeCache(context.Background(), state, e))
+
+	seed, err := helpers.Seed(state, e, params.BeaconConfig().DomainBeaconAttester)
+	require.NoError(t, err)
+	want, err := helpers.ShuffledIndices(state, e)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, store[seed])
+
+	// After a restart the committees are served from the persisted shuffle.
+	helpers.ClearCache()
+	store[seed] = append([]primitives.ValidatorIndex{}, want...)
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), state, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, helpers.CommitteeCache().HasEntry(string(seed[:])))
+	committeeCount := helpers.SlotCommitteeCount(uint64(len(want)))
+	require.DeepEqual(t, want[:len(want)/int(params.BeaconConfig().SlotsPerEpoch.Mul(committeeCount))], committee)
+}
+
 func TestUpdateCommitteeCache_CanUpdateAcrossEpochs(t *testing.T) {
 	helpers.ClearCache()
 
@@ -0,0 +1,87 @@
+package helpers
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	log "github.com/sirupsen/logrus"
+)
+
+// ShuffleStore persists the shuffled active validator indices of epochs, keyed by the seed of
+// their shuffle, so that the committee cache can be filled without shuffling again after a restart.
+type ShuffleStore interface {
+	ShuffledIndices(seed [32]byte) ([]primitives.ValidatorIndex, error)
+	SaveShuffledIndices(seed [32]byte, indices []primitives.ValidatorIndex) error
+}
+
+var (
+	shuffleStoreLock sync.RWMutex
+	shuffleStore     ShuffleStore
+)
+
+// SetShuffleStore sets the store in which the shuffles computed for the committee cache are
+// persisted, and which is looked up on committee cache misses. A nil store disables persistence.
+func SetShuffleStore(s ShuffleStore) {
+	shuffleStoreLock.Lock()
+	defer shuffleStoreLock.Unlock()
+	shuffleStore = s
+}
+
+func getShuffleStore() ShuffleStore {
+	shuffleStoreLock.RLock()
+	defer shuffleStoreLock.RUnlock()
+	return shuffleStore
+}
+
+// persistShuffle saves the shuffled indices of a seed in the shuffle store, if one is set.
+func persistShuffle(seed [32]byte, shuffledIndices []primitives.ValidatorIndex) {
+	store := getShuffleStore()
+	if store == nil {
+		return
+	}
+	if err := store.SaveShuffledIndices(seed, shuffledIndices); err != nil {
+		log.WithError(err).Warn("Could not persist committee shuffle")
+	}
+}
+
+// loadPersistedShuffle fills the committee cache with the shuffled indices of the seed from the
+// shuffle store. It returns whether the shuffle was found.
+func loadPersistedShuffle(ctx context.Context, seed [32]byte) (bool, error) {
+	store := getShuffleStore()
+	if store == nil {
+		return false, nil
+	}
+	shuffledIndices, err := store.ShuffledIndices(seed)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "could not read persisted shuffle")
+	}
+	if err := addShuffleToCommitteeCache(ctx, seed, shuffledIndices); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// committeeFromPersistedShuffle returns a committee from the committee cache after loading the
+// shuffle of its seed from the shuffle store. It returns nil if the shuffle is not persisted.
+func committeeFromPersistedShuffle(
+	ctx context.Context,
+	slot primitives.Slot,
+	seed [32]byte,
+	index primitives.CommitteeIndex,
+) ([]primitives.ValidatorIndex, error) {
+	found, err := loadPersistedShuffle(ctx, seed)
+	if err != nil {
+		log.WithError(err).Debug("Could not load persisted committee shuffle")
+		return nil, nil
+	}
+	if !found {
+		return nil, nil
+	}
+	return committeeCache.Committee(ctx, slot, seed, index)
+}
@@ -0,0 +1,33 @@
+package das
+
+// Source identifies how a sidecar needed to check the data availability of a block was obtained.
+type Source uint8
+
+const (
+	// SourceUnknown is used for sidecars whose origin was not recorded, such as sidecars saved before a restart.
+	SourceUnknown Source = iota
+	// SourceGossip is used for sidecars received on the gossip network.
+	SourceGossip
+	// SourceReqResp is used for sidecars requested from peers.
+	SourceReqResp
+	// SourceExecution is used for sidecars reconstructed from the blobs of the execution client.
+	SourceExecution
+	// SourceAPI is used for sidecars submitted through the beacon node APIs, including those of local proposals.
+	SourceAPI
+)
+
+// String returns the name of the source, as used in metric labels and events.
+func (s Source) String() string {
+	switch s {
+	case SourceGossip:
+		return "gossip"
+	case SourceReqResp:
+		return "req_resp"
+	case SourceExecution:
+		return "execution"
+	case SourceAPI:
+		return "api"
+	default:
+		return "unknown"
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -99,6 +100,7 @@ type BlobStorage struct {
 	fsync           bool
 	fs              afero.Fs
 	pruner          *blobPruner
+	bytesWritten    atomic.Uint64
 }
 
 // WarmCache runs the prune routine with an expiration of slot of 0, so nothing will be pruned, but the pruner's cache
@@ -217,10 +219,25 @@ func (bs *BlobStorage) Save(sidecar blocks.VerifiedROBlob) error {
 	}
 	partialMoved = true
 	blobsWrittenCounter.Inc()
+	bs.bytesWritten.Add(uint64(n))
 	blobSaveLatency.Observe(float64(time.Since(startTime).Milliseconds()))
 	return nil
 }
 
+// BytesWritten returns the number of bytes of blob sidecars saved since the node started.
+func (bs *BlobStorage) BytesWritten() uint64 {
+	return bs.bytesWritten.Load()
+}
+
+// Prune removes the blobs which are outside of the retention period at the given slot right away, rather than
+// when the next blob is saved.
+func (bs *BlobStorage) Prune(current primitives.Slot) error {
+	if bs.pruner == nil {
+		return nil
+	}
+	return bs.pruner.pruneAt(current)
+}
+
 // Get retrieves a single BlobSidecar by its root and index.
 // Since BlobStorage only writes blobs that have undergone full verification, the return
 // value is always a VerifiedROBlob.
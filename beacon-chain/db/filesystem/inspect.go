@@ -0,0 +1,43 @@
+package filesystem
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/spf13/afero"
+)
+
+// BlobSlots returns the slot of the blobs saved under the base directory of a blob storage, by block root.
+// The directory is opened read-only, so that it can be inspected while a beacon node is using it.
+func BlobSlots(base string) (map[[32]byte]primitives.Slot, error) {
+	return blobSlots(afero.NewBasePathFs(afero.NewReadOnlyFs(afero.NewOsFs()), path.Clean(base)))
+}
+
+func blobSlots(fs afero.Fs) (map[[32]byte]primitives.Slot, error) {
+	dirs, err := listDir(fs, ".")
+	if err != nil {
+		return nil, err
+	}
+	slots := make(map[[32]byte]primitives.Slot)
+	for _, dir := range filter(dirs, filterRoot) {
+		root, err := rootFromDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := listDir(fs, dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list blobs of root %s", dir)
+		}
+		scFiles := filter(entries, filterSsz)
+		if len(scFiles) == 0 {
+			continue
+		}
+		slot, err := slotFromFile(path.Join(dir, scFiles[0]), fs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read slot of blobs of root %s", dir)
+		}
+		slots[root] = slot
+	}
+	return slots, nil
+}
@@ -0,0 +1,34 @@
+package filesystem
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestBlobSlots(t *testing.T) {
+	fs, bs := NewEphemeralBlobStorageWithFs(t)
+	slots, err := blobSlots(fs)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slots))
+
+	want := make(map[[32]byte]primitives.Slot)
+	for _, slot := range []primitives.Slot{3, 10, 11} {
+		_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, slot, 2)
+		for _, sidecar := range sidecars {
+			sc, err := verification.BlobSidecarNoop(sidecar)
+			require.NoError(t, err)
+			require.NoError(t, bs.Save(sc))
+			want[sc.BlockRoot()] = slot
+		}
+	}
+	// Directories without blobs are ignored.
+	require.NoError(t, fs.Mkdir(rootString([32]byte{'a'}), directoryPermissions))
+
+	slots, err = blobSlots(fs)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, slots)
+}
@@ -66,20 +66,41 @@ func (p *blobPruner) notify(root [32]byte, latest primitives.Slot, idx uint64) e
 	if err := p.cache.ensure(root, latest, idx); err != nil {
 		return err
 	}
-	pruned := uint64(windowMin(latest, p.windowSize))
-	if p.prunedBefore.Swap(pruned) == pruned {
+	pruned, ok := p.advance(latest)
+	if !ok {
 		return nil
 	}
 	go func() {
-		p.Lock()
-		defer p.Unlock()
-		if err := p.prune(primitives.Slot(pruned)); err != nil {
+		if err := p.pruneBefore(pruned); err != nil {
 			log.WithError(err).Errorf("Failed to prune blobs from slot %d", latest)
 		}
 	}()
 	return nil
 }
 
+// pruneAt prunes the blobs outside of the retention window at the latest slot, unless they were already pruned
+// for the window of that slot.
+func (p *blobPruner) pruneAt(latest primitives.Slot) error {
+	pruned, ok := p.advance(latest)
+	if !ok {
+		return nil
+	}
+	return p.pruneBefore(pruned)
+}
+
+// advance moves the start of the retention window to the one of the latest slot, returning false if it was
+// already there.
+func (p *blobPruner) advance(latest primitives.Slot) (primitives.Slot, bool) {
+	pruned := windowMin(latest, p.windowSize)
+	return pruned, p.prunedBefore.Swap(uint64(pruned)) != uint64(pruned)
+}
+
+func (p *blobPruner) pruneBefore(pruned primitives.Slot) error {
+	p.Lock()
+	defer p.Unlock()
+	return p.prune(pruned)
+}
+
 func windowMin(latest, offset primitives.Slot) primitives.Slot {
 	// Safely compute the first slot in the epoch for the latest slot
 	latest = latest - latest%params.BeaconConfig().SlotsPerEpoch
@@ -0,0 +1,122 @@
+package filesystem
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/spf13/afero"
+)
+
+// shufflesRetained is the number of shuffles kept on disk. It covers the current and next epochs
+// of a few competing forks.
+const shufflesRetained = 8
+
+var (
+	errNoShuffleBasePath = errors.New("ShuffleStorage base path not specified in init")
+	errShuffleCorrupted  = errors.New("shuffle file length is not a multiple of 8")
+)
+
+// ShuffleStorage saves the shuffled active validator indices of epochs on disk, keyed by the seed
+// of their shuffle, so that they survive restarts. Only the most recently saved shuffles are kept.
+type ShuffleStorage struct {
+	sync.Mutex
+	fs afero.Fs
+}
+
+// NewShuffleStorage creates a ShuffleStorage saving shuffles in the given directory.
+func NewShuffleStorage(base string) (*ShuffleStorage, error) {
+	if base == "" {
+		return nil, errNoShuffleBasePath
+	}
+	base = path.Clean(base)
+	if err := file.MkdirAll(base); err != nil {
+		return nil, errors.Wrapf(err, "failed to create shuffle storage at %s", base)
+	}
+	return &ShuffleStorage{fs: afero.NewBasePathFs(afero.NewOsFs(), base)}, nil
+}
+
+// NewEphemeralShuffleStorage creates a ShuffleStorage backed by memory, for tests.
+func NewEphemeralShuffleStorage() *ShuffleStorage {
+	return &ShuffleStorage{fs: afero.NewMemMapFs()}
+}
+
+func shuffleFileName(seed [32]byte) string {
+	return fmt.Sprintf("%#x.%s", seed, sszExt)
+}
+
+// ShuffledIndices returns the shuffled indices saved for the given seed. The returned error
+// satisfies os.IsNotExist when no shuffle was saved for the seed.
+func (s *ShuffleStorage) ShuffledIndices(seed [32]byte) ([]primitives.ValidatorIndex, error) {
+	s.Lock()
+	defer s.Unlock()
+	enc, err := afero.ReadFile(s.fs, shuffleFileName(seed))
+	if err != nil {
+		return nil, err
+	}
+	if len(enc)%8 != 0 {
+		return nil, errShuffleCorrupted
+	}
+	indices := make([]primitives.ValidatorIndex, len(enc)/8)
+	for i := range indices {
+		indices[i] = primitives.ValidatorIndex(binary.LittleEndian.Uint64(enc[i*8:]))
+	}
+	return indices, nil
+}
+
+// SaveShuffledIndices saves the shuffled indices of the given seed, and removes the oldest
+// shuffles beyond the retained ones.
+func (s *ShuffleStorage) SaveShuffledIndices(seed [32]byte, indices []primitives.ValidatorIndex) error {
+	enc := make([]byte, len(indices)*8)
+	for i, idx := range indices {
+		binary.LittleEndian.PutUint64(enc[i*8:], uint64(idx))
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	name := shuffleFileName(seed)
+	partPath := name + "." + partExt
+	if err := afero.WriteFile(s.fs, partPath, enc, 0600); err != nil {
+		return errors.Wrap(err, "failed to write shuffle")
+	}
+	if err := s.fs.Rename(partPath, name); err != nil {
+		if rmErr := s.fs.Remove(partPath); rmErr != nil {
+			log.WithError(rmErr).Debug("Could not remove partial shuffle file")
+		}
+		return errors.Wrap(err, "failed to rename partial shuffle file")
+	}
+	return s.prune()
+}
+
+// prune removes the oldest shuffles beyond the retained ones.
+func (s *ShuffleStorage) prune() error {
+	entries, err := afero.ReadDir(s.fs, ".")
+	if err != nil {
+		return errors.Wrap(err, "failed to list shuffles")
+	}
+	var shuffles []os.FileInfo
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), "."+sszExt) {
+			shuffles = append(shuffles, e)
+		}
+	}
+	if len(shuffles) <= shufflesRetained {
+		return nil
+	}
+	sort.Slice(shuffles, func(i, j int) bool {
+		return shuffles[i].ModTime().After(shuffles[j].ModTime())
+	})
+	for _, e := range shuffles[shufflesRetained:] {
+		if err := s.fs.Remove(e.Name()); err != nil {
+			return errors.Wrapf(err, "failed to remove shuffle %s", e.Name())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package filesystem
+
+import (
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/spf13/afero"
+)
+
+func TestShuffleStorage_RoundTrip(t *testing.T) {
+	s := NewEphemeralShuffleStorage()
+	seed := [32]byte{'a'}
+	_, err := s.ShuffledIndices(seed)
+	require.Equal(t, true, os.IsNotExist(err))
+
+	indices := []primitives.ValidatorIndex{5, 0, 3, 1, 4, 2}
+	require.NoError(t, s.SaveShuffledIndices(seed, indices))
+	got, err := s.ShuffledIndices(seed)
+	require.NoError(t, err)
+	require.DeepEqual(t, indices, got)
+}
+
+func TestShuffleStorage_Corrupted(t *testing.T) {
+	s := NewEphemeralShuffleStorage()
+	seed := [32]byte{'a'}
+	require.NoError(t, afero.WriteFile(s.fs, shuffleFileName(seed), []byte{1, 2, 3}, 0600))
+	_, err := s.ShuffledIndices(seed)
+	require.ErrorIs(t, err, errShuffleCorrupted)
+}
+
+func TestShuffleStorage_Prune(t *testing.T) {
+	s := NewEphemeralShuffleStorage()
+	for i := 0; i < shufflesRetained+3; i++ {
+		require.NoError(t, s.SaveShuffledIndices([32]byte{byte(i)}, []primitives.ValidatorIndex{primitives.ValidatorIndex(i)}))
+	}
+	entries, err := afero.ReadDir(s.fs, ".")
+	require.NoError(t, err)
+	require.Equal(t, shufflesRetained, len(entries))
+
+	got, err := s.ShuffledIndices([32]byte{shufflesRetained + 2})
+	require.NoError(t, err)
+	require.DeepEqual(t, []primitives.ValidatorIndex{shufflesRetained + 2}, got)
+}
@@ -64,6 +64,8 @@ type ReadOnlyDatabase interface {
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillStatus(context.Context) (*dbval.BackfillStatus, error)
+	PayloadReconstructionRange(ctx context.Context) (primitives.Slot, primitives.Slot, error)
+	ShutdownHeadRoot(ctx context.Context) ([32]byte, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -75,6 +77,8 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
 	SaveROBlocks(ctx context.Context, blks []blocks.ROBlock, cache bool) error
+	ReplaceBlindedBlocks(ctx context.Context, blks []blocks.ROBlock) error
+	SavePayloadReconstructionRange(ctx context.Context, low, high primitives.Slot) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
@@ -110,6 +114,8 @@ type HeadAccessDatabase interface {
 	// Block related methods.
 	HeadBlock(ctx context.Context) (interfaces.ReadOnlySignedBeaconBlock, error)
 	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveShutdownHeadRoot(ctx context.Context, blockRoot [32]byte) error
+	DeleteShutdownHeadRoot(ctx context.Context) error
 
 	// Genesis operations.
 	LoadGenesis(ctx context.Context, stateBytes []byte) error
@@ -336,6 +336,7 @@ func (s *Store) SaveROBlocks(ctx context.Context, blks []blocks.ROBlock, cache b
 			if err := bkt.Put(batch[i].root, batch[i].enc); err != nil {
 				return errors.Wrapf(err, "could write block to db with root %#x", batch[i].root)
 			}
+			s.writes.blocks.Add(uint64(len(batch[i].enc)))
 			if err := updateValueForIndices(ctx, batch[i].indices, batch[i].root, tx); err != nil {
 				return errors.Wrapf(err, "could not update DB indices for root %#x", batch[i].root)
 			}
@@ -354,6 +355,53 @@ func (s *Store) SaveROBlocks(ctx context.Context, blks []blocks.ROBlock, cache b
 	return err
 }
 
+// ReplaceBlindedBlocks overwrites blinded blocks already present in the db with their full counterparts.
+// This lets a node which stores blinded blocks keep the execution payloads of historical blocks, for example
+// after reconstructing them from the execution client. Blocks which are not already in the db are skipped,
+// as are blocks whose stored encoding is already full.
+func (s *Store) ReplaceBlindedBlocks(ctx context.Context, blks []blocks.ROBlock) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ReplaceBlindedBlocks")
+	defer span.End()
+
+	batch, err := prepareBlockBatch(blks, false)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode all blocks in batch for saving to the db")
+	}
+	for i := range batch {
+		if batch[i].block.IsBlinded() {
+			return fmt.Errorf("cannot replace block with root %#x by a blinded block", batch[i].root)
+		}
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		for i := range batch {
+			existing := bkt.Get(batch[i].root)
+			if existing == nil {
+				continue
+			}
+			stored, err := unmarshalBlock(ctx, existing)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode stored block with root %#x", batch[i].root)
+			}
+			if !stored.IsBlinded() {
+				continue
+			}
+			if err := bkt.Put(batch[i].root, batch[i].enc); err != nil {
+				return errors.Wrapf(err, "could write block to db with root %#x", batch[i].root)
+			}
+			s.writes.blocks.Add(uint64(len(batch[i].enc)))
+			batch[i].updated = true
+		}
+		return nil
+	})
+	for i := range batch {
+		if batch[i].updated {
+			s.blockCache.Del(string(batch[i].root))
+		}
+	}
+	return err
+}
+
 // blockIndices takes in a beacon block and returns
 // a map of bolt DB index buckets corresponding to each particular key for indices for
 // data, such as (shard indices bucket -> shard 5).
@@ -215,6 +215,51 @@ func TestStore_BlocksCRUD(t *testing.T) {
 	}
 }
 
+func TestStore_ReplaceBlindedBlocks(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	b := util.NewBeaconBlockCapella()
+	b.Block.Slot = 20
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	stored, err := db.Block(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, true, stored.IsBlinded())
+
+	unknown := util.NewBeaconBlockCapella()
+	unknown.Block.Slot = 21
+	unknownBlk, err := blocks.NewSignedBeaconBlock(unknown)
+	require.NoError(t, err)
+
+	robs := make([]blocks.ROBlock, 0, 2)
+	for _, sb := range []interfaces.ReadOnlySignedBeaconBlock{blk, unknownBlk} {
+		rob, err := blocks.NewROBlock(sb)
+		require.NoError(t, err)
+		robs = append(robs, rob)
+	}
+	require.NoError(t, db.ReplaceBlindedBlocks(ctx, robs))
+
+	replaced, err := db.Block(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, false, replaced.IsBlinded())
+	wantedPb, err := blk.Proto()
+	require.NoError(t, err)
+	replacedPb, err := replaced.Proto()
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(wantedPb, replacedPb))
+	assert.Equal(t, false, db.HasBlock(ctx, robs[1].Root()))
+
+	blinded, err := blk.ToBlinded()
+	require.NoError(t, err)
+	rob, err := blocks.NewROBlock(blinded)
+	require.NoError(t, err)
+	require.ErrorContains(t, "blinded block", db.ReplaceBlindedBlocks(ctx, []blocks.ROBlock{rob}))
+}
+
 func TestStore_BlocksHandleZeroCase(t *testing.T) {
 	for _, tt := range blockTests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,61 @@
+package kv
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+// The functions below read a bolt db directly, so that a database can be inspected without opening it as a
+// Store, which would require write access.
+
+// BlockSlots returns the slots for which the db has at least one block, in increasing order.
+func BlockSlots(db *bolt.DB) ([]primitives.Slot, error) {
+	var slots []primitives.Slot
+	err := db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blockSlotIndicesBucket)
+		if bkt == nil {
+			return nil
+		}
+		return bkt.ForEach(func(k, _ []byte) error {
+			slots = append(slots, bytesutil.BytesToSlotBigEndian(k))
+			return nil
+		})
+	})
+	return slots, err
+}
+
+// LastArchivedState returns the slot and block root of the last archived point of the db, and whether the
+// state at that point is saved. The state slot index also holds the states saved between the archived points, so
+// only the slots which are a multiple of slotsPerArchivedPoint are considered. A db without archived points returns
+// the zero slot and root.
+func LastArchivedState(db *bolt.DB, slotsPerArchivedPoint primitives.Slot) (primitives.Slot, [32]byte, bool, error) {
+	if slotsPerArchivedPoint == 0 {
+		return 0, [32]byte{}, false, errors.New("slots per archived point must be positive")
+	}
+	var slot primitives.Slot
+	var root [32]byte
+	var saved bool
+	err := db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateSlotIndicesBucket)
+		if bkt == nil {
+			return nil
+		}
+		c := bkt.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			s := bytesutil.BytesToSlotBigEndian(k)
+			if s%slotsPerArchivedPoint != 0 {
+				continue
+			}
+			slot = s
+			root = bytesutil.ToBytes32(v)
+			if states := tx.Bucket(stateBucket); states != nil {
+				saved = states.Get(v) != nil
+			}
+			return nil
+		}
+		return nil
+	})
+	return slot, root, saved, err
+}
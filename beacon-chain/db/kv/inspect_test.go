@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestBlockSlots(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slots, err := BlockSlots(db.db)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(slots))
+
+	for _, slot := range []primitives.Slot{7, 1, 3} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wsb))
+	}
+	slots, err = BlockSlots(db.db)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []primitives.Slot{1, 3, 7}, slots)
+}
+
+func TestLastArchivedState(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slot, root, saved, err := LastArchivedState(db.db, 64)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(0), slot)
+	assert.Equal(t, [32]byte{}, root)
+	assert.Equal(t, false, saved)
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(64))
+	require.NoError(t, db.SaveState(ctx, st, [32]byte{'A'}))
+	// A state saved after the last archived point is not an archived point.
+	require.NoError(t, st.SetSlot(96))
+	require.NoError(t, db.SaveState(ctx, st, [32]byte{'B'}))
+	slot, root, saved, err = LastArchivedState(db.db, 64)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(64), slot)
+	assert.Equal(t, [32]byte{'A'}, root)
+	assert.Equal(t, true, saved)
+
+	_, _, _, err = LastArchivedState(db.db, 0)
+	require.ErrorContains(t, "must be positive", err)
+}
@@ -89,6 +89,7 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	writes              storageWrites
 	ctx                 context.Context
 }
 
@@ -0,0 +1,44 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SavePayloadReconstructionRange saves the range of finalized slots [low, high) whose blinded blocks were replaced
+// by full blocks, so that the reconstruction of historical execution payloads resumes where it stopped on restart.
+func (s *Store) SavePayloadReconstructionRange(ctx context.Context, low, high primitives.Slot) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SavePayloadReconstructionRange")
+	defer span.End()
+	enc := append(bytesutil.SlotToBytesBigEndian(low), bytesutil.SlotToBytesBigEndian(high)...)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(blocksBucket)
+		return bucket.Put(payloadReconstructionKey, enc)
+	})
+}
+
+// PayloadReconstructionRange retrieves the range of slots saved by SavePayloadReconstructionRange.
+func (s *Store) PayloadReconstructionRange(ctx context.Context) (primitives.Slot, primitives.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PayloadReconstructionRange")
+	defer span.End()
+	var low, high primitives.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(blocksBucket)
+		enc := bucket.Get(payloadReconstructionKey)
+		if len(enc) == 0 {
+			return errors.Wrap(ErrNotFound, "payload reconstruction range not found")
+		}
+		if len(enc) != 16 {
+			return errors.Errorf("invalid payload reconstruction range length %d", len(enc))
+		}
+		low = bytesutil.BytesToSlotBigEndian(enc[:8])
+		high = bytesutil.BytesToSlotBigEndian(enc[8:])
+		return nil
+	})
+	return low, high, err
+}
@@ -0,0 +1,23 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_PayloadReconstructionRange(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, _, err := db.PayloadReconstructionRange(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, db.SavePayloadReconstructionRange(ctx, 100, 200))
+	low, high, err := db.PayloadReconstructionRange(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(100), low)
+	require.Equal(t, primitives.Slot(200), high)
+}
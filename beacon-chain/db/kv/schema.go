@@ -59,6 +59,10 @@ var (
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// tracking data about an ongoing backfill
 	backfillStatusKey = []byte("backfill-status")
+	// range of finalized slots whose blinded blocks were replaced by full blocks
+	payloadReconstructionKey = []byte("payload-reconstruction-range")
+	// block root of the head state written to the db on shutdown only
+	shutdownHeadRootKey = []byte("shutdown-head-root")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
@@ -0,0 +1,50 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SaveShutdownHeadRoot saves the block root of a head state which was only written to the db on shutdown,
+// so that the next startup removes that state once it resumed from it.
+func (s *Store) SaveShutdownHeadRoot(ctx context.Context, blockRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveShutdownHeadRoot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(blocksBucket)
+		return bucket.Put(shutdownHeadRootKey, blockRoot[:])
+	})
+}
+
+// ShutdownHeadRoot retrieves the block root saved by SaveShutdownHeadRoot.
+func (s *Store) ShutdownHeadRoot(ctx context.Context) ([32]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ShutdownHeadRoot")
+	defer span.End()
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(blocksBucket)
+		enc := bucket.Get(shutdownHeadRootKey)
+		if len(enc) == 0 {
+			return errors.Wrap(ErrNotFound, "shutdown head root not found")
+		}
+		if len(enc) != len(root) {
+			return errors.Errorf("invalid shutdown head root length %d", len(enc))
+		}
+		copy(root[:], enc)
+		return nil
+	})
+	return root, err
+}
+
+// DeleteShutdownHeadRoot deletes the block root saved by SaveShutdownHeadRoot.
+func (s *Store) DeleteShutdownHeadRoot(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteShutdownHeadRoot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(blocksBucket)
+		return bucket.Delete(shutdownHeadRootKey)
+	})
+}
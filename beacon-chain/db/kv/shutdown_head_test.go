@@ -0,0 +1,26 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_ShutdownHeadRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.ShutdownHeadRoot(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	root := [32]byte{'a'}
+	require.NoError(t, db.SaveShutdownHeadRoot(ctx, root))
+	got, err := db.ShutdownHeadRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, root, got)
+
+	require.NoError(t, db.DeleteShutdownHeadRoot(ctx))
+	_, err = db.ShutdownHeadRoot(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+}
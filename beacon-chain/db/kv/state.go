@@ -151,6 +151,7 @@ func (s *Store) SaveStates(ctx context.Context, states []state.ReadOnlyBeaconSta
 			if err := bucket.Put(rt[:], multipleEncs[i]); err != nil {
 				return err
 			}
+			s.writes.states.Add(uint64(len(multipleEncs[i])))
 		}
 		return nil
 	}); err != nil {
@@ -272,6 +273,7 @@ func (s *Store) processPhase0(ctx context.Context, pbState *ethpb.BeaconState, r
 	if err := bucket.Put(rootHash, encodedState); err != nil {
 		return err
 	}
+	s.writes.states.Add(uint64(len(encodedState)))
 	if err := valIdxBkt.Put(rootHash, validatorKey); err != nil {
 		return err
 	}
@@ -289,6 +291,7 @@ func (s *Store) processAltair(ctx context.Context, pbState *ethpb.BeaconStateAlt
 	if err := bucket.Put(rootHash, encodedState); err != nil {
 		return err
 	}
+	s.writes.states.Add(uint64(len(encodedState)))
 	pbState.Validators = valEntries
 	if err := valIdxBkt.Put(rootHash, validatorKey); err != nil {
 		return err
@@ -307,6 +310,7 @@ func (s *Store) processBellatrix(ctx context.Context, pbState *ethpb.BeaconState
 	if err := bucket.Put(rootHash, encodedState); err != nil {
 		return err
 	}
+	s.writes.states.Add(uint64(len(encodedState)))
 	pbState.Validators = valEntries
 	if err := valIdxBkt.Put(rootHash, validatorKey); err != nil {
 		return err
@@ -325,6 +329,7 @@ func (s *Store) processCapella(ctx context.Context, pbState *ethpb.BeaconStateCa
 	if err := bucket.Put(rootHash, encodedState); err != nil {
 		return err
 	}
+	s.writes.states.Add(uint64(len(encodedState)))
 	pbState.Validators = valEntries
 	if err := valIdxBkt.Put(rootHash, validatorKey); err != nil {
 		return err
@@ -343,6 +348,7 @@ func (s *Store) processDeneb(ctx context.Context, pbState *ethpb.BeaconStateDene
 	if err := bucket.Put(rootHash, encodedState); err != nil {
 		return err
 	}
+	s.writes.states.Add(uint64(len(encodedState)))
 	pbState.Validators = valEntries
 	if err := valIdxBkt.Put(rootHash, validatorKey); err != nil {
 		return err
@@ -361,6 +367,7 @@ func (s *Store) processElectra(ctx context.Context, pbState *ethpb.BeaconStateEl
 	if err := bucket.Put(rootHash, encodedState); err != nil {
 		return err
 	}
+	s.writes.states.Add(uint64(len(encodedState)))
 	pbState.Validators = valEntries
 	if err := valIdxBkt.Put(rootHash, validatorKey); err != nil {
 		return err
@@ -0,0 +1,21 @@
+package kv
+
+import "sync/atomic"
+
+// storageWrites counts the bytes of the blocks and states written to the database since the node started,
+// so that the growth of the database can be broken down by kind of data.
+type storageWrites struct {
+	blocks atomic.Uint64
+	states atomic.Uint64
+}
+
+// BlockBytesWritten returns the number of bytes of encoded blocks written to the database since the node started.
+func (s *Store) BlockBytesWritten() uint64 {
+	return s.writes.blocks.Load()
+}
+
+// StateBytesWritten returns the number of bytes of encoded states written to the database since the node started.
+// The validator entries, which are stored separately and shared by states, are not included.
+func (s *Store) StateBytesWritten() uint64 {
+	return s.writes.states.Load()
+}
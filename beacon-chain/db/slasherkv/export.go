@@ -0,0 +1,289 @@
+package slasherkv
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	exportVersion = 1
+	// Upper bound of a key or value size, guarding against corrupted exports.
+	maxExportedItemSize = 64 * 1024 * 1024
+)
+
+var (
+	exportMagic = []byte("prysm-slasher-db")
+
+	// Number of records written per transaction during an import.
+	importBatchSize = 10_000
+
+	// ErrDatabaseNotEmpty is returned when importing into a database which already contains slasher data.
+	ErrDatabaseNotEmpty = errors.New("slasher database is not empty")
+
+	// exportedBuckets lists all the buckets holding slasher data, in the order they are exported.
+	exportedBuckets = [][]byte{
+		attestedEpochsByValidator,
+		attestationRecordsBucket,
+		attestationDataRootsBucket,
+		proposalRecordsBucket,
+		slasherChunksBucket,
+	}
+)
+
+// Export writes the content of all the slasher buckets (min and max spans, attestation and proposal
+// records, last epoch written per validator) to w, from a consistent snapshot of the database.
+// Keys and values are written as stored, so that an export can be imported by any backend
+// implementing the same encoding. The format is:
+//
+//	magic | version | bucket count | bucket names...
+//	(bucket number | key | value)... | 0 | record count
+//
+// where integers are uvarints, bucket numbers start at 1, and byte slices are prefixed with their length.
+func (s *Store) Export(ctx context.Context, w io.Writer) (uint64, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.Export")
+	defer span.End()
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(exportMagic); err != nil {
+		return 0, err
+	}
+	writeUvarint(bw, exportVersion)
+	writeUvarint(bw, uint64(len(exportedBuckets)))
+	for _, name := range exportedBuckets {
+		writeBytes(bw, name)
+	}
+
+	var count uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		for i, name := range exportedBuckets {
+			bkt := tx.Bucket(name)
+			if bkt == nil {
+				return errors.Errorf("bucket %s not found", name)
+			}
+			if err := bkt.ForEach(func(k, v []byte) error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				writeUvarint(bw, uint64(i+1))
+				writeBytes(bw, k)
+				writeBytes(bw, v)
+				count++
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not export slasher buckets")
+	}
+
+	writeUvarint(bw, 0)
+	writeUvarint(bw, count)
+	if err := bw.Flush(); err != nil {
+		return 0, errors.Wrap(err, "could not write export")
+	}
+	return count, nil
+}
+
+// Import loads the content of an export produced by Export into the database, which must not
+// contain any slasher data yet. It returns the number of imported records. The records are saved
+// in batches, so when the import fails, the records already saved are removed for the import to
+// be retried.
+func (s *Store) Import(ctx context.Context, r io.Reader) (uint64, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.Import")
+	defer span.End()
+
+	empty, err := s.isEmpty()
+	if err != nil {
+		return 0, err
+	}
+	if !empty {
+		return 0, ErrDatabaseNotEmpty
+	}
+
+	count, err := s.importRecords(ctx, r)
+	if err != nil {
+		// The database was empty before the import, so clearing the slasher buckets only removes
+		// the imported records.
+		if clearErr := s.clearExportedBuckets(); clearErr != nil {
+			log.WithError(clearErr).Error("Could not remove the partially imported records")
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *Store) importRecords(ctx context.Context, r io.Reader) (uint64, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(exportMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || !bytes.Equal(magic, exportMagic) {
+		return 0, errors.New("not a slasher database export")
+	}
+	version, err := binary.ReadUvarint(br)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not read export version")
+	}
+	if version != exportVersion {
+		return 0, errors.Errorf("unsupported export version %d", version)
+	}
+
+	bucketsCount, err := binary.ReadUvarint(br)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not read buckets count")
+	}
+	if bucketsCount > uint64(len(exportedBuckets)) {
+		return 0, errors.Errorf("export contains %d buckets, expected at most %d", bucketsCount, len(exportedBuckets))
+	}
+	buckets := make([][]byte, bucketsCount)
+	for i := range buckets {
+		name, err := readBytes(br)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not read bucket name")
+		}
+		if !isExportedBucket(name) {
+			return 0, errors.Errorf("unknown bucket %s", name)
+		}
+		buckets[i] = name
+	}
+
+	type record struct {
+		bucket     []byte
+		key, value []byte
+	}
+	batch := make([]record, 0, importBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := s.db.Update(func(tx *bolt.Tx) error {
+			for _, rec := range batch {
+				if err := tx.Bucket(rec.bucket).Put(rec.key, rec.value); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		batch = batch[:0]
+		return err
+	}
+
+	var count uint64
+	for {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		bucketNumber, err := binary.ReadUvarint(br)
+		if err != nil {
+			return count, errors.Wrap(err, "could not read record, export may be truncated")
+		}
+		if bucketNumber == 0 {
+			break
+		}
+		if bucketNumber > bucketsCount {
+			return count, errors.Errorf("invalid bucket number %d", bucketNumber)
+		}
+		key, err := readBytes(br)
+		if err != nil {
+			return count, errors.Wrap(err, "could not read record key")
+		}
+		value, err := readBytes(br)
+		if err != nil {
+			return count, errors.Wrap(err, "could not read record value")
+		}
+		batch = append(batch, record{bucket: buckets[bucketNumber-1], key: key, value: value})
+		count++
+		if len(batch) == importBatchSize {
+			if err := flush(); err != nil {
+				return count, errors.Wrap(err, "could not save records")
+			}
+		}
+	}
+
+	expected, err := binary.ReadUvarint(br)
+	if err != nil {
+		return count, errors.Wrap(err, "could not read records count")
+	}
+	if expected != count {
+		return count, errors.Errorf("export contains %d records, expected %d", count, expected)
+	}
+	if err := flush(); err != nil {
+		return count, errors.Wrap(err, "could not save records")
+	}
+	return count, nil
+}
+
+// clearExportedBuckets removes all the data of the slasher buckets.
+func (s *Store) clearExportedBuckets() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, name := range exportedBuckets {
+			if err := tx.DeleteBucket(name); err != nil {
+				return err
+			}
+			if _, err := tx.CreateBucket(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// isEmpty returns true if none of the slasher buckets contains any data.
+func (s *Store) isEmpty() (bool, error) {
+	empty := true
+	err := s.db.View(func(tx *bolt.Tx) error {
+		for _, name := range exportedBuckets {
+			if k, _ := tx.Bucket(name).Cursor().First(); k != nil {
+				empty = false
+				return nil
+			}
+		}
+		return nil
+	})
+	return empty, err
+}
+
+func isExportedBucket(name []byte) bool {
+	for _, b := range exportedBuckets {
+		if bytes.Equal(b, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeUvarint and writeBytes do not return errors, since bufio.Writer
+// keeps the first one and returns it on Flush.
+func writeUvarint(w *bufio.Writer, x uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], x)
+	_, _ = w.Write(buf[:n])
+}
+
+func writeBytes(w *bufio.Writer, b []byte) {
+	writeUvarint(w, uint64(len(b)))
+	_, _ = w.Write(b)
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxExportedItemSize {
+		return nil, errors.Errorf("item size %d exceeds the maximum of %d", size, maxExportedItemSize)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
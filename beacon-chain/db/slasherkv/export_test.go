@@ -0,0 +1,88 @@
+package slasherkv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_ExportImport(t *testing.T) {
+	ctx := context.Background()
+	source := setupDB(t)
+
+	atts := []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(1, 2, []uint64{0, 1}, []byte{1}),
+		createAttestationWrapper(2, 3, []uint64{2}, []byte{2}),
+	}
+	require.NoError(t, source.SaveAttestationRecordsForValidators(ctx, atts))
+	require.NoError(t, source.SaveBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(t, 4, 1, []byte{3}),
+	}))
+	require.NoError(t, source.SaveLastEpochWrittenForValidators(ctx, map[primitives.ValidatorIndex]primitives.Epoch{1: 3}))
+	chunkKey := []byte{1, 2, 3}
+	require.NoError(t, source.SaveSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{chunkKey}, [][]uint16{{1, 2, 3, 4}}))
+
+	var buf bytes.Buffer
+	exported, err := source.Export(ctx, &buf)
+	require.NoError(t, err)
+	require.NotEqual(t, uint64(0), exported)
+	export := buf.Bytes()
+
+	target := setupDB(t)
+	imported, err := target.Import(ctx, bytes.NewReader(export))
+	require.NoError(t, err)
+	require.Equal(t, exported, imported)
+
+	att, err := target.AttestationRecordForValidator(ctx, 2, 3)
+	require.NoError(t, err)
+	require.NotNil(t, att)
+	require.Equal(t, atts[1].DataRoot, att.DataRoot)
+
+	proposal, err := target.BlockProposalForValidator(ctx, 1, 4)
+	require.NoError(t, err)
+	require.NotNil(t, proposal)
+
+	epochs, err := target.LastEpochWrittenForValidators(ctx, []primitives.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(epochs))
+	require.Equal(t, primitives.Epoch(3), epochs[0].Epoch)
+
+	chunks, exists, err := target.LoadSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{chunkKey})
+	require.NoError(t, err)
+	require.Equal(t, true, exists[0])
+	require.DeepEqual(t, []uint16{1, 2, 3, 4}, chunks[0])
+
+	t.Run("not empty", func(t *testing.T) {
+		_, err := target.Import(ctx, bytes.NewReader(export))
+		require.ErrorIs(t, err, ErrDatabaseNotEmpty)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		_, err := setupDB(t).Import(ctx, bytes.NewReader(export[:len(export)-4]))
+		require.ErrorContains(t, "could not read record", err)
+	})
+	t.Run("retry after partial import", func(t *testing.T) {
+		defer func(size int) {
+			importBatchSize = size
+		}(importBatchSize)
+		// Save every record in its own transaction, so that some are saved before the failure.
+		importBatchSize = 1
+		db := setupDB(t)
+		_, err := db.Import(ctx, bytes.NewReader(export[:len(export)-4]))
+		require.ErrorContains(t, "could not read record", err)
+		empty, err := db.isEmpty()
+		require.NoError(t, err)
+		require.Equal(t, true, empty)
+
+		imported, err := db.Import(ctx, bytes.NewReader(export))
+		require.NoError(t, err)
+		require.Equal(t, exported, imported)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := setupDB(t).Import(ctx, bytes.NewReader([]byte("not an export")))
+		require.ErrorContains(t, "not a slasher database export", err)
+	})
+}
@@ -0,0 +1,8 @@
+/*
+Package diskusage defines a runtime service which samples the size of the data
+directory of the beacon node and the bytes of blocks, states and blobs written to
+it, to forecast when the data directory will reach a size threshold. When the
+forecast falls within a configured horizon, it can run pruning actions, such as
+removing the states which are not needed anymore, before the disk fills up.
+*/
+package diskusage
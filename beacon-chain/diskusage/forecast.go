@@ -0,0 +1,99 @@
+package diskusage
+
+import (
+	"math"
+	"time"
+)
+
+// KindTotal is the growth rate of the data directory as a whole, as opposed to the kinds of data written to it.
+const KindTotal = "total"
+
+// sample is a measure of the size of the data directory, and of the bytes of each kind of data written to it.
+type sample struct {
+	time    time.Time
+	size    uint64
+	written map[string]uint64
+}
+
+// Forecast is the forecast of the disk usage of the data directory made from the latest samples.
+type Forecast struct {
+	// Time is when the latest sample was taken.
+	Time           time.Time
+	DataDirBytes   uint64
+	ThresholdBytes uint64
+	// GrowthRates are in bytes per second, for the data directory as a whole under KindTotal, and for each
+	// kind of data written to it.
+	GrowthRates map[string]float64
+	// ThresholdTime is when the data directory is forecast to reach the threshold. It is zero when the data
+	// directory is not growing.
+	ThresholdTime time.Time
+	// LastPruned is when the pruning actions last ran, if ever.
+	LastPruned time.Time
+}
+
+// UntilThreshold returns the time left until the data directory reaches the threshold, from the time of the
+// forecast. The boolean is false when the data directory is not growing.
+func (f *Forecast) UntilThreshold() (time.Duration, bool) {
+	if f.ThresholdTime.IsZero() {
+		return 0, false
+	}
+	return f.ThresholdTime.Sub(f.Time), true
+}
+
+// forecast fits a line through the sizes of the data directory in the samples, which are ordered by time, and
+// extrapolates it to the threshold. The growth rates of the kinds of data are averaged over the samples.
+func forecast(samples []sample, threshold uint64) *Forecast {
+	if len(samples) == 0 {
+		return nil
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	f := &Forecast{
+		Time:           last.time,
+		DataDirBytes:   last.size,
+		ThresholdBytes: threshold,
+		GrowthRates:    make(map[string]float64, len(last.written)+1),
+	}
+	elapsed := last.time.Sub(first.time).Seconds()
+	if elapsed <= 0 {
+		return f
+	}
+	for kind, written := range last.written {
+		if written >= first.written[kind] {
+			f.GrowthRates[kind] = float64(written-first.written[kind]) / elapsed
+		}
+	}
+
+	slope := sizeSlope(samples)
+	f.GrowthRates[KindTotal] = slope
+	switch {
+	case last.size >= threshold:
+		f.ThresholdTime = last.time
+	case slope > 0:
+		seconds := float64(threshold-last.size) / slope
+		if seconds < math.MaxInt64/float64(time.Second) {
+			f.ThresholdTime = last.time.Add(time.Duration(seconds * float64(time.Second)))
+		}
+	}
+	return f
+}
+
+// sizeSlope returns the slope, in bytes per second, of the least squares line through the sizes of the samples.
+func sizeSlope(samples []sample) float64 {
+	// The times and sizes are taken relative to the first sample, so that the sums keep their precision.
+	start, base := samples[0].time, float64(samples[0].size)
+	n := float64(len(samples))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, s := range samples {
+		x := s.time.Sub(start).Seconds()
+		y := float64(s.size) - base
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denominator
+}
@@ -0,0 +1,65 @@
+package diskusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestForecast(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	samples := []sample{
+		{time: start, size: 1000, written: map[string]uint64{"blocks": 0, "blobs": 100}},
+		{time: start.Add(100 * time.Second), size: 1100, written: map[string]uint64{"blocks": 50, "blobs": 100}},
+		{time: start.Add(200 * time.Second), size: 1200, written: map[string]uint64{"blocks": 100, "blobs": 300}},
+	}
+	f := forecast(samples, 2200)
+	require.NotNil(t, f)
+	assert.Equal(t, samples[2].time, f.Time)
+	assert.Equal(t, uint64(1200), f.DataDirBytes)
+	assert.Equal(t, uint64(2200), f.ThresholdBytes)
+	assert.Equal(t, 1.0, f.GrowthRates[KindTotal])
+	assert.Equal(t, 0.5, f.GrowthRates["blocks"])
+	assert.Equal(t, 1.0, f.GrowthRates["blobs"])
+	until, ok := f.UntilThreshold()
+	require.Equal(t, true, ok)
+	assert.Equal(t, 1000*time.Second, until)
+}
+
+func TestForecast_NotGrowing(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	samples := []sample{
+		{time: start, size: 1200},
+		{time: start.Add(100 * time.Second), size: 1100},
+		{time: start.Add(200 * time.Second), size: 1000},
+	}
+	f := forecast(samples, 2000)
+	assert.Equal(t, -1.0, f.GrowthRates[KindTotal])
+	_, ok := f.UntilThreshold()
+	assert.Equal(t, false, ok)
+}
+
+func TestForecast_ThresholdReached(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	samples := []sample{
+		{time: start, size: 1000},
+		{time: start.Add(100 * time.Second), size: 900},
+	}
+	f := forecast(samples, 900)
+	until, ok := f.UntilThreshold()
+	require.Equal(t, true, ok)
+	assert.Equal(t, time.Duration(0), until)
+}
+
+func TestForecast_SingleSample(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	f := forecast([]sample{{time: start, size: 1000}}, 2000)
+	assert.Equal(t, uint64(1000), f.DataDirBytes)
+	assert.Equal(t, 0, len(f.GrowthRates))
+	_, ok := f.UntilThreshold()
+	assert.Equal(t, false, ok)
+
+	assert.Equal(t, true, forecast(nil, 2000) == nil)
+}
@@ -0,0 +1,5 @@
+package diskusage
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "disk-usage")
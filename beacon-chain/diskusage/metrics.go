@@ -0,0 +1,29 @@
+package diskusage
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	dataDirSizeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "disk_usage_data_dir_bytes",
+		Help: "The size of the data directory of the beacon node",
+	})
+	thresholdGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "disk_usage_threshold_bytes",
+		Help: "The size of the data directory which the forecast is made for",
+	})
+	growthRateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "disk_usage_growth_bytes_per_second",
+		Help: "The growth rate of the data directory, and of the blocks, states and blobs written to it",
+	}, []string{"kind"})
+	secondsUntilThresholdGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "disk_usage_seconds_until_threshold",
+		Help: "The forecast number of seconds until the data directory reaches the threshold, -1 if it is not growing",
+	})
+	pruneActionsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "disk_usage_prune_actions_total",
+		Help: "The number of pruning actions run because of the forecast, by action and result",
+	}, []string{"action", "result"})
+)
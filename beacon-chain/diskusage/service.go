@@ -0,0 +1,253 @@
+package diskusage
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// pruneCooldown is the minimum time between two runs of the pruning actions, so that the actions have the time
+	// to reflect in the forecast.
+	pruneCooldown = time.Hour
+	// walkInterval is the minimum time between two walks of the data directory, which can hold millions of files.
+	// In between, the size of the data directory is estimated from the bytes written since the last walk.
+	walkInterval = 6 * time.Hour
+)
+
+// PruneAction is a configured action which frees disk space when the data directory is forecast to reach the
+// threshold soon.
+type PruneAction struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Config contains the data directory to forecast the usage of, and what to do about it.
+type Config struct {
+	DataDir string
+	// ThresholdBytes is the size of the data directory the forecast is made for. When zero, it is the size the
+	// data directory would have if it used up all the free space of its disk.
+	ThresholdBytes uint64
+	// WriteCounters return the bytes written to the data directory since the node started, by kind of data.
+	WriteCounters map[string]func() uint64
+	// PruneActions run when the data directory is forecast to reach the threshold within the PruneHorizon.
+	PruneActions []PruneAction
+	PruneHorizon time.Duration
+	// SampleInterval is the time between two samples of the data directory.
+	SampleInterval time.Duration
+	// Window is how long the samples are kept to make the forecast.
+	Window time.Duration
+}
+
+// Fetcher returns the latest forecast of the disk usage of the data directory.
+type Fetcher interface {
+	Forecast() *Forecast
+}
+
+// Service samples the disk usage of the data directory and forecasts when it will reach the threshold.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// Locks access to samples, latest and lastPruned.
+	sync.RWMutex
+	samples    []sample
+	latest     *Forecast
+	lastPruned time.Time
+	// The last walk of the data directory, only accessed by update.
+	lastWalk walk
+}
+
+// walk is the size of the data directory when it was last walked, and the bytes written until then.
+type walk struct {
+	time    time.Time
+	size    uint64
+	written uint64
+}
+
+var _ Fetcher = (*Service)(nil)
+
+// NewService returns a disk usage forecasting service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start the disk usage service.
+func (s *Service) Start() {
+	actions := make([]string, len(s.cfg.PruneActions))
+	for i, a := range s.cfg.PruneActions {
+		actions[i] = a.Name
+	}
+	log.WithFields(logrus.Fields{
+		"thresholdBytes": s.cfg.ThresholdBytes,
+		"pruneActions":   actions,
+	}).Info("Starting service")
+	go s.run()
+}
+
+// Stop the disk usage service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the disk usage service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Forecast returns the latest forecast, or nil if the data directory has not been sampled yet.
+func (s *Service) Forecast() *Forecast {
+	s.RLock()
+	defer s.RUnlock()
+	return s.latest
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.SampleInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.update(time.Now()); err != nil {
+			log.WithError(err).Error("Could not forecast disk usage")
+		}
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// update samples the data directory, updates the forecast and runs the pruning actions if needed.
+func (s *Service) update(now time.Time) error {
+	smp := sample{time: now, written: make(map[string]uint64, len(s.cfg.WriteCounters))}
+	var written uint64
+	for kind, counter := range s.cfg.WriteCounters {
+		smp.written[kind] = counter()
+		written += smp.written[kind]
+	}
+	size, err := s.size(now, written)
+	if err != nil {
+		return err
+	}
+	smp.size = size
+	threshold := s.cfg.ThresholdBytes
+	if threshold == 0 {
+		free, _, err := file.DiskUsage(s.cfg.DataDir)
+		if err != nil {
+			return err
+		}
+		threshold = size + free
+	}
+
+	s.Lock()
+	s.samples = append(s.samples, smp)
+	for len(s.samples) > 1 && now.Sub(s.samples[0].time) > s.cfg.Window {
+		s.samples = s.samples[1:]
+	}
+	f := forecast(s.samples, threshold)
+	f.LastPruned = s.lastPruned
+	s.latest = f
+	s.Unlock()
+
+	dataDirSizeGauge.Set(float64(f.DataDirBytes))
+	thresholdGauge.Set(float64(f.ThresholdBytes))
+	for kind, rate := range f.GrowthRates {
+		growthRateGauge.WithLabelValues(kind).Set(rate)
+	}
+	until, ok := f.UntilThreshold()
+	if !ok {
+		secondsUntilThresholdGauge.Set(-1)
+		return nil
+	}
+	secondsUntilThresholdGauge.Set(until.Seconds())
+
+	if until > s.cfg.PruneHorizon || len(s.cfg.PruneActions) == 0 || now.Sub(f.LastPruned) < pruneCooldown {
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"dataDirBytes":   f.DataDirBytes,
+		"thresholdBytes": f.ThresholdBytes,
+		"untilThreshold": until.Round(time.Minute),
+	}).Warn("Data directory is forecast to reach the threshold soon, pruning")
+	s.prune(now)
+	return nil
+}
+
+// size returns the size of the data directory, walking it if the last walk is older than the walk interval.
+func (s *Service) size(now time.Time, written uint64) (uint64, error) {
+	if !s.lastWalk.time.IsZero() && now.Sub(s.lastWalk.time) < walkInterval && written >= s.lastWalk.written {
+		return s.lastWalk.size + written - s.lastWalk.written, nil
+	}
+	size, err := dirSize(s.cfg.DataDir)
+	if err != nil {
+		return 0, err
+	}
+	s.lastWalk = walk{time: now, size: size, written: written}
+	return size, nil
+}
+
+// prune runs the configured pruning actions.
+func (s *Service) prune(now time.Time) {
+	s.Lock()
+	s.lastPruned = now
+	s.latest.LastPruned = now
+	s.Unlock()
+	// Walk the data directory on the next update to see the space freed by the actions.
+	s.lastWalk = walk{}
+	for _, a := range s.cfg.PruneActions {
+		start := time.Now()
+		if err := a.Run(s.ctx); err != nil {
+			pruneActionsCounter.WithLabelValues(a.Name, "failure").Inc()
+			log.WithError(err).WithField("action", a.Name).Error("Could not prune")
+			continue
+		}
+		pruneActionsCounter.WithLabelValues(a.Name, "success").Inc()
+		log.WithFields(logrus.Fields{
+			"action":   a.Name,
+			"duration": time.Since(start),
+		}).Info("Pruned data directory")
+	}
+}
+
+// dirSize returns the total size of the regular files under the directory.
+func dirSize(dir string) (uint64, error) {
+	var size uint64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Files may be removed while the directory is walked, e.g. blobs being pruned.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		size += uint64(info.Size())
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not get size of %s", dir)
+	}
+	return size, nil
+}
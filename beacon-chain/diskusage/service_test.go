@@ -0,0 +1,83 @@
+package diskusage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestDirSize(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), make([]byte, 100), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b"), make([]byte, 50), 0600))
+	size, err := dirSize(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(150), size)
+}
+
+func TestService_Update(t *testing.T) {
+	dir := t.TempDir()
+	var written uint64
+	pruned := 0
+	s := NewService(context.Background(), &Config{
+		DataDir:        dir,
+		ThresholdBytes: 1000,
+		WriteCounters:  map[string]func() uint64{"blocks": func() uint64 { return written }},
+		PruneActions: []PruneAction{{Name: "test", Run: func(context.Context) error {
+			pruned++
+			return nil
+		}}},
+		PruneHorizon: time.Hour,
+		Window:       time.Hour,
+	})
+	require.Equal(t, true, s.Forecast() == nil)
+
+	start := time.Unix(1700000000, 0)
+	require.NoError(t, s.update(start))
+	f := s.Forecast()
+	require.NotNil(t, f)
+	assert.Equal(t, uint64(0), f.DataDirBytes)
+	assert.Equal(t, 0, pruned)
+
+	// Growing by 100 bytes in 100 seconds, the threshold is reached in 900 seconds.
+	written = 100
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "blocks"), make([]byte, 100), 0600))
+	require.NoError(t, s.update(start.Add(100*time.Second)))
+	f = s.Forecast()
+	assert.Equal(t, uint64(100), f.DataDirBytes)
+	assert.Equal(t, 1.0, f.GrowthRates["blocks"])
+	until, ok := f.UntilThreshold()
+	require.Equal(t, true, ok)
+	assert.Equal(t, 900*time.Second, until)
+	assert.Equal(t, 1, pruned)
+	assert.Equal(t, start.Add(100*time.Second), f.LastPruned)
+
+	// The pruning actions do not run again before the cooldown.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "blocks"), make([]byte, 200), 0600))
+	require.NoError(t, s.update(start.Add(200*time.Second)))
+	assert.Equal(t, 1, pruned)
+	// The data directory is walked again after pruning.
+	assert.Equal(t, uint64(200), s.Forecast().DataDirBytes)
+
+	// Between walks, the size is estimated from the bytes written.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "blocks"), make([]byte, 500), 0600))
+	written = 150
+	require.NoError(t, s.update(start.Add(300*time.Second)))
+	assert.Equal(t, uint64(250), s.Forecast().DataDirBytes)
+
+	// Samples older than the window are dropped.
+	require.NoError(t, s.update(start.Add(2*time.Hour)))
+	s.RLock()
+	assert.Equal(t, 1, len(s.samples))
+	s.RUnlock()
+	assert.Equal(t, 1, pruned)
+
+	require.NoError(t, s.update(start.Add(200*time.Second+walkInterval)))
+	assert.Equal(t, uint64(500), s.Forecast().DataDirBytes)
+}
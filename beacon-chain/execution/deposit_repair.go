@@ -0,0 +1,280 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	contracts "github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// DepositRepairer checks the deposit cache against the beacon state and repairs it from the logs of the
+// deposit contract, so that a corrupted cache does not require a full resync.
+type DepositRepairer interface {
+	CheckDeposits(ctx context.Context, eth1Data *ethpb.Eth1Data) (*DepositCheck, error)
+	RepairDeposits(ctx context.Context, fromBlock, toBlock uint64) (*DepositRepair, error)
+}
+
+// DepositCheck is the result of the comparison of the deposit cache with the eth1 data of a beacon state.
+type DepositCheck struct {
+	// DepositCount is the number of deposits in the deposit cache.
+	DepositCount uint64
+	// TreeDepositCount is the number of deposits in the deposit tree of the execution service.
+	TreeDepositCount uint64
+	// StateDepositCount is the deposit count of the eth1 data of the beacon state.
+	StateDepositCount uint64
+	// LastBlock is the execution block of the last cached deposit, zero when the cache holds no deposit.
+	LastBlock uint64
+	// Problems found in the deposit cache, the cache is consistent when empty.
+	Problems []string
+}
+
+// Consistent returns true when no problem was found in the deposit cache.
+func (c *DepositCheck) Consistent() bool {
+	return len(c.Problems) == 0
+}
+
+// DepositRepair is the result of the repair of the deposit cache from a range of execution blocks.
+type DepositRepair struct {
+	FromBlock uint64
+	ToBlock   uint64
+	// LogsScanned is the number of deposit logs found in the range.
+	LogsScanned uint64
+	// RemovedDeposits is the number of cached deposits removed as they did not match the logs.
+	RemovedDeposits uint64
+	// AddedDeposits is the number of deposits inserted from the logs.
+	AddedDeposits uint64
+	// DepositCount is the number of deposits in the deposit cache after the repair.
+	DepositCount uint64
+}
+
+// CheckDeposits compares the deposit cache with the eth1 data of a beacon state. The cache must hold at least the
+// deposits of the state, and its deposit root at the deposit count of the state must be the one of the state.
+func (s *Service) CheckDeposits(ctx context.Context, eth1Data *ethpb.Eth1Data) (*DepositCheck, error) {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+
+	fd, err := s.cfg.depositCache.FinalizedDeposits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized deposits")
+	}
+	ctrs := s.cfg.depositCache.AllDepositContainers(ctx)
+	check := &DepositCheck{
+		DepositCount:      uint64(fd.MerkleTrieIndex() + 1),
+		TreeDepositCount:  uint64(s.depositTrie.NumOfItems()),
+		StateDepositCount: eth1Data.DepositCount,
+	}
+	if len(ctrs) > 0 {
+		last := ctrs[len(ctrs)-1]
+		check.DepositCount = uint64(last.Index + 1)
+		check.LastBlock = last.Eth1BlockHeight
+		for i, c := range ctrs {
+			if c.Index != ctrs[0].Index+int64(i) {
+				check.Problems = append(check.Problems, fmt.Sprintf("deposit %d is missing from the cache", ctrs[0].Index+int64(i)))
+				break
+			}
+		}
+	}
+	if check.TreeDepositCount != check.DepositCount {
+		check.Problems = append(check.Problems, fmt.Sprintf("the deposit tree holds %d deposits but the cache holds %d", check.TreeDepositCount, check.DepositCount))
+	}
+	if check.DepositCount < check.StateDepositCount {
+		check.Problems = append(check.Problems, fmt.Sprintf("the cache holds %d deposits but the state expects %d", check.DepositCount, check.StateDepositCount))
+		return check, nil
+	}
+	if check.StateDepositCount == 0 {
+		return check, nil
+	}
+	// The containers hold the root of the deposit tree after their deposit.
+	var root []byte
+	for _, c := range ctrs {
+		if uint64(c.Index+1) == check.StateDepositCount {
+			root = c.DepositRoot
+			break
+		}
+	}
+	if root == nil && uint64(fd.MerkleTrieIndex()+1) == check.StateDepositCount {
+		r, err := fd.Deposits().HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute root of finalized deposits")
+		}
+		root = r[:]
+	}
+	if root != nil && !bytes.Equal(root, eth1Data.DepositRoot) {
+		check.Problems = append(check.Problems, fmt.Sprintf("the deposit root at count %d is %#x but the state expects %#x", check.StateDepositCount, root, eth1Data.DepositRoot))
+	}
+	return check, nil
+}
+
+// RepairDeposits scans the deposit logs of the execution blocks in the range (inclusive), and repairs the deposit
+// cache and tree from them. Cached deposits not matching the logs are removed along with all the following ones, and
+// the deposits of the logs missing from the cache are inserted. Finalized deposits can not be repaired, and the logs
+// must cover all the deposits following the ones kept in the cache. The repair is only possible after chain start.
+func (s *Service) RepairDeposits(ctx context.Context, fromBlock, toBlock uint64) (*DepositRepair, error) {
+	if fromBlock > toBlock {
+		return nil, errors.Errorf("from block %d is after to block %d", fromBlock, toBlock)
+	}
+	if !s.chainStartData.Chainstarted {
+		return nil, errors.New("deposits can not be repaired before chain start")
+	}
+	logs, err := s.depositLogs(ctx, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+
+	repair := &DepositRepair{
+		FromBlock:   fromBlock,
+		ToBlock:     toBlock,
+		LogsScanned: uint64(len(logs)),
+	}
+	ctrs := s.cfg.depositCache.AllDepositContainers(ctx)
+	mismatch := int64(-1)
+	for _, l := range logs {
+		index, root, err := depositLogRoot(l)
+		if err != nil {
+			return nil, err
+		}
+		if len(ctrs) == 0 || index < ctrs[0].Index || index > ctrs[len(ctrs)-1].Index {
+			continue
+		}
+		ctr := ctrs[index-ctrs[0].Index]
+		cached, err := ctr.Deposit.Data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash cached deposit")
+		}
+		if ctr.Index != index || cached != root {
+			mismatch = index
+			break
+		}
+	}
+	if mismatch >= 0 {
+		if err := s.cfg.depositCache.TruncateDeposits(ctx, mismatch); err != nil {
+			return nil, errors.Wrapf(err, "could not remove deposits from index %d, the node must be resynced", mismatch)
+		}
+		if err := s.rebuildDepositTrie(ctx); err != nil {
+			return nil, err
+		}
+		repair.RemovedDeposits = uint64(ctrs[len(ctrs)-1].Index - mismatch + 1)
+		log.WithFields(logrus.Fields{
+			"fromIndex": mismatch,
+			"removed":   repair.RemovedDeposits,
+		}).Warn("Removed cached deposits not matching the deposit logs")
+	} else if len(ctrs) > 0 && int64(s.depositTrie.NumOfItems()) != ctrs[len(ctrs)-1].Index+1 {
+		if err := s.rebuildDepositTrie(ctx); err != nil {
+			return nil, err
+		}
+		log.WithField("depositCount", s.depositTrie.NumOfItems()).Warn("Rebuilt deposit tree not matching the deposit cache")
+	}
+
+	for i := range logs {
+		index, _, err := depositLogRoot(logs[i])
+		if err != nil {
+			return nil, err
+		}
+		if index <= s.lastReceivedMerkleIndex {
+			continue
+		}
+		if err := s.ProcessDepositLog(ctx, &logs[i]); err != nil {
+			return nil, errors.Wrapf(err, "could not insert deposit %d, the range may not cover all missing deposits", index)
+		}
+		repair.AddedDeposits++
+	}
+	repair.DepositCount = uint64(s.lastReceivedMerkleIndex + 1)
+	if repair.RemovedDeposits > 0 || repair.AddedDeposits > 0 {
+		if err := s.savePowchainData(ctx); err != nil {
+			return nil, errors.Wrap(err, "could not save repaired deposits")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"fromBlock":    fromBlock,
+		"toBlock":      toBlock,
+		"logs":         repair.LogsScanned,
+		"removed":      repair.RemovedDeposits,
+		"added":        repair.AddedDeposits,
+		"depositCount": repair.DepositCount,
+	}).Info("Repaired deposit cache from deposit logs")
+	return repair, nil
+}
+
+// depositLogs returns the deposit logs of the execution blocks in the range (inclusive), which are ordered by
+// deposit index.
+func (s *Service) depositLogs(ctx context.Context, fromBlock, toBlock uint64) ([]gethtypes.Log, error) {
+	var logs []gethtypes.Log
+	batchSize := max(s.cfg.eth1HeaderReqLimit, 1)
+	for start := fromBlock; start <= toBlock; start += batchSize {
+		end := min(start+batchSize-1, toBlock)
+		query := ethereum.FilterQuery{
+			Addresses: []common.Address{s.cfg.depositContractAddr},
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+		}
+		batch, err := s.httpLogger.FilterLogs(ctx, query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get logs of blocks %d to %d", start, end)
+		}
+		for _, l := range batch {
+			if len(l.Topics) > 0 && l.Topics[0] == depositEventSignature && !l.Removed {
+				logs = append(logs, l)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// rebuildDepositTrie rebuilds the deposit tree of the service from the deposit cache.
+func (s *Service) rebuildDepositTrie(ctx context.Context) error {
+	fd, err := s.cfg.depositCache.FinalizedDeposits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized deposits")
+	}
+	tree, ok := fd.Deposits().(*depositsnapshot.DepositTree)
+	if !ok {
+		return errors.New("deposit tree was not EIP4881 DepositTree")
+	}
+	for _, c := range s.cfg.depositCache.AllDepositContainers(ctx) {
+		if c.Index <= fd.MerkleTrieIndex() {
+			continue
+		}
+		root, err := c.Deposit.Data.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if err := tree.Insert(root[:], int(c.Index)); err != nil {
+			return errors.Wrapf(err, "could not insert deposit %d", c.Index)
+		}
+	}
+	s.depositTrie = tree
+	s.lastReceivedMerkleIndex = int64(tree.NumOfItems() - 1)
+	return nil
+}
+
+func depositLogRoot(l gethtypes.Log) (int64, [32]byte, error) {
+	pubkey, withdrawalCredentials, amount, signature, merkleTreeIndex, err := contracts.UnpackDepositLogData(l.Data)
+	if err != nil {
+		return 0, [32]byte{}, errors.Wrap(err, "could not unpack log")
+	}
+	data := &ethpb.Deposit_Data{
+		PublicKey:             pubkey,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                bytesutil.FromBytes8(amount),
+		Signature:             signature,
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return 0, [32]byte{}, errors.Wrap(err, "could not hash deposit data")
+	}
+	return int64(binary.LittleEndian.Uint64(merkleTreeIndex)), root, nil // lint:ignore uintcast -- MerkleTreeIndex should not exceed int64 in your lifetime.
+}
@@ -0,0 +1,105 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	contracts "github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v5/contracts/deposit/mock"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestRepairDeposits(t *testing.T) {
+	ctx := context.Background()
+	testAcc, err := mock.Setup()
+	require.NoError(t, err, "Unable to set up simulated backend")
+	depositCache, err := depositsnapshot.New()
+	require.NoError(t, err)
+	server, endpoint, err := mockExecution.SetupRPCServer()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		server.Stop()
+	})
+	web3Service, err := NewService(ctx,
+		WithHttpEndpoint(endpoint),
+		WithDepositContractAddress(testAcc.ContractAddr),
+		WithDatabase(testDB.SetupDB(t)),
+		WithDepositCache(depositCache),
+	)
+	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
+	web3Service = setDefaultMocks(web3Service)
+	web3Service.httpLogger = &goodLogger{backend: testAcc.Backend}
+	web3Service.depositContractCaller, err = contracts.NewDepositContractCaller(testAcc.ContractAddr, testAcc.Backend)
+	require.NoError(t, err)
+	testAcc.Backend.Commit()
+
+	deposits, _, err := util.DeterministicDepositsAndKeys(3)
+	require.NoError(t, err)
+	_, depositRoots, err := util.DeterministicDepositTrie(len(deposits))
+	require.NoError(t, err)
+	testAcc.TxOpts.Value = mock.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	for i, d := range deposits {
+		_, err = testAcc.Contract.Deposit(testAcc.TxOpts, d.Data.PublicKey, d.Data.WithdrawalCredentials, d.Data.Signature, depositRoots[i])
+		require.NoError(t, err, "Could not deposit to deposit contract")
+	}
+	testAcc.Backend.Commit()
+
+	logs, err := testAcc.Backend.FilterLogs(ctx, ethereum.FilterQuery{Addresses: []common.Address{testAcc.ContractAddr}})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(logs))
+	latest := logs[2].BlockNumber
+	web3Service.chainStartData.Chainstarted = true
+	require.NoError(t, web3Service.ProcessLog(ctx, &logs[0]))
+
+	// A corrupted cache holding the wrong second deposit, and missing the third.
+	wrong := &ethpb.Deposit{Data: deposits[2].Data}
+	root, err := wrong.Data.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, web3Service.depositTrie.Insert(root[:], 1))
+	require.NoError(t, depositCache.InsertDeposit(ctx, wrong, logs[1].BlockNumber, 1, [32]byte{'a'}))
+	web3Service.lastReceivedMerkleIndex = 1
+
+	depositRoot, err := testAcc.Contract.GetDepositRoot(&bind.CallOpts{})
+	require.NoError(t, err)
+	eth1Data := &ethpb.Eth1Data{DepositCount: 3, DepositRoot: depositRoot[:]}
+	check, err := web3Service.CheckDeposits(ctx, eth1Data)
+	require.NoError(t, err)
+	assert.Equal(t, false, check.Consistent())
+	assert.Equal(t, uint64(2), check.DepositCount)
+
+	repair, err := web3Service.RepairDeposits(ctx, 0, latest)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), repair.LogsScanned)
+	assert.Equal(t, uint64(1), repair.RemovedDeposits)
+	assert.Equal(t, uint64(2), repair.AddedDeposits)
+	assert.Equal(t, uint64(3), repair.DepositCount)
+
+	check, err = web3Service.CheckDeposits(ctx, eth1Data)
+	require.NoError(t, err)
+	assert.Equal(t, true, check.Consistent(), "unexpected problems %v", check.Problems)
+	treeRoot, err := web3Service.depositTrie.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, depositRoot, treeRoot)
+
+	// Repairing a consistent cache changes nothing.
+	repair, err = web3Service.RepairDeposits(ctx, 0, latest)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), repair.RemovedDeposits)
+	assert.Equal(t, uint64(0), repair.AddedDeposits)
+
+	_, err = web3Service.RepairDeposits(ctx, latest, 0)
+	require.ErrorContains(t, "is after to block", err)
+	web3Service.chainStartData.Chainstarted = false
+	_, err = web3Service.RepairDeposits(ctx, 0, latest)
+	require.ErrorContains(t, "before chain start", err)
+}
@@ -126,6 +126,7 @@ var ErrEmptyBlockHash = errors.New("Block hash is empty 0x0000...")
 func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionData, versionedHashes []common.Hash, parentBlockRoot *common.Hash, executionRequests *pb.ExecutionRequests) ([]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.NewPayload")
 	defer span.End()
+	ctx = trace.WithBaggage(ctx, "block_hash", fmt.Sprintf("%#x", payload.BlockHash()))
 	start := time.Now()
 	defer func() {
 		newPayloadLatency.Observe(float64(time.Since(start).Milliseconds()))
@@ -201,6 +202,9 @@ func (s *Service) ForkchoiceUpdated(
 ) (*pb.PayloadIDBytes, []byte, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ForkchoiceUpdated")
 	defer span.End()
+	if state != nil {
+		ctx = trace.WithBaggage(ctx, "head_block_hash", fmt.Sprintf("%#x", state.HeadBlockHash))
+	}
 	start := time.Now()
 	defer func() {
 		forkchoiceUpdatedLatency.Observe(float64(time.Since(start).Milliseconds()))
@@ -288,7 +292,7 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	defer func() {
 		getPayloadLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	d := time.Now().Add(defaultEngineTimeout)
+	d := time.Now().Add(s.getPayloadTimeout())
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
 
@@ -304,6 +308,13 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	return res, nil
 }
 
+func (s *Service) getPayloadTimeout() time.Duration {
+	if s.cfg == nil || s.cfg.getPayloadTimeout <= 0 {
+		return defaultEngineTimeout
+	}
+	return s.cfg.getPayloadTimeout
+}
+
 func (s *Service) ExchangeCapabilities(ctx context.Context) ([]string, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ExchangeCapabilities")
 	defer span.End()
@@ -0,0 +1,128 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+)
+
+const (
+	// maxRecordedBodySize caps how many bytes of a request or response are written per recorded call,
+	// execution payloads can be several megabytes on mainnet.
+	maxRecordedBodySize = 4096
+	// Record files are rotated once they reach this size, keeping the previous engineRecordBackups files.
+	engineRecordMaxSize = 100 * 1024 * 1024
+	engineRecordBackups = 5
+)
+
+// recordedEngineMethods are the prefixes of the Engine API methods which the recorder writes out.
+var recordedEngineMethods = []string{
+	"engine_newPayload",
+	"engine_forkchoiceUpdated",
+	"engine_getPayload",
+}
+
+// engineCallRecord is a single line in the engine API record file.
+type engineCallRecord struct {
+	Time       time.Time `json:"time"`
+	Method     string    `json:"method"`
+	DurationMs int64     `json:"duration_ms"`
+	Request    string    `json:"request"`
+	Response   string    `json:"response,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// engineRecorder writes every Engine API call made through a client it wraps, along with the
+// response and how long the call took, as a line of JSON. It is meant for debugging desyncs
+// between the consensus and execution clients.
+type engineRecorder struct {
+	sync.Mutex
+	w io.WriteCloser
+}
+
+// newEngineRecorder opens a rotating record file at path.
+func newEngineRecorder(path string) (*engineRecorder, error) {
+	f, err := logs.NewRotatingFile(path, engineRecordMaxSize, engineRecordBackups)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open engine API record file")
+	}
+	return &engineRecorder{w: f}, nil
+}
+
+// wrap returns an RPCClient which records calls made through c.
+func (r *engineRecorder) wrap(c RPCClient) RPCClient {
+	return &recordingRPCClient{RPCClient: c, rec: r}
+}
+
+// Close closes the underlying record file.
+func (r *engineRecorder) Close() error {
+	r.Lock()
+	defer r.Unlock()
+	return r.w.Close()
+}
+
+type recordingRPCClient struct {
+	RPCClient
+	rec *engineRecorder
+}
+
+// CallContext --
+func (c *recordingRPCClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if !isRecordedEngineMethod(method) {
+		return c.RPCClient.CallContext(ctx, result, method, args...)
+	}
+	start := time.Now()
+	err := c.RPCClient.CallContext(ctx, result, method, args...)
+	rec := &engineCallRecord{
+		Time:       start,
+		Method:     method,
+		DurationMs: time.Since(start).Milliseconds(),
+		Request:    truncatedJSON(args),
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	} else {
+		rec.Response = truncatedJSON(result)
+	}
+	c.rec.record(rec)
+	return err
+}
+
+func (r *engineRecorder) record(rec *engineCallRecord) {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		log.WithError(err).Debug("Could not encode engine API call record")
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if _, err := r.w.Write(append(b, '\n')); err != nil {
+		log.WithError(err).Debug("Could not write engine API call record")
+	}
+}
+
+func isRecordedEngineMethod(method string) bool {
+	for _, m := range recordedEngineMethods {
+		if strings.HasPrefix(method, m) {
+			return true
+		}
+	}
+	return false
+}
+
+func truncatedJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "(could not encode: " + err.Error() + ")"
+	}
+	if len(b) > maxRecordedBodySize {
+		return string(b[:maxRecordedBodySize]) + "...(truncated)"
+	}
+	return string(b)
+}
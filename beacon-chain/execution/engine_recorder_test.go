@@ -0,0 +1,73 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type stubRPCClient struct {
+	err error
+}
+
+func (stubRPCClient) Close() {}
+
+func (stubRPCClient) BatchCall([]gethRPC.BatchElem) error {
+	return nil
+}
+
+func (c stubRPCClient) CallContext(_ context.Context, result interface{}, _ string, _ ...interface{}) error {
+	if c.err != nil {
+		return c.err
+	}
+	if s, ok := result.(*string); ok {
+		*s = "VALID"
+	}
+	return nil
+}
+
+type nopCloseBuffer struct {
+	bytes.Buffer
+}
+
+func (*nopCloseBuffer) Close() error {
+	return nil
+}
+
+func TestEngineRecorder_CallContext(t *testing.T) {
+	ctx := context.Background()
+	buf := &nopCloseBuffer{}
+	rec := &engineRecorder{w: buf}
+
+	c := rec.wrap(stubRPCClient{})
+	var result string
+	require.NoError(t, c.CallContext(ctx, &result, NewPayloadMethodV3, strings.Repeat("a", 2*maxRecordedBodySize)))
+	require.Equal(t, "VALID", result)
+	// Methods outside the engine namespace are not recorded.
+	require.NoError(t, c.CallContext(ctx, &result, BlockByNumberMethod, "latest"))
+
+	failing := rec.wrap(stubRPCClient{err: errors.New("connection refused")})
+	require.ErrorContains(t, "connection refused", failing.CallContext(ctx, &result, ForkchoiceUpdatedMethodV3, "state"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 2, len(lines))
+
+	first := &engineCallRecord{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), first))
+	require.Equal(t, NewPayloadMethodV3, first.Method)
+	require.Equal(t, `"VALID"`, first.Response)
+	require.Equal(t, true, strings.HasSuffix(first.Request, "...(truncated)"))
+	require.Equal(t, maxRecordedBodySize+len("...(truncated)"), len(first.Request))
+
+	second := &engineCallRecord{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), second))
+	require.Equal(t, ForkchoiceUpdatedMethodV3, second.Method)
+	require.Equal(t, "connection refused", second.Error)
+	require.Equal(t, "", second.Response)
+}
@@ -63,6 +63,14 @@ var (
 		Name: "execution_server_error_count",
 		Help: "The number of errors that occurred due to server error",
 	})
+	historicalPayloadsReconstructed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_payloads_reconstructed_total",
+		Help: "Count the number of historical blinded blocks replaced in the db by full blocks reconstructed from the execution client",
+	})
+	historicalPayloadReconstructionSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "historical_payload_reconstruction_slot",
+		Help: "The highest slot up to which historical blinded blocks have been reconstructed",
+	})
 	reconstructedExecutionPayloadCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "reconstructed_execution_payload_count",
 		Help: "Count the number of execution payloads that are reconstructed using JSON-RPC from payload headers",
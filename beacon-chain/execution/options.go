@@ -1,6 +1,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -124,3 +126,36 @@ func WithVerifierWaiter(v *verification.InitializerWaiter) Option {
 		return nil
 	}
 }
+
+// WithEngineAPIRecordFile records every Engine API call made by the service to the given file.
+func WithEngineAPIRecordFile(path string) Option {
+	return func(s *Service) error {
+		if path == "" {
+			return nil
+		}
+		r, err := newEngineRecorder(path)
+		if err != nil {
+			return err
+		}
+		log.WithField("path", path).Warn("Recording Engine API calls, this is meant for debugging and will use additional disk space")
+		s.engineRecorder = r
+		return nil
+	}
+}
+
+// WithHistoricalPayloadReconstruction replaces finalized blinded blocks in the db with full blocks in the background,
+// using payload bodies from the execution client.
+func WithHistoricalPayloadReconstruction(enabled bool) Option {
+	return func(s *Service) error {
+		s.cfg.reconstructHistoricalPayloads = enabled
+		return nil
+	}
+}
+
+// WithGetPayloadTimeout sets how long to wait for the execution client to respond to engine_getPayload.
+func WithGetPayloadTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.getPayloadTimeout = timeout
+		return nil
+	}
+}
@@ -42,6 +42,28 @@ func reconstructBlindedBlockBatch(ctx context.Context, client RPCClient, sbb []i
 	return r.unblinded()
 }
 
+// reconstructBlindedBlockBatchByRange is like reconstructBlindedBlockBatch, but requests every payload body with
+// engine_getPayloadBodiesByRange. This is cheaper for runs of consecutive canonical blocks, such as finalized history.
+func reconstructBlindedBlockBatchByRange(ctx context.Context, client RPCClient, sbb []interfaces.ReadOnlySignedBeaconBlock) ([]interfaces.SignedBeaconBlock, error) {
+	r, err := newBlindedBlockReconstructor(sbb)
+	if err != nil {
+		return nil, err
+	}
+	for method, batch := range r.batches {
+		hbns := make([]hashBlockNumber, 0, len(batch))
+		for h, n := range batch {
+			hbns = append(hbns, hashBlockNumber{h: h, n: n})
+		}
+		reqs := computeRanges(hbns)
+		for i := range reqs {
+			if err := r.requestBodiesByRange(ctx, client, rangeMethodForHashMethod(method), reqs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r.unblinded()
+}
+
 func newBlindedBlockReconstructor(sbb []interfaces.ReadOnlySignedBeaconBlock) (*blindedBlockReconstructor, error) {
 	r := &blindedBlockReconstructor{
 		orderedBlocks: make([]*blockWithHeader, 0, len(sbb)),
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
@@ -333,6 +334,44 @@ func TestReconstructBlindedBlockBatchFallbackToRange(t *testing.T) {
 	})
 }
 
+func TestReconstructBlindedBlockBatchByRange(t *testing.T) {
+	defer util.HackElectraMaxuint(t)()
+	ctx := context.Background()
+	cli, srv := newMockEngine(t)
+	fx := testBlindedBlockFixtures(t)
+	srv.register(GetPayloadBodiesByRangeV1, func(msg *jsonrpcMessage, w http.ResponseWriter, r *http.Request) {
+		p := mockParseUintList(t, msg.Params)
+		require.Equal(t, 2, len(p))
+		start, count := p[0], p[1]
+		if start == fx.denebBlock.blinded.header.BlockNumber() {
+			require.Equal(t, uint64(2), count)
+			mockWriteResult(t, w, msg, []*pb.ExecutionPayloadBody{
+				payloadToBody(t, fx.denebBlock.blinded.header),
+				payloadToBody(t, fx.emptyDenebBlock.blinded.header),
+			})
+			return
+		}
+		require.Equal(t, fx.afterSkipDeneb.blinded.header.BlockNumber(), start)
+		require.Equal(t, uint64(1), count)
+		mockWriteResult(t, w, msg, []*pb.ExecutionPayloadBody{
+			payloadToBody(t, fx.afterSkipDeneb.blinded.header),
+		})
+	})
+	blind := []interfaces.ReadOnlySignedBeaconBlock{
+		fx.denebBlock.blinded.block,
+		fx.emptyDenebBlock.blinded.block,
+		fx.afterSkipDeneb.blinded.block,
+	}
+	unblind, err := reconstructBlindedBlockBatchByRange(ctx, cli, blind)
+	require.NoError(t, err)
+	require.Equal(t, 0, srv.callCount(GetPayloadBodiesByHashV1))
+	require.Equal(t, 2, srv.callCount(GetPayloadBodiesByRangeV1))
+	for i := range unblind {
+		require.Equal(t, false, unblind[i].IsBlinded())
+		testAssertReconstructedEquivalent(t, blind[i], unblind[i])
+	}
+}
+
 func TestReconstructBlindedBlockBatchDenebAndElectra(t *testing.T) {
 	defer util.HackElectraMaxuint(t)()
 	t.Run("deneb and electra", func(t *testing.T) {
@@ -354,3 +393,21 @@ func TestReconstructBlindedBlockBatchDenebAndElectra(t *testing.T) {
 		}
 	})
 }
+
+func TestNewHistoricalPayloadReconstructor(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	s := &Service{cfg: &config{beaconDB: beaconDB}}
+
+	// Without a saved range, the reconstruction starts from the fork.
+	r := newHistoricalPayloadReconstructor(ctx, s, 64)
+	require.Equal(t, primitives.Slot(64), r.low)
+	require.Equal(t, primitives.Slot(64), r.high)
+
+	// The saved range is resumed.
+	r.high = 1000
+	require.NoError(t, r.save(ctx))
+	r = newHistoricalPayloadReconstructor(ctx, s, 64)
+	require.Equal(t, primitives.Slot(64), r.low)
+	require.Equal(t, primitives.Slot(1000), r.high)
+}
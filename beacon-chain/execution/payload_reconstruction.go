@@ -0,0 +1,208 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// historicalReconstructionBatchSize is the number of slots read from the db, and requested from the
+	// execution client with engine_getPayloadBodiesByRange, at a time.
+	historicalReconstructionBatchSize = 32
+	// historicalReconstructionPeriod is how long the reconstruction routine waits between passes, and after errors.
+	historicalReconstructionPeriod = 6 * time.Minute
+	// historicalReconstructionSaveInterval is the number of slots reconstructed between two saves of the
+	// reconstructed range, so that a restart does not redo them.
+	historicalReconstructionSaveInterval = 32 * historicalReconstructionBatchSize
+)
+
+// historicalPayloadReconstructor tracks which range of finalized slots have had their blinded blocks replaced by
+// full blocks. The range grows upwards as the chain finalizes and downwards as backfill fills in older blocks. It is
+// saved to the db, so that the reconstruction resumes where it stopped after a restart.
+type historicalPayloadReconstructor struct {
+	s    *Service
+	fork primitives.Slot
+	low  primitives.Slot
+	high primitives.Slot
+}
+
+// reconstructHistoricalPayloads runs in the background, replacing blinded finalized blocks in the db with full
+// blocks whose execution payloads are fetched from the execution client. This allows a node that was checkpoint
+// synced, and so only stores blinded blocks, to keep serving full historical blocks.
+func (s *Service) reconstructHistoricalPayloads(ctx context.Context) {
+	start, err := slots.EpochStart(params.BeaconConfig().BellatrixForkEpoch)
+	if err != nil {
+		log.WithError(err).Error("Could not determine bellatrix fork slot, not reconstructing historical payloads")
+		return
+	}
+	r := newHistoricalPayloadReconstructor(ctx, s, start)
+	ticker := time.NewTicker(historicalReconstructionPeriod)
+	defer ticker.Stop()
+	for {
+		if err := r.pass(ctx); err != nil {
+			log.WithError(err).Warn("Could not reconstruct historical execution payloads, will retry")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newHistoricalPayloadReconstructor returns a reconstructor resuming from the range saved in the db, or starting from
+// the lowest available slot if there is none.
+func newHistoricalPayloadReconstructor(ctx context.Context, s *Service, fork primitives.Slot) *historicalPayloadReconstructor {
+	r := &historicalPayloadReconstructor{s: s, fork: fork}
+	low, high, err := s.cfg.beaconDB.PayloadReconstructionRange(ctx)
+	if err == nil && fork <= low && low <= high {
+		r.low, r.high = low, high
+		log.WithFields(logrus.Fields{
+			"lowSlot":  low,
+			"highSlot": high,
+		}).Debug("Resuming historical execution payload reconstruction")
+		return r
+	}
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		log.WithError(err).Warn("Could not read historical execution payload reconstruction range, starting over")
+	}
+	r.low = r.lowestAvailableSlot(ctx)
+	r.high = r.low
+	return r
+}
+
+// pass extends the reconstructed range up to the finalized checkpoint, and down to the lowest backfilled block.
+func (r *historicalPayloadReconstructor) pass(ctx context.Context) error {
+	cp, err := r.s.cfg.beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalized, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return err
+	}
+	for r.high < finalized {
+		end := r.high + historicalReconstructionSaveInterval
+		if end > finalized {
+			end = finalized
+		}
+		reached, err := r.reconstructRange(ctx, r.high, end)
+		if reached > r.high {
+			r.high = reached
+			if err := r.save(ctx); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if low := r.lowestAvailableSlot(ctx); low < r.low {
+		reached, err := r.reconstructRange(ctx, low, r.low)
+		if err != nil {
+			return err
+		}
+		if reached == r.low {
+			r.low = low
+			if err := r.save(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	historicalPayloadReconstructionSlot.Set(float64(r.high))
+	return nil
+}
+
+// save saves the reconstructed range to the db.
+func (r *historicalPayloadReconstructor) save(ctx context.Context) error {
+	if err := r.s.cfg.beaconDB.SavePayloadReconstructionRange(ctx, r.low, r.high); err != nil {
+		return errors.Wrap(err, "could not save reconstructed range")
+	}
+	return nil
+}
+
+// lowestAvailableSlot returns the lowest slot we can expect blocks for, taking backfill into account.
+func (r *historicalPayloadReconstructor) lowestAvailableSlot(ctx context.Context) primitives.Slot {
+	bf, err := r.s.cfg.beaconDB.BackfillStatus(ctx)
+	if err != nil || bf == nil {
+		// Without backfill status the node synced from genesis, so all blocks since the merge are available.
+		return r.fork
+	}
+	low := primitives.Slot(bf.LowSlot)
+	if low < r.fork {
+		return r.fork
+	}
+	return low
+}
+
+// reconstructRange reconstructs blinded blocks in [start, end), returning the slot it got up to.
+func (r *historicalPayloadReconstructor) reconstructRange(ctx context.Context, start, end primitives.Slot) (primitives.Slot, error) {
+	for start < end {
+		if ctx.Err() != nil {
+			return start, ctx.Err()
+		}
+		batchEnd := start + historicalReconstructionBatchSize
+		if batchEnd > end {
+			batchEnd = end
+		}
+		n, err := r.reconstructBatch(ctx, start, batchEnd-1)
+		if err != nil {
+			return start, errors.Wrapf(err, "could not reconstruct blocks in slots [%d, %d)", start, batchEnd)
+		}
+		if n > 0 {
+			log.WithFields(logrus.Fields{
+				"startSlot": start,
+				"endSlot":   batchEnd - 1,
+				"count":     n,
+			}).Debug("Reconstructed historical execution payloads")
+		}
+		historicalPayloadsReconstructed.Add(float64(n))
+		start = batchEnd
+	}
+	return start, nil
+}
+
+func (r *historicalPayloadReconstructor) reconstructBatch(ctx context.Context, start, end primitives.Slot) (int, error) {
+	blks, roots, err := r.s.cfg.beaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not read blocks from db")
+	}
+	blinded := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blks))
+	blindedRoots := make([][32]byte, 0, len(blks))
+	for i := range blks {
+		// Only finalized blocks are on the canonical execution chain, which is what getPayloadBodiesByRange serves.
+		if !blks[i].IsBlinded() || !r.s.cfg.beaconDB.IsFinalizedBlock(ctx, roots[i]) {
+			continue
+		}
+		blinded = append(blinded, blks[i])
+		blindedRoots = append(blindedRoots, roots[i])
+	}
+	if len(blinded) == 0 {
+		return 0, nil
+	}
+	full, err := reconstructBlindedBlockBatchByRange(ctx, r.s.rpcClient, blinded)
+	if err != nil {
+		return 0, err
+	}
+	robs := make([]blocks.ROBlock, len(full))
+	for i := range full {
+		robs[i], err = blocks.NewROBlockWithRoot(full[i], blindedRoots[i])
+		if err != nil {
+			return 0, err
+		}
+	}
+	if err := r.s.cfg.beaconDB.ReplaceBlindedBlocks(ctx, robs); err != nil {
+		return 0, errors.Wrap(err, "could not save reconstructed blocks")
+	}
+	return len(robs), nil
+}
@@ -25,6 +25,9 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 	// Attach the clients to the service struct.
 	fetcher := ethclient.NewClient(client)
 	s.rpcClient = client
+	if s.engineRecorder != nil {
+		s.rpcClient = s.engineRecorder.wrap(client)
+	}
 	s.httpLogger = fetcher
 
 	depositContractCaller, err := contracts.NewDepositContractCaller(s.cfg.depositContractAddr, fetcher)
@@ -130,6 +130,10 @@ type config struct {
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 	jwtId                   string
+	// reconstructHistoricalPayloads enables the background replacement of blinded historical blocks by full blocks.
+	reconstructHistoricalPayloads bool
+	// getPayloadTimeout is the deadline for engine_getPayload calls, defaultEngineTimeout is used when unset.
+	getPayloadTimeout time.Duration
 }
 
 // Service fetches important information about the canonical
@@ -160,6 +164,7 @@ type Service struct {
 	verifierWaiter          *verification.InitializerWaiter
 	blobVerifier            verification.NewBlobVerifier
 	capabilityCache         *capabilityCache
+	engineRecorder          *engineRecorder
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -248,6 +253,9 @@ func (s *Service) Start() {
 	s.pollConnectionStatus(s.ctx)
 
 	go s.run(s.ctx.Done())
+	if s.cfg.reconstructHistoricalPayloads {
+		go s.reconstructHistoricalPayloads(s.ctx)
+	}
 }
 
 // Stop the web3 service's main event loop and associated goroutines.
@@ -258,6 +266,9 @@ func (s *Service) Stop() error {
 	if s.rpcClient != nil {
 		s.rpcClient.Close()
 	}
+	if s.engineRecorder != nil {
+		return s.engineRecorder.Close()
+	}
 	return nil
 }
 
@@ -385,10 +396,12 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 		}
 	}
 	validDepositsCount.Add(float64(currIndex))
+	// The deposits before the first container are in the deposit snapshot the node started from.
+	firstIndex := uint64(ctrs[0].Index)
 	// Only add pending deposits if the container slice length
 	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	if currIndex >= firstIndex && firstIndex+uint64(len(ctrs)) > currIndex {
+		for _, c := range ctrs[currIndex-firstIndex:] {
 			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -764,6 +777,20 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	}
 	s.latestEth1Data = eth1DataInDB.CurrentEth1Data
 	ctrs := eth1DataInDB.DepositContainers
+	if startedFromSnapshot(eth1DataInDB) {
+		// The node does not hold the deposits of the snapshot it started from, the deposit cache
+		// is initialized from the snapshot and the deposits following it.
+		snapshot := eth1DataInDB.DepositSnapshot
+		ctrs = make([]*ethpb.DepositContainer, 0, len(eth1DataInDB.DepositContainers))
+		for _, c := range eth1DataInDB.DepositContainers {
+			if c.Index >= int64(snapshot.DepositCount) {
+				ctrs = append(ctrs, c)
+			}
+		}
+		if err := s.cfg.depositCache.InsertSnapshot(ctx, snapshot); err != nil {
+			return errors.Wrap(err, "could not initialize deposit cache from snapshot")
+		}
+	}
 	// Look at previously finalized index, as we are building off a finalized
 	// snapshot rather than the full trie.
 	lastFinalizedIndex := int64(s.depositTrie.NumOfItems() - 1)
@@ -781,15 +808,16 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	}
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
-	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
+	if err := s.initDepositCaches(ctx, ctrs); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
 	return nil
 }
 
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
+// in order. The containers may start at any index covered by the deposit snapshot,
+// as the deposits of the snapshot a node started from are not held.
+func validateDepositContainers(ctrs []*ethpb.DepositContainer, snapshotDepositCount uint64) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -799,7 +827,11 @@ func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
 	sort.Slice(ctrs, func(i, j int) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
-	startIndex := int64(0)
+	startIndex := ctrs[0].Index
+	if startIndex > int64(snapshotDepositCount) {
+		log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
+		return false
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -810,6 +842,21 @@ func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
 	return true
 }
 
+// startedFromSnapshot returns true if the node started from a deposit snapshot, obtained
+// with checkpoint sync, rather than from the logs of the deposit contract since its
+// deployment. Such a node does not hold the deposits of the snapshot.
+func startedFromSnapshot(eth1Data *ethpb.ETH1ChainData) bool {
+	if eth1Data.DepositSnapshot.GetDepositCount() == 0 {
+		return false
+	}
+	for _, c := range eth1Data.DepositContainers {
+		if c.Index == 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // Validates the current powchain data is saved and makes sure that any
 // embedded genesis state is correctly accounted for.
 func (s *Service) validPowchainData(ctx context.Context) (*ethpb.ETH1ChainData, error) {
@@ -824,7 +871,7 @@ func (s *Service) validPowchainData(ctx context.Context) (*ethpb.ETH1ChainData,
 	if genState == nil || genState.IsNil() {
 		return eth1Data, nil
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers, eth1Data.DepositSnapshot.GetDepositCount()) {
 		pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
 		if err != nil {
 			return nil, err
@@ -449,6 +449,42 @@ func TestInitDepositCacheWithFinalization_OK(t *testing.T) {
 	assert.Equal(t, 0, len(deps))
 }
 
+func TestInitializeEth1Data_DepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	tree := depositsnapshot.NewDepositTree()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tree.Insert(bytesutil.PadTo([]byte{byte(i)}, 32), i))
+	}
+	require.NoError(t, tree.Finalize(2, [32]byte{'a'}, 100))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+
+	s := &Service{
+		cfg:                     &config{beaconDB: dbutil.SetupDB(t)},
+		lastReceivedMerkleIndex: -1,
+	}
+	s.cfg.depositCache, err = depositsnapshot.New()
+	require.NoError(t, err)
+	require.NoError(t, s.initializeEth1Data(ctx, &ethpb.ETH1ChainData{
+		CurrentEth1Data: &ethpb.LatestETH1Data{BlockHeight: 100, LastRequestedBlock: 100},
+		ChainstartData:  &ethpb.ChainStartData{Chainstarted: true, Eth1Data: &ethpb.Eth1Data{}},
+		DepositSnapshot: snapshot,
+	}))
+	assert.Equal(t, int64(2), s.lastReceivedMerkleIndex)
+	assert.Equal(t, uint64(100), s.latestEth1Data.LastRequestedBlock)
+
+	fDeposits, err := s.cfg.depositCache.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), fDeposits.MerkleTrieIndex())
+	count, root := s.cfg.depositCache.DepositsNumberAndRootAtHeight(ctx, big.NewInt(100))
+	assert.Equal(t, uint64(3), count)
+	assert.DeepEqual(t, bytesutil.ToBytes32(snapshot.DepositRoot), root)
+
+	// The deposits following the snapshot are inserted in the cache.
+	d := &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: bytesutil.PadTo([]byte{3}, 48)}}
+	require.NoError(t, s.cfg.depositCache.InsertDeposit(ctx, d, 101, 3, [32]byte{'b'}))
+}
+
 func TestNewService_EarliestVotingBlock(t *testing.T) {
 	testAcc, err := mock.Setup()
 	require.NoError(t, err, "Unable to set up simulated backend")
@@ -659,9 +695,10 @@ func TestService_EnsureValidPowchainData(t *testing.T) {
 
 func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
-		name        string
-		ctrsFunc    func() []*ethpb.DepositContainer
-		expectedRes bool
+		name          string
+		ctrsFunc      func() []*ethpb.DepositContainer
+		snapshotCount uint64
+		expectedRes   bool
 	}{
 		{
 			name: "zero containers",
@@ -692,6 +729,30 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers following the deposit snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshotCount: 5,
+			expectedRes:   true,
+		},
+		{
+			name: "containers missing after the deposit snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 6; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshotCount: 5,
+			expectedRes:   false,
+		},
 		{
 			name: "skipped containers",
 			ctrsFunc: func() []*ethpb.DepositContainer {
@@ -709,7 +770,7 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc(), test.snapshotCount))
 	}
 }
 
@@ -674,3 +674,18 @@ func (f *ForkChoice) ParentRoot(root [32]byte) ([32]byte, error) {
 	}
 	return n.parent.root, nil
 }
+
+// Children returns the roots and slots of the children of the node with the given root.
+func (f *ForkChoice) Children(root [32]byte) ([][32]byte, []primitives.Slot, error) {
+	n, ok := f.store.nodeByRoot[root]
+	if !ok || n == nil {
+		return nil, nil, ErrNilNode
+	}
+	roots := make([][32]byte, 0, len(n.children))
+	slots := make([]primitives.Slot, 0, len(n.children))
+	for _, c := range n.children {
+		roots = append(roots, c.root)
+		slots = append(slots, c.slot)
+	}
+	return roots, slots, nil
+}
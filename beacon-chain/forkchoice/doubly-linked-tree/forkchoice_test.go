@@ -912,6 +912,36 @@ func TestForkchoiceParentRoot(t *testing.T) {
 	require.Equal(t, zeroHash, root)
 }
 
+func TestForkchoiceChildren(t *testing.T) {
+	f := setup(0, 0)
+	ctx := context.Background()
+	root1 := [32]byte{'a'}
+	st, blk, err := prepareForkchoiceState(ctx, 1, root1, params.BeaconConfig().ZeroHash, [32]byte{'A'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, blk))
+	root2 := [32]byte{'b'}
+	st, blk, err = prepareForkchoiceState(ctx, 2, root2, root1, [32]byte{'B'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, blk))
+	root3 := [32]byte{'c'}
+	st, blk, err = prepareForkchoiceState(ctx, 3, root3, root1, [32]byte{'C'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, blk))
+
+	roots, slots, err := f.Children(root1)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][32]byte{root2, root3}, roots)
+	require.DeepEqual(t, []primitives.Slot{2, 3}, slots)
+
+	roots, slots, err = f.Children(root3)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(roots))
+	require.Equal(t, 0, len(slots))
+
+	_, _, err = f.Children([32]byte{'d'})
+	require.ErrorIs(t, err, ErrNilNode)
+}
+
 func TestForkChoice_CleanupInserting(t *testing.T) {
 	f := setup(0, 0)
 	ctx := context.Background()
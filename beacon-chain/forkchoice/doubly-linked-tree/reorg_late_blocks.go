@@ -3,14 +3,11 @@ package doublylinkedtree
 import (
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
-// orphanLateBlockProposingEarly determines the maximum threshold that we
-// consider the node is proposing early and sure to receive proposer boost
-const orphanLateBlockProposingEarly = 2
-
 // ShouldOverrideFCU returns whether the current forkchoice head is weak
 // and thus may be reorged when proposing the next block.
 // This function should only be called if the following two conditions are
@@ -33,6 +30,9 @@ const orphanLateBlockProposingEarly = 2
 // proposal time by calling GetProposerHead.
 func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
 	override = false
+	if features.Get().DisableReorgLateBlocks {
+		return
+	}
 
 	// We only need to override FCU if our current head is from the current
 	// slot. This differs from the spec implementation in that we assume
@@ -110,6 +110,9 @@ func (f *ForkChoice) GetProposerHead() [32]byte {
 	if head == nil {
 		return [32]byte{}
 	}
+	if features.Get().DisableReorgLateBlocks {
+		return head.root
+	}
 
 	// Only reorg blocks from the previous slot.
 	if head.slot+1 != slots.CurrentSlot(f.store.genesisTime) {
@@ -158,7 +161,7 @@ func (f *ForkChoice) GetProposerHead() [32]byte {
 		log.WithError(err).Error("could not check if proposing early")
 		return head.root
 	}
-	if secs >= orphanLateBlockProposingEarly {
+	if secs >= params.BeaconConfig().ReorgProposerCutoffSeconds {
 		return head.root
 	}
 	return parent.root
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -99,6 +100,11 @@ func TestForkChoice_ShouldOverrideFCU(t *testing.T) {
 		f.store.headNode.parent.weight = saved
 		driftGenesisTime(f, 2, orphanLateBlockFirstThreshold+1)
 	})
+	t.Run("reorgs disabled", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{DisableReorgLateBlocks: true})
+		defer resetCfg()
+		require.Equal(t, false, f.ShouldOverrideFCU())
+	})
 	t.Run("Head is strong", func(t *testing.T) {
 		f.store.headNode.weight = f.store.committeeWeight
 		require.Equal(t, false, f.ShouldOverrideFCU())
@@ -191,6 +197,18 @@ func TestForkChoice_GetProposerHead(t *testing.T) {
 		require.Equal(t, false, f.ShouldOverrideFCU())
 		f.store.headNode.parent.weight = saved
 	})
+	t.Run("reorgs disabled", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{DisableReorgLateBlocks: true})
+		defer resetCfg()
+		require.Equal(t, childRoot, f.GetProposerHead())
+	})
+	t.Run("proposing after cutoff", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ReorgProposerCutoffSeconds = 1
+		params.OverrideBeaconConfig(cfg)
+		require.Equal(t, childRoot, f.GetProposerHead())
+	})
 	t.Run("Head is strong", func(t *testing.T) {
 		f.store.headNode.weight = f.store.committeeWeight
 		require.Equal(t, childRoot, f.GetProposerHead())
@@ -58,6 +58,7 @@ type Getter interface {
 	CommonAncestor(ctx context.Context, root1 [32]byte, root2 [32]byte) ([32]byte, primitives.Slot, error)
 	ForkChoiceDump(context.Context) (*forkchoice2.Dump, error)
 	Tips() ([][32]byte, []primitives.Slot)
+	Children(root [32]byte) ([][32]byte, []primitives.Slot, error)
 }
 
 type FastGetter interface {
@@ -0,0 +1,12 @@
+/*
+Package healthscore defines a runtime service which computes, at every slot, a
+single health score of the chain and of the beacon node from 0 to 100, for fleet
+dashboards and automated failover decisions. The score is the weighted average of
+the scores of its components: the participation rate of the previous epoch, the
+distance to the finalized checkpoint, the number and quality of the connected
+peers, the lag of the head behind the current slot, and the share of the
+validators tracked by the validator monitor whose attestations are included.
+Components which can not be measured, such as the duties when no validator is
+tracked, do not count in the score.
+*/
+package healthscore
@@ -0,0 +1,5 @@
+package healthscore
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "health-score")
@@ -0,0 +1,17 @@
+package healthscore
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	healthScoreGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_health_score",
+		Help: "The health score of the chain and of the node, from 0 to 100",
+	})
+	componentScoreGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "beacon_health_score_component",
+		Help: "The score of each available component of the health score, from 0 to 100",
+	}, []string{"component"})
+)
@@ -0,0 +1,184 @@
+package healthscore
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// Components of the health score.
+const (
+	ComponentParticipation = "participation"
+	ComponentFinality      = "finality"
+	ComponentPeers         = "peers"
+	ComponentHeadLag       = "head_lag"
+	ComponentDuties        = "duties"
+)
+
+// componentWeights are the weights of the components in the health score.
+var componentWeights = map[string]float64{
+	ComponentParticipation: 0.25,
+	ComponentFinality:      0.25,
+	ComponentPeers:         0.2,
+	ComponentHeadLag:       0.2,
+	ComponentDuties:        0.1,
+}
+
+const (
+	// The participation rates of the previous epoch scoring 0 and 100.
+	minParticipation  = 0.5
+	fullParticipation = 0.95
+	// The number of epochs between the current epoch and the finalized one when the chain finalizes normally, and
+	// from which the finality component scores 0.
+	normalFinalityDistance = 2
+	maxFinalityDistance    = 10
+)
+
+// Score of the health of the chain and of the node, from 0 to 100.
+type Score struct {
+	Score      float64
+	Components []*Component
+	Time       time.Time
+}
+
+// Component of the health score, scored from 0 to 100.
+type Component struct {
+	Name   string
+	Score  float64
+	Weight float64
+	// Available is false when the component could not be measured, in which case it does not count in the
+	// health score.
+	Available bool
+	Details   map[string]string
+}
+
+// Inputs are the measurements the health score is computed from.
+type Inputs struct {
+	// ParticipationRate is the share of the active balance which attested to the correct target in the previous
+	// epoch. It is not available when ParticipationErr is set.
+	ParticipationRate float64
+	ParticipationErr  error
+	CurrentEpoch      primitives.Epoch
+	FinalizedEpoch    primitives.Epoch
+	ConnectedPeers    int
+	// GoodPeers is the number of connected peers not considered bad by the peer scorers.
+	GoodPeers   int
+	TargetPeers int
+	CurrentSlot primitives.Slot
+	HeadSlot    primitives.Slot
+	// AttestingValidators is the number of tracked validators whose attestations were recently included, out of
+	// TrackedValidators.
+	AttestingValidators int
+	TrackedValidators   int
+}
+
+// Compute the health score from the measurements. The score is the weighted average of the scores of the
+// available components.
+func Compute(in *Inputs, now time.Time) *Score {
+	components := []*Component{
+		participationComponent(in),
+		finalityComponent(in),
+		peersComponent(in),
+		headLagComponent(in),
+		dutiesComponent(in),
+	}
+	var total, weights float64
+	for _, c := range components {
+		c.Weight = componentWeights[c.Name]
+		if !c.Available {
+			continue
+		}
+		total += c.Score * c.Weight
+		weights += c.Weight
+	}
+	score := &Score{Components: components, Time: now}
+	if weights > 0 {
+		score.Score = round(total / weights)
+	}
+	return score
+}
+
+func participationComponent(in *Inputs) *Component {
+	c := newComponent(ComponentParticipation)
+	if in.ParticipationErr != nil {
+		c.Available = false
+		c.Details["error"] = in.ParticipationErr.Error()
+		return c
+	}
+	c.Details["target_participation_rate"] = strconv.FormatFloat(in.ParticipationRate, 'f', 4, 64)
+	c.Score = scale(in.ParticipationRate, minParticipation, fullParticipation)
+	return c
+}
+
+func finalityComponent(in *Inputs) *Component {
+	c := newComponent(ComponentFinality)
+	var distance primitives.Epoch
+	if in.CurrentEpoch > in.FinalizedEpoch {
+		distance = in.CurrentEpoch - in.FinalizedEpoch
+	}
+	c.Details["finality_distance_epochs"] = strconv.FormatUint(uint64(distance), 10)
+	c.Score = 100 - scale(float64(distance), normalFinalityDistance, maxFinalityDistance)
+	return c
+}
+
+func peersComponent(in *Inputs) *Component {
+	c := newComponent(ComponentPeers)
+	c.Details["connected_peers"] = strconv.Itoa(in.ConnectedPeers)
+	c.Details["good_peers"] = strconv.Itoa(in.GoodPeers)
+	c.Details["target_peers"] = strconv.Itoa(in.TargetPeers)
+	if in.ConnectedPeers == 0 {
+		return c
+	}
+	// Half of the target peers is plenty to follow the chain, only the quality of the peers matters past that.
+	count := scale(float64(in.ConnectedPeers), 0, math.Max(float64(in.TargetPeers)/2, 1)) / 100
+	quality := float64(in.GoodPeers) / float64(in.ConnectedPeers)
+	c.Score = round(100 * count * quality)
+	return c
+}
+
+func headLagComponent(in *Inputs) *Component {
+	c := newComponent(ComponentHeadLag)
+	var lag primitives.Slot
+	if in.CurrentSlot > in.HeadSlot {
+		lag = in.CurrentSlot - in.HeadSlot
+	}
+	c.Details["head_lag_slots"] = strconv.FormatUint(uint64(lag), 10)
+	// The head lags a slot behind until the block of the current slot arrives, and is stale after an epoch.
+	c.Score = 100 - scale(float64(lag), 1, float64(params.BeaconConfig().SlotsPerEpoch))
+	return c
+}
+
+func dutiesComponent(in *Inputs) *Component {
+	c := newComponent(ComponentDuties)
+	if in.TrackedValidators == 0 {
+		c.Available = false
+		return c
+	}
+	c.Details["attesting_validators"] = strconv.Itoa(in.AttestingValidators)
+	c.Details["tracked_validators"] = strconv.Itoa(in.TrackedValidators)
+	c.Score = round(100 * float64(in.AttestingValidators) / float64(in.TrackedValidators))
+	return c
+}
+
+func newComponent(name string) *Component {
+	return &Component{Name: name, Available: true, Details: make(map[string]string)}
+}
+
+// scale maps the value linearly from the [low, high] range to a score from 0 to 100.
+func scale(value, low, high float64) float64 {
+	if value <= low {
+		return 0
+	}
+	if value >= high {
+		return 100
+	}
+	return round(100 * (value - low) / (high - low))
+}
+
+// round the score to a single decimal.
+func round(score float64) float64 {
+	return math.Round(score*10) / 10
+}
@@ -0,0 +1,89 @@
+package healthscore
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCompute(t *testing.T) {
+	now := time.Now()
+	byName := func(s *Score) map[string]*Component {
+		components := make(map[string]*Component, len(s.Components))
+		for _, c := range s.Components {
+			components[c.Name] = c
+		}
+		return components
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		s := Compute(&Inputs{
+			ParticipationRate:   0.97,
+			CurrentEpoch:        100,
+			FinalizedEpoch:      98,
+			ConnectedPeers:      70,
+			GoodPeers:           70,
+			TargetPeers:         70,
+			CurrentSlot:         3200,
+			HeadSlot:            3200,
+			AttestingValidators: 4,
+			TrackedValidators:   4,
+		}, now)
+		assert.Equal(t, 100.0, s.Score)
+		assert.Equal(t, now, s.Time)
+		require.Equal(t, 5, len(s.Components))
+		for _, c := range s.Components {
+			assert.Equal(t, true, c.Available, c.Name)
+			assert.Equal(t, 100.0, c.Score, c.Name)
+		}
+	})
+
+	t.Run("degraded", func(t *testing.T) {
+		s := Compute(&Inputs{
+			ParticipationRate:   0.725,
+			CurrentEpoch:        100,
+			FinalizedEpoch:      94,
+			ConnectedPeers:      35,
+			GoodPeers:           28,
+			TargetPeers:         140,
+			CurrentSlot:         3217,
+			HeadSlot:            3200,
+			AttestingValidators: 3,
+			TrackedValidators:   4,
+		}, now)
+		components := byName(s)
+		assert.Equal(t, 50.0, components[ComponentParticipation].Score)
+		assert.Equal(t, 50.0, components[ComponentFinality].Score)
+		assert.Equal(t, "6", components[ComponentFinality].Details["finality_distance_epochs"])
+		assert.Equal(t, 40.0, components[ComponentPeers].Score)
+		assert.Equal(t, "17", components[ComponentHeadLag].Details["head_lag_slots"])
+		assert.Equal(t, 75.0, components[ComponentDuties].Score)
+		assert.Equal(t, 50.2, s.Score)
+	})
+
+	t.Run("unavailable components do not count", func(t *testing.T) {
+		s := Compute(&Inputs{
+			ParticipationErr: errors.New("no head state"),
+			CurrentEpoch:     100,
+			FinalizedEpoch:   94,
+			ConnectedPeers:   70,
+			GoodPeers:        70,
+			TargetPeers:      70,
+			CurrentSlot:      3200,
+			HeadSlot:         3200,
+		}, now)
+		components := byName(s)
+		assert.Equal(t, false, components[ComponentParticipation].Available)
+		assert.Equal(t, "no head state", components[ComponentParticipation].Details["error"])
+		assert.Equal(t, false, components[ComponentDuties].Available)
+		assert.Equal(t, 80.8, s.Score)
+	})
+
+	t.Run("no peers", func(t *testing.T) {
+		s := Compute(&Inputs{TargetPeers: 70}, now)
+		assert.Equal(t, 0.0, byName(s)[ComponentPeers].Score)
+	})
+}
@@ -0,0 +1,201 @@
+package healthscore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Config contains the dependencies the health score is measured from.
+type Config struct {
+	ClockWaiter         startup.ClockWaiter
+	HeadFetcher         blockchain.HeadFetcher
+	FinalizationFetcher blockchain.FinalizationFetcher
+	PeersProvider       p2p.PeersProvider
+	// DutyPerformanceFetcher, when set, reports the duty performance of the tracked validators.
+	DutyPerformanceFetcher monitor.DutyPerformanceFetcher
+}
+
+// Fetcher returns the latest health score.
+type Fetcher interface {
+	HealthScore() *Score
+}
+
+// Service computes the health score of the chain and of the node at every slot.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// The participation rate is computed once per epoch of the head state, by the run routine only.
+	participationComputed bool
+	participationEpoch    primitives.Epoch
+	participationRate     float64
+	participationErr      error
+
+	// Locks access to latest.
+	sync.RWMutex
+	latest *Score
+}
+
+var _ Fetcher = (*Service)(nil)
+
+// NewService returns a health score service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start the health score service.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the health score service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the health score service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// HealthScore returns the latest health score, or nil if it was not computed yet.
+func (s *Service) HealthScore() *Score {
+	s.RLock()
+	defer s.RUnlock()
+	return s.latest
+}
+
+func (s *Service) run() {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not wait for clock")
+		return
+	}
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			s.update(slot)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// update computes the health score at the slot and exports it.
+func (s *Service) update(slot primitives.Slot) {
+	in := &Inputs{
+		CurrentSlot:  slot,
+		CurrentEpoch: slots.ToEpoch(slot),
+		HeadSlot:     s.cfg.HeadFetcher.HeadSlot(),
+	}
+	if finalized := s.cfg.FinalizationFetcher.FinalizedCheckpt(); finalized != nil {
+		in.FinalizedEpoch = finalized.Epoch
+	}
+	in.ParticipationRate, in.ParticipationErr = s.participation()
+
+	peers := s.cfg.PeersProvider.Peers()
+	connected := peers.Connected()
+	in.ConnectedPeers = len(connected)
+	in.TargetPeers = peers.MaxPeerLimit()
+	for _, pid := range connected {
+		if peers.Scorers().IsBadPeer(pid) == nil {
+			in.GoodPeers++
+		}
+	}
+	if s.cfg.DutyPerformanceFetcher != nil {
+		in.AttestingValidators, in.TrackedValidators = s.cfg.DutyPerformanceFetcher.AttestingValidators(in.CurrentEpoch)
+	}
+
+	score := Compute(in, time.Now())
+	s.Lock()
+	s.latest = score
+	s.Unlock()
+
+	healthScoreGauge.Set(score.Score)
+	for _, c := range score.Components {
+		if c.Available {
+			componentScoreGauge.WithLabelValues(c.Name).Set(c.Score)
+		} else {
+			componentScoreGauge.DeleteLabelValues(c.Name)
+		}
+	}
+}
+
+// participation returns the participation rate of the previous epoch of the head state, which is only computed
+// again once the head state reaches a new epoch.
+func (s *Service) participation() (float64, error) {
+	st, err := s.cfg.HeadFetcher.HeadStateReadOnly(s.ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get head state")
+	}
+	if st == nil || st.IsNil() {
+		return 0, errors.New("head state is nil")
+	}
+	epoch := slots.ToEpoch(st.Slot())
+	if s.participationComputed && s.participationEpoch == epoch {
+		return s.participationRate, s.participationErr
+	}
+	s.participationComputed = true
+	s.participationEpoch = epoch
+	s.participationRate, s.participationErr = participationRate(s.ctx, st)
+	return s.participationRate, s.participationErr
+}
+
+// participationRate returns the share of the active balance which attested to the correct target in the previous
+// epoch of the state.
+func participationRate(ctx context.Context, st state.ReadOnlyBeaconState) (float64, error) {
+	bst, ok := st.(state.BeaconState)
+	if !ok {
+		return 0, errors.New("head state is not a beacon state")
+	}
+	var b *precompute.Balance
+	var err error
+	if bst.Version() == version.Phase0 {
+		var v []*precompute.Validator
+		v, b, err = precompute.New(ctx, bst)
+		if err != nil {
+			return 0, err
+		}
+		_, b, err = precompute.ProcessAttestations(ctx, bst, v, b)
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		var v []*precompute.Validator
+		v, b, err = altair.InitializePrecomputeValidators(ctx, bst)
+		if err != nil {
+			return 0, err
+		}
+		_, b, err = altair.ProcessEpochParticipation(ctx, bst, b, v)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if b.ActivePrevEpoch == 0 {
+		return 0, errors.New("no active balance in the previous epoch")
+	}
+	return float64(b.PrevEpochTargetAttested) / float64(b.ActivePrevEpoch), nil
+}
@@ -0,0 +1,9 @@
+/*
+Package maintenance puts the beacon node in maintenance mode ahead of a restart. Once
+entered, the node rejects new work on its HTTP and gRPC APIs, waits for the requests
+already in flight, such as block or attestation submissions, to complete, says goodbye
+to its peers so that they do not penalize it for disappearing, and finally stops, which
+persists its state to disk. Load balancers see the rejected requests as an unhealthy node,
+and move the validator clients to another node before it exits.
+*/
+package maintenance
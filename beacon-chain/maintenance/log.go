@@ -0,0 +1,5 @@
+package maintenance
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "maintenance")
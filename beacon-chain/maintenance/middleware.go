@@ -0,0 +1,71 @@
+package maintenance
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIPath is the path of the maintenance mode endpoint, which is served during the maintenance to report its progress.
+const APIPath = "/prysm/v1/node/maintenance"
+
+const rejectedMessage = "Node is in maintenance mode and about to exit"
+
+// Middleware rejects the HTTP requests with a 503 status once the node is in maintenance, and accounts for the
+// requests in flight. Event streams, which stay open until the node stops, are not waited for.
+func (m *Mode) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == APIPath {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if strings.Contains(r.Header.Get("Accept"), api.EventStreamMediaType) {
+			if m.active() {
+				httputil.HandleError(w, rejectedMessage, http.StatusServiceUnavailable)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !m.begin() {
+			httputil.HandleError(w, rejectedMessage, http.StatusServiceUnavailable)
+			return
+		}
+		defer m.end()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// UnaryServerInterceptor rejects the gRPC calls once the node is in maintenance, and accounts for the calls in flight.
+func (m *Mode) UnaryServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !m.begin() {
+		return nil, status.Error(codes.Unavailable, rejectedMessage)
+	}
+	defer m.end()
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects the new gRPC streams once the node is in maintenance. Streams stay open until the
+// node stops, so they are not waited for.
+func (m *Mode) StreamServerInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if m.active() {
+		return status.Error(codes.Unavailable, rejectedMessage)
+	}
+	return handler(srv, ss)
+}
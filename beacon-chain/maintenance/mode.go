@@ -0,0 +1,169 @@
+package maintenance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+)
+
+const (
+	// DefaultDrainTimeout is how long the in-flight API requests are waited for, before moving on.
+	DefaultDrainTimeout = 30 * time.Second
+	disconnectTimeout   = 10 * time.Second
+)
+
+// ErrActive is returned when entering maintenance mode while the node is already in maintenance.
+var ErrActive = errors.New("node is already in maintenance mode")
+
+// Phase of the maintenance of the node.
+type Phase string
+
+const (
+	// Inactive is the phase of a node serving its APIs.
+	Inactive Phase = "inactive"
+	// Draining is the phase rejecting new API work while the requests in flight complete.
+	Draining Phase = "draining"
+	// Disconnecting is the phase sending goodbye messages to the peers.
+	Disconnecting Phase = "disconnecting"
+	// Exiting is the phase persisting the state of the node to disk and stopping it.
+	Exiting Phase = "exiting"
+)
+
+// PeerDisconnector disconnects all the peers of the node, with a goodbye message.
+type PeerDisconnector interface {
+	DisconnectPeers(ctx context.Context, code p2ptypes.RPCGoodbyeCode)
+}
+
+// Controller enters maintenance mode, and reports its progress.
+type Controller interface {
+	Enter() error
+	Status() *Status
+}
+
+// Status of the maintenance of the node.
+type Status struct {
+	Phase Phase
+	// Since is when the node entered maintenance mode, zero if it is inactive.
+	Since    time.Time
+	InFlight int
+}
+
+// Config of the maintenance mode.
+type Config struct {
+	DrainTimeout time.Duration
+	Disconnector PeerDisconnector
+	// Exit stops the node, persisting its caches and state to disk.
+	Exit func()
+}
+
+// Mode tracks the API requests in flight, and runs the maintenance of the node once entered.
+type Mode struct {
+	cfg      *Config
+	lock     sync.Mutex
+	phase    Phase
+	since    time.Time
+	inFlight int
+	drained  chan struct{}
+}
+
+// New returns an inactive maintenance mode.
+func New(cfg *Config) *Mode {
+	if cfg.DrainTimeout == 0 {
+		cfg.DrainTimeout = DefaultDrainTimeout
+	}
+	return &Mode{
+		cfg:     cfg,
+		phase:   Inactive,
+		drained: make(chan struct{}),
+	}
+}
+
+// Enter puts the node in maintenance mode, returning once new API work is rejected. The node then drains, says
+// goodbye to its peers and exits in the background.
+func (m *Mode) Enter() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.phase != Inactive {
+		return ErrActive
+	}
+	m.phase = Draining
+	m.since = time.Now()
+	if m.inFlight == 0 {
+		close(m.drained)
+	}
+	log.WithField("inFlight", m.inFlight).Info("Entering maintenance mode, rejecting new API requests")
+	go m.run()
+	return nil
+}
+
+// Status of the maintenance of the node.
+func (m *Mode) Status() *Status {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return &Status{
+		Phase:    m.phase,
+		Since:    m.since,
+		InFlight: m.inFlight,
+	}
+}
+
+func (m *Mode) run() {
+	select {
+	case <-m.drained:
+		log.Info("In-flight API requests completed")
+	case <-time.After(m.cfg.DrainTimeout):
+		log.WithField("inFlight", m.Status().InFlight).Warn("Timed out waiting for in-flight API requests to complete")
+	}
+
+	m.setPhase(Disconnecting)
+	if m.cfg.Disconnector != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), disconnectTimeout)
+		m.cfg.Disconnector.DisconnectPeers(ctx, p2ptypes.GoodbyeCodeClientShutdown)
+		cancel()
+	}
+
+	m.setPhase(Exiting)
+	log.WithField("duration", time.Since(m.Status().Since)).Info("Maintenance mode drained the node, exiting")
+	if m.cfg.Exit != nil {
+		m.cfg.Exit()
+	}
+}
+
+func (m *Mode) setPhase(p Phase) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.phase = p
+	log.WithField("phase", p).Debug("Maintenance mode phase")
+}
+
+// begin accounts for a new API request, returning false if the node is in maintenance and the request must be
+// rejected.
+func (m *Mode) begin() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.phase != Inactive {
+		return false
+	}
+	m.inFlight++
+	return true
+}
+
+// end accounts for the completion of an API request accepted by begin.
+func (m *Mode) end() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.inFlight--
+	if m.inFlight == 0 && m.phase == Draining {
+		close(m.drained)
+	}
+}
+
+// active returns true once the node entered maintenance mode.
+func (m *Mode) active() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.phase != Inactive
+}
@@ -0,0 +1,108 @@
+package maintenance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockDisconnector struct {
+	code p2ptypes.RPCGoodbyeCode
+}
+
+func (m *mockDisconnector) DisconnectPeers(_ context.Context, code p2ptypes.RPCGoodbyeCode) {
+	m.code = code
+}
+
+func TestMode_Enter(t *testing.T) {
+	disconnector := &mockDisconnector{}
+	exited := make(chan struct{})
+	m := New(&Config{Disconnector: disconnector, Exit: func() { close(exited) }})
+
+	// A request in flight holds the node in the draining phase.
+	release := make(chan struct{})
+	started := make(chan struct{})
+	handler := m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	}))
+	done := make(chan struct{})
+	go func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/eth/v1/node/version", nil))
+		close(done)
+	}()
+	<-started
+
+	require.NoError(t, m.Enter())
+	require.ErrorIs(t, m.Enter(), ErrActive)
+	st := m.Status()
+	assert.Equal(t, Draining, st.Phase)
+	assert.Equal(t, 1, st.InFlight)
+
+	// New requests are rejected, the maintenance endpoint is still served.
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/eth/v1/node/version", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	served := false
+	w = httptest.NewRecorder()
+	m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = true
+	})).ServeHTTP(w, httptest.NewRequest(http.MethodGet, APIPath, nil))
+	assert.Equal(t, true, served)
+
+	close(release)
+	<-done
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("node did not exit")
+	}
+	assert.Equal(t, p2ptypes.GoodbyeCodeClientShutdown, disconnector.code)
+	assert.Equal(t, Exiting, m.Status().Phase)
+	assert.Equal(t, 0, m.Status().InFlight)
+}
+
+func TestMode_DrainTimeout(t *testing.T) {
+	exited := make(chan struct{})
+	m := New(&Config{DrainTimeout: 10 * time.Millisecond, Exit: func() { close(exited) }})
+	require.Equal(t, true, m.begin())
+	require.NoError(t, m.Enter())
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("node did not exit")
+	}
+	// The request completing after the timeout does not close the drained channel twice.
+	m.end()
+}
+
+func TestMode_Interceptors(t *testing.T) {
+	m := New(&Config{DrainTimeout: time.Second})
+	unary := func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, 1, m.Status().InFlight)
+		return "ok", nil
+	}
+	resp, err := m.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, unary)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	require.NoError(t, m.StreamServerInterceptor(nil, nil, &grpc.StreamServerInfo{}, func(interface{}, grpc.ServerStream) error {
+		return nil
+	}))
+
+	require.NoError(t, m.Enter())
+	_, err = m.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, unary)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	err = m.StreamServerInterceptor(nil, nil, &grpc.StreamServerInfo{}, func(interface{}, grpc.ServerStream) error {
+		return nil
+	})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,81 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// alertMissedProposals raises an alert for each slot skipped before the block whose proposer is one of
+// our tracked validators. Only the skipped slots of the last epoch are checked.
+func (s *Service) alertMissedProposals(ctx context.Context, st state.BeaconState, blk interfaces.ReadOnlyBeaconBlock) {
+	if s.config.Alerter == nil {
+		return
+	}
+	parentRoot := blk.ParentRoot()
+	for i := primitives.Slot(1); i <= params.BeaconConfig().SlotsPerEpoch && i < blk.Slot(); i++ {
+		slot := blk.Slot() - i
+		// The block roots of a skipped slot and of the slot before it are both the root of the parent block.
+		previous, err := helpers.BlockRootAtSlot(st, slot-1)
+		if err != nil || !bytes.Equal(previous, parentRoot[:]) {
+			return
+		}
+		proposer, err := helpers.BeaconProposerIndexAtSlot(ctx, st, slot)
+		if err != nil {
+			log.WithError(err).WithField("slot", slot).Debug("Could not get proposer of skipped slot")
+			return
+		}
+		s.RLock()
+		tracked := s.trackedIndex(proposer)
+		s.RUnlock()
+		if !tracked {
+			continue
+		}
+		s.config.Alerter.Notify(&alerts.Alert{
+			Event:    alerts.MissedProposal,
+			Key:      fmt.Sprintf("%d/%d", proposer, slot),
+			Severity: alerts.SeverityError,
+			Summary:  fmt.Sprintf("Validator %d missed its proposal at slot %d", proposer, slot),
+			Details: map[string]string{
+				"validatorIndex": fmt.Sprintf("%d", proposer),
+				"slot":           fmt.Sprintf("%d", slot),
+			},
+		})
+	}
+}
+
+// alertAttestationStreaks raises an alert for the tracked validators whose attestations have not been
+// included for AttestationStreakEpochs epochs, and resolves it for the ones whose attestations are
+// included again. An attestation of an epoch may be included until the end of the next one, so the
+// epochs up to two before the current one are checked.
+// It assumes the caller holds the service Lock.
+func (s *Service) alertAttestationStreaks(epoch primitives.Epoch) {
+	if s.config.Alerter == nil || s.config.AttestationStreakEpochs == 0 || epoch < 2 {
+		return
+	}
+	for idx := range s.TrackedValidators {
+		lastEpoch := s.lastAttestedEpoch(idx)
+		var missed primitives.Epoch
+		if epoch-2 > lastEpoch {
+			missed = epoch - 2 - lastEpoch
+		}
+		s.config.Alerter.Notify(&alerts.Alert{
+			Event:    alerts.AttestationInclusionStreak,
+			Key:      fmt.Sprintf("%d", idx),
+			Severity: alerts.SeverityError,
+			Summary:  fmt.Sprintf("Attestations of validator %d have not been included for %d epochs", idx, missed),
+			Details: map[string]string{
+				"validatorIndex":   fmt.Sprintf("%d", idx),
+				"lastIncludedSlot": fmt.Sprintf("%d", s.latestPerformance[idx].attestedSlot),
+			},
+			Resolved: missed < s.config.AttestationStreakEpochs,
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockAlerter struct {
+	alerts []*alerts.Alert
+}
+
+func (m *mockAlerter) Notify(a *alerts.Alert) {
+	m.alerts = append(m.alerts, a)
+}
+
+func TestAlertMissedProposals(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	alerter := &mockAlerter{}
+	s.config.Alerter = alerter
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(5))
+	parentRoot := [32]byte{'p'}
+	require.NoError(t, st.UpdateBlockRootAtIndex(1, [32]byte{'g'}))
+	for i := uint64(2); i < 5; i++ {
+		require.NoError(t, st.UpdateBlockRootAtIndex(i, parentRoot))
+	}
+	// Slots 3 and 4 are skipped.
+	s.TrackedValidators = make(map[primitives.ValidatorIndex]bool)
+	for _, slot := range []primitives.Slot{3, 4} {
+		proposer, err := helpers.BeaconProposerIndexAtSlot(ctx, st, slot)
+		require.NoError(t, err)
+		s.TrackedValidators[proposer] = true
+	}
+
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = 5
+	b.Block.ParentRoot = parentRoot[:]
+	wb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	s.alertMissedProposals(ctx, st, wb.Block())
+
+	require.Equal(t, 2, len(alerter.alerts))
+	require.Equal(t, alerts.MissedProposal, alerter.alerts[0].Event)
+	require.Equal(t, "4", alerter.alerts[0].Details["slot"])
+	require.Equal(t, "3", alerter.alerts[1].Details["slot"])
+}
+
+func TestAlertAttestationStreaks(t *testing.T) {
+	s := setupService(t)
+	alerter := &mockAlerter{}
+	s.config.Alerter = alerter
+	s.config.AttestationStreakEpochs = 3
+	s.TrackedValidators = map[primitives.ValidatorIndex]bool{1: true, 2: true}
+	s.latestPerformance[1] = ValidatorLatestPerformance{attestedSlot: 32 * 7}
+	s.latestPerformance[2] = ValidatorLatestPerformance{attestedSlot: 32 * 4}
+
+	s.alertAttestationStreaks(9)
+	byKey := make(map[string]*alerts.Alert)
+	for _, a := range alerter.alerts {
+		byKey[a.Key] = a
+	}
+	require.Equal(t, 2, len(byKey))
+	require.Equal(t, true, byKey["1"].Resolved)
+	require.Equal(t, false, byKey["2"].Resolved)
+	require.Equal(t, "Attestations of validator 2 have not been included for 3 epochs", byKey["2"].Summary)
+}
@@ -0,0 +1,47 @@
+package monitor
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// DutyPerformanceFetcher reports how many of the tracked validators perform their duties.
+type DutyPerformanceFetcher interface {
+	AttestingValidators(epoch primitives.Epoch) (attesting, tracked int)
+}
+
+var _ DutyPerformanceFetcher = (*Service)(nil)
+
+// AttestingValidators returns the number of tracked validators whose attestations were included recently, along
+// with the number of tracked validators. An attestation of an epoch may be included until the end of the next one,
+// so the validators which attested in any of the two epochs before the given one are attesting. No validator is
+// tracked until the monitor reports their performance.
+func (s *Service) AttestingValidators(epoch primitives.Epoch) (attesting, tracked int) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if !s.isLogging {
+		return 0, 0
+	}
+	for idx := range s.TrackedValidators {
+		if _, ok := s.latestPerformance[idx]; !ok {
+			continue
+		}
+		tracked++
+		if epoch < 2 || s.lastAttestedEpoch(idx) >= epoch-2 {
+			attesting++
+		}
+	}
+	return attesting, tracked
+}
+
+// lastAttestedEpoch returns the epoch of the last included attestation of the tracked validator, or the epoch
+// its performance is tracked from when none was included since.
+// It assumes the caller holds the service Lock.
+func (s *Service) lastAttestedEpoch(idx primitives.ValidatorIndex) primitives.Epoch {
+	lastEpoch := s.aggregatedPerformance[idx].startEpoch
+	if attested := s.latestPerformance[idx].attestedSlot; attested > 0 {
+		lastEpoch = slots.ToEpoch(attested)
+	}
+	return lastEpoch
+}
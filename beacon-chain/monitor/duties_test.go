@@ -0,0 +1,26 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestAttestingValidators(t *testing.T) {
+	s := setupService(t)
+	s.TrackedValidators = map[primitives.ValidatorIndex]bool{1: true, 2: true, 3: true}
+	s.latestPerformance = map[primitives.ValidatorIndex]ValidatorLatestPerformance{
+		1: {attestedSlot: 32 * 7},
+		2: {attestedSlot: 32 * 4},
+	}
+
+	attesting, tracked := s.AttestingValidators(9)
+	require.Equal(t, 0, attesting)
+	require.Equal(t, 0, tracked)
+
+	s.isLogging = true
+	attesting, tracked = s.AttestingValidators(9)
+	require.Equal(t, 1, attesting)
+	require.Equal(t, 2, tracked)
+}
@@ -0,0 +1,163 @@
+package monitor
+
+import (
+	"context"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	// inclusionBatchBuffer is the number of blocks whose inclusions wait to be exported before they are dropped.
+	inclusionBatchBuffer   = 64
+	inclusionExportTimeout = 10 * time.Second
+)
+
+// AttestationInclusion records the inclusion in a block of the attestation of a tracked validator.
+type AttestationInclusion struct {
+	ValidatorIndex primitives.ValidatorIndex
+	// Slot is the slot of the attestation.
+	Slot primitives.Slot
+	// InclusionSlot is the slot of the block including the attestation.
+	InclusionSlot primitives.Slot
+	// Time is the time at which the block including the attestation was processed.
+	Time time.Time
+	// The timely flags are only known since Altair, they are false before.
+	CorrectSource bool
+	CorrectTarget bool
+	CorrectHead   bool
+}
+
+// Distance is the number of slots between the attestation and its inclusion.
+func (a *AttestationInclusion) Distance() primitives.Slot {
+	return a.InclusionSlot - a.Slot
+}
+
+// InclusionExporter exports the attestation inclusions of the tracked validators.
+type InclusionExporter interface {
+	Export(ctx context.Context, inclusions []*AttestationInclusion) error
+	Close() error
+}
+
+// queueInclusions queues the inclusions recorded while processing a block for export. The inclusions are
+// dropped when the exporters fall behind, so that block processing is never slowed down.
+func (s *Service) queueInclusions(inclusions []*AttestationInclusion) {
+	if s.inclusions == nil || len(inclusions) == 0 {
+		return
+	}
+	select {
+	case s.inclusions <- inclusions:
+	default:
+		log.WithField("count", len(inclusions)).Warn("Dropping attestation inclusions, exporters are too slow")
+	}
+}
+
+// exportInclusions sends the queued inclusions to the exporters until the service stops.
+func (s *Service) exportInclusions() {
+	defer func() {
+		for _, e := range s.config.InclusionExporters {
+			if err := e.Close(); err != nil {
+				log.WithError(err).Error("Could not close attestation inclusion exporter")
+			}
+		}
+	}()
+	for {
+		select {
+		case inclusions := <-s.inclusions:
+			for _, e := range s.config.InclusionExporters {
+				ctx, cancel := context.WithTimeout(s.ctx, inclusionExportTimeout)
+				if err := e.Export(ctx, inclusions); err != nil {
+					log.WithError(err).Error("Could not export attestation inclusions")
+				}
+				cancel()
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+var inclusionCSVHeader = []string{
+	"time", "validator_index", "slot", "inclusion_slot", "inclusion_distance", "correct_source", "correct_target", "correct_head",
+}
+
+// CSVInclusionExporter appends the attestation inclusions to a CSV file.
+type CSVInclusionExporter struct {
+	f *os.File
+	w *csv.Writer
+}
+
+// NewCSVInclusionExporter opens the CSV file to append the inclusions to, writing the header when the file is new.
+func NewCSVInclusionExporter(path string) (*CSVInclusionExporter, error) {
+	expanded, err := file.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.MkdirAll(filepath.Dir(expanded)); err != nil {
+		return nil, errors.Wrap(err, "could not create directory of the CSV file")
+	}
+	f, err := os.OpenFile(expanded, os.O_WRONLY|os.O_CREATE|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open CSV file")
+	}
+	e := &CSVInclusionExporter{f: f, w: csv.NewWriter(f)}
+	if err := e.writeHeader(); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close CSV file")
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+// writeHeader writes the header of the CSV file when it is empty.
+func (e *CSVInclusionExporter) writeHeader() error {
+	info, err := e.f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "could not stat CSV file")
+	}
+	if info.Size() > 0 {
+		return nil
+	}
+	if err := e.w.Write(inclusionCSVHeader); err != nil {
+		return errors.Wrap(err, "could not write CSV header")
+	}
+	e.w.Flush()
+	return errors.Wrap(e.w.Error(), "could not write CSV header")
+}
+
+// Export appends a row per inclusion to the CSV file.
+func (e *CSVInclusionExporter) Export(_ context.Context, inclusions []*AttestationInclusion) error {
+	for _, a := range inclusions {
+		if err := e.w.Write([]string{
+			a.Time.UTC().Format(time.RFC3339Nano),
+			strconv.FormatUint(uint64(a.ValidatorIndex), 10),
+			strconv.FormatUint(uint64(a.Slot), 10),
+			strconv.FormatUint(uint64(a.InclusionSlot), 10),
+			strconv.FormatUint(uint64(a.Distance()), 10),
+			strconv.FormatBool(a.CorrectSource),
+			strconv.FormatBool(a.CorrectTarget),
+			strconv.FormatBool(a.CorrectHead),
+		}); err != nil {
+			return errors.Wrap(err, "could not write CSV row")
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// Close closes the CSV file.
+func (e *CSVInclusionExporter) Close() error {
+	e.w.Flush()
+	if err := e.w.Error(); err != nil {
+		return err
+	}
+	return e.f.Close()
+}
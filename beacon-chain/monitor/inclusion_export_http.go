@@ -0,0 +1,141 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// InfluxDBInclusionExporter writes the attestation inclusions to InfluxDB in the line protocol. The URL is the
+// write endpoint, including the database or bucket, such as http://localhost:8086/write?db=prysm.
+type InfluxDBInclusionExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewInfluxDBInclusionExporter returns an exporter writing to the InfluxDB write endpoint.
+func NewInfluxDBInclusionExporter(url string) *InfluxDBInclusionExporter {
+	return &InfluxDBInclusionExporter{url: url, client: &http.Client{Timeout: inclusionExportTimeout}}
+}
+
+// Export writes a point per inclusion, timestamped with the time the including block was processed.
+func (e *InfluxDBInclusionExporter) Export(ctx context.Context, inclusions []*AttestationInclusion) error {
+	var buf bytes.Buffer
+	for _, a := range inclusions {
+		fmt.Fprintf(
+			&buf,
+			"attestation_inclusion,validator_index=%d slot=%di,inclusion_slot=%di,inclusion_distance=%di,correct_source=%t,correct_target=%t,correct_head=%t %d\n",
+			a.ValidatorIndex, a.Slot, a.InclusionSlot, a.Distance(), a.CorrectSource, a.CorrectTarget, a.CorrectHead, a.Time.UnixNano(),
+		)
+	}
+	return postInclusions(ctx, e.client, e.url, &buf, map[string]string{"Content-Type": "text/plain; charset=utf-8"})
+}
+
+// Close does nothing, the exporter holds no resources.
+func (*InfluxDBInclusionExporter) Close() error {
+	return nil
+}
+
+// RemoteWriteInclusionExporter sends the attestation inclusions to a Prometheus remote write endpoint, as the
+// monitor_attestation_inclusion_distance and monitor_attestation_correct series of each validator.
+type RemoteWriteInclusionExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewRemoteWriteInclusionExporter returns an exporter sending to the Prometheus remote write endpoint.
+func NewRemoteWriteInclusionExporter(url string) *RemoteWriteInclusionExporter {
+	return &RemoteWriteInclusionExporter{url: url, client: &http.Client{Timeout: inclusionExportTimeout}}
+}
+
+// Export sends a remote write request with a sample per series and inclusion.
+func (e *RemoteWriteInclusionExporter) Export(ctx context.Context, inclusions []*AttestationInclusion) error {
+	body := snappy.Encode(nil, remoteWriteRequest(inclusions))
+	return postInclusions(ctx, e.client, e.url, bytes.NewReader(body), map[string]string{
+		"Content-Encoding":                  "snappy",
+		"Content-Type":                      "application/x-protobuf",
+		"X-Prometheus-Remote-Write-Version": "0.1.0",
+	})
+}
+
+// Close does nothing, the exporter holds no resources.
+func (*RemoteWriteInclusionExporter) Close() error {
+	return nil
+}
+
+// remoteWriteRequest encodes the inclusions as a prometheus.WriteRequest protobuf message.
+func remoteWriteRequest(inclusions []*AttestationInclusion) []byte {
+	var b []byte
+	for _, a := range inclusions {
+		idx := strconv.FormatUint(uint64(a.ValidatorIndex), 10)
+		ms := a.Time.UnixMilli()
+		// Labels are sorted by name, as remote write requires.
+		b = appendTimeSeries(b, []string{"__name__", "monitor_attestation_inclusion_distance", "validator_index", idx}, float64(a.Distance()), ms)
+		for _, flag := range []struct {
+			name    string
+			correct bool
+		}{{"head", a.CorrectHead}, {"source", a.CorrectSource}, {"target", a.CorrectTarget}} {
+			b = appendTimeSeries(b, []string{"__name__", "monitor_attestation_correct", "flag", flag.name, "validator_index", idx}, boolToFloat(flag.correct), ms)
+		}
+	}
+	return b
+}
+
+// appendTimeSeries appends a TimeSeries with a single sample to a WriteRequest. The labels are name value pairs.
+func appendTimeSeries(b []byte, labels []string, value float64, timestampMs int64) []byte {
+	var ts []byte
+	for i := 0; i+1 < len(labels); i += 2 {
+		var l []byte
+		l = protowire.AppendTag(l, 1, protowire.BytesType)
+		l = protowire.AppendString(l, labels[i])
+		l = protowire.AppendTag(l, 2, protowire.BytesType)
+		l = protowire.AppendString(l, labels[i+1])
+		ts = protowire.AppendTag(ts, 1, protowire.BytesType)
+		ts = protowire.AppendBytes(ts, l)
+	}
+	var sample []byte
+	sample = protowire.AppendTag(sample, 1, protowire.Fixed64Type)
+	sample = protowire.AppendFixed64(sample, math.Float64bits(value))
+	sample = protowire.AppendTag(sample, 2, protowire.VarintType)
+	sample = protowire.AppendVarint(sample, uint64(timestampMs))
+	ts = protowire.AppendTag(ts, 2, protowire.BytesType)
+	ts = protowire.AppendBytes(ts, sample)
+
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	return protowire.AppendBytes(b, ts)
+}
+
+func postInclusions(ctx context.Context, client *http.Client, url string, body io.Reader, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not post attestation inclusions")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if err != nil {
+			return errors.Wrapf(err, "endpoint returned status %d", resp.StatusCode)
+		}
+		return errors.Errorf("endpoint returned status %d: %s", resp.StatusCode, string(msg))
+	}
+	return nil
+}
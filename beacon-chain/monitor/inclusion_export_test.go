@@ -0,0 +1,156 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func testInclusions() []*AttestationInclusion {
+	return []*AttestationInclusion{
+		{ValidatorIndex: 2, Slot: 10, InclusionSlot: 11, Time: time.Unix(1700000000, 0), CorrectSource: true, CorrectTarget: true, CorrectHead: true},
+		{ValidatorIndex: 12, Slot: 9, InclusionSlot: 11, Time: time.Unix(1700000000, 0), CorrectSource: true},
+	}
+}
+
+func TestCSVInclusionExporter(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "monitor", "inclusions.csv")
+	e, err := NewCSVInclusionExporter(path)
+	require.NoError(t, err)
+	require.NoError(t, e.Export(ctx, testInclusions()))
+	require.NoError(t, e.Close())
+
+	// The header is not written again when appending to the file.
+	e, err = NewCSVInclusionExporter(path)
+	require.NoError(t, err)
+	require.NoError(t, e.Export(ctx, testInclusions()[:1]))
+	require.NoError(t, e.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "time,validator_index,slot,inclusion_slot,inclusion_distance,correct_source,correct_target,correct_head\n"+
+		"2023-11-14T22:13:20Z,2,10,11,1,true,true,true\n"+
+		"2023-11-14T22:13:20Z,12,9,11,2,true,false,false\n"+
+		"2023-11-14T22:13:20Z,2,10,11,1,true,true,true\n", string(content))
+}
+
+func TestInfluxDBInclusionExporter(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(b)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	e := NewInfluxDBInclusionExporter(srv.URL + "/write?db=prysm")
+	require.NoError(t, e.Export(context.Background(), testInclusions()))
+	assert.Equal(t, "attestation_inclusion,validator_index=2 slot=10i,inclusion_slot=11i,inclusion_distance=1i,correct_source=true,correct_target=true,correct_head=true 1700000000000000000\n"+
+		"attestation_inclusion,validator_index=12 slot=9i,inclusion_slot=11i,inclusion_distance=2i,correct_source=true,correct_target=false,correct_head=false 1700000000000000000\n", body)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "database not found", http.StatusNotFound)
+	}))
+	defer failing.Close()
+	err := NewInfluxDBInclusionExporter(failing.URL).Export(context.Background(), testInclusions())
+	require.ErrorContains(t, "database not found", err)
+}
+
+func TestRemoteWriteInclusionExporter(t *testing.T) {
+	var req []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "snappy", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req, err = snappy.Decode(nil, b)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	e := NewRemoteWriteInclusionExporter(srv.URL)
+	require.NoError(t, e.Export(context.Background(), testInclusions()[:1]))
+
+	// Decode the series of the WriteRequest as their labels and sample.
+	var series []string
+	for len(req) > 0 {
+		_, _, n := protowire.ConsumeTag(req)
+		ts, m := protowire.ConsumeBytes(req[n:])
+		req = req[n+m:]
+		var labels []string
+		for len(ts) > 0 {
+			num, _, n := protowire.ConsumeTag(ts)
+			field, m := protowire.ConsumeBytes(ts[n:])
+			ts = ts[n+m:]
+			if num == 2 {
+				_, _, n := protowire.ConsumeTag(field)
+				v, m := protowire.ConsumeFixed64(field[n:])
+				_, _, k := protowire.ConsumeTag(field[n+m:])
+				ms, _ := protowire.ConsumeVarint(field[n+m+k:])
+				labels = append(labels, fmt.Sprintf("%v@%d", math.Float64frombits(v), ms))
+				continue
+			}
+			_, _, n = protowire.ConsumeTag(field)
+			name, m := protowire.ConsumeString(field[n:])
+			_, _, k := protowire.ConsumeTag(field[n+m:])
+			value, _ := protowire.ConsumeString(field[n+m+k:])
+			labels = append(labels, name+"="+value)
+		}
+		series = append(series, strings.Join(labels, ","))
+	}
+	require.Equal(t, 4, len(series))
+	assert.Equal(t, "__name__=monitor_attestation_inclusion_distance,validator_index=2,1@1700000000000", series[0])
+	assert.Equal(t, "__name__=monitor_attestation_correct,flag=head,validator_index=2,1@1700000000000", series[1])
+	assert.Equal(t, "__name__=monitor_attestation_correct,flag=source,validator_index=2,1@1700000000000", series[2])
+	assert.Equal(t, "__name__=monitor_attestation_correct,flag=target,validator_index=2,1@1700000000000", series[3])
+}
+
+type recordingExporter struct {
+	exported chan []*AttestationInclusion
+	closed   chan struct{}
+}
+
+func (e *recordingExporter) Export(_ context.Context, inclusions []*AttestationInclusion) error {
+	e.exported <- inclusions
+	return nil
+}
+
+func (e *recordingExporter) Close() error {
+	close(e.closed)
+	return nil
+}
+
+func TestService_ExportInclusions(t *testing.T) {
+	e := &recordingExporter{exported: make(chan []*AttestationInclusion, 1), closed: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Service{
+		config:     &ValidatorMonitorConfig{InclusionExporters: []InclusionExporter{e}},
+		ctx:        ctx,
+		cancel:     cancel,
+		inclusions: make(chan []*AttestationInclusion, inclusionBatchBuffer),
+	}
+	go s.exportInclusions()
+
+	// Blocks without inclusions of tracked validators are not exported.
+	s.queueInclusions(nil)
+	s.queueInclusions(testInclusions())
+	assert.DeepEqual(t, testInclusions(), <-e.exported)
+
+	cancel()
+	<-e.closed
+}
@@ -1,8 +1,11 @@
 package monitor
 
 import (
+	"fmt"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/sirupsen/logrus"
 )
 
@@ -22,6 +25,40 @@ var (
 			"validator_index",
 		},
 	)
+	// inclusionDistanceGauge used to track the inclusion distance of the latest attestation
+	inclusionDistanceGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "inclusion_distance",
+			Help:      "Number of slots between the latest included attestation and its inclusion",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// attestationCorrectGauge used to track the timely flags of the latest attestation
+	attestationCorrectGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "attestation_correct",
+			Help:      "Whether the latest included attestation had the timely source, target or head flag, 1 if so and 0 otherwise",
+		},
+		[]string{
+			"validator_index",
+			"flag",
+		},
+	)
+	// balanceChangeGauge used to track the balance change of the latest included duty
+	balanceChangeGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "balance_change_gwei",
+			Help:      "Change of balance since the previous included attestation or proposal, in Gwei",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
 	// timelyHeadCounter used to track attestation timely head flags
 	timelyHeadCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -91,3 +128,31 @@ var (
 		},
 	)
 )
+
+// deleteValidatorMetrics removes the metrics of a validator which is no longer tracked.
+func deleteValidatorMetrics(idx primitives.ValidatorIndex) {
+	label := fmt.Sprintf("%d", idx)
+	for _, vec := range []*prometheus.GaugeVec{inclusionSlotGauge, inclusionDistanceGauge, balanceChangeGauge} {
+		vec.DeleteLabelValues(label)
+	}
+	for _, flag := range []string{"source", "target", "head"} {
+		attestationCorrectGauge.DeleteLabelValues(label, flag)
+	}
+	for _, vec := range []*prometheus.CounterVec{
+		timelyHeadCounter,
+		timelyTargetCounter,
+		timelySourceCounter,
+		proposedSlotsCounter,
+		aggregationCounter,
+		syncCommitteeContributionCounter,
+	} {
+		vec.DeleteLabelValues(label)
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
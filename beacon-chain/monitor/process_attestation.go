@@ -3,6 +3,7 @@ package monitor
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
@@ -52,23 +53,28 @@ func logMessageTimelyFlagsForIndex(idx primitives.ValidatorIndex, data *ethpb.At
 	}
 }
 
-// processAttestations logs the event for the tracked validators' attestations inclusion in block
+// processAttestations logs the event for the tracked validators' attestations inclusion in block, and queues
+// the inclusions for export.
 func (s *Service) processAttestations(ctx context.Context, state state.BeaconState, blk interfaces.ReadOnlyBeaconBlock) {
 	if blk == nil || blk.Body() == nil {
 		return
 	}
+	var inclusions []*AttestationInclusion
 	for _, att := range blk.Body().Attestations() {
-		s.processIncludedAttestation(ctx, state, att)
+		inclusions = append(inclusions, s.processIncludedAttestation(ctx, state, att)...)
 	}
+	s.queueInclusions(inclusions)
 }
 
 // processIncludedAttestation logs in the event for the tracked validators' and their latest attestation gets processed.
-func (s *Service) processIncludedAttestation(ctx context.Context, state state.BeaconState, att ethpb.Att) {
+// It returns the inclusions of the attestation of the tracked validators.
+func (s *Service) processIncludedAttestation(ctx context.Context, state state.BeaconState, att ethpb.Att) []*AttestationInclusion {
 	attestingIndices, err := attestingIndices(ctx, state, att)
 	if err != nil {
 		log.WithError(err).Error("Could not get attesting indices")
-		return
+		return nil
 	}
+	var inclusions []*AttestationInclusion
 	s.Lock()
 	defer s.Unlock()
 	for _, idx := range attestingIndices {
@@ -77,7 +83,7 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			balance, err := state.BalanceAtIndex(primitives.ValidatorIndex(idx))
 			if err != nil {
 				log.WithError(err).Error("Could not get balance")
-				return
+				return inclusions
 			}
 
 			aggregatedPerf := s.aggregatedPerformance[primitives.ValidatorIndex(idx)]
@@ -93,7 +99,7 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			inclusionSlotGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot))
 			aggregatedPerf.totalDistance += uint64(latestPerf.inclusionSlot - latestPerf.attestedSlot)
 
-			if state.Version() == version.Altair {
+			if state.Version() >= version.Altair {
 				targetIdx := params.BeaconConfig().TimelyTargetFlagIndex
 				sourceIdx := params.BeaconConfig().TimelySourceFlagIndex
 				headIdx := params.BeaconConfig().TimelyHeadFlagIndex
@@ -104,32 +110,32 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 					participation, err = state.CurrentEpochParticipation()
 					if err != nil {
 						log.WithError(err).Error("Could not get current epoch participation")
-						return
+						return inclusions
 					}
 				} else {
 					participation, err = state.PreviousEpochParticipation()
 					if err != nil {
 						log.WithError(err).Error("Could not get previous epoch participation")
-						return
+						return inclusions
 					}
 				}
 				flags := participation[idx]
 				hasFlag, err := altair.HasValidatorFlag(flags, sourceIdx)
 				if err != nil {
 					log.WithError(err).Error("Could not get timely Source flag")
-					return
+					return inclusions
 				}
 				latestPerf.timelySource = hasFlag
 				hasFlag, err = altair.HasValidatorFlag(flags, headIdx)
 				if err != nil {
 					log.WithError(err).Error("Could not get timely Head flag")
-					return
+					return inclusions
 				}
 				latestPerf.timelyHead = hasFlag
 				hasFlag, err = altair.HasValidatorFlag(flags, targetIdx)
 				if err != nil {
 					log.WithError(err).Error("Could not get timely Target flag")
-					return
+					return inclusions
 				}
 				latestPerf.timelyTarget = hasFlag
 
@@ -146,6 +152,12 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 					aggregatedPerf.totalCorrectTarget++
 				}
 			}
+			label := fmt.Sprintf("%d", idx)
+			inclusionDistanceGauge.WithLabelValues(label).Set(float64(latestPerf.inclusionSlot - latestPerf.attestedSlot))
+			balanceChangeGauge.WithLabelValues(label).Set(float64(balanceChg))
+			attestationCorrectGauge.WithLabelValues(label, "source").Set(boolToFloat(latestPerf.timelySource))
+			attestationCorrectGauge.WithLabelValues(label, "target").Set(boolToFloat(latestPerf.timelyTarget))
+			attestationCorrectGauge.WithLabelValues(label, "head").Set(boolToFloat(latestPerf.timelyHead))
 			logFields["correctHead"] = latestPerf.timelyHead
 			logFields["correctSource"] = latestPerf.timelySource
 			logFields["correctTarget"] = latestPerf.timelyTarget
@@ -156,8 +168,18 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			s.latestPerformance[primitives.ValidatorIndex(idx)] = latestPerf
 			s.aggregatedPerformance[primitives.ValidatorIndex(idx)] = aggregatedPerf
 			log.WithFields(logFields).Info("Attestation included")
+			inclusions = append(inclusions, &AttestationInclusion{
+				ValidatorIndex: primitives.ValidatorIndex(idx),
+				Slot:           latestPerf.attestedSlot,
+				InclusionSlot:  latestPerf.inclusionSlot,
+				Time:           time.Now(),
+				CorrectSource:  latestPerf.timelySource,
+				CorrectTarget:  latestPerf.timelyTarget,
+				CorrectHead:    latestPerf.timelyHead,
+			})
 		}
 	}
+	return inclusions
 }
 
 // processUnaggregatedAttestation logs when the beacon node observes an unaggregated attestation from tracked validator.
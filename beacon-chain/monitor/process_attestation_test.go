@@ -7,6 +7,7 @@ import (
 
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -54,11 +55,22 @@ func TestProcessIncludedAttestationTwoTracked(t *testing.T) {
 		},
 		AggregationBits: bitfield.Bitlist{0b11, 0b1},
 	}
-	s.processIncludedAttestation(context.Background(), state, att)
+	inclusions := s.processIncludedAttestation(context.Background(), state, att)
 	wanted1 := "\"Attestation included\" balanceChange=0 correctHead=true correctSource=true correctTarget=true head=0x68656c6c6f2d inclusionSlot=2 newBalance=32000000000 prefix=monitor slot=1 source=0x68656c6c6f2d target=0x68656c6c6f2d validatorIndex=2"
 	wanted2 := "\"Attestation included\" balanceChange=100000000 correctHead=true correctSource=true correctTarget=true head=0x68656c6c6f2d inclusionSlot=2 newBalance=32000000000 prefix=monitor slot=1 source=0x68656c6c6f2d target=0x68656c6c6f2d validatorIndex=12"
 	require.LogsContain(t, hook, wanted1)
 	require.LogsContain(t, hook, wanted2)
+	require.Equal(t, 2, len(inclusions))
+	require.Equal(t, primitives.ValidatorIndex(12), inclusions[0].ValidatorIndex)
+	require.Equal(t, primitives.ValidatorIndex(2), inclusions[1].ValidatorIndex)
+	for _, inclusion := range inclusions {
+		require.Equal(t, primitives.Slot(1), inclusion.Slot)
+		require.Equal(t, primitives.Slot(2), inclusion.InclusionSlot)
+		require.Equal(t, primitives.Slot(1), inclusion.Distance())
+		require.Equal(t, true, inclusion.CorrectSource)
+		require.Equal(t, true, inclusion.CorrectTarget)
+		require.Equal(t, true, inclusion.CorrectHead)
+	}
 }
 
 func TestProcessUnaggregatedAttestationStateNotCached(t *testing.T) {
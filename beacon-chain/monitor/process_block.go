@@ -55,9 +55,17 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 	}
 
 	s.processSyncAggregate(st, blk)
+	s.alertMissedProposals(ctx, st, blk)
 	s.processProposedBlock(st, root, blk)
 	s.processAttestations(ctx, st, blk)
 
+	s.Lock()
+	if currEpoch > s.lastStreakEpoch {
+		s.alertAttestationStreaks(currEpoch)
+		s.lastStreakEpoch = currEpoch
+	}
+	s.Unlock()
+
 	if blk.Slot()%(AggregateReportingPeriod*params.BeaconConfig().SlotsPerEpoch) == 0 {
 		s.logAggregatedPerformance()
 	}
@@ -83,6 +91,7 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 		latestPerf.balanceChange = balanceChg
 		latestPerf.balance = balance
 		s.latestPerformance[blk.ProposerIndex()] = latestPerf
+		balanceChangeGauge.WithLabelValues(fmt.Sprintf("%d", blk.ProposerIndex())).Set(float64(balanceChg))
 
 		aggPerf := s.aggregatedPerformance[blk.ProposerIndex()]
 		aggPerf.totalProposedCount++
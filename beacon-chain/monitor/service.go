@@ -3,10 +3,10 @@ package monitor
 import (
 	"context"
 	"errors"
-	"sort"
 	"sync"
 
 	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
@@ -59,6 +59,15 @@ type ValidatorMonitorConfig struct {
 	HeadFetcher         blockchain.HeadFetcher
 	StateGen            stategen.StateManager
 	InitialSyncComplete chan struct{}
+	// Alerter, when set, is notified of the duties missed by the tracked validators.
+	Alerter alerts.Alerter
+	// AttestationStreakEpochs is the number of epochs without an included attestation of a tracked
+	// validator past which an alert is raised.
+	AttestationStreakEpochs primitives.Epoch
+	// TrackedValidatorsPath, when set, is the file persisting the validators tracked at runtime.
+	TrackedValidatorsPath string
+	// InclusionExporters continuously export the attestation inclusions of the tracked validators.
+	InclusionExporters []InclusionExporter
 }
 
 // Service is the main structure that tracks validators and reports logs and
@@ -70,7 +79,7 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, lastSyncedEpoch and lastStreakEpoch
 	sync.RWMutex
 
 	TrackedValidators           map[primitives.ValidatorIndex]bool
@@ -78,6 +87,10 @@ type Service struct {
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
 	lastSyncedEpoch             primitives.Epoch
+	lastStreakEpoch             primitives.Epoch
+	// inclusions queues the attestation inclusions of each processed block for export. It is nil when there
+	// are no exporters.
+	inclusions chan []*AttestationInclusion
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
@@ -93,9 +106,19 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []p
 		trackedSyncCommitteeIndices: make(map[primitives.ValidatorIndex][]primitives.CommitteeIndex),
 		isLogging:                   false,
 	}
+	if len(config.InclusionExporters) > 0 {
+		r.inclusions = make(chan []*AttestationInclusion, inclusionBatchBuffer)
+	}
 	for _, idx := range tracked {
 		r.TrackedValidators[idx] = true
 	}
+	persisted, err := loadTrackedValidators(config.TrackedValidatorsPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range persisted {
+		r.TrackedValidators[idx] = true
+	}
 	return r, nil
 }
 
@@ -104,16 +127,13 @@ func (s *Service) Start() {
 	s.Lock()
 	defer s.Unlock()
 
-	tracked := make([]primitives.ValidatorIndex, 0, len(s.TrackedValidators))
-	for idx := range s.TrackedValidators {
-		tracked = append(tracked, idx)
-	}
-	sort.Slice(tracked, func(i, j int) bool { return tracked[i] < tracked[j] })
-
 	log.WithFields(logrus.Fields{
-		"validatorIndices": tracked,
+		"validatorIndices": s.sortedTrackedIndices(),
 	}).Info("Starting service")
 
+	if s.inclusions != nil {
+		go s.exportInclusions()
+	}
 	go s.run()
 }
 
@@ -155,19 +175,25 @@ func (s *Service) run() {
 // and validatorAggregatedPerformance for each tracked validator.
 func (s *Service) initializePerformanceStructures(state state.BeaconState, epoch primitives.Epoch) {
 	for idx := range s.TrackedValidators {
-		balance, err := state.BalanceAtIndex(idx)
-		if err != nil {
-			log.WithError(err).WithField("validatorIndex", idx).Error(
-				"Could not fetch starting balance, skipping aggregated logs.")
-			balance = 0
-		}
-		s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
-			startEpoch:   epoch,
-			startBalance: balance,
-		}
-		s.latestPerformance[idx] = ValidatorLatestPerformance{
-			balance: balance,
-		}
+		s.initializePerformance(state, idx, epoch)
+	}
+}
+
+// initializePerformance initializes the validatorLatestPerformance and
+// validatorAggregatedPerformance of a tracked validator.
+func (s *Service) initializePerformance(state state.BeaconState, idx primitives.ValidatorIndex, epoch primitives.Epoch) {
+	balance, err := state.BalanceAtIndex(idx)
+	if err != nil {
+		log.WithError(err).WithField("validatorIndex", idx).Error(
+			"Could not fetch starting balance, skipping aggregated logs.")
+		balance = 0
+	}
+	s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
+		startEpoch:   epoch,
+		startBalance: balance,
+	}
+	s.latestPerformance[idx] = ValidatorLatestPerformance{
+		balance: balance,
 	}
 }
 
@@ -211,6 +237,9 @@ func (s *Service) monitorRoutine(stateChannel chan *feed.Event, stateSub event.S
 	for {
 		select {
 		case e := <-stateChannel:
+			if !s.hasTrackedValidators() {
+				continue
+			}
 			if e.Type == statefeed.BlockProcessed {
 				data, ok := e.Data.(*statefeed.BlockProcessedData)
 				if !ok {
@@ -221,6 +250,9 @@ func (s *Service) monitorRoutine(stateChannel chan *feed.Event, stateSub event.S
 				}
 			}
 		case e := <-opChannel:
+			if !s.hasTrackedValidators() {
+				continue
+			}
 			switch e.Type {
 			case operation.UnaggregatedAttReceived:
 				data, ok := e.Data.(*operation.UnAggregatedAttReceivedData)
@@ -0,0 +1,145 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// TrackedValidatorsManager changes the set of validators tracked by the monitor while the node runs.
+type TrackedValidatorsManager interface {
+	TrackedValidatorIndices() []primitives.ValidatorIndex
+	AddTrackedValidators(ctx context.Context, indices []primitives.ValidatorIndex) error
+	RemoveTrackedValidators(indices []primitives.ValidatorIndex) error
+}
+
+var _ TrackedValidatorsManager = (*Service)(nil)
+
+// TrackedValidatorIndices returns the sorted indices of the tracked validators.
+func (s *Service) TrackedValidatorIndices() []primitives.ValidatorIndex {
+	s.RLock()
+	defer s.RUnlock()
+	return s.sortedTrackedIndices()
+}
+
+// AddTrackedValidators starts tracking the validators. When the monitor is already reporting, their
+// performance is tracked from the head state onwards.
+func (s *Service) AddTrackedValidators(ctx context.Context, indices []primitives.ValidatorIndex) error {
+	s.RLock()
+	isLogging := s.isLogging
+	s.RUnlock()
+
+	if isLogging {
+		st, err := s.config.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get head state")
+		}
+		if st == nil || st.IsNil() {
+			return errors.New("head state is nil")
+		}
+		for _, idx := range indices {
+			if uint64(idx) >= uint64(st.NumValidators()) {
+				return errors.Errorf("validator index %d is out of range", idx)
+			}
+		}
+		s.Lock()
+		epoch := slots.ToEpoch(st.Slot())
+		for _, idx := range indices {
+			if s.trackedIndex(idx) {
+				continue
+			}
+			s.TrackedValidators[idx] = true
+			s.initializePerformance(st, idx, epoch)
+		}
+		s.Unlock()
+		s.updateSyncCommitteeTrackedVals(st)
+	} else {
+		s.Lock()
+		for _, idx := range indices {
+			s.TrackedValidators[idx] = true
+		}
+		s.Unlock()
+	}
+
+	log.WithField("validatorIndices", indices).Info("Started tracking validators")
+	return s.persistTrackedValidators()
+}
+
+// RemoveTrackedValidators stops tracking the validators, and removes their metrics.
+func (s *Service) RemoveTrackedValidators(indices []primitives.ValidatorIndex) error {
+	s.Lock()
+	for _, idx := range indices {
+		delete(s.TrackedValidators, idx)
+		delete(s.latestPerformance, idx)
+		delete(s.aggregatedPerformance, idx)
+		delete(s.trackedSyncCommitteeIndices, idx)
+		deleteValidatorMetrics(idx)
+	}
+	s.Unlock()
+
+	log.WithField("validatorIndices", indices).Info("Stopped tracking validators")
+	return s.persistTrackedValidators()
+}
+
+// sortedTrackedIndices returns the sorted indices of the tracked validators.
+// It assumes the caller holds the service Lock.
+func (s *Service) sortedTrackedIndices() []primitives.ValidatorIndex {
+	tracked := make([]primitives.ValidatorIndex, 0, len(s.TrackedValidators))
+	for idx := range s.TrackedValidators {
+		tracked = append(tracked, idx)
+	}
+	sort.Slice(tracked, func(i, j int) bool { return tracked[i] < tracked[j] })
+	return tracked
+}
+
+// hasTrackedValidators reports whether any validator is tracked.
+func (s *Service) hasTrackedValidators() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return len(s.TrackedValidators) > 0
+}
+
+// persistTrackedValidators writes the indices of the tracked validators to the configured file, so that
+// they are tracked again after a restart.
+func (s *Service) persistTrackedValidators() error {
+	if s.config.TrackedValidatorsPath == "" {
+		return nil
+	}
+	enc, err := json.Marshal(s.TrackedValidatorIndices())
+	if err != nil {
+		return errors.Wrap(err, "could not marshal tracked validators")
+	}
+	if err := file.WriteFile(s.config.TrackedValidatorsPath, enc); err != nil {
+		return errors.Wrap(err, "could not persist tracked validators")
+	}
+	return nil
+}
+
+// loadTrackedValidators reads the indices of the validators tracked before the last restart. A missing
+// file means no validators were tracked.
+func loadTrackedValidators(path string) ([]primitives.ValidatorIndex, error) {
+	if path == "" {
+		return nil, nil
+	}
+	exists, err := file.Exists(path, file.Regular)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read tracked validators")
+	}
+	var indices []primitives.ValidatorIndex
+	if err := json.Unmarshal(enc, &indices); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal tracked validators from %s", path)
+	}
+	return indices, nil
+}
@@ -0,0 +1,47 @@
+package monitor
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestAddRemoveTrackedValidators(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	s.isLogging = true
+
+	require.NoError(t, s.AddTrackedValidators(ctx, []primitives.ValidatorIndex{3, 1}))
+	require.DeepEqual(t, []primitives.ValidatorIndex{1, 2, 3, 12, 15}, s.TrackedValidatorIndices())
+	_, ok := s.latestPerformance[3]
+	require.Equal(t, true, ok)
+	require.Equal(t, uint64(32000000000), s.aggregatedPerformance[3].startBalance)
+	// The performance of validators which were already tracked is kept.
+	require.Equal(t, uint64(12), s.aggregatedPerformance[1].totalAttestedCount)
+
+	err := s.AddTrackedValidators(ctx, []primitives.ValidatorIndex{1000})
+	require.ErrorContains(t, "validator index 1000 is out of range", err)
+
+	require.NoError(t, s.RemoveTrackedValidators([]primitives.ValidatorIndex{1, 12}))
+	require.DeepEqual(t, []primitives.ValidatorIndex{2, 3, 15}, s.TrackedValidatorIndices())
+	_, ok = s.aggregatedPerformance[1]
+	require.Equal(t, false, ok)
+	_, ok = s.trackedSyncCommitteeIndices[12]
+	require.Equal(t, false, ok)
+}
+
+func TestTrackedValidators_Persisted(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "monitored_validators.json")
+	s, err := NewService(ctx, &ValidatorMonitorConfig{TrackedValidatorsPath: path}, []primitives.ValidatorIndex{5})
+	require.NoError(t, err)
+	require.NoError(t, s.AddTrackedValidators(ctx, []primitives.ValidatorIndex{9, 2}))
+	require.NoError(t, s.RemoveTrackedValidators([]primitives.ValidatorIndex{9}))
+
+	restarted, err := NewService(ctx, &ValidatorMonitorConfig{TrackedValidatorsPath: path}, []primitives.ValidatorIndex{7})
+	require.NoError(t, err)
+	require.DeepEqual(t, []primitives.ValidatorIndex{2, 5, 7}, restarted.TrackedValidatorIndices())
+}
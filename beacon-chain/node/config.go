@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -13,12 +14,17 @@ import (
 )
 
 func configureTracing(cliCtx *cli.Context) error {
+	opts, err := cmd.TracingOptions(cliCtx)
+	if err != nil {
+		return err
+	}
 	return tracing.Setup(
 		"beacon-chain", // service name
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
 		cliCtx.Bool(cmd.EnableTracingFlag.Name),
+		opts...,
 	)
 }
 
@@ -131,6 +137,30 @@ func configureEth1Config(cliCtx *cli.Context) error {
 	return nil
 }
 
+func configureLateBlockReorg(cliCtx *cli.Context) error {
+	c := params.BeaconConfig().Copy()
+	if cliCtx.IsSet(flags.ReorgHeadWeightThreshold.Name) {
+		c.ReorgWeightThreshold = cliCtx.Uint64(flags.ReorgHeadWeightThreshold.Name)
+		log.WithField("reorgWeightThreshold", c.ReorgWeightThreshold).Warn("Late block reorg weight threshold overridden")
+	}
+	if cliCtx.IsSet(flags.ReorgParentWeightThreshold.Name) {
+		c.ReorgParentWeightThreshold = cliCtx.Uint64(flags.ReorgParentWeightThreshold.Name)
+		log.WithField("reorgParentWeightThreshold", c.ReorgParentWeightThreshold).Warn("Late block reorg parent weight threshold overridden")
+	}
+	if cliCtx.IsSet(flags.ReorgMaxEpochsSinceFinalization.Name) {
+		c.ReorgMaxEpochsSinceFinalization = primitives.Epoch(cliCtx.Uint64(flags.ReorgMaxEpochsSinceFinalization.Name))
+		log.WithField("reorgMaxEpochsSinceFinalization", c.ReorgMaxEpochsSinceFinalization).Warn("Late block reorg max epochs since finalization overridden")
+	}
+	if cliCtx.IsSet(flags.ReorgProposerCutoffSeconds.Name) {
+		c.ReorgProposerCutoffSeconds = cliCtx.Uint64(flags.ReorgProposerCutoffSeconds.Name)
+		if c.ReorgProposerCutoffSeconds >= c.SecondsPerSlot {
+			return fmt.Errorf("%s must be less than the slot duration of %d seconds", flags.ReorgProposerCutoffSeconds.Name, c.SecondsPerSlot)
+		}
+		log.WithField("reorgProposerCutoffSeconds", c.ReorgProposerCutoffSeconds).Warn("Late block reorg proposer cutoff overridden")
+	}
+	return params.SetActive(c)
+}
+
 func configureNetwork(cliCtx *cli.Context) {
 	if cliCtx.IsSet(cmd.BootstrapNode.Name) {
 		c := params.BeaconNetworkConfig()
@@ -144,6 +174,15 @@ func configureNetwork(cliCtx *cli.Context) {
 	}
 }
 
+func configureCacheSizes(cliCtx *cli.Context) {
+	const mb = uint64(1 << 20)
+	helpers.SetCacheSizes(
+		cliCtx.Uint64(flags.CommitteeCacheSize.Name)*mb,
+		cliCtx.Uint64(flags.ProposerIndicesCacheSize.Name)*mb,
+		cliCtx.Uint64(flags.SyncCommitteeCacheSize.Name)*mb,
+	)
+}
+
 func configureExecutionSetting(cliCtx *cli.Context) error {
 	if cliCtx.IsSet(flags.TerminalTotalDifficultyOverride.Name) {
 		c := params.BeaconConfig()
@@ -73,6 +73,32 @@ func TestConfigureProofOfWork(t *testing.T) {
 	assert.Equal(t, "deposit-contract", params.BeaconConfig().DepositContractAddress)
 }
 
+func TestConfigureLateBlockReorg(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.ReorgHeadWeightThreshold.Name, 0, "")
+	set.Uint64(flags.ReorgParentWeightThreshold.Name, 0, "")
+	set.Uint64(flags.ReorgMaxEpochsSinceFinalization.Name, 0, "")
+	set.Uint64(flags.ReorgProposerCutoffSeconds.Name, 0, "")
+	require.NoError(t, set.Set(flags.ReorgHeadWeightThreshold.Name, "30"))
+	require.NoError(t, set.Set(flags.ReorgParentWeightThreshold.Name, "150"))
+	require.NoError(t, set.Set(flags.ReorgMaxEpochsSinceFinalization.Name, "4"))
+	require.NoError(t, set.Set(flags.ReorgProposerCutoffSeconds.Name, "3"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureLateBlockReorg(cliCtx))
+
+	assert.Equal(t, uint64(30), params.BeaconConfig().ReorgWeightThreshold)
+	assert.Equal(t, uint64(150), params.BeaconConfig().ReorgParentWeightThreshold)
+	assert.Equal(t, primitives.Epoch(4), params.BeaconConfig().ReorgMaxEpochsSinceFinalization)
+	assert.Equal(t, uint64(3), params.BeaconConfig().ReorgProposerCutoffSeconds)
+
+	require.NoError(t, set.Set(flags.ReorgProposerCutoffSeconds.Name, "12"))
+	require.ErrorContains(t, "must be less than the slot duration", configureLateBlockReorg(cliCtx))
+}
+
 func TestConfigureExecutionSetting(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	hook := logTest.NewGlobal()
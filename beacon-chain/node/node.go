@@ -16,23 +16,31 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/httprest"
 	"github.com/prysmaticlabs/prysm/v5/api/server/middleware"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/balancearchive"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/clockskew"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/diskusage"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/healthscore"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/maintenance"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
@@ -41,7 +49,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/profiler"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
@@ -54,6 +64,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/genesis"
 	initialsync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/wsverifier"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
@@ -72,6 +83,12 @@ import (
 
 const testSkipPowFlag = "test-skip-pow"
 
+// monitorTrackedValidatorsFile persists, in the data directory, the validators tracked by the monitor at runtime.
+const monitorTrackedValidatorsFile = "monitored_validators.json"
+
+// attestationPoolFile persists, in the data directory, the attestations of the pool across restarts.
+const attestationPoolFile = "attestation_pool.ssz_snappy"
+
 // Used as a struct to keep cli flag options for configuring services
 // for the beacon node. We keep this as a separate struct to not pollute the actual BeaconNode
 // struct, as it is merely used to pass down configuration options into the appropriate services.
@@ -101,11 +118,13 @@ type BeaconNode struct {
 	depositCache            cache.DepositCache
 	trackedValidatorsCache  *cache.TrackedValidatorsCache
 	payloadIDCache          *cache.PayloadIDCache
+	badBlockCache           *cache.BadBlockCache
 	stateFeed               *event.Feed
 	blockFeed               *event.Feed
 	opFeed                  *event.Feed
 	stateGen                *stategen.State
 	collector               *bcnodeCollector
+	maintenance             *maintenance.Mode
 	slasherBlockHeadersFeed *event.Feed
 	slasherAttestationsFeed *event.Feed
 	finalizedStateAtStartUp state.BeaconState
@@ -134,6 +153,19 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 
 	registry := runtime.NewServiceRegistry()
 	ctx := cliCtx.Context
+	poolExpiry := operationsPoolExpiry(cliCtx)
+	exitPool := voluntaryexits.NewPool(
+		voluntaryexits.WithMaxSize(cliCtx.Int(flags.ExitPoolMaxSizeFlag.Name)),
+		voluntaryexits.WithExpiry(poolExpiry),
+	)
+	slashingsPool := slashings.NewPool(
+		slashings.WithMaxSize(cliCtx.Int(flags.SlashingPoolMaxSizeFlag.Name)),
+		slashings.WithExpiry(poolExpiry),
+	)
+	blsToExecPool := blstoexec.NewPool(
+		blstoexec.WithMaxSize(cliCtx.Int(flags.BLSToExecPoolMaxSizeFlag.Name)),
+		blstoexec.WithExpiry(poolExpiry),
+	)
 
 	beacon := &BeaconNode{
 		cliCtx:                  cliCtx,
@@ -145,12 +177,13 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 		blockFeed:               new(event.Feed),
 		opFeed:                  new(event.Feed),
 		attestationPool:         attestations.NewPool(),
-		exitPool:                voluntaryexits.NewPool(),
-		slashingsPool:           slashings.NewPool(),
+		exitPool:                exitPool,
+		slashingsPool:           slashingsPool,
 		syncCommitteePool:       synccommittee.NewPool(),
-		blsToExecPool:           blstoexec.NewPool(),
+		blsToExecPool:           blsToExecPool,
 		trackedValidatorsCache:  cache.NewTrackedValidatorsCache(),
 		payloadIDCache:          cache.NewPayloadIDCache(),
+		badBlockCache:           cache.NewBadBlockCache(cache.BadBlockCacheSize),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
@@ -183,6 +216,14 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 		beacon.BlobStorage = blobs
 	}
 
+	if cliCtx.Bool(flags.PersistCommitteeShuffles.Name) {
+		shuffles, err := filesystem.NewShuffleStorage(filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), "shuffles"))
+		if err != nil {
+			return nil, err
+		}
+		helpers.SetShuffleStore(shuffles)
+	}
+
 	bfs, err := startBaseServices(cliCtx, beacon, depositAddress)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not start modules")
@@ -237,6 +278,7 @@ func configureBeacon(cliCtx *cli.Context) error {
 	}
 
 	flags.ConfigureGlobalFlags(cliCtx)
+	configureCacheSizes(cliCtx)
 
 	if err := configureChainConfig(cliCtx); err != nil {
 		return errors.Wrap(err, "could not configure chain config")
@@ -258,6 +300,10 @@ func configureBeacon(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not configure eth1 config")
 	}
 
+	if err := configureLateBlockReorg(cliCtx); err != nil {
+		return errors.Wrap(err, "could not configure late block reorg")
+	}
+
 	configureNetwork(cliCtx)
 
 	if err := configureExecutionSetting(cliCtx); err != nil {
@@ -329,9 +375,9 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register initial sync service")
 	}
 
-	log.Debugln("Registering Sync Service")
-	if err := beacon.registerSyncService(beacon.initialSyncComplete, bfs); err != nil {
-		return errors.Wrap(err, "could not register sync service")
+	log.Debugln("Registering Clock Skew Service")
+	if err := beacon.registerClockSkewService(); err != nil {
+		return errors.Wrap(err, "could not register clock skew service")
 	}
 
 	log.Debugln("Registering Slasher Service")
@@ -339,11 +385,51 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register slasher service")
 	}
 
+	log.Debugln("Registering Sync Service")
+	if err := beacon.registerSyncService(beacon.initialSyncComplete, bfs); err != nil {
+		return errors.Wrap(err, "could not register sync service")
+	}
+
 	log.Debugln("Registering builder service")
 	if err := beacon.registerBuilderService(cliCtx); err != nil {
 		return errors.Wrap(err, "could not register builder service")
 	}
 
+	log.Debugln("Registering Alerts Service")
+	if err := beacon.registerAlertsService(beacon.initialSyncComplete); err != nil {
+		return errors.Wrap(err, "could not register alerts service")
+	}
+
+	log.Debugln("Registering Validator Monitoring Service")
+	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
+		return errors.Wrap(err, "could not register validator monitoring service")
+	}
+
+	log.Debugln("Registering Weak Subjectivity Verifier Service")
+	if err := beacon.registerWSVerifierService(beacon.initialSyncComplete); err != nil {
+		return errors.Wrap(err, "could not register weak subjectivity verifier service")
+	}
+
+	log.Debugln("Registering Disk Usage Service")
+	if err := beacon.registerDiskUsageService(); err != nil {
+		return errors.Wrap(err, "could not register disk usage service")
+	}
+
+	log.Debugln("Registering Health Score Service")
+	if err := beacon.registerHealthScoreService(); err != nil {
+		return errors.Wrap(err, "could not register health score service")
+	}
+
+	log.Debugln("Registering Balance Archive Service")
+	if err := beacon.registerBalanceArchiveService(); err != nil {
+		return errors.Wrap(err, "could not register balance archive service")
+	}
+
+	log.Debugln("Registering Profiler Service")
+	if err := beacon.registerProfilerService(); err != nil {
+		return errors.Wrap(err, "could not register profiler service")
+	}
+
 	log.Debugln("Registering RPC Service")
 	router := http.NewServeMux()
 	if err := beacon.registerRPCService(router); err != nil {
@@ -355,11 +441,6 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register HTTP service")
 	}
 
-	log.Debugln("Registering Validator Monitoring Service")
-	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
-		return errors.Wrap(err, "could not register validator monitoring service")
-	}
-
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		log.Debugln("Registering Prometheus Service")
 		if err := beacon.registerPrometheusService(cliCtx); err != nil {
@@ -417,10 +498,21 @@ func (b *BeaconNode) Start() {
 		log.Info("Got interrupt, shutting down...")
 		debug.Exit(b.cliCtx) // Ensure trace and CPU profile data are flushed.
 		go b.Close()
-		for i := 10; i > 0; i-- {
-			<-sigc
-			if i > 1 {
-				log.WithField("times", i-1).Info("Already shutting down, interrupt more to panic")
+		var timeout <-chan time.Time
+		if d := b.cliCtx.Duration(flags.ShutdownTimeoutFlag.Name); d > 0 {
+			timeout = time.After(d)
+		}
+		for i := 10; i > 0; {
+			select {
+			case <-stop:
+				return
+			case <-timeout:
+				panic("Timed out closing the beacon node")
+			case <-sigc:
+				i--
+				if i > 0 {
+					log.WithField("times", i).Info("Already shutting down, interrupt more to panic")
+				}
 			}
 		}
 		panic("Panic closing the beacon node")
@@ -655,6 +747,18 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		return errors.Wrapf(err, "could not register p2p service")
 	}
 
+	compression, err := encoder.ParseCompressionLevel(cliCtx.String(cmd.P2PCompression.Name))
+	if err != nil {
+		return errors.Wrapf(err, "could not parse --%s", cmd.P2PCompression.Name)
+	}
+	localCompression := compression
+	if cliCtx.IsSet(cmd.P2PLocalCompression.Name) {
+		localCompression, err = encoder.ParseCompressionLevel(cliCtx.String(cmd.P2PLocalCompression.Name))
+		if err != nil {
+			return errors.Wrapf(err, "could not parse --%s", cmd.P2PLocalCompression.Name)
+		}
+	}
+
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
 		NoDiscovery:          cliCtx.Bool(cmd.NoDiscovery.Name),
 		StaticPeers:          slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
@@ -674,6 +778,9 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		QueueSize:            cliCtx.Uint(cmd.PubsubQueueSize.Name),
 		AllowListCIDR:        cliCtx.String(cmd.P2PAllowList.Name),
 		DenyListCIDR:         slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		Compression:          compression,
+		LocalCompression:     localCompression,
+		EnableZstd:           cliCtx.Bool(cmd.P2PExperimentalZstd.Name),
 		EnableUPnP:           cliCtx.Bool(cmd.EnableUPnPFlag.Name),
 		StateNotifier:        b,
 		DB:                   b.db,
@@ -701,10 +808,19 @@ func (b *BeaconNode) fetchBuilderService() *builder.Service {
 	return s
 }
 
+// operationsPoolExpiry returns how long exits, slashings and BLS to execution changes can stay pending in the
+// operations pool, zero meaning they never expire.
+func operationsPoolExpiry(cliCtx *cli.Context) time.Duration {
+	epochs := cliCtx.Uint64(flags.OperationsPoolExpiryEpochsFlag.Name)
+	secondsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch) * params.BeaconConfig().SecondsPerSlot
+	return time.Duration(epochs*secondsPerEpoch) * time.Second
+}
+
 func (b *BeaconNode) registerAttestationPool() error {
 	s, err := attestations.NewService(b.ctx, &attestations.Config{
 		Pool:                b.attestationPool,
 		InitialSyncComplete: b.initialSyncComplete,
+		PersistPath:         filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), attestationPoolFile),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register atts pool service")
@@ -745,8 +861,11 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer, gs *st
 		blockchain.WithSyncComplete(syncComplete),
 		blockchain.WithBlobStorage(b.BlobStorage),
 		blockchain.WithTrackedValidatorsCache(b.trackedValidatorsCache),
+		blockchain.WithBadBlockCache(b.badBlockCache),
 		blockchain.WithPayloadIDCache(b.payloadIDCache),
 		blockchain.WithSyncChecker(b.syncChecker),
+		blockchain.WithSafeModeStallEpochs(primitives.Epoch(b.cliCtx.Uint64(flags.SafeModeStallEpochsFlag.Name))),
+		blockchain.WithMaxOptimisticWindow(b.cliCtx.Duration(flags.MaxOptimisticWindowFlag.Name)),
 	)
 
 	blockchainService, err := blockchain.NewService(b.ctx, opts...)
@@ -806,8 +925,17 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFil
 		return err
 	}
 
-	rs := regularsync.NewService(
-		b.ctx,
+	equivocationPolicy, err := regularsync.ParseEquivocationPolicy(b.cliCtx.String(flags.GossipEquivocationPolicyFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	var clockSkewService *clockskew.Service
+	if err := b.services.FetchService(&clockSkewService); err != nil {
+		return err
+	}
+
+	opts := []regularsync.Option{
 		regularsync.WithDatabase(b.db),
 		regularsync.WithP2P(b.fetchP2P()),
 		regularsync.WithChainService(chainService),
@@ -830,10 +958,32 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFil
 		regularsync.WithBlobStorage(b.BlobStorage),
 		regularsync.WithVerifierWaiter(b.verifyInitWaiter),
 		regularsync.WithAvailableBlocker(bFillStore),
-	)
+		regularsync.WithBadBlockCache(b.badBlockCache),
+		regularsync.WithEquivocationPolicy(equivocationPolicy),
+		regularsync.WithClockSkewRecorder(clockSkewService),
+	}
+	if features.Get().EnableSlasher {
+		// The slasher is registered before, so that the equivocations detected on gossip follow its slashing policy.
+		var slasherService *slasher.Service
+		if err := b.services.FetchService(&slasherService); err != nil {
+			return err
+		}
+		opts = append(opts, regularsync.WithSlashingSubmitter(slasherService))
+	}
+	rs := regularsync.NewService(b.ctx, opts...)
 	return b.services.RegisterService(rs)
 }
 
+func (b *BeaconNode) registerClockSkewService() error {
+	svc := clockskew.NewService(b.ctx, &clockskew.Config{
+		NTPServers:    b.cliCtx.StringSlice(flags.ClockSkewNTPServersFlag.Name),
+		Threshold:     b.cliCtx.Duration(flags.ClockSkewThresholdFlag.Name),
+		HoldDuties:    b.cliCtx.Bool(flags.ClockSkewHoldDutiesFlag.Name),
+		CheckInterval: time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second * time.Duration(params.BeaconConfig().SlotsPerEpoch),
+	})
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -870,6 +1020,19 @@ func (b *BeaconNode) registerSlasherService() error {
 		return err
 	}
 
+	var backfill *slasher.BackfillConfig
+	if b.cliCtx.IsSet(flags.SlasherBackfillStartEpochFlag.Name) {
+		backfill = &slasher.BackfillConfig{
+			StartEpoch: primitives.Epoch(b.cliCtx.Uint64(flags.SlasherBackfillStartEpochFlag.Name)),
+			EndEpoch:   primitives.Epoch(b.cliCtx.Uint64(flags.SlasherBackfillEndEpochFlag.Name)),
+		}
+	}
+
+	policy, err := slasher.ParseSlashingPolicy(b.cliCtx.String(flags.SlasherPolicyFlag.Name))
+	if err != nil {
+		return err
+	}
+
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
@@ -881,6 +1044,10 @@ func (b *BeaconNode) registerSlasherService() error {
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
 		ClockWaiter:             b.clockWaiter,
+		MaxWorkers:              b.cliCtx.Int(flags.SlasherMaxWorkersFlag.Name),
+		BeaconDatabase:          b.db,
+		Backfill:                backfill,
+		Policy:                  policy,
 	})
 	if err != nil {
 		return err
@@ -911,6 +1078,67 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 		}
 	}
 
+	var monitorService *monitor.Service
+	if err := b.services.FetchService(&monitorService); err != nil {
+		return err
+	}
+
+	var diskUsageService *diskusage.Service
+	if err := b.services.FetchService(&diskUsageService); err != nil {
+		return err
+	}
+
+	var clockSkewService *clockskew.Service
+	if err := b.services.FetchService(&clockSkewService); err != nil {
+		return err
+	}
+
+	var regularSyncService *regularsync.Service
+	if err := b.services.FetchService(&regularSyncService); err != nil {
+		return err
+	}
+
+	var healthScoreService *healthscore.Service
+	if err := b.services.FetchService(&healthScoreService); err != nil {
+		return err
+	}
+
+	// Only set the interface when the balance archive is enabled, so that it is not a typed nil.
+	var balanceArchive balancearchive.Reader
+	if b.cliCtx.Bool(flags.BalanceArchiveFlag.Name) {
+		var balanceArchiveService *balancearchive.Service
+		if err := b.services.FetchService(&balanceArchiveService); err != nil {
+			return err
+		}
+		balanceArchive = balanceArchiveService
+	}
+
+	// Only set the interface when alerts are enabled, so that it is not a typed nil.
+	var alerter alerts.Alerter
+	if b.cliCtx.String(flags.AlertWebhookURLFlag.Name) != "" {
+		var alertsService *alerts.Service
+		if err := b.services.FetchService(&alertsService); err != nil {
+			return err
+		}
+		alerter = alertsService
+	}
+	b.maintenance = maintenance.New(&maintenance.Config{
+		Disconnector: regularSyncService,
+		Exit: func() {
+			debug.Exit(b.cliCtx) // Ensure trace and CPU profile data are flushed.
+			b.Close()
+		},
+	})
+
+	var p2pService *p2p.Service
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+	runtimeOverrides, err := b.runtimeOverrides(p2pService, regularSyncService, b.fetchBuilderService())
+	if err != nil {
+		return errors.Wrap(err, "could not apply runtime overrides")
+	}
+
 	depositFetcher := b.depositCache
 	chainStartFetcher := web3Service
 
@@ -923,8 +1151,23 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	enableDebugRPCEndpoints := !b.cliCtx.Bool(flags.DisableDebugRPCEndpoints.Name)
+	headSnapshotDir := b.cliCtx.String(flags.HeadSnapshotDirFlag.Name)
+	if headSnapshotDir == "" {
+		headSnapshotDir = filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "head-snapshots")
+	}
+
+	// Only set the interface when the slasher is enabled, so that it is not a typed nil.
+	var slasherHistoryFetcher slasher.HistoryFetcher
+	var slashingApprover slasher.SlashingApprover
+	var slasherBacklogFetcher slasher.BacklogFetcher
+	if slasherService != nil {
+		slasherHistoryFetcher = slasherService
+		slasherBacklogFetcher = slasherService
+		if slasherService.Policy() == slasher.PolicyApprove {
+			slashingApprover = slasherService
+		}
+	}
 
-	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		ExecutionEngineCaller:     web3Service,
 		ExecutionReconstructor:    web3Service,
@@ -968,13 +1211,35 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 		OperationNotifier:         b,
 		StateGen:                  b.stateGen,
 		EnableDebugRPCEndpoints:   enableDebugRPCEndpoints,
+		EnableAdminRPCEndpoints:   b.cliCtx.Bool(flags.EnableAdminRPCEndpoints.Name),
 		MaxMsgSize:                maxMsgSize,
 		BlockBuilder:              b.fetchBuilderService(),
+		RegistrationHistory:       b.fetchBuilderService(),
 		Router:                    router,
 		ClockWaiter:               b.clockWaiter,
 		BlobStorage:               b.BlobStorage,
 		TrackedValidatorsCache:    b.trackedValidatorsCache,
 		PayloadIDCache:            b.payloadIDCache,
+		BadBlockCache:             b.badBlockCache,
+		SlasherHistoryFetcher:     slasherHistoryFetcher,
+		SlashingApprover:          slashingApprover,
+		SlasherBacklogFetcher:     slasherBacklogFetcher,
+		ValidatorMonitor:          monitorService,
+		DiskUsageFetcher:          diskUsageService,
+		HealthScoreFetcher:        healthScoreService,
+		FinalityStallFetcher:      chainService,
+		OptimisticStatusFetcher:   chainService,
+		ServeOptimisticDuties:     b.cliCtx.Bool(flags.OptimisticServeDutiesFlag.Name),
+		ParticipationFetcher:      chainService,
+		BalanceArchive:            balanceArchive,
+		Maintenance:               b.maintenance,
+		ClockSkew:                 clockSkewService,
+		RuntimeOverrides:          runtimeOverrides,
+		DepositRepairer:           web3Service,
+		HeadSnapshotDir:           headSnapshotDir,
+		GetPayloadSlotOffset:      b.cliCtx.Duration(flags.GetPayloadSlotOffset.Name),
+		GetPayloadRetryCachedID:   b.cliCtx.Bool(flags.GetPayloadRetryCachedID.Name),
+		Alerter:                   alerter,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -996,6 +1261,11 @@ func (b *BeaconNode) registerPrometheusService(_ *cli.Context) error {
 	service := prometheus.NewService(
 		fmt.Sprintf("%s:%d", b.cliCtx.String(cmd.MonitoringHostFlag.Name), b.cliCtx.Int(flags.MonitoringPortFlag.Name)),
 		b.services,
+		&prometheus.CardinalityConfig{
+			DisabledFamilies:   b.cliCtx.StringSlice(cmd.MetricsDisabledFamiliesFlag.Name),
+			AggregatedLabels:   b.cliCtx.StringSlice(cmd.MetricsAggregatedLabelsFlag.Name),
+			MaxSeriesPerFamily: b.cliCtx.Int(cmd.MetricsMaxSeriesPerFamilyFlag.Name),
+		},
 		additionalHandlers...,
 	)
 	hook := prometheus.NewLogrusCollector()
@@ -1018,6 +1288,14 @@ func (b *BeaconNode) registerHTTPService(router *http.ServeMux) error {
 		middleware.NormalizeQueryValuesHandler,
 		middleware.CorsHandler(allowedOrigins),
 	}
+	if b.maintenance != nil {
+		middlewares = append(middlewares, b.maintenance.Middleware)
+	}
+	var clockSkewService *clockskew.Service
+	if err := b.services.FetchService(&clockSkewService); err != nil {
+		return err
+	}
+	middlewares = append(middlewares, clockSkewService.Middleware)
 
 	opts := []httprest.Option{
 		httprest.WithRouter(router),
@@ -1036,9 +1314,6 @@ func (b *BeaconNode) registerHTTPService(router *http.ServeMux) error {
 
 func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan struct{}) error {
 	cliSlice := b.cliCtx.IntSlice(cmd.ValidatorMonitorIndicesFlag.Name)
-	if cliSlice == nil {
-		return nil
-	}
 	tracked := make([]primitives.ValidatorIndex, len(cliSlice))
 	for i := range tracked {
 		tracked[i] = primitives.ValidatorIndex(cliSlice[i])
@@ -1049,11 +1324,33 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 		return err
 	}
 	monitorConfig := &monitor.ValidatorMonitorConfig{
-		StateNotifier:       b,
-		AttestationNotifier: b,
-		StateGen:            b.stateGen,
-		HeadFetcher:         chainService,
-		InitialSyncComplete: initialSyncComplete,
+		StateNotifier:           b,
+		AttestationNotifier:     b,
+		StateGen:                b.stateGen,
+		HeadFetcher:             chainService,
+		InitialSyncComplete:     initialSyncComplete,
+		AttestationStreakEpochs: primitives.Epoch(b.cliCtx.Uint64(flags.AlertAttestationStreakFlag.Name)),
+		TrackedValidatorsPath:   filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), monitorTrackedValidatorsFile),
+	}
+	if b.cliCtx.String(flags.AlertWebhookURLFlag.Name) != "" {
+		var alertsService *alerts.Service
+		if err := b.services.FetchService(&alertsService); err != nil {
+			return err
+		}
+		monitorConfig.Alerter = alertsService
+	}
+	if path := b.cliCtx.String(flags.MonitorInclusionCSVFlag.Name); path != "" {
+		csvExporter, err := monitor.NewCSVInclusionExporter(path)
+		if err != nil {
+			return errors.Wrap(err, "could not create attestation inclusion CSV exporter")
+		}
+		monitorConfig.InclusionExporters = append(monitorConfig.InclusionExporters, csvExporter)
+	}
+	if url := b.cliCtx.String(flags.MonitorInclusionInfluxDBURLFlag.Name); url != "" {
+		monitorConfig.InclusionExporters = append(monitorConfig.InclusionExporters, monitor.NewInfluxDBInclusionExporter(url))
+	}
+	if url := b.cliCtx.String(flags.MonitorInclusionRemoteWriteURLFlag.Name); url != "" {
+		monitorConfig.InclusionExporters = append(monitorConfig.InclusionExporters, monitor.NewRemoteWriteInclusionExporter(url))
 	}
 	svc, err := monitor.NewService(b.ctx, monitorConfig, tracked)
 	if err != nil {
@@ -1062,6 +1359,206 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 	return b.services.RegisterService(svc)
 }
 
+func (b *BeaconNode) registerAlertsService(initialSyncComplete chan struct{}) error {
+	url := b.cliCtx.String(flags.AlertWebhookURLFlag.Name)
+	if url == "" {
+		return nil
+	}
+	events, err := alerts.ParseEvents(b.cliCtx.StringSlice(flags.AlertEventsFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var p2pService *p2p.Service
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+
+	source, err := os.Hostname()
+	if err != nil {
+		source = "prysm-beacon-node"
+	}
+	svc := alerts.NewService(b.ctx, &alerts.Config{
+		Notifier:                alerts.NewWebhook(url, b.cliCtx.String(flags.AlertWebhookRoutingKeyFlag.Name), source),
+		Events:                  events,
+		InitialSyncComplete:     initialSyncComplete,
+		ClockWaiter:             b.clockWaiter,
+		FinalizationFetcher:     chainService,
+		PeersProvider:           p2pService,
+		OptimisticStatusFetcher: chainService,
+		DataDir:                 b.cliCtx.String(cmd.DataDirFlag.Name),
+		MinPeers:                b.cliCtx.Int(flags.AlertMinPeersFlag.Name),
+		FinalityStallEpochs:     primitives.Epoch(b.cliCtx.Uint64(flags.AlertFinalityStallEpochsFlag.Name)),
+		MinDiskFreePercent:      b.cliCtx.Uint64(flags.AlertMinDiskFreePercentFlag.Name),
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerWSVerifierService(initialSyncComplete chan struct{}) error {
+	urls := b.cliCtx.StringSlice(flags.CheckpointProvidersFlag.Name)
+	if len(urls) == 0 {
+		return nil
+	}
+	providers := make([]wsverifier.Provider, len(urls))
+	for i, u := range urls {
+		p, err := wsverifier.NewAPIProvider(u)
+		if err != nil {
+			return err
+		}
+		providers[i] = p
+	}
+	quorum := b.cliCtx.Int(flags.CheckpointProviderQuorumFlag.Name)
+	if quorum < 0 || quorum > len(providers) {
+		return fmt.Errorf("--%s must be between 0, for a majority, and the number of --%s, got %d",
+			flags.CheckpointProviderQuorumFlag.Name, flags.CheckpointProvidersFlag.Name, quorum)
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	cfg := &wsverifier.Config{
+		Providers:           providers,
+		Quorum:              quorum,
+		InitialSyncComplete: initialSyncComplete,
+		ClockWaiter:         b.clockWaiter,
+		FinalizationFetcher: chainService,
+	}
+	if b.cliCtx.String(flags.AlertWebhookURLFlag.Name) != "" {
+		var alertsService *alerts.Service
+		if err := b.services.FetchService(&alertsService); err != nil {
+			return err
+		}
+		cfg.Alerter = alertsService
+	}
+	return b.services.RegisterService(wsverifier.NewService(b.ctx, cfg))
+}
+
+func (b *BeaconNode) registerDiskUsageService() error {
+	counters := map[string]func() uint64{
+		"blobs": b.BlobStorage.BytesWritten,
+	}
+	if w, ok := b.db.(interface {
+		BlockBytesWritten() uint64
+		StateBytesWritten() uint64
+	}); ok {
+		counters["blocks"] = w.BlockBytesWritten
+		counters["states"] = w.StateBytesWritten
+	}
+
+	var actions []diskusage.PruneAction
+	for _, name := range b.cliCtx.StringSlice(flags.DiskUsagePruneActionsFlag.Name) {
+		switch name {
+		case "blobs":
+			actions = append(actions, diskusage.PruneAction{Name: name, Run: func(ctx context.Context) error {
+				clock, err := b.clockWaiter.WaitForClock(ctx)
+				if err != nil {
+					return err
+				}
+				return b.BlobStorage.Prune(clock.CurrentSlot())
+			}})
+		default:
+			return fmt.Errorf("unknown disk usage prune action %s", name)
+		}
+	}
+
+	epoch := time.Duration(params.BeaconConfig().SlotsPerEpoch) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	svc := diskusage.NewService(b.ctx, &diskusage.Config{
+		DataDir:        b.cliCtx.String(cmd.DataDirFlag.Name),
+		ThresholdBytes: b.cliCtx.Uint64(flags.DiskUsageThresholdFlag.Name) * 1e9,
+		WriteCounters:  counters,
+		PruneActions:   actions,
+		PruneHorizon:   b.cliCtx.Duration(flags.DiskUsagePruneHorizonFlag.Name),
+		SampleInterval: epoch,
+		Window:         24 * time.Hour,
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerHealthScoreService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var p2pService *p2p.Service
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+	var monitorService *monitor.Service
+	if err := b.services.FetchService(&monitorService); err != nil {
+		return err
+	}
+	svc := healthscore.NewService(b.ctx, &healthscore.Config{
+		ClockWaiter:            b.clockWaiter,
+		HeadFetcher:            chainService,
+		FinalizationFetcher:    chainService,
+		PeersProvider:          p2pService,
+		DutyPerformanceFetcher: monitorService,
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerBalanceArchiveService() error {
+	if !b.cliCtx.Bool(flags.BalanceArchiveFlag.Name) {
+		return nil
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	history := stategen.NewCanonicalHistory(b.db, chainService, chainService, stategen.WithCache(b.stateGen.CombinedCache()))
+	svc, err := balancearchive.NewService(b.ctx, &balancearchive.Config{
+		ClockWaiter:     b.clockWaiter,
+		HeadFetcher:     chainService,
+		StateNotifier:   b,
+		ReplayerBuilder: history,
+		Dir:             filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "balance-archive"),
+		RetentionEpochs: primitives.Epoch(b.cliCtx.Uint64(flags.BalanceArchiveRetentionEpochsFlag.Name)),
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerProfilerService() error {
+	if !b.cliCtx.Bool(flags.ContinuousProfilingFlag.Name) {
+		return nil
+	}
+	types, err := profiler.ParseTypes(b.cliCtx.StringSlice(flags.ContinuousProfilingTypesFlag.Name))
+	if err != nil {
+		return err
+	}
+	interval := b.cliCtx.Duration(flags.ContinuousProfilingIntervalFlag.Name)
+	if slot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second; interval <= 2*slot {
+		return fmt.Errorf("%s must be longer than two slots", flags.ContinuousProfilingIntervalFlag.Name)
+	}
+	dir := b.cliCtx.String(flags.ContinuousProfilingDirFlag.Name)
+	if dir == "" {
+		dir = filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "profiles")
+	}
+
+	cfg := &profiler.Config{
+		ClockWaiter: b.clockWaiter,
+		Types:       types,
+		Interval:    interval,
+		Dir:         dir,
+		Retention:   b.cliCtx.Duration(flags.ContinuousProfilingRetentionFlag.Name),
+	}
+	if url := b.cliCtx.String(flags.ContinuousProfilingPushURLFlag.Name); url != "" {
+		labels := map[string]string{"network": params.BeaconConfig().ConfigName}
+		if host, err := os.Hostname(); err == nil {
+			labels["instance"] = host
+		}
+		cfg.Pusher = profiler.NewIngestPusher(url, "prysm-beacon", labels)
+	}
+	return b.services.RegisterService(profiler.NewService(b.ctx, cfg))
+}
+
 func (b *BeaconNode) registerBuilderService(cliCtx *cli.Context) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
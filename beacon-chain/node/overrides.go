@@ -0,0 +1,146 @@
+package node
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/overrides"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	regularsync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	subnetStrategyDefault = "default"
+	subnetStrategyAll     = "all"
+)
+
+// runtimeOverrides registers the parameters that can be changed without restarting the node, and applies the
+// overrides persisted in the data directory by the previous run.
+func (b *BeaconNode) runtimeOverrides(
+	p2pService *p2p.Service,
+	syncService *regularsync.Service,
+	builderService *builder.Service,
+) (*overrides.Manager, error) {
+	m := overrides.New(b.cliCtx.String(cmd.DataDirFlag.Name))
+	m.Register(&overrides.Parameter{
+		Name:        overrides.PeerTarget,
+		Description: "The number of peers the node maintains, as set by --" + cmd.P2PMaxPeers.Name + ".",
+		Get: func() string {
+			return strconv.FormatUint(p2pService.Peers().ConnectedPeerLimit(), 10)
+		},
+		Set: func(value string) error {
+			n, err := strconv.ParseUint(value, 10, 32)
+			if err != nil || n == 0 {
+				return errors.New("must be a positive integer")
+			}
+			p2pService.SetMaxPeers(uint(n))
+			return nil
+		},
+	})
+	m.Register(&overrides.Parameter{
+		Name: overrides.SubnetStrategy,
+		Description: "Either \"" + subnetStrategyDefault + "\", subscribing to the subnets of the attached validators only, or \"" +
+			subnetStrategyAll + "\", subscribing to all the attestation and sync committee subnets as --" + flags.SubscribeToAllSubnets.Name + " does.",
+		Get: func() string {
+			if flags.Get().SubscribeToAllSubnets {
+				return subnetStrategyAll
+			}
+			return subnetStrategyDefault
+		},
+		Set: func(value string) error {
+			if value != subnetStrategyDefault && value != subnetStrategyAll {
+				return errors.Errorf("must be %q or %q", subnetStrategyDefault, subnetStrategyAll)
+			}
+			flags.Update(func(cfg *flags.GlobalFlags) {
+				cfg.SubscribeToAllSubnets = value == subnetStrategyAll
+			})
+			return nil
+		},
+	})
+	m.Register(&overrides.Parameter{
+		Name:        overrides.LogLevel,
+		Description: "The global log level, as set by --" + cmd.VerbosityFlag.Name + ".",
+		Get: func() string {
+			level, _ := logs.Levels()
+			return level.String()
+		},
+		Set: func(value string) error {
+			level, err := logrus.ParseLevel(value)
+			if err != nil {
+				return err
+			}
+			logs.SetLevel(level)
+			return nil
+		},
+	})
+	if builderService.Configured() {
+		m.Register(&overrides.Parameter{
+			Name:        overrides.BuilderEnabled,
+			Description: "Whether block proposals request payloads from the builder configured by --" + flags.MevRelayEndpoint.Name + ".",
+			Get: func() string {
+				return strconv.FormatBool(builderService.Enabled())
+			},
+			Set: func(value string) error {
+				enabled, err := strconv.ParseBool(value)
+				if err != nil {
+					return err
+				}
+				builderService.SetEnabled(enabled)
+				return nil
+			},
+		})
+	}
+	m.Register(&overrides.Parameter{
+		Name:        overrides.BlockBatchLimit,
+		Description: "The number of blocks per second each peer may request from the node, as set by --" + flags.BlockBatchLimit.Name + ".",
+		Get: func() string {
+			return strconv.Itoa(flags.Get().BlockBatchLimit)
+		},
+		Set: func(value string) error {
+			n, err := positiveInt(value)
+			if err != nil {
+				return err
+			}
+			flags.Update(func(cfg *flags.GlobalFlags) {
+				cfg.BlockBatchLimit = n
+			})
+			syncService.UpdateRateLimits()
+			return nil
+		},
+	})
+	m.Register(&overrides.Parameter{
+		Name:        overrides.BlobBatchLimit,
+		Description: "The number of blob sidecars per second each peer may request from the node, as set by --" + flags.BlobBatchLimit.Name + ".",
+		Get: func() string {
+			return strconv.Itoa(flags.Get().BlobBatchLimit)
+		},
+		Set: func(value string) error {
+			n, err := positiveInt(value)
+			if err != nil {
+				return err
+			}
+			flags.Update(func(cfg *flags.GlobalFlags) {
+				cfg.BlobBatchLimit = n
+			})
+			syncService.UpdateRateLimits()
+			return nil
+		},
+	})
+	if err := m.Load(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func positiveInt(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0, errors.New("must be a positive integer")
+	}
+	return n, nil
+}
@@ -0,0 +1,146 @@
+package attestations
+
+import (
+	"encoding/binary"
+	"os"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/sirupsen/logrus"
+)
+
+// Kinds of the persisted attestations.
+const (
+	persistedAggregated byte = iota
+	persistedUnaggregated
+)
+
+// Each persisted attestation is prefixed with its kind, its version and the length of its SSZ encoding.
+const persistedAttHeaderLength = 1 + 1 + 4
+
+// saveAtts writes the aggregated and unaggregated attestations of the pool to the configured file, so that
+// they can be included in blocks proposed right after a restart.
+func (s *Service) saveAtts() error {
+	if s.cfg.PersistPath == "" {
+		return nil
+	}
+	unaggregated, err := s.cfg.Pool.UnaggregatedAttestations()
+	if err != nil {
+		return errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	aggregated := s.cfg.Pool.AggregatedAttestations()
+
+	var enc []byte
+	for _, att := range aggregated {
+		if enc, err = appendPersistedAtt(enc, persistedAggregated, att); err != nil {
+			return err
+		}
+	}
+	for _, att := range unaggregated {
+		if enc, err = appendPersistedAtt(enc, persistedUnaggregated, att); err != nil {
+			return err
+		}
+	}
+	if err := file.WriteFile(s.cfg.PersistPath, snappy.Encode(nil, enc)); err != nil {
+		return errors.Wrap(err, "could not persist attestations")
+	}
+	log.WithFields(logrus.Fields{
+		"aggregated":   len(aggregated),
+		"unaggregated": len(unaggregated),
+	}).Info("Saved attestation pool")
+	return nil
+}
+
+// loadAtts saves in the pool the attestations persisted before the last restart, except the expired ones, and
+// deletes the file so that they are not loaded again. A missing file means no attestations were persisted.
+func (s *Service) loadAtts() error {
+	if s.cfg.PersistPath == "" {
+		return nil
+	}
+	exists, err := file.Exists(s.cfg.PersistPath, file.Regular)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	compressed, err := file.ReadFileAsBytes(s.cfg.PersistPath)
+	if err != nil {
+		return errors.Wrap(err, "could not read persisted attestations")
+	}
+	if err := os.Remove(s.cfg.PersistPath); err != nil {
+		return errors.Wrap(err, "could not delete persisted attestations")
+	}
+	enc, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return errors.Wrapf(err, "could not decompress persisted attestations from %s", s.cfg.PersistPath)
+	}
+
+	var aggregated, unaggregated []ethpb.Att
+	for len(enc) > 0 {
+		var kind byte
+		var att ethpb.Att
+		kind, att, enc, err = readPersistedAtt(enc)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode persisted attestations from %s", s.cfg.PersistPath)
+		}
+		if s.expired(att.GetData().Slot) {
+			continue
+		}
+		if kind == persistedAggregated {
+			aggregated = append(aggregated, att)
+		} else {
+			unaggregated = append(unaggregated, att)
+		}
+	}
+	if err := s.cfg.Pool.SaveAggregatedAttestations(aggregated); err != nil {
+		return errors.Wrap(err, "could not save persisted aggregated attestations")
+	}
+	if err := s.cfg.Pool.SaveUnaggregatedAttestations(unaggregated); err != nil {
+		return errors.Wrap(err, "could not save persisted unaggregated attestations")
+	}
+	log.WithFields(logrus.Fields{
+		"aggregated":   len(aggregated),
+		"unaggregated": len(unaggregated),
+	}).Info("Loaded attestation pool")
+	return nil
+}
+
+func appendPersistedAtt(enc []byte, kind byte, att ethpb.Att) ([]byte, error) {
+	ssz, err := att.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attestation")
+	}
+	enc = append(enc, kind, byte(att.Version()))
+	enc = binary.LittleEndian.AppendUint32(enc, uint32(len(ssz)))
+	return append(enc, ssz...), nil
+}
+
+// readPersistedAtt decodes the first persisted attestation, and returns the rest of the encoding.
+func readPersistedAtt(enc []byte) (byte, ethpb.Att, []byte, error) {
+	if len(enc) < persistedAttHeaderLength {
+		return 0, nil, nil, errors.New("truncated attestation header")
+	}
+	kind, v := enc[0], int(enc[1])
+	length := binary.LittleEndian.Uint32(enc[2:persistedAttHeaderLength])
+	enc = enc[persistedAttHeaderLength:]
+	if uint64(len(enc)) < uint64(length) {
+		return 0, nil, nil, errors.New("truncated attestation")
+	}
+	if kind != persistedAggregated && kind != persistedUnaggregated {
+		return 0, nil, nil, errors.Errorf("unknown attestation kind %d", kind)
+	}
+	var att ethpb.Att
+	if v >= version.Electra {
+		att = &ethpb.AttestationElectra{}
+	} else {
+		att = &ethpb.Attestation{}
+	}
+	if err := att.UnmarshalSSZ(enc[:length]); err != nil {
+		return 0, nil, nil, errors.Wrap(err, "could not unmarshal attestation")
+	}
+	return kind, att, enc[length:], nil
+}
@@ -0,0 +1,75 @@
+package attestations
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+func TestPersistAtts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "attestation_pool.ssz_snappy")
+	// Rewind back one epoch worth of time, so that the attestations of slot 0 are expired.
+	genesisTime := uint64(prysmTime.Now().Unix()) - uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot))
+
+	s, err := NewService(context.Background(), &Config{Pool: NewPool(), PersistPath: path})
+	require.NoError(t, err)
+	s.SetGenesisTime(genesisTime)
+
+	ad0 := util.HydrateAttestationData(&ethpb.AttestationData{})
+	ad1 := util.HydrateAttestationData(&ethpb.AttestationData{Slot: 1})
+	unaggregated := &ethpb.Attestation{Data: ad1, AggregationBits: bitfield.Bitlist{0b1000, 0b1}, Signature: make([]byte, fieldparams.BLSSignatureLength)}
+	aggregated := &ethpb.Attestation{Data: ad1, AggregationBits: bitfield.Bitlist{0b1101, 0b1}, Signature: make([]byte, fieldparams.BLSSignatureLength)}
+	require.NoError(t, s.cfg.Pool.SaveUnaggregatedAttestations([]ethpb.Att{
+		unaggregated,
+		&ethpb.Attestation{Data: ad0, AggregationBits: bitfield.Bitlist{0b1000, 0b1}, Signature: make([]byte, fieldparams.BLSSignatureLength)},
+	}))
+	require.NoError(t, s.cfg.Pool.SaveAggregatedAttestations([]ethpb.Att{
+		aggregated,
+		&ethpb.Attestation{Data: ad0, AggregationBits: bitfield.Bitlist{0b1101, 0b1}, Signature: make([]byte, fieldparams.BLSSignatureLength)},
+	}))
+	require.NoError(t, s.Stop())
+
+	// The attestations are loaded in the pool after a restart, except the expired ones.
+	restarted, err := NewService(context.Background(), &Config{Pool: NewPool(), PersistPath: path})
+	require.NoError(t, err)
+	restarted.SetGenesisTime(genesisTime)
+	require.NoError(t, restarted.loadAtts())
+	atts, err := restarted.cfg.Pool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, unaggregated, atts[0])
+	atts = restarted.cfg.Pool.AggregatedAttestations()
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, aggregated, atts[0])
+
+	// The file is deleted once loaded.
+	exists, err := file.Exists(path, file.Regular)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+	require.NoError(t, restarted.loadAtts())
+	assert.Equal(t, 1, restarted.cfg.Pool.UnaggregatedAttestationCount())
+}
+
+func TestPersistedAtt_Electra(t *testing.T) {
+	att := util.HydrateAttestationElectra(&ethpb.AttestationElectra{AggregationBits: bitfield.Bitlist{0b1101, 0b1}})
+	enc, err := appendPersistedAtt(nil, persistedAggregated, att)
+	require.NoError(t, err)
+	kind, decoded, rest, err := readPersistedAtt(enc)
+	require.NoError(t, err)
+	assert.Equal(t, persistedAggregated, kind)
+	assert.Equal(t, 0, len(rest))
+	assert.DeepEqual(t, att, decoded)
+
+	_, _, _, err = readPersistedAtt(enc[:len(enc)-1])
+	assert.ErrorContains(t, "truncated attestation", err)
+}
@@ -30,6 +30,9 @@ type Config struct {
 	Pool                Pool
 	pruneInterval       time.Duration
 	InitialSyncComplete chan struct{}
+	// PersistPath, when set, is the file the aggregated and unaggregated attestations of the pool are saved to on
+	// shutdown, and loaded from on startup.
+	PersistPath string
 }
 
 // NewService instantiates a new attestation pool service instance that will
@@ -57,6 +60,9 @@ func (s *Service) Start() {
 		log.WithError(err).Error("failed to wait for initial sync")
 		return
 	}
+	if err := s.loadAtts(); err != nil {
+		log.WithError(err).Error("Could not load persisted attestations")
+	}
 	go s.prepareForkChoiceAtts()
 	go s.pruneAttsPool()
 }
@@ -75,6 +81,9 @@ func (s *Service) waitForSync(syncChan chan struct{}) error {
 // and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if err := s.saveAtts(); err != nil {
+		log.WithError(err).Error("Could not persist attestations")
+	}
 	return nil
 }
 
@@ -0,0 +1,59 @@
+package blstoexec
+
+import "time"
+
+// Reasons of the evictions of objects from the pool.
+const (
+	evictedSize   = "size"
+	evictedExpiry = "expiry"
+)
+
+// Option configures the limits of the pool.
+type Option func(*Pool)
+
+// WithMaxSize limits the number of pending objects, the oldest objects being evicted to make room for new ones.
+// Zero means no limit.
+func WithMaxSize(size int) Option {
+	return func(p *Pool) {
+		p.maxSize = size
+	}
+}
+
+// WithExpiry evicts the objects pending for longer than the expiry. Zero means objects never expire.
+func WithExpiry(expiry time.Duration) Option {
+	return func(p *Pool) {
+		p.expiry = expiry
+	}
+}
+
+// evictExpired evicts the objects pending for longer than the expiry. As objects are appended to the pending list,
+// the oldest objects come first.
+// Note: this method requires caller to hold the lock.
+func (p *Pool) evictExpired(now time.Time) {
+	if p.expiry == 0 {
+		return
+	}
+	for node := p.pending.First(); node != nil; node = p.pending.First() {
+		change, err := node.Value()
+		if err != nil || now.Sub(p.inserted[change.Message.ValidatorIndex]) <= p.expiry {
+			return
+		}
+		p.evictOldest(evictedExpiry)
+	}
+}
+
+// evictOldest evicts the oldest pending object, and returns false if there is none.
+// Note: this method requires caller to hold the lock.
+func (p *Pool) evictOldest(reason string) bool {
+	node := p.pending.First()
+	if node == nil {
+		return false
+	}
+	change, err := node.Value()
+	if err != nil {
+		return false
+	}
+	p.remove(change.Message.ValidatorIndex, node)
+	blsToExecMessageEvictedTotal.WithLabelValues(reason).Inc()
+	return true
+}
@@ -0,0 +1,51 @@
+package blstoexec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func changeForValIdx(idx primitives.ValidatorIndex) *ethpb.SignedBLSToExecutionChange {
+	return &ethpb.SignedBLSToExecutionChange{
+		Message: &ethpb.BLSToExecutionChange{
+			ValidatorIndex: idx,
+		},
+	}
+}
+
+func TestInsertBLSToExecChange_MaxSize(t *testing.T) {
+	pool := NewPool(WithMaxSize(2))
+	pool.InsertBLSToExecChange(changeForValIdx(0))
+	pool.InsertBLSToExecChange(changeForValIdx(1))
+	pool.InsertBLSToExecChange(changeForValIdx(2))
+
+	// The oldest change is evicted.
+	changes, err := pool.PendingBLSToExecChanges()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(changes))
+	assert.Equal(t, primitives.ValidatorIndex(1), changes[0].Message.ValidatorIndex)
+	assert.Equal(t, primitives.ValidatorIndex(2), changes[1].Message.ValidatorIndex)
+	assert.Equal(t, false, pool.ValidatorExists(0))
+	assert.Equal(t, 2, len(pool.inserted))
+}
+
+func TestInsertBLSToExecChange_Expiry(t *testing.T) {
+	pool := NewPool(WithExpiry(time.Hour))
+	pool.InsertBLSToExecChange(changeForValIdx(0))
+	pool.InsertBLSToExecChange(changeForValIdx(1))
+	pool.inserted[0] = pool.inserted[0].Add(-2 * time.Hour)
+	pool.InsertBLSToExecChange(changeForValIdx(2))
+
+	// The expired change is evicted.
+	changes, err := pool.PendingBLSToExecChanges()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(changes))
+	assert.Equal(t, primitives.ValidatorIndex(1), changes[0].Message.ValidatorIndex)
+	assert.Equal(t, primitives.ValidatorIndex(2), changes[1].Message.ValidatorIndex)
+	assert.Equal(t, false, pool.ValidatorExists(0))
+}
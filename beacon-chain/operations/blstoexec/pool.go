@@ -3,6 +3,7 @@ package blstoexec
 import (
 	"math"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -25,6 +26,10 @@ var (
 		Name: "bls_to_exec_message_pool_total",
 		Help: "The number of saved bls to exec messages in the operation pool.",
 	})
+	blsToExecMessageEvictedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bls_to_exec_message_pool_evicted_total",
+		Help: "The number of bls to exec messages evicted from the operation pool, by reason.",
+	}, []string{"reason"})
 )
 
 // PoolManager maintains pending and seen BLS-to-execution-change objects.
@@ -39,26 +44,39 @@ type PoolManager interface {
 
 // Pool is a concrete implementation of PoolManager.
 type Pool struct {
-	lock    sync.RWMutex
-	pending doublylinkedlist.List[*ethpb.SignedBLSToExecutionChange]
-	m       map[primitives.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedBLSToExecutionChange]
+	lock     sync.RWMutex
+	pending  doublylinkedlist.List[*ethpb.SignedBLSToExecutionChange]
+	m        map[primitives.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedBLSToExecutionChange]
+	inserted map[primitives.ValidatorIndex]time.Time
+	maxSize  int
+	expiry   time.Duration
 }
 
 // NewPool returns an initialized pool.
-func NewPool() *Pool {
-	return &Pool{
-		pending: doublylinkedlist.List[*ethpb.SignedBLSToExecutionChange]{},
-		m:       make(map[primitives.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedBLSToExecutionChange]),
+func NewPool(opts ...Option) *Pool {
+	p := &Pool{
+		pending:  doublylinkedlist.List[*ethpb.SignedBLSToExecutionChange]{},
+		m:        make(map[primitives.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedBLSToExecutionChange]),
+		inserted: make(map[primitives.ValidatorIndex]time.Time),
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
-// Copies the internal map and returns a new one.
+// Copies the internal maps and returns new ones.
 func (p *Pool) cycleMap() {
 	newMap := make(map[primitives.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedBLSToExecutionChange])
 	for k, v := range p.m {
 		newMap[k] = v
 	}
 	p.m = newMap
+	newInserted := make(map[primitives.ValidatorIndex]time.Time)
+	for k, v := range p.inserted {
+		newInserted[k] = v
+	}
+	p.inserted = newInserted
 }
 
 // PendingBLSToExecChanges returns all objects from the pool.
@@ -85,6 +103,10 @@ func (p *Pool) PendingBLSToExecChanges() ([]*ethpb.SignedBLSToExecutionChange, e
 // BLSToExecChangesForInclusion returns objects that are ready for inclusion.
 // This method will not return more than the block enforced MaxBlsToExecutionChanges.
 func (p *Pool) BLSToExecChangesForInclusion(st state.ReadOnlyBeaconState) ([]*ethpb.SignedBLSToExecutionChange, error) {
+	p.lock.Lock()
+	p.evictExpired(time.Now())
+	p.lock.Unlock()
+
 	p.lock.RLock()
 	defer p.lock.RUnlock()
 	length := int(math.Min(float64(params.BeaconConfig().MaxBlsToExecutionChanges), float64(p.pending.Len())))
@@ -123,8 +145,17 @@ func (p *Pool) InsertBLSToExecChange(change *ethpb.SignedBLSToExecutionChange) {
 		return
 	}
 
+	now := time.Now()
+	p.evictExpired(now)
+	for p.maxSize > 0 && p.numPending() >= p.maxSize {
+		if !p.evictOldest(evictedSize) {
+			break
+		}
+	}
+
 	p.pending.Append(doublylinkedlist.NewNode(change))
 	p.m[change.Message.ValidatorIndex] = p.pending.Last()
+	p.inserted[change.Message.ValidatorIndex] = now
 
 	blsToExecMessageInPoolTotal.Inc()
 }
@@ -140,7 +171,14 @@ func (p *Pool) MarkIncluded(change *ethpb.SignedBLSToExecutionChange) {
 		return
 	}
 
-	delete(p.m, change.Message.ValidatorIndex)
+	p.remove(change.Message.ValidatorIndex, node)
+}
+
+// remove the pending object of the validator from the pool.
+// Note: this method requires caller to hold the lock.
+func (p *Pool) remove(idx primitives.ValidatorIndex, node *doublylinkedlist.Node[*ethpb.SignedBLSToExecutionChange]) {
+	delete(p.m, idx)
+	delete(p.inserted, idx)
 	p.pending.Remove(node)
 	if p.numPending() == blsChangesPoolThreshold {
 		p.cycleMap()
@@ -0,0 +1,109 @@
+package slashings
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// Kinds of the slashings, and reasons of their evictions from the pool.
+const (
+	attesterSlashingKind = "attester"
+	proposerSlashingKind = "proposer"
+	evictedSize          = "size"
+	evictedExpiry        = "expiry"
+)
+
+// Option configures the limits of the pool.
+type Option func(*Pool)
+
+// WithMaxSize limits the number of pending attester slashings, and the number of pending proposer slashings, the
+// oldest slashings being evicted to make room for new ones. Zero means no limit.
+func WithMaxSize(size int) Option {
+	return func(p *Pool) {
+		p.maxSize = size
+	}
+}
+
+// WithExpiry evicts the slashings pending for longer than the expiry. Zero means slashings never expire.
+func WithExpiry(expiry time.Duration) Option {
+	return func(p *Pool) {
+		p.expiry = expiry
+	}
+}
+
+// evictExpired evicts the slashings pending for longer than the expiry.
+// Note: this method requires caller to hold the lock.
+func (p *Pool) evictExpired() {
+	if p.expiry == 0 {
+		return
+	}
+	now := time.Now()
+	attester := p.pendingAttesterSlashing[:0]
+	for _, s := range p.pendingAttesterSlashing {
+		if now.Sub(s.inserted) > p.expiry {
+			evictedSlashings.WithLabelValues(attesterSlashingKind, evictedExpiry).Inc()
+			continue
+		}
+		attester = append(attester, s)
+	}
+	p.pendingAttesterSlashing = attester
+
+	proposer := p.pendingProposerSlashing[:0]
+	for _, s := range p.pendingProposerSlashing {
+		idx := s.Header_1.Header.ProposerIndex
+		if now.Sub(p.proposerSlashingInserted[idx]) > p.expiry {
+			delete(p.proposerSlashingInserted, idx)
+			evictedSlashings.WithLabelValues(proposerSlashingKind, evictedExpiry).Inc()
+			continue
+		}
+		proposer = append(proposer, s)
+	}
+	p.pendingProposerSlashing = proposer
+}
+
+// makeRoomForAttesterSlashing evicts the oldest pending attester slashings until another one fits in the pool.
+// Note: this method requires caller to hold the lock.
+func (p *Pool) makeRoomForAttesterSlashing() {
+	for p.maxSize > 0 && len(p.pendingAttesterSlashing) >= p.maxSize {
+		oldest := 0
+		for i, s := range p.pendingAttesterSlashing {
+			if s.inserted.Before(p.pendingAttesterSlashing[oldest].inserted) {
+				oldest = i
+			}
+		}
+		p.pendingAttesterSlashing = append(p.pendingAttesterSlashing[:oldest], p.pendingAttesterSlashing[oldest+1:]...)
+		evictedSlashings.WithLabelValues(attesterSlashingKind, evictedSize).Inc()
+	}
+}
+
+// makeRoomForProposerSlashing evicts the oldest pending proposer slashings until another one fits in the pool.
+// Note: this method requires caller to hold the lock.
+func (p *Pool) makeRoomForProposerSlashing() {
+	for p.maxSize > 0 && len(p.pendingProposerSlashing) >= p.maxSize {
+		oldest := 0
+		for i, s := range p.pendingProposerSlashing {
+			oldestIdx := p.pendingProposerSlashing[oldest].Header_1.Header.ProposerIndex
+			if p.proposerSlashingInserted[s.Header_1.Header.ProposerIndex].Before(p.proposerSlashingInserted[oldestIdx]) {
+				oldest = i
+			}
+		}
+		delete(p.proposerSlashingInserted, p.pendingProposerSlashing[oldest].Header_1.Header.ProposerIndex)
+		p.pendingProposerSlashing = append(p.pendingProposerSlashing[:oldest], p.pendingProposerSlashing[oldest+1:]...)
+		evictedSlashings.WithLabelValues(proposerSlashingKind, evictedSize).Inc()
+	}
+}
+
+// trackAttesterSlashing records the time the attester slashing is inserted in the pool.
+func (*Pool) trackAttesterSlashing(s *PendingAttesterSlashing) {
+	s.inserted = time.Now()
+}
+
+// trackProposerSlashing records the time the proposer slashing of the validator is inserted in the pool.
+// Note: this method requires caller to hold the lock.
+func (p *Pool) trackProposerSlashing(idx primitives.ValidatorIndex) {
+	if p.proposerSlashingInserted == nil {
+		p.proposerSlashingInserted = make(map[primitives.ValidatorIndex]time.Time)
+	}
+	p.proposerSlashingInserted[idx] = time.Now()
+}
@@ -0,0 +1,76 @@
+package slashings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestPool_InsertProposerSlashing_MaxSize(t *testing.T) {
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	slashings := make([]*ethpb.ProposerSlashing, 3)
+	for i := 0; i < len(slashings); i++ {
+		sl, err := util.GenerateProposerSlashingForValidator(beaconState, privKeys[i], primitives.ValidatorIndex(i))
+		require.NoError(t, err)
+		slashings[i] = sl
+	}
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+
+	p := NewPool(WithMaxSize(2))
+	for _, sl := range slashings {
+		require.NoError(t, p.InsertProposerSlashing(context.Background(), beaconState, sl))
+	}
+
+	// The oldest slashing is evicted.
+	pending := p.PendingProposerSlashings(context.Background(), beaconState, true /*noLimit*/)
+	require.Equal(t, 2, len(pending))
+	assert.Equal(t, primitives.ValidatorIndex(1), pending[0].Header_1.Header.ProposerIndex)
+	assert.Equal(t, primitives.ValidatorIndex(2), pending[1].Header_1.Header.ProposerIndex)
+	assert.Equal(t, 2, len(p.proposerSlashingInserted))
+}
+
+func TestPool_InsertAttesterSlashing_MaxSize(t *testing.T) {
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	slashings := make([]*ethpb.AttesterSlashing, 3)
+	for i := 0; i < len(slashings); i++ {
+		slashings[i] = validAttesterSlashingForValIdx(t, beaconState, privKeys, uint64(i))
+	}
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+
+	p := NewPool(WithMaxSize(2))
+	for _, sl := range slashings {
+		require.NoError(t, p.InsertAttesterSlashing(context.Background(), beaconState, sl))
+	}
+
+	// The oldest slashing is evicted.
+	require.Equal(t, 2, len(p.pendingAttesterSlashing))
+	assert.Equal(t, primitives.ValidatorIndex(1), p.pendingAttesterSlashing[0].validatorToSlash)
+	assert.Equal(t, primitives.ValidatorIndex(2), p.pendingAttesterSlashing[1].validatorToSlash)
+}
+
+func TestPool_PendingSlashings_Expiry(t *testing.T) {
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	proposerSlashing, err := util.GenerateProposerSlashingForValidator(beaconState, privKeys[0], 0)
+	require.NoError(t, err)
+	attesterSlashing := validAttesterSlashingForValIdx(t, beaconState, privKeys, 1)
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+
+	p := NewPool(WithExpiry(time.Hour))
+	require.NoError(t, p.InsertProposerSlashing(context.Background(), beaconState, proposerSlashing))
+	require.NoError(t, p.InsertAttesterSlashing(context.Background(), beaconState, attesterSlashing))
+	require.Equal(t, 1, len(p.PendingProposerSlashings(context.Background(), beaconState, true /*noLimit*/)))
+	require.Equal(t, 1, len(p.PendingAttesterSlashings(context.Background(), beaconState, true /*noLimit*/)))
+
+	p.proposerSlashingInserted[0] = p.proposerSlashingInserted[0].Add(-2 * time.Hour)
+	p.pendingAttesterSlashing[0].inserted = p.pendingAttesterSlashing[0].inserted.Add(-2 * time.Hour)
+	assert.Equal(t, 0, len(p.PendingProposerSlashings(context.Background(), beaconState, true /*noLimit*/)))
+	assert.Equal(t, 0, len(p.PendingAttesterSlashings(context.Background(), beaconState, true /*noLimit*/)))
+	assert.Equal(t, 0, len(p.proposerSlashingInserted))
+}
@@ -30,4 +30,11 @@ var (
 			Help: "Number of proposer slashings included in blocks",
 		},
 	)
+	evictedSlashings = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "slashings_pool_evicted_total",
+			Help: "Number of slashings evicted from the pool, by kind and reason",
+		},
+		[]string{"kind", "reason"},
+	)
 )
@@ -19,12 +19,16 @@ import (
 )
 
 // NewPool returns an initialized attester slashing and proposer slashing pool.
-func NewPool() *Pool {
-	return &Pool{
+func NewPool(opts ...Option) *Pool {
+	p := &Pool{
 		pendingProposerSlashing: make([]*ethpb.ProposerSlashing, 0),
 		pendingAttesterSlashing: make([]*PendingAttesterSlashing, 0),
 		included:                make(map[primitives.ValidatorIndex]bool),
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // PendingAttesterSlashings returns attester slashings that are able to be included into a block.
@@ -36,6 +40,8 @@ func (p *Pool) PendingAttesterSlashings(ctx context.Context, state state.ReadOnl
 	_, span := trace.StartSpan(ctx, "operations.PendingAttesterSlashing")
 	defer span.End()
 
+	p.evictExpired()
+
 	// Update prom metric.
 	numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashing)))
 
@@ -86,6 +92,8 @@ func (p *Pool) PendingProposerSlashings(ctx context.Context, state state.ReadOnl
 	_, span := trace.StartSpan(ctx, "operations.PendingProposerSlashing")
 	defer span.End()
 
+	p.evictExpired()
+
 	// Update prom metric.
 	numPendingProposerSlashings.Set(float64(len(p.pendingProposerSlashing)))
 
@@ -106,6 +114,7 @@ func (p *Pool) PendingProposerSlashings(ctx context.Context, state state.ReadOnl
 			continue
 		}
 		if !valid {
+			delete(p.proposerSlashingInserted, slashing.Header_1.Header.ProposerIndex)
 			p.pendingProposerSlashing = append(p.pendingProposerSlashing[:i], p.pendingProposerSlashing[i+1:]...)
 			i--
 			continue
@@ -131,6 +140,7 @@ func (p *Pool) InsertAttesterSlashing(
 	if err := blocks.VerifyAttesterSlashing(ctx, state, slashing); err != nil {
 		return errors.Wrap(err, "could not verify attester slashing")
 	}
+	p.evictExpired()
 
 	slashedVal := slice.IntersectionUint64(slashing.FirstAttestation().GetAttestingIndices(), slashing.SecondAttestation().GetAttestingIndices())
 	cantSlash := make([]uint64, 0, len(slashedVal))
@@ -164,6 +174,8 @@ func (p *Pool) InsertAttesterSlashing(
 			attesterSlashing: slashing,
 			validatorToSlash: primitives.ValidatorIndex(val),
 		}
+		p.makeRoomForAttesterSlashing()
+		p.trackAttesterSlashing(pendingSlashing)
 		// Insert into pending list and sort again.
 		p.pendingAttesterSlashing = append(p.pendingAttesterSlashing, pendingSlashing)
 		sort.Slice(p.pendingAttesterSlashing, func(i, j int) bool {
@@ -196,6 +208,7 @@ func (p *Pool) InsertProposerSlashing(
 	if err := blocks.VerifyProposerSlashing(state, slashing); err != nil {
 		return errors.Wrap(err, "could not verify proposer slashing")
 	}
+	p.evictExpired()
 
 	idx := slashing.Header_1.Header.ProposerIndex
 	ok, err := p.validatorSlashingPreconditionCheck(state, idx)
@@ -219,6 +232,8 @@ func (p *Pool) InsertProposerSlashing(
 		return errors.New("slashing object already exists in pending proposer slashings")
 	}
 
+	p.makeRoomForProposerSlashing()
+	p.trackProposerSlashing(slashing.Header_1.Header.ProposerIndex)
 	// Insert into pending list and sort again.
 	p.pendingProposerSlashing = append(p.pendingProposerSlashing, slashing)
 	sort.Slice(p.pendingProposerSlashing, func(i, j int) bool {
@@ -260,6 +275,7 @@ func (p *Pool) MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing) {
 	if i != len(p.pendingProposerSlashing) && p.pendingProposerSlashing[i].Header_1.Header.ProposerIndex == ps.Header_1.Header.ProposerIndex {
 		p.pendingProposerSlashing = append(p.pendingProposerSlashing[:i], p.pendingProposerSlashing[i+1:]...)
 	}
+	delete(p.proposerSlashingInserted, ps.Header_1.Header.ProposerIndex)
 	p.included[ps.Header_1.Header.ProposerIndex] = true
 	numProposerSlashingsIncluded.Inc()
 }
@@ -3,6 +3,7 @@ package slashings
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -39,6 +40,10 @@ type Pool struct {
 	pendingProposerSlashing []*ethpb.ProposerSlashing
 	pendingAttesterSlashing []*PendingAttesterSlashing
 	included                map[primitives.ValidatorIndex]bool
+	// proposerSlashingInserted is the time each pending proposer slashing was inserted, by proposer index.
+	proposerSlashingInserted map[primitives.ValidatorIndex]time.Time
+	maxSize                  int
+	expiry                   time.Duration
 }
 
 // PendingAttesterSlashing represents an attester slashing in the operation pool.
@@ -46,4 +51,5 @@ type Pool struct {
 type PendingAttesterSlashing struct {
 	attesterSlashing ethpb.AttSlashing
 	validatorToSlash primitives.ValidatorIndex
+	inserted         time.Time
 }
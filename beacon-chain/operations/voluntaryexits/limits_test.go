@@ -0,0 +1,53 @@
+package voluntaryexits
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func exitForValIdx(idx types.ValidatorIndex) *ethpb.SignedVoluntaryExit {
+	return &ethpb.SignedVoluntaryExit{
+		Exit: &ethpb.VoluntaryExit{
+			ValidatorIndex: idx,
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestInsertVoluntaryExit_MaxSize(t *testing.T) {
+	pool := NewPool(WithMaxSize(2))
+	pool.InsertVoluntaryExit(exitForValIdx(0))
+	pool.InsertVoluntaryExit(exitForValIdx(1))
+	pool.InsertVoluntaryExit(exitForValIdx(2))
+
+	// The oldest exit is evicted.
+	exits, err := pool.PendingExits()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(exits))
+	assert.Equal(t, types.ValidatorIndex(1), exits[0].Exit.ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(2), exits[1].Exit.ValidatorIndex)
+	assert.Equal(t, 2, len(pool.m))
+	assert.Equal(t, 2, len(pool.inserted))
+}
+
+func TestInsertVoluntaryExit_Expiry(t *testing.T) {
+	pool := NewPool(WithExpiry(time.Hour))
+	pool.InsertVoluntaryExit(exitForValIdx(0))
+	pool.InsertVoluntaryExit(exitForValIdx(1))
+	pool.inserted[0] = pool.inserted[0].Add(-2 * time.Hour)
+	pool.InsertVoluntaryExit(exitForValIdx(2))
+
+	// The expired exit is evicted.
+	exits, err := pool.PendingExits()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(exits))
+	assert.Equal(t, types.ValidatorIndex(1), exits[0].Exit.ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(2), exits[1].Exit.ValidatorIndex)
+	_, ok := pool.inserted[0]
+	assert.Equal(t, false, ok)
+}
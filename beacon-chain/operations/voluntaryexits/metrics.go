@@ -0,0 +1,11 @@
+package voluntaryexits
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var evictedExits = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "exit_pool_evicted_total",
+	Help: "The number of voluntary exits evicted from the pool, by reason.",
+}, []string{"reason"})
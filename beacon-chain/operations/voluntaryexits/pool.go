@@ -3,6 +3,7 @@ package voluntaryexits
 import (
 	"math"
 	"sync"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -25,17 +26,25 @@ type PoolManager interface {
 
 // Pool is a concrete implementation of PoolManager.
 type Pool struct {
-	lock    sync.RWMutex
-	pending doublylinkedlist.List[*ethpb.SignedVoluntaryExit]
-	m       map[types.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedVoluntaryExit]
+	lock     sync.RWMutex
+	pending  doublylinkedlist.List[*ethpb.SignedVoluntaryExit]
+	m        map[types.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedVoluntaryExit]
+	inserted map[types.ValidatorIndex]time.Time
+	maxSize  int
+	expiry   time.Duration
 }
 
 // NewPool returns an initialized pool.
-func NewPool() *Pool {
-	return &Pool{
-		pending: doublylinkedlist.List[*ethpb.SignedVoluntaryExit]{},
-		m:       make(map[types.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedVoluntaryExit]),
+func NewPool(opts ...Option) *Pool {
+	p := &Pool{
+		pending:  doublylinkedlist.List[*ethpb.SignedVoluntaryExit]{},
+		m:        make(map[types.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedVoluntaryExit]),
+		inserted: make(map[types.ValidatorIndex]time.Time),
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // PendingExits returns all objects from the pool.
@@ -62,6 +71,10 @@ func (p *Pool) PendingExits() ([]*ethpb.SignedVoluntaryExit, error) {
 // ExitsForInclusion returns objects that are ready for inclusion at the given slot. This method will not
 // return more than the block enforced MaxVoluntaryExits.
 func (p *Pool) ExitsForInclusion(state state.ReadOnlyBeaconState, slot types.Slot) ([]*ethpb.SignedVoluntaryExit, error) {
+	p.lock.Lock()
+	p.evictExpired(time.Now())
+	p.lock.Unlock()
+
 	p.lock.RLock()
 	length := int(math.Min(float64(params.BeaconConfig().MaxVoluntaryExits), float64(p.pending.Len())))
 	result := make([]*ethpb.SignedVoluntaryExit, 0, length)
@@ -119,8 +132,17 @@ func (p *Pool) InsertVoluntaryExit(exit *ethpb.SignedVoluntaryExit) {
 		return
 	}
 
+	now := time.Now()
+	p.evictExpired(now)
+	for p.maxSize > 0 && p.pending.Len() >= p.maxSize {
+		if !p.evictOldest(evictedSize) {
+			break
+		}
+	}
+
 	p.pending.Append(doublylinkedlist.NewNode(exit))
 	p.m[exit.Exit.ValidatorIndex] = p.pending.Last()
+	p.inserted[exit.Exit.ValidatorIndex] = now
 }
 
 // MarkIncluded is used when an exit has been included in a beacon block. Every block seen by this
@@ -134,6 +156,13 @@ func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit) {
 		return
 	}
 
-	delete(p.m, exit.Exit.ValidatorIndex)
+	p.remove(exit.Exit.ValidatorIndex, node)
+}
+
+// remove the pending exit of the validator from the pool.
+// Note: this method requires caller to hold the lock.
+func (p *Pool) remove(idx types.ValidatorIndex, node *doublylinkedlist.Node[*ethpb.SignedVoluntaryExit]) {
+	delete(p.m, idx)
+	delete(p.inserted, idx)
 	p.pending.Remove(node)
 }
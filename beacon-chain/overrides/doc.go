@@ -0,0 +1,7 @@
+/*
+Package overrides changes a small set of runtime parameters of the beacon node, such as its
+peer target or log level, without restarting it. Only the parameters registered by the node
+can be changed. The overrides are persisted to a file in the data directory, and applied
+again on the next startup, on top of the values of the flags.
+*/
+package overrides
@@ -0,0 +1,5 @@
+package overrides
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "overrides")
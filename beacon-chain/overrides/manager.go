@@ -0,0 +1,206 @@
+package overrides
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+// FileName of the overrides file in the data directory.
+const FileName = "runtime_overrides.json"
+
+// Names of the parameters the beacon node allows to change at runtime.
+const (
+	PeerTarget      = "peer_target"
+	SubnetStrategy  = "subnet_strategy"
+	LogLevel        = "log_level"
+	BuilderEnabled  = "builder_enabled"
+	BlockBatchLimit = "block_batch_limit"
+	BlobBatchLimit  = "blob_batch_limit"
+)
+
+var (
+	// ErrUnknownParameter is returned for a parameter that can not be changed at runtime.
+	ErrUnknownParameter = errors.New("unknown runtime parameter")
+	// ErrInvalidValue is returned when a parameter rejects its new value.
+	ErrInvalidValue = errors.New("invalid value of runtime parameter")
+)
+
+// Parameter of the node that can be changed at runtime.
+type Parameter struct {
+	Name        string
+	Description string
+	// Get returns the current value of the parameter.
+	Get func() string
+	// Set validates the value and applies it to the node.
+	Set func(value string) error
+}
+
+// Value of a parameter.
+type Value struct {
+	Name        string
+	Description string
+	Value       string
+	// Default is the value the parameter had at startup, before applying the overrides.
+	Default    string
+	Overridden bool
+}
+
+// Manager applies the overrides of the registered parameters and persists them.
+type Manager struct {
+	path      string
+	lock      sync.Mutex
+	params    map[string]*Parameter
+	defaults  map[string]string
+	overrides map[string]string
+}
+
+// New creates a manager persisting the overrides in the data directory.
+func New(dataDir string) *Manager {
+	return &Manager{
+		path:      filepath.Join(dataDir, FileName),
+		params:    make(map[string]*Parameter),
+		defaults:  make(map[string]string),
+		overrides: make(map[string]string),
+	}
+}
+
+// Register allows the parameter to be changed at runtime. Its current value is its default.
+func (m *Manager) Register(p *Parameter) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.params[p.Name] = p
+	m.defaults[p.Name] = p.Get()
+}
+
+// Load applies the overrides persisted by a previous run. Overrides of parameters that are no longer
+// registered, or whose value is no longer valid, are dropped.
+func (m *Manager) Load() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	enc, err := os.ReadFile(m.path) // #nosec G304 -- The path is within the data directory.
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read overrides")
+	}
+	persisted := make(map[string]string)
+	if err := json.Unmarshal(enc, &persisted); err != nil {
+		return errors.Wrap(err, "could not decode overrides")
+	}
+	for _, name := range sortedNames(persisted) {
+		if err := m.apply(name, persisted[name]); err != nil {
+			log.WithError(err).WithField("parameter", name).Warn("Dropping runtime override")
+			continue
+		}
+		log.WithFields(logrus.Fields{"parameter": name, "value": persisted[name]}).Info("Applied runtime override")
+	}
+	if len(m.overrides) != len(persisted) {
+		return m.persist()
+	}
+	return nil
+}
+
+// Values returns the values of all the registered parameters, sorted by name.
+func (m *Manager) Values() []*Value {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	values := make([]*Value, 0, len(m.params))
+	for _, name := range sortedNames(m.params) {
+		p := m.params[name]
+		_, overridden := m.overrides[name]
+		values = append(values, &Value{
+			Name:        name,
+			Description: p.Description,
+			Value:       p.Get(),
+			Default:     m.defaults[name],
+			Overridden:  overridden,
+		})
+	}
+	return values
+}
+
+// Set changes the parameters to the values and persists them. The names are all checked before any
+// parameter is changed. When a value is rejected, the parameters set before it keep their new values.
+func (m *Manager) Set(values map[string]string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for name := range values {
+		if _, ok := m.params[name]; !ok {
+			return errors.Wrap(ErrUnknownParameter, name)
+		}
+	}
+	var applyErr error
+	for _, name := range sortedNames(values) {
+		if applyErr = m.apply(name, values[name]); applyErr != nil {
+			break
+		}
+		log.WithFields(logrus.Fields{"parameter": name, "value": values[name]}).Info("Changed runtime parameter")
+	}
+	if err := m.persist(); err != nil {
+		return err
+	}
+	return applyErr
+}
+
+// Reset restores the default value of the parameter and removes its override.
+func (m *Manager) Reset(name string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	p, ok := m.params[name]
+	if !ok {
+		return errors.Wrap(ErrUnknownParameter, name)
+	}
+	if _, ok := m.overrides[name]; !ok {
+		return nil
+	}
+	if err := p.Set(m.defaults[name]); err != nil {
+		return errors.Wrapf(err, "could not restore default value of %s", name)
+	}
+	delete(m.overrides, name)
+	log.WithFields(logrus.Fields{"parameter": name, "value": m.defaults[name]}).Info("Reset runtime parameter")
+	return m.persist()
+}
+
+func (m *Manager) apply(name, value string) error {
+	p, ok := m.params[name]
+	if !ok {
+		return errors.Wrap(ErrUnknownParameter, name)
+	}
+	if err := p.Set(value); err != nil {
+		return errors.Wrapf(ErrInvalidValue, "%s: %v", name, err)
+	}
+	m.overrides[name] = value
+	return nil
+}
+
+func (m *Manager) persist() error {
+	enc, err := json.MarshalIndent(m.overrides, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode overrides")
+	}
+	if err := file.WriteFile(m.path, enc); err != nil {
+		return errors.Wrap(err, "could not write overrides")
+	}
+	return nil
+}
+
+func sortedNames[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
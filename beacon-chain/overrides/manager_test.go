@@ -0,0 +1,111 @@
+package overrides
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func intParameter(name string, v *int) *Parameter {
+	return &Parameter{
+		Name: name,
+		Get:  func() string { return strconv.Itoa(*v) },
+		Set: func(value string) error {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+			if n <= 0 {
+				return errors.New("must be positive")
+			}
+			*v = n
+			return nil
+		},
+	}
+}
+
+func TestManager_SetAndReset(t *testing.T) {
+	dir := t.TempDir()
+	peers, limit := 70, 64
+	m := New(dir)
+	m.Register(intParameter(PeerTarget, &peers))
+	m.Register(intParameter(BlockBatchLimit, &limit))
+
+	require.NoError(t, m.Set(map[string]string{PeerTarget: "100"}))
+	assert.Equal(t, 100, peers)
+	values := m.Values()
+	require.Equal(t, 2, len(values))
+	assert.Equal(t, BlockBatchLimit, values[0].Name)
+	assert.Equal(t, false, values[0].Overridden)
+	assert.Equal(t, PeerTarget, values[1].Name)
+	assert.Equal(t, "100", values[1].Value)
+	assert.Equal(t, "70", values[1].Default)
+	assert.Equal(t, true, values[1].Overridden)
+
+	enc, err := os.ReadFile(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	assert.StringContains(t, `"peer_target": "100"`, string(enc))
+
+	require.NoError(t, m.Reset(PeerTarget))
+	assert.Equal(t, 70, peers)
+	assert.Equal(t, false, m.Values()[1].Overridden)
+	enc, err = os.ReadFile(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(enc))
+}
+
+func TestManager_SetRejected(t *testing.T) {
+	peers, limit := 70, 64
+	m := New(t.TempDir())
+	m.Register(intParameter(PeerTarget, &peers))
+	m.Register(intParameter(BlockBatchLimit, &limit))
+
+	err := m.Set(map[string]string{"max_peers": "10", PeerTarget: "100"})
+	assert.Equal(t, true, errors.Is(err, ErrUnknownParameter))
+	assert.Equal(t, 70, peers, "no parameter should change when a name is unknown")
+
+	err = m.Set(map[string]string{BlockBatchLimit: "32", PeerTarget: "-1"})
+	assert.Equal(t, true, errors.Is(err, ErrInvalidValue))
+	assert.Equal(t, 32, limit)
+	assert.Equal(t, 70, peers)
+
+	assert.Equal(t, true, errors.Is(m.Reset("max_peers"), ErrUnknownParameter))
+}
+
+func TestManager_Load(t *testing.T) {
+	dir := t.TempDir()
+	peers, limit := 70, 64
+	m := New(dir)
+	m.Register(intParameter(PeerTarget, &peers))
+	m.Register(intParameter(BlockBatchLimit, &limit))
+	require.NoError(t, m.Set(map[string]string{PeerTarget: "100", BlockBatchLimit: "32"}))
+
+	// A new run, which no longer allows the block batch limit to change.
+	peers = 70
+	m = New(dir)
+	m.Register(intParameter(PeerTarget, &peers))
+	require.NoError(t, m.Load())
+	assert.Equal(t, 100, peers)
+	values := m.Values()
+	require.Equal(t, 1, len(values))
+	assert.Equal(t, "70", values[0].Default)
+	assert.Equal(t, true, values[0].Overridden)
+
+	enc, err := os.ReadFile(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	assert.StringNotContains(t, BlockBatchLimit, string(enc))
+}
+
+func TestManager_LoadNoFile(t *testing.T) {
+	peers := 70
+	m := New(t.TempDir())
+	m.Register(intParameter(PeerTarget, &peers))
+	require.NoError(t, m.Load())
+	assert.Equal(t, 70, peers)
+	assert.Equal(t, false, m.Values()[0].Overridden)
+}
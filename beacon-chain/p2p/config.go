@@ -3,6 +3,7 @@ package p2p
 import (
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 )
 
@@ -31,6 +32,9 @@ type Config struct {
 	QueueSize            uint
 	AllowListCIDR        string
 	DenyListCIDR         []string
+	Compression          encoder.CompressionLevel
+	LocalCompression     encoder.CompressionLevel
+	EnableZstd           bool
 	StateNotifier        statefeed.Notifier
 	DB                   db.ReadOnlyDatabase
 	ClockWaiter          startup.ClockWaiter
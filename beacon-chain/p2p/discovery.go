@@ -304,11 +304,12 @@ func (s *Service) listenForNewNodes() {
 			}
 
 			// Compute the number of new peers we want to dial.
-			activePeerCount, missingPeerCount := peersSummary(s.cfg.MaxPeers)
+			maxPeers := s.maxPeers()
+			activePeerCount, missingPeerCount := peersSummary(maxPeers)
 
 			fields := logrus.Fields{
 				"currentPeerCount": activePeerCount,
-				"targetPeerCount":  s.cfg.MaxPeers,
+				"targetPeerCount":  maxPeers,
 			}
 
 			if missingPeerCount == 0 {
@@ -584,7 +585,7 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 // active peers are above our set max peer limit.
 func (s *Service) isPeerAtLimit(inbound bool) bool {
 	numOfConns := len(s.host.Network().Peers())
-	maxPeers := int(s.cfg.MaxPeers)
+	maxPeers := int(s.maxPeers())
 	// If we are measuring the limit for inbound peers
 	// we apply the high watermark buffer.
 	if inbound {
@@ -604,7 +605,7 @@ func (s *Service) isPeerAtLimit(inbound bool) bool {
 // we are connected to satisfies the minimum expected outbound peer count
 // according to our peer limit.
 func (s *Service) isBelowOutboundPeerThreshold() bool {
-	maxPeers := int(s.cfg.MaxPeers)
+	maxPeers := int(s.maxPeers())
 	inBoundLimit := s.Peers().InboundLimit()
 	// Impossible Condition
 	if maxPeers < inBoundLimit {
@@ -806,6 +807,35 @@ func getPort(node *enode.Node, protocol internetProtocol) (uint, bool, error) {
 	return port, true, nil
 }
 
+// AdvertisedPorts are the ports of a node in its ENR record, zero when not advertised.
+type AdvertisedPorts struct {
+	TCP  uint
+	UDP  uint
+	QUIC uint
+}
+
+// ENRAdvertisedPorts returns the TCP, UDP and QUIC ports advertised in the ENR record of the node.
+func ENRAdvertisedPorts(node *enode.Node) (AdvertisedPorts, error) {
+	var ports AdvertisedPorts
+	for protocol, port := range map[internetProtocol]*uint{tcp: &ports.TCP, udp: &ports.UDP, quic: &ports.QUIC} {
+		p, _, err := getPort(node, protocol)
+		if err != nil {
+			return AdvertisedPorts{}, errors.Wrapf(err, "could not get %s port", protocol)
+		}
+		*port = p
+	}
+	return ports, nil
+}
+
+// ENRMultiAddrs returns the multiaddrs, with the peer ID, at which other peers reach the node according to
+// its ENR record. It returns none when the record has no IP.
+func ENRMultiAddrs(node *enode.Node) ([]ma.Multiaddr, error) {
+	if node.IP() == nil {
+		return nil, nil
+	}
+	return retrieveMultiAddrsFromNode(node)
+}
+
 func convertToUdpMultiAddr(node *enode.Node) ([]ma.Multiaddr, error) {
 	pubkey := node.Pubkey()
 	assertedKey, err := ecdsaprysm.ConvertToInterfacePubkey(pubkey)
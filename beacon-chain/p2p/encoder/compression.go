@@ -0,0 +1,208 @@
+package encoder
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/libp2p/go-libp2p/core/network"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/stream"
+)
+
+// CompressionLevel of the req/resp messages written to the peers.
+type CompressionLevel uint8
+
+const (
+	// CompressionDefault compresses the messages with the snappy defaults.
+	CompressionDefault CompressionLevel = iota
+	// CompressionNone writes the messages as uncompressed snappy chunks, which saves CPU at the cost of bandwidth.
+	CompressionNone
+	// CompressionBetter compresses the messages better than the snappy defaults, at the cost of CPU.
+	CompressionBetter
+	// CompressionBest compresses the messages as much as possible, at the cost of even more CPU.
+	CompressionBest
+)
+
+var compressionLevelNames = map[CompressionLevel]string{
+	CompressionDefault: "default",
+	CompressionNone:    "none",
+	CompressionBetter:  "better",
+	CompressionBest:    "best",
+}
+
+// String returns the name of the compression level.
+func (l CompressionLevel) String() string {
+	if name, ok := compressionLevelNames[l]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseCompressionLevel returns the compression level of the given name.
+func ParseCompressionLevel(name string) (CompressionLevel, error) {
+	for l, n := range compressionLevelNames {
+		if strings.EqualFold(n, name) {
+			return l, nil
+		}
+	}
+	return CompressionDefault, errors.Errorf("unknown compression level %q, expected one of default, none, better or best", name)
+}
+
+// BufferedStream buffers the req/resp messages encoded for a stream, so that they can be written to it at once.
+// The messages are encoded as they would be if written to the stream directly.
+type BufferedStream struct {
+	bytes.Buffer
+	// Stream the buffered messages are meant for.
+	Stream network.Stream
+}
+
+// streamOf returns the stream the req/resp messages are written to or read from, or nil if they are not.
+func streamOf(rw interface{}) network.Stream {
+	switch s := rw.(type) {
+	case network.Stream:
+		return s
+	case *BufferedStream:
+		return s.Stream
+	}
+	return nil
+}
+
+// isZstdStream returns true when the zstd extension protocol was negotiated for the stream.
+func isZstdStream(s network.Stream) bool {
+	return s != nil && strings.HasSuffix(string(s.Protocol()), "/"+ProtocolSuffixSSZZstd)
+}
+
+// isLocalStream returns true when the remote peer of the stream is on a loopback or private network.
+func isLocalStream(s network.Stream) bool {
+	if s == nil || s.Conn() == nil {
+		return false
+	}
+	addr := s.Conn().RemoteMultiaddr()
+	if addr == nil {
+		return false
+	}
+	return manet.IsIPLoopback(addr) || manet.IsPrivateAddr(addr)
+}
+
+// The pools of the snappy compatible s2 writers of the compression levels other than the default one.
+var s2WriterPools = map[CompressionLevel]*sync.Pool{
+	CompressionNone:   new(sync.Pool),
+	CompressionBetter: new(sync.Pool),
+	CompressionBest:   new(sync.Pool),
+}
+
+// Instantiates a snappy compatible s2 writer of the compression level using our sync pools.
+func newS2Writer(w io.Writer, level CompressionLevel) *s2.Writer {
+	if bufW, ok := s2WriterPools[level].Get().(*s2.Writer); ok {
+		bufW.Reset(w)
+		return bufW
+	}
+	opts := []s2.WriterOption{s2.WriterSnappyCompat(), s2.WriterConcurrency(1)}
+	switch level {
+	case CompressionNone:
+		opts = append(opts, s2.WriterUncompressed())
+	case CompressionBetter:
+		opts = append(opts, s2.WriterBetterCompression())
+	case CompressionBest:
+		opts = append(opts, s2.WriterBestCompression())
+	}
+	return s2.NewWriter(w, opts...)
+}
+
+// Writes a bytes value through a snappy compatible s2 writer of the compression level.
+func writeS2Buffer(w io.Writer, b []byte, level CompressionLevel) (int, error) {
+	bufWriter := newS2Writer(w, level)
+	defer s2WriterPools[level].Put(bufWriter)
+	num, err := bufWriter.Write(b)
+	if err != nil {
+		// Close buf writer in the event of an error.
+		if err := bufWriter.Close(); err != nil {
+			return 0, err
+		}
+		return 0, err
+	}
+	return num, bufWriter.Close()
+}
+
+// The zstd encoders of the compression levels, zstd has no uncompressed mode so that the fastest level is used
+// when compression is disabled.
+var zstdEncoders = map[CompressionLevel]func() (*zstd.Encoder, error){
+	CompressionDefault: newZstdEncoder(zstd.SpeedDefault),
+	CompressionNone:    newZstdEncoder(zstd.SpeedFastest),
+	CompressionBetter:  newZstdEncoder(zstd.SpeedBetterCompression),
+	CompressionBest:    newZstdEncoder(zstd.SpeedBestCompression),
+}
+
+func newZstdEncoder(level zstd.EncoderLevel) func() (*zstd.Encoder, error) {
+	return sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil, zstd.WithEncoderLevel(level))
+	})
+}
+
+var zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+	return zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecodeAllCapLimit(true))
+})
+
+// Writes a bytes value as a zstd frame prefixed with its varint length, as the frame must be read without reading
+// past its end.
+func writeZstdBuffer(w io.Writer, b []byte, level CompressionLevel) (int, error) {
+	enc, err := zstdEncoders[level]()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not create zstd encoder")
+	}
+	start := time.Now()
+	frame := enc.EncodeAll(b, nil)
+	observeCompression(codecZstd, operationCompress, len(b), len(frame), time.Since(start))
+	if _, err := w.Write(append(proto.EncodeVarint(uint64(len(frame))), frame...)); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Reads a zstd frame prefixed with its varint length, which must decompress to exactly msgLen bytes.
+func readZstdBuffer(r io.Reader, msgLen uint64) ([]byte, error) {
+	frameLen, err := readVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if frameLen > zstdMaxLength(msgLen) {
+		return nil, errors.Errorf("zstd frame of %d bytes goes over the max length of %d", frameLen, zstdMaxLength(msgLen))
+	}
+	frame, err := stream.ReadFull(r, frameLen)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := zstdDecoder()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create zstd decoder")
+	}
+	start := time.Now()
+	// The capacity of the destination limits the decompressed size.
+	buf, err := dec.DecodeAll(frame, make([]byte, 0, msgLen))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress zstd frame")
+	}
+	observeCompression(codecZstd, operationDecompress, len(buf), len(frame), time.Since(start))
+	if uint64(len(buf)) != msgLen {
+		return nil, errors.Errorf("zstd frame decompressed to %d bytes instead of %d", len(buf), msgLen)
+	}
+	return buf, nil
+}
+
+// zstdMaxLength is the maximum length of a zstd frame of the given uncompressed length.
+func zstdMaxLength(length uint64) uint64 {
+	const blockSize = 128 << 10
+	maxLen := length + length>>8
+	if length < blockSize {
+		maxLen += (blockSize - length) >> 11
+	}
+	// Frame header and checksum.
+	return maxLen + 32
+}
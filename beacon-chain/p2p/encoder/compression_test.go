@@ -0,0 +1,113 @@
+package encoder_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestParseCompressionLevel(t *testing.T) {
+	for _, l := range []encoder.CompressionLevel{encoder.CompressionDefault, encoder.CompressionNone, encoder.CompressionBetter, encoder.CompressionBest} {
+		parsed, err := encoder.ParseCompressionLevel(l.String())
+		require.NoError(t, err)
+		assert.Equal(t, l, parsed)
+	}
+	parsed, err := encoder.ParseCompressionLevel("NONE")
+	require.NoError(t, err)
+	assert.Equal(t, encoder.CompressionNone, parsed)
+	_, err = encoder.ParseCompressionLevel("fastest")
+	require.ErrorContains(t, "unknown compression level", err)
+}
+
+func TestSszNetworkEncoder_CompressionLevels(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	msg := st.ToProtoUnsafe().(*ethpb.BeaconState)
+	sizes := make(map[encoder.CompressionLevel]int)
+	for _, l := range []encoder.CompressionLevel{encoder.CompressionDefault, encoder.CompressionNone, encoder.CompressionBetter, encoder.CompressionBest} {
+		t.Run(l.String(), func(t *testing.T) {
+			e := &encoder.SszNetworkEncoder{Compression: l}
+			buf := new(bytes.Buffer)
+			_, err := e.EncodeWithMaxLength(buf, msg)
+			require.NoError(t, err)
+			sizes[l] = buf.Len()
+
+			// The messages are readable by any snappy decoder.
+			decoded := &ethpb.BeaconState{}
+			require.NoError(t, (&encoder.SszNetworkEncoder{}).DecodeWithMaxLength(buf, decoded))
+			assertProtoMessagesEqual(t, msg, decoded)
+		})
+	}
+	assert.Equal(t, true, sizes[encoder.CompressionNone] > sizes[encoder.CompressionDefault], "uncompressed message is not larger")
+	assert.Equal(t, true, sizes[encoder.CompressionBest] <= sizes[encoder.CompressionDefault], "best compressed message is larger")
+}
+
+func TestSszNetworkEncoder_Zstd(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	msg := st.ToProtoUnsafe().(*ethpb.BeaconState)
+	e := &encoder.SszNetworkEncoder{Zstd: true}
+	assert.DeepEqual(t, []string{"/" + encoder.ProtocolSuffixSSZZstd, "/" + encoder.ProtocolSuffixSSZSnappy}, e.ReqRespProtocolSuffixes())
+	assert.DeepEqual(t, []string{"/" + encoder.ProtocolSuffixSSZSnappy}, (&encoder.SszNetworkEncoder{}).ReqRespProtocolSuffixes())
+
+	stream := &bufferStream{protocol: "/eth2/beacon_chain/req/status/1/" + encoder.ProtocolSuffixSSZZstd}
+	_, err := e.EncodeWithMaxLength(stream, msg)
+	require.NoError(t, err)
+	// A second message follows the first one on the stream.
+	fork := &ethpb.Fork{PreviousVersion: []byte("fooo"), CurrentVersion: []byte("barr"), Epoch: 9001}
+	_, err = e.EncodeWithMaxLength(stream, fork)
+	require.NoError(t, err)
+
+	// The zstd message is not snappy framed.
+	require.NotNil(t, (&encoder.SszNetworkEncoder{}).DecodeWithMaxLength(bytes.NewReader(stream.Bytes()), &ethpb.BeaconState{}))
+
+	decoded := &ethpb.BeaconState{}
+	require.NoError(t, e.DecodeWithMaxLength(stream, decoded))
+	assertProtoMessagesEqual(t, msg, decoded)
+	decodedFork := &ethpb.Fork{}
+	require.NoError(t, e.DecodeWithMaxLength(stream, decodedFork))
+	assertProtoMessagesEqual(t, fork, decodedFork)
+
+	// Messages buffered for the stream are compressed as if written to it.
+	buffered := &encoder.BufferedStream{Stream: stream}
+	_, err = e.EncodeWithMaxLength(buffered, fork)
+	require.NoError(t, err)
+	_, err = stream.Write(buffered.Bytes())
+	require.NoError(t, err)
+	decodedFork = &ethpb.Fork{}
+	require.NoError(t, e.DecodeWithMaxLength(stream, decodedFork))
+	assertProtoMessagesEqual(t, fork, decodedFork)
+}
+
+// bufferStream is a stream of the given protocol, which reads what was written to it.
+type bufferStream struct {
+	network.Stream
+	bytes.Buffer
+	protocol protocol.ID
+}
+
+func (s *bufferStream) Read(p []byte) (int, error) {
+	return s.Buffer.Read(p)
+}
+
+func (s *bufferStream) Write(p []byte) (int, error) {
+	return s.Buffer.Write(p)
+}
+
+func (s *bufferStream) Reset() error {
+	s.Buffer.Reset()
+	return nil
+}
+
+func (s *bufferStream) Protocol() protocol.ID {
+	return s.protocol
+}
+
+func (*bufferStream) Conn() network.Conn {
+	return nil
+}
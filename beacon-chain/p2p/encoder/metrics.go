@@ -0,0 +1,75 @@
+package encoder
+
+import (
+	"io"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	codecSnappy = "snappy"
+	codecZstd   = "zstd"
+
+	operationCompress   = "compress"
+	operationDecompress = "decompress"
+)
+
+var (
+	compressionRatio = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "p2p_req_resp_compression_ratio",
+			Help:    "The ratio of the uncompressed to the compressed size of the req/resp messages.",
+			Buckets: []float64{1, 1.25, 1.5, 2, 2.5, 3, 4, 6, 8, 16},
+		},
+		[]string{"codec", "operation"},
+	)
+	compressionSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "p2p_req_resp_compression_seconds",
+			Help:    "The time spent compressing and decompressing the req/resp messages, not counting the network I/O.",
+			Buckets: prometheus.ExponentialBuckets(0.00001, 4, 10),
+		},
+		[]string{"codec", "operation"},
+	)
+)
+
+func observeCompression(codec, operation string, uncompressed, compressed int, elapsed time.Duration) {
+	if compressed > 0 {
+		compressionRatio.WithLabelValues(codec, operation).Observe(float64(uncompressed) / float64(compressed))
+	}
+	compressionSeconds.WithLabelValues(codec, operation).Observe(elapsed.Seconds())
+}
+
+// meteredWriter counts the bytes written to the underlying writer and the time spent waiting on it, so that the
+// time spent compressing can be told apart from the time spent writing to the network.
+type meteredWriter struct {
+	w    io.Writer
+	n    int
+	wait time.Duration
+}
+
+func (m *meteredWriter) Write(p []byte) (int, error) {
+	start := time.Now()
+	n, err := m.w.Write(p)
+	m.wait += time.Since(start)
+	m.n += n
+	return n, err
+}
+
+// meteredReader counts the bytes read from the underlying reader and the time spent waiting on it, so that the
+// time spent decompressing can be told apart from the time spent reading from the network.
+type meteredReader struct {
+	r    io.Reader
+	n    int
+	wait time.Duration
+}
+
+func (m *meteredReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	n, err := m.r.Read(p)
+	m.wait += time.Since(start)
+	m.n += n
+	return n, err
+}
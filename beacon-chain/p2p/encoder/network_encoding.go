@@ -20,4 +20,7 @@ type NetworkEncoding interface {
 	EncodeWithMaxLength(io.Writer, ssz.Marshaler) (int, error)
 	// ProtocolSuffix returns the last part of the protocol ID to indicate the encoding scheme.
 	ProtocolSuffix() string
+	// ReqRespProtocolSuffixes returns the suffixes of the req/resp protocol IDs supported by the encoding, by order
+	// of preference. The encoding of the messages depends on the protocol negotiated for the stream.
+	ReqRespProtocolSuffixes() []string
 }
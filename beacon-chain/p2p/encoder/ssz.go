@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
 	"github.com/pkg/errors"
 	fastssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/stream"
 	"github.com/prysmaticlabs/prysm/v5/math"
 )
 
@@ -29,11 +31,24 @@ var bufReaderPool = new(sync.Pool)
 
 // SszNetworkEncoder supports p2p networking encoding using SimpleSerialize
 // with snappy compression (if enabled).
-type SszNetworkEncoder struct{}
+type SszNetworkEncoder struct {
+	// Compression of the req/resp messages written to the peers.
+	Compression CompressionLevel
+	// LocalCompression of the req/resp messages written to the peers on loopback or private networks.
+	LocalCompression CompressionLevel
+	// Zstd enables the experimental zstd compression of the req/resp messages, with the peers supporting the
+	// ssz_zstd extension protocol of Prysm.
+	Zstd bool
+}
 
 // ProtocolSuffixSSZSnappy is the last part of the topic string to identify the encoding protocol.
 const ProtocolSuffixSSZSnappy = "ssz_snappy"
 
+// ProtocolSuffixSSZZstd is the last part of the req/resp protocol IDs of the Prysm extension protocol, which
+// compresses the messages with zstd instead of snappy. Each message is written as a zstd frame prefixed with the
+// varint length of the frame, after the varint length of the uncompressed message.
+const ProtocolSuffixSSZZstd = "ssz_zstd"
+
 // EncodeGossip the proto gossip message to the io.Writer.
 func (_ SszNetworkEncoder) EncodeGossip(w io.Writer, msg fastssz.Marshaler) (int, error) {
 	if msg == nil {
@@ -52,7 +67,7 @@ func (_ SszNetworkEncoder) EncodeGossip(w io.Writer, msg fastssz.Marshaler) (int
 
 // EncodeWithMaxLength the proto message to the io.Writer. This encoding prefixes the byte slice with a protobuf varint
 // to indicate the size of the message. This checks that the encoded message isn't larger than the provided max limit.
-func (_ SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg fastssz.Marshaler) (int, error) {
+func (e SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg fastssz.Marshaler) (int, error) {
 	if msg == nil {
 		return 0, nil
 	}
@@ -72,7 +87,27 @@ func (_ SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg fastssz.Marshale
 	if err != nil {
 		return 0, err
 	}
-	return writeSnappyBuffer(w, b)
+	stream := streamOf(w)
+	level := e.Compression
+	if isLocalStream(stream) {
+		level = e.LocalCompression
+	}
+	if isZstdStream(stream) {
+		return writeZstdBuffer(w, b, level)
+	}
+	mw := &meteredWriter{w: w}
+	start := time.Now()
+	var num int
+	if level == CompressionDefault {
+		num, err = writeSnappyBuffer(mw, b)
+	} else {
+		num, err = writeS2Buffer(mw, b, level)
+	}
+	if err != nil {
+		return 0, err
+	}
+	observeCompression(codecSnappy, operationCompress, len(b), mw.n, time.Since(start)-mw.wait)
+	return num, nil
 }
 
 func doDecode(b []byte, to fastssz.Unmarshaler) error {
@@ -118,22 +153,31 @@ func (e SszNetworkEncoder) DecodeWithMaxLength(r io.Reader, to fastssz.Unmarshal
 			MaxChunkSize,
 		)
 	}
+	if isZstdStream(streamOf(r)) {
+		buf, err := readZstdBuffer(r, msgLen)
+		if err != nil {
+			return err
+		}
+		return doDecode(buf, to)
+	}
 	msgMax, err := e.MaxLength(msgLen)
 	if err != nil {
 		return err
 	}
-	limitedRdr := io.LimitReader(r, int64(msgMax))
-	r = newBufferedReader(limitedRdr)
+	mr := &meteredReader{r: io.LimitReader(r, int64(msgMax))}
+	r = newBufferedReader(mr)
 	defer bufReaderPool.Put(r)
 
-	buf := make([]byte, msgLen)
+	start := time.Now()
 	// Returns an error if less than msgLen bytes
 	// are read. This ensures we read exactly the
-	// required amount.
-	_, err = io.ReadFull(r, buf)
+	// required amount, without allocating it before
+	// the bytes are received.
+	buf, err := stream.ReadFull(r, msgLen)
 	if err != nil {
 		return err
 	}
+	observeCompression(codecSnappy, operationDecompress, len(buf), mr.n, time.Since(start)-mr.wait)
 	return doDecode(buf, to)
 }
 
@@ -142,6 +186,15 @@ func (_ SszNetworkEncoder) ProtocolSuffix() string {
 	return "/" + ProtocolSuffixSSZSnappy
 }
 
+// ReqRespProtocolSuffixes returns the suffixes of the req/resp protocol IDs supported by the encoder, by order of
+// preference.
+func (e SszNetworkEncoder) ReqRespProtocolSuffixes() []string {
+	if e.Zstd {
+		return []string{"/" + ProtocolSuffixSSZZstd, e.ProtocolSuffix()}
+	}
+	return []string{e.ProtocolSuffix()}
+}
+
 // MaxLength specifies the maximum possible length of an encoded
 // chunk of data.
 func (_ SszNetworkEncoder) MaxLength(length uint64) (int, error) {
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	gogo "github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
 	"github.com/google/go-cmp/cmp"
 	fastssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
@@ -652,6 +653,26 @@ func TestSszNetworkEncoder_DecodeWithMultipleFrames(t *testing.T) {
 	err = e.DecodeWithMaxLength(buf, decoded)
 	assert.NoError(t, err)
 }
+func TestSszNetworkEncoder_DecodeWithMaxLength_Truncated(t *testing.T) {
+	defer func(maxChunkSize uint64) {
+		encoder.MaxChunkSize = maxChunkSize
+	}(encoder.MaxChunkSize)
+	encoder.MaxChunkSize = 1 << 22
+
+	// The message declares the max chunk size but only a few bytes are sent.
+	buf := new(bytes.Buffer)
+	_, err := buf.Write(gogo.EncodeVarint(encoder.MaxChunkSize))
+	require.NoError(t, err)
+	w := snappy.NewBufferedWriter(buf)
+	_, err = w.Write([]byte("foobar"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	e := &encoder.SszNetworkEncoder{}
+	err = e.DecodeWithMaxLength(buf, new(ethpb.Fork))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
 func TestSszNetworkEncoder_NegativeMaxLength(t *testing.T) {
 	e := &encoder.SszNetworkEncoder{}
 	length, err := e.MaxLength(0xfffffffffff)
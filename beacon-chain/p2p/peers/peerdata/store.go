@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
@@ -40,6 +41,7 @@ type Store struct {
 	sync.RWMutex
 	ctx          context.Context
 	config       *StoreConfig
+	maxPeers     atomic.Int64
 	peers        map[peer.ID]*PeerData
 	trustedPeers map[peer.ID]bool
 }
@@ -69,12 +71,14 @@ type PeerData struct {
 
 // NewStore creates new peer data store.
 func NewStore(ctx context.Context, config *StoreConfig) *Store {
-	return &Store{
+	s := &Store{
 		ctx:          ctx,
 		config:       config,
 		peers:        make(map[peer.ID]*PeerData),
 		trustedPeers: make(map[peer.ID]bool),
 	}
+	s.maxPeers.Store(int64(config.MaxPeers))
+	return s
 }
 
 // PeerData returns data associated with a given peer, if any.
@@ -149,3 +153,14 @@ func (s *Store) IsTrustedPeer(p peer.ID) bool {
 func (s *Store) Config() *StoreConfig {
 	return s.config
 }
+
+// MaxPeers returns the maximum number of peers the store keeps. It starts at the configured value and
+// may be changed at runtime with SetMaxPeers.
+func (s *Store) MaxPeers() int {
+	return int(s.maxPeers.Load())
+}
+
+// SetMaxPeers changes the maximum number of peers the store keeps.
+func (s *Store) SetMaxPeers(maxPeers int) {
+	s.maxPeers.Store(int64(maxPeers))
+}
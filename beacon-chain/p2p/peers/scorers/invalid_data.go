@@ -0,0 +1,236 @@
+package scorers
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+var _ Scorer = (*InvalidDataScorer)(nil)
+
+const (
+	// DefaultInvalidDataBanDuration defines how long a peer is banned for its first offense.
+	// The ban duration doubles with each offense of the peer.
+	DefaultInvalidDataBanDuration = 10 * time.Minute
+	// DefaultInvalidDataMaxBanDuration defines the maximum duration of a ban.
+	DefaultInvalidDataMaxBanDuration = 24 * time.Hour
+	// DefaultInvalidDataDecayInterval defines how often to decay offenses of peers which are not banned anymore.
+	// Every interval their offense counter will be decremented by 1.
+	DefaultInvalidDataDecayInterval = 6 * time.Hour
+)
+
+// InvalidDataScorer bans the peers which served blocks or blobs failing verification, for longer each time
+// they do so again. The offense records outlive the peer data of the store, and are persisted so that repeat
+// offenders are remembered across restarts.
+type InvalidDataScorer struct {
+	config *InvalidDataScorerConfig
+	store  *peerdata.Store
+	// records are protected by the lock of the store.
+	records map[peer.ID]*OffenseRecord
+}
+
+// InvalidDataScorerConfig holds configuration parameters for the invalid data scoring service.
+type InvalidDataScorerConfig struct {
+	// BanDuration specifies how long a peer is banned for its first offense.
+	BanDuration time.Duration
+	// MaxBanDuration specifies the maximum duration of a ban.
+	MaxBanDuration time.Duration
+	// DecayInterval specifies how often offenses should be decayed.
+	DecayInterval time.Duration
+	// RecordsPath specifies the file the offense records are persisted to, they are not persisted when empty.
+	RecordsPath string
+}
+
+// OffenseRecord holds the offenses of a peer which served invalid data.
+type OffenseRecord struct {
+	Offenses    int       `json:"offenses"`
+	LastOffense time.Time `json:"last_offense"`
+	BannedUntil time.Time `json:"banned_until"`
+}
+
+// newInvalidDataScorer creates new invalid data scoring service.
+func newInvalidDataScorer(store *peerdata.Store, config *InvalidDataScorerConfig) *InvalidDataScorer {
+	if config == nil {
+		config = &InvalidDataScorerConfig{}
+	}
+	scorer := &InvalidDataScorer{
+		config:  config,
+		store:   store,
+		records: make(map[peer.ID]*OffenseRecord),
+	}
+	if scorer.config.BanDuration == 0 {
+		scorer.config.BanDuration = DefaultInvalidDataBanDuration
+	}
+	if scorer.config.MaxBanDuration == 0 {
+		scorer.config.MaxBanDuration = DefaultInvalidDataMaxBanDuration
+	}
+	if scorer.config.DecayInterval == 0 {
+		scorer.config.DecayInterval = DefaultInvalidDataDecayInterval
+	}
+	return scorer
+}
+
+// Score returns score (penalty) of the peer, which is the bad peer score while the peer is banned.
+func (s *InvalidDataScorer) Score(pid peer.ID) float64 {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.scoreNoLock(pid)
+}
+
+// scoreNoLock is a lock-free version of Score.
+func (s *InvalidDataScorer) scoreNoLock(pid peer.ID) float64 {
+	if s.isBadPeerNoLock(pid) != nil {
+		return BadPeerScore
+	}
+	return 0
+}
+
+// Params exposes scorer's parameters.
+func (s *InvalidDataScorer) Params() *InvalidDataScorerConfig {
+	return s.config
+}
+
+// Ban records an offense of the peer, and bans it for a duration doubling with each of its offenses. The ban
+// applies even if the records could not be persisted, in which case an error is returned.
+func (s *InvalidDataScorer) Ban(pid peer.ID) (time.Duration, error) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	record, ok := s.records[pid]
+	if !ok {
+		record = &OffenseRecord{}
+		s.records[pid] = record
+	}
+	record.Offenses++
+	duration := s.config.BanDuration
+	for i := 1; i < record.Offenses && duration < s.config.MaxBanDuration; i++ {
+		duration *= 2
+	}
+	duration = min(duration, s.config.MaxBanDuration)
+	record.LastOffense = time.Now()
+	record.BannedUntil = record.LastOffense.Add(duration)
+	return duration, s.persistNoLock()
+}
+
+// Record returns a copy of the offense record of the peer, if it has any.
+func (s *InvalidDataScorer) Record(pid peer.ID) (OffenseRecord, bool) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+
+	record, ok := s.records[pid]
+	if !ok {
+		return OffenseRecord{}, false
+	}
+	return *record, true
+}
+
+// IsBadPeer states if the peer is currently banned.
+func (s *InvalidDataScorer) IsBadPeer(pid peer.ID) error {
+	s.store.RLock()
+	defer s.store.RUnlock()
+
+	return s.isBadPeerNoLock(pid)
+}
+
+// isBadPeerNoLock is lock-free version of IsBadPeer.
+func (s *InvalidDataScorer) isBadPeerNoLock(pid peer.ID) error {
+	record, ok := s.records[pid]
+	if !ok || !time.Now().Before(record.BannedUntil) {
+		return nil
+	}
+	return errors.Errorf("peer is banned until %s after serving invalid data %d times",
+		record.BannedUntil.Format(time.RFC3339), record.Offenses)
+}
+
+// BadPeers returns the peers that are currently banned.
+func (s *InvalidDataScorer) BadPeers() []peer.ID {
+	s.store.RLock()
+	defer s.store.RUnlock()
+
+	badPeers := make([]peer.ID, 0)
+	for pid := range s.records {
+		if s.isBadPeerNoLock(pid) != nil {
+			badPeers = append(badPeers, pid)
+		}
+	}
+	return badPeers
+}
+
+// Decay reduces the offenses of the peers which are not banned anymore, so that reformed peers are eventually
+// forgotten. The records of the peers without offenses are removed.
+func (s *InvalidDataScorer) Decay() error {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	now := time.Now()
+	changed := false
+	for pid, record := range s.records {
+		if now.Before(record.BannedUntil) {
+			continue
+		}
+		record.Offenses--
+		if record.Offenses <= 0 {
+			delete(s.records, pid)
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return s.persistNoLock()
+}
+
+// LoadRecords loads the offense records persisted to the records path, replacing the current ones.
+func (s *InvalidDataScorer) LoadRecords() error {
+	if s.config.RecordsPath == "" {
+		return nil
+	}
+	enc, err := os.ReadFile(s.config.RecordsPath) // #nosec G304 -- The path is within the data directory.
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read offense records")
+	}
+	persisted := make(map[string]*OffenseRecord)
+	if err := json.Unmarshal(enc, &persisted); err != nil {
+		return errors.Wrap(err, "could not decode offense records")
+	}
+	records := make(map[peer.ID]*OffenseRecord, len(persisted))
+	for id, record := range persisted {
+		pid, err := peer.Decode(id)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode peer id %s", id)
+		}
+		records[pid] = record
+	}
+
+	s.store.Lock()
+	defer s.store.Unlock()
+	s.records = records
+	return nil
+}
+
+// persistNoLock writes the offense records to the records path, if any.
+func (s *InvalidDataScorer) persistNoLock() error {
+	if s.config.RecordsPath == "" {
+		return nil
+	}
+	persisted := make(map[string]*OffenseRecord, len(s.records))
+	for pid, record := range s.records {
+		persisted[pid.String()] = record
+	}
+	enc, err := json.MarshalIndent(persisted, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode offense records")
+	}
+	if err := file.WriteFile(s.config.RecordsPath, enc); err != nil {
+		return errors.Wrap(err, "could not write offense records")
+	}
+	return nil
+}
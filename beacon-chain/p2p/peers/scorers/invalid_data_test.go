@@ -0,0 +1,129 @@
+package scorers_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestScorers_InvalidData_Ban(t *testing.T) {
+	const pid = "peer1"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			InvalidDataScorerConfig: &scorers.InvalidDataScorerConfig{
+				BanDuration:    10 * time.Minute,
+				MaxBanDuration: 30 * time.Minute,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().InvalidDataScorer()
+
+	assert.NoError(t, scorer.IsBadPeer(pid))
+	assert.Equal(t, 0., scorer.Score(pid))
+
+	// The ban doubles with each offense, up to the max ban duration.
+	for _, want := range []time.Duration{10 * time.Minute, 20 * time.Minute, 30 * time.Minute, 30 * time.Minute} {
+		duration, err := scorer.Ban(pid)
+		require.NoError(t, err)
+		assert.Equal(t, want, duration)
+	}
+	assert.ErrorContains(t, "serving invalid data 4 times", scorer.IsBadPeer(pid))
+	assert.Equal(t, scorers.BadPeerScore, scorer.Score(pid))
+	assert.DeepEqual(t, []peer.ID{pid}, scorer.BadPeers())
+	assert.NotNil(t, peerStatuses.Scorers().IsBadPeer(pid))
+
+	// Offenses of banned peers do not decay.
+	require.NoError(t, scorer.Decay())
+	record, ok := scorer.Record(pid)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 4, record.Offenses)
+}
+
+func TestScorers_InvalidData_Decay(t *testing.T) {
+	const pid = "peer1"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			InvalidDataScorerConfig: &scorers.InvalidDataScorerConfig{
+				BanDuration: time.Nanosecond,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().InvalidDataScorer()
+
+	_, err := scorer.Ban(pid)
+	require.NoError(t, err)
+	_, err = scorer.Ban(pid)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+
+	// The peer is not banned anymore, but its offenses are remembered until they decay.
+	assert.NoError(t, scorer.IsBadPeer(pid))
+	assert.Equal(t, 0, len(scorer.BadPeers()))
+	record, ok := scorer.Record(pid)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 2, record.Offenses)
+
+	require.NoError(t, scorer.Decay())
+	record, ok = scorer.Record(pid)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 1, record.Offenses)
+
+	require.NoError(t, scorer.Decay())
+	_, ok = scorer.Record(pid)
+	assert.Equal(t, false, ok)
+}
+
+func TestScorers_InvalidData_Persistence(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pid, err := peer.Decode("QmcgpsyWgH8Y8ajJz1Cu72KnS5uo2Aa2LpzU7kinSupNKC")
+	require.NoError(t, err)
+	config := func(path string) *peers.StatusConfig {
+		return &peers.StatusConfig{
+			PeerLimit: 30,
+			ScorerParams: &scorers.Config{
+				InvalidDataScorerConfig: &scorers.InvalidDataScorerConfig{RecordsPath: path},
+			},
+		}
+	}
+	path := filepath.Join(t.TempDir(), "peer_offenses.json")
+
+	scorer := peers.NewStatus(ctx, config(path)).Scorers().InvalidDataScorer()
+	// Nothing to load yet.
+	require.NoError(t, scorer.LoadRecords())
+	_, err = scorer.Ban(pid)
+	require.NoError(t, err)
+	duration, err := scorer.Ban(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 2*scorers.DefaultInvalidDataBanDuration, duration)
+
+	// The repeat offender is remembered after a restart.
+	restarted := peers.NewStatus(ctx, config(path)).Scorers().InvalidDataScorer()
+	assert.NoError(t, restarted.IsBadPeer(pid))
+	require.NoError(t, restarted.LoadRecords())
+	assert.NotNil(t, restarted.IsBadPeer(pid))
+	record, ok := restarted.Record(pid)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 2, record.Offenses)
+	duration, err = restarted.Ban(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 4*scorers.DefaultInvalidDataBanDuration, duration)
+}
@@ -0,0 +1,5 @@
+package scorers
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "peers")
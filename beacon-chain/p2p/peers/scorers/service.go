@@ -37,6 +37,7 @@ type Service struct {
 		blockProviderScorer *BlockProviderScorer
 		peerStatusScorer    *PeerStatusScorer
 		gossipScorer        *GossipScorer
+		invalidDataScorer   *InvalidDataScorer
 	}
 	weights     map[Scorer]float64
 	totalWeight float64
@@ -48,6 +49,7 @@ type Config struct {
 	BlockProviderScorerConfig *BlockProviderScorerConfig
 	PeerStatusScorerConfig    *PeerStatusScorerConfig
 	GossipScorerConfig        *GossipScorerConfig
+	InvalidDataScorerConfig   *InvalidDataScorerConfig
 }
 
 // NewService provides fully initialized peer scoring service.
@@ -66,6 +68,8 @@ func NewService(ctx context.Context, store *peerdata.Store, config *Config) *Ser
 	s.setScorerWeight(s.scorers.peerStatusScorer, 0.3)
 	s.scorers.gossipScorer = newGossipScorer(store, config.GossipScorerConfig)
 	s.setScorerWeight(s.scorers.gossipScorer, 0.4)
+	s.scorers.invalidDataScorer = newInvalidDataScorer(store, config.InvalidDataScorerConfig)
+	s.setScorerWeight(s.scorers.invalidDataScorer, 0.0)
 
 	// Start background tasks.
 	go s.loop(ctx)
@@ -93,6 +97,11 @@ func (s *Service) GossipScorer() *GossipScorer {
 	return s.scorers.gossipScorer
 }
 
+// InvalidDataScorer exposes the scoring service banning the peers serving invalid data.
+func (s *Service) InvalidDataScorer() *InvalidDataScorer {
+	return s.scorers.invalidDataScorer
+}
+
 // ActiveScorersCount returns number of scorers that can affect score (have non-zero weight).
 func (s *Service) ActiveScorersCount() int {
 	cnt := 0
@@ -141,6 +150,10 @@ func (s *Service) IsBadPeerNoLock(pid peer.ID) error {
 		return errors.Wrap(err, "peer status scorer")
 	}
 
+	if err := s.scorers.invalidDataScorer.isBadPeerNoLock(pid); err != nil {
+		return errors.Wrap(err, "invalid data scorer")
+	}
+
 	if features.Get().EnablePeerScorer {
 		if err := s.scorers.gossipScorer.isBadPeerNoLock(pid); err != nil {
 			return errors.Wrap(err, "gossip scorer")
@@ -183,6 +196,8 @@ func (s *Service) loop(ctx context.Context) {
 	defer decayBadResponsesStats.Stop()
 	decayBlockProviderStats := time.NewTicker(s.scorers.blockProviderScorer.Params().DecayInterval)
 	defer decayBlockProviderStats.Stop()
+	decayInvalidDataStats := time.NewTicker(s.scorers.invalidDataScorer.Params().DecayInterval)
+	defer decayInvalidDataStats.Stop()
 
 	for {
 		select {
@@ -198,6 +213,14 @@ func (s *Service) loop(ctx context.Context) {
 				return
 			}
 			s.scorers.blockProviderScorer.Decay()
+		case <-decayInvalidDataStats.C:
+			// Exit early if context is canceled.
+			if ctx.Err() != nil {
+				return
+			}
+			if err := s.scorers.invalidDataScorer.Decay(); err != nil {
+				log.WithError(err).Error("Could not persist offense records of peers serving invalid data")
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -134,7 +134,12 @@ func (p *Status) Scorers() *scorers.Service {
 
 // MaxPeerLimit returns the max peer limit stored in the current peer store.
 func (p *Status) MaxPeerLimit() int {
-	return p.store.Config().MaxPeers
+	return p.store.MaxPeers()
+}
+
+// SetPeerLimit changes the maximum amount of concurrent peers that are expected to be connected to the node.
+func (p *Status) SetPeerLimit(limit int) {
+	p.store.SetMaxPeers(maxLimitBuffer + limit)
 }
 
 // Add adds a peer.
@@ -608,7 +613,7 @@ func (p *Status) Prune() {
 		return
 	}
 	// Exit early if there is nothing to prune.
-	if len(p.store.Peers()) <= p.store.Config().MaxPeers {
+	if len(p.store.Peers()) <= p.store.MaxPeers() {
 		return
 	}
 	notBadPeer := func(pid peer.ID) bool {
@@ -641,7 +646,7 @@ func (p *Status) Prune() {
 		return peersToPrune[i].score > peersToPrune[j].score
 	})
 
-	limitDiff := len(p.store.Peers()) - p.store.Config().MaxPeers
+	limitDiff := len(p.store.Peers()) - p.store.MaxPeers()
 	if limitDiff > len(peersToPrune) {
 		limitDiff = len(peersToPrune)
 	}
@@ -659,7 +664,7 @@ func (p *Status) Prune() {
 // bad response counts.
 func (p *Status) deprecatedPrune() {
 	// Exit early if there is nothing to prune.
-	if len(p.store.Peers()) <= p.store.Config().MaxPeers {
+	if len(p.store.Peers()) <= p.store.MaxPeers() {
 		return
 	}
 
@@ -693,7 +698,7 @@ func (p *Status) deprecatedPrune() {
 		return peersToPrune[i].badResp < peersToPrune[j].badResp
 	})
 
-	limitDiff := len(p.store.Peers()) - p.store.Config().MaxPeers
+	limitDiff := len(p.store.Peers()) - p.store.MaxPeers()
 	if limitDiff > len(peersToPrune) {
 		limitDiff = len(peersToPrune)
 	}
@@ -36,7 +36,13 @@ func (s *Service) Send(ctx context.Context, message interface{}, baseTopic strin
 	ctx, cancel := context.WithTimeout(ctx, maxDialTimeout)
 	defer cancel()
 
-	stream, err := s.host.NewStream(ctx, pid, protocol.ID(topic))
+	// The first protocol supported by the peer is negotiated, the encoding of the messages depends on it.
+	suffixes := s.Encoding().ReqRespProtocolSuffixes()
+	protocols := make([]protocol.ID, 0, len(suffixes))
+	for _, suffix := range suffixes {
+		protocols = append(protocols, protocol.ID(baseTopic+suffix))
+	}
+	stream, err := s.host.NewStream(ctx, pid, protocols...)
 	if err != nil {
 		tracing.AnnotateError(span, err)
 		return nil, err
@@ -6,6 +6,7 @@ package p2p
 import (
 	"context"
 	"crypto/ecdsa"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -166,8 +167,14 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 				Threshold:     maxBadResponses,
 				DecayInterval: time.Hour,
 			},
+			InvalidDataScorerConfig: &scorers.InvalidDataScorerConfig{
+				RecordsPath: offenseRecordsPath(s.cfg.DataDir),
+			},
 		},
 	})
+	if err := s.peers.Scorers().InvalidDataScorer().LoadRecords(); err != nil {
+		log.WithError(err).Warn("Could not load offense records of peers serving invalid data")
+	}
 
 	// Initialize Data maps.
 	types.InitializeDataMaps()
@@ -314,8 +321,15 @@ func (s *Service) Started() bool {
 }
 
 // Encoding returns the configured networking encoding.
-func (*Service) Encoding() encoder.NetworkEncoding {
-	return &encoder.SszNetworkEncoder{}
+func (s *Service) Encoding() encoder.NetworkEncoding {
+	if s.cfg == nil {
+		return &encoder.SszNetworkEncoder{}
+	}
+	return &encoder.SszNetworkEncoder{
+		Compression:      s.cfg.Compression,
+		LocalCompression: s.cfg.LocalCompression,
+		Zstd:             s.cfg.EnableZstd,
+	}
 }
 
 // PubSub returns the p2p pubsub framework.
@@ -355,6 +369,16 @@ func (s *Service) Peers() *peers.Status {
 	return s.peers
 }
 
+// SetMaxPeers changes the target number of peers of the node at runtime.
+func (s *Service) SetMaxPeers(maxPeers uint) {
+	s.peers.SetPeerLimit(int(maxPeers)) // lint:ignore uintcast -- Peer limit will not exceed int.
+}
+
+// maxPeers returns the current target number of peers, which starts at the configured value.
+func (s *Service) maxPeers() uint {
+	return uint(s.peers.ConnectedPeerLimit())
+}
+
 // ENR returns the local node's current ENR.
 func (s *Service) ENR() *enr.Record {
 	if s.dv5Listener == nil {
@@ -510,3 +534,12 @@ func (s *Service) connectToBootnodes() error {
 func (s *Service) isInitialized() bool {
 	return !s.genesisTime.IsZero() && len(s.genesisValidatorsRoot) == 32
 }
+
+// offenseRecordsPath returns the file the offense records of the peers serving invalid data are persisted to,
+// they are not persisted without a data directory.
+func offenseRecordsPath(dataDir string) string {
+	if dataDir == "" {
+		return ""
+	}
+	return filepath.Join(dataDir, "peer_offenses.json")
+}
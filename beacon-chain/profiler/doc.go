@@ -0,0 +1,13 @@
+/*
+Package profiler defines a runtime service which periodically captures profiles of
+the beacon node, so that performance regressions can be analyzed after the fact.
+
+The CPU profile of a slot is captured in one profile for each of its phases: the
+proposal, the attestation and the aggregation phase, so that the work done at a
+given point of the slot can be told apart. The other profiles, such as the heap and
+the goroutine profiles, are snapshots taken at the end of the slot.
+
+Profiles are written to a directory, and optionally pushed to a profiling server
+supporting the Pyroscope ingest API, such as Grafana Pyroscope.
+*/
+package profiler
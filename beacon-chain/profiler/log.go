@@ -0,0 +1,5 @@
+package profiler
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "profiler")
@@ -0,0 +1,23 @@
+package profiler
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	profilesCapturedCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "profiler_profiles_captured_total",
+			Help: "The number of profiles captured, by type and result",
+		},
+		[]string{"type", "result"},
+	)
+	profilesPushedCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "profiler_profiles_pushed_total",
+			Help: "The number of profiles pushed to the profiling server, by type and result",
+		},
+		[]string{"type", "result"},
+	)
+)
@@ -0,0 +1,83 @@
+package profiler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime/pprof"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// ProfileCPU is the type of the CPU profiles. The other types are the names of the runtime profiles, such
+// as heap, goroutine, allocs, block or mutex.
+const ProfileCPU = "cpu"
+
+// Phases of a slot, in which the CPU profile of the slot is split.
+var Phases = []string{"proposal", "attestation", "aggregation"}
+
+// Profile is a captured profile, in the gzipped protobuf format of pprof.
+type Profile struct {
+	Type string
+	// Phase is the phase of the slot the CPU profile was captured in, and is empty for the other profiles.
+	Phase string
+	Slot  primitives.Slot
+	From  time.Time
+	Until time.Time
+	Data  []byte
+}
+
+// fileName returns the name of the file the profile is written to.
+func (p *Profile) fileName() string {
+	name := fmt.Sprintf("%d-%d-%s", p.From.Unix(), p.Slot, p.Type)
+	if p.Phase != "" {
+		name += "-" + p.Phase
+	}
+	return name + ".pb.gz"
+}
+
+// ParseTypes validates the types of profiles to capture.
+func ParseTypes(types []string) ([]string, error) {
+	parsed := make([]string, 0, len(types))
+	for _, t := range types {
+		t = strings.TrimSpace(strings.ToLower(t))
+		if t != ProfileCPU && pprof.Lookup(t) == nil {
+			return nil, errors.Errorf("unknown profile type %s", t)
+		}
+		parsed = append(parsed, t)
+	}
+	return parsed, nil
+}
+
+// captureCPU profiles the CPU for the duration. It fails if the CPU is already being profiled, for instance
+// through the pprof server.
+func captureCPU(ctx context.Context, d time.Duration) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := pprof.StartCPUProfile(buf); err != nil {
+		return nil, errors.Wrap(err, "could not start CPU profile")
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	pprof.StopCPUProfile()
+	return buf.Bytes(), ctx.Err()
+}
+
+// captureSnapshot writes the runtime profile of the type.
+func captureSnapshot(t string) ([]byte, error) {
+	p := pprof.Lookup(t)
+	if p == nil {
+		return nil, errors.Errorf("unknown profile type %s", t)
+	}
+	buf := new(bytes.Buffer)
+	if err := p.WriteTo(buf, 0); err != nil {
+		return nil, errors.Wrapf(err, "could not write %s profile", t)
+	}
+	return buf.Bytes(), nil
+}
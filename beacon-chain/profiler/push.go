@@ -0,0 +1,98 @@
+package profiler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const pushTimeout = 30 * time.Second
+
+// Pusher sends the captured profiles to a profiling server.
+type Pusher interface {
+	Push(ctx context.Context, p *Profile) error
+}
+
+// IngestPusher pushes profiles to the ingest API of Pyroscope, which is also supported by other profiling
+// servers. The profiles are labelled with their type and, for the CPU profiles, their slot phase.
+type IngestPusher struct {
+	url     string
+	appName string
+	labels  map[string]string
+	client  *http.Client
+}
+
+var _ Pusher = (*IngestPusher)(nil)
+
+// NewIngestPusher returns a pusher to the server at the URL, which profiles are stored under the application
+// name with the additional labels.
+func NewIngestPusher(serverURL, appName string, labels map[string]string) *IngestPusher {
+	return &IngestPusher{
+		url:     strings.TrimSuffix(serverURL, "/") + "/ingest",
+		appName: appName,
+		labels:  labels,
+		client:  &http.Client{Timeout: pushTimeout},
+	}
+}
+
+// name returns the application name of the profile, with its labels in the format of the ingest API.
+func (i *IngestPusher) name(p *Profile) string {
+	labels := map[string]string{"profile_type": p.Type}
+	if p.Phase != "" {
+		labels["slot_phase"] = p.Phase
+	}
+	for k, v := range i.labels {
+		labels[k] = v
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for j, k := range keys {
+		pairs[j] = fmt.Sprintf("%s=%s", k, labels[k])
+	}
+	return fmt.Sprintf("%s{%s}", i.appName, strings.Join(pairs, ","))
+}
+
+// Push sends the profile to the ingest API.
+func (i *IngestPusher) Push(ctx context.Context, p *Profile) error {
+	query := url.Values{}
+	query.Set("name", i.name(p))
+	query.Set("from", strconv.FormatInt(p.From.Unix(), 10))
+	query.Set("until", strconv.FormatInt(p.Until.Unix(), 10))
+	query.Set("format", "pprof")
+	query.Set("spyName", "gospy")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.url+"?"+query.Encode(), bytes.NewReader(p.Data))
+	if err != nil {
+		return errors.Wrap(err, "could not create ingest request")
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not push profile")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if err != nil {
+			return errors.Wrapf(err, "profiling server returned status %d", resp.StatusCode)
+		}
+		return errors.Errorf("profiling server returned status %d: %s", resp.StatusCode, string(msg))
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package profiler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestIngestPusher_Push(t *testing.T) {
+	var query map[string][]string
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ingest", r.URL.Path)
+		query = r.URL.Query()
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	from := time.Unix(1700000000, 0)
+	p := &Profile{Type: ProfileCPU, Phase: "attestation", Slot: 5, From: from, Until: from.Add(4 * time.Second), Data: []byte{1, 2, 3}}
+	pusher := NewIngestPusher(srv.URL+"/", "prysm-beacon", map[string]string{"network": "mainnet"})
+	require.NoError(t, pusher.Push(context.Background(), p))
+	assert.Equal(t, "prysm-beacon{network=mainnet,profile_type=cpu,slot_phase=attestation}", query["name"][0])
+	assert.Equal(t, "1700000000", query["from"][0])
+	assert.Equal(t, "1700000004", query["until"][0])
+	assert.Equal(t, "pprof", query["format"][0])
+	assert.DeepEqual(t, []byte{1, 2, 3}, body)
+}
+
+func TestIngestPusher_Push_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad profile", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	pusher := NewIngestPusher(srv.URL, "prysm-beacon", nil)
+	err := pusher.Push(context.Background(), &Profile{Type: "heap", From: time.Now(), Until: time.Now()})
+	require.ErrorContains(t, "profiling server returned status 400: bad profile", err)
+}
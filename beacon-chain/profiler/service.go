@@ -0,0 +1,186 @@
+package profiler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+// Config contains the profiles to capture, how often, and where to store them.
+type Config struct {
+	ClockWaiter startup.ClockWaiter
+	Types       []string
+	// Interval is the time between the starts of two captures.
+	Interval time.Duration
+	// Dir is the directory the profiles are written to. No profile is written when it is empty.
+	Dir string
+	// Retention is how long the profiles are kept in the directory.
+	Retention time.Duration
+	// Pusher sends the profiles to a profiling server, if set.
+	Pusher Pusher
+}
+
+// Service captures the configured profiles of one slot at every interval.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewService returns a profiler service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start the profiler service.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"types":    s.cfg.Types,
+		"interval": s.cfg.Interval,
+		"dir":      s.cfg.Dir,
+		"push":     s.cfg.Pusher != nil,
+	}).Info("Starting service")
+	go s.run()
+}
+
+// Stop the profiler service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the profiler service.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not wait for clock")
+		return
+	}
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			slot := clock.CurrentSlot() + 1
+			phase := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / time.Duration(len(Phases))
+			s.capture(slot, clock.SlotStart(slot), phase)
+			s.prune(time.Now())
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// capture profiles the slot starting at the given time, capturing one CPU profile for each of its phases,
+// and a snapshot of the other profiles at its end.
+func (s *Service) capture(slot primitives.Slot, start time.Time, phase time.Duration) {
+	timer := time.NewTimer(time.Until(start))
+	select {
+	case <-timer.C:
+	case <-s.ctx.Done():
+		timer.Stop()
+		return
+	}
+
+	var captured []*Profile
+	if slices.Contains(s.cfg.Types, ProfileCPU) {
+		for _, name := range Phases {
+			from := time.Now()
+			data, err := captureCPU(s.ctx, phase)
+			if err != nil {
+				profilesCapturedCount.WithLabelValues(ProfileCPU, "failure").Inc()
+				log.WithError(err).WithField("type", ProfileCPU).Debug("Could not capture profile")
+				break
+			}
+			profilesCapturedCount.WithLabelValues(ProfileCPU, "success").Inc()
+			captured = append(captured, &Profile{Type: ProfileCPU, Phase: name, Slot: slot, From: from, Until: time.Now(), Data: data})
+		}
+	}
+	for _, t := range s.cfg.Types {
+		if t == ProfileCPU {
+			continue
+		}
+		now := time.Now()
+		data, err := captureSnapshot(t)
+		if err != nil {
+			profilesCapturedCount.WithLabelValues(t, "failure").Inc()
+			log.WithError(err).WithField("type", t).Debug("Could not capture profile")
+			continue
+		}
+		profilesCapturedCount.WithLabelValues(t, "success").Inc()
+		captured = append(captured, &Profile{Type: t, Slot: slot, From: now, Until: now, Data: data})
+	}
+
+	for _, p := range captured {
+		if err := s.store(p); err != nil {
+			log.WithError(err).WithField("type", p.Type).Error("Could not store profile")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"slot":     slot,
+		"profiles": len(captured),
+	}).Debug("Captured profiles")
+}
+
+// store writes the profile to the directory, and pushes it to the profiling server.
+func (s *Service) store(p *Profile) error {
+	if s.cfg.Dir != "" {
+		if err := file.MkdirAll(s.cfg.Dir); err != nil {
+			return errors.Wrapf(err, "could not create directory %s", s.cfg.Dir)
+		}
+		if err := file.WriteFile(filepath.Join(s.cfg.Dir, p.fileName()), p.Data); err != nil {
+			return errors.Wrap(err, "could not write profile")
+		}
+	}
+	if s.cfg.Pusher != nil {
+		if err := s.cfg.Pusher.Push(s.ctx, p); err != nil {
+			profilesPushedCount.WithLabelValues(p.Type, "failure").Inc()
+			return err
+		}
+		profilesPushedCount.WithLabelValues(p.Type, "success").Inc()
+	}
+	return nil
+}
+
+// prune removes the profiles older than the retention period from the directory.
+func (s *Service) prune(now time.Time) {
+	if s.cfg.Dir == "" || s.cfg.Retention == 0 {
+		return
+	}
+	entries, err := os.ReadDir(s.cfg.Dir)
+	if err != nil {
+		log.WithError(err).Debug("Could not read profiles directory")
+		return
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() || !strings.HasSuffix(e.Name(), ".pb.gz") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil || now.Sub(info.ModTime()) <= s.cfg.Retention {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.cfg.Dir, e.Name())); err != nil {
+			log.WithError(err).WithField("file", e.Name()).Debug("Could not remove profile")
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package profiler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockPusher struct {
+	pushed []*Profile
+}
+
+func (m *mockPusher) Push(_ context.Context, p *Profile) error {
+	m.pushed = append(m.pushed, p)
+	return nil
+}
+
+func TestParseTypes(t *testing.T) {
+	types, err := ParseTypes([]string{"cpu", " Heap", "goroutine"})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"cpu", "heap", "goroutine"}, types)
+
+	_, err = ParseTypes([]string{"cpu", "unknown"})
+	require.ErrorContains(t, "unknown profile type unknown", err)
+}
+
+func TestService_Capture(t *testing.T) {
+	dir := t.TempDir()
+	pusher := &mockPusher{}
+	s := NewService(context.Background(), &Config{
+		Types:  []string{ProfileCPU, "heap", "goroutine"},
+		Dir:    dir,
+		Pusher: pusher,
+	})
+	s.capture(10, time.Now(), 10*time.Millisecond)
+
+	require.Equal(t, len(Phases)+2, len(pusher.pushed))
+	for i, phase := range Phases {
+		assert.Equal(t, ProfileCPU, pusher.pushed[i].Type)
+		assert.Equal(t, phase, pusher.pushed[i].Phase)
+	}
+	assert.Equal(t, "heap", pusher.pushed[3].Type)
+	assert.Equal(t, "", pusher.pushed[3].Phase)
+	assert.Equal(t, "goroutine", pusher.pushed[4].Type)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, len(pusher.pushed), len(entries))
+	for _, p := range pusher.pushed {
+		assert.Equal(t, true, len(p.Data) > 0)
+		data, err := os.ReadFile(filepath.Join(dir, p.fileName()))
+		require.NoError(t, err)
+		assert.DeepEqual(t, p.Data, data)
+	}
+}
+
+func TestService_Prune(t *testing.T) {
+	dir := t.TempDir()
+	s := NewService(context.Background(), &Config{Dir: dir, Retention: time.Hour})
+	now := time.Now()
+	old := filepath.Join(dir, "1-1-heap.pb.gz")
+	recent := filepath.Join(dir, "2-2-heap.pb.gz")
+	other := filepath.Join(dir, "other")
+	for _, f := range []string{old, recent, other} {
+		require.NoError(t, os.WriteFile(f, []byte{1}, 0600))
+		require.NoError(t, os.Chtimes(f, now.Add(-2*time.Hour), now.Add(-2*time.Hour)))
+	}
+	require.NoError(t, os.Chtimes(recent, now, now))
+
+	s.prune(now)
+	_, err := os.Stat(old)
+	assert.Equal(t, true, os.IsNotExist(err))
+	_, err = os.Stat(recent)
+	assert.NoError(t, err)
+	_, err = os.Stat(other)
+	assert.NoError(t, err)
+}
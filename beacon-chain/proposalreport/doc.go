@@ -0,0 +1,10 @@
+/*
+Package proposalreport records the profitability of the blocks proposed through
+this beacon node by its attached validators: the consensus rewards of each
+proposal, the value of its execution payload, the bid of the builder relay if
+any, and whether the payload was built locally or by the relay. The recorded
+proposals are summarized per payload source and relay over a range of slots,
+and exported as CSV, so that operators can audit the profitability of their
+proposals per relay. Proposals are kept in memory only.
+*/
+package proposalreport
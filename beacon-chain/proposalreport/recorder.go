@@ -0,0 +1,128 @@
+package proposalreport
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// Sources of the execution payload of a proposal.
+const (
+	SourceLocal   = "local"
+	SourceBuilder = "builder"
+	// SourceUnknown is the source of the blocks proposed through this node which were not built by it.
+	SourceUnknown = "unknown"
+)
+
+const (
+	// maxProposals is the number of proposals kept, the oldest ones being dropped first.
+	maxProposals = 8192
+	// pendingEpochs is the number of epochs a built block waits to be proposed before it is dropped.
+	pendingEpochs = 2
+)
+
+// Proposal records the profitability of a block proposed by an attached validator.
+type Proposal struct {
+	Slot          primitives.Slot
+	ProposerIndex primitives.ValidatorIndex
+	BlockRoot     [32]byte
+	Source        string
+	// Relay is the URL of the builder relay queried for a bid, empty when no relay was queried.
+	Relay string
+	// LocalValue is the value of the locally built payload, and BidValue is the value of the bid of the relay,
+	// which is only set when HasBid is true.
+	LocalValue primitives.Gwei
+	BidValue   primitives.Gwei
+	HasBid     bool
+	// ExecutionValue is the value of the payload of the proposed block, paid to the fee recipient.
+	ExecutionValue primitives.Gwei
+	// ConsensusReward is the reward of the proposer for the attestations, sync aggregate and slashings of the
+	// block. It is only set when HasConsensusReward is true, as it is computed once the block is proposed.
+	ConsensusReward    primitives.Gwei
+	HasConsensusReward bool
+	Time               time.Time
+}
+
+// Recorder records the blocks built for the attached validators, and their proposals.
+type Recorder struct {
+	sync.RWMutex
+	// built are the blocks built by this node waiting to be proposed, keyed by slot.
+	built map[primitives.Slot]*Proposal
+	// proposals are in increasing order of slots.
+	proposals []*Proposal
+}
+
+// NewRecorder returns a recorder of proposals.
+func NewRecorder() *Recorder {
+	return &Recorder{built: make(map[primitives.Slot]*Proposal)}
+}
+
+// RecordBuild records the execution payload chosen for a block built by this node, which may then be proposed.
+func (r *Recorder) RecordBuild(p *Proposal) {
+	r.Lock()
+	defer r.Unlock()
+	r.built[p.Slot] = p
+	horizon := params.BeaconConfig().SlotsPerEpoch * pendingEpochs
+	for slot := range r.built {
+		if slot+horizon < p.Slot {
+			delete(r.built, slot)
+		}
+	}
+}
+
+// RecordProposal records the proposal of a block. The block is matched with the block built by this node for the
+// slot, if any, and its source is unknown otherwise.
+func (r *Recorder) RecordProposal(slot primitives.Slot, proposer primitives.ValidatorIndex, root [32]byte, now time.Time) {
+	r.Lock()
+	defer r.Unlock()
+	p, ok := r.built[slot]
+	if ok && p.ProposerIndex == proposer {
+		delete(r.built, slot)
+	} else {
+		p = &Proposal{Slot: slot, ProposerIndex: proposer, Source: SourceUnknown}
+	}
+	p.BlockRoot = root
+	p.Time = now
+
+	i := sort.Search(len(r.proposals), func(i int) bool { return r.proposals[i].Slot >= slot })
+	if i < len(r.proposals) && r.proposals[i].Slot == slot {
+		// The block was proposed again, or another block was proposed for the slot.
+		r.proposals[i] = p
+		return
+	}
+	r.proposals = append(r.proposals, nil)
+	copy(r.proposals[i+1:], r.proposals[i:])
+	r.proposals[i] = p
+	if len(r.proposals) > maxProposals {
+		r.proposals = r.proposals[len(r.proposals)-maxProposals:]
+	}
+}
+
+// SetConsensusReward sets the consensus reward of the proposed block.
+func (r *Recorder) SetConsensusReward(root [32]byte, reward primitives.Gwei) {
+	r.Lock()
+	defer r.Unlock()
+	for i := len(r.proposals) - 1; i >= 0; i-- {
+		if r.proposals[i].BlockRoot == root {
+			r.proposals[i].ConsensusReward = reward
+			r.proposals[i].HasConsensusReward = true
+			return
+		}
+	}
+}
+
+// Proposals returns the proposals from the start slot to the end slot, both included.
+func (r *Recorder) Proposals(start, end primitives.Slot) []*Proposal {
+	r.RLock()
+	defer r.RUnlock()
+	i := sort.Search(len(r.proposals), func(i int) bool { return r.proposals[i].Slot >= start })
+	proposals := make([]*Proposal, 0)
+	for ; i < len(r.proposals) && r.proposals[i].Slot <= end; i++ {
+		p := *r.proposals[i]
+		proposals = append(proposals, &p)
+	}
+	return proposals
+}
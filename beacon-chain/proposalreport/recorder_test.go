@@ -0,0 +1,90 @@
+package proposalreport
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestRecorder_RecordProposal(t *testing.T) {
+	r := NewRecorder()
+	now := time.Unix(1700000000, 0)
+	r.RecordBuild(&Proposal{Slot: 10, ProposerIndex: 1, Source: SourceLocal, Relay: "http://relay-a", LocalValue: 100, BidValue: 90, HasBid: true, ExecutionValue: 100})
+	r.RecordBuild(&Proposal{Slot: 12, ProposerIndex: 2, Source: SourceBuilder, Relay: "http://relay-a", LocalValue: 100, BidValue: 200, HasBid: true, ExecutionValue: 200})
+
+	r.RecordProposal(12, 2, [32]byte{12}, now)
+	r.RecordProposal(10, 1, [32]byte{10}, now)
+	// The block was not built by this node.
+	r.RecordProposal(11, 3, [32]byte{11}, now)
+	r.SetConsensusReward([32]byte{12}, 30)
+
+	proposals := r.Proposals(0, 100)
+	require.Equal(t, 3, len(proposals))
+	assert.Equal(t, primitives.Slot(10), proposals[0].Slot)
+	assert.Equal(t, SourceLocal, proposals[0].Source)
+	assert.Equal(t, false, proposals[0].HasConsensusReward)
+	assert.Equal(t, SourceUnknown, proposals[1].Source)
+	assert.Equal(t, primitives.ValidatorIndex(3), proposals[1].ProposerIndex)
+	assert.Equal(t, SourceBuilder, proposals[2].Source)
+	assert.Equal(t, [32]byte{12}, proposals[2].BlockRoot)
+	assert.Equal(t, true, proposals[2].HasConsensusReward)
+	assert.Equal(t, primitives.Gwei(30), proposals[2].ConsensusReward)
+
+	proposals = r.Proposals(11, 11)
+	require.Equal(t, 1, len(proposals))
+	assert.Equal(t, primitives.Slot(11), proposals[0].Slot)
+}
+
+func TestRecorder_DropsStaleBuilds(t *testing.T) {
+	r := NewRecorder()
+	r.RecordBuild(&Proposal{Slot: 1, ProposerIndex: 1, Source: SourceLocal})
+	r.RecordBuild(&Proposal{Slot: 1 + 3*params.BeaconConfig().SlotsPerEpoch, ProposerIndex: 2, Source: SourceLocal})
+	r.RecordProposal(1, 1, [32]byte{1}, time.Now())
+	proposals := r.Proposals(1, 1)
+	require.Equal(t, 1, len(proposals))
+	assert.Equal(t, SourceUnknown, proposals[0].Source)
+}
+
+func TestRecorder_NewReport(t *testing.T) {
+	r := NewRecorder()
+	for slot, p := range map[primitives.Slot]*Proposal{
+		1: {Source: SourceLocal, Relay: "http://relay-a", LocalValue: 100, BidValue: 150, HasBid: true, ExecutionValue: 100},
+		2: {Source: SourceBuilder, Relay: "http://relay-a", LocalValue: 100, BidValue: 300, HasBid: true, ExecutionValue: 300},
+		3: {Source: SourceBuilder, Relay: "http://relay-a", LocalValue: 50, BidValue: 200, HasBid: true, ExecutionValue: 200},
+		4: {Source: SourceLocal, LocalValue: 80, ExecutionValue: 80},
+		9: {Source: SourceLocal, LocalValue: 80, ExecutionValue: 80},
+	} {
+		p.Slot = slot
+		r.RecordBuild(p)
+		r.RecordProposal(slot, 0, [32]byte{byte(slot)}, time.Now())
+		r.SetConsensusReward([32]byte{byte(slot)}, 10)
+	}
+
+	report := r.NewReport(1, 8)
+	assert.Equal(t, 4, report.Total.Proposals)
+	assert.Equal(t, primitives.Gwei(680), report.Total.ExecutionValue)
+	assert.Equal(t, primitives.Gwei(40), report.Total.ConsensusReward)
+	assert.Equal(t, primitives.Gwei(50), report.Total.LostBidValue)
+	require.Equal(t, 3, len(report.Sources))
+	assert.DeepEqual(t, &Summary{Source: SourceBuilder, Relay: "http://relay-a", Proposals: 2, ExecutionValue: 500, ConsensusReward: 20, BidValue: 500}, report.Sources[0])
+	assert.DeepEqual(t, &Summary{Source: SourceLocal, Proposals: 1, ExecutionValue: 80, ConsensusReward: 10}, report.Sources[1])
+	assert.DeepEqual(t, &Summary{Source: SourceLocal, Relay: "http://relay-a", Proposals: 1, ExecutionValue: 100, ConsensusReward: 10, BidValue: 150, LostBidValue: 50}, report.Sources[2])
+}
+
+func TestWriteCSV(t *testing.T) {
+	proposals := []*Proposal{
+		{Slot: 1, ProposerIndex: 2, BlockRoot: [32]byte{1}, Source: SourceBuilder, Relay: "http://relay-a", LocalValue: 100, BidValue: 300, HasBid: true, ExecutionValue: 300, ConsensusReward: 10, HasConsensusReward: true, Time: time.Unix(0, 0)},
+		{Slot: 2, ProposerIndex: 3, Source: SourceLocal, LocalValue: 80, ExecutionValue: 80, Time: time.Unix(0, 0)},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, proposals))
+	want := "slot,proposer_index,block_root,source,relay,local_value_gwei,bid_value_gwei,execution_value_gwei,consensus_reward_gwei,time\n" +
+		"1,2,0x0100000000000000000000000000000000000000000000000000000000000000,builder,http://relay-a,100,300,300,10,1970-01-01T00:00:00Z\n" +
+		"2,3,0x0000000000000000000000000000000000000000000000000000000000000000,local,,80,,80,,1970-01-01T00:00:00Z\n"
+	assert.Equal(t, want, buf.String())
+}
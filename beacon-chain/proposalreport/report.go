@@ -0,0 +1,120 @@
+package proposalreport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// Report summarizes the profitability of the proposals of a range of slots, per payload source and relay.
+type Report struct {
+	StartSlot primitives.Slot
+	EndSlot   primitives.Slot
+	Total     *Summary
+	// Sources are the summaries of the proposals per source and relay, in increasing order of source and relay.
+	Sources []*Summary
+}
+
+// Summary of the profitability of a set of proposals.
+type Summary struct {
+	Source          string
+	Relay           string
+	Proposals       int
+	ExecutionValue  primitives.Gwei
+	ConsensusReward primitives.Gwei
+	// BidValue is the total of the bids of the relays, and LostBidValue the total of the bids of the relays which
+	// were higher than the value of the proposed payloads.
+	BidValue     primitives.Gwei
+	LostBidValue primitives.Gwei
+}
+
+// NewReport summarizes the proposals from the start slot to the end slot.
+func (r *Recorder) NewReport(start, end primitives.Slot) *Report {
+	report := &Report{StartSlot: start, EndSlot: end, Total: &Summary{}}
+	sources := make(map[[2]string]*Summary)
+	for _, p := range r.Proposals(start, end) {
+		key := [2]string{p.Source, p.Relay}
+		s, ok := sources[key]
+		if !ok {
+			s = &Summary{Source: p.Source, Relay: p.Relay}
+			sources[key] = s
+			report.Sources = append(report.Sources, s)
+		}
+		s.add(p)
+		report.Total.add(p)
+	}
+	sort.Slice(report.Sources, func(i, j int) bool {
+		a, b := report.Sources[i], report.Sources[j]
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		return a.Relay < b.Relay
+	})
+	return report
+}
+
+func (s *Summary) add(p *Proposal) {
+	s.Proposals++
+	s.ExecutionValue += p.ExecutionValue
+	s.ConsensusReward += p.ConsensusReward
+	if p.HasBid {
+		s.BidValue += p.BidValue
+		if p.BidValue > p.ExecutionValue {
+			s.LostBidValue += p.BidValue - p.ExecutionValue
+		}
+	}
+}
+
+// csvHeader are the columns of the CSV export of the proposals.
+var csvHeader = []string{
+	"slot",
+	"proposer_index",
+	"block_root",
+	"source",
+	"relay",
+	"local_value_gwei",
+	"bid_value_gwei",
+	"execution_value_gwei",
+	"consensus_reward_gwei",
+	"time",
+}
+
+// WriteCSV writes the proposals as CSV, with a header row. The bid value is empty when no bid was received, and the
+// consensus reward is empty when it is not known.
+func WriteCSV(w io.Writer, proposals []*Proposal) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, p := range proposals {
+		record := []string{
+			fmt.Sprintf("%d", p.Slot),
+			fmt.Sprintf("%d", p.ProposerIndex),
+			hexutil.Encode(p.BlockRoot[:]),
+			p.Source,
+			p.Relay,
+			fmt.Sprintf("%d", p.LocalValue),
+			"",
+			fmt.Sprintf("%d", p.ExecutionValue),
+			"",
+			p.Time.UTC().Format(time.RFC3339),
+		}
+		if p.HasBid {
+			record[6] = strconv.FormatUint(uint64(p.BidValue), 10)
+		}
+		if p.HasConsensusReward {
+			record[8] = strconv.FormatUint(uint64(p.ConsensusReward), 10)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
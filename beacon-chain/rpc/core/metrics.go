@@ -0,0 +1,18 @@
+package core
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	syncContributionParticipants = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sync_committee_contribution_participants",
+		Help: "The number of members of the subcommittee included in the latest sync committee contribution of the subcommittee.",
+	}, []string{"subcommittee_index"})
+	syncContributionParticipation = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "sync_committee_contribution_participation_ratio",
+		Help:    "The share of the members of the subcommittee included in the sync committee contributions produced.",
+		Buckets: prometheus.LinearBuckets(0.1, 0.1, 10),
+	})
+)
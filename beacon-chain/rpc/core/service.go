@@ -22,8 +22,12 @@ type Service struct {
 	SyncCommitteePool     synccommittee.Pool
 	OperationNotifier     opfeed.Notifier
 	AttestationCache      *cache.AttestationCache
+	SyncContributionCache *cache.SyncContributionCache
 	StateGen              stategen.StateManager
 	P2P                   p2p.Broadcaster
 	ReplayerBuilder       stategen.ReplayerBuilder
 	OptimisticModeFetcher blockchain.OptimisticModeFetcher
+	// ServeOptimisticDuties serves attestation data with a warning while the node is optimistic, instead of
+	// rejecting the request.
+	ServeOptimisticDuties bool
 }
@@ -435,34 +435,58 @@ func (s *Service) AggregatedSigAndAggregationBits(
 	ctx context.Context,
 	req *ethpb.AggregatedSigAndAggregationBitsRequest) ([]byte, []byte, error) {
 	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
-	sigs := make([][]byte, 0, subCommitteeSize)
-	bits := ethpb.NewSyncCommitteeAggregationBits()
+	// Build upon the partial aggregate of the subcommittee, if another aggregator already requested it.
+	var contribution *cache.SyncContribution
+	if s.SyncContributionCache != nil {
+		contribution = s.SyncContributionCache.Get(req.Slot, req.SubnetId, req.BlockRoot)
+	}
+	if contribution == nil {
+		contribution = &cache.SyncContribution{
+			Bits:       ethpb.NewSyncCommitteeAggregationBits(),
+			Aggregated: make(map[primitives.ValidatorIndex]bool),
+		}
+	}
+	bits := ethpb.ConvertToSyncContributionBitVector(contribution.Bits)
+	sigs := make([]bls.Signature, 0, subCommitteeSize)
+	if contribution.Signature != nil {
+		sigs = append(sigs, contribution.Signature)
+	}
 	for _, msg := range req.Msgs {
-		if bytes.Equal(req.BlockRoot, msg.BlockRoot) {
-			headSyncCommitteeIndices, err := s.HeadFetcher.HeadSyncCommitteeIndices(ctx, msg.ValidatorIndex, req.Slot)
-			if err != nil {
-				return nil, nil, errors.Wrapf(err, "could not get sync subcommittee index")
-			}
-			for _, index := range headSyncCommitteeIndices {
-				i := uint64(index)
-				subnetIndex := i / subCommitteeSize
-				indexMod := i % subCommitteeSize
-				if subnetIndex == req.SubnetId && !bits.BitAt(indexMod) {
-					bits.SetBitAt(indexMod, true)
-					sigs = append(sigs, msg.Signature)
+		if !bytes.Equal(req.BlockRoot, msg.BlockRoot) || contribution.Aggregated[msg.ValidatorIndex] {
+			continue
+		}
+		headSyncCommitteeIndices, err := s.HeadFetcher.HeadSyncCommitteeIndices(ctx, msg.ValidatorIndex, req.Slot)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not get sync subcommittee index")
+		}
+		for _, index := range headSyncCommitteeIndices {
+			i := uint64(index)
+			subnetIndex := i / subCommitteeSize
+			indexMod := i % subCommitteeSize
+			if subnetIndex == req.SubnetId && !bits.BitAt(indexMod) {
+				sig, err := bls.SignatureFromBytes(msg.Signature)
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "could not decompress signatures")
 				}
+				bits.SetBitAt(indexMod, true)
+				sigs = append(sigs, sig)
 			}
 		}
+		contribution.Aggregated[msg.ValidatorIndex] = true
 	}
 	aggregatedSig := make([]byte, 96)
 	aggregatedSig[0] = 0xC0
 	if len(sigs) != 0 {
-		uncompressedSigs, err := bls.MultipleSignaturesFromBytes(sigs)
-		if err != nil {
-			return nil, nil, errors.Wrapf(err, "could not decompress signatures")
-		}
-		aggregatedSig = bls.AggregateSignatures(uncompressedSigs).Marshal()
+		contribution.Signature = bls.AggregateSignatures(sigs)
+		aggregatedSig = contribution.Signature.Marshal()
+	}
+	contribution.Bits = bits
+	if s.SyncContributionCache != nil {
+		s.SyncContributionCache.Put(req.Slot, req.SubnetId, req.BlockRoot, contribution)
 	}
+	participants := bits.Count()
+	syncContributionParticipants.WithLabelValues(fmt.Sprintf("%d", req.SubnetId)).Set(float64(participants))
+	syncContributionParticipation.Observe(float64(participants) / float64(subCommitteeSize))
 	return aggregatedSig, bits, nil
 }
 
@@ -538,7 +562,10 @@ func (s *Service) GetAttestationData(
 		return nil, &RpcError{Reason: Internal, Err: err}
 	}
 	if optimistic {
-		return nil, &RpcError{Reason: Unavailable, Err: errOptimisticMode}
+		if !s.ServeOptimisticDuties {
+			return nil, &RpcError{Reason: Unavailable, Err: errOptimisticMode}
+		}
+		log.WithField("slot", req.Slot).Warn("Serving attestation data while the node is optimistic, the head has not been validated by the execution client")
 	}
 
 	headRoot, err := s.HeadFetcher.HeadRoot(ctx)
@@ -1,13 +1,18 @@
 package core
 
 import (
+	"context"
 	"encoding/binary"
 	"testing"
 	"time"
 
+	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -63,3 +68,55 @@ func pubKey(i uint64) []byte {
 	binary.LittleEndian.PutUint64(pubKey, i)
 	return pubKey
 }
+
+func TestAggregatedSigAndAggregationBits_ReusesCachedContribution(t *testing.T) {
+	ctx := context.Background()
+	headFetcher := &mockChain.ChainService{SyncCommitteeIndices: []primitives.CommitteeIndex{10}}
+	s := &Service{HeadFetcher: headFetcher, SyncContributionCache: cache.NewSyncContributionCache()}
+	blockRoot := bytesutil.PadTo([]byte{'a'}, 32)
+	key1, err := bls.RandKey()
+	require.NoError(t, err)
+	key2, err := bls.RandKey()
+	require.NoError(t, err)
+	msg1 := &ethpb.SyncCommitteeMessage{Slot: 1, ValidatorIndex: 1, BlockRoot: blockRoot, Signature: key1.Sign(blockRoot).Marshal()}
+	msg2 := &ethpb.SyncCommitteeMessage{Slot: 1, ValidatorIndex: 2, BlockRoot: blockRoot, Signature: key2.Sign(blockRoot).Marshal()}
+	req := &ethpb.AggregatedSigAndAggregationBitsRequest{Msgs: []*ethpb.SyncCommitteeMessage{msg1}, Slot: 1, SubnetId: 0, BlockRoot: blockRoot}
+
+	sig, bits, err := s.AggregatedSigAndAggregationBits(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, msg1.Signature, sig)
+	assert.Equal(t, true, ethpb.ConvertToSyncContributionBitVector(bits).BitAt(10))
+
+	// The message already aggregated is not processed again.
+	headFetcher.SyncCommitteeIndices = nil
+	sig, bits, err = s.AggregatedSigAndAggregationBits(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, msg1.Signature, sig)
+	assert.Equal(t, uint64(1), ethpb.ConvertToSyncContributionBitVector(bits).Count())
+
+	// Only the new message is aggregated onto the cached contribution.
+	headFetcher.SyncCommitteeIndices = []primitives.CommitteeIndex{11}
+	req.Msgs = append(req.Msgs, msg2)
+	sig, bits, err = s.AggregatedSigAndAggregationBits(ctx, req)
+	require.NoError(t, err)
+	sig1, err := bls.SignatureFromBytes(msg1.Signature)
+	require.NoError(t, err)
+	sig2, err := bls.SignatureFromBytes(msg2.Signature)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bls.AggregateSignatures([]bls.Signature{sig1, sig2}).Marshal(), sig)
+	vector := ethpb.ConvertToSyncContributionBitVector(bits)
+	assert.Equal(t, true, vector.BitAt(10))
+	assert.Equal(t, true, vector.BitAt(11))
+	assert.Equal(t, uint64(2), vector.Count())
+}
+
+func TestAggregatedSigAndAggregationBits_NoMessages(t *testing.T) {
+	s := &Service{HeadFetcher: &mockChain.ChainService{}}
+	sig, bits, err := s.AggregatedSigAndAggregationBits(context.Background(), &ethpb.AggregatedSigAndAggregationBitsRequest{
+		Slot:      1,
+		BlockRoot: make([]byte, 32),
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, append([]byte{0xC0}, make([]byte, 95)...), sig)
+	assert.Equal(t, uint64(0), ethpb.ConvertToSyncContributionBitVector(bits).Count())
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	beaconprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/beacon"
 	nodeprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/node"
+	debugv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/debug"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	validatorprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
@@ -80,6 +81,7 @@ func (e *endpoint) handlerWithMiddleware() http.HandlerFunc {
 
 func (s *Service) endpoints(
 	enableDebug bool,
+	enableAdmin bool,
 	blocker lookup.Blocker,
 	stater lookup.Stater,
 	rewardFetcher rewards.BlockRewardsFetcher,
@@ -97,11 +99,17 @@ func (s *Service) endpoints(
 	endpoints = append(endpoints, s.configEndpoints()...)
 	endpoints = append(endpoints, s.lightClientEndpoints(blocker, stater)...)
 	endpoints = append(endpoints, s.eventsEndpoints()...)
-	endpoints = append(endpoints, s.prysmBeaconEndpoints(ch, stater, coreService)...)
+	endpoints = append(endpoints, s.prysmBeaconEndpoints(ch, stater, blocker, coreService)...)
 	endpoints = append(endpoints, s.prysmNodeEndpoints()...)
-	endpoints = append(endpoints, s.prysmValidatorEndpoints(stater, coreService)...)
+	endpoints = append(endpoints, s.prysmValidatorEndpoints(validatorServer, stater, coreService)...)
 	if enableDebug {
-		endpoints = append(endpoints, s.debugEndpoints(stater)...)
+		endpoints = append(endpoints, s.debugEndpoints(stater, ch)...)
+	}
+	if enableAdmin {
+		endpoints = append(endpoints, s.prysmNodeAdminEndpoints()...)
+	}
+	if s.cfg.SlashingApprover != nil {
+		endpoints = append(endpoints, s.slashingApprovalEndpoints()...)
 	}
 	return endpoints
 }
@@ -913,6 +921,15 @@ func (*Service) configEndpoints() []endpoint {
 			handler: config.GetSpec,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/config/resolved",
+			name:     namespace + ".GetResolvedConfig",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: config.GetResolvedConfig,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
 
@@ -966,7 +983,7 @@ func (s *Service) lightClientEndpoints(blocker lookup.Blocker, stater lookup.Sta
 	}
 }
 
-func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
+func (s *Service) debugEndpoints(stater lookup.Stater, ch *stategen.CanonicalHistory) []endpoint {
 	server := &debug.Server{
 		BeaconDB:              s.cfg.BeaconDB,
 		HeadFetcher:           s.cfg.HeadFetcher,
@@ -976,6 +993,24 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 		ForkchoiceFetcher:     s.cfg.ForkchoiceFetcher,
 		FinalizationFetcher:   s.cfg.FinalizationFetcher,
 		ChainInfoFetcher:      s.cfg.ChainInfoFetcher,
+		BadBlockCache:         s.cfg.BadBlockCache,
+		HeadSnapshotDir:       s.cfg.HeadSnapshotDir,
+		V1Alpha1Server: &debugv1alpha1.Server{
+			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
+			BeaconDB:           s.cfg.BeaconDB,
+			StateGen:           s.cfg.StateGen,
+			HeadFetcher:        s.cfg.HeadFetcher,
+			PeerManager:        s.cfg.PeerManager,
+			PeersFetcher:       s.cfg.PeersFetcher,
+			ReplayerBuilder:    ch,
+		},
+	}
+	// Only set the interface when deposits can be repaired, so that it is not a typed nil.
+	if s.cfg.DepositRepairer != nil {
+		server.DepositRepairer = s.cfg.DepositRepairer
+	}
+	if s.cfg.StateGen != nil {
+		server.StateRegenerator = s.cfg.StateGen
 	}
 
 	const namespace = "debug"
@@ -1007,6 +1042,147 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 			handler: server.GetForkChoice,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/debug/fork_choice/dot",
+			name:     namespace + ".GetForkChoiceDOT",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.GraphvizMediaType}),
+			},
+			handler: server.GetForkChoiceDOT,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/debug/bad_blocks",
+			name:     namespace + ".ListBadBlocks",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListBadBlocks,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/debug/bad_blocks",
+			name:     namespace + ".AddBadBlock",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.AddBadBlock,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/debug/bad_blocks/{block_root}",
+			name:     namespace + ".RemoveBadBlock",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.RemoveBadBlock,
+			methods: []string{http.MethodDelete},
+		},
+		{
+			template: "/prysm/v1/debug/deposits",
+			name:     namespace + ".CheckDeposits",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.CheckDeposits,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/debug/deposits/repair",
+			name:     namespace + ".RepairDeposits",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.RepairDeposits,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/debug/states/{block_root}/regenerate",
+			name:     namespace + ".RegenerateState",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.RegenerateState,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/debug/head/snapshot",
+			name:     namespace + ".SnapshotHead",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.SnapshotHead,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/debug/peers",
+			name:     namespace + ".ListPeers",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListPeers,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/debug/peers/{peer_id}",
+			name:     namespace + ".GetPeer",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPeer,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/debug/inclusion_slot",
+			name:     namespace + ".GetInclusionSlot",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetInclusionSlot,
+			methods: []string{http.MethodGet},
+		},
+	}
+}
+
+// slashingApprovalEndpoints are the endpoints through which an operator decides on the slashings detected by the
+// slasher. They are registered whenever the slasher runs with the approve policy, as the slashings are never
+// submitted otherwise.
+func (s *Service) slashingApprovalEndpoints() []endpoint {
+	server := &debug.Server{
+		SlashingApprover: s.cfg.SlashingApprover,
+	}
+
+	const namespace = "debug"
+	return []endpoint{
+		{
+			template: "/prysm/v1/debug/slasher/pending_slashings",
+			name:     namespace + ".ListPendingSlashings",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListPendingSlashings,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/debug/slasher/pending_slashings/{slashing_root}",
+			name:     namespace + ".ApprovePendingSlashing",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ApprovePendingSlashing,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/debug/slasher/pending_slashings/{slashing_root}",
+			name:     namespace + ".RejectPendingSlashing",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.RejectPendingSlashing,
+			methods: []string{http.MethodDelete},
+		},
 	}
 }
 
@@ -1037,6 +1213,7 @@ func (s *Service) eventsEndpoints() []endpoint {
 func (s *Service) prysmBeaconEndpoints(
 	ch *stategen.CanonicalHistory,
 	stater lookup.Stater,
+	blocker lookup.Blocker,
 	coreService *core.Service,
 ) []endpoint {
 	server := &beaconprysm.Server{
@@ -1047,6 +1224,7 @@ func (s *Service) prysmBeaconEndpoints(
 		CanonicalHistory:      ch,
 		BeaconDB:              s.cfg.BeaconDB,
 		Stater:                stater,
+		Blocker:               blocker,
 		ChainInfoFetcher:      s.cfg.ChainInfoFetcher,
 		FinalizationFetcher:   s.cfg.FinalizationFetcher,
 		CoreService:           coreService,
@@ -1083,6 +1261,33 @@ func (s *Service) prysmBeaconEndpoints(
 			handler: server.GetValidatorCount,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/beacon/states/{state_id}/execution_requests",
+			name:     namespace + ".GetExecutionRequests",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetExecutionRequests,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/blocks/{block_id}/children",
+			name:     namespace + ".GetBlockChildren",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBlockChildren,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/blocks/{block_id}/ancestor",
+			name:     namespace + ".GetBlockAncestor",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBlockAncestor,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/prysm/v1/beacon/individual_votes",
 			name:     namespace + ".GetIndividualVotes",
@@ -1115,7 +1320,7 @@ func (s *Service) prysmBeaconEndpoints(
 	}
 }
 
-func (s *Service) prysmNodeEndpoints() []endpoint {
+func (s *Service) prysmNodeServer() *nodeprysm.Server {
 	server := &nodeprysm.Server{
 		BeaconDB:                  s.cfg.BeaconDB,
 		SyncChecker:               s.cfg.SyncService,
@@ -1126,7 +1331,24 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 		MetadataProvider:          s.cfg.MetadataProvider,
 		HeadFetcher:               s.cfg.HeadFetcher,
 		ExecutionChainInfoFetcher: s.cfg.ExecutionChainInfoFetcher,
+		BlockBuilder:              s.cfg.BlockBuilder,
+		SlasherBacklogFetcher:     s.cfg.SlasherBacklogFetcher,
+		DiskUsageFetcher:          s.cfg.DiskUsageFetcher,
+		HealthScoreFetcher:        s.cfg.HealthScoreFetcher,
+		FinalityStallFetcher:      s.cfg.FinalityStallFetcher,
+		OptimisticStatusFetcher:   s.cfg.OptimisticStatusFetcher,
+		ServeOptimisticDuties:     s.cfg.ServeOptimisticDuties,
+		RuntimeOverrides:          s.cfg.RuntimeOverrides,
+	}
+	// Only set the interface when maintenance mode is available, so that it is not a typed nil.
+	if s.cfg.Maintenance != nil {
+		server.MaintenanceController = s.cfg.Maintenance
 	}
+	return server
+}
+
+func (s *Service) prysmNodeEndpoints() []endpoint {
+	server := s.prysmNodeServer()
 
 	const namespace = "prysm.node"
 	return []endpoint{
@@ -1186,14 +1408,155 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 			handler: server.RemoveTrustedPeer,
 			methods: []string{http.MethodDelete},
 		},
+		{
+			template: "/prysm/v1/node/log_levels",
+			name:     namespace + ".GetLogLevels",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetLogLevels,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/log_levels",
+			name:     namespace + ".SetLogLevels",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.SetLogLevels,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/node/health/details",
+			name:     namespace + ".GetHealthDetails",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetHealthDetails,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/health/score",
+			name:     namespace + ".GetHealthScore",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetHealthScore,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/finality_stall",
+			name:     namespace + ".GetFinalityStall",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetFinalityStall,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/optimistic",
+			name:     namespace + ".GetOptimisticStatus",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetOptimisticStatus,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/identity",
+			name:     namespace + ".GetNodeIdentity",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetNodeIdentity,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/disk_usage",
+			name:     namespace + ".GetDiskUsage",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetDiskUsage,
+			methods: []string{http.MethodGet},
+		},
+	}
+}
+
+// prysmNodeAdminEndpoints are the endpoints changing the state of the node. They are not authenticated, so they
+// are only registered when the operator opts in.
+func (s *Service) prysmNodeAdminEndpoints() []endpoint {
+	server := s.prysmNodeServer()
+
+	const namespace = "prysm.node"
+	return []endpoint{
+		{
+			template: "/prysm/v1/node/maintenance",
+			name:     namespace + ".GetMaintenance",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetMaintenance,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/maintenance",
+			name:     namespace + ".EnterMaintenance",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.EnterMaintenance,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/node/runtime_config",
+			name:     namespace + ".GetRuntimeConfig",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetRuntimeConfig,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/runtime_config",
+			name:     namespace + ".SetRuntimeConfig",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.SetRuntimeConfig,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/node/runtime_config/{name}",
+			name:     namespace + ".ResetRuntimeConfig",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ResetRuntimeConfig,
+			methods: []string{http.MethodDelete},
+		},
 	}
 }
 
-func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *core.Service) []endpoint {
+func (s *Service) prysmValidatorEndpoints(
+	validatorServer *validatorv1alpha1.Server,
+	stater lookup.Stater,
+	coreService *core.Service,
+) []endpoint {
 	server := &validatorprysm.Server{
-		ChainInfoFetcher: s.cfg.ChainInfoFetcher,
-		Stater:           stater,
-		CoreService:      coreService,
+		ChainInfoFetcher:      s.cfg.ChainInfoFetcher,
+		TimeFetcher:           s.cfg.GenesisTimeFetcher,
+		Stater:                stater,
+		CoreService:           coreService,
+		SlasherHistoryFetcher: s.cfg.SlasherHistoryFetcher,
+		ValidatorMonitor:      s.cfg.ValidatorMonitor,
+		V1Alpha1Server:        validatorServer,
+		RegistrationHistory:   s.cfg.RegistrationHistory,
+		BalanceArchive:        s.cfg.BalanceArchive,
+		ParticipationFetcher:  s.cfg.ParticipationFetcher,
+		ProposalRecorder:      validatorServer.ProposalRecorder,
 	}
 
 	const namespace = "prysm.validator"
@@ -1227,6 +1590,15 @@ func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *cor
 			handler: server.GetParticipation,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/validators/participation_history",
+			name:     namespace + ".GetParticipationHistory",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetParticipationHistory,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/prysm/v1/validators/active_set_changes",
 			name:     namespace + ".GetActiveSetChanges",
@@ -1236,5 +1608,126 @@ func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *cor
 			handler: server.GetActiveSetChanges,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/validators/{validator_id}/slashing_history",
+			name:     namespace + ".GetSlashingHistory",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetSlashingHistory,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/{validator_id}/execution_requests",
+			name:     namespace + ".GetExecutionRequests",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetExecutionRequests,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/{validator_id}/balance_history",
+			name:     namespace + ".GetBalanceHistory",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBalanceHistory,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/queues",
+			name:     namespace + ".GetValidatorQueues",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetValidatorQueues,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/proposer_lookahead",
+			name:     namespace + ".GetProposerLookahead",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetProposerLookahead,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/committee_assignments/{epoch}",
+			name:     namespace + ".GetCommitteeAssignments",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetCommitteeAssignments,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/validators/monitor",
+			name:     namespace + ".GetMonitoredValidators",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetMonitoredValidators,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/monitor",
+			name:     namespace + ".AddMonitoredValidators",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.AddMonitoredValidators,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/validators/monitor",
+			name:     namespace + ".RemoveMonitoredValidators",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.RemoveMonitoredValidators,
+			methods: []string{http.MethodDelete},
+		},
+		{
+			template: "/prysm/v1/validators/registrations",
+			name:     namespace + ".GetRelayRegistrations",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetRelayRegistrations,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/proposals/report",
+			name:     namespace + ".GetProposalReport",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetProposalReport,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/proposals/export",
+			name:     namespace + ".ExportProposals",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.CsvMediaType}),
+			},
+			handler: server.ExportProposals,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validator/aggregate_and_proof",
+			name:     namespace + ".ProduceAggregateAndProof",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ProduceAggregateAndProof,
+			methods: []string{http.MethodPost},
+		},
 	}
 }
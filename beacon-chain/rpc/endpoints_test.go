@@ -5,6 +5,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"golang.org/x/exp/maps"
 )
@@ -70,12 +71,23 @@ func Test_endpoints(t *testing.T) {
 		"/eth/v1/config/fork_schedule":    {http.MethodGet},
 		"/eth/v1/config/spec":             {http.MethodGet},
 		"/eth/v1/config/deposit_contract": {http.MethodGet},
+		"/prysm/v1/config/resolved":       {http.MethodGet},
 	}
 
 	debugRoutes := map[string][]string{
-		"/eth/v2/debug/beacon/states/{state_id}": {http.MethodGet},
-		"/eth/v2/debug/beacon/heads":             {http.MethodGet},
-		"/eth/v1/debug/fork_choice":              {http.MethodGet},
+		"/eth/v2/debug/beacon/states/{state_id}":         {http.MethodGet},
+		"/eth/v2/debug/beacon/heads":                     {http.MethodGet},
+		"/eth/v1/debug/fork_choice":                      {http.MethodGet},
+		"/prysm/v1/debug/fork_choice/dot":                {http.MethodGet},
+		"/prysm/v1/debug/bad_blocks":                     {http.MethodGet, http.MethodPost},
+		"/prysm/v1/debug/bad_blocks/{block_root}":        {http.MethodDelete},
+		"/prysm/v1/debug/deposits":                       {http.MethodGet},
+		"/prysm/v1/debug/deposits/repair":                {http.MethodPost},
+		"/prysm/v1/debug/states/{block_root}/regenerate": {http.MethodPost},
+		"/prysm/v1/debug/head/snapshot":                  {http.MethodPost},
+		"/prysm/v1/debug/peers":                          {http.MethodGet},
+		"/prysm/v1/debug/peers/{peer_id}":                {http.MethodGet},
+		"/prysm/v1/debug/inclusion_slot":                 {http.MethodGet},
 	}
 
 	eventsRoutes := map[string][]string{
@@ -116,11 +128,14 @@ func Test_endpoints(t *testing.T) {
 	}
 
 	prysmBeaconRoutes := map[string][]string{
-		"/prysm/v1/beacon/weak_subjectivity":                 {http.MethodGet},
-		"/eth/v1/beacon/states/{state_id}/validator_count":   {http.MethodGet},
-		"/prysm/v1/beacon/states/{state_id}/validator_count": {http.MethodGet},
-		"/prysm/v1/beacon/chain_head":                        {http.MethodGet},
-		"/prysm/v1/beacon/blobs":                             {http.MethodPost},
+		"/prysm/v1/beacon/weak_subjectivity":                    {http.MethodGet},
+		"/eth/v1/beacon/states/{state_id}/validator_count":      {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/validator_count":    {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/execution_requests": {http.MethodGet},
+		"/prysm/v1/beacon/blocks/{block_id}/children":           {http.MethodGet},
+		"/prysm/v1/beacon/blocks/{block_id}/ancestor":           {http.MethodGet},
+		"/prysm/v1/beacon/chain_head":                           {http.MethodGet},
+		"/prysm/v1/beacon/blobs":                                {http.MethodPost},
 	}
 
 	prysmNodeRoutes := map[string][]string{
@@ -128,18 +143,48 @@ func Test_endpoints(t *testing.T) {
 		"/prysm/v1/node/trusted_peers":           {http.MethodGet, http.MethodPost},
 		"/prysm/node/trusted_peers/{peer_id}":    {http.MethodDelete},
 		"/prysm/v1/node/trusted_peers/{peer_id}": {http.MethodDelete},
+		"/prysm/v1/node/log_levels":              {http.MethodGet, http.MethodPost},
+		"/prysm/v1/node/health/details":          {http.MethodGet},
+		"/prysm/v1/node/health/score":            {http.MethodGet},
+		"/prysm/v1/node/finality_stall":          {http.MethodGet},
+		"/prysm/v1/node/optimistic":              {http.MethodGet},
+		"/prysm/v1/node/identity":                {http.MethodGet},
+		"/prysm/v1/node/disk_usage":              {http.MethodGet},
+	}
+
+	prysmNodeAdminRoutes := map[string][]string{
+		"/prysm/v1/node/maintenance":           {http.MethodGet, http.MethodPost},
+		"/prysm/v1/node/runtime_config":        {http.MethodGet, http.MethodPost},
+		"/prysm/v1/node/runtime_config/{name}": {http.MethodDelete},
 	}
 
 	prysmValidatorRoutes := map[string][]string{
-		"/prysm/validators/performance":           {http.MethodPost},
-		"/prysm/v1/validators/performance":        {http.MethodPost},
-		"/prysm/v1/validators/participation":      {http.MethodGet},
-		"/prysm/v1/validators/active_set_changes": {http.MethodGet},
+		"/prysm/validators/performance":                          {http.MethodPost},
+		"/prysm/v1/validators/performance":                       {http.MethodPost},
+		"/prysm/v1/validators/participation":                     {http.MethodGet},
+		"/prysm/v1/validators/participation_history":             {http.MethodGet},
+		"/prysm/v1/validators/active_set_changes":                {http.MethodGet},
+		"/prysm/v1/validators/{validator_id}/slashing_history":   {http.MethodGet},
+		"/prysm/v1/validators/{validator_id}/execution_requests": {http.MethodGet},
+		"/prysm/v1/validators/{validator_id}/balance_history":    {http.MethodGet},
+		"/prysm/v1/validators/queues":                            {http.MethodGet},
+		"/prysm/v1/validators/proposer_lookahead":                {http.MethodGet},
+		"/prysm/v1/validators/committee_assignments/{epoch}":     {http.MethodPost},
+		"/prysm/v1/validators/monitor":                           {http.MethodGet, http.MethodPost, http.MethodDelete},
+		"/prysm/v1/validators/registrations":                     {http.MethodGet},
+		"/prysm/v1/validators/proposals/report":                  {http.MethodGet},
+		"/prysm/v1/validators/proposals/export":                  {http.MethodGet},
+		"/prysm/v1/validator/aggregate_and_proof":                {http.MethodPost},
+	}
+
+	slashingApprovalRoutes := map[string][]string{
+		"/prysm/v1/debug/slasher/pending_slashings":                 {http.MethodGet},
+		"/prysm/v1/debug/slasher/pending_slashings/{slashing_root}": {http.MethodPost, http.MethodDelete},
 	}
 
-	s := &Service{cfg: &Config{}}
+	s := &Service{cfg: &Config{SlashingApprover: &slasher.Service{}}}
 
-	endpoints := s.endpoints(true, nil, nil, nil, nil, nil, nil)
+	endpoints := s.endpoints(true, true, nil, nil, nil, nil, nil, nil)
 	actualRoutes := make(map[string][]string, len(endpoints))
 	for _, e := range endpoints {
 		if _, ok := actualRoutes[e.template]; ok {
@@ -148,7 +193,7 @@ func Test_endpoints(t *testing.T) {
 			actualRoutes[e.template] = e.methods
 		}
 	}
-	expectedRoutes := combineMaps(beaconRoutes, builderRoutes, configRoutes, debugRoutes, eventsRoutes, nodeRoutes, validatorRoutes, rewardsRoutes, lightClientRoutes, blobRoutes, prysmValidatorRoutes, prysmNodeRoutes, prysmBeaconRoutes)
+	expectedRoutes := combineMaps(beaconRoutes, builderRoutes, configRoutes, debugRoutes, eventsRoutes, nodeRoutes, validatorRoutes, rewardsRoutes, lightClientRoutes, blobRoutes, prysmValidatorRoutes, prysmNodeRoutes, prysmNodeAdminRoutes, prysmBeaconRoutes, slashingApprovalRoutes)
 
 	assert.Equal(t, true, maps.EqualFunc(expectedRoutes, actualRoutes, func(actualMethods []string, expectedMethods []string) bool {
 		return slices.Equal(expectedMethods, actualMethods)
@@ -28,6 +28,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/stream"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -36,6 +37,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// maxBlockSSZSize is the max size of the SSZ body of a block publishing request: a block of at most the max chunk
+// size of the p2p network, along with its blobs.
+const maxBlockSSZSize = 20 << 20 // 20 MiB
+
 const (
 	broadcastValidationQueryParam               = "broadcast_validation"
 	broadcastValidationConsensus                = "consensus"
@@ -343,7 +348,11 @@ func (s *Server) PublishBlindedBlockV2(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) publishBlindedBlockSSZ(ctx context.Context, w http.ResponseWriter, r *http.Request, versionRequired bool) { // nolint:gocognit
-	body, err := io.ReadAll(r.Body)
+	body, err := readBlockSSZ(r)
+	if errors.Is(err, stream.ErrTooLarge) {
+		httputil.HandleError(w, "Request body is too large: "+err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
 	if err != nil {
 		httputil.HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -680,9 +689,13 @@ func (s *Server) PublishBlockV2(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) publishBlockSSZ(ctx context.Context, w http.ResponseWriter, r *http.Request, versionRequired bool) { // nolint:gocognit
-	body, err := io.ReadAll(r.Body)
+	body, err := readBlockSSZ(r)
+	if errors.Is(err, stream.ErrTooLarge) {
+		httputil.HandleError(w, "Request body is too large: "+err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
 	if err != nil {
-		httputil.HandleError(w, "Could not read request body", http.StatusInternalServerError)
+		httputil.HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 	versionHeader := r.Header.Get(api.VersionHeader)
@@ -852,6 +865,14 @@ func (s *Server) publishBlockSSZ(ctx context.Context, w http.ResponseWriter, r *
 	httputil.HandleError(w, "Body does not represent a valid block type", http.StatusBadRequest)
 }
 
+// readBlockSSZ reads the SSZ body of a block publishing request, rejecting it as soon as it goes over maxBlockSSZSize.
+func readBlockSSZ(r *http.Request) ([]byte, error) {
+	if err := stream.CheckSize(r.ContentLength, maxBlockSSZSize); err != nil {
+		return nil, err
+	}
+	return stream.ReadAll(r.Body, maxBlockSSZSize)
+}
+
 func (s *Server) publishBlock(ctx context.Context, w http.ResponseWriter, r *http.Request, versionRequired bool) { // nolint:gocognit
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -1618,6 +1619,31 @@ func TestPublishBlockSSZ(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, writer.Code)
 		assert.StringContains(t, fmt.Sprintf("Could not decode request body into %s consensus block", version.String(version.Capella)), writer.Body.String())
 	})
+	t.Run("too large", func(t *testing.T) {
+		server := &Server{
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+		}
+
+		// The length of the body is not declared, so it is rejected while being read.
+		body := io.MultiReader(bytes.NewReader(make([]byte, maxBlockSSZSize+1)))
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", body)
+		request.Header.Set("Content-Type", api.OctetStreamMediaType)
+		request.Header.Set(api.VersionHeader, version.String(version.Bellatrix))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.PublishBlock(writer, request)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, writer.Code)
+
+		// The declared length of the body is rejected before reading it.
+		request = httptest.NewRequest(http.MethodPost, "http://foo.example", bytes.NewReader([]byte("foo")))
+		request.ContentLength = maxBlockSSZSize + 1
+		request.Header.Set("Content-Type", api.OctetStreamMediaType)
+		request.Header.Set(api.VersionHeader, version.String(version.Bellatrix))
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.PublishBlock(writer, request)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, writer.Code)
+	})
 	t.Run("syncing", func(t *testing.T) {
 		chainService := &chainMock.ChainService{}
 		server := &Server{
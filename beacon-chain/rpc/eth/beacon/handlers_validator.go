@@ -124,7 +124,7 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 			ExecutionOptimistic: isOptimistic,
 			Finalized:           isFinalized,
 		}
-		httputil.WriteJson(w, resp)
+		httputil.WriteJsonStream(w, resp)
 		return
 	}
 
@@ -170,7 +170,7 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
 	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteJsonStream(w, resp)
 }
 
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
@@ -321,7 +321,7 @@ func (s *Server) GetValidatorBalances(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
 	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteJsonStream(w, resp)
 }
 
 // decodeIds takes in a list of validator ID strings (as either a pubkey or a validator index)
@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	field_params "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
@@ -50,13 +49,9 @@ func (s *Server) Blobs(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Sidecars are large, so the response is streamed one sidecar at a time rather than encoded as a whole.
 	if httputil.RespondWithSsz(r) {
-		sszResp, err := buildSidecarsSSZResponse(verifiedBlobs)
-		if err != nil {
-			httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		httputil.WriteSsz(w, sszResp, "blob_sidecars.ssz")
+		httputil.WriteSszStream(w, verifiedBlobs, "blob_sidecars.ssz")
 		return
 	}
 
@@ -83,7 +78,7 @@ func (s *Server) Blobs(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, blkRoot),
 	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteJsonStream(w, resp)
 }
 
 // parseIndices filters out invalid and duplicate blob indices
@@ -134,15 +129,3 @@ func buildSidecarsJsonResponse(verifiedBlobs []*blocks.VerifiedROBlob) []*struct
 	}
 	return sidecars
 }
-
-func buildSidecarsSSZResponse(verifiedBlobs []*blocks.VerifiedROBlob) ([]byte, error) {
-	ssz := make([]byte, field_params.BlobSidecarSize*len(verifiedBlobs))
-	for i, sidecar := range verifiedBlobs {
-		sszrep, err := sidecar.MarshalSSZ()
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal sidecar ssz")
-		}
-		copy(ssz[i*field_params.BlobSidecarSize:(i+1)*field_params.BlobSidecarSize], sszrep)
-	}
-	return ssz, nil
-}
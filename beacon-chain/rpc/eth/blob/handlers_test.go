@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -367,6 +368,34 @@ func TestBlobs(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, writer.Code)
 		require.Equal(t, len(writer.Body.Bytes()), fieldparams.BlobSidecarSize*4) // size of each sidecar
+		assert.Equal(t, strconv.Itoa(fieldparams.BlobSidecarSize*4), writer.Header().Get("Content-Length"))
+		var sidecar eth.BlobSidecar
+		require.NoError(t, sidecar.UnmarshalSSZ(writer.Body.Bytes()[fieldparams.BlobSidecarSize*3:]))
+		assert.Equal(t, uint64(3), sidecar.Index)
+	})
+	t.Run("ssz with indices", func(t *testing.T) {
+		u := "http://foo.example/finalized?indices=1&indices=3"
+		request := httptest.NewRequest("GET", u, nil)
+		request.Header.Add("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.Blocker = &lookup.BeaconDbBlocker{
+			ChainInfoFetcher: &mockChain.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Root: blockRoot[:]}},
+			GenesisTimeFetcher: &testutil.MockGenesisTimeFetcher{
+				Genesis: time.Now(),
+			},
+			BeaconDB:    db,
+			BlobStorage: bs,
+		}
+		s.Blobs(writer, request)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, len(writer.Body.Bytes()), fieldparams.BlobSidecarSize*2)
+		var sidecar eth.BlobSidecar
+		require.NoError(t, sidecar.UnmarshalSSZ(writer.Body.Bytes()[:fieldparams.BlobSidecarSize]))
+		assert.Equal(t, uint64(1), sidecar.Index)
+		require.NoError(t, sidecar.UnmarshalSSZ(writer.Body.Bytes()[fieldparams.BlobSidecarSize:]))
+		assert.Equal(t, uint64(3), sidecar.Index)
 	})
 }
 
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
@@ -72,7 +74,7 @@ func GetSpec(w http.ResponseWriter, r *http.Request) {
 	_, span := trace.StartSpan(r.Context(), "config.GetSpec")
 	defer span.End()
 
-	data, err := prepareConfigSpec()
+	data, err := prepareConfigSpec(params.BeaconConfig())
 	if err != nil {
 		httputil.HandleError(w, "Could not prepare config spec: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -80,9 +82,64 @@ func GetSpec(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, &structs.GetSpecResponse{Data: data})
 }
 
-func prepareConfigSpec() (map[string]string, error) {
+// GetResolvedConfig retrieves the configuration used on this node once the config file and the flags are applied,
+// along with the specification params which differ from the built-in mainnet configuration and the features which
+// are enabled, so that the overrides of a network can be checked.
+func GetResolvedConfig(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "config.GetResolvedConfig")
+	defer span.End()
+
+	spec, err := prepareConfigSpec(params.BeaconConfig())
+	if err != nil {
+		httputil.HandleError(w, "Could not prepare config spec: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	mainnetSpec, err := prepareConfigSpec(params.MainnetConfig())
+	if err != nil {
+		httputil.HandleError(w, "Could not prepare mainnet config spec: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetResolvedConfigResponse{
+		Data: &structs.ResolvedConfig{
+			ConfigName:  params.BeaconConfig().ConfigName,
+			Spec:        spec,
+			MainnetDiff: specDiff(spec, mainnetSpec),
+			Features:    enabledFeatures(features.Get()),
+		},
+	})
+}
+
+// specDiff returns the params which differ between the spec and the mainnet spec, sorted by name.
+func specDiff(spec, mainnetSpec map[string]string) []*structs.ConfigDiff {
+	diff := make([]*structs.ConfigDiff, 0)
+	for name, value := range spec {
+		if mainnetValue, ok := mainnetSpec[name]; !ok || mainnetValue != value {
+			diff = append(diff, &structs.ConfigDiff{Name: name, Value: value, MainnetValue: mainnetValue})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].Name < diff[j].Name
+	})
+	return diff
+}
+
+// enabledFeatures returns the features which are not set to their zero value, by name.
+func enabledFeatures(flags *features.Flags) map[string]string {
+	enabled := make(map[string]string)
+	v := reflect.ValueOf(flags).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() || v.Field(i).IsZero() {
+			continue
+		}
+		enabled[t.Field(i).Name] = fmt.Sprintf("%v", v.Field(i).Interface())
+	}
+	return enabled
+}
+
+func prepareConfigSpec(c *params.BeaconChainConfig) (map[string]string, error) {
 	data := make(map[string]string)
-	config := *params.BeaconConfig()
+	config := *c
 	t := reflect.TypeOf(config)
 	v := reflect.ValueOf(config)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
@@ -434,7 +435,7 @@ func TestGetSpec(t *testing.T) {
 				assert.Equal(t, "76", v)
 			case "REORG_MAX_EPOCHS_SINCE_FINALIZATION":
 				assert.Equal(t, "2", v)
-			case "REORG_WEIGHT_THRESHOLD":
+			case "REORG_HEAD_WEIGHT_THRESHOLD":
 				assert.Equal(t, "20", v)
 			case "REORG_PARENT_WEIGHT_THRESHOLD":
 				assert.Equal(t, "160", v)
@@ -589,3 +590,32 @@ func TestForkSchedule_Ok(t *testing.T) {
 		assert.Equal(t, os.Len(), len(resp.Data))
 	})
 }
+
+func TestGetResolvedConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.MainnetConfig().Copy()
+	config.ConfigName = "devnet"
+	config.ElectraForkEpoch = 100
+	params.OverrideBeaconConfig(config)
+	resetFeatures := features.InitWithReset(&features.Flags{EnableLightClient: true})
+	defer resetFeatures()
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/config/resolved", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	GetResolvedConfig(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetResolvedConfigResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "devnet", resp.Data.ConfigName)
+	assert.Equal(t, "100", resp.Data.Spec["ELECTRA_FORK_EPOCH"])
+	require.Equal(t, 2, len(resp.Data.MainnetDiff))
+	assert.DeepEqual(t, &structs.ConfigDiff{Name: "CONFIG_NAME", Value: "devnet", MainnetValue: params.MainnetName}, resp.Data.MainnetDiff[0])
+	assert.DeepEqual(t, &structs.ConfigDiff{
+		Name:         "ELECTRA_FORK_EPOCH",
+		Value:        "100",
+		MainnetValue: fmt.Sprintf("%d", params.MainnetConfig().ElectraForkEpoch),
+	}, resp.Data.MainnetDiff[1])
+	assert.DeepEqual(t, map[string]string{"EnableLightClient": "true"}, resp.Data.Features)
+}
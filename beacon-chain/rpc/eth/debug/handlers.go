@@ -4,15 +4,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
@@ -30,7 +38,7 @@ func (s *Server) GetBeaconStateV2(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if httputil.RespondWithSsz(r) {
-		s.getBeaconStateSSZV2(ctx, w, []byte(stateId))
+		s.getBeaconStateSSZV2(ctx, w, r, []byte(stateId))
 	} else {
 		s.getBeaconStateV2(ctx, w, []byte(stateId))
 	}
@@ -99,24 +107,30 @@ func (s *Server) getBeaconStateV2(ctx context.Context, w http.ResponseWriter, id
 		return
 	}
 
-	jsonBytes, err := json.Marshal(respSt)
-	if err != nil {
-		httputil.HandleError(w, "Could not marshal state into JSON: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
 	ver := version.String(st.Version())
-	resp := &structs.GetBeaconStateV2Response{
+	resp := &beaconStateV2Response{
 		Version:             ver,
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
-		Data:                jsonBytes,
+		Data:                respSt,
 	}
 	w.Header().Set(api.VersionHeader, ver)
-	httputil.WriteJson(w, resp)
+	httputil.WriteJsonStream(w, resp)
+}
+
+// beaconStateV2Response is the same message as structs.GetBeaconStateV2Response, but holds the state
+// unencoded so that it is streamed to the response instead of being encoded in memory first.
+type beaconStateV2Response struct {
+	Version             string      `json:"version"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+	Finalized           bool        `json:"finalized"`
+	Data                interface{} `json:"data"`
 }
 
 // getBeaconStateSSZV2 returns the SSZ-serialized version of the full beacon state object for given state ID.
-func (s *Server) getBeaconStateSSZV2(ctx context.Context, w http.ResponseWriter, id []byte) {
+// The state is compressed with zstd or snappy when accepted by the request, and a byte range of it can be requested
+// to resume a failed download, as long as the state root did not change.
+func (s *Server) getBeaconStateSSZV2(ctx context.Context, w http.ResponseWriter, r *http.Request, id []byte) {
 	st, err := s.Stater.State(ctx, id)
 	if err != nil {
 		shared.WriteStateFetchError(w, err)
@@ -127,8 +141,13 @@ func (s *Server) getBeaconStateSSZV2(ctx context.Context, w http.ResponseWriter,
 		httputil.HandleError(w, "Could not marshal state into SSZ: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not calculate state root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 	w.Header().Set(api.VersionHeader, version.String(st.Version()))
-	httputil.WriteSsz(w, sszState, "beacon_state.ssz")
+	httputil.WriteSszContent(w, r, sszState, "beacon_state.ssz", hexutil.Encode(root[:]))
 }
 
 // GetForkChoiceHeadsV2 retrieves the leaves of the current fork choice tree.
@@ -201,3 +220,295 @@ func (s *Server) GetForkChoice(w http.ResponseWriter, r *http.Request) {
 	}
 	httputil.WriteJson(w, resp)
 }
+
+// GetForkChoiceDOT returns the fork choice store as a Graphviz DOT graph, with node weights, optimistic
+// status and invalid nodes marked.
+func (s *Server) GetForkChoiceDOT(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoiceDOT")
+	defer span.End()
+
+	dump, err := s.ForkchoiceFetcher.ForkChoiceDump(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get forkchoice dump: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteRaw(w, api.GraphvizMediaType, []byte(dump.DOT()))
+}
+
+// ListBadBlocks returns the blocks the node currently considers invalid, along with the reason they were marked.
+func (s *Server) ListBadBlocks(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "debug.ListBadBlocks")
+	defer span.End()
+
+	entries := s.BadBlockCache.Entries()
+	data := make([]*structs.BadBlock, len(entries))
+	for i, e := range entries {
+		data[i] = &structs.BadBlock{
+			BlockRoot: hexutil.Encode(e.Root[:]),
+			Reason:    string(e.Reason),
+			MarkedAt:  fmt.Sprintf("%d", e.MarkedAt.Unix()),
+		}
+	}
+	httputil.WriteJson(w, &structs.BadBlocksResponse{Data: data})
+}
+
+// AddBadBlock manually marks a block as invalid, so that it and its descendants are rejected.
+func (s *Server) AddBadBlock(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "debug.AddBadBlock")
+	defer span.End()
+
+	var req structs.AddBadBlockRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	root, valid := shared.ValidateHex(w, "block_root", req.BlockRoot, fieldparams.RootLength)
+	if !valid {
+		return
+	}
+	s.BadBlockCache.Add(bytesutil.ToBytes32(root), cache.BadBlockManual)
+	w.WriteHeader(http.StatusOK)
+}
+
+// RemoveBadBlock clears a block from the set of invalid blocks, e.g. after a spurious invalidation by the
+// execution client, so that it can be imported again.
+func (s *Server) RemoveBadBlock(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "debug.RemoveBadBlock")
+	defer span.End()
+
+	_, root, valid := shared.HexFromRoute(w, r, "block_root", fieldparams.RootLength)
+	if !valid {
+		return
+	}
+	if !s.BadBlockCache.Remove(bytesutil.ToBytes32(root)) {
+		httputil.HandleError(w, "Block is not marked as bad", http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// ListPendingSlashings returns the slashings detected by the slasher which are waiting for an operator
+// decision, when the slasher runs with the approve policy.
+func (s *Server) ListPendingSlashings(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "debug.ListPendingSlashings")
+	defer span.End()
+
+	if s.SlashingApprover == nil {
+		httputil.HandleError(w, "Slasher is not enabled on this node", http.StatusServiceUnavailable)
+		return
+	}
+
+	pending := s.SlashingApprover.PendingSlashings()
+	data := make([]*structs.PendingSlashing, len(pending))
+	for i, p := range pending {
+		var slashing interface{}
+		kind := string(slasher.AttesterOffense)
+		switch {
+		case p.ProposerSlashing != nil:
+			kind = string(slasher.ProposerOffense)
+			slashing = structs.ProposerSlashingFromConsensus(p.ProposerSlashing)
+		case p.AttesterSlashing.Version() >= version.Electra:
+			as, ok := p.AttesterSlashing.(*eth.AttesterSlashingElectra)
+			if !ok {
+				httputil.HandleError(w, fmt.Sprintf("Unable to convert slashing of type %T", p.AttesterSlashing), http.StatusInternalServerError)
+				return
+			}
+			slashing = structs.AttesterSlashingElectraFromConsensus(as)
+		default:
+			as, ok := p.AttesterSlashing.(*eth.AttesterSlashing)
+			if !ok {
+				httputil.HandleError(w, fmt.Sprintf("Unable to convert slashing of type %T", p.AttesterSlashing), http.StatusInternalServerError)
+				return
+			}
+			slashing = structs.AttesterSlashingFromConsensus(as)
+		}
+		raw, err := json.Marshal(slashing)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal slashing: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		data[i] = &structs.PendingSlashing{
+			SlashingRoot: hexutil.Encode(p.Root[:]),
+			Kind:         kind,
+			Slashing:     raw,
+			DetectedAt:   p.DetectedAt.UTC().Format(time.RFC3339),
+		}
+	}
+	httputil.WriteJson(w, &structs.PendingSlashingsResponse{Data: data})
+}
+
+// ApprovePendingSlashing submits a pending slashing to the operations pool.
+func (s *Server) ApprovePendingSlashing(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.ApprovePendingSlashing")
+	defer span.End()
+
+	if s.SlashingApprover == nil {
+		httputil.HandleError(w, "Slasher is not enabled on this node", http.StatusServiceUnavailable)
+		return
+	}
+	_, root, valid := shared.HexFromRoute(w, r, "slashing_root", fieldparams.RootLength)
+	if !valid {
+		return
+	}
+	if err := s.SlashingApprover.ApproveSlashing(ctx, bytesutil.ToBytes32(root)); err != nil {
+		if errors.Is(err, slasher.ErrPendingSlashingNotFound) {
+			httputil.HandleError(w, "Slashing is not pending", http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not approve slashing: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// RejectPendingSlashing drops a pending slashing.
+func (s *Server) RejectPendingSlashing(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "debug.RejectPendingSlashing")
+	defer span.End()
+
+	if s.SlashingApprover == nil {
+		httputil.HandleError(w, "Slasher is not enabled on this node", http.StatusServiceUnavailable)
+		return
+	}
+	_, root, valid := shared.HexFromRoute(w, r, "slashing_root", fieldparams.RootLength)
+	if !valid {
+		return
+	}
+	if err := s.SlashingApprover.RejectSlashing(bytesutil.ToBytes32(root)); err != nil {
+		if errors.Is(err, slasher.ErrPendingSlashingNotFound) {
+			httputil.HandleError(w, "Slashing is not pending", http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not reject slashing: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// CheckDeposits compares the deposit cache of the node with the eth1 data of the head state.
+func (s *Server) CheckDeposits(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.CheckDeposits")
+	defer span.End()
+
+	if s.DepositRepairer == nil {
+		httputil.HandleError(w, "Deposit repair is not available", http.StatusServiceUnavailable)
+		return
+	}
+	st, err := s.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	check, err := s.DepositRepairer.CheckDeposits(ctx, st.Eth1Data())
+	if err != nil {
+		httputil.HandleError(w, "Could not check deposits: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.DepositCheckResponse{
+		Data: &structs.DepositCheck{
+			Consistent:        check.Consistent(),
+			DepositCount:      fmt.Sprintf("%d", check.DepositCount),
+			TreeDepositCount:  fmt.Sprintf("%d", check.TreeDepositCount),
+			StateDepositCount: fmt.Sprintf("%d", check.StateDepositCount),
+			LastBlock:         fmt.Sprintf("%d", check.LastBlock),
+			Problems:          check.Problems,
+		},
+	})
+}
+
+// RepairDeposits re-scans the deposit logs of a range of execution blocks and repairs the deposit cache of the
+// node from them, instead of requiring a full resync when the cache is corrupted.
+func (s *Server) RepairDeposits(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.RepairDeposits")
+	defer span.End()
+
+	if s.DepositRepairer == nil {
+		httputil.HandleError(w, "Deposit repair is not available", http.StatusServiceUnavailable)
+		return
+	}
+	var req structs.RepairDepositsRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	fromBlock, valid := shared.ValidateUint(w, "from_block", req.FromBlock)
+	if !valid {
+		return
+	}
+	toBlock, valid := shared.ValidateUint(w, "to_block", req.ToBlock)
+	if !valid {
+		return
+	}
+	if fromBlock > toBlock {
+		httputil.HandleError(w, "from_block must not be after to_block", http.StatusBadRequest)
+		return
+	}
+	repair, err := s.DepositRepairer.RepairDeposits(ctx, fromBlock, toBlock)
+	if err != nil {
+		httputil.HandleError(w, "Could not repair deposits: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.RepairDepositsResponse{
+		Data: &structs.DepositRepair{
+			FromBlock:       fmt.Sprintf("%d", repair.FromBlock),
+			ToBlock:         fmt.Sprintf("%d", repair.ToBlock),
+			LogsScanned:     fmt.Sprintf("%d", repair.LogsScanned),
+			RemovedDeposits: fmt.Sprintf("%d", repair.RemovedDeposits),
+			AddedDeposits:   fmt.Sprintf("%d", repair.AddedDeposits),
+			DepositCount:    fmt.Sprintf("%d", repair.DepositCount),
+		},
+	})
+}
+
+// RegenerateState forces the regeneration of the state of a block, bypassing the state caches, and replaces every
+// copy of the state kept by the node with the regenerated one, to recover from a corrupted cache without restarting
+// or resyncing the node.
+func (s *Server) RegenerateState(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.RegenerateState")
+	defer span.End()
+
+	if s.StateRegenerator == nil {
+		httputil.HandleError(w, "State regeneration is not available", http.StatusServiceUnavailable)
+		return
+	}
+	_, root, valid := shared.HexFromRoute(w, r, "block_root", fieldparams.RootLength)
+	if !valid {
+		return
+	}
+	blockRoot := bytesutil.ToBytes32(root)
+	if !s.BeaconDB.HasBlock(ctx, blockRoot) {
+		httputil.HandleError(w, "Block not found", http.StatusNotFound)
+		return
+	}
+	regen, err := s.StateRegenerator.RegenerateState(ctx, blockRoot)
+	if err != nil {
+		httputil.HandleError(w, "Could not regenerate state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	replaced := make([]string, len(regen.Replaced))
+	copy(replaced, regen.Replaced)
+	corrupted := make([]string, len(regen.Corrupted))
+	copy(corrupted, regen.Corrupted)
+	httputil.WriteJson(w, &structs.RegenerateStateResponse{
+		Data: &structs.StateRegeneration{
+			BlockRoot:      hexutil.Encode(regen.BlockRoot[:]),
+			Slot:           fmt.Sprintf("%d", regen.Slot),
+			StateRoot:      hexutil.Encode(regen.StateRoot[:]),
+			BaseRoot:       hexutil.Encode(regen.BaseRoot[:]),
+			BaseSlot:       fmt.Sprintf("%d", regen.BaseSlot),
+			ReplayedBlocks: fmt.Sprintf("%d", regen.ReplayedBlocks),
+			Replaced:       replaced,
+			Corrupted:      corrupted,
+		},
+	})
+}
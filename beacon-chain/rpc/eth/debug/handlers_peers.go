@@ -0,0 +1,152 @@
+package debug
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPeers returns the debugging data of all the peers known to the node, connected or not.
+func (s *Server) ListPeers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.ListPeers")
+	defer span.End()
+
+	resp, err := s.V1Alpha1Server.ListPeers(ctx, &empty.Empty{})
+	if err != nil {
+		handleV1Alpha1Error(w, "Could not list peers", err)
+		return
+	}
+	data := make([]*structs.DebugPeer, len(resp.Responses))
+	for i, p := range resp.Responses {
+		data[i] = debugPeerFromConsensus(p)
+	}
+	httputil.WriteJson(w, &structs.DebugPeersResponse{Data: data})
+}
+
+// GetPeer returns the debugging data of a peer.
+func (s *Server) GetPeer(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetPeer")
+	defer span.End()
+
+	peerId := r.PathValue("peer_id")
+	if peerId == "" {
+		httputil.HandleError(w, "peer_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	resp, err := s.V1Alpha1Server.GetPeer(ctx, &eth.PeerRequest{PeerId: peerId})
+	if err != nil {
+		handleV1Alpha1Error(w, "Could not get peer", err)
+		return
+	}
+	httputil.WriteJson(w, &structs.DebugPeerResponse{Data: debugPeerFromConsensus(resp)})
+}
+
+// GetInclusionSlot returns the slot of the block which included the attestation of a validator for a slot.
+func (s *Server) GetInclusionSlot(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetInclusionSlot")
+	defer span.End()
+
+	rawIndex, index, ok := shared.UintFromQuery(w, r, "validator_index", true)
+	if !ok {
+		return
+	}
+	rawSlot, slot, ok := shared.UintFromQuery(w, r, "slot", true)
+	if !ok {
+		return
+	}
+	resp, err := s.V1Alpha1Server.GetInclusionSlot(ctx, &eth.InclusionSlotRequest{Id: index, Slot: primitives.Slot(slot)})
+	if err != nil {
+		handleV1Alpha1Error(w, "Could not get inclusion slot", err)
+		return
+	}
+	data := &structs.InclusionSlot{ValidatorIndex: rawIndex, Slot: rawSlot}
+	// The inclusion slot is the max slot when the attestation is not in the blocks of the inclusion window.
+	if resp.Slot != primitives.Slot(math.MaxUint64) {
+		data.Included = true
+		data.InclusionSlot = fmt.Sprintf("%d", resp.Slot)
+	}
+	httputil.WriteJson(w, &structs.InclusionSlotResponse{Data: data})
+}
+
+func handleV1Alpha1Error(w http.ResponseWriter, msg string, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.NotFound:
+		code = http.StatusNotFound
+	}
+	httputil.HandleError(w, msg+": "+status.Convert(err).Message(), code)
+}
+
+func debugPeerFromConsensus(p *eth.DebugPeerResponse) *structs.DebugPeer {
+	peer := &structs.DebugPeer{
+		PeerId:             p.PeerId,
+		Enr:                p.Enr,
+		ListeningAddresses: p.ListeningAddresses,
+		Direction:          strings.ToLower(p.Direction.String()),
+		State:              strings.ToLower(p.ConnectionState.String()),
+		StatusUpdatedAt:    fmt.Sprintf("%d", p.LastUpdated),
+	}
+	if info := p.PeerInfo; info != nil {
+		peer.AgentVersion = info.AgentVersion
+		peer.ProtocolVersion = info.ProtocolVersion
+		peer.Protocols = info.Protocols
+		peer.FaultCount = fmt.Sprintf("%d", info.FaultCount)
+		peer.LatencyMs = fmt.Sprintf("%d", info.PeerLatency)
+		switch {
+		case info.MetadataV1 != nil:
+			peer.Metadata = &structs.DebugPeerMeta{
+				SeqNumber: fmt.Sprintf("%d", info.MetadataV1.SeqNumber),
+				Attnets:   hexutil.Encode(info.MetadataV1.Attnets),
+				Syncnets:  hexutil.Encode(info.MetadataV1.Syncnets),
+			}
+		case info.MetadataV0 != nil:
+			peer.Metadata = &structs.DebugPeerMeta{
+				SeqNumber: fmt.Sprintf("%d", info.MetadataV0.SeqNumber),
+				Attnets:   hexutil.Encode(info.MetadataV0.Attnets),
+			}
+		}
+	}
+	if st := p.PeerStatus; st != nil {
+		peer.Status = &structs.DebugPeerChain{
+			ForkDigest:     hexutil.Encode(st.ForkDigest),
+			FinalizedRoot:  hexutil.Encode(st.FinalizedRoot),
+			FinalizedEpoch: fmt.Sprintf("%d", st.FinalizedEpoch),
+			HeadRoot:       hexutil.Encode(st.HeadRoot),
+			HeadSlot:       fmt.Sprintf("%d", st.HeadSlot),
+		}
+	}
+	if score := p.ScoreInfo; score != nil {
+		peer.Score = &structs.DebugPeerScore{
+			Overall:          fmt.Sprintf("%f", score.OverallScore),
+			ProcessedBlocks:  fmt.Sprintf("%d", score.ProcessedBlocks),
+			BlockProvider:    fmt.Sprintf("%f", score.BlockProviderScore),
+			Gossip:           fmt.Sprintf("%f", score.GossipScore),
+			BehaviourPenalty: fmt.Sprintf("%f", score.BehaviourPenalty),
+			ValidationError:  score.ValidationError,
+			Topics:           make(map[string]*structs.DebugTopicScore, len(score.TopicScores)),
+		}
+		for topic, ts := range score.TopicScores {
+			peer.Score.Topics[topic] = &structs.DebugTopicScore{
+				TimeInMesh:               fmt.Sprintf("%d", ts.TimeInMesh),
+				FirstMessageDeliveries:   fmt.Sprintf("%f", ts.FirstMessageDeliveries),
+				MeshMessageDeliveries:    fmt.Sprintf("%f", ts.MeshMessageDeliveries),
+				InvalidMessageDeliveries: fmt.Sprintf("%f", ts.InvalidMessageDeliveries),
+			}
+		}
+	}
+	return peer
+}
@@ -0,0 +1,72 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	debugv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/debug"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestPeers(t *testing.T) {
+	peersProvider := &mockp2p.MockPeersProvider{}
+	mP2P := mockp2p.NewTestP2P(t)
+	s := &Server{
+		V1Alpha1Server: &debugv1alpha1.Server{
+			PeersFetcher: peersProvider,
+			PeerManager:  &mockp2p.MockPeerManager{BHost: mP2P.BHost},
+		},
+	}
+	firstPeer := peersProvider.Peers().All()[0]
+
+	t.Run("list", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/peers", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListPeers(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.DebugPeersResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 2, len(resp.Data))
+	})
+	t.Run("get", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/peers/{peer_id}", nil)
+		request.SetPathValue("peer_id", firstPeer.String())
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPeer(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.DebugPeerResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, firstPeer.String(), resp.Data.PeerId)
+		assert.Equal(t, "inbound", resp.Data.Direction)
+		assert.Equal(t, "connected", resp.Data.State)
+	})
+	t.Run("get invalid peer", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/peers/{peer_id}", nil)
+		request.SetPathValue("peer_id", "foo")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPeer(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetInclusionSlot_MissingSlot(t *testing.T) {
+	s := &Server{V1Alpha1Server: &debugv1alpha1.Server{}}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/inclusion_slot?validator_index=1", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetInclusionSlot(writer, request)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+}
@@ -7,16 +7,22 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	blockchainmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -293,6 +299,9 @@ func TestGetBeaconStateSSZV2(t *testing.T) {
 		sszExpected, err := fakeState.MarshalSSZ()
 		require.NoError(t, err)
 		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+		root, err := fakeState.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, `"`+hexutil.Encode(root[:])+`"`, writer.Header().Get("Etag"))
 	})
 	t.Run("Altair", func(t *testing.T) {
 		fakeState, err := util.NewBeaconStateAltair()
@@ -486,3 +495,335 @@ func TestGetForkChoice(t *testing.T) {
 	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 	require.Equal(t, "2", resp.FinalizedCheckpoint.Epoch)
 }
+
+func TestGetForkChoiceDOT(t *testing.T) {
+	store := doublylinkedtree.New()
+	fRoot := [32]byte{'a'}
+	fc := &forkchoicetypes.Checkpoint{Epoch: 2, Root: fRoot}
+	require.NoError(t, store.UpdateFinalizedCheckpoint(fc))
+	s := &Server{ForkchoiceFetcher: &blockchainmock.ChainService{ForkChoiceStore: store}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/fork_choice/dot", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetForkChoiceDOT(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, api.GraphvizMediaType, writer.Header().Get("Content-Type"))
+	assert.StringContains(t, "digraph forkchoice {", writer.Body.String())
+}
+
+func TestBadBlocks(t *testing.T) {
+	c := cache.NewBadBlockCache(10)
+	elRoot := [32]byte{'a'}
+	c.Add(elRoot, cache.BadBlockExecutionInvalid)
+	s := &Server{BadBlockCache: c}
+
+	t.Run("add", func(t *testing.T) {
+		body := `{"block_root":"` + hexutil.Encode(bytesutil.PadTo([]byte{'b'}, 32)) + `"}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/bad_blocks", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.AddBadBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, true, c.Has([32]byte{'b'}))
+	})
+	t.Run("add invalid root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/bad_blocks", bytes.NewBufferString(`{"block_root":"0x01"}`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.AddBadBlock(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("list", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/bad_blocks", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListBadBlocks(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.BadBlocksResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, string(cache.BadBlockManual), resp.Data[0].Reason)
+		assert.Equal(t, hexutil.Encode(elRoot[:]), resp.Data[1].BlockRoot)
+		assert.Equal(t, string(cache.BadBlockExecutionInvalid), resp.Data[1].Reason)
+	})
+	t.Run("remove", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/prysm/v1/debug/bad_blocks/{block_root}", nil)
+		request.SetPathValue("block_root", hexutil.Encode(elRoot[:]))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.RemoveBadBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, false, c.Has(elRoot))
+
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.RemoveBadBlock(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+type mockSlashingApprover struct {
+	pending  []slasher.PendingSlashing
+	approved [][32]byte
+}
+
+func (m *mockSlashingApprover) PendingSlashings() []slasher.PendingSlashing {
+	return m.pending
+}
+
+func (m *mockSlashingApprover) ApproveSlashing(_ context.Context, root [32]byte) error {
+	return m.take(root, &m.approved)
+}
+
+func (m *mockSlashingApprover) RejectSlashing(root [32]byte) error {
+	return m.take(root, nil)
+}
+
+func (m *mockSlashingApprover) take(root [32]byte, taken *[][32]byte) error {
+	for i, p := range m.pending {
+		if p.Root == root {
+			m.pending = append(m.pending[:i], m.pending[i+1:]...)
+			if taken != nil {
+				*taken = append(*taken, root)
+			}
+			return nil
+		}
+	}
+	return slasher.ErrPendingSlashingNotFound
+}
+
+func TestPendingSlashings(t *testing.T) {
+	attRoot, propRoot := [32]byte{'a'}, [32]byte{'p'}
+	approver := &mockSlashingApprover{pending: []slasher.PendingSlashing{
+		{
+			Root: attRoot,
+			AttesterSlashing: &eth.AttesterSlashing{
+				Attestation_1: util.HydrateIndexedAttestation(&eth.IndexedAttestation{}),
+				Attestation_2: util.HydrateIndexedAttestation(&eth.IndexedAttestation{}),
+			},
+			DetectedAt: time.Unix(10, 0),
+		},
+		{
+			Root: propRoot,
+			ProposerSlashing: &eth.ProposerSlashing{
+				Header_1: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{}),
+				Header_2: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{}),
+			},
+			DetectedAt: time.Unix(20, 0),
+		},
+	}}
+	s := &Server{SlashingApprover: approver}
+
+	t.Run("list", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/slasher/pending_slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListPendingSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.PendingSlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, hexutil.Encode(attRoot[:]), resp.Data[0].SlashingRoot)
+		assert.Equal(t, "attester", resp.Data[0].Kind)
+		assert.Equal(t, time.Unix(10, 0).UTC().Format(time.RFC3339), resp.Data[0].DetectedAt)
+		attSlashing := &structs.AttesterSlashing{}
+		require.NoError(t, json.Unmarshal(resp.Data[0].Slashing, attSlashing))
+		require.NotNil(t, attSlashing.Attestation1)
+		assert.Equal(t, "proposer", resp.Data[1].Kind)
+		propSlashing := &structs.ProposerSlashing{}
+		require.NoError(t, json.Unmarshal(resp.Data[1].Slashing, propSlashing))
+		require.NotNil(t, propSlashing.SignedHeader1)
+	})
+	t.Run("approve", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/slasher/pending_slashings/{slashing_root}", nil)
+		request.SetPathValue("slashing_root", hexutil.Encode(attRoot[:]))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ApprovePendingSlashing(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.DeepEqual(t, [][32]byte{attRoot}, approver.approved)
+
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ApprovePendingSlashing(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("reject", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/prysm/v1/debug/slasher/pending_slashings/{slashing_root}", nil)
+		request.SetPathValue("slashing_root", hexutil.Encode(propRoot[:]))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.RejectPendingSlashing(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, 0, len(approver.pending))
+		require.Equal(t, 1, len(approver.approved))
+	})
+	t.Run("slasher disabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/slasher/pending_slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{}).ListPendingSlashings(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
+
+type mockDepositRepairer struct {
+	eth1Data  *eth.Eth1Data
+	fromBlock uint64
+	toBlock   uint64
+}
+
+func (m *mockDepositRepairer) CheckDeposits(_ context.Context, eth1Data *eth.Eth1Data) (*execution.DepositCheck, error) {
+	m.eth1Data = eth1Data
+	return &execution.DepositCheck{
+		DepositCount:      10,
+		TreeDepositCount:  10,
+		StateDepositCount: eth1Data.DepositCount,
+		Problems:          []string{"the cache holds 10 deposits but the state expects 12"},
+	}, nil
+}
+
+func (m *mockDepositRepairer) RepairDeposits(_ context.Context, fromBlock, toBlock uint64) (*execution.DepositRepair, error) {
+	m.fromBlock, m.toBlock = fromBlock, toBlock
+	return &execution.DepositRepair{FromBlock: fromBlock, ToBlock: toBlock, LogsScanned: 12, AddedDeposits: 2, DepositCount: 12}, nil
+}
+
+func TestDeposits(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetEth1Data(&eth.Eth1Data{DepositCount: 12, DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)}))
+	repairer := &mockDepositRepairer{}
+	s := &Server{HeadFetcher: &blockchainmock.ChainService{State: st}, DepositRepairer: repairer}
+
+	t.Run("check", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/deposits", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.CheckDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.DepositCheckResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, false, resp.Data.Consistent)
+		assert.Equal(t, "10", resp.Data.DepositCount)
+		assert.Equal(t, "12", resp.Data.StateDepositCount)
+		assert.Equal(t, 1, len(resp.Data.Problems))
+		assert.Equal(t, uint64(12), repairer.eth1Data.DepositCount)
+	})
+	t.Run("repair", func(t *testing.T) {
+		body := bytes.NewBufferString(`{"from_block":"100","to_block":"200"}`)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/deposits/repair", body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.RepairDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.RepairDepositsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "2", resp.Data.AddedDeposits)
+		assert.Equal(t, "12", resp.Data.DepositCount)
+		assert.Equal(t, uint64(100), repairer.fromBlock)
+		assert.Equal(t, uint64(200), repairer.toBlock)
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		body := bytes.NewBufferString(`{"from_block":"200","to_block":"100"}`)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/deposits/repair", body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.RepairDeposits(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("not available", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/deposits", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{}).CheckDeposits(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
+
+type mockStateRegenerator struct {
+	blockRoot [32]byte
+}
+
+func (m *mockStateRegenerator) RegenerateState(_ context.Context, blockRoot [32]byte) (*stategen.Regeneration, error) {
+	m.blockRoot = blockRoot
+	return &stategen.Regeneration{
+		BlockRoot:      blockRoot,
+		Slot:           5,
+		BaseSlot:       2,
+		ReplayedBlocks: 3,
+		Replaced:       []string{stategen.LocationHotStateCache, stategen.LocationDB},
+		Corrupted:      []string{stategen.LocationHotStateCache},
+	}, nil
+}
+
+func TestRegenerateState(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t)
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 5
+	util.SaveBlock(t, ctx, db, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	regenerator := &mockStateRegenerator{}
+	s := &Server{BeaconDB: db, StateRegenerator: regenerator}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/states/{block_root}/regenerate", nil)
+		request.SetPathValue("block_root", hexutil.Encode(root[:]))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.RegenerateState(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.RegenerateStateResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, hexutil.Encode(root[:]), resp.Data.BlockRoot)
+		assert.Equal(t, "5", resp.Data.Slot)
+		assert.Equal(t, "3", resp.Data.ReplayedBlocks)
+		assert.DeepEqual(t, []string{stategen.LocationHotStateCache, stategen.LocationDB}, resp.Data.Replaced)
+		assert.DeepEqual(t, []string{stategen.LocationHotStateCache}, resp.Data.Corrupted)
+		assert.Equal(t, root, regenerator.blockRoot)
+	})
+	t.Run("unknown block", func(t *testing.T) {
+		unknown := [32]byte{'a'}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/states/{block_root}/regenerate", nil)
+		request.SetPathValue("block_root", hexutil.Encode(unknown[:]))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.RegenerateState(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/states/{block_root}/regenerate", nil)
+		request.SetPathValue("block_root", "0x123")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.RegenerateState(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("not available", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/states/{block_root}/regenerate", nil)
+		request.SetPathValue("block_root", hexutil.Encode(root[:]))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{BeaconDB: db}).RegenerateState(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
@@ -0,0 +1,5 @@
+package debug
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "rpc/debug")
@@ -5,8 +5,13 @@ package debug
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
+	debugv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/debug"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 )
 
 // Server defines a server implementation of the gRPC Beacon Chain service,
@@ -20,4 +25,15 @@ type Server struct {
 	ForkchoiceFetcher     blockchain.ForkchoiceFetcher
 	FinalizationFetcher   blockchain.FinalizationFetcher
 	ChainInfoFetcher      blockchain.ChainInfoFetcher
+	BadBlockCache         *cache.BadBlockCache
+	// SlashingApprover is nil unless the slasher runs with the approve policy.
+	SlashingApprover slasher.SlashingApprover
+	// DepositRepairer is nil unless the node follows the execution chain.
+	DepositRepairer execution.DepositRepairer
+	// StateRegenerator is nil unless the node manages its states with stategen.
+	StateRegenerator stategen.Regenerator
+	// HeadSnapshotDir is the directory the head snapshots are written to.
+	HeadSnapshotDir string
+	// V1Alpha1Server serves the debug endpoints which were only available through gRPC.
+	V1Alpha1Server *debugv1alpha1.Server
 }
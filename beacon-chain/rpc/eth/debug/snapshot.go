@@ -0,0 +1,144 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+const (
+	headSnapshotBlockFile = "block.ssz"
+	headSnapshotStateFile = "state.ssz"
+	// headSnapshotAttempts is the number of times the head is read again when it changes while being read.
+	headSnapshotAttempts = 3
+)
+
+// SnapshotHead writes the current head block and state of the node to SSZ files, in a new directory of the head
+// snapshot directory which appears once both files are written, e.g. to start identical devnet replicas or to debug
+// an issue with the head offline.
+func (s *Server) SnapshotHead(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.SnapshotHead")
+	defer span.End()
+
+	if s.HeadSnapshotDir == "" {
+		httputil.HandleError(w, "Head snapshots are not available", http.StatusServiceUnavailable)
+		return
+	}
+	root, blk, st, err := s.consistentHead(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	dir, err := writeHeadSnapshot(s.HeadSnapshotDir, root, blk, st)
+	if err != nil {
+		httputil.HandleError(w, "Could not write head snapshot: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	stateRoot := blk.Block().StateRoot()
+	httputil.WriteJson(w, &structs.HeadSnapshotResponse{
+		Data: &structs.HeadSnapshot{
+			Version:   version.String(blk.Version()),
+			Slot:      fmt.Sprintf("%d", blk.Block().Slot()),
+			BlockRoot: hexutil.Encode(root[:]),
+			StateRoot: hexutil.Encode(stateRoot[:]),
+			Dir:       dir,
+			BlockFile: filepath.Join(dir, headSnapshotBlockFile),
+			StateFile: filepath.Join(dir, headSnapshotStateFile),
+		},
+	})
+}
+
+// consistentHead returns the head block and the state of that block, reading them again if the head changed in
+// between.
+func (s *Server) consistentHead(ctx context.Context) ([32]byte, interfaces.ReadOnlySignedBeaconBlock, state.BeaconState, error) {
+	for i := 0; i < headSnapshotAttempts; i++ {
+		blk, err := s.HeadFetcher.HeadBlock(ctx)
+		if err != nil {
+			return [32]byte{}, nil, nil, errors.Wrap(err, "could not get head block")
+		}
+		st, err := s.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return [32]byte{}, nil, nil, errors.Wrap(err, "could not get head state")
+		}
+		if blk == nil || blk.IsNil() || st == nil || st.IsNil() {
+			return [32]byte{}, nil, nil, errors.New("no head")
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, nil, nil, errors.Wrap(err, "could not compute head block root")
+		}
+		ok, err := stategen.StateMatchesBlock(ctx, st, root)
+		if err != nil {
+			return [32]byte{}, nil, nil, err
+		}
+		if ok {
+			return root, blk, st, nil
+		}
+	}
+	return [32]byte{}, nil, nil, errors.New("head changed while being read")
+}
+
+// writeHeadSnapshot writes the head block and state to a temporary directory, which is then renamed to a directory
+// named after the slot and root of the block, so that a snapshot directory is never seen partially written. It
+// returns the path of the snapshot directory.
+func writeHeadSnapshot(baseDir string, root [32]byte, blk interfaces.ReadOnlySignedBeaconBlock, st state.BeaconState) (string, error) {
+	blkSSZ, err := blk.MarshalSSZ()
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal block")
+	}
+	stSSZ, err := st.MarshalSSZ()
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal state")
+	}
+	baseDir, err = file.ExpandPath(baseDir)
+	if err != nil {
+		return "", err
+	}
+	if err := file.MkdirAll(baseDir); err != nil {
+		return "", errors.Wrap(err, "could not create snapshot directory")
+	}
+	name := fmt.Sprintf("%d_%#x", blk.Block().Slot(), root)
+	dir := filepath.Join(baseDir, name)
+	// The state of a block never changes, so an existing snapshot of the block is identical.
+	exists, err := file.Exists(dir, file.Directory)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return dir, nil
+	}
+
+	tmp, err := os.MkdirTemp(baseDir, "."+name+"-")
+	if err != nil {
+		return "", errors.Wrap(err, "could not create temporary directory")
+	}
+	defer func() {
+		if err := os.RemoveAll(tmp); err != nil {
+			log.WithError(err).Debug("Could not remove temporary snapshot directory")
+		}
+	}()
+	if err := file.WriteFile(filepath.Join(tmp, headSnapshotBlockFile), blkSSZ); err != nil {
+		return "", errors.Wrap(err, "could not write block")
+	}
+	if err := file.WriteFile(filepath.Join(tmp, headSnapshotStateFile), stSSZ); err != nil {
+		return "", errors.Wrap(err, "could not write state")
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		return "", errors.Wrap(err, "could not move snapshot into place")
+	}
+	log.WithField("dir", dir).Info("Wrote head snapshot")
+	return dir, nil
+}
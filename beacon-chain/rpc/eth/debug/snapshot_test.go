@@ -0,0 +1,85 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	blockchainmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestSnapshotHead(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 32)
+	blk, err := blocks.NewGenesisBlockForState(ctx, st)
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	t.Run("ok", func(t *testing.T) {
+		dir := t.TempDir()
+		s := &Server{HeadFetcher: &blockchainmock.ChainService{Block: blk, State: st}, HeadSnapshotDir: dir}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/head/snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SnapshotHead(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.HeadSnapshotResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "phase0", resp.Data.Version)
+		assert.Equal(t, "0", resp.Data.Slot)
+		assert.Equal(t, hexutil.Encode(root[:]), resp.Data.BlockRoot)
+		assert.Equal(t, filepath.Join(dir, "0_"+hexutil.Encode(root[:])), resp.Data.Dir)
+
+		blkSSZ, err := os.ReadFile(resp.Data.BlockFile)
+		require.NoError(t, err)
+		savedBlk := &eth.SignedBeaconBlock{}
+		require.NoError(t, savedBlk.UnmarshalSSZ(blkSSZ))
+		savedRoot, err := savedBlk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root, savedRoot)
+
+		stSSZ, err := os.ReadFile(resp.Data.StateFile)
+		require.NoError(t, err)
+		wantSSZ, err := st.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, wantSSZ, stSSZ)
+
+		// Only the snapshot directory is left, without any temporary directory.
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(entries))
+	})
+	t.Run("head state of another block", func(t *testing.T) {
+		other := st.Copy()
+		require.NoError(t, other.SetSlot(1))
+		s := &Server{HeadFetcher: &blockchainmock.ChainService{Block: blk, State: other}, HeadSnapshotDir: t.TempDir()}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/head/snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SnapshotHead(writer, request)
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+	t.Run("not available", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/head/snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{}).SnapshotHead(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
@@ -67,6 +67,10 @@ const (
 	LightClientFinalityUpdateTopic = "light_client_finality_update"
 	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
 	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
+	// DataAvailabilityTopic is a Prysm specific topic for the data availability resolution of blocks.
+	DataAvailabilityTopic = "data_availability"
+	// ProposerLookaheadTopic is a Prysm specific topic for the proposer schedule of the next epoch.
+	ProposerLookaheadTopic = "proposer_lookahead"
 )
 
 var (
@@ -108,6 +112,8 @@ var stateFeedEventTopics = map[feed.EventType]string{
 	statefeed.Reorg:                       ChainReorgTopic,
 	statefeed.BlockProcessed:              BlockTopic,
 	statefeed.PayloadAttributes:           PayloadAttributesTopic,
+	statefeed.DataAvailabilityChecked:     DataAvailabilityTopic,
+	statefeed.ProposerLookahead:           ProposerLookaheadTopic,
 }
 
 var topicsForStateFeed = topicsForFeed(stateFeedEventTopics)
@@ -435,12 +441,16 @@ func topicForEvent(event *feed.Event) string {
 		return LightClientFinalityUpdateTopic
 	case interfaces.LightClientOptimisticUpdate:
 		return LightClientOptimisticUpdateTopic
-	case *ethpb.EventChainReorg:
+	case *ethpb.EventChainReorg, *statefeed.ReorgData:
 		return ChainReorgTopic
 	case *statefeed.BlockProcessedData:
 		return BlockTopic
 	case payloadattribute.EventData:
 		return PayloadAttributesTopic
+	case *statefeed.DataAvailabilityCheckedData:
+		return DataAvailabilityTopic
+	case *statefeed.ProposerLookaheadData:
+		return ProposerLookaheadTopic
 	default:
 		return InvalidTopic
 	}
@@ -555,6 +565,59 @@ func (s *Server) lazyReaderForEvent(ctx context.Context, event *feed.Event, topi
 		return func() io.Reader {
 			return jsonMarshalReader(eventName, structs.EventChainReorgFromV1(v))
 		}, nil
+	case *statefeed.DataAvailabilityCheckedData:
+		return func() io.Reader {
+			sidecars := make([]*structs.DataAvailabilitySidecarEvent, len(v.Sidecars))
+			for i, sc := range v.Sidecars {
+				var arrival string
+				if !sc.Arrival.IsZero() {
+					arrival = fmt.Sprintf("%d", sc.Arrival.UnixMilli())
+				}
+				sidecars[i] = &structs.DataAvailabilitySidecarEvent{
+					Index:       fmt.Sprintf("%d", sc.Index),
+					Source:      sc.Source,
+					ArrivalTime: arrival,
+				}
+			}
+			return jsonMarshalReader(eventName, &structs.DataAvailabilityEvent{
+				Slot:                      fmt.Sprintf("%d", v.Slot),
+				BlockRoot:                 hexutil.Encode(v.BlockRoot[:]),
+				BlobsExpected:             fmt.Sprintf("%d", len(v.Sidecars)),
+				Sidecars:                  sidecars,
+				CompletedTime:             fmt.Sprintf("%d", v.Completed.UnixMilli()),
+				BeforeAttestationDeadline: v.BeforeAttestationDeadline,
+			})
+		}, nil
+	case *statefeed.ProposerLookaheadData:
+		start, err := slots.EpochStart(v.Epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get start slot of epoch %d", v.Epoch)
+		}
+		return func() io.Reader {
+			proposers := make([]*structs.ProposerLookaheadSlot, len(v.Proposers))
+			for i, idx := range v.Proposers {
+				proposers[i] = &structs.ProposerLookaheadSlot{
+					Slot:           fmt.Sprintf("%d", start+primitives.Slot(i)),
+					ValidatorIndex: fmt.Sprintf("%d", idx),
+				}
+			}
+			return jsonMarshalReader(eventName, &structs.ProposerLookaheadEvent{
+				Epoch:         fmt.Sprintf("%d", v.Epoch),
+				DependentRoot: hexutil.Encode(v.DependentRoot[:]),
+				Proposers:     proposers,
+			})
+		}, nil
+	case *statefeed.ReorgData:
+		return func() io.Reader {
+			ev := structs.EventChainReorgFromV1(v.Event)
+			ev.Distance = fmt.Sprintf("%d", v.Distance)
+			ev.CommonAncestorRoot = hexutil.Encode(v.CommonAncestorRoot[:])
+			ev.OldHeadProposer = fmt.Sprintf("%d", v.OldHeadProposer)
+			ev.NewHeadProposer = fmt.Sprintf("%d", v.NewHeadProposer)
+			ev.OldHeadWeight = fmt.Sprintf("%d", v.OldHeadWeight)
+			ev.NewHeadWeight = fmt.Sprintf("%d", v.NewHeadWeight)
+			return jsonMarshalReader(eventName, ev)
+		}, nil
 	case *statefeed.BlockProcessedData:
 		blockRoot, err := v.SignedBlock.Block().HashTreeRoot()
 		if err != nil {
@@ -325,6 +325,8 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			FinalizedCheckpointTopic,
 			ChainReorgTopic,
 			BlockTopic,
+			DataAvailabilityTopic,
+			ProposerLookaheadTopic,
 		})
 		require.NoError(t, err)
 		request := topics.testHttpRequest(testSync.ctx, t)
@@ -357,15 +359,22 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			},
 			&feed.Event{
 				Type: statefeed.Reorg,
-				Data: &ethpb.EventChainReorg{
-					Slot:                0,
-					Depth:               0,
-					OldHeadBlock:        make([]byte, 32),
-					NewHeadBlock:        make([]byte, 32),
-					OldHeadState:        make([]byte, 32),
-					NewHeadState:        make([]byte, 32),
-					Epoch:               0,
-					ExecutionOptimistic: false,
+				Data: &statefeed.ReorgData{
+					Event: &ethpb.EventChainReorg{
+						Slot:                0,
+						Depth:               0,
+						OldHeadBlock:        make([]byte, 32),
+						NewHeadBlock:        make([]byte, 32),
+						OldHeadState:        make([]byte, 32),
+						NewHeadState:        make([]byte, 32),
+						Epoch:               0,
+						ExecutionOptimistic: false,
+					},
+					Distance:        1,
+					OldHeadProposer: 1,
+					NewHeadProposer: 2,
+					OldHeadWeight:   10,
+					NewHeadWeight:   20,
 				},
 			},
 			&feed.Event{
@@ -377,6 +386,27 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 					ExecutionOptimistic: false,
 				},
 			},
+			&feed.Event{
+				Type: statefeed.DataAvailabilityChecked,
+				Data: &statefeed.DataAvailabilityCheckedData{
+					Slot:      1,
+					BlockRoot: [32]byte{},
+					Sidecars: []statefeed.DataAvailabilitySidecar{
+						{Index: 0, Source: "gossip", Arrival: time.Now()},
+						{Index: 1, Source: "unknown"},
+					},
+					Completed:                 time.Now(),
+					BeforeAttestationDeadline: true,
+				},
+			},
+			&feed.Event{
+				Type: statefeed.ProposerLookahead,
+				Data: &statefeed.ProposerLookaheadData{
+					Epoch:         2,
+					DependentRoot: [32]byte{'a'},
+					Proposers:     []primitives.ValidatorIndex{3, 1, 4, 1},
+				},
+			},
 		}
 
 		go func() {
@@ -249,8 +249,12 @@ func (p *BeaconDbBlocker) Blobs(ctx context.Context, id string, indices []uint64
 		}
 	}
 	// returns empty slice if there are no indices
-	blobs := make([]*blocks.VerifiedROBlob, len(indices))
-	for i, index := range indices {
+	blobs := make([]*blocks.VerifiedROBlob, 0, len(indices))
+	for _, index := range indices {
+		// Indices past the commitments of the block do not reference any blob.
+		if index >= uint64(len(commitments)) {
+			continue
+		}
 		vblob, err := p.BlobStorage.Get(bytesutil.ToBytes32(root), index)
 		if err != nil {
 			log.WithFields(log.Fields{
@@ -259,7 +263,7 @@ func (p *BeaconDbBlocker) Blobs(ctx context.Context, id string, indices []uint64
 			}).Error(errors.Wrapf(err, "could not retrieve blob for block root %#x at index %d", root, index))
 			return nil, &core.RpcError{Err: fmt.Errorf("could not retrieve blob for block root %#x at index %d", root, index), Reason: core.Internal}
 		}
-		blobs[i] = &vblob
+		blobs = append(blobs, &vblob)
 	}
 	return blobs, nil
 }
@@ -0,0 +1,249 @@
+package beacon
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Processing statuses of the pending execution requests, following the order in which the epoch processing of the
+// queues stops or skips entries.
+const (
+	requestStatusAwaitingFinalization       = "awaiting_finalization"
+	requestStatusPostponed                  = "postponed"
+	requestStatusQueued                     = "queued"
+	requestStatusAwaitingWithdrawableEpoch  = "awaiting_withdrawable_epoch"
+	requestStatusWithdrawable               = "withdrawable"
+	requestStatusSkippedExited              = "skipped_exited"
+	requestStatusAwaitingSourceWithdrawable = "awaiting_source_withdrawable"
+	requestStatusSkippedSlashed             = "skipped_slashed"
+	requestStatusReady                      = "ready"
+	depositSourceExecutionRequest           = "execution_request"
+	depositSourceLegacy                     = "legacy"
+)
+
+// GetExecutionRequests serves the GET /prysm/v1/beacon/states/{state_id}/execution_requests endpoint. It returns the
+// execution layer requests (EIP-7685) which the state has queued and not yet processed: deposits, partial withdrawals
+// and consolidations, along with the churn left to process them and the status of each request, i.e. what it is
+// waiting for at the next epoch processing.
+func (s *Server) GetExecutionRequests(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetExecutionRequests")
+	defer span.End()
+
+	stateID := r.PathValue("state_id")
+	if stateID == "" {
+		httputil.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	st, err := s.Stater.State(ctx, []byte(stateID))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+	if st.Version() < version.Electra {
+		httputil.HandleError(w, "Execution requests are not available before Electra", http.StatusBadRequest)
+		return
+	}
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateID), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		httputil.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := executionRequests(st)
+	if err != nil {
+		httputil.HandleError(w, "Could not get execution requests: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPendingExecutionRequestsResponse{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, blockRoot),
+		Data:                data,
+	})
+}
+
+// executionRequests returns the pending execution requests of an Electra state, with their processing status.
+func executionRequests(st state.ReadOnlyBeaconState) (*structs.PendingExecutionRequests, error) {
+	startIndex, err := st.DepositRequestsStartIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get deposit requests start index")
+	}
+	depositBalance, err := st.DepositBalanceToConsume()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get deposit balance to consume")
+	}
+	exitBalance, err := st.ExitBalanceToConsume()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get exit balance to consume")
+	}
+	earliestExit, err := st.EarliestExitEpoch()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get earliest exit epoch")
+	}
+	consolidationBalance, err := st.ConsolidationBalanceToConsume()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get consolidation balance to consume")
+	}
+	earliestConsolidation, err := st.EarliestConsolidationEpoch()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get earliest consolidation epoch")
+	}
+	deposits, err := pendingDepositRequests(st)
+	if err != nil {
+		return nil, err
+	}
+	withdrawals, err := pendingWithdrawalRequests(st)
+	if err != nil {
+		return nil, err
+	}
+	consolidations, err := pendingConsolidationRequests(st)
+	if err != nil {
+		return nil, err
+	}
+	return &structs.PendingExecutionRequests{
+		DepositRequestsStartIndex:     strconv.FormatUint(startIndex, 10),
+		DepositBalanceToConsume:       strconv.FormatUint(uint64(depositBalance), 10),
+		ExitBalanceToConsume:          strconv.FormatUint(uint64(exitBalance), 10),
+		EarliestExitEpoch:             strconv.FormatUint(uint64(earliestExit), 10),
+		ConsolidationBalanceToConsume: strconv.FormatUint(uint64(consolidationBalance), 10),
+		EarliestConsolidationEpoch:    strconv.FormatUint(uint64(earliestConsolidation), 10),
+		Deposits:                      deposits,
+		Withdrawals:                   withdrawals,
+		Consolidations:                consolidations,
+	}, nil
+}
+
+// pendingDepositRequests returns the pending deposits of the state. Deposits are processed in order once their slot
+// is finalized, deposits to exiting validators being postponed until the validator is withdrawn. Pending deposits
+// at the genesis slot are not execution requests, but deposits from the deposit contract or balances queued at the
+// Electra fork.
+func pendingDepositRequests(st state.ReadOnlyBeaconState) ([]*structs.PendingDepositRequest, error) {
+	pending, err := st.PendingDeposits()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pending deposits")
+	}
+	finalizedSlot, err := slots.EpochStart(st.FinalizedCheckpointEpoch())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized slot")
+	}
+	nextEpoch := slots.ToEpoch(st.Slot()) + 1
+	deposits := make([]*structs.PendingDepositRequest, len(pending))
+	awaitingFinalization := false
+	for i, d := range pending {
+		deposit := &structs.PendingDepositRequest{
+			Pubkey:                hexutil.Encode(d.PublicKey),
+			WithdrawalCredentials: hexutil.Encode(d.WithdrawalCredentials),
+			Amount:                strconv.FormatUint(d.Amount, 10),
+			Slot:                  strconv.FormatUint(uint64(d.Slot), 10),
+			Source:                depositSourceExecutionRequest,
+			Status:                requestStatusQueued,
+		}
+		if d.Slot == params.BeaconConfig().GenesisSlot {
+			deposit.Source = depositSourceLegacy
+		}
+		// The processing of the queue stops at the first deposit which is not finalized.
+		awaitingFinalization = awaitingFinalization || d.Slot > finalizedSlot
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(d.PublicKey))
+		if ok {
+			deposit.ValidatorIndex = strconv.FormatUint(uint64(idx), 10)
+		}
+		switch {
+		case awaitingFinalization:
+			deposit.Status = requestStatusAwaitingFinalization
+		case ok:
+			v, err := st.ValidatorAtIndexReadOnly(idx)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get validator %d", idx)
+			}
+			if v.ExitEpoch() < params.BeaconConfig().FarFutureEpoch && v.WithdrawableEpoch() >= nextEpoch {
+				deposit.Status = requestStatusPostponed
+			}
+		}
+		deposits[i] = deposit
+	}
+	return deposits, nil
+}
+
+// pendingWithdrawalRequests returns the pending partial withdrawals of the state. A partial withdrawal is processed
+// from its withdrawable epoch, and skipped if the validator has exited in the meantime.
+func pendingWithdrawalRequests(st state.ReadOnlyBeaconState) ([]*structs.PendingWithdrawalRequest, error) {
+	pending, err := st.PendingPartialWithdrawals()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pending partial withdrawals")
+	}
+	epoch := slots.ToEpoch(st.Slot())
+	withdrawals := make([]*structs.PendingWithdrawalRequest, len(pending))
+	awaitingEpoch := false
+	for i, p := range pending {
+		v, err := st.ValidatorAtIndexReadOnly(p.Index)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator %d", p.Index)
+		}
+		// The processing of the queue stops at the first withdrawal which is not withdrawable yet.
+		awaitingEpoch = awaitingEpoch || p.WithdrawableEpoch > epoch
+		status := requestStatusWithdrawable
+		switch {
+		case awaitingEpoch:
+			status = requestStatusAwaitingWithdrawableEpoch
+		case v.ExitEpoch() < params.BeaconConfig().FarFutureEpoch:
+			status = requestStatusSkippedExited
+		}
+		withdrawals[i] = &structs.PendingWithdrawalRequest{
+			ValidatorIndex:    strconv.FormatUint(uint64(p.Index), 10),
+			Amount:            strconv.FormatUint(p.Amount, 10),
+			WithdrawableEpoch: strconv.FormatUint(uint64(p.WithdrawableEpoch), 10),
+			Status:            status,
+		}
+	}
+	return withdrawals, nil
+}
+
+// pendingConsolidationRequests returns the pending consolidations of the state. A consolidation is processed once
+// its source validator is withdrawable, and skipped if the source validator has been slashed.
+func pendingConsolidationRequests(st state.ReadOnlyBeaconState) ([]*structs.PendingConsolidationRequest, error) {
+	pending, err := st.PendingConsolidations()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pending consolidations")
+	}
+	nextEpoch := slots.ToEpoch(st.Slot()) + 1
+	consolidations := make([]*structs.PendingConsolidationRequest, len(pending))
+	awaitingSource := false
+	for i, c := range pending {
+		source, err := st.ValidatorAtIndexReadOnly(c.SourceIndex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator %d", c.SourceIndex)
+		}
+		// The processing of the queue stops at the first unslashed source validator which is not withdrawable.
+		awaitingSource = awaitingSource || (!source.Slashed() && source.WithdrawableEpoch() > nextEpoch)
+		status := requestStatusReady
+		switch {
+		case awaitingSource:
+			status = requestStatusAwaitingSourceWithdrawable
+		case source.Slashed():
+			status = requestStatusSkippedSlashed
+		}
+		consolidations[i] = &structs.PendingConsolidationRequest{
+			SourceIndex:             strconv.FormatUint(uint64(c.SourceIndex), 10),
+			TargetIndex:             strconv.FormatUint(uint64(c.TargetIndex), 10),
+			SourceWithdrawableEpoch: strconv.FormatUint(uint64(source.WithdrawableEpoch()), 10),
+			Status:                  status,
+		}
+	}
+	return consolidations, nil
+}
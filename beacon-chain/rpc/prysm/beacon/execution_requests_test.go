@@ -0,0 +1,74 @@
+package beacon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestGetExecutionRequests(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateElectra(t, 4)
+	require.NoError(t, st.SetSlot(64))
+	pubkey := st.PubkeyAtIndex(0)
+	require.NoError(t, st.SetPendingDeposits([]*eth.PendingDeposit{
+		{PublicKey: pubkey[:], WithdrawalCredentials: make([]byte, 32), Amount: 1000, Signature: make([]byte, 96), Slot: 0},
+		{PublicKey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32), Amount: 2000, Signature: make([]byte, 96), Slot: 10},
+		{PublicKey: pubkey[:], WithdrawalCredentials: make([]byte, 32), Amount: 3000, Signature: make([]byte, 96), Slot: 0},
+	}))
+	require.NoError(t, st.AppendPendingPartialWithdrawal(&eth.PendingPartialWithdrawal{Index: 1, Amount: 100, WithdrawableEpoch: 1}))
+	require.NoError(t, st.AppendPendingPartialWithdrawal(&eth.PendingPartialWithdrawal{Index: 2, Amount: 200, WithdrawableEpoch: 5}))
+	require.NoError(t, st.AppendPendingConsolidation(&eth.PendingConsolidation{SourceIndex: 3, TargetIndex: 0}))
+
+	chainService := &chainMock.ChainService{FinalizedRoots: make(map[[32]byte]bool)}
+	s := &Server{
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		Stater:                &testutil.MockStater{BeaconState: st},
+	}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/execution_requests", nil)
+	request.SetPathValue("state_id", "head")
+	writer := httptest.NewRecorder()
+	s.GetExecutionRequests(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetPendingExecutionRequestsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+
+	require.Equal(t, 3, len(resp.Data.Deposits))
+	assert.Equal(t, "legacy", resp.Data.Deposits[0].Source)
+	assert.Equal(t, "0", resp.Data.Deposits[0].ValidatorIndex)
+	assert.Equal(t, "queued", resp.Data.Deposits[0].Status)
+	assert.Equal(t, "execution_request", resp.Data.Deposits[1].Source)
+	assert.Equal(t, "", resp.Data.Deposits[1].ValidatorIndex)
+	assert.Equal(t, "awaiting_finalization", resp.Data.Deposits[1].Status)
+	// Deposits after a deposit awaiting finalization wait for it.
+	assert.Equal(t, "awaiting_finalization", resp.Data.Deposits[2].Status)
+
+	require.Equal(t, 2, len(resp.Data.Withdrawals))
+	assert.Equal(t, "withdrawable", resp.Data.Withdrawals[0].Status)
+	assert.Equal(t, "awaiting_withdrawable_epoch", resp.Data.Withdrawals[1].Status)
+	assert.Equal(t, "200", resp.Data.Withdrawals[1].Amount)
+
+	require.Equal(t, 1, len(resp.Data.Consolidations))
+	assert.Equal(t, "3", resp.Data.Consolidations[0].SourceIndex)
+	assert.Equal(t, "awaiting_source_withdrawable", resp.Data.Consolidations[0].Status)
+}
+
+func TestGetExecutionRequests_PreElectra(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateDeneb(t, 4)
+	s := &Server{Stater: &testutil.MockStater{BeaconState: st}}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/execution_requests", nil)
+	request.SetPathValue("state_id", "head")
+	writer := httptest.NewRecorder()
+	s.GetExecutionRequests(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	assert.StringContains(t, "not available before Electra", writer.Body.String())
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -223,7 +224,7 @@ func (s *Server) PublishBlobs(w http.ResponseWriter, r *http.Request) {
 		}
 
 		verifiedBlob := blocks.NewVerifiedROBlob(readOnlySc)
-		if err := s.BlobReceiver.ReceiveBlob(ctx, verifiedBlob); err != nil {
+		if err := s.BlobReceiver.ReceiveBlob(ctx, verifiedBlob, das.SourceAPI); err != nil {
 			httputil.HandleError(w, "Could not receive blob: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
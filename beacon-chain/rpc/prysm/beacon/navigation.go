@@ -0,0 +1,136 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetBlockChildren serves the GET /prysm/v1/beacon/blocks/{block_id}/children endpoint. It returns the children of the
+// block known to fork choice, i.e. the blocks built on top of it on every fork, so that the fork structure can be
+// navigated without downloading the whole fork choice dump. Only the blocks after the finalized checkpoint are in fork
+// choice.
+func (s *Server) GetBlockChildren(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlockChildren")
+	defer span.End()
+
+	_, root, ok := s.navigationBlock(ctx, w, r)
+	if !ok {
+		return
+	}
+	roots, slots, err := s.ChainInfoFetcher.Children(root)
+	if err != nil {
+		httputil.HandleError(w, "Block is not in fork choice: "+err.Error(), http.StatusNotFound)
+		return
+	}
+	children := make([]*structs.BlockRelative, len(roots))
+	for i := range roots {
+		children[i], err = s.blockRelative(ctx, roots[i], slots[i])
+		if err != nil {
+			httputil.HandleError(w, "Could not determine if block is canonical: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	isOptimistic, err := s.OptimisticModeFetcher.IsOptimisticForRoot(ctx, root)
+	if err != nil {
+		httputil.HandleError(w, "Could not check if block is optimistic: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetBlockChildrenResponse{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, root),
+		Data:                children,
+	})
+}
+
+// GetBlockAncestor serves the GET /prysm/v1/beacon/blocks/{block_id}/ancestor endpoint. It returns the ancestor of
+// the block at the slot of the query, on the chain of the block rather than the canonical chain. When the slot is
+// empty on that chain, the latest ancestor before the slot is returned.
+func (s *Server) GetBlockAncestor(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlockAncestor")
+	defer span.End()
+
+	_, slot, ok := shared.UintFromQuery(w, r, "slot", true)
+	if !ok {
+		return
+	}
+	blk, root, ok := s.navigationBlock(ctx, w, r)
+	if !ok {
+		return
+	}
+	if primitives.Slot(slot) > blk.Block().Slot() {
+		httputil.HandleError(w, fmt.Sprintf("Slot %d is after the slot %d of the block", slot, blk.Block().Slot()), http.StatusBadRequest)
+		return
+	}
+	ancestor, err := s.ChainInfoFetcher.Ancestor(ctx, root[:], primitives.Slot(slot))
+	if err != nil {
+		httputil.HandleError(w, "Could not get ancestor: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ancestorRoot := bytesutil.ToBytes32(ancestor)
+	ancestorBlk, err := s.BeaconDB.Block(ctx, ancestorRoot)
+	if err != nil {
+		httputil.HandleError(w, "Could not get ancestor block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if ancestorBlk == nil || ancestorBlk.IsNil() {
+		httputil.HandleError(w, "Ancestor block not found", http.StatusNotFound)
+		return
+	}
+	relative, err := s.blockRelative(ctx, ancestorRoot, ancestorBlk.Block().Slot())
+	if err != nil {
+		httputil.HandleError(w, "Could not determine if block is canonical: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	isOptimistic, err := s.OptimisticModeFetcher.IsOptimisticForRoot(ctx, root)
+	if err != nil {
+		httputil.HandleError(w, "Could not check if block is optimistic: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetBlockAncestorResponse{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, root),
+		Data:                relative,
+	})
+}
+
+// navigationBlock returns the block of the block_id route parameter and its root, writing the error otherwise.
+func (s *Server) navigationBlock(ctx context.Context, w http.ResponseWriter, r *http.Request) (interfaces.ReadOnlySignedBeaconBlock, [32]byte, bool) {
+	blockID := r.PathValue("block_id")
+	if blockID == "" {
+		httputil.HandleError(w, "block_id is required in URL params", http.StatusBadRequest)
+		return nil, [32]byte{}, false
+	}
+	blk, err := s.Blocker.Block(ctx, []byte(blockID))
+	if !shared.WriteBlockFetchError(w, blk, err) {
+		return nil, [32]byte{}, false
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not hash block: "+err.Error(), http.StatusInternalServerError)
+		return nil, [32]byte{}, false
+	}
+	return blk, root, true
+}
+
+// blockRelative returns the root and slot of a block related to another block, and whether it is canonical.
+func (s *Server) blockRelative(ctx context.Context, root [32]byte, slot primitives.Slot) (*structs.BlockRelative, error) {
+	canonical, err := s.ChainInfoFetcher.IsCanonical(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	return &structs.BlockRelative{
+		Root:      hexutil.Encode(root[:]),
+		Slot:      fmt.Sprintf("%d", slot),
+		Canonical: canonical,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// navigationServer returns a server with a fork in fork choice: blocks 1 and 2 are both children of block 0, and
+// block 3 is a child of block 1, on the canonical chain. Block 4 is not in fork choice. The blocks are looked up by
+// their slot.
+func navigationServer(t *testing.T) (*Server, [][32]byte) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	fc := doublylinkedtree.New()
+	parents := []int{-1, 0, 0, 1, 3}
+	roots := make([][32]byte, len(parents))
+	blks := make(map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock)
+	for i, parent := range parents {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = primitives.Slot(i)
+		if parent >= 0 {
+			b.Block.ParentRoot = roots[parent][:]
+		}
+		blk := util.SaveBlock(t, ctx, beaconDB, b)
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = root
+		blks[primitives.Slot(i)] = blk
+		if i == len(parents)-1 {
+			continue
+		}
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(primitives.Slot(i)))
+		roblock, err := blocks.NewROBlockWithRoot(blk, root)
+		require.NoError(t, err)
+		require.NoError(t, fc.InsertNode(ctx, st, roblock))
+	}
+
+	chainService := &chainMock.ChainService{
+		ForkChoiceStore: fc,
+		CanonicalRoots:  map[[32]byte]bool{roots[0]: true, roots[1]: true, roots[3]: true},
+		FinalizedRoots:  map[[32]byte]bool{roots[0]: true},
+	}
+	return &Server{
+		ChainInfoFetcher:      chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		BeaconDB:              beaconDB,
+		Blocker:               &testutil.MockBlocker{SlotBlockMap: blks},
+	}, roots
+}
+
+func TestGetBlockChildren(t *testing.T) {
+	s, roots := navigationServer(t)
+
+	t.Run("fork", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/blocks/{block_id}/children", nil)
+		request.SetPathValue("block_id", "0")
+		writer := httptest.NewRecorder()
+		s.GetBlockChildren(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetBlockChildrenResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Finalized)
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, &structs.BlockRelative{Root: hexutil.Encode(roots[1][:]), Slot: "1", Canonical: true}, resp.Data[0])
+		assert.DeepEqual(t, &structs.BlockRelative{Root: hexutil.Encode(roots[2][:]), Slot: "2", Canonical: false}, resp.Data[1])
+	})
+	t.Run("tip", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/blocks/{block_id}/children", nil)
+		request.SetPathValue("block_id", "3")
+		writer := httptest.NewRecorder()
+		s.GetBlockChildren(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetBlockChildrenResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, false, resp.Finalized)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("not in fork choice", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/blocks/{block_id}/children", nil)
+		request.SetPathValue("block_id", "4")
+		writer := httptest.NewRecorder()
+		s.GetBlockChildren(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("unknown block", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/blocks/{block_id}/children", nil)
+		request.SetPathValue("block_id", "5")
+		writer := httptest.NewRecorder()
+		s.GetBlockChildren(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+func TestGetBlockAncestor(t *testing.T) {
+	s, roots := navigationServer(t)
+
+	for _, tt := range []struct {
+		name string
+		slot string
+		want *structs.BlockRelative
+	}{
+		{name: "skipped slot", slot: "2", want: &structs.BlockRelative{Root: hexutil.Encode(roots[1][:]), Slot: "1", Canonical: true}},
+		{name: "genesis", slot: "0", want: &structs.BlockRelative{Root: hexutil.Encode(roots[0][:]), Slot: "0", Canonical: true}},
+		{name: "block itself", slot: "3", want: &structs.BlockRelative{Root: hexutil.Encode(roots[3][:]), Slot: "3", Canonical: true}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/blocks/{block_id}/ancestor?slot="+tt.slot, nil)
+			request.SetPathValue("block_id", "3")
+			writer := httptest.NewRecorder()
+			s.GetBlockAncestor(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.GetBlockAncestorResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			assert.DeepEqual(t, tt.want, resp.Data)
+		})
+	}
+	t.Run("slot after block", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/blocks/{block_id}/ancestor?slot=4", nil)
+		request.SetPathValue("block_id", "3")
+		writer := httptest.NewRecorder()
+		s.GetBlockAncestor(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("no slot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/blocks/{block_id}/ancestor", nil)
+		request.SetPathValue("block_id", "3")
+		writer := httptest.NewRecorder()
+		s.GetBlockAncestor(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
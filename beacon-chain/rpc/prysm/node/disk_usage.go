@@ -0,0 +1,47 @@
+package node
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetDiskUsage retrieves the size of the data directory, its growth rate and the growth rates of the
+// blocks, states and blobs written to it, and when it is forecast to reach the disk usage threshold.
+// The seconds until the threshold and the threshold time are empty when the data directory is not growing.
+func (s *Server) GetDiskUsage(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetDiskUsage")
+	defer span.End()
+
+	if s.DiskUsageFetcher == nil {
+		httputil.HandleError(w, "Disk usage forecasting is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	f := s.DiskUsageFetcher.Forecast()
+	if f == nil {
+		httputil.HandleError(w, "Data directory has not been sampled yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	usage := &structs.DiskUsage{
+		DataDirBytes:         strconv.FormatUint(f.DataDirBytes, 10),
+		ThresholdBytes:       strconv.FormatUint(f.ThresholdBytes, 10),
+		GrowthBytesPerSecond: make(map[string]string, len(f.GrowthRates)),
+		Time:                 f.Time.UTC().Format(time.RFC3339),
+	}
+	for kind, rate := range f.GrowthRates {
+		usage.GrowthBytesPerSecond[kind] = strconv.FormatFloat(rate, 'f', 2, 64)
+	}
+	if until, ok := f.UntilThreshold(); ok {
+		usage.SecondsUntilThreshold = strconv.FormatInt(int64(until.Seconds()), 10)
+		usage.ThresholdTime = f.ThresholdTime.UTC().Format(time.RFC3339)
+	}
+	if !f.LastPruned.IsZero() {
+		usage.LastPruned = f.LastPruned.UTC().Format(time.RFC3339)
+	}
+	httputil.WriteJson(w, &structs.DiskUsageResponse{Data: usage})
+}
@@ -0,0 +1,34 @@
+package node
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetFinalityStall retrieves the number of epochs since the finalized checkpoint, how long finality has stalled,
+// and whether the node is in safe mode because of it. The stall duration is zero when the chain finalizes normally.
+func (s *Server) GetFinalityStall(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetFinalityStall")
+	defer span.End()
+
+	if s.FinalityStallFetcher == nil {
+		httputil.HandleError(w, "Finality stall detection is not available", http.StatusServiceUnavailable)
+		return
+	}
+	stall := s.FinalityStallFetcher.FinalityStall()
+	data := &structs.FinalityStall{
+		FinalizedEpoch:      strconv.FormatUint(uint64(stall.FinalizedEpoch), 10),
+		EpochsSinceFinality: strconv.FormatUint(uint64(stall.EpochsSinceFinality), 10),
+		StallSeconds:        strconv.FormatInt(int64(stall.Duration.Seconds()), 10),
+		SafeMode:            stall.SafeMode,
+	}
+	if stall.SafeMode {
+		data.SafeModeSince = stall.SafeModeSince.UTC().Format(time.RFC3339)
+	}
+	httputil.WriteJson(w, &structs.FinalityStallResponse{Data: data})
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
 )
 
 // ListTrustedPeer retrieves data about the node's trusted peers.
@@ -129,6 +131,87 @@ func (s *Server) RemoveTrustedPeer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetLogLevels retrieves the global log level of the node and the log levels overriding it for some modules.
+func (s *Server) GetLogLevels(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetLogLevels")
+	defer span.End()
+
+	httputil.WriteJson(w, logLevelsResponse())
+}
+
+// SetLogLevels changes the global log level of the node and the log levels of some modules, such as p2p,
+// sync or state-gen, without restarting it. An empty level removes the override of a module.
+func (s *Server) SetLogLevels(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.SetLogLevels")
+	defer span.End()
+
+	var req structs.SetLogLevelsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errJson := &httputil.DefaultJsonError{
+			Message: errors.Wrapf(err, "Could not decode request body into log levels").Error(),
+			Code:    http.StatusBadRequest,
+		}
+		httputil.WriteError(w, errJson)
+		return
+	}
+
+	// Parse all the levels before applying any of them, so that an invalid request changes nothing.
+	var global *logrus.Level
+	if req.Level != "" {
+		level, err := logrus.ParseLevel(req.Level)
+		if err != nil {
+			httputil.HandleError(w, "Invalid log level: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		global = &level
+	}
+	modules := make(map[string]*logrus.Level, len(req.Modules))
+	for module, l := range req.Modules {
+		if module == "" {
+			httputil.HandleError(w, "Module name cannot be empty", http.StatusBadRequest)
+			return
+		}
+		if l == "" {
+			modules[module] = nil
+			continue
+		}
+		level, err := logrus.ParseLevel(l)
+		if err != nil {
+			httputil.HandleError(w, "Invalid log level of module "+module+": "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		modules[module] = &level
+	}
+
+	if global != nil {
+		logs.SetLevel(*global)
+	}
+	for module, level := range modules {
+		if level == nil {
+			logs.ClearModuleLevel(module)
+		} else {
+			logs.SetModuleLevel(module, *level)
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"level":   req.Level,
+		"modules": req.Modules,
+	}).Info("Changed log levels")
+	httputil.WriteJson(w, logLevelsResponse())
+}
+
+func logLevelsResponse() *structs.LogLevelsResponse {
+	global, modules := logs.Levels()
+	resp := &structs.LogLevelsResponse{
+		Level:   global.String(),
+		Modules: make(map[string]string, len(modules)),
+	}
+	for module, level := range modules {
+		resp.Modules[module] = level.String()
+	}
+	return resp
+}
+
 // httpPeerInfo does the same thing as peerInfo function in node.go but returns the
 // http peer response.
 func httpPeerInfo(peerStatus *peers.Status, id peer.ID) (*structs.Peer, error) {
@@ -3,22 +3,44 @@ package node
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	corenet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
 	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	mockBuilder "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/diskusage"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/healthscore"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/maintenance"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/overrides"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -249,3 +271,500 @@ func TestRemoveTrustedPeer_EmptyParameter(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, writer.Code)
 	assert.Equal(t, "Could not decode peer id: failed to parse peer ID: invalid cid: cid too short", e.Message)
 }
+
+func TestSetLogLevels(t *testing.T) {
+	global, _ := logs.Levels()
+	t.Cleanup(func() {
+		logs.SetLevel(global)
+		logs.ClearModuleLevel("p2p")
+	})
+	s := Server{}
+
+	body := `{"level":"warn","modules":{"p2p":"debug"}}`
+	request := httptest.NewRequest(http.MethodPost, "http://anything.is.fine", bytes.NewBufferString(body))
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetLogLevels(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.LogLevelsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "warning", resp.Level)
+	assert.DeepEqual(t, map[string]string{"p2p": "debug"}, resp.Modules)
+
+	request = httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLogLevels(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp = &structs.LogLevelsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "warning", resp.Level)
+	assert.DeepEqual(t, map[string]string{"p2p": "debug"}, resp.Modules)
+
+	body = `{"modules":{"p2p":""}}`
+	request = httptest.NewRequest(http.MethodPost, "http://anything.is.fine", bytes.NewBufferString(body))
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetLogLevels(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp = &structs.LogLevelsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, 0, len(resp.Modules))
+}
+
+func TestSetLogLevels_InvalidLevel(t *testing.T) {
+	global, _ := logs.Levels()
+	s := Server{}
+
+	body := `{"level":"debug","modules":{"p2p":"verbose"}}`
+	request := httptest.NewRequest(http.MethodPost, "http://anything.is.fine", bytes.NewBufferString(body))
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetLogLevels(writer, request)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &httputil.DefaultJsonError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Invalid log level of module p2p", e.Message)
+	// Nothing is applied when any of the levels is invalid.
+	level, modules := logs.Levels()
+	assert.Equal(t, global, level)
+	assert.Equal(t, 0, len(modules))
+}
+
+type mockSlasherBacklog struct {
+	backlog slasher.Backlog
+}
+
+func (m *mockSlasherBacklog) Backlog() slasher.Backlog {
+	return m.backlog
+}
+
+func TestGetHealthDetails(t *testing.T) {
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	id := libp2ptest.GeneratePeerIDs(1)[0]
+	peerFetcher.Peers().Add(nil, id, nil, corenet.DirOutbound)
+	peerFetcher.Peers().SetConnectionState(id, peers.Connected)
+	slot := primitives.Slot(10)
+	s := Server{
+		BeaconDB:                  dbtest.SetupDB(t),
+		PeersFetcher:              peerFetcher,
+		SyncChecker:               &mockSync.Sync{IsSynced: true},
+		OptimisticModeFetcher:     &mockChain.ChainService{},
+		HeadFetcher:               &mockChain.ChainService{},
+		GenesisTimeFetcher:        &mockChain.ChainService{Slot: &slot},
+		ExecutionChainInfoFetcher: &mockExecution.Chain{},
+		BlockBuilder:              &mockBuilder.MockBuilderService{HasConfigured: true, ErrRelayStatus: errors.New("relay down")},
+		SlasherBacklogFetcher:     &mockSlasherBacklog{backlog: slasher.Backlog{QueuedAttestations: 5, LastAttestationsProcessing: time.Minute}},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetHealthDetails(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.HealthDetailsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "degraded", resp.Data.Status)
+	statuses := make(map[string]string)
+	for _, c := range resp.Data.Components {
+		statuses[c.Name] = c.Status
+	}
+	assert.DeepEqual(t, map[string]string{
+		"database":  "healthy",
+		"p2p":       "healthy",
+		"sync":      "healthy",
+		"execution": "healthy",
+		"builder":   "degraded",
+		"slasher":   "degraded",
+	}, statuses)
+
+	s.SyncChecker = &mockSync.Sync{}
+	s.BlockBuilder = nil
+	s.SlasherBacklogFetcher = nil
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetHealthDetails(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	resp = &structs.HealthDetailsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "unhealthy", resp.Data.Status)
+	for _, c := range resp.Data.Components {
+		switch c.Name {
+		case "sync":
+			assert.Equal(t, "unhealthy", c.Status)
+			assert.DeepEqual(t, []string{"the node is neither synced nor syncing"}, c.Reasons)
+		case "builder", "slasher":
+			assert.Equal(t, "disabled", c.Status)
+		}
+	}
+}
+
+type mockDiskUsage struct {
+	forecast *diskusage.Forecast
+}
+
+func (m *mockDiskUsage) Forecast() *diskusage.Forecast { return m.forecast }
+
+func TestGetDiskUsage(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	fetcher := &mockDiskUsage{}
+	s := Server{DiskUsageFetcher: fetcher}
+
+	request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetDiskUsage(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+
+	fetcher.forecast = &diskusage.Forecast{
+		Time:           now,
+		DataDirBytes:   1000,
+		ThresholdBytes: 2000,
+		GrowthRates:    map[string]float64{diskusage.KindTotal: 0.5, "blocks": 0.25},
+		ThresholdTime:  now.Add(2000 * time.Second),
+	}
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetDiskUsage(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.DiskUsageResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "1000", resp.Data.DataDirBytes)
+	assert.Equal(t, "2000", resp.Data.ThresholdBytes)
+	assert.Equal(t, "2000", resp.Data.SecondsUntilThreshold)
+	assert.Equal(t, "0.50", resp.Data.GrowthBytesPerSecond[diskusage.KindTotal])
+	assert.Equal(t, "0.25", resp.Data.GrowthBytesPerSecond["blocks"])
+	assert.Equal(t, "", resp.Data.LastPruned)
+
+	fetcher.forecast.ThresholdTime = time.Time{}
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetDiskUsage(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp = &structs.DiskUsageResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "", resp.Data.SecondsUntilThreshold)
+	assert.Equal(t, "", resp.Data.ThresholdTime)
+}
+
+type mockHealthScore struct {
+	score *healthscore.Score
+}
+
+func (m *mockHealthScore) HealthScore() *healthscore.Score { return m.score }
+
+func TestGetHealthScore(t *testing.T) {
+	fetcher := &mockHealthScore{}
+	s := Server{HealthScoreFetcher: fetcher}
+
+	request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetHealthScore(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+
+	fetcher.score = healthscore.Compute(&healthscore.Inputs{
+		ParticipationRate: 0.97,
+		CurrentEpoch:      100,
+		FinalizedEpoch:    94,
+		ConnectedPeers:    70,
+		GoodPeers:         70,
+		TargetPeers:       70,
+		CurrentSlot:       3200,
+		HeadSlot:          3200,
+	}, time.Unix(1700000000, 0))
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetHealthScore(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.HealthScoreResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "86.1", resp.Data.Score)
+	assert.Equal(t, "2023-11-14T22:13:20Z", resp.Data.Time)
+	require.Equal(t, 5, len(resp.Data.Components))
+	assert.Equal(t, healthscore.ComponentFinality, resp.Data.Components[1].Name)
+	assert.Equal(t, "50.0", resp.Data.Components[1].Score)
+	assert.Equal(t, "0.25", resp.Data.Components[1].Weight)
+	assert.Equal(t, "6", resp.Data.Components[1].Details["finality_distance_epochs"])
+	assert.Equal(t, false, resp.Data.Components[4].Available)
+
+	request = httptest.NewRequest(http.MethodGet, "http://anything.is.fine?min_score=90", nil)
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetHealthScore(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	resp = &structs.HealthScoreResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "86.1", resp.Data.Score)
+
+	request = httptest.NewRequest(http.MethodGet, "http://anything.is.fine?min_score=80", nil)
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetHealthScore(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+}
+
+func TestEnterMaintenance(t *testing.T) {
+	exited := make(chan struct{})
+	s := Server{MaintenanceController: maintenance.New(&maintenance.Config{Exit: func() { close(exited) }})}
+
+	request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetMaintenance(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.MaintenanceResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, string(maintenance.Inactive), resp.Data.Phase)
+	assert.Equal(t, "", resp.Data.Since)
+
+	request = httptest.NewRequest(http.MethodPost, "http://anything.is.fine", nil)
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.EnterMaintenance(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp = &structs.MaintenanceResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.NotEqual(t, string(maintenance.Inactive), resp.Data.Phase)
+	assert.NotEqual(t, "", resp.Data.Since)
+
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.EnterMaintenance(writer, request)
+	require.Equal(t, http.StatusConflict, writer.Code)
+
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("node did not exit")
+	}
+}
+
+func TestEnterMaintenance_NotAvailable(t *testing.T) {
+	s := Server{}
+	request := httptest.NewRequest(http.MethodPost, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.EnterMaintenance(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+}
+
+func TestRuntimeConfig(t *testing.T) {
+	peerTarget := 70
+	m := overrides.New(t.TempDir())
+	m.Register(&overrides.Parameter{
+		Name: overrides.PeerTarget,
+		Get:  func() string { return strconv.Itoa(peerTarget) },
+		Set: func(value string) error {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+			peerTarget = n
+			return nil
+		},
+	})
+	s := Server{RuntimeOverrides: m}
+
+	t.Run("set", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://anything.is.fine", bytes.NewBufferString(`{"peer_target":"100"}`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SetRuntimeConfig(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.RuntimeConfigResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "100", resp.Data[0].Value)
+		assert.Equal(t, "70", resp.Data[0].Default)
+		assert.Equal(t, true, resp.Data[0].Overridden)
+		assert.Equal(t, 100, peerTarget)
+	})
+	t.Run("unknown parameter", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://anything.is.fine", bytes.NewBufferString(`{"max_peers":"100"}`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SetRuntimeConfig(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("invalid value", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://anything.is.fine", bytes.NewBufferString(`{"peer_target":"many"}`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SetRuntimeConfig(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Equal(t, 100, peerTarget)
+	})
+	t.Run("reset", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://anything.is.fine", nil)
+		request.SetPathValue("name", overrides.PeerTarget)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ResetRuntimeConfig(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, 70, peerTarget)
+
+		request.SetPathValue("name", "max_peers")
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ResetRuntimeConfig(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+func TestRuntimeConfig_NotAvailable(t *testing.T) {
+	s := Server{}
+	request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetRuntimeConfig(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+}
+
+type mockFinalityStall struct {
+	stall *blockchain.FinalityStall
+}
+
+func (m *mockFinalityStall) FinalityStall() *blockchain.FinalityStall { return m.stall }
+
+func TestGetFinalityStall(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s := Server{}
+	s.GetFinalityStall(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+
+	since := time.Unix(1700000000, 0)
+	s.FinalityStallFetcher = &mockFinalityStall{stall: &blockchain.FinalityStall{
+		FinalizedEpoch:      10,
+		EpochsSinceFinality: 20,
+		Duration:            90 * time.Minute,
+		SafeMode:            true,
+		SafeModeSince:       since,
+	}}
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetFinalityStall(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.FinalityStallResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "10", resp.Data.FinalizedEpoch)
+	assert.Equal(t, "20", resp.Data.EpochsSinceFinality)
+	assert.Equal(t, "5400", resp.Data.StallSeconds)
+	assert.Equal(t, true, resp.Data.SafeMode)
+	assert.Equal(t, since.UTC().Format(time.RFC3339), resp.Data.SafeModeSince)
+}
+
+type mockOptimisticStatus struct {
+	status *blockchain.OptimisticStatus
+}
+
+func (m *mockOptimisticStatus) OptimisticStatus() *blockchain.OptimisticStatus { return m.status }
+
+func TestGetOptimisticStatus(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s := Server{}
+	s.GetOptimisticStatus(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+
+	since := time.Unix(1700000000, 0)
+	s.ServeOptimisticDuties = true
+	s.OptimisticStatusFetcher = &mockOptimisticStatus{status: &blockchain.OptimisticStatus{
+		Optimistic:         true,
+		Since:              since,
+		Duration:           45 * time.Minute,
+		HeadRoot:           [32]byte{'a'},
+		HeadSlot:           100,
+		PayloadBlockHash:   []byte{'b'},
+		PayloadBlockNumber: 200,
+		MaxWindow:          30 * time.Minute,
+		WindowExceeded:     true,
+	}}
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetOptimisticStatus(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.OptimisticStatusResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, true, resp.Data.Optimistic)
+	assert.Equal(t, since.UTC().Format(time.RFC3339), resp.Data.OptimisticSince)
+	assert.Equal(t, "2700", resp.Data.OptimisticSeconds)
+	assert.Equal(t, "100", resp.Data.HeadSlot)
+	assert.Equal(t, "0x62", resp.Data.PayloadBlockHash)
+	assert.Equal(t, "200", resp.Data.PayloadBlockNumber)
+	assert.Equal(t, "1800", resp.Data.MaxWindowSeconds)
+	assert.Equal(t, true, resp.Data.WindowExceeded)
+	assert.Equal(t, true, resp.Data.ServeDuties)
+}
+
+func TestGetNodeIdentity(t *testing.T) {
+	key, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	defer db.Close()
+	localNode := enode.NewLocalNode(db, key)
+	localNode.SetStaticIP(net.IP{7, 7, 7, 7})
+	localNode.Set(enr.TCP(13000))
+	localNode.Set(enr.UDP(12000))
+	record := localNode.Node().Record()
+
+	p2pAddr, err := ma.NewMultiaddr("/ip4/0.0.0.0/tcp/13000")
+	require.NoError(t, err)
+	discAddr, err := ma.NewMultiaddr("/ip4/7.7.7.7/udp/12000/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N")
+	require.NoError(t, err)
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(1, true)
+	syncnets := bitfield.NewBitvector4()
+	syncnets.SetBitAt(2, true)
+	s := &Server{
+		PeerManager: &mockp2p.MockPeerManager{
+			Enr:           record,
+			PID:           "foo",
+			BHost:         &mockp2p.MockHost{Addresses: []ma.Multiaddr{p2pAddr}},
+			DiscoveryAddr: []ma.Multiaddr{discAddr},
+		},
+		MetadataProvider: &mockp2p.MockMetadataProvider{Data: wrapper.WrappedMetadataV1(&ethpb.MetaDataV1{
+			SeqNumber: 3,
+			Attnets:   attnets,
+			Syncnets:  syncnets,
+		})},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/identity", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetNodeIdentity(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.NodeIdentityResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+
+	peerId := peer.ID("foo").String()
+	assert.Equal(t, peerId, resp.Data.PeerId)
+	serializedEnr, err := p2p.SerializeENR(record)
+	require.NoError(t, err)
+	assert.Equal(t, "enr:"+serializedEnr, resp.Data.Enr)
+	assert.Equal(t, localNode.Node().URLv4(), resp.Data.Enode)
+	assert.DeepEqual(t, []string{p2pAddr.String() + "/p2p/" + peerId}, resp.Data.P2PAddresses)
+	assert.DeepEqual(t, []string{discAddr.String()}, resp.Data.DiscoveryAddresses)
+	require.Equal(t, 1, len(resp.Data.ExternalAddresses))
+	assert.Equal(t, true, strings.HasPrefix(resp.Data.ExternalAddresses[0], "/ip4/7.7.7.7/tcp/13000/p2p/"))
+	assert.DeepEqual(t, &structs.NodePorts{Tcp: "13000", Udp: "12000", Quic: "0"}, resp.Data.Ports)
+	assert.DeepEqual(t, &structs.NodeIdentityMetadata{
+		SeqNumber: "3",
+		Attnets:   hexutil.Encode(attnets),
+		Syncnets:  hexutil.Encode(syncnets),
+	}, resp.Data.Metadata)
+
+	t.Run("unsigned ENR", func(t *testing.T) {
+		s.PeerManager = &mockp2p.MockPeerManager{Enr: &enr.Record{}, PID: "foo"}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetNodeIdentity(writer, request)
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+}
@@ -0,0 +1,226 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// Statuses of the components of the node, from the best to the worst one.
+const (
+	healthDisabled  = "disabled"
+	healthHealthy   = "healthy"
+	healthDegraded  = "degraded"
+	healthUnhealthy = "unhealthy"
+)
+
+var healthRanks = map[string]int{
+	healthDisabled:  0,
+	healthHealthy:   0,
+	healthDegraded:  1,
+	healthUnhealthy: 2,
+}
+
+// componentHealth accumulates the status of a component along with the reasons why it is not healthy.
+type componentHealth struct {
+	*structs.ComponentHealth
+}
+
+func newComponentHealth(name string) componentHealth {
+	return componentHealth{&structs.ComponentHealth{Name: name, Status: healthHealthy, Details: make(map[string]string)}}
+}
+
+// worsen sets the status of the component, unless it is already worse, and records the reason.
+func (c componentHealth) worsen(status, reason string) {
+	if healthRanks[status] > healthRanks[c.Status] {
+		c.Status = status
+	}
+	c.Reasons = append(c.Reasons, reason)
+}
+
+// GetHealthDetails retrieves the status of each component of the node: the database, the p2p network,
+// sync, the execution client, the builder and the slasher, with the reasons of the components which are
+// degraded or unhealthy. It responds with 503 if any component is unhealthy, and 200 otherwise, so that
+// it can be used for readiness probes.
+func (s *Server) GetHealthDetails(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "node.GetHealthDetails")
+	defer span.End()
+
+	components := []*structs.ComponentHealth{
+		s.databaseHealth(ctx),
+		s.p2pHealth(),
+		s.syncHealth(ctx),
+		s.executionHealth(),
+		s.builderHealth(),
+		s.slasherHealth(),
+	}
+	status := healthHealthy
+	for _, c := range components {
+		if healthRanks[c.Status] > healthRanks[status] {
+			status = c.Status
+		}
+	}
+	resp := &structs.HealthDetailsResponse{
+		Data: &structs.HealthDetails{Status: status, Components: components},
+	}
+	if status != healthUnhealthy {
+		httputil.WriteJson(w, resp)
+		return
+	}
+	w.Header().Set("Content-Type", api.JsonMediaType)
+	w.WriteHeader(http.StatusServiceUnavailable)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write response message")
+	}
+}
+
+// GetHealthScore retrieves the latest health score of the chain and of the node, from 0 to 100, along with the
+// scores of its components. When the min_score query parameter is set, it responds with 503 if the health score
+// is lower, so that it can be used for automated failover decisions.
+func (s *Server) GetHealthScore(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetHealthScore")
+	defer span.End()
+
+	rawMinScore, minScore, ok := shared.UintFromQuery(w, r, "min_score", false)
+	if !ok {
+		return
+	}
+	if s.HealthScoreFetcher == nil {
+		httputil.HandleError(w, "Health score is not available", http.StatusServiceUnavailable)
+		return
+	}
+	score := s.HealthScoreFetcher.HealthScore()
+	if score == nil {
+		httputil.HandleError(w, "Health score has not been computed yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	data := &structs.HealthScore{
+		Score:      formatScore(score.Score),
+		Components: make([]*structs.HealthScoreComponent, len(score.Components)),
+		Time:       score.Time.UTC().Format(time.RFC3339),
+	}
+	for i, c := range score.Components {
+		data.Components[i] = &structs.HealthScoreComponent{
+			Name:      c.Name,
+			Score:     formatScore(c.Score),
+			Weight:    strconv.FormatFloat(c.Weight, 'f', 2, 64),
+			Available: c.Available,
+			Details:   c.Details,
+		}
+	}
+	resp := &structs.HealthScoreResponse{Data: data}
+	if rawMinScore == "" || score.Score >= float64(minScore) {
+		httputil.WriteJson(w, resp)
+		return
+	}
+	w.Header().Set("Content-Type", api.JsonMediaType)
+	w.WriteHeader(http.StatusServiceUnavailable)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write response message")
+	}
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', 1, 64)
+}
+
+func (s *Server) databaseHealth(ctx context.Context) *structs.ComponentHealth {
+	c := newComponentHealth("database")
+	// The genesis block root is missing from databases initialized from a checkpoint, which is fine.
+	if _, err := s.BeaconDB.GenesisBlockRoot(ctx); err != nil && !db.IsNotFound(err) {
+		c.worsen(healthUnhealthy, "could not read from the database: "+err.Error())
+	}
+	return c.ComponentHealth
+}
+
+func (s *Server) p2pHealth() *structs.ComponentHealth {
+	c := newComponentHealth("p2p")
+	peers := s.PeersFetcher.Peers()
+	connected := len(peers.Connected())
+	c.Details["connected_peers"] = strconv.Itoa(connected)
+	c.Details["target_peers"] = strconv.Itoa(peers.MaxPeerLimit())
+	minPeers := flags.Get().MinimumSyncPeers
+	switch {
+	case connected == 0:
+		c.worsen(healthUnhealthy, "no connected peers")
+	case connected < minPeers:
+		c.worsen(healthDegraded, fmt.Sprintf("%d connected peers, fewer than the %d needed to sync", connected, minPeers))
+	}
+	return c.ComponentHealth
+}
+
+func (s *Server) syncHealth(ctx context.Context) *structs.ComponentHealth {
+	c := newComponentHealth("sync")
+	headSlot := s.HeadFetcher.HeadSlot()
+	currentSlot := s.GenesisTimeFetcher.CurrentSlot()
+	c.Details["head_slot"] = strconv.FormatUint(uint64(headSlot), 10)
+	c.Details["current_slot"] = strconv.FormatUint(uint64(currentSlot), 10)
+	switch {
+	case s.SyncChecker.Syncing():
+		c.worsen(healthDegraded, "the node is syncing")
+	case !s.SyncChecker.Synced():
+		c.worsen(healthUnhealthy, "the node is neither synced nor syncing")
+	}
+	optimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
+	if err != nil {
+		c.worsen(healthDegraded, "could not check optimistic status: "+err.Error())
+	} else if optimistic {
+		c.worsen(healthDegraded, "the head is optimistic, its execution payload is not validated yet")
+	}
+	return c.ComponentHealth
+}
+
+func (s *Server) executionHealth() *structs.ComponentHealth {
+	c := newComponentHealth("execution")
+	if !s.ExecutionChainInfoFetcher.ExecutionClientConnected() {
+		reason := "the execution client is not connected"
+		if err := s.ExecutionChainInfoFetcher.ExecutionClientConnectionErr(); err != nil {
+			reason += ": " + err.Error()
+		}
+		c.worsen(healthUnhealthy, reason)
+	}
+	return c.ComponentHealth
+}
+
+func (s *Server) builderHealth() *structs.ComponentHealth {
+	c := newComponentHealth("builder")
+	if s.BlockBuilder == nil || !s.BlockBuilder.Configured() {
+		c.Status = healthDisabled
+		return c.ComponentHealth
+	}
+	// Blocks are built locally when the builder is down, so the node keeps working.
+	if err := s.BlockBuilder.RelayStatus(); err != nil {
+		c.worsen(healthDegraded, "the builder relay network is down: "+err.Error())
+	}
+	return c.ComponentHealth
+}
+
+func (s *Server) slasherHealth() *structs.ComponentHealth {
+	c := newComponentHealth("slasher")
+	if s.SlasherBacklogFetcher == nil {
+		c.Status = healthDisabled
+		return c.ComponentHealth
+	}
+	backlog := s.SlasherBacklogFetcher.Backlog()
+	c.Details["queued_attestations"] = strconv.Itoa(backlog.QueuedAttestations)
+	c.Details["queued_blocks"] = strconv.Itoa(backlog.QueuedBlocks)
+	c.Details["last_attestations_processing"] = backlog.LastAttestationsProcessing.String()
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	if backlog.LastAttestationsProcessing > slotDuration {
+		c.worsen(healthDegraded, fmt.Sprintf("processing the last attestations took %s, longer than a slot", backlog.LastAttestationsProcessing))
+	}
+	return c.ComponentHealth
+}
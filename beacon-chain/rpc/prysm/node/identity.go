@@ -0,0 +1,85 @@
+package node
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetNodeIdentity retrieves the full network identity of the node in a single document for provisioning
+// tooling: its peer ID, ENR and enode, the listening and discovery addresses, the external addresses and ports
+// advertised in its ENR, and the subnets of its metadata.
+func (s *Server) GetNodeIdentity(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetNodeIdentity")
+	defer span.End()
+
+	peerId := s.PeerManager.PeerID().String()
+	record := s.PeerManager.ENR()
+	serializedEnr, err := p2p.SerializeENR(record)
+	if err != nil {
+		httputil.HandleError(w, "Could not obtain enr: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	node, err := enode.New(enode.ValidSchemes, record)
+	if err != nil {
+		httputil.HandleError(w, "Could not parse enr: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ports, err := p2p.ENRAdvertisedPorts(node)
+	if err != nil {
+		httputil.HandleError(w, "Could not obtain advertised ports: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sourceExternal, err := p2p.ENRMultiAddrs(node)
+	if err != nil {
+		httputil.HandleError(w, "Could not obtain external addresses: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	externalAddresses := make([]string, len(sourceExternal))
+	for i := range sourceExternal {
+		externalAddresses[i] = sourceExternal[i].String()
+	}
+
+	sourcep2p := s.PeerManager.Host().Addrs()
+	p2pAddresses := make([]string, len(sourcep2p))
+	for i := range sourcep2p {
+		p2pAddresses[i] = sourcep2p[i].String() + "/p2p/" + peerId
+	}
+	sourceDisc, err := s.PeerManager.DiscoveryAddresses()
+	if err != nil {
+		httputil.HandleError(w, "Could not obtain discovery address: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	discoveryAddresses := make([]string, len(sourceDisc))
+	for i := range sourceDisc {
+		discoveryAddresses[i] = sourceDisc[i].String()
+	}
+
+	md := s.MetadataProvider.Metadata()
+	httputil.WriteJson(w, &structs.NodeIdentityResponse{
+		Data: &structs.NodeIdentity{
+			PeerId:             peerId,
+			Enr:                "enr:" + serializedEnr,
+			Enode:              node.URLv4(),
+			P2PAddresses:       p2pAddresses,
+			DiscoveryAddresses: discoveryAddresses,
+			ExternalAddresses:  externalAddresses,
+			Ports: &structs.NodePorts{
+				Tcp:  strconv.FormatUint(uint64(ports.TCP), 10),
+				Udp:  strconv.FormatUint(uint64(ports.UDP), 10),
+				Quic: strconv.FormatUint(uint64(ports.QUIC), 10),
+			},
+			Metadata: &structs.NodeIdentityMetadata{
+				SeqNumber: strconv.FormatUint(s.MetadataProvider.MetadataSeq(), 10),
+				Attnets:   hexutil.Encode(md.AttnetsBitfield()),
+				Syncnets:  hexutil.Encode(md.SyncnetsBitfield()),
+			},
+		},
+	})
+}
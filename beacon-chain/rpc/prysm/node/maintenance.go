@@ -0,0 +1,59 @@
+package node
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/maintenance"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetMaintenance retrieves the maintenance mode phase of the node, when it entered maintenance mode and the
+// number of API requests it is still waiting for.
+func (s *Server) GetMaintenance(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetMaintenance")
+	defer span.End()
+
+	if s.MaintenanceController == nil {
+		httputil.HandleError(w, "Maintenance mode is not available", http.StatusServiceUnavailable)
+		return
+	}
+	httputil.WriteJson(w, maintenanceResponse(s.MaintenanceController.Status()))
+}
+
+// EnterMaintenance puts the node in maintenance mode: the node rejects new API requests, waits for the requests in
+// flight, says goodbye to its peers, persists its state to disk and exits. The response is sent once new API
+// requests are rejected, and the node exits in the background.
+func (s *Server) EnterMaintenance(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.EnterMaintenance")
+	defer span.End()
+
+	if s.MaintenanceController == nil {
+		httputil.HandleError(w, "Maintenance mode is not available", http.StatusServiceUnavailable)
+		return
+	}
+	if err := s.MaintenanceController.Enter(); err != nil {
+		if errors.Is(err, maintenance.ErrActive) {
+			httputil.HandleError(w, err.Error(), http.StatusConflict)
+			return
+		}
+		httputil.HandleError(w, "Could not enter maintenance mode: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, maintenanceResponse(s.MaintenanceController.Status()))
+}
+
+func maintenanceResponse(st *maintenance.Status) *structs.MaintenanceResponse {
+	m := &structs.Maintenance{
+		Phase:    string(st.Phase),
+		InFlight: strconv.Itoa(st.InFlight),
+	}
+	if !st.Since.IsZero() {
+		m.Since = st.Since.UTC().Format(time.RFC3339)
+	}
+	return &structs.MaintenanceResponse{Data: m}
+}
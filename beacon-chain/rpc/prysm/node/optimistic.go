@@ -0,0 +1,42 @@
+package node
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetOptimisticStatus retrieves whether the head of the node is optimistic, since when and on which execution
+// payload, and whether it has been optimistic for longer than the maximum optimistic window.
+func (s *Server) GetOptimisticStatus(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetOptimisticStatus")
+	defer span.End()
+
+	if s.OptimisticStatusFetcher == nil {
+		httputil.HandleError(w, "Optimistic status is not available", http.StatusServiceUnavailable)
+		return
+	}
+	st := s.OptimisticStatusFetcher.OptimisticStatus()
+	data := &structs.OptimisticStatus{
+		Optimistic:         st.Optimistic,
+		OptimisticSeconds:  strconv.FormatInt(int64(st.Duration.Seconds()), 10),
+		HeadRoot:           hexutil.Encode(st.HeadRoot[:]),
+		HeadSlot:           strconv.FormatUint(uint64(st.HeadSlot), 10),
+		MaxWindowSeconds:   strconv.FormatInt(int64(st.MaxWindow.Seconds()), 10),
+		WindowExceeded:     st.WindowExceeded,
+		PayloadBlockNumber: strconv.FormatUint(st.PayloadBlockNumber, 10),
+		ServeDuties:        s.ServeOptimisticDuties,
+	}
+	if len(st.PayloadBlockHash) > 0 {
+		data.PayloadBlockHash = hexutil.Encode(st.PayloadBlockHash)
+	}
+	if !st.Since.IsZero() {
+		data.OptimisticSince = st.Since.UTC().Format(time.RFC3339)
+	}
+	httputil.WriteJson(w, &structs.OptimisticStatusResponse{Data: data})
+}
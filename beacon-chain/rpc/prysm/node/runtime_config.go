@@ -0,0 +1,89 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/overrides"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetRuntimeConfig retrieves the parameters that can be changed without restarting the node, with their current
+// and default values.
+func (s *Server) GetRuntimeConfig(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetRuntimeConfig")
+	defer span.End()
+
+	if s.RuntimeOverrides == nil {
+		httputil.HandleError(w, "Runtime configuration is not available", http.StatusServiceUnavailable)
+		return
+	}
+	httputil.WriteJson(w, runtimeConfigResponse(s.RuntimeOverrides.Values()))
+}
+
+// SetRuntimeConfig changes the parameters of the request body, a map of parameter names to their new values. The
+// new values are persisted to the data directory, and applied again when the node restarts.
+func (s *Server) SetRuntimeConfig(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.SetRuntimeConfig")
+	defer span.End()
+
+	if s.RuntimeOverrides == nil {
+		httputil.HandleError(w, "Runtime configuration is not available", http.StatusServiceUnavailable)
+		return
+	}
+	var req map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req) == 0 {
+		httputil.HandleError(w, "No parameters provided", http.StatusBadRequest)
+		return
+	}
+	if err := s.RuntimeOverrides.Set(req); err != nil {
+		if errors.Is(err, overrides.ErrUnknownParameter) || errors.Is(err, overrides.ErrInvalidValue) {
+			httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		httputil.HandleError(w, "Could not change runtime parameters: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, runtimeConfigResponse(s.RuntimeOverrides.Values()))
+}
+
+// ResetRuntimeConfig restores the value the parameter had at startup, and removes its persisted override.
+func (s *Server) ResetRuntimeConfig(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.ResetRuntimeConfig")
+	defer span.End()
+
+	if s.RuntimeOverrides == nil {
+		httputil.HandleError(w, "Runtime configuration is not available", http.StatusServiceUnavailable)
+		return
+	}
+	if err := s.RuntimeOverrides.Reset(r.PathValue("name")); err != nil {
+		if errors.Is(err, overrides.ErrUnknownParameter) {
+			httputil.HandleError(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not reset runtime parameter: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, runtimeConfigResponse(s.RuntimeOverrides.Values()))
+}
+
+func runtimeConfigResponse(values []*overrides.Value) *structs.RuntimeConfigResponse {
+	data := make([]*structs.RuntimeParameter, len(values))
+	for i, v := range values {
+		data[i] = &structs.RuntimeParameter{
+			Name:        v.Name,
+			Description: v.Description,
+			Value:       v.Value,
+			Default:     v.Default,
+			Overridden:  v.Overridden,
+		}
+	}
+	return &structs.RuntimeConfigResponse{Data: data}
+}
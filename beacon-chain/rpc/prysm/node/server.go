@@ -2,9 +2,15 @@ package node
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/diskusage"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/healthscore"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/maintenance"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/overrides"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 )
 
@@ -18,4 +24,13 @@ type Server struct {
 	GenesisTimeFetcher        blockchain.TimeFetcher
 	HeadFetcher               blockchain.HeadFetcher
 	ExecutionChainInfoFetcher execution.ChainInfoFetcher
+	BlockBuilder              builder.BlockBuilder
+	SlasherBacklogFetcher     slasher.BacklogFetcher
+	DiskUsageFetcher          diskusage.Fetcher
+	HealthScoreFetcher        healthscore.Fetcher
+	FinalityStallFetcher      blockchain.FinalityStallFetcher
+	OptimisticStatusFetcher   blockchain.OptimisticStatusFetcher
+	ServeOptimisticDuties     bool
+	MaintenanceController     maintenance.Controller
+	RuntimeOverrides          *overrides.Manager
 }
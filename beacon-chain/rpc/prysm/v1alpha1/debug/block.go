@@ -2,7 +2,6 @@ package debug
 
 import (
 	"context"
-	"fmt"
 	"math"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
@@ -49,7 +48,7 @@ func (ds *Server) GetInclusionSlot(ctx context.Context, req *pbrpc.InclusionSlot
 		epochBack = ds.GenesisTimeFetcher.CurrentSlot() - params.BeaconConfig().SlotsPerEpoch
 	}
 	if epochBack < req.Slot {
-		return nil, fmt.Errorf("attestation has one epoch window, please request slot older than %d", epochBack)
+		return nil, status.Errorf(codes.InvalidArgument, "attestation has one epoch window, please request slot older than %d", epochBack)
 	}
 
 	// Attestation could be in blocks between slot + 1 to slot + epoch_duration.
@@ -81,7 +80,7 @@ func (ds *Server) GetInclusionSlot(ctx context.Context, req *pbrpc.InclusionSlot
 			}
 			indices, err := attestation.AttestingIndices(a, c)
 			if err != nil {
-				return nil, err
+				return nil, status.Errorf(codes.Internal, "Could not get attesting indices: %v", err)
 			}
 			for _, i := range indices {
 				if req.Id == i && req.Slot == a.GetData().Slot {
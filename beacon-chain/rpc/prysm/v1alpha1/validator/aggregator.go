@@ -82,7 +82,7 @@ func (vs *Server) processAggregateSelection(ctx context.Context, req *ethpb.Aggr
 
 	// An optimistic validator MUST NOT participate in attestation
 	// (i.e., sign across the DOMAIN_BEACON_ATTESTER, DOMAIN_SELECTION_PROOF or DOMAIN_AGGREGATE_AND_PROOF domains).
-	if err := vs.optimisticStatus(ctx); err != nil {
+	if err := vs.optimisticDutyStatus(ctx, "aggregation"); err != nil {
 		return 0, 0, err
 	}
 
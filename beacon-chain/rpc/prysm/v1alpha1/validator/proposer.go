@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -179,6 +180,9 @@ func (vs *Server) getParentState(ctx context.Context, slot primitives.Slot) (sta
 }
 
 func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState, skipMevBoost bool, builderBoostFactor primitives.Gwei) (*ethpb.GenericBeaconBlock, error) {
+	// Reuse what was computed for this block during the previous slot, if anything.
+	precomputed := vs.precomputedProposalFor(sBlk.Block().Slot(), sBlk.Block().ParentRoot())
+
 	// Build consensus fields in background
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -186,15 +190,29 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 		defer wg.Done()
 
 		// Set eth1 data.
-		eth1Data, err := vs.eth1DataMajorityVote(ctx, head)
-		if err != nil {
-			eth1Data = &ethpb.Eth1Data{DepositRoot: params.BeaconConfig().ZeroHash[:], BlockHash: params.BeaconConfig().ZeroHash[:]}
-			log.WithError(err).Error("Could not get eth1data")
+		var eth1Data *ethpb.Eth1Data
+		if precomputed != nil {
+			eth1Data = precomputed.eth1Data
+		} else {
+			var err error
+			eth1Data, err = vs.eth1DataMajorityVote(ctx, head)
+			if err != nil {
+				eth1Data = &ethpb.Eth1Data{DepositRoot: params.BeaconConfig().ZeroHash[:], BlockHash: params.BeaconConfig().ZeroHash[:]}
+				log.WithError(err).Error("Could not get eth1data")
+			}
 		}
 		sBlk.SetEth1Data(eth1Data)
 
 		// Set deposit and attestation.
-		deposits, atts, err := vs.packDepositsAndAttestations(ctx, head, sBlk.Block().Slot(), eth1Data) // TODO: split attestations and deposits
+		var deposits []*ethpb.Deposit
+		var atts []ethpb.Att
+		var err error
+		if precomputed != nil {
+			deposits = precomputed.deposits
+			atts, err = vs.packAttestations(ctx, head, sBlk.Block().Slot())
+		} else {
+			deposits, atts, err = vs.packDepositsAndAttestations(ctx, head, sBlk.Block().Slot(), eth1Data) // TODO: split attestations and deposits
+		}
 		if err != nil {
 			sBlk.SetDeposits([]*ethpb.Deposit{})
 			if err := sBlk.SetAttestations([]ethpb.Att{}); err != nil {
@@ -216,7 +234,11 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 		}
 
 		// Set exits.
-		sBlk.SetVoluntaryExits(vs.getExits(head, sBlk.Block().Slot()))
+		if precomputed != nil {
+			sBlk.SetVoluntaryExits(precomputed.exits)
+		} else {
+			sBlk.SetVoluntaryExits(vs.getExits(head, sBlk.Block().Slot()))
+		}
 
 		// Set sync aggregate. New in Altair.
 		vs.setSyncAggregate(ctx, sBlk)
@@ -247,6 +269,7 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
 		}
+		vs.recordBuild(sBlk, local, builderBid, winningBid)
 	}
 
 	wg.Wait()
@@ -275,7 +298,8 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 	}
 
 	var sidecars []*ethpb.BlobSidecar
-	if block.IsBlinded() {
+	blinded := block.IsBlinded()
+	if blinded {
 		block, sidecars, err = vs.handleBlindedBlock(ctx, block)
 	} else if block.Version() >= version.Deneb {
 		sidecars, err = vs.blobSidecarsFromUnblindedBlock(block, req)
@@ -289,27 +313,11 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 		return nil, status.Errorf(codes.Internal, "Could not hash tree root: %v", err)
 	}
 
-	var wg sync.WaitGroup
-	errChan := make(chan error, 1)
-
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		if err := vs.broadcastReceiveBlock(ctx, block, root); err != nil {
-			errChan <- errors.Wrap(err, "broadcast/receive block failed")
-			return
-		}
-		errChan <- nil
-	}()
-
-	if err := vs.broadcastAndReceiveBlobs(ctx, sidecars, root); err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not broadcast/receive blobs: %v", err)
-	}
-
-	wg.Wait()
-	if err := <-errChan; err != nil {
+	if err := vs.broadcastAndReceive(ctx, block, sidecars, root); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not broadcast/receive block: %v", err)
 	}
+	vs.verifyFeeRecipient(ctx, block, blinded)
+	vs.recordProposal(block, root)
 
 	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
 }
@@ -353,25 +361,26 @@ func (vs *Server) blobSidecarsFromUnblindedBlock(block interfaces.SignedBeaconBl
 	return BuildBlobSidecars(block, rawBlobs, proofs)
 }
 
-// broadcastReceiveBlock broadcasts a block and handles its reception.
-func (vs *Server) broadcastReceiveBlock(ctx context.Context, block interfaces.SignedBeaconBlock, root [32]byte) error {
+// broadcastAndReceive publishes the block and its blob sidecars concurrently, each of them being imported
+// locally once it is published, so that the propagation of the block does not wait for the blob sidecars to be
+// published. A block or blob sidecar failing to be published is not imported.
+func (vs *Server) broadcastAndReceive(ctx context.Context, block interfaces.SignedBeaconBlock, sidecars []*ethpb.BlobSidecar, root [32]byte) error {
 	protoBlock, err := block.Proto()
 	if err != nil {
 		return errors.Wrap(err, "protobuf conversion failed")
 	}
-	if err := vs.P2P.Broadcast(ctx, protoBlock); err != nil {
-		return errors.Wrap(err, "broadcast failed")
-	}
-	vs.BlockNotifier.BlockFeed().Send(&feed.Event{
-		Type: blockfeed.ReceivedBlock,
-		Data: &blockfeed.ReceivedBlockData{SignedBlock: block},
-	})
-	return vs.BlockReceiver.ReceiveBlock(ctx, block, root, nil)
-}
 
-// broadcastAndReceiveBlobs handles the broadcasting and reception of blob sidecars.
-func (vs *Server) broadcastAndReceiveBlobs(ctx context.Context, sidecars []*ethpb.BlobSidecar, root [32]byte) error {
 	eg, eCtx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		if err := vs.P2P.Broadcast(ctx, protoBlock); err != nil {
+			return errors.Wrap(err, "broadcast failed")
+		}
+		vs.BlockNotifier.BlockFeed().Send(&feed.Event{
+			Type: blockfeed.ReceivedBlock,
+			Data: &blockfeed.ReceivedBlockData{SignedBlock: block},
+		})
+		return vs.BlockReceiver.ReceiveBlock(ctx, block, root, nil)
+	})
 	for i, sc := range sidecars {
 		// Copy the iteration instance to a local variable to give each go-routine its own copy to play with.
 		// See https://golang.org/doc/faq#closures_and_goroutines for more details.
@@ -379,14 +388,14 @@ func (vs *Server) broadcastAndReceiveBlobs(ctx context.Context, sidecars []*ethp
 		sCar := sc
 		eg.Go(func() error {
 			if err := vs.P2P.BroadcastBlob(eCtx, uint64(subIdx), sCar); err != nil {
-				return errors.Wrap(err, "broadcast blob failed")
+				return errors.Wrapf(err, "broadcast blob %d failed", subIdx)
 			}
 			readOnlySc, err := blocks.NewROBlobWithRoot(sCar, root)
 			if err != nil {
 				return errors.Wrap(err, "ROBlob creation failed")
 			}
 			verifiedBlob := blocks.NewVerifiedROBlob(readOnlySc)
-			if err := vs.BlobReceiver.ReceiveBlob(ctx, verifiedBlob); err != nil {
+			if err := vs.BlobReceiver.ReceiveBlob(ctx, verifiedBlob, das.SourceAPI); err != nil {
 				return errors.Wrap(err, "receive blob failed")
 			}
 			vs.OperationNotifier.OperationFeed().Send(&feed.Event{
@@ -120,7 +120,11 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 		}
 	}
 
-	atts = sorted.limitToMaxAttestations()
+	atts, err = sorted.selectByProfit(ctx, latestState, blkSlot)
+	if err != nil {
+		log.WithError(err).Error("Could not select attestations by profit, using the sorted order instead")
+		atts = sorted.limitToMaxAttestations()
+	}
 	return vs.filterAttestationBySignature(ctx, atts, latestState)
 }
 
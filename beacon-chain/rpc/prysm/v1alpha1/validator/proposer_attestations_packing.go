@@ -0,0 +1,302 @@
+package validator
+
+import (
+	"container/heap"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/math"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// attestationPackingTimeout is the time budget for selecting the attestations of a block.
+// Once it is exceeded, the remaining slots are filled in the order the attestations were sorted in.
+const attestationPackingTimeout = 100 * time.Millisecond
+
+var (
+	attestationPackingDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "proposer_attestation_packing_milliseconds",
+		Help:    "Time spent selecting the attestations included in a proposed block.",
+		Buckets: []float64{1, 5, 10, 25, 50, 100, 250},
+	})
+	attestationPackingEfficiency = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_attestation_packing_efficiency",
+		Help: "Ratio of the distinct attestation votes available in the pool that were included in the last proposed block.",
+	})
+	attestationPackedVotes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_attestation_packed_votes",
+		Help: "Number of distinct attestation votes included in the last proposed block.",
+	})
+	attestationPackingTimeouts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_attestation_packing_timeouts_total",
+		Help: "Count the number of times attestation packing ran out of its time budget.",
+	})
+)
+
+// voteKey identifies a set of votes that can overlap: the same attestation data in the same committee.
+type voteKey struct {
+	dataRoot  [32]byte
+	committee primitives.CommitteeIndex
+}
+
+// voteSegment holds the aggregation bits an attestation carries for a single committee.
+type voteSegment struct {
+	key  voteKey
+	bits bitfield.Bitlist
+}
+
+type packingCandidate struct {
+	index    int
+	segments []voteSegment
+	weight   uint64
+	gain     uint64
+}
+
+// newVotes returns the number of votes of the candidate that are not covered yet.
+func (c *packingCandidate) newVotes(covered map[voteKey]bitfield.Bitlist) (uint64, error) {
+	var n uint64
+	for _, s := range c.segments {
+		cov, ok := covered[s.key]
+		if !ok {
+			n += s.bits.Count()
+			continue
+		}
+		overlap, err := s.bits.And(cov)
+		if err != nil {
+			return 0, err
+		}
+		n += s.bits.Count() - overlap.Count()
+	}
+	return n, nil
+}
+
+// candidateHeap orders candidates by their last known gain, breaking ties with their sorted position.
+type candidateHeap []*packingCandidate
+
+func (h candidateHeap) Len() int { return len(h) }
+
+func (h candidateHeap) Less(i, j int) bool {
+	if h[i].gain != h[j].gain {
+		return h[i].gain > h[j].gain
+	}
+	return h[i].index < h[j].index
+}
+
+func (h candidateHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *candidateHeap) Push(x interface{}) {
+	c, ok := x.(*packingCandidate)
+	if ok {
+		*h = append(*h, c)
+	}
+}
+
+func (h *candidateHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	c := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return c
+}
+
+// selectByProfit picks at most the maximum number of attestations per block, maximizing the
+// proposer reward. Each attestation is worth the number of votes it adds to the ones already
+// picked, where votes are tracked per committee so that overlapping on-chain aggregates are not
+// counted twice, weighted by the participation flags a timely inclusion in the block rewards.
+// Attestations are picked greedily, lazily re-evaluating their worth as the covered votes grow.
+// The picked attestations are returned in their original order.
+func (a proposerAtts) selectByProfit(ctx context.Context, st state.ReadOnlyBeaconState, blkSlot primitives.Slot) (proposerAtts, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.selectByProfit")
+	defer span.End()
+
+	if len(a) == 0 {
+		return a, nil
+	}
+	start := time.Now()
+
+	limit := params.BeaconConfig().MaxAttestations
+	if a[0].Version() >= version.Electra {
+		limit = params.BeaconConfig().MaxAttestationsElectra
+	}
+
+	committeeSizes := make(map[voteKey]uint64)
+	available := make(map[voteKey]bitfield.Bitlist)
+	h := make(candidateHeap, 0, len(a))
+	for i, att := range a {
+		segments, err := voteSegments(ctx, st, att, committeeSizes)
+		if err != nil {
+			return nil, err
+		}
+		c := &packingCandidate{
+			index:    i,
+			segments: segments,
+			weight:   timelinessWeight(att.GetData().Slot, blkSlot),
+		}
+		for _, s := range segments {
+			c.gain += s.bits.Count() * c.weight
+			if err := orVotes(available, s); err != nil {
+				return nil, err
+			}
+		}
+		h = append(h, c)
+	}
+	heap.Init(&h)
+
+	covered := make(map[voteKey]bitfield.Bitlist, len(available))
+	selected := make([]bool, len(a))
+	var numSelected uint64
+	var packedVotes uint64
+	for h.Len() > 0 && numSelected < limit {
+		if time.Since(start) > attestationPackingTimeout {
+			attestationPackingTimeouts.Inc()
+			break
+		}
+		c := h[0]
+		votes, err := c.newVotes(covered)
+		if err != nil {
+			return nil, err
+		}
+		if gain := votes * c.weight; gain < c.gain {
+			c.gain = gain
+			heap.Fix(&h, 0)
+			continue
+		}
+		heap.Pop(&h)
+		if c.gain == 0 {
+			// Every remaining candidate is worthless, they are left to the fill below.
+			break
+		}
+		selected[c.index] = true
+		numSelected++
+		packedVotes += votes
+		for _, s := range c.segments {
+			if err := orVotes(covered, s); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Fill the remaining slots in the sorted order, so that the block is never emptier than it used to be.
+	for i := 0; i < len(a) && numSelected < limit; i++ {
+		if !selected[i] {
+			selected[i] = true
+			numSelected++
+		}
+	}
+
+	result := make(proposerAtts, 0, numSelected)
+	for i, att := range a {
+		if selected[i] {
+			result = append(result, att)
+		}
+	}
+
+	var availableVotes uint64
+	for _, bits := range available {
+		availableVotes += bits.Count()
+	}
+	if availableVotes > 0 {
+		attestationPackingEfficiency.Set(float64(packedVotes) / float64(availableVotes))
+	}
+	attestationPackedVotes.Set(float64(packedVotes))
+	attestationPackingDuration.Observe(float64(time.Since(start).Milliseconds()))
+	return result, nil
+}
+
+// voteSegments splits the aggregation bits of an attestation per committee. Committee sizes
+// are needed to split on-chain aggregates, they are looked up in the state and cached in sizes.
+func voteSegments(
+	ctx context.Context,
+	st state.ReadOnlyBeaconState,
+	att ethpb.Att,
+	sizes map[voteKey]uint64,
+) ([]voteSegment, error) {
+	dataRoot, err := att.GetData().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	if att.Version() < version.Electra {
+		return []voteSegment{{
+			key:  voteKey{dataRoot: dataRoot, committee: att.GetData().CommitteeIndex},
+			bits: att.GetAggregationBits(),
+		}}, nil
+	}
+
+	aggBits := att.GetAggregationBits()
+	committees := helpers.CommitteeIndices(att.CommitteeBitsVal())
+	segments := make([]voteSegment, 0, len(committees))
+	offset := uint64(0)
+	for _, ci := range committees {
+		key := voteKey{dataRoot: dataRoot, committee: ci}
+		size, ok := sizes[key]
+		if !ok {
+			committee, err := helpers.BeaconCommitteeFromState(ctx, st, att.GetData().Slot, ci)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get committee %d", ci)
+			}
+			size = uint64(len(committee))
+			sizes[key] = size
+		}
+		if offset+size > aggBits.Len() {
+			return nil, errors.Errorf("aggregation bits length %d is too short for committee %d", aggBits.Len(), ci)
+		}
+		bits := bitfield.NewBitlist(size)
+		for i := uint64(0); i < size; i++ {
+			if aggBits.BitAt(offset + i) {
+				bits.SetBitAt(i, true)
+			}
+		}
+		segments = append(segments, voteSegment{key: key, bits: bits})
+		offset += size
+	}
+	return segments, nil
+}
+
+// orVotes adds the votes of a segment to the votes in m.
+func orVotes(m map[voteKey]bitfield.Bitlist, s voteSegment) error {
+	existing, ok := m[s.key]
+	if !ok {
+		m[s.key] = s.bits
+		return nil
+	}
+	merged, err := existing.Or(s.bits)
+	if err != nil {
+		return err
+	}
+	m[s.key] = merged
+	return nil
+}
+
+// timelinessWeight returns the sum of the weights of the participation flags that an attestation
+// from attSlot sets when included in a block at blkSlot, assuming its votes are correct.
+func timelinessWeight(attSlot, blkSlot primitives.Slot) uint64 {
+	cfg := params.BeaconConfig()
+	if attSlot >= blkSlot {
+		return 0
+	}
+	delay := blkSlot - attSlot
+	var weight uint64
+	if delay <= primitives.Slot(math.IntegerSquareRoot(uint64(cfg.SlotsPerEpoch))) {
+		weight += cfg.TimelySourceWeight
+	}
+	// Since Deneb, the target flag is set regardless of the inclusion delay.
+	if delay <= cfg.SlotsPerEpoch || slots.ToEpoch(blkSlot) >= cfg.DenebForkEpoch {
+		weight += cfg.TimelyTargetWeight
+	}
+	if delay == cfg.MinAttestationInclusionDelay {
+		weight += cfg.TimelyHeadWeight
+	}
+	return weight
+}
@@ -0,0 +1,69 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func Test_selectByProfit(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(cfg)
+
+	newAtt := func(slot primitives.Slot, committee primitives.CommitteeIndex, indices ...uint64) ethpb.Att {
+		bits := bitfield.NewBitlist(8)
+		for _, i := range indices {
+			bits.SetBitAt(i, true)
+		}
+		return util.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bits,
+			Data:            &ethpb.AttestationData{Slot: slot, CommitteeIndex: committee},
+		})
+	}
+
+	t.Run("overlapping votes", func(t *testing.T) {
+		a := newAtt(1, 0, 0, 1, 2, 3)
+		b := newAtt(1, 0, 0, 1, 2, 4)
+		c := newAtt(1, 0, 5, 6, 7)
+		got, err := proposerAtts{a, b, c}.selectByProfit(context.Background(), nil, 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(got))
+		assert.DeepEqual(t, a, got[0])
+		assert.DeepEqual(t, c, got[1])
+	})
+	t.Run("timely votes", func(t *testing.T) {
+		late := newAtt(1, 0, 0, 1, 2, 3)
+		timely := newAtt(9, 1, 0, 1, 2)
+		other := newAtt(9, 2, 0, 1)
+		got, err := proposerAtts{late, timely, other}.selectByProfit(context.Background(), nil, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(got))
+		assert.DeepEqual(t, timely, got[0])
+		assert.DeepEqual(t, other, got[1])
+	})
+	t.Run("fills with worthless attestations", func(t *testing.T) {
+		a := newAtt(1, 0, 0, 1, 2, 3)
+		b := newAtt(1, 0, 0, 1)
+		got, err := proposerAtts{a, b}.selectByProfit(context.Background(), nil, 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(got))
+		assert.DeepEqual(t, a, got[0])
+		assert.DeepEqual(t, b, got[1])
+	})
+}
+
+func Test_timelinessWeight(t *testing.T) {
+	cfg := params.BeaconConfig()
+	assert.Equal(t, cfg.TimelySourceWeight+cfg.TimelyTargetWeight+cfg.TimelyHeadWeight, timelinessWeight(1, 2))
+	assert.Equal(t, cfg.TimelySourceWeight+cfg.TimelyTargetWeight, timelinessWeight(1, 3))
+	assert.Equal(t, uint64(0), timelinessWeight(2, 2))
+}
@@ -20,7 +20,7 @@ func (vs *Server) canUseBuilder(ctx context.Context, slot primitives.Slot, idx p
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.canUseBuilder")
 	defer span.End()
 
-	if !vs.BlockBuilder.Configured() {
+	if !vs.BlockBuilder.Configured() || !vs.BlockBuilder.Enabled() {
 		return false, nil
 	}
 	activated, err := vs.circuitBreakBuilder(slot)
@@ -142,6 +142,12 @@ func TestServer_canUseBuilder(t *testing.T) {
 	reg, err = proposerServer.canUseBuilder(ctx, params.BeaconConfig().MaxBuilderConsecutiveMissedSlots-1, 0)
 	require.NoError(t, err)
 	require.Equal(t, true, reg)
+
+	// The builder was disabled at runtime.
+	proposerServer.BlockBuilder.(*testing2.MockBuilderService).Disabled = true
+	reg, err = proposerServer.canUseBuilder(ctx, params.BeaconConfig().MaxBuilderConsecutiveMissedSlots-1, 0)
+	require.NoError(t, err)
+	require.Equal(t, false, reg)
 }
 
 func createState(
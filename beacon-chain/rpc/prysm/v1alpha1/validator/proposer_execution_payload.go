@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -12,7 +13,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -39,6 +41,12 @@ var (
 		Name: "payload_id_cache_hit",
 		Help: "The number of payload id get requests that are present in the cache.",
 	})
+	// payloadBuildDuration tracks how long the execution client had to build a payload before it was retrieved.
+	payloadBuildDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "payload_build_duration_milliseconds",
+		Help:    "Time between asking the execution client to prepare a payload and retrieving it with getPayload, in milliseconds.",
+		Buckets: []float64{100, 250, 500, 1000, 2000, 4000, 8000, 12000},
+	})
 )
 
 func setFeeRecipientIfBurnAddress(val *cache.TrackedValidator) {
@@ -63,6 +71,12 @@ func (vs *Server) getLocalPayload(ctx context.Context, blk interfaces.ReadOnlyBe
 	return vs.getLocalPayloadFromEngine(ctx, st, headRoot, slot, vIdx)
 }
 
+// isGetPayloadTimeout returns whether getting a payload from the execution client timed out, either on the deadline
+// of the context or on the timeout of the http client, which the engine client reports as execution.ErrHTTPTimeout.
+func isGetPayloadTimeout(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, execution.ErrHTTPTimeout)
+}
+
 // This returns the local execution payload of a slot, proposer ID, and parent root assuming payload Is cached.
 // If the payload ID is not cached, the function will prepare a new payload through local EL engine and return it by using the head state.
 func (vs *Server) getLocalPayloadFromEngine(
@@ -89,20 +103,31 @@ func (vs *Server) getLocalPayloadFromEngine(
 		var pid primitives.PayloadID
 		copy(pid[:], payloadId[:])
 		payloadIDCacheHit.Inc()
+		if err := vs.waitForGetPayloadSlotOffset(ctx, st, slot); err != nil {
+			return nil, err
+		}
 		res, err := vs.ExecutionEngineCaller.GetPayload(ctx, pid, slot)
+		if isGetPayloadTimeout(err) && vs.GetPayloadRetryCachedID {
+			log.WithFields(logFields).Warn("Timed out getting cached payload from execution client, retrying")
+			res, err = vs.ExecutionEngineCaller.GetPayload(ctx, pid, slot)
+		}
 		if err == nil {
+			if preparedAt, ok := vs.PayloadIDCache.PreparedAt(pid); ok {
+				payloadBuildDuration.Observe(float64(time.Since(preparedAt).Milliseconds()))
+			}
 			warnIfFeeRecipientDiffers(val.FeeRecipient[:], res.ExecutionData.FeeRecipient())
 			return res, nil
 		}
 		// TODO: TestServer_getExecutionPayloadContextTimeout expects this behavior.
 		// We need to figure out if it is actually important to "retry" by falling through to the code below when
 		// we get a timeout when trying to retrieve the cached payload id.
-		if !errors.Is(err, context.DeadlineExceeded) {
+		if !isGetPayloadTimeout(err) {
 			return nil, errors.Wrap(err, "could not get cached payload from execution client")
 		}
 	}
 	log.WithFields(logFields).Debug("Payload ID cache miss")
-	parentHash, err := vs.getParentBlockHash(ctx, st, slot)
+	preparedAt := time.Now()
+	payloadID, err := vs.preparePayload(ctx, st, parentRoot, slot, val.FeeRecipient)
 	switch {
 	case errors.Is(err, errActivationNotReached) || errors.Is(err, errNoTerminalBlockHash):
 		return consensusblocks.NewGetPayloadResponse(emptyPayload())
@@ -110,8 +135,36 @@ func (vs *Server) getLocalPayloadFromEngine(
 		return nil, err
 	}
 	payloadIDCacheMiss.Inc()
+	if err := vs.waitForGetPayloadSlotOffset(ctx, st, slot); err != nil {
+		return nil, err
+	}
+	res, err := vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID, slot)
+	if err != nil {
+		return nil, err
+	}
+	payloadBuildDuration.Observe(float64(time.Since(preparedAt).Milliseconds()))
+
+	warnIfFeeRecipientDiffers(val.FeeRecipient[:], res.ExecutionData.FeeRecipient())
+	log.WithField("value", res.Bid).Debug("Received execution payload from local engine")
+	return res, nil
+}
+
+// preparePayload asks the execution client to start building the payload of the block at the given slot,
+// on top of the block with the given root, and returns the ID of the payload being built.
+// It returns errActivationNotReached or errNoTerminalBlockHash when the block must have an empty payload.
+func (vs *Server) preparePayload(
+	ctx context.Context,
+	st state.BeaconState,
+	parentRoot [32]byte,
+	slot primitives.Slot,
+	feeRecipient primitives.ExecutionAddress,
+) (*enginev1.PayloadIDBytes, error) {
+	parentHash, err := vs.getParentBlockHash(ctx, st, slot)
+	if err != nil {
+		return nil, err
+	}
 
-	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
+	random, err := helpers.RandaoMix(st, coreTime.CurrentEpoch(st))
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +197,7 @@ func (vs *Server) getLocalPayloadFromEngine(
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributesV3{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient[:],
 			Withdrawals:           withdrawals,
 			ParentBeaconBlockRoot: parentRoot[:],
 		})
@@ -159,7 +212,7 @@ func (vs *Server) getLocalPayloadFromEngine(
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributesV2{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient[:],
 			Withdrawals:           withdrawals,
 		})
 		if err != nil {
@@ -169,7 +222,7 @@ func (vs *Server) getLocalPayloadFromEngine(
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributes{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient[:],
 		})
 		if err != nil {
 			return nil, err
@@ -184,14 +237,31 @@ func (vs *Server) getLocalPayloadFromEngine(
 	if payloadID == nil {
 		return nil, fmt.Errorf("nil payload with block hash: %#x", parentHash)
 	}
-	res, err := vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID, slot)
+	return payloadID, nil
+}
+
+// waitForGetPayloadSlotOffset blocks until GetPayloadSlotOffset has passed since the start of the slot, which gives
+// the execution client longer to build the payload at the cost of proposing later.
+func (vs *Server) waitForGetPayloadSlotOffset(ctx context.Context, st state.BeaconState, slot primitives.Slot) error {
+	if vs.GetPayloadSlotOffset <= 0 {
+		return nil
+	}
+	slotStart, err := slots.ToTime(st.GenesisTime(), slot)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	wait := time.Until(slotStart.Add(vs.GetPayloadSlotOffset))
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-
-	warnIfFeeRecipientDiffers(val.FeeRecipient[:], res.ExecutionData.FeeRecipient())
-	log.WithField("value", res.Bid).Debug("Received execution payload from local engine")
-	return res, nil
 }
 
 // warnIfFeeRecipientDiffers logs a warning if the fee recipient in the payload (eg the EL engine get payload response) does not
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	powtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -215,6 +217,87 @@ func TestServer_getExecutionPayloadContextTimeout(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// timeoutOnceEngineClient times out the first getPayload call with the given error, and behaves like the wrapped
+// client afterwards.
+type timeoutOnceEngineClient struct {
+	*powtesting.EngineClient
+	timeoutErr      error
+	getPayloadCalls int
+}
+
+func (e *timeoutOnceEngineClient) GetPayload(ctx context.Context, id [8]byte, slot primitives.Slot) (*blocks.GetPayloadResponse, error) {
+	e.getPayloadCalls++
+	if e.getPayloadCalls == 1 {
+		return nil, e.timeoutErr
+	}
+	return e.EngineClient.GetPayload(ctx, id, slot)
+}
+
+func TestServer_getExecutionPayload_RetryCachedID(t *testing.T) {
+	for name, timeoutErr := range map[string]error{
+		"context deadline": context.DeadlineExceeded,
+		// The engine client converts the timeouts of the http client into this error.
+		"http client timeout": execution.ErrHTTPTimeout,
+	} {
+		t.Run(name, func(t *testing.T) {
+			st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+			ed, err := blocks.NewWrappedExecutionData(&pb.ExecutionPayload{BlockNumber: 7})
+			require.NoError(t, err)
+			engine := &timeoutOnceEngineClient{
+				EngineClient: &powtesting.EngineClient{GetPayloadResponse: &blocks.GetPayloadResponse{ExecutionData: ed}},
+				timeoutErr:   timeoutErr,
+			}
+			vs := &Server{
+				ExecutionEngineCaller:   engine,
+				PayloadIDCache:          cache.NewPayloadIDCache(),
+				TrackedValidatorsCache:  cache.NewTrackedValidatorsCache(),
+				GetPayloadRetryCachedID: true,
+			}
+			parentRoot := [32]byte{'a'}
+			vs.PayloadIDCache.Set(st.Slot(), parentRoot, [8]byte{100})
+
+			res, err := vs.getLocalPayloadFromEngine(context.Background(), st, parentRoot, st.Slot(), 0)
+			require.NoError(t, err)
+			require.Equal(t, 2, engine.getPayloadCalls)
+			require.Equal(t, uint64(7), res.ExecutionData.BlockNumber())
+		})
+	}
+}
+
+func TestServer_waitForGetPayloadSlotOffset(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	require.NoError(t, st.SetGenesisTime(uint64(time.Now().Unix())))
+
+	t.Run("disabled", func(t *testing.T) {
+		vs := &Server{}
+		start := time.Now()
+		require.NoError(t, vs.waitForGetPayloadSlotOffset(context.Background(), st, 0))
+		require.Equal(t, true, time.Since(start) < time.Second)
+	})
+	t.Run("offset already passed", func(t *testing.T) {
+		vs := &Server{GetPayloadSlotOffset: time.Millisecond}
+		require.NoError(t, st.SetGenesisTime(uint64(time.Now().Add(-time.Minute).Unix())))
+		start := time.Now()
+		require.NoError(t, vs.waitForGetPayloadSlotOffset(context.Background(), st, 0))
+		require.Equal(t, true, time.Since(start) < time.Second)
+	})
+	t.Run("waits for offset", func(t *testing.T) {
+		vs := &Server{GetPayloadSlotOffset: 2 * time.Second}
+		genesis := time.Now().Truncate(time.Second)
+		require.NoError(t, st.SetGenesisTime(uint64(genesis.Unix())))
+		require.NoError(t, vs.waitForGetPayloadSlotOffset(context.Background(), st, 0))
+		require.Equal(t, false, time.Now().Before(genesis.Add(vs.GetPayloadSlotOffset)))
+	})
+	t.Run("context cancelled", func(t *testing.T) {
+		vs := &Server{GetPayloadSlotOffset: time.Minute}
+		require.NoError(t, st.SetGenesisTime(uint64(time.Now().Unix())))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := vs.waitForGetPayloadSlotOffset(ctx, st, 0)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
 func TestServer_getExecutionPayload_UnexpectedFeeRecipient(t *testing.T) {
 	hook := logTest.NewGlobal()
 	beaconDB := dbTest.SetupDB(t)
@@ -0,0 +1,115 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	feeRecipientMatch          = "match"
+	feeRecipientBuilderPayment = "builder_payment"
+	feeRecipientMismatch       = "mismatch"
+)
+
+var feeRecipientChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "proposer_fee_recipient_checks_total",
+	Help: "Fee recipient checks of the blocks proposed by the attached validators. The result is match, " +
+		"builder_payment when a builder payload pays the fee recipient with its last transaction, or mismatch.",
+}, []string{"result"})
+
+// verifyFeeRecipient checks that the execution payload of a block proposed by an attached validator pays the fee
+// recipient configured for the validator. The payload of a builder may set the builder as fee recipient and pay the
+// proposer with its last transaction, as MEV relays do. A mismatch, which points at a misconfigured relay or a
+// compromised execution client, is logged and raises an alert.
+func (vs *Server) verifyFeeRecipient(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock, fromBuilder bool) {
+	if blk.Version() < version.Bellatrix || vs.TrackedValidatorsCache == nil {
+		return
+	}
+	proposer := blk.Block().ProposerIndex()
+	val, ok := vs.TrackedValidatorsCache.Validator(proposer)
+	if !ok {
+		return
+	}
+	setFeeRecipientIfBurnAddress(&val)
+	want := val.FeeRecipient
+	if fromBuilder && vs.BlockBuilder != nil {
+		// The builder is asked to pay the fee recipient of the registration of the validator.
+		if reg, err := vs.BlockBuilder.RegistrationByValidatorID(ctx, proposer); err == nil {
+			want = primitives.ExecutionAddress(reg.FeeRecipient)
+		}
+	}
+	payload, err := blk.Block().Body().Execution()
+	if err != nil {
+		log.WithError(err).Debug("Could not get execution payload to verify its fee recipient")
+		return
+	}
+	result, err := feeRecipientResult(payload, want, fromBuilder)
+	if err != nil {
+		log.WithError(err).Debug("Could not verify fee recipient")
+		return
+	}
+	feeRecipientChecks.WithLabelValues(result).Inc()
+	if result != feeRecipientMismatch {
+		return
+	}
+	wanted, got := fmt.Sprintf("%#x", want), fmt.Sprintf("%#x", payload.FeeRecipient())
+	log.WithFields(logrus.Fields{
+		"slot":               blk.Block().Slot(),
+		"proposerIndex":      proposer,
+		"wantedFeeRecipient": wanted,
+		"feeRecipient":       got,
+		"builder":            fromBuilder,
+	}).Error("Proposed block does not pay the configured fee recipient")
+	if vs.Alerter != nil {
+		vs.Alerter.Notify(&alerts.Alert{
+			Event:    alerts.FeeRecipientMismatch,
+			Key:      fmt.Sprintf("%d/%d", proposer, blk.Block().Slot()),
+			Severity: alerts.SeverityCritical,
+			Summary:  fmt.Sprintf("Block proposed by validator %d at slot %d does not pay its fee recipient", proposer, blk.Block().Slot()),
+			Details: map[string]string{
+				"validatorIndex":     fmt.Sprintf("%d", proposer),
+				"slot":               fmt.Sprintf("%d", blk.Block().Slot()),
+				"wantedFeeRecipient": wanted,
+				"feeRecipient":       got,
+				"builder":            fmt.Sprintf("%t", fromBuilder),
+			},
+		})
+	}
+}
+
+// feeRecipientResult compares the fee recipient of the payload with the wanted one. When the payload comes from a
+// builder, its last transaction paying the wanted fee recipient is a match as well.
+func feeRecipientResult(payload interfaces.ExecutionData, want primitives.ExecutionAddress, fromBuilder bool) (string, error) {
+	if bytes.Equal(payload.FeeRecipient(), want[:]) {
+		return feeRecipientMatch, nil
+	}
+	if !fromBuilder {
+		return feeRecipientMismatch, nil
+	}
+	txs, err := payload.Transactions()
+	if err != nil {
+		return "", errors.Wrap(err, "could not get transactions")
+	}
+	if len(txs) == 0 {
+		return feeRecipientMismatch, nil
+	}
+	tx := &gethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(txs[len(txs)-1]); err != nil {
+		return "", errors.Wrap(err, "could not decode last transaction")
+	}
+	if tx.To() != nil && bytes.Equal(tx.To().Bytes(), want[:]) {
+		return feeRecipientBuilderPayment, nil
+	}
+	return feeRecipientMismatch, nil
+}
@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
+	testing2 "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockAlerter struct {
+	alerts []*alerts.Alert
+}
+
+func (m *mockAlerter) Notify(a *alerts.Alert) {
+	m.alerts = append(m.alerts, a)
+}
+
+func TestServer_verifyFeeRecipient(t *testing.T) {
+	ctx := context.Background()
+	proposerRecipient := primitives.ExecutionAddress{'p'}
+	builderRecipient := primitives.ExecutionAddress{'b'}
+	payment, err := gethtypes.NewTx(&gethtypes.LegacyTx{
+		To:    (*common.Address)(&proposerRecipient),
+		Value: big.NewInt(1),
+	}).MarshalBinary()
+	require.NoError(t, err)
+	other, err := gethtypes.NewTx(&gethtypes.LegacyTx{
+		To:    &common.Address{'o'},
+		Value: big.NewInt(1),
+	}).MarshalBinary()
+	require.NoError(t, err)
+
+	newBlock := func(t *testing.T, proposer primitives.ValidatorIndex, recipient primitives.ExecutionAddress, txs ...[]byte) *blocks.SignedBeaconBlock {
+		b := util.NewBeaconBlockCapella()
+		b.Block.Slot = 5
+		b.Block.ProposerIndex = proposer
+		b.Block.Body.ExecutionPayload.FeeRecipient = recipient[:]
+		b.Block.Body.ExecutionPayload.Transactions = txs
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		return sb.(*blocks.SignedBeaconBlock)
+	}
+
+	tests := []struct {
+		name        string
+		blk         *blocks.SignedBeaconBlock
+		fromBuilder bool
+		result      string
+	}{
+		{
+			name:   "local payload paying the fee recipient",
+			blk:    newBlock(t, 1, proposerRecipient),
+			result: feeRecipientMatch,
+		},
+		{
+			name:   "local payload paying another fee recipient",
+			blk:    newBlock(t, 1, builderRecipient),
+			result: feeRecipientMismatch,
+		},
+		{
+			name:        "builder payload paying the proposer with its last transaction",
+			blk:         newBlock(t, 1, builderRecipient, other, payment),
+			fromBuilder: true,
+			result:      feeRecipientBuilderPayment,
+		},
+		{
+			name:        "builder payload not paying the proposer",
+			blk:         newBlock(t, 1, builderRecipient, payment, other),
+			fromBuilder: true,
+			result:      feeRecipientMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := tt.blk.Block().Body().Execution()
+			require.NoError(t, err)
+			result, err := feeRecipientResult(payload, proposerRecipient, tt.fromBuilder)
+			require.NoError(t, err)
+			assert.Equal(t, tt.result, result)
+		})
+	}
+
+	t.Run("alerts on mismatch", func(t *testing.T) {
+		trackedCache := cache.NewTrackedValidatorsCache()
+		trackedCache.Set(cache.TrackedValidator{Active: true, Index: 1, FeeRecipient: primitives.ExecutionAddress{'x'}})
+		registrations := cache.NewRegistrationCache()
+		registrations.UpdateIndexToRegisteredMap(ctx, map[primitives.ValidatorIndex]*ethpb.ValidatorRegistrationV1{
+			1: {FeeRecipient: proposerRecipient[:]},
+		})
+		alerter := &mockAlerter{}
+		vs := &Server{
+			TrackedValidatorsCache: trackedCache,
+			BlockBuilder:           &testing2.MockBuilderService{RegistrationCache: registrations},
+			Alerter:                alerter,
+		}
+
+		// The builder pays the fee recipient of the registration.
+		vs.verifyFeeRecipient(ctx, newBlock(t, 1, builderRecipient, payment), true)
+		require.Equal(t, 0, len(alerter.alerts))
+		// A local payload must pay the fee recipient of the tracked validator.
+		vs.verifyFeeRecipient(ctx, newBlock(t, 1, proposerRecipient), false)
+		require.Equal(t, 1, len(alerter.alerts))
+		assert.Equal(t, alerts.FeeRecipientMismatch, alerter.alerts[0].Event)
+		assert.Equal(t, "1/5", alerter.alerts[0].Key)
+		// Validators which are not attached to the node are not checked.
+		vs.verifyFeeRecipient(ctx, newBlock(t, 2, builderRecipient), false)
+		require.Equal(t, 1, len(alerter.alerts))
+	})
+}
@@ -0,0 +1,217 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	proposalPrecomputeDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "proposal_precompute_milliseconds",
+		Help:    "Time spent preparing a tracked proposal during the slot before it.",
+		Buckets: []float64{10, 50, 100, 250, 500, 1000, 2000},
+	})
+	precomputedProposalHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "precomputed_proposal_hit_total",
+		Help: "Count the number of blocks built from a precomputed proposal.",
+	})
+	precomputedProposalMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "precomputed_proposal_miss_total",
+		Help: "Count the number of blocks built without a matching precomputed proposal.",
+	})
+)
+
+// precomputedProposal holds the parts of a block that were computed during the slot before it.
+// They are only valid for a block with the same slot and parent root.
+type precomputedProposal struct {
+	slot       primitives.Slot
+	parentRoot [32]byte
+	eth1Data   *ethpb.Eth1Data
+	deposits   []*ethpb.Deposit
+	exits      []*ethpb.SignedVoluntaryExit
+}
+
+// proposalPrecomputeCache holds the latest precomputed proposal.
+type proposalPrecomputeCache struct {
+	sync.Mutex
+	proposal *precomputedProposal
+}
+
+// PrecomputeProposals runs until the context is canceled. Two thirds into every slot, if a tracked
+// validator proposes the next slot, it prepares everything of its block that only depends on the
+// current head: it advances the head state to the proposal slot, selects the eth1 data vote, the
+// deposits and the voluntary exits, and dispatches the payload attributes to the execution client.
+func (vs *Server) PrecomputeProposals(ctx context.Context) {
+	clock, err := vs.ClockWaiter.WaitForClock(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not wait for clock to precompute proposals")
+		return
+	}
+	offset := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second * 2 / 3
+	ticker := slots.NewSlotTickerWithOffset(clock.GenesisTime(), offset, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if err := vs.precomputeProposal(ctx, slot+1); err != nil {
+				log.WithError(err).WithField("slot", slot+1).Warn("Could not precompute proposal")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (vs *Server) precomputeProposal(ctx context.Context, slot primitives.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.precomputeProposal")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil
+	}
+	if !features.Get().PrepareAllPayloads && !vs.TrackedValidatorsCache.Validating() {
+		return nil
+	}
+	headRoot, head, err := vs.HeadFetcher.HeadRootAndStateReadOnly(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if head.Slot() >= slot {
+		return nil
+	}
+	val, tracked := vs.trackedProposer(ctx, head, slot)
+	if !tracked {
+		return nil
+	}
+	start := time.Now()
+
+	// Advance the head state to the proposal slot through the next slot cache, which is
+	// where the block production and the state root computation pick it up from. The cache
+	// copies the head state when advancing it and hands out copies of the advanced state,
+	// so the head state is only copied when a proposal is precomputed.
+	st := transition.NextSlotState(headRoot[:], slot)
+	if st == nil {
+		headState, ok := head.(state.BeaconState)
+		if !ok {
+			return errors.New("head state is not a beacon state")
+		}
+		if err := transition.UpdateNextSlotCache(ctx, headRoot[:], headState); err != nil {
+			return errors.Wrap(err, "could not update next slot cache")
+		}
+		if st = transition.NextSlotState(headRoot[:], slot); st == nil {
+			return errors.New("could not get the advanced head state from the next slot cache")
+		}
+	}
+	st, err = transition.ProcessSlotsIfPossible(ctx, st, slot)
+	if err != nil {
+		return errors.Wrapf(err, "could not process slots up to %d", slot)
+	}
+
+	if st.Version() >= version.Bellatrix {
+		if _, ok := vs.PayloadIDCache.PayloadID(slot, headRoot); !ok {
+			vs.preparePrecomputedPayload(ctx, st, headRoot, slot, val)
+		}
+	}
+
+	eth1Data, err := vs.eth1DataMajorityVote(ctx, st)
+	if err != nil {
+		return errors.Wrap(err, "could not get eth1 data")
+	}
+	deposits, err := vs.deposits(ctx, st, eth1Data)
+	if err != nil {
+		return errors.Wrap(err, "could not get deposits")
+	}
+	exits := vs.getExits(st, slot)
+
+	vs.precomputed.Lock()
+	vs.precomputed.proposal = &precomputedProposal{
+		slot:       slot,
+		parentRoot: headRoot,
+		eth1Data:   eth1Data,
+		deposits:   deposits,
+		exits:      exits,
+	}
+	vs.precomputed.Unlock()
+
+	proposalPrecomputeDuration.Observe(float64(time.Since(start).Milliseconds()))
+	log.WithFields(logrus.Fields{
+		"slot":       slot,
+		"parentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+		"deposits":   len(deposits),
+		"exits":      len(exits),
+		"duration":   time.Since(start),
+	}).Debug("Precomputed proposal")
+	return nil
+}
+
+// preparePrecomputedPayload dispatches the payload attributes of the proposal to the execution client.
+// Failing to do so is not fatal, the payload is then prepared during the block production.
+func (vs *Server) preparePrecomputedPayload(
+	ctx context.Context,
+	st state.BeaconState,
+	parentRoot [32]byte,
+	slot primitives.Slot,
+	val cache.TrackedValidator,
+) {
+	setFeeRecipientIfBurnAddress(&val)
+	payloadID, err := vs.preparePayload(ctx, st, parentRoot, slot, val.FeeRecipient)
+	switch {
+	case errors.Is(err, errActivationNotReached) || errors.Is(err, errNoTerminalBlockHash):
+		return
+	case err != nil:
+		log.WithError(err).WithField("slot", slot).Warn("Could not prepare payload ahead of proposal")
+		return
+	}
+	var pid primitives.PayloadID
+	copy(pid[:], payloadID[:])
+	vs.PayloadIDCache.Set(slot, parentRoot, pid)
+}
+
+// trackedProposer returns the validator proposing at the given slot if the beacon node was
+// informed of it, via the validators/prepare_proposer endpoint, and it is active.
+func (vs *Server) trackedProposer(ctx context.Context, st state.ReadOnlyBeaconState, slot primitives.Slot) (cache.TrackedValidator, bool) {
+	if features.Get().PrepareAllPayloads {
+		return cache.TrackedValidator{Active: true}, true
+	}
+	idx, err := helpers.BeaconProposerIndexAtSlot(ctx, st, slot)
+	if err != nil {
+		return cache.TrackedValidator{}, false
+	}
+	val, ok := vs.TrackedValidatorsCache.Validator(idx)
+	if !ok {
+		return cache.TrackedValidator{}, false
+	}
+	return val, val.Active
+}
+
+// precomputedProposalFor returns the proposal precomputed for a block of the given slot and parent root, if any.
+func (vs *Server) precomputedProposalFor(slot primitives.Slot, parentRoot [32]byte) *precomputedProposal {
+	vs.precomputed.Lock()
+	defer vs.precomputed.Unlock()
+	p := vs.precomputed.proposal
+	if p == nil || p.slot != slot || p.parentRoot != parentRoot {
+		precomputedProposalMiss.Inc()
+		return nil
+	}
+	precomputedProposalHit.Inc()
+	return p
+}
@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_precomputeProposal(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 64)
+	headRoot := [32]byte{'a'}
+	vs := &Server{
+		SyncChecker:            &mockSync.Sync{},
+		HeadFetcher:            &mock.ChainService{State: st, Root: headRoot[:]},
+		TrackedValidatorsCache: cache.NewTrackedValidatorsCache(),
+		PayloadIDCache:         cache.NewPayloadIDCache(),
+		ExitPool:               voluntaryexits.NewPool(),
+		MockEth1Votes:          true,
+	}
+
+	// The head is not read while the beacon node tracks no validator.
+	vs.HeadFetcher = &mock.ChainService{}
+	require.NoError(t, vs.precomputeProposal(ctx, 1))
+	vs.HeadFetcher = &mock.ChainService{State: st, Root: headRoot[:]}
+
+	// The proposer of the slot is not tracked.
+	vs.TrackedValidatorsCache.Set(cache.TrackedValidator{Active: true, Index: 1 << 20})
+	require.NoError(t, vs.precomputeProposal(ctx, 1))
+	require.IsNil(t, vs.precomputedProposalFor(1, headRoot))
+
+	idx, err := helpers.BeaconProposerIndexAtSlot(ctx, st, 1)
+	require.NoError(t, err)
+	vs.TrackedValidatorsCache.Set(cache.TrackedValidator{Active: true, Index: idx})
+	require.NoError(t, vs.precomputeProposal(ctx, 1))
+	p := vs.precomputedProposalFor(1, headRoot)
+	require.NotNil(t, p)
+	require.NotNil(t, p.eth1Data)
+	require.Equal(t, 0, len(p.deposits))
+	// The head state is not advanced in place.
+	require.Equal(t, primitives.Slot(0), st.Slot())
+
+	// The proposal only applies to a block of the same slot and parent.
+	require.IsNil(t, vs.precomputedProposalFor(2, headRoot))
+	require.IsNil(t, vs.precomputedProposalFor(1, [32]byte{'b'}))
+}
@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	builderapi "github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/proposalreport"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/sirupsen/logrus"
+)
+
+// proposalRewardTimeout bounds the computation of the consensus reward of a proposed block.
+const proposalRewardTimeout = 30 * time.Second
+
+// recordBuild records the execution payload chosen for the block, so that the profitability of its proposal is
+// reported once it is proposed.
+func (vs *Server) recordBuild(blk interfaces.SignedBeaconBlock, local *blocks.GetPayloadResponse, bid builderapi.Bid, winningBid primitives.Wei) {
+	if vs.ProposalRecorder == nil || local == nil {
+		return
+	}
+	p := &proposalreport.Proposal{
+		Slot:           blk.Block().Slot(),
+		ProposerIndex:  blk.Block().ProposerIndex(),
+		Source:         proposalreport.SourceLocal,
+		LocalValue:     primitives.WeiToGwei(local.Bid),
+		ExecutionValue: primitives.WeiToGwei(winningBid),
+	}
+	if blk.IsBlinded() {
+		p.Source = proposalreport.SourceBuilder
+	}
+	if bid != nil && !bid.IsNil() {
+		p.BidValue = primitives.WeiToGwei(bid.Value())
+		p.HasBid = true
+		if vs.BlockBuilder != nil {
+			p.Relay = vs.BlockBuilder.RelayURL()
+		}
+	}
+	vs.ProposalRecorder.RecordBuild(p)
+}
+
+// recordProposal records the proposal of the block, and computes its consensus reward in the background.
+func (vs *Server) recordProposal(block interfaces.ReadOnlySignedBeaconBlock, root [32]byte) {
+	if vs.ProposalRecorder == nil {
+		return
+	}
+	blk := block.Block()
+	vs.ProposalRecorder.RecordProposal(blk.Slot(), blk.ProposerIndex(), root, time.Now())
+	if vs.BlockRewardFetcher == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(vs.Ctx, proposalRewardTimeout)
+		defer cancel()
+		fields := logrus.Fields{"slot": blk.Slot(), "blockRoot": root}
+		rewards, httpErr := vs.BlockRewardFetcher.GetBlockRewardsData(ctx, blk)
+		if httpErr != nil {
+			log.WithFields(fields).WithField("error", httpErr.Message).Debug("Could not compute consensus reward of proposed block")
+			return
+		}
+		total, err := strconv.ParseUint(rewards.Total, 10, 64)
+		if err != nil {
+			log.WithFields(fields).WithError(err).Debug("Could not parse consensus reward of proposed block")
+			return
+		}
+		vs.ProposalRecorder.SetConsensusReward(root, primitives.Gwei(total))
+	}()
+}
@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/proposalreport"
+	rewardtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/rewards/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_RecordProposal(t *testing.T) {
+	vs := &Server{
+		Ctx:                context.Background(),
+		ProposalRecorder:   proposalreport.NewRecorder(),
+		BlockRewardFetcher: &rewardtesting.MockBlockRewardFetcher{Rewards: &structs.BlockRewards{Total: "1234"}},
+	}
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 5
+	b.Block.ProposerIndex = 3
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	local := &blocks.GetPayloadResponse{Bid: primitives.Uint64ToWei(2_000_000_000)}
+	vs.recordBuild(blk, local, nil, local.Bid)
+	vs.recordProposal(blk, [32]byte{5})
+
+	// The consensus reward is computed in the background.
+	var proposals []*proposalreport.Proposal
+	for i := 0; i < 100; i++ {
+		proposals = vs.ProposalRecorder.Proposals(5, 5)
+		if len(proposals) == 1 && proposals[0].HasConsensusReward {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, 1, len(proposals))
+	p := proposals[0]
+	assert.Equal(t, primitives.ValidatorIndex(3), p.ProposerIndex)
+	assert.Equal(t, proposalreport.SourceLocal, p.Source)
+	assert.Equal(t, primitives.Gwei(2), p.LocalValue)
+	assert.Equal(t, primitives.Gwei(2), p.ExecutionValue)
+	assert.Equal(t, false, p.HasBid)
+	assert.Equal(t, primitives.Gwei(1234), p.ConsensusReward)
+}
@@ -1002,6 +1002,37 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 	}
 }
 
+type failingBroadcaster struct {
+	mockp2p.MockBroadcaster
+}
+
+func (*failingBroadcaster) Broadcast(context.Context, proto.Message) error {
+	return errors.New("could not broadcast")
+}
+
+func TestProposer_ProposeBlock_NotReceivedWhenBroadcastFails(t *testing.T) {
+	ctx := context.Background()
+	beaconState, _ := util.DeterministicGenesisState(t, 64)
+	bsRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	c := &mock.ChainService{Root: bsRoot[:], State: beaconState}
+	proposerServer := &Server{
+		BlockReceiver:     c,
+		BlockNotifier:     c.BlockNotifier(),
+		P2P:               &failingBroadcaster{},
+		BlobReceiver:      c,
+		OperationNotifier: c.OperationNotifier(),
+	}
+	blockToPropose := util.NewBeaconBlock()
+	blockToPropose.Block.Slot = 5
+	blockToPropose.Block.ParentRoot = bsRoot[:]
+	_, err = proposerServer.ProposeBeaconBlock(ctx, &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Phase0{Phase0: blockToPropose}})
+	require.ErrorContains(t, "could not broadcast", err)
+	// The block is not imported locally when it could not be broadcast.
+	assert.Equal(t, true, c.Block == nil)
+}
+
 func TestProposer_ComputeStateRoot_OK(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
@@ -23,7 +24,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/proposalreport"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/rewards"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
@@ -78,6 +81,20 @@ type Server struct {
 	BLSChangesPool         blstoexec.PoolManager
 	ClockWaiter            startup.ClockWaiter
 	CoreService            *core.Service
+	// GetPayloadSlotOffset is the earliest time into the slot at which the local payload is retrieved.
+	GetPayloadSlotOffset time.Duration
+	// GetPayloadRetryCachedID retries getPayload with the cached payload ID once after a timeout.
+	GetPayloadRetryCachedID bool
+	// ServeOptimisticDuties serves attestation, aggregation and sync committee duties with a warning while the node
+	// is optimistic, instead of rejecting them.
+	ServeOptimisticDuties bool
+	// Alerter, when set, is notified of the proposed blocks which do not pay the configured fee recipient.
+	Alerter alerts.Alerter
+	// ProposalRecorder, when set, records the profitability of the proposed blocks, whose consensus rewards are
+	// computed with BlockRewardFetcher.
+	ProposalRecorder   *proposalreport.Recorder
+	BlockRewardFetcher rewards.BlockRewardsFetcher
+	precomputed        proposalPrecomputeCache
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
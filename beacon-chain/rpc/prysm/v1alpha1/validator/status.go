@@ -272,6 +272,17 @@ func (vs *Server) optimisticStatus(ctx context.Context) error {
 	return status.Errorf(codes.Unavailable, errOptimisticMode.Error())
 }
 
+// optimisticDutyStatus returns an error if the node is currently optimistic with respect to head, unless the node
+// is configured to serve the duty while optimistic, in which case a warning is logged instead.
+func (vs *Server) optimisticDutyStatus(ctx context.Context, duty string) error {
+	err := vs.optimisticStatus(ctx)
+	if err == nil || !vs.ServeOptimisticDuties || status.Code(err) != codes.Unavailable {
+		return err
+	}
+	log.WithField("duty", duty).Warn("Serving duty while the node is optimistic, the head has not been validated by the execution client")
+	return nil
+}
+
 // validatorStatus searches for the requested validator's state and deposit to retrieve its inclusion estimate. Also returns the validators index.
 func (vs *Server) validatorStatus(
 	ctx context.Context,
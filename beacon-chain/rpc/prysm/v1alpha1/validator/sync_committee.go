@@ -18,7 +18,7 @@ func (vs *Server) GetSyncMessageBlockRoot(
 ) (*ethpb.SyncMessageBlockRootResponse, error) {
 	// An optimistic validator MUST NOT participate in sync committees
 	// (i.e., sign across the DOMAIN_SYNC_COMMITTEE, DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF or DOMAIN_CONTRIBUTION_AND_PROOF domains).
-	if err := vs.optimisticStatus(ctx); err != nil {
+	if err := vs.optimisticDutyStatus(ctx, "sync committee message"); err != nil {
 		return nil, err
 	}
 
@@ -64,7 +64,7 @@ func (vs *Server) GetSyncCommitteeContribution(
 ) (*ethpb.SyncCommitteeContribution, error) {
 	// An optimistic validator MUST NOT participate in sync committees
 	// (i.e., sign across the DOMAIN_SYNC_COMMITTEE, DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF or DOMAIN_CONTRIBUTION_AND_PROOF domains).
-	if err := vs.optimisticStatus(ctx); err != nil {
+	if err := vs.optimisticDutyStatus(ctx, "sync committee contribution"); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProduceAggregateAndProof constructs the aggregate and proof of an aggregator from its selection proof, for the
+// validator client to sign. It spares validator clients using the REST API fetching the attestation data and
+// the aggregate attestation in separate requests.
+func (s *Server) ProduceAggregateAndProof(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.ProduceAggregateAndProof")
+	defer span.End()
+
+	var req structs.AggregateSelectionRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	slot, ok := shared.ValidateUint(w, "slot", req.Slot)
+	if !ok {
+		return
+	}
+	committeeIndex, ok := shared.ValidateUint(w, "committee_index", req.CommitteeIndex)
+	if !ok {
+		return
+	}
+	pubkey, ok := shared.ValidateHex(w, "pubkey", req.Pubkey, fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+	selectionProof, ok := shared.ValidateHex(w, "selection_proof", req.SelectionProof, fieldparams.BLSSignatureLength)
+	if !ok {
+		return
+	}
+
+	selection := &ethpb.AggregateSelectionRequest{
+		Slot:           primitives.Slot(slot),
+		CommitteeIndex: primitives.CommitteeIndex(committeeIndex),
+		PublicKey:      pubkey,
+		SlotSignature:  selectionProof,
+	}
+	v := slots.ToForkVersion(selection.Slot)
+	var data interface{}
+	if slots.ToEpoch(selection.Slot) >= params.BeaconConfig().ElectraForkEpoch {
+		resp, err := s.V1Alpha1Server.SubmitAggregateSelectionProofElectra(ctx, selection)
+		if err != nil {
+			handleAggregateSelectionError(w, err)
+			return
+		}
+		data = structs.AggregateAttAndProofElectraFromConsensus(resp.AggregateAndProof)
+	} else {
+		resp, err := s.V1Alpha1Server.SubmitAggregateSelectionProof(ctx, selection)
+		if err != nil {
+			handleAggregateSelectionError(w, err)
+			return
+		}
+		data = structs.AggregateAttAndProofFromConsensus(resp.AggregateAndProof)
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal aggregate and proof: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, version.String(v))
+	httputil.WriteJson(w, &structs.AggregateAndProofResponse{
+		Version: version.String(v),
+		Data:    encoded,
+	})
+}
+
+func handleAggregateSelectionError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.Unavailable:
+		code = http.StatusServiceUnavailable
+	}
+	httputil.HandleError(w, "Could not produce aggregate and proof: "+err.Error(), code)
+}
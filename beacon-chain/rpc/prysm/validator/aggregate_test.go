@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/mock"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProduceAggregateAndProof(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pubkey := bytes.Repeat([]byte{1}, fieldparams.BLSPubkeyLength)
+	selectionProof := bytes.Repeat([]byte{2}, fieldparams.BLSSignatureLength)
+	selection := &ethpb.AggregateSelectionRequest{
+		Slot:           32,
+		CommitteeIndex: 3,
+		PublicKey:      pubkey,
+		SlotSignature:  selectionProof,
+	}
+	body, err := json.Marshal(&structs.AggregateSelectionRequest{
+		Slot:           "32",
+		CommitteeIndex: "3",
+		Pubkey:         hexutil.Encode(pubkey),
+		SelectionProof: hexutil.Encode(selectionProof),
+	})
+	require.NoError(t, err)
+
+	t.Run("ok", func(t *testing.T) {
+		aggregateAndProof := &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: 7,
+			Aggregate:       util.HydrateAttestation(&ethpb.Attestation{AggregationBits: []byte{0b11}}),
+			SelectionProof:  selectionProof,
+		}
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().SubmitAggregateSelectionProof(gomock.Any(), selection).Return(
+			&ethpb.AggregateSelectionResponse{AggregateAndProof: aggregateAndProof}, nil)
+		s := &Server{V1Alpha1Server: v1alpha1Server}
+
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validator/aggregate_and_proof", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ProduceAggregateAndProof(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "phase0", writer.Header().Get(api.VersionHeader))
+		resp := &structs.AggregateAndProofResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "phase0", resp.Version)
+		got := &structs.AggregateAttestationAndProof{}
+		require.NoError(t, json.Unmarshal(resp.Data, got))
+		consensus, err := got.ToConsensus()
+		require.NoError(t, err)
+		assert.DeepEqual(t, aggregateAndProof, consensus)
+	})
+	t.Run("not an aggregator", func(t *testing.T) {
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().SubmitAggregateSelectionProof(gomock.Any(), selection).Return(
+			nil, status.Error(codes.InvalidArgument, "Validator is not an aggregator"))
+		s := &Server{V1Alpha1Server: v1alpha1Server}
+
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validator/aggregate_and_proof", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ProduceAggregateAndProof(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "Validator is not an aggregator", writer.Body.String())
+	})
+	t.Run("invalid selection proof", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validator/aggregate_and_proof",
+			strings.NewReader(`{"slot":"32","committee_index":"3","pubkey":"`+hexutil.Encode(pubkey)+`","selection_proof":"0x01"}`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ProduceAggregateAndProof(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "selection_proof", writer.Body.String())
+	})
+	t.Run("no body", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validator/aggregate_and_proof", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ProduceAggregateAndProof(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "No data submitted", writer.Body.String())
+	})
+}
@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+const (
+	// MaxBalanceHistoryEpochs is the maximum number of epochs which can be queried at once with GetBalanceHistory.
+	MaxBalanceHistoryEpochs = 1024
+	// defaultBalanceHistoryEpochs is the number of epochs queried when start_epoch is not set.
+	defaultBalanceHistoryEpochs = 100
+)
+
+// GetBalanceHistory returns the balances of a validator, identified by its index or public key, archived at the
+// start of each epoch of the range, along with the change of the balance since the previous epoch. The epoch range
+// defaults to the last 100 archived epochs.
+func (s *Server) GetBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetBalanceHistory")
+	defer span.End()
+
+	if s.BalanceArchive == nil {
+		httputil.HandleError(w, "Balance archive is not enabled on this node", http.StatusServiceUnavailable)
+		return
+	}
+
+	valId := r.PathValue("validator_id")
+	if valId == "" {
+		httputil.HandleError(w, "validator_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	var valIndex primitives.ValidatorIndex
+	if strings.HasPrefix(valId, "0x") {
+		pubkey, err := hexutil.Decode(valId)
+		if err != nil || len(pubkey) != fieldparams.BLSPubkeyLength {
+			httputil.HandleError(w, "Invalid validator_id: "+valId, http.StatusBadRequest)
+			return
+		}
+		st, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+		if err != nil {
+			httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		if !ok {
+			httputil.HandleError(w, "Unknown validator: "+valId, http.StatusNotFound)
+			return
+		}
+		valIndex = idx
+	} else {
+		idx, err := strconv.ParseUint(valId, 10, 64)
+		if err != nil {
+			httputil.HandleError(w, "Invalid validator_id: "+valId, http.StatusBadRequest)
+			return
+		}
+		valIndex = primitives.ValidatorIndex(idx)
+	}
+
+	_, lastArchived, archived := s.BalanceArchive.ArchivedRange()
+	rawEnd, end, ok := shared.UintFromQuery(w, r, "end_epoch", false)
+	if !ok {
+		return
+	}
+	endEpoch := primitives.Epoch(end)
+	if rawEnd == "" {
+		endEpoch = lastArchived
+	}
+	rawStart, start, ok := shared.UintFromQuery(w, r, "start_epoch", false)
+	if !ok {
+		return
+	}
+	startEpoch := primitives.Epoch(start)
+	if rawStart == "" && endEpoch >= defaultBalanceHistoryEpochs {
+		startEpoch = endEpoch - (defaultBalanceHistoryEpochs - 1)
+	}
+	if startEpoch > endEpoch {
+		httputil.HandleError(w, "start_epoch must not be greater than end_epoch", http.StatusBadRequest)
+		return
+	}
+	if endEpoch-startEpoch >= MaxBalanceHistoryEpochs {
+		httputil.HandleError(w, fmt.Sprintf("Cannot query more than %d epochs at once", MaxBalanceHistoryEpochs), http.StatusBadRequest)
+		return
+	}
+
+	resp := &structs.GetBalanceHistoryResponse{
+		ValidatorIndex: fmt.Sprintf("%d", valIndex),
+		StartEpoch:     fmt.Sprintf("%d", startEpoch),
+		EndEpoch:       fmt.Sprintf("%d", endEpoch),
+		Balances:       make([]*structs.ArchivedBalance, 0),
+	}
+	if !archived {
+		httputil.WriteJson(w, resp)
+		return
+	}
+	records, err := s.BalanceArchive.BalanceHistory(valIndex, startEpoch, endEpoch)
+	if err != nil {
+		httputil.HandleError(w, "Could not get balance history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, rec := range records {
+		b := &structs.ArchivedBalance{
+			Epoch:   fmt.Sprintf("%d", rec.Epoch),
+			Balance: fmt.Sprintf("%d", rec.Balance),
+		}
+		if rec.HasChange {
+			b.Change = fmt.Sprintf("%d", rec.Change)
+		}
+		resp.Balances = append(resp.Balances, b)
+	}
+	httputil.WriteJson(w, resp)
+}
@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/balancearchive"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockBalanceArchive struct {
+	validatorIndex       primitives.ValidatorIndex
+	startEpoch, endEpoch primitives.Epoch
+	last                 primitives.Epoch
+	records              []*balancearchive.BalanceRecord
+}
+
+func (m *mockBalanceArchive) BalanceHistory(idx primitives.ValidatorIndex, start, end primitives.Epoch) ([]*balancearchive.BalanceRecord, error) {
+	m.validatorIndex, m.startEpoch, m.endEpoch = idx, start, end
+	return m.records, nil
+}
+
+func (m *mockBalanceArchive) ArchivedRange() (first, last primitives.Epoch, ok bool) {
+	return 0, m.last, true
+}
+
+func TestServer_GetBalanceHistory(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 4)
+	archive := &mockBalanceArchive{last: 300, records: []*balancearchive.BalanceRecord{
+		{Epoch: 5, Balance: 32_000_000_000},
+		{Epoch: 6, Balance: 31_999_999_990, Change: -10, HasChange: true},
+	}}
+	s := &Server{
+		ChainInfoFetcher: &mock.ChainService{State: st},
+		BalanceArchive:   archive,
+	}
+
+	t.Run("by index with range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/2/balance_history?start_epoch=5&end_epoch=6", nil)
+		request.SetPathValue("validator_id", "2")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBalanceHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetBalanceHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, primitives.ValidatorIndex(2), archive.validatorIndex)
+		require.Equal(t, primitives.Epoch(5), archive.startEpoch)
+		require.Equal(t, primitives.Epoch(6), archive.endEpoch)
+		require.Equal(t, "2", resp.ValidatorIndex)
+		require.Equal(t, 2, len(resp.Balances))
+		require.Equal(t, "32000000000", resp.Balances[0].Balance)
+		require.Equal(t, "", resp.Balances[0].Change)
+		require.Equal(t, "6", resp.Balances[1].Epoch)
+		require.Equal(t, "-10", resp.Balances[1].Change)
+	})
+	t.Run("by pubkey with default range", func(t *testing.T) {
+		key := st.PubkeyAtIndex(3)
+		pubkey := hexutil.Encode(key[:])
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/"+pubkey+"/balance_history", nil)
+		request.SetPathValue("validator_id", pubkey)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBalanceHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, primitives.ValidatorIndex(3), archive.validatorIndex)
+		require.Equal(t, primitives.Epoch(300-defaultBalanceHistoryEpochs+1), archive.startEpoch)
+		require.Equal(t, primitives.Epoch(300), archive.endEpoch)
+	})
+	t.Run("range too large", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/2/balance_history?start_epoch=0&end_epoch=5000", nil)
+		request.SetPathValue("validator_id", "2")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBalanceHistory(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		require.StringContains(t, "Cannot query more than", writer.Body.String())
+	})
+	t.Run("archive disabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/2/balance_history", nil)
+		request.SetPathValue("validator_id", "2")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{}).GetBalanceHistory(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
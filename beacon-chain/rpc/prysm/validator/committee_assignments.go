@@ -0,0 +1,127 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// GetCommitteeAssignments returns the committee assignments of the requested validators in an epoch, which can be any
+// epoch up to the next one. Unlike the attester duties, past epochs are served too, and unlike the committees of the
+// state, only the committees of the requested validators are returned. The shuffling of the epoch is computed once
+// and kept in the committee cache, so that the assignments of later requests for the same epoch are cheap.
+func (s *Server) GetCommitteeAssignments(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetCommitteeAssignments")
+	defer span.End()
+
+	_, e, ok := shared.UintFromRoute(w, r, "epoch")
+	if !ok {
+		return
+	}
+	epoch := primitives.Epoch(e)
+	var rawIndices []string
+	err := json.NewDecoder(r.Body).Decode(&rawIndices)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(rawIndices) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	indices := make([]primitives.ValidatorIndex, len(rawIndices))
+	for i, ix := range rawIndices {
+		index, valid := shared.ValidateUint(w, fmt.Sprintf("ValidatorIndices[%d]", i), ix)
+		if !valid {
+			return
+		}
+		indices[i] = primitives.ValidatorIndex(index)
+	}
+
+	currentEpoch := slots.ToEpoch(s.TimeFetcher.CurrentSlot())
+	if epoch > currentEpoch+1 {
+		httputil.HandleError(w, fmt.Sprintf("Request epoch %d can not be greater than next epoch %d", epoch, currentEpoch+1), http.StatusBadRequest)
+		return
+	}
+	// The assignments of the next epoch are known from the state of the current epoch.
+	stateEpoch := epoch
+	if epoch == currentEpoch+1 {
+		stateEpoch = currentEpoch
+	}
+	startSlot, err := slots.EpochStart(stateEpoch)
+	if err != nil {
+		httputil.HandleError(w, fmt.Sprintf("Could not get start slot of epoch %d: %v", stateEpoch, err), http.StatusInternalServerError)
+		return
+	}
+	st, err := s.Stater.StateBySlot(ctx, startSlot)
+	if err != nil {
+		httputil.HandleError(w, "Could not get state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, index := range indices {
+		if uint64(index) >= uint64(st.NumValidators()) {
+			httputil.HandleError(w, fmt.Sprintf("Invalid validator index %d", index), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Fill the committee cache with the shuffling of the epoch, so that the committees are not computed one by one.
+	if err := helpers.UpdateCommitteeCache(ctx, st, epoch); err != nil {
+		httputil.HandleError(w, "Could not update committee cache: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	assignments, err := helpers.CommitteeAssignments(ctx, st, epoch, indices)
+	if err != nil {
+		httputil.HandleError(w, "Could not compute committee assignments: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	activeCount, err := helpers.ActiveValidatorCount(ctx, st, epoch)
+	if err != nil {
+		httputil.HandleError(w, "Could not get active validator count: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	committeesAtSlot := helpers.SlotCommitteeCount(activeCount)
+
+	data := make([]*structs.CommitteeAssignment, 0, len(indices))
+	for _, index := range indices {
+		a, ok := assignments[index]
+		if !ok {
+			// The validator is not active in the epoch.
+			continue
+		}
+		data = append(data, committeeAssignment(index, a, committeesAtSlot))
+	}
+	httputil.WriteJson(w, &structs.GetCommitteeAssignmentsResponse{Epoch: fmt.Sprintf("%d", epoch), Data: data})
+}
+
+func committeeAssignment(index primitives.ValidatorIndex, a *helpers.CommitteeAssignment, committeesAtSlot uint64) *structs.CommitteeAssignment {
+	var position int
+	for i, v := range a.Committee {
+		if v == index {
+			position = i
+			break
+		}
+	}
+	return &structs.CommitteeAssignment{
+		ValidatorIndex:          fmt.Sprintf("%d", index),
+		Slot:                    fmt.Sprintf("%d", a.AttesterSlot),
+		CommitteeIndex:          fmt.Sprintf("%d", a.CommitteeIndex),
+		CommitteeLength:         fmt.Sprintf("%d", len(a.Committee)),
+		CommitteesAtSlot:        fmt.Sprintf("%d", committeesAtSlot),
+		ValidatorCommitteeIndex: fmt.Sprintf("%d", position),
+	}
+}
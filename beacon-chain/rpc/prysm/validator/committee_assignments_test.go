@@ -0,0 +1,98 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetCommitteeAssignments(t *testing.T) {
+	helpers.ClearCache()
+	st, _ := util.DeterministicGenesisState(t, 64)
+	spe := params.BeaconConfig().SlotsPerEpoch
+	currentSlot := spe + 3
+	currentSt := st.Copy()
+	require.NoError(t, currentSt.SetSlot(spe))
+	s := &Server{
+		Stater:      &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{0: st, spe: currentSt}},
+		TimeFetcher: &mock.ChainService{Slot: &currentSlot},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/committee_assignments/{epoch}", bytes.NewBufferString(`["1","5"]`))
+		request.SetPathValue("epoch", "0")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetCommitteeAssignments(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetCommitteeAssignmentsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "0", resp.Epoch)
+		require.Equal(t, 2, len(resp.Data))
+
+		assignments, err := helpers.CommitteeAssignments(context.Background(), st, 0, []primitives.ValidatorIndex{1, 5})
+		require.NoError(t, err)
+		for i, index := range []primitives.ValidatorIndex{1, 5} {
+			a := assignments[index]
+			assert.Equal(t, fmt.Sprintf("%d", index), resp.Data[i].ValidatorIndex)
+			assert.Equal(t, fmt.Sprintf("%d", a.AttesterSlot), resp.Data[i].Slot)
+			assert.Equal(t, fmt.Sprintf("%d", a.CommitteeIndex), resp.Data[i].CommitteeIndex)
+			assert.Equal(t, fmt.Sprintf("%d", len(a.Committee)), resp.Data[i].CommitteeLength)
+		}
+	})
+	t.Run("next epoch", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/committee_assignments/{epoch}", bytes.NewBufferString(`["1"]`))
+		request.SetPathValue("epoch", "2")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetCommitteeAssignments(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetCommitteeAssignmentsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 1, len(resp.Data))
+	})
+	t.Run("epoch too far", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/committee_assignments/{epoch}", bytes.NewBufferString(`["1"]`))
+		request.SetPathValue("epoch", "3")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetCommitteeAssignments(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/committee_assignments/{epoch}", bytes.NewBufferString(`["64"]`))
+		request.SetPathValue("epoch", "0")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetCommitteeAssignments(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("no data", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/committee_assignments/{epoch}", bytes.NewBufferString(`[]`))
+		request.SetPathValue("epoch", "0")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetCommitteeAssignments(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
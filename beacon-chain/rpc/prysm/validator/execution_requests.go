@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+const (
+	// requestStatusQueued is the status of a request waiting for its epoch to be reached.
+	requestStatusQueued = "queued"
+	// requestStatusProcessable is the status of a request whose epoch was reached, which is processed
+	// by the next blocks or epoch transition, depending on the churn.
+	requestStatusProcessable = "processable"
+)
+
+// GetExecutionRequests returns the progress of the execution layer requests affecting a validator, identified by
+// its index or public key: its exit, its queued partial withdrawals and the queued consolidations it takes part in.
+// Requests are read from the state given by the state_id query parameter, which defaults to the head state. Once
+// processed, requests leave the queues of the state, and their effects show in the balances of the validators.
+func (s *Server) GetExecutionRequests(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetExecutionRequests")
+	defer span.End()
+
+	valId := r.PathValue("validator_id")
+	if valId == "" {
+		httputil.HandleError(w, "validator_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	stateId := r.URL.Query().Get("state_id")
+	if stateId == "" {
+		stateId = "head"
+	}
+	st, err := s.Stater.State(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+
+	var valIndex primitives.ValidatorIndex
+	if strings.HasPrefix(valId, "0x") {
+		pubkey, err := hexutil.Decode(valId)
+		if err != nil || len(pubkey) != fieldparams.BLSPubkeyLength {
+			httputil.HandleError(w, "Invalid validator_id: "+valId, http.StatusBadRequest)
+			return
+		}
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		if !ok {
+			httputil.HandleError(w, "Unknown validator: "+valId, http.StatusNotFound)
+			return
+		}
+		valIndex = idx
+	} else {
+		idx, err := strconv.ParseUint(valId, 10, 64)
+		if err != nil {
+			httputil.HandleError(w, "Invalid validator_id: "+valId, http.StatusBadRequest)
+			return
+		}
+		valIndex = primitives.ValidatorIndex(idx)
+	}
+	if uint64(valIndex) >= uint64(st.NumValidators()) {
+		httputil.HandleError(w, "Unknown validator: "+valId, http.StatusNotFound)
+		return
+	}
+	val, err := st.ValidatorAtIndexReadOnly(valIndex)
+	if err != nil {
+		httputil.HandleError(w, "Could not get validator: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	epoch := slots.ToEpoch(st.Slot())
+	resp := &structs.GetExecutionRequestsResponse{
+		ValidatorIndex:           fmt.Sprintf("%d", valIndex),
+		Epoch:                    fmt.Sprintf("%d", epoch),
+		PendingBalanceToWithdraw: "0",
+		PartialWithdrawals:       make([]*structs.PartialWithdrawalRequestInfo, 0),
+		Consolidations:           make([]*structs.ConsolidationRequestInfo, 0),
+	}
+	if val.ExitEpoch() != params.BeaconConfig().FarFutureEpoch {
+		resp.ExitEpoch = fmt.Sprintf("%d", val.ExitEpoch())
+		resp.WithdrawableEpoch = fmt.Sprintf("%d", val.WithdrawableEpoch())
+	}
+	// There are no execution layer requests before Electra.
+	if st.Version() < version.Electra {
+		httputil.WriteJson(w, resp)
+		return
+	}
+
+	pendingBalance, err := st.PendingBalanceToWithdraw(valIndex)
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending balance to withdraw: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp.PendingBalanceToWithdraw = fmt.Sprintf("%d", pendingBalance)
+	withdrawals, err := st.PendingPartialWithdrawals()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending partial withdrawals: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, pw := range withdrawals {
+		if pw.Index != valIndex {
+			continue
+		}
+		resp.PartialWithdrawals = append(resp.PartialWithdrawals, &structs.PartialWithdrawalRequestInfo{
+			Amount:            fmt.Sprintf("%d", pw.Amount),
+			WithdrawableEpoch: fmt.Sprintf("%d", pw.WithdrawableEpoch),
+			Status:            requestStatus(epoch, pw.WithdrawableEpoch),
+		})
+	}
+	consolidations, err := st.PendingConsolidations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending consolidations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, pc := range consolidations {
+		if pc.SourceIndex != valIndex && pc.TargetIndex != valIndex {
+			continue
+		}
+		// A consolidation is processed once its source validator is withdrawable.
+		source, err := st.ValidatorAtIndexReadOnly(pc.SourceIndex)
+		if err != nil {
+			httputil.HandleError(w, "Could not get consolidation source validator: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Consolidations = append(resp.Consolidations, &structs.ConsolidationRequestInfo{
+			SourceIndex:       fmt.Sprintf("%d", pc.SourceIndex),
+			TargetIndex:       fmt.Sprintf("%d", pc.TargetIndex),
+			WithdrawableEpoch: fmt.Sprintf("%d", source.WithdrawableEpoch()),
+			Status:            requestStatus(epoch, source.WithdrawableEpoch()),
+		})
+	}
+	httputil.WriteJson(w, resp)
+}
+
+func requestStatus(current, processable primitives.Epoch) string {
+	if current < processable {
+		return requestStatusQueued
+	}
+	return requestStatusProcessable
+}
@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetExecutionRequests(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateElectra(t, 4)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*10))
+	require.NoError(t, st.AppendPendingPartialWithdrawal(&ethpb.PendingPartialWithdrawal{Index: 1, Amount: 100, WithdrawableEpoch: 12}))
+	require.NoError(t, st.AppendPendingPartialWithdrawal(&ethpb.PendingPartialWithdrawal{Index: 2, Amount: 200, WithdrawableEpoch: 9}))
+	require.NoError(t, st.AppendPendingPartialWithdrawal(&ethpb.PendingPartialWithdrawal{Index: 1, Amount: 300, WithdrawableEpoch: 10}))
+	// Validator 3 consolidates into validator 1.
+	source, err := st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	source.ExitEpoch = 8
+	source.WithdrawableEpoch = 11
+	require.NoError(t, st.UpdateValidatorAtIndex(3, source))
+	require.NoError(t, st.AppendPendingConsolidation(&ethpb.PendingConsolidation{SourceIndex: 3, TargetIndex: 1}))
+	s := &Server{Stater: &testutil.MockStater{BeaconState: st}}
+
+	t.Run("target of a consolidation", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/1/execution_requests", nil)
+		request.SetPathValue("validator_id", "1")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetExecutionRequests(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetExecutionRequestsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "1", resp.ValidatorIndex)
+		assert.Equal(t, "10", resp.Epoch)
+		assert.Equal(t, "", resp.ExitEpoch)
+		assert.Equal(t, "400", resp.PendingBalanceToWithdraw)
+		require.Equal(t, 2, len(resp.PartialWithdrawals))
+		assert.DeepEqual(t, &structs.PartialWithdrawalRequestInfo{Amount: "100", WithdrawableEpoch: "12", Status: "queued"}, resp.PartialWithdrawals[0])
+		assert.DeepEqual(t, &structs.PartialWithdrawalRequestInfo{Amount: "300", WithdrawableEpoch: "10", Status: "processable"}, resp.PartialWithdrawals[1])
+		require.Equal(t, 1, len(resp.Consolidations))
+		assert.DeepEqual(t, &structs.ConsolidationRequestInfo{SourceIndex: "3", TargetIndex: "1", WithdrawableEpoch: "11", Status: "queued"}, resp.Consolidations[0])
+	})
+	t.Run("source of a consolidation by pubkey", func(t *testing.T) {
+		pubkey := st.PubkeyAtIndex(3)
+		valId := hexutil.Encode(pubkey[:])
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/"+valId+"/execution_requests", nil)
+		request.SetPathValue("validator_id", valId)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetExecutionRequests(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetExecutionRequestsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "3", resp.ValidatorIndex)
+		assert.Equal(t, "8", resp.ExitEpoch)
+		assert.Equal(t, "11", resp.WithdrawableEpoch)
+		assert.Equal(t, 0, len(resp.PartialWithdrawals))
+		require.Equal(t, 1, len(resp.Consolidations))
+		assert.Equal(t, "3", resp.Consolidations[0].SourceIndex)
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/10/execution_requests", nil)
+		request.SetPathValue("validator_id", "10")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetExecutionRequests(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid validator id", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/foo/execution_requests", nil)
+		request.SetPathValue("validator_id", "foo")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetExecutionRequests(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "Invalid validator_id", writer.Body.String())
+	})
+	t.Run("before Electra", func(t *testing.T) {
+		phase0, _ := util.DeterministicGenesisState(t, 4)
+		s := &Server{Stater: &testutil.MockStater{BeaconState: phase0}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/1/execution_requests", nil)
+		request.SetPathValue("validator_id", "1")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetExecutionRequests(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetExecutionRequestsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "0", resp.PendingBalanceToWithdraw)
+		assert.Equal(t, 0, len(resp.PartialWithdrawals))
+		assert.Equal(t, 0, len(resp.Consolidations))
+	})
+}
@@ -0,0 +1,89 @@
+package validator
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetMonitoredValidators returns the indices of the validators tracked by the validator monitor.
+func (s *Server) GetMonitoredValidators(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetMonitoredValidators")
+	defer span.End()
+
+	httputil.WriteJson(w, s.monitoredValidatorsResponse())
+}
+
+// AddMonitoredValidators starts tracking the validators with the validator monitor. The set of tracked
+// validators is persisted, so that they are still tracked after a restart.
+func (s *Server) AddMonitoredValidators(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.AddMonitoredValidators")
+	defer span.End()
+
+	indices, ok := decodeMonitoredValidatorsRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := s.ValidatorMonitor.AddTrackedValidators(ctx, indices); err != nil {
+		httputil.HandleError(w, "Could not add monitored validators: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, s.monitoredValidatorsResponse())
+}
+
+// RemoveMonitoredValidators stops tracking the validators with the validator monitor.
+func (s *Server) RemoveMonitoredValidators(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.RemoveMonitoredValidators")
+	defer span.End()
+
+	indices, ok := decodeMonitoredValidatorsRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := s.ValidatorMonitor.RemoveTrackedValidators(indices); err != nil {
+		httputil.HandleError(w, "Could not remove monitored validators: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, s.monitoredValidatorsResponse())
+}
+
+func (s *Server) monitoredValidatorsResponse() *structs.MonitoredValidatorsResponse {
+	tracked := s.ValidatorMonitor.TrackedValidatorIndices()
+	indices := make([]string, len(tracked))
+	for i, idx := range tracked {
+		indices[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	return &structs.MonitoredValidatorsResponse{Indices: indices}
+}
+
+func decodeMonitoredValidatorsRequest(w http.ResponseWriter, r *http.Request) ([]primitives.ValidatorIndex, bool) {
+	var req structs.MonitoredValidatorsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errJson := &httputil.DefaultJsonError{
+			Message: errors.Wrapf(err, "Could not decode request body into monitored validators").Error(),
+			Code:    http.StatusBadRequest,
+		}
+		httputil.WriteError(w, errJson)
+		return nil, false
+	}
+	if len(req.Indices) == 0 {
+		httputil.HandleError(w, "No validator indices provided", http.StatusBadRequest)
+		return nil, false
+	}
+	indices := make([]primitives.ValidatorIndex, len(req.Indices))
+	for i, raw := range req.Indices {
+		idx, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			httputil.HandleError(w, "Invalid validator index: "+raw, http.StatusBadRequest)
+			return nil, false
+		}
+		indices[i] = primitives.ValidatorIndex(idx)
+	}
+	return indices, true
+}
@@ -0,0 +1,82 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockValidatorMonitor struct {
+	tracked map[primitives.ValidatorIndex]bool
+}
+
+func (m *mockValidatorMonitor) TrackedValidatorIndices() []primitives.ValidatorIndex {
+	indices := make([]primitives.ValidatorIndex, 0, len(m.tracked))
+	for idx := range m.tracked {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	return indices
+}
+
+func (m *mockValidatorMonitor) AddTrackedValidators(_ context.Context, indices []primitives.ValidatorIndex) error {
+	for _, idx := range indices {
+		m.tracked[idx] = true
+	}
+	return nil
+}
+
+func (m *mockValidatorMonitor) RemoveTrackedValidators(indices []primitives.ValidatorIndex) error {
+	for _, idx := range indices {
+		delete(m.tracked, idx)
+	}
+	return nil
+}
+
+func TestServer_MonitoredValidators(t *testing.T) {
+	s := &Server{ValidatorMonitor: &mockValidatorMonitor{tracked: map[primitives.ValidatorIndex]bool{7: true}}}
+
+	call := func(t *testing.T, method string, body string, handler http.HandlerFunc) (int, *structs.MonitoredValidatorsResponse) {
+		request := httptest.NewRequest(method, "http://example.com/prysm/v1/validators/monitor", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		handler(writer, request)
+		resp := &structs.MonitoredValidatorsResponse{}
+		if writer.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		}
+		return writer.Code, resp
+	}
+
+	t.Run("get", func(t *testing.T) {
+		code, resp := call(t, http.MethodGet, "", s.GetMonitoredValidators)
+		require.Equal(t, http.StatusOK, code)
+		require.DeepEqual(t, []string{"7"}, resp.Indices)
+	})
+	t.Run("add", func(t *testing.T) {
+		code, resp := call(t, http.MethodPost, `{"indices":["12","3"]}`, s.AddMonitoredValidators)
+		require.Equal(t, http.StatusOK, code)
+		require.DeepEqual(t, []string{"3", "7", "12"}, resp.Indices)
+	})
+	t.Run("remove", func(t *testing.T) {
+		code, resp := call(t, http.MethodDelete, `{"indices":["7"]}`, s.RemoveMonitoredValidators)
+		require.Equal(t, http.StatusOK, code)
+		require.DeepEqual(t, []string{"3", "12"}, resp.Indices)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		code, _ := call(t, http.MethodPost, `{"indices":["abc"]}`, s.AddMonitoredValidators)
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("no indices", func(t *testing.T) {
+		code, _ := call(t, http.MethodDelete, `{"indices":[]}`, s.RemoveMonitoredValidators)
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
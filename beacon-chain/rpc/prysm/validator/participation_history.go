@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+const (
+	// MaxParticipationHistoryEpochs is the maximum number of epochs which can be queried at once with
+	// GetParticipationHistory.
+	MaxParticipationHistoryEpochs = 1024
+	// defaultParticipationHistoryEpochs is the number of epochs queried when start_epoch is not set.
+	defaultParticipationHistoryEpochs = 100
+)
+
+// GetParticipationHistory returns the attestation participation of each epoch of the range: the balance of the
+// active validators, and the balance and share of it which attested to the correct source, target and head. The
+// participation of an epoch is recorded once the head of the canonical chain reaches the next epoch, and the epoch
+// range defaults to the last 100 epochs.
+func (s *Server) GetParticipationHistory(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetParticipationHistory")
+	defer span.End()
+
+	rawEnd, end, ok := shared.UintFromQuery(w, r, "end_epoch", false)
+	if !ok {
+		return
+	}
+	endEpoch := primitives.Epoch(end)
+	if rawEnd == "" {
+		endEpoch = slots.ToEpoch(s.ChainInfoFetcher.HeadSlot())
+	}
+	rawStart, start, ok := shared.UintFromQuery(w, r, "start_epoch", false)
+	if !ok {
+		return
+	}
+	startEpoch := primitives.Epoch(start)
+	if rawStart == "" && endEpoch >= defaultParticipationHistoryEpochs {
+		startEpoch = endEpoch - (defaultParticipationHistoryEpochs - 1)
+	}
+	if startEpoch > endEpoch {
+		httputil.HandleError(w, "start_epoch must not be greater than end_epoch", http.StatusBadRequest)
+		return
+	}
+	if endEpoch-startEpoch >= MaxParticipationHistoryEpochs {
+		httputil.HandleError(w, fmt.Sprintf("Cannot query more than %d epochs at once", MaxParticipationHistoryEpochs), http.StatusBadRequest)
+		return
+	}
+
+	history := s.ParticipationFetcher.ParticipationHistory(startEpoch, endEpoch)
+	resp := &structs.GetParticipationHistoryResponse{
+		StartEpoch: fmt.Sprintf("%d", startEpoch),
+		EndEpoch:   fmt.Sprintf("%d", endEpoch),
+		Data:       make([]*structs.EpochParticipation, len(history)),
+	}
+	for i, p := range history {
+		resp.Data[i] = &structs.EpochParticipation{
+			Epoch:      fmt.Sprintf("%d", p.Epoch),
+			ActiveGwei: fmt.Sprintf("%d", p.ActiveGwei),
+			SourceGwei: fmt.Sprintf("%d", p.SourceGwei),
+			TargetGwei: fmt.Sprintf("%d", p.TargetGwei),
+			HeadGwei:   fmt.Sprintf("%d", p.HeadGwei),
+			SourceRate: strconv.FormatFloat(p.SourceRate(), 'f', 4, 64),
+			TargetRate: strconv.FormatFloat(p.TargetRate(), 'f', 4, 64),
+			HeadRate:   strconv.FormatFloat(p.HeadRate(), 'f', 4, 64),
+		}
+	}
+	httputil.WriteJson(w, resp)
+}
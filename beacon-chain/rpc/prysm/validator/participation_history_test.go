@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockParticipationFetcher struct {
+	startEpoch, endEpoch primitives.Epoch
+	history              []*blockchain.EpochParticipation
+}
+
+func (m *mockParticipationFetcher) ParticipationHistory(start, end primitives.Epoch) []*blockchain.EpochParticipation {
+	m.startEpoch, m.endEpoch = start, end
+	return m.history
+}
+
+func TestServer_GetParticipationHistory(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 4)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*300))
+	fetcher := &mockParticipationFetcher{history: []*blockchain.EpochParticipation{
+		{Epoch: 10, ActiveGwei: 1000, SourceGwei: 990, TargetGwei: 950, HeadGwei: 900},
+	}}
+	s := &Server{
+		ChainInfoFetcher:     &mock.ChainService{State: st},
+		ParticipationFetcher: fetcher,
+	}
+
+	t.Run("with range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/participation_history?start_epoch=5&end_epoch=20", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetParticipationHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetParticipationHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, primitives.Epoch(5), fetcher.startEpoch)
+		require.Equal(t, primitives.Epoch(20), fetcher.endEpoch)
+		require.Equal(t, 1, len(resp.Data))
+		require.Equal(t, "10", resp.Data[0].Epoch)
+		require.Equal(t, "950", resp.Data[0].TargetGwei)
+		require.Equal(t, "0.9900", resp.Data[0].SourceRate)
+		require.Equal(t, "0.9500", resp.Data[0].TargetRate)
+		require.Equal(t, "0.9000", resp.Data[0].HeadRate)
+	})
+	t.Run("default range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/participation_history", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetParticipationHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, primitives.Epoch(300-defaultParticipationHistoryEpochs+1), fetcher.startEpoch)
+		require.Equal(t, primitives.Epoch(300), fetcher.endEpoch)
+	})
+	t.Run("range too large", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/participation_history?start_epoch=0&end_epoch=5000", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetParticipationHistory(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		require.StringContains(t, "Cannot query more than", writer.Body.String())
+	})
+}
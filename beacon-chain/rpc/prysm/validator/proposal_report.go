@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/proposalreport"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// defaultProposalReportEpochs is the number of epochs reported when start_epoch is not set, about a day.
+const defaultProposalReportEpochs = 225
+
+// GetProposalReport returns the profitability of the blocks proposed through this node from start_epoch to end_epoch,
+// in total and per payload source and relay: the value of their execution payloads, their consensus rewards, and the
+// bids of the relays, including those which were higher than the proposed payloads. The epoch range defaults to the
+// last 225 epochs.
+func (s *Server) GetProposalReport(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetProposalReport")
+	defer span.End()
+
+	if s.ProposalRecorder == nil {
+		httputil.HandleError(w, "Proposal report is not available", http.StatusServiceUnavailable)
+		return
+	}
+	start, end, ok := s.proposalReportRange(w, r)
+	if !ok {
+		return
+	}
+
+	report := s.ProposalRecorder.NewReport(start, end)
+	resp := &structs.GetProposalReportResponse{
+		StartSlot: fmt.Sprintf("%d", report.StartSlot),
+		EndSlot:   fmt.Sprintf("%d", report.EndSlot),
+		Total:     proposalSummaryToJson(report.Total),
+		Sources:   make([]*structs.ProposalSummary, len(report.Sources)),
+	}
+	for i, summary := range report.Sources {
+		resp.Sources[i] = proposalSummaryToJson(summary)
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// ExportProposals returns the blocks proposed through this node from start_epoch to end_epoch as CSV, with a row per
+// proposal. The epoch range defaults to the last 225 epochs.
+func (s *Server) ExportProposals(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.ExportProposals")
+	defer span.End()
+
+	if s.ProposalRecorder == nil {
+		httputil.HandleError(w, "Proposal report is not available", http.StatusServiceUnavailable)
+		return
+	}
+	start, end, ok := s.proposalReportRange(w, r)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := proposalreport.WriteCSV(&buf, s.ProposalRecorder.Proposals(start, end)); err != nil {
+		httputil.HandleError(w, "Could not export proposals: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteRaw(w, api.CsvMediaType, buf.Bytes())
+}
+
+// proposalReportRange returns the slots of the epochs given by the start_epoch and end_epoch query parameters.
+func (s *Server) proposalReportRange(w http.ResponseWriter, r *http.Request) (primitives.Slot, primitives.Slot, bool) {
+	rawEnd, end, ok := shared.UintFromQuery(w, r, "end_epoch", false)
+	if !ok {
+		return 0, 0, false
+	}
+	endEpoch := primitives.Epoch(end)
+	if rawEnd == "" {
+		endEpoch = slots.ToEpoch(s.ChainInfoFetcher.HeadSlot())
+	}
+	rawStart, start, ok := shared.UintFromQuery(w, r, "start_epoch", false)
+	if !ok {
+		return 0, 0, false
+	}
+	startEpoch := primitives.Epoch(start)
+	if rawStart == "" && endEpoch >= defaultProposalReportEpochs {
+		startEpoch = endEpoch - (defaultProposalReportEpochs - 1)
+	}
+	if startEpoch > endEpoch {
+		httputil.HandleError(w, "start_epoch must not be greater than end_epoch", http.StatusBadRequest)
+		return 0, 0, false
+	}
+	startSlot, err := slots.EpochStart(startEpoch)
+	if err != nil {
+		httputil.HandleError(w, "Invalid start_epoch: "+err.Error(), http.StatusBadRequest)
+		return 0, 0, false
+	}
+	endSlot, err := slots.EpochEnd(endEpoch)
+	if err != nil {
+		httputil.HandleError(w, "Invalid end_epoch: "+err.Error(), http.StatusBadRequest)
+		return 0, 0, false
+	}
+	return startSlot, endSlot, true
+}
+
+func proposalSummaryToJson(summary *proposalreport.Summary) *structs.ProposalSummary {
+	return &structs.ProposalSummary{
+		Source:              summary.Source,
+		Relay:               summary.Relay,
+		Proposals:           fmt.Sprintf("%d", summary.Proposals),
+		ExecutionValueGwei:  fmt.Sprintf("%d", summary.ExecutionValue),
+		ConsensusRewardGwei: fmt.Sprintf("%d", summary.ConsensusReward),
+		BidValueGwei:        fmt.Sprintf("%d", summary.BidValue),
+		LostBidValueGwei:    fmt.Sprintf("%d", summary.LostBidValue),
+	}
+}
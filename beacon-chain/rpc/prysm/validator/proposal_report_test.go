@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/proposalreport"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetProposalReport(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	st, _ := util.DeterministicGenesisState(t, 4)
+	require.NoError(t, st.SetSlot(slotsPerEpoch*10))
+	recorder := proposalreport.NewRecorder()
+	for _, p := range []*proposalreport.Proposal{
+		{Slot: slotsPerEpoch, Source: proposalreport.SourceBuilder, Relay: "http://relay-a", LocalValue: 10, BidValue: 30, HasBid: true, ExecutionValue: 30},
+		{Slot: slotsPerEpoch * 5, Source: proposalreport.SourceLocal, Relay: "http://relay-a", LocalValue: 20, BidValue: 25, HasBid: true, ExecutionValue: 20},
+	} {
+		recorder.RecordBuild(p)
+		recorder.RecordProposal(p.Slot, 0, [32]byte{byte(p.Slot)}, time.Unix(0, 0))
+	}
+	s := &Server{
+		ChainInfoFetcher: &mock.ChainService{State: st},
+		ProposalRecorder: recorder,
+	}
+
+	t.Run("report", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/proposals/report", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposalReport(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetProposalReportResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, "0", resp.StartSlot)
+		require.Equal(t, "2", resp.Total.Proposals)
+		require.Equal(t, "50", resp.Total.ExecutionValueGwei)
+		require.Equal(t, "5", resp.Total.LostBidValueGwei)
+		require.Equal(t, 2, len(resp.Sources))
+		require.Equal(t, proposalreport.SourceBuilder, resp.Sources[0].Source)
+		require.Equal(t, "http://relay-a", resp.Sources[0].Relay)
+		require.Equal(t, "30", resp.Sources[0].ExecutionValueGwei)
+	})
+	t.Run("report with range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/proposals/report?start_epoch=2&end_epoch=5", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposalReport(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetProposalReportResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, "1", resp.Total.Proposals)
+		require.Equal(t, 1, len(resp.Sources))
+		require.Equal(t, proposalreport.SourceLocal, resp.Sources[0].Source)
+	})
+	t.Run("export", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/proposals/export", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ExportProposals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, api.CsvMediaType, writer.Header().Get("Content-Type"))
+		lines := strings.Split(strings.TrimSpace(writer.Body.String()), "\n")
+		require.Equal(t, 3, len(lines))
+		require.Equal(t, true, strings.HasPrefix(lines[0], "slot,"))
+		require.Equal(t, true, strings.HasPrefix(lines[1], fmt.Sprintf("%d,0,", slotsPerEpoch)))
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/proposals/report?start_epoch=6&end_epoch=5", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposalReport(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
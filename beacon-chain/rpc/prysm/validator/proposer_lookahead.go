@@ -0,0 +1,98 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// GetProposerLookahead returns every proposer schedule that can be computed from the head state: the schedule of the
+// current epoch, which is final for the dependent root, and the schedule of the next epoch, which can still change if
+// effective balances change at the epoch transition. None of the forks supported by this client makes the proposers
+// of later epochs deterministic, so the lookahead stops at the next epoch.
+func (s *Server) GetProposerLookahead(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetProposerLookahead")
+	defer span.End()
+
+	currentEpoch := slots.ToEpoch(s.TimeFetcher.CurrentSlot())
+	st, err := s.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	epochStartSlot, err := slots.EpochStart(currentEpoch)
+	if err != nil {
+		httputil.HandleError(w, fmt.Sprintf("Could not get start slot of epoch %d: %v", currentEpoch, err), http.StatusInternalServerError)
+		return
+	}
+	// Advance the head state with empty slots up to the current epoch.
+	if st.Slot() < epochStartSlot {
+		headRoot, err := s.ChainInfoFetcher.HeadRoot(ctx)
+		if err != nil {
+			httputil.HandleError(w, "Could not get head root: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		st, err = transition.ProcessSlotsUsingNextSlotCache(ctx, st, headRoot, epochStartSlot)
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not process slots up to %d: %v", epochStartSlot, err), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	current, err := proposerSchedule(ctx, st, currentEpoch)
+	if err != nil {
+		httputil.HandleError(w, "Could not compute proposer schedule of the current epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	current.Final = true
+	if currentEpoch > 0 {
+		dependentRoot, err := helpers.BlockRootAtSlot(st, epochStartSlot-1)
+		if err != nil {
+			httputil.HandleError(w, "Could not get dependent root: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		current.DependentRoot = hexutil.Encode(dependentRoot)
+	}
+	next, err := proposerSchedule(ctx, st, currentEpoch+1)
+	if err != nil {
+		httputil.HandleError(w, "Could not compute proposer schedule of the next epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetProposerLookaheadResponse{Data: []*structs.ProposerLookahead{current, next}})
+}
+
+func proposerSchedule(ctx context.Context, st state.ReadOnlyBeaconState, epoch primitives.Epoch) (*structs.ProposerLookahead, error) {
+	indices, err := helpers.ActiveValidatorIndices(ctx, st, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active validator indices")
+	}
+	proposers, err := helpers.PrecomputeProposerIndices(st, indices, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute proposer indices")
+	}
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	duties := make([]*structs.ProposerDuty, len(proposers))
+	for i, idx := range proposers {
+		pubkey := st.PubkeyAtIndex(idx)
+		duties[i] = &structs.ProposerDuty{
+			Pubkey:         hexutil.Encode(pubkey[:]),
+			ValidatorIndex: fmt.Sprintf("%d", idx),
+			Slot:           fmt.Sprintf("%d", start+primitives.Slot(i)),
+		}
+	}
+	return &structs.ProposerLookahead{Epoch: fmt.Sprintf("%d", epoch), Proposers: duties}, nil
+}
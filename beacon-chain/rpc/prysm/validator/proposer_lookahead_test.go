@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetProposerLookahead(t *testing.T) {
+	helpers.ClearCache()
+	st, _ := util.DeterministicGenesisState(t, 64)
+	spe := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(2*spe))
+	dependentRoot := [32]byte{'a'}
+	require.NoError(t, st.UpdateBlockRootAtIndex(uint64(2*spe-1), dependentRoot))
+	currentSlot := 2*spe + 3
+	s := &Server{
+		ChainInfoFetcher: &mock.ChainService{State: st},
+		TimeFetcher:      &mock.ChainService{Slot: &currentSlot},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/proposer_lookahead", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetProposerLookahead(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetProposerLookaheadResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+
+	current := resp.Data[0]
+	assert.Equal(t, "2", current.Epoch)
+	assert.Equal(t, true, current.Final)
+	assert.Equal(t, hexutil.Encode(dependentRoot[:]), current.DependentRoot)
+	next := resp.Data[1]
+	assert.Equal(t, "3", next.Epoch)
+	assert.Equal(t, false, next.Final)
+	assert.Equal(t, "", next.DependentRoot)
+
+	for i, e := range []primitives.Epoch{2, 3} {
+		indices, err := helpers.ActiveValidatorIndices(context.Background(), st, e)
+		require.NoError(t, err)
+		proposers, err := helpers.PrecomputeProposerIndices(st, indices, e)
+		require.NoError(t, err)
+		require.Equal(t, len(proposers), len(resp.Data[i].Proposers))
+		start := primitives.Slot(e) * spe
+		for j, idx := range proposers {
+			pubkey := st.PubkeyAtIndex(idx)
+			assert.DeepEqual(t, &structs.ProposerDuty{
+				Pubkey:         hexutil.Encode(pubkey[:]),
+				ValidatorIndex: fmt.Sprintf("%d", idx),
+				Slot:           fmt.Sprintf("%d", start+primitives.Slot(j)),
+			}, resp.Data[i].Proposers[j])
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package validator
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetRelayRegistrations returns the last validator registration which successfully reached each relay, for each
+// validator, so that operators can verify that the registrations of their validators reached the relays. The
+// registrations can be filtered with the pubkey query parameter, which may be repeated.
+func (s *Server) GetRelayRegistrations(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetRelayRegistrations")
+	defer span.End()
+
+	var pubkeys [][]byte
+	for _, raw := range r.URL.Query()["pubkey"] {
+		pubkey, ok := shared.ValidateHex(w, "pubkey", raw, fieldparams.BLSPubkeyLength)
+		if !ok {
+			return
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	if s.RegistrationHistory == nil {
+		httputil.HandleError(w, "Validator registrations are not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	data := make([]*structs.RelayRegistration, 0)
+	for _, reg := range s.RegistrationHistory.LastRegistrations() {
+		if len(pubkeys) > 0 && !containsPubkey(pubkeys, reg.Registration.Pubkey) {
+			continue
+		}
+		data = append(data, &structs.RelayRegistration{
+			Relay:          reg.Relay,
+			Pubkey:         hexutil.Encode(reg.Registration.Pubkey),
+			FeeRecipient:   hexutil.Encode(reg.Registration.FeeRecipient),
+			GasLimit:       strconv.FormatUint(reg.Registration.GasLimit, 10),
+			Timestamp:      strconv.FormatUint(reg.Registration.Timestamp, 10),
+			LastRegistered: reg.Time.UTC().Format(time.RFC3339),
+		})
+	}
+	httputil.WriteJson(w, &structs.RelayRegistrationsResponse{Data: data})
+}
+
+func containsPubkey(pubkeys [][]byte, pubkey []byte) bool {
+	for _, p := range pubkeys {
+		if bytes.Equal(p, pubkey) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockRegistrationHistory struct {
+	regs []*builder.RelayRegistration
+}
+
+func (m *mockRegistrationHistory) LastRegistrations() []*builder.RelayRegistration { return m.regs }
+
+func TestGetRelayRegistrations(t *testing.T) {
+	registered := time.Unix(1700000000, 0)
+	pubkey1 := bytesutil.PadTo([]byte{1}, 48)
+	pubkey2 := bytesutil.PadTo([]byte{2}, 48)
+	s := &Server{RegistrationHistory: &mockRegistrationHistory{regs: []*builder.RelayRegistration{
+		{
+			Relay:        "http://relay-a",
+			Registration: &eth.ValidatorRegistrationV1{Pubkey: pubkey1, FeeRecipient: make([]byte, 20), GasLimit: 30000000, Timestamp: 1699999990},
+			Time:         registered,
+		},
+		{
+			Relay:        "http://relay-a",
+			Registration: &eth.ValidatorRegistrationV1{Pubkey: pubkey2, FeeRecipient: make([]byte, 20), GasLimit: 30000000, Timestamp: 1699999990},
+			Time:         registered,
+		},
+	}}}
+
+	t.Run("all", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/registrations", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRelayRegistrations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.RelayRegistrationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "http://relay-a", resp.Data[0].Relay)
+		assert.Equal(t, hexutil.Encode(pubkey1), resp.Data[0].Pubkey)
+		assert.Equal(t, "30000000", resp.Data[0].GasLimit)
+		assert.Equal(t, "1699999990", resp.Data[0].Timestamp)
+		assert.Equal(t, registered.UTC().Format(time.RFC3339), resp.Data[0].LastRegistered)
+	})
+	t.Run("filtered by pubkey", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/registrations?pubkey="+hexutil.Encode(pubkey2), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRelayRegistrations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.RelayRegistrationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, hexutil.Encode(pubkey2), resp.Data[0].Pubkey)
+	})
+	t.Run("invalid pubkey", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/registrations?pubkey=0x01", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRelayRegistrations(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
@@ -1,10 +1,16 @@
 package validator
 
 import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/balancearchive"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/proposalreport"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 type Server struct {
@@ -13,5 +19,15 @@ type Server struct {
 	CanonicalFetcher    blockchain.CanonicalFetcher
 	FinalizationFetcher blockchain.FinalizationFetcher
 	ChainInfoFetcher    blockchain.ChainInfoFetcher
+	TimeFetcher         blockchain.TimeFetcher
 	CoreService         *core.Service
+	// SlasherHistoryFetcher is nil unless the slasher is enabled.
+	SlasherHistoryFetcher slasher.HistoryFetcher
+	ValidatorMonitor      monitor.TrackedValidatorsManager
+	V1Alpha1Server        eth.BeaconNodeValidatorServer
+	RegistrationHistory   builder.RegistrationHistory
+	ParticipationFetcher  blockchain.ParticipationFetcher
+	ProposalRecorder      *proposalreport.Recorder
+	// BalanceArchive is nil unless the balance archive is enabled.
+	BalanceArchive balancearchive.Reader
 }
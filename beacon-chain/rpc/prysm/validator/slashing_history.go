@@ -0,0 +1,130 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// MaxSlashingHistoryEpochs is the maximum number of epochs which can be queried at once with GetSlashingHistory.
+const MaxSlashingHistoryEpochs = 256
+
+// GetSlashingHistory returns the attestations and proposals recorded by the slasher for a validator,
+// identified by its index or public key, as well as the slashable offenses detected for it.
+// The epoch range defaults to the last MaxSlashingHistoryEpochs epochs.
+func (s *Server) GetSlashingHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetSlashingHistory")
+	defer span.End()
+
+	if s.SlasherHistoryFetcher == nil {
+		httputil.HandleError(w, "Slasher is not enabled on this node", http.StatusServiceUnavailable)
+		return
+	}
+
+	valId := r.PathValue("validator_id")
+	if valId == "" {
+		httputil.HandleError(w, "validator_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	var valIndex primitives.ValidatorIndex
+	if strings.HasPrefix(valId, "0x") {
+		pubkey, err := hexutil.Decode(valId)
+		if err != nil || len(pubkey) != fieldparams.BLSPubkeyLength {
+			httputil.HandleError(w, "Invalid validator_id: "+valId, http.StatusBadRequest)
+			return
+		}
+		st, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+		if err != nil {
+			httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		if !ok {
+			httputil.HandleError(w, "Unknown validator: "+valId, http.StatusNotFound)
+			return
+		}
+		valIndex = idx
+	} else {
+		idx, err := strconv.ParseUint(valId, 10, 64)
+		if err != nil {
+			httputil.HandleError(w, "Invalid validator_id: "+valId, http.StatusBadRequest)
+			return
+		}
+		valIndex = primitives.ValidatorIndex(idx)
+	}
+
+	rawEnd, end, ok := shared.UintFromQuery(w, r, "end_epoch", false)
+	if !ok {
+		return
+	}
+	endEpoch := primitives.Epoch(end)
+	if rawEnd == "" {
+		endEpoch = slots.ToEpoch(s.ChainInfoFetcher.HeadSlot())
+	}
+	rawStart, start, ok := shared.UintFromQuery(w, r, "start_epoch", false)
+	if !ok {
+		return
+	}
+	startEpoch := primitives.Epoch(start)
+	if rawStart == "" && endEpoch >= MaxSlashingHistoryEpochs {
+		startEpoch = endEpoch - (MaxSlashingHistoryEpochs - 1)
+	}
+	if startEpoch > endEpoch {
+		httputil.HandleError(w, "start_epoch must not be greater than end_epoch", http.StatusBadRequest)
+		return
+	}
+	if endEpoch-startEpoch >= MaxSlashingHistoryEpochs {
+		httputil.HandleError(w, fmt.Sprintf("Cannot query more than %d epochs at once", MaxSlashingHistoryEpochs), http.StatusBadRequest)
+		return
+	}
+
+	history, err := s.SlasherHistoryFetcher.ValidatorHistory(ctx, valIndex, startEpoch, endEpoch)
+	if err != nil {
+		httputil.HandleError(w, "Could not get slashing history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := &structs.GetSlashingHistoryResponse{
+		ValidatorIndex: fmt.Sprintf("%d", valIndex),
+		StartEpoch:     fmt.Sprintf("%d", startEpoch),
+		EndEpoch:       fmt.Sprintf("%d", endEpoch),
+		Attestations:   make([]*structs.SlasherAttestationRecord, len(history.Attestations)),
+		Proposals:      make([]*structs.SlasherProposalRecord, len(history.Proposals)),
+		Offenses:       make([]*structs.SlasherOffense, len(history.Offenses)),
+	}
+	for i, att := range history.Attestations {
+		data := att.IndexedAttestation.GetData()
+		resp.Attestations[i] = &structs.SlasherAttestationRecord{
+			SourceEpoch: fmt.Sprintf("%d", data.Source.Epoch),
+			TargetEpoch: fmt.Sprintf("%d", data.Target.Epoch),
+			DataRoot:    hexutil.Encode(att.DataRoot[:]),
+		}
+	}
+	for i, p := range history.Proposals {
+		resp.Proposals[i] = &structs.SlasherProposalRecord{
+			Slot:       fmt.Sprintf("%d", p.SignedBeaconBlockHeader.Header.Slot),
+			HeaderRoot: hexutil.Encode(p.HeaderRoot[:]),
+		}
+	}
+	for i, o := range history.Offenses {
+		resp.Offenses[i] = &structs.SlasherOffense{
+			Kind:         string(o.Kind),
+			Epoch:        fmt.Sprintf("%d", o.Epoch),
+			SlashingRoot: hexutil.Encode(o.SlashingRoot[:]),
+			DetectedAt:   o.DetectedAt.UTC().Format(time.RFC3339),
+		}
+	}
+	httputil.WriteJson(w, resp)
+}
@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockHistoryFetcher struct {
+	validatorIndex       primitives.ValidatorIndex
+	startEpoch, endEpoch primitives.Epoch
+	history              *slasher.ValidatorHistory
+}
+
+func (m *mockHistoryFetcher) ValidatorHistory(_ context.Context, validatorIndex primitives.ValidatorIndex, startEpoch, endEpoch primitives.Epoch) (*slasher.ValidatorHistory, error) {
+	m.validatorIndex, m.startEpoch, m.endEpoch = validatorIndex, startEpoch, endEpoch
+	return m.history, nil
+}
+
+func TestServer_GetSlashingHistory(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 4)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*300))
+
+	fetcher := &mockHistoryFetcher{history: &slasher.ValidatorHistory{
+		Attestations: []*slashertypes.IndexedAttestationWrapper{{
+			IndexedAttestation: &ethpb.IndexedAttestation{
+				AttestingIndices: []uint64{2},
+				Data: &ethpb.AttestationData{
+					Source: &ethpb.Checkpoint{Epoch: 9},
+					Target: &ethpb.Checkpoint{Epoch: 10},
+				},
+			},
+			DataRoot: [32]byte{1},
+		}},
+		Proposals: []*slashertypes.SignedBlockHeaderWrapper{{
+			SignedBeaconBlockHeader: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 321}},
+			HeaderRoot:              [32]byte{2},
+		}},
+		Offenses: []slasher.DetectedOffense{{
+			Kind:         slasher.ProposerOffense,
+			Epoch:        10,
+			SlashingRoot: [32]byte{3},
+			DetectedAt:   time.Unix(0, 0),
+		}},
+	}}
+	s := &Server{
+		ChainInfoFetcher:      &mock.ChainService{State: st},
+		SlasherHistoryFetcher: fetcher,
+	}
+
+	t.Run("by index with range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/2/slashing_history?start_epoch=5&end_epoch=20", nil)
+		request.SetPathValue("validator_id", "2")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSlashingHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlashingHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, primitives.ValidatorIndex(2), fetcher.validatorIndex)
+		require.Equal(t, primitives.Epoch(5), fetcher.startEpoch)
+		require.Equal(t, primitives.Epoch(20), fetcher.endEpoch)
+		require.Equal(t, 1, len(resp.Attestations))
+		require.Equal(t, "10", resp.Attestations[0].TargetEpoch)
+		require.Equal(t, 1, len(resp.Proposals))
+		require.Equal(t, "321", resp.Proposals[0].Slot)
+		require.Equal(t, 1, len(resp.Offenses))
+		require.Equal(t, "proposer", resp.Offenses[0].Kind)
+	})
+	t.Run("by pubkey with default range", func(t *testing.T) {
+		key := st.PubkeyAtIndex(3)
+		pubkey := hexutil.Encode(key[:])
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/"+pubkey+"/slashing_history", nil)
+		request.SetPathValue("validator_id", pubkey)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSlashingHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, primitives.ValidatorIndex(3), fetcher.validatorIndex)
+		require.Equal(t, primitives.Epoch(300-MaxSlashingHistoryEpochs+1), fetcher.startEpoch)
+		require.Equal(t, primitives.Epoch(300), fetcher.endEpoch)
+	})
+	t.Run("range too large", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/2/slashing_history?start_epoch=0&end_epoch=1000", nil)
+		request.SetPathValue("validator_id", "2")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSlashingHistory(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		require.StringContains(t, "Cannot query more than", writer.Body.String())
+	})
+	t.Run("slasher disabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/2/slashing_history", nil)
+		request.SetPathValue("validator_id", "2")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{}).GetSlashingHistory(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
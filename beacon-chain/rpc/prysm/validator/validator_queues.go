@@ -0,0 +1,187 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// GetValidatorQueues returns the activation and exit churn of the head state, the length of the activation and exit
+// queues, and an estimate of when a new deposit would be activated and a new exit would be effective.
+// Since Electra, the churn is a balance per epoch, and the estimates are for the balance given by the balance
+// query parameter, which defaults to MIN_ACTIVATION_BALANCE. The activation estimate assumes the chain finalizes
+// without delay, so it is a lower bound.
+func (s *Server) GetValidatorQueues(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetValidatorQueues")
+	defer span.End()
+
+	rawBalance, balance, ok := shared.UintFromQuery(w, r, "balance", false)
+	if !ok {
+		return
+	}
+	if rawBalance == "" {
+		balance = params.BeaconConfig().MinActivationBalance
+	}
+	if balance == 0 {
+		httputil.HandleError(w, "balance must be greater than zero", http.StatusBadRequest)
+		return
+	}
+	st, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp, err := validatorQueues(st, primitives.Gwei(balance))
+	if err != nil {
+		httputil.HandleError(w, "Could not estimate validator queues: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, resp)
+}
+
+func validatorQueues(st state.ReadOnlyBeaconState, balance primitives.Gwei) (*structs.ValidatorQueuesResponse, error) {
+	cfg := params.BeaconConfig()
+	current := slots.ToEpoch(st.Slot())
+	var (
+		activeCount, activeBalance              uint64
+		activationQueue, activationQueueBalance uint64
+		exitQueue, exitQueueBalance             uint64
+		exitQueueEpoch                          = helpers.ActivationExitEpoch(current)
+		exitQueueChurn                          uint64
+	)
+	if err := st.ReadFromEveryValidator(func(_ int, val state.ReadOnlyValidator) error {
+		if val.ActivationEpoch() <= current && current < val.ExitEpoch() {
+			activeCount++
+			activeBalance += val.EffectiveBalance()
+		}
+		if val.ActivationEligibilityEpoch() != cfg.FarFutureEpoch && val.ActivationEpoch() == cfg.FarFutureEpoch {
+			activationQueue++
+			activationQueueBalance += val.EffectiveBalance()
+		}
+		if val.ExitEpoch() == cfg.FarFutureEpoch {
+			return nil
+		}
+		if val.ExitEpoch() > current {
+			exitQueue++
+			exitQueueBalance += val.EffectiveBalance()
+		}
+		switch {
+		case val.ExitEpoch() > exitQueueEpoch:
+			exitQueueEpoch = val.ExitEpoch()
+			exitQueueChurn = 1
+		case val.ExitEpoch() == exitQueueEpoch:
+			exitQueueChurn++
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	// Like get_total_active_balance.
+	activeBalance = max(activeBalance, cfg.EffectiveBalanceIncrement)
+
+	var activationEpoch, exitEpoch primitives.Epoch
+	resp := &structs.ValidatorQueuesResponse{Epoch: fmt.Sprintf("%d", current)}
+	if st.Version() < version.Electra {
+		resp.ChurnUnit = "validators"
+		activationChurn := helpers.ValidatorActivationChurnLimit(activeCount)
+		if st.Version() >= version.Deneb {
+			activationChurn = helpers.ValidatorActivationChurnLimitDeneb(activeCount)
+		}
+		exitChurn := helpers.ValidatorExitChurnLimit(activeCount)
+		resp.ActivationChurnLimit = fmt.Sprintf("%d", activationChurn)
+		resp.ExitChurnLimit = fmt.Sprintf("%d", exitChurn)
+		resp.ActivationQueueLength = fmt.Sprintf("%d", activationQueue)
+		resp.ActivationQueueBalance = fmt.Sprintf("%d", activationQueueBalance)
+
+		// A new deposit becomes eligible at the next epoch, then waits for the validators ahead of it in the queue.
+		activationEpoch = current + 1 + primitives.Epoch((activationQueue+activationChurn)/activationChurn) + cfg.MaxSeedLookahead
+		// Like initiate_validator_exit.
+		exitEpoch = exitQueueEpoch
+		if exitQueueChurn >= exitChurn {
+			exitEpoch++
+		}
+	} else {
+		resp.ChurnUnit = "gwei"
+		churn := helpers.ActivationExitChurnLimit(primitives.Gwei(activeBalance))
+		resp.ActivationChurnLimit = fmt.Sprintf("%d", churn)
+		resp.ExitChurnLimit = fmt.Sprintf("%d", churn)
+
+		// Since Electra, deposits wait in the pending deposits queue before creating validators, which are then
+		// activated once their eligibility is finalized.
+		deposits, err := st.PendingDeposits()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get pending deposits")
+		}
+		var depositsBalance primitives.Gwei
+		for _, d := range deposits {
+			depositsBalance += primitives.Gwei(d.Amount)
+		}
+		resp.ActivationQueueLength = fmt.Sprintf("%d", activationQueue+uint64(len(deposits)))
+		resp.ActivationQueueBalance = fmt.Sprintf("%d", activationQueueBalance+uint64(depositsBalance))
+		toConsume, err := st.DepositBalanceToConsume()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get deposit balance to consume")
+		}
+		depositEpochs := primitives.Epoch(1)
+		if depositsBalance+balance > toConsume {
+			depositEpochs = primitives.Epoch((depositsBalance + balance - toConsume + churn - 1) / churn)
+		}
+		// The deposit is processed, the new validator becomes eligible at the next epoch and is activated once
+		// its eligibility is finalized.
+		activationEpoch = current + depositEpochs + 1 + 1 + cfg.MaxSeedLookahead
+
+		exitEpoch, err = electraExitEpoch(st, current, churn, balance)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.ExitQueueLength = fmt.Sprintf("%d", exitQueue)
+	resp.ExitQueueBalance = fmt.Sprintf("%d", exitQueueBalance)
+
+	resp.Activation, resp.Exit = queueEstimate(st, current, activationEpoch), queueEstimate(st, current, exitEpoch)
+	return resp, nil
+}
+
+// electraExitEpoch computes the exit epoch of a new exit of the given balance, like
+// compute_exit_epoch_and_update_churn without updating the state.
+func electraExitEpoch(st state.ReadOnlyBeaconState, current primitives.Epoch, churn, balance primitives.Gwei) (primitives.Epoch, error) {
+	earliestExitEpoch, err := st.EarliestExitEpoch()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get earliest exit epoch")
+	}
+	exitEpoch := max(earliestExitEpoch, helpers.ActivationExitEpoch(current))
+	toConsume := churn
+	if earliestExitEpoch >= exitEpoch {
+		toConsume, err = st.ExitBalanceToConsume()
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get exit balance to consume")
+		}
+	}
+	if balance > toConsume {
+		exitEpoch += primitives.Epoch((balance-toConsume-1)/churn + 1)
+	}
+	return exitEpoch, nil
+}
+
+func queueEstimate(st state.ReadOnlyBeaconState, current, epoch primitives.Epoch) *structs.QueueEstimate {
+	cfg := params.BeaconConfig()
+	wait := epoch - current
+	return &structs.QueueEstimate{
+		Epoch:       fmt.Sprintf("%d", epoch),
+		WaitEpochs:  fmt.Sprintf("%d", wait),
+		WaitSeconds: fmt.Sprintf("%d", uint64(wait)*uint64(cfg.SlotsPerEpoch)*cfg.SecondsPerSlot),
+		Time:        slots.StartTime(st.GenesisTime(), primitives.Slot(uint64(epoch)*uint64(cfg.SlotsPerEpoch))).UTC().Format(time.RFC3339),
+	}
+}
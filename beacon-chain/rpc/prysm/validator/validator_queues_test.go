@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetValidatorQueues(t *testing.T) {
+	cfg := params.BeaconConfig()
+	epochSeconds := uint64(cfg.SlotsPerEpoch) * cfg.SecondsPerSlot
+
+	getQueues := func(t *testing.T, s *Server, query string) *structs.ValidatorQueuesResponse {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/queues"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorQueues(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ValidatorQueuesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+
+	t.Run("pre-electra", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateDeneb(t, 64)
+		require.NoError(t, st.SetSlot(cfg.SlotsPerEpoch*10))
+		// Validator 0 waits in the activation queue.
+		val, err := st.ValidatorAtIndex(0)
+		require.NoError(t, err)
+		val.ActivationEligibilityEpoch = 5
+		val.ActivationEpoch = cfg.FarFutureEpoch
+		require.NoError(t, st.UpdateValidatorAtIndex(0, val))
+		// Validators 1 to 4 fill the exit churn of epoch 15.
+		for i := 1; i <= 4; i++ {
+			val, err := st.ValidatorAtIndex(primitives.ValidatorIndex(i))
+			require.NoError(t, err)
+			val.ExitEpoch = 15
+			require.NoError(t, st.UpdateValidatorAtIndex(primitives.ValidatorIndex(i), val))
+		}
+		s := &Server{ChainInfoFetcher: &mock.ChainService{State: st}}
+
+		resp := getQueues(t, s, "")
+		assert.Equal(t, "10", resp.Epoch)
+		assert.Equal(t, "validators", resp.ChurnUnit)
+		assert.Equal(t, "4", resp.ActivationChurnLimit)
+		assert.Equal(t, "4", resp.ExitChurnLimit)
+		assert.Equal(t, "1", resp.ActivationQueueLength)
+		assert.Equal(t, "4", resp.ExitQueueLength)
+		assert.Equal(t, fmt.Sprintf("%d", 4*cfg.MaxEffectiveBalance), resp.ExitQueueBalance)
+		assert.Equal(t, "16", resp.Activation.Epoch)
+		assert.Equal(t, "6", resp.Activation.WaitEpochs)
+		assert.Equal(t, fmt.Sprintf("%d", 6*epochSeconds), resp.Activation.WaitSeconds)
+		assert.Equal(t, "16", resp.Exit.Epoch)
+		assert.Equal(t, "6", resp.Exit.WaitEpochs)
+	})
+	t.Run("electra", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateElectra(t, 4)
+		require.NoError(t, st.SetSlot(cfg.SlotsPerEpoch*10))
+		require.NoError(t, st.AppendPendingDeposit(&ethpb.PendingDeposit{Amount: 8 * cfg.MinActivationBalance}))
+		s := &Server{ChainInfoFetcher: &mock.ChainService{State: st}}
+
+		resp := getQueues(t, s, "")
+		assert.Equal(t, "gwei", resp.ChurnUnit)
+		assert.Equal(t, fmt.Sprintf("%d", cfg.MinPerEpochChurnLimitElectra), resp.ActivationChurnLimit)
+		assert.Equal(t, fmt.Sprintf("%d", cfg.MinPerEpochChurnLimitElectra), resp.ExitChurnLimit)
+		assert.Equal(t, "1", resp.ActivationQueueLength)
+		assert.Equal(t, fmt.Sprintf("%d", 8*cfg.MinActivationBalance), resp.ActivationQueueBalance)
+		assert.Equal(t, "0", resp.ExitQueueLength)
+		// 9 * 32 ETH of deposits take 3 epochs of churn.
+		assert.Equal(t, "19", resp.Activation.Epoch)
+		assert.Equal(t, "15", resp.Exit.Epoch)
+		assert.Equal(t, "5", resp.Exit.WaitEpochs)
+		assert.Equal(t, fmt.Sprintf("%d", 5*epochSeconds), resp.Exit.WaitSeconds)
+	})
+	t.Run("electra with balance", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateElectra(t, 4)
+		require.NoError(t, st.SetSlot(cfg.SlotsPerEpoch*10))
+		s := &Server{ChainInfoFetcher: &mock.ChainService{State: st}}
+
+		resp := getQueues(t, s, fmt.Sprintf("?balance=%d", 10*cfg.MinActivationBalance))
+		assert.Equal(t, "19", resp.Activation.Epoch)
+		assert.Equal(t, "17", resp.Exit.Epoch)
+	})
+	t.Run("invalid balance", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/queues?balance=0", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorQueues(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
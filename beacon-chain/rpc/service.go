@@ -8,28 +8,38 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/balancearchive"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/clockskew"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/diskusage"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/healthscore"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/maintenance"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/blstoexec"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/overrides"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/proposalreport"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/rewards"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
@@ -37,6 +47,7 @@ import (
 	debugv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/debug"
 	nodev1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/node"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
@@ -95,6 +106,7 @@ type Config struct {
 	GenesisFetcher            blockchain.GenesisFetcher
 	MockEth1Votes             bool
 	EnableDebugRPCEndpoints   bool
+	EnableAdminRPCEndpoints   bool
 	AttestationsPool          attestations.Pool
 	ExitPool                  voluntaryexits.PoolManager
 	SlashingsPool             slashings.PoolManager
@@ -115,11 +127,32 @@ type Config struct {
 	ExecutionEngineCaller     execution.EngineCaller
 	OptimisticModeFetcher     blockchain.OptimisticModeFetcher
 	BlockBuilder              builder.BlockBuilder
+	RegistrationHistory       builder.RegistrationHistory
+	BalanceArchive            balancearchive.Reader
+	ParticipationFetcher      blockchain.ParticipationFetcher
 	Router                    *http.ServeMux
 	ClockWaiter               startup.ClockWaiter
 	BlobStorage               *filesystem.BlobStorage
 	TrackedValidatorsCache    *cache.TrackedValidatorsCache
 	PayloadIDCache            *cache.PayloadIDCache
+	BadBlockCache             *cache.BadBlockCache
+	SlasherHistoryFetcher     slasher.HistoryFetcher
+	SlashingApprover          slasher.SlashingApprover
+	SlasherBacklogFetcher     slasher.BacklogFetcher
+	ValidatorMonitor          monitor.TrackedValidatorsManager
+	DiskUsageFetcher          diskusage.Fetcher
+	HealthScoreFetcher        healthscore.Fetcher
+	FinalityStallFetcher      blockchain.FinalityStallFetcher
+	OptimisticStatusFetcher   blockchain.OptimisticStatusFetcher
+	ServeOptimisticDuties     bool
+	Maintenance               *maintenance.Mode
+	ClockSkew                 *clockskew.Service
+	RuntimeOverrides          *overrides.Manager
+	DepositRepairer           execution.DepositRepairer
+	HeadSnapshotDir           string
+	GetPayloadSlotOffset      time.Duration
+	GetPayloadRetryCachedID   bool
+	Alerter                   alerts.Alerter
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -142,24 +175,33 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	s.listener = lis
 	log.WithField("address", address).Info("gRPC server listening on port")
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(
+			recovery.WithRecoveryHandlerContext(tracing.RecoveryHandlerFunc),
+		),
+		grpcprometheus.StreamServerInterceptor,
+		grpcopentracing.StreamServerInterceptor(),
+		s.validatorStreamConnectionInterceptor,
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(
+			recovery.WithRecoveryHandlerContext(tracing.RecoveryHandlerFunc),
+		),
+		grpcprometheus.UnaryServerInterceptor,
+		grpcopentracing.UnaryServerInterceptor(),
+		s.validatorUnaryConnectionInterceptor,
+	}
+	if s.cfg.Maintenance != nil {
+		streamInterceptors = append(streamInterceptors, s.cfg.Maintenance.StreamServerInterceptor)
+		unaryInterceptors = append(unaryInterceptors, s.cfg.Maintenance.UnaryServerInterceptor)
+	}
+	if s.cfg.ClockSkew != nil {
+		unaryInterceptors = append(unaryInterceptors, s.cfg.ClockSkew.UnaryServerInterceptor)
+	}
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(middleware.ChainStreamServer(
-			recovery.StreamServerInterceptor(
-				recovery.WithRecoveryHandlerContext(tracing.RecoveryHandlerFunc),
-			),
-			grpcprometheus.StreamServerInterceptor,
-			grpcopentracing.StreamServerInterceptor(),
-			s.validatorStreamConnectionInterceptor,
-		)),
-		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
-			recovery.UnaryServerInterceptor(
-				recovery.WithRecoveryHandlerContext(tracing.RecoveryHandlerFunc),
-			),
-			grpcprometheus.UnaryServerInterceptor,
-			grpcopentracing.UnaryServerInterceptor(),
-			s.validatorUnaryConnectionInterceptor,
-		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryInterceptors...)),
 		grpc.MaxRecvMsgSize(s.cfg.MaxMsgSize),
 	}
 	if s.cfg.CertFlag != "" && s.cfg.KeyFlag != "" {
@@ -204,49 +246,57 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		SyncCommitteePool:     s.cfg.SyncCommitteeObjectPool,
 		OperationNotifier:     s.cfg.OperationNotifier,
 		AttestationCache:      cache.NewAttestationCache(),
+		SyncContributionCache: cache.NewSyncContributionCache(),
 		StateGen:              s.cfg.StateGen,
 		P2P:                   s.cfg.Broadcaster,
 		FinalizedFetcher:      s.cfg.FinalizationFetcher,
 		ReplayerBuilder:       ch,
 		OptimisticModeFetcher: s.cfg.OptimisticModeFetcher,
+		ServeOptimisticDuties: s.cfg.ServeOptimisticDuties,
 	}
 	validatorServer := &validatorv1alpha1.Server{
-		Ctx:                    s.ctx,
-		AttPool:                s.cfg.AttestationsPool,
-		ExitPool:               s.cfg.ExitPool,
-		HeadFetcher:            s.cfg.HeadFetcher,
-		ForkFetcher:            s.cfg.ForkFetcher,
-		ForkchoiceFetcher:      s.cfg.ForkchoiceFetcher,
-		GenesisFetcher:         s.cfg.GenesisFetcher,
-		FinalizationFetcher:    s.cfg.FinalizationFetcher,
-		TimeFetcher:            s.cfg.GenesisTimeFetcher,
-		BlockFetcher:           s.cfg.ExecutionChainService,
-		DepositFetcher:         s.cfg.DepositFetcher,
-		ChainStartFetcher:      s.cfg.ChainStartFetcher,
-		Eth1InfoFetcher:        s.cfg.ExecutionChainService,
-		OptimisticModeFetcher:  s.cfg.OptimisticModeFetcher,
-		SyncChecker:            s.cfg.SyncService,
-		StateNotifier:          s.cfg.StateNotifier,
-		BlockNotifier:          s.cfg.BlockNotifier,
-		OperationNotifier:      s.cfg.OperationNotifier,
-		P2P:                    s.cfg.Broadcaster,
-		BlockReceiver:          s.cfg.BlockReceiver,
-		BlobReceiver:           s.cfg.BlobReceiver,
-		MockEth1Votes:          s.cfg.MockEth1Votes,
-		Eth1BlockFetcher:       s.cfg.ExecutionChainService,
-		PendingDepositsFetcher: s.cfg.PendingDepositFetcher,
-		SlashingsPool:          s.cfg.SlashingsPool,
-		StateGen:               s.cfg.StateGen,
-		SyncCommitteePool:      s.cfg.SyncCommitteeObjectPool,
-		ReplayerBuilder:        ch,
-		ExecutionEngineCaller:  s.cfg.ExecutionEngineCaller,
-		BeaconDB:               s.cfg.BeaconDB,
-		BlockBuilder:           s.cfg.BlockBuilder,
-		BLSChangesPool:         s.cfg.BLSChangesPool,
-		ClockWaiter:            s.cfg.ClockWaiter,
-		CoreService:            coreService,
-		TrackedValidatorsCache: s.cfg.TrackedValidatorsCache,
-		PayloadIDCache:         s.cfg.PayloadIDCache,
+		Ctx:                     s.ctx,
+		AttPool:                 s.cfg.AttestationsPool,
+		ExitPool:                s.cfg.ExitPool,
+		HeadFetcher:             s.cfg.HeadFetcher,
+		ForkFetcher:             s.cfg.ForkFetcher,
+		ForkchoiceFetcher:       s.cfg.ForkchoiceFetcher,
+		GenesisFetcher:          s.cfg.GenesisFetcher,
+		FinalizationFetcher:     s.cfg.FinalizationFetcher,
+		TimeFetcher:             s.cfg.GenesisTimeFetcher,
+		BlockFetcher:            s.cfg.ExecutionChainService,
+		DepositFetcher:          s.cfg.DepositFetcher,
+		ChainStartFetcher:       s.cfg.ChainStartFetcher,
+		Eth1InfoFetcher:         s.cfg.ExecutionChainService,
+		OptimisticModeFetcher:   s.cfg.OptimisticModeFetcher,
+		ServeOptimisticDuties:   s.cfg.ServeOptimisticDuties,
+		SyncChecker:             s.cfg.SyncService,
+		StateNotifier:           s.cfg.StateNotifier,
+		BlockNotifier:           s.cfg.BlockNotifier,
+		OperationNotifier:       s.cfg.OperationNotifier,
+		P2P:                     s.cfg.Broadcaster,
+		BlockReceiver:           s.cfg.BlockReceiver,
+		BlobReceiver:            s.cfg.BlobReceiver,
+		MockEth1Votes:           s.cfg.MockEth1Votes,
+		Eth1BlockFetcher:        s.cfg.ExecutionChainService,
+		PendingDepositsFetcher:  s.cfg.PendingDepositFetcher,
+		SlashingsPool:           s.cfg.SlashingsPool,
+		StateGen:                s.cfg.StateGen,
+		SyncCommitteePool:       s.cfg.SyncCommitteeObjectPool,
+		ReplayerBuilder:         ch,
+		ExecutionEngineCaller:   s.cfg.ExecutionEngineCaller,
+		BeaconDB:                s.cfg.BeaconDB,
+		BlockBuilder:            s.cfg.BlockBuilder,
+		BLSChangesPool:          s.cfg.BLSChangesPool,
+		ClockWaiter:             s.cfg.ClockWaiter,
+		CoreService:             coreService,
+		TrackedValidatorsCache:  s.cfg.TrackedValidatorsCache,
+		PayloadIDCache:          s.cfg.PayloadIDCache,
+		GetPayloadSlotOffset:    s.cfg.GetPayloadSlotOffset,
+		GetPayloadRetryCachedID: s.cfg.GetPayloadRetryCachedID,
+		Alerter:                 s.cfg.Alerter,
+		ProposalRecorder:        proposalreport.NewRecorder(),
+		BlockRewardFetcher:      rewardFetcher,
 	}
 	s.validatorServer = validatorServer
 	nodeServer := &nodev1alpha1.Server{
@@ -288,7 +338,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		CoreService:                 coreService,
 	}
 
-	endpoints := s.endpoints(s.cfg.EnableDebugRPCEndpoints, blocker, stater, rewardFetcher, validatorServer, coreService, ch)
+	endpoints := s.endpoints(s.cfg.EnableDebugRPCEndpoints, s.cfg.EnableAdminRPCEndpoints, blocker, stater, rewardFetcher, validatorServer, coreService, ch)
 	for _, e := range endpoints {
 		for i := range e.methods {
 			s.cfg.Router.HandleFunc(
@@ -327,6 +377,7 @@ var _ stategen.CurrentSlotter = blockchain.ChainInfoFetcher(nil)
 // Start the gRPC server.
 func (s *Service) Start() {
 	grpcprometheus.EnableHandlingTimeHistogram()
+	go s.validatorServer.PrecomputeProposals(s.ctx)
 	go func() {
 		if s.listener != nil {
 			if err := s.grpcServer.Serve(s.listener); err != nil {
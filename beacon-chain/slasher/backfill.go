@@ -0,0 +1,189 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// BackfillConfig defines the range of epochs for which the blocks stored in the beacon
+// database are checked for slashable offenses when the slasher starts.
+type BackfillConfig struct {
+	StartEpoch primitives.Epoch
+	// EndEpoch is inclusive. The backfill stops at the head epoch if it is unset or greater.
+	EndEpoch primitives.Epoch
+}
+
+// backfill ingests the attestations and block headers of all the blocks stored in the beacon database
+// for the configured epoch range, epoch by epoch, and checks them for slashable offenses as if they
+// had been received over the network. It must run before the live detection routines, since spans
+// can only be updated for epochs after the latest epoch written for each validator.
+func (s *Service) backfill(ctx context.Context, headEpoch primitives.Epoch) error {
+	cfg := s.serviceCfg.Backfill
+	if s.serviceCfg.BeaconDatabase == nil {
+		return errors.New("no beacon database configured")
+	}
+
+	start, end := cfg.StartEpoch, cfg.EndEpoch
+	if end == 0 || end > headEpoch {
+		end = headEpoch
+	}
+
+	// Data older than the history length would be pruned right away.
+	if headEpoch >= s.params.historyLength && start <= headEpoch-s.params.historyLength {
+		start = headEpoch - s.params.historyLength + 1
+	}
+
+	// Spans cannot be updated for epochs already written to disk.
+	for _, epoch := range s.latestEpochUpdatedForValidator {
+		if epoch >= start {
+			start = epoch + 1
+		}
+	}
+
+	if start > end {
+		log.WithFields(logrus.Fields{
+			"startEpoch": cfg.StartEpoch,
+			"endEpoch":   cfg.EndEpoch,
+		}).Warn("Nothing to backfill, slasher already has data for the requested epochs")
+		return nil
+	}
+
+	log.WithFields(logrus.Fields{
+		"startEpoch": start,
+		"endEpoch":   end,
+	}).Info("Backfilling slasher with historical blocks")
+
+	started := time.Now()
+	for epoch := start; epoch <= end; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.backfillEpoch(ctx, epoch); err != nil {
+			return errors.Wrapf(err, "could not backfill epoch %d", epoch)
+		}
+		backfilledEpochsTotal.Inc()
+	}
+
+	log.WithField("elapsed", time.Since(started)).Info("Finished backfilling slasher")
+	return nil
+}
+
+// backfillEpoch checks the attestations and block headers of all the blocks stored for the given epoch.
+func (s *Service) backfillEpoch(ctx context.Context, epoch primitives.Epoch) error {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return err
+	}
+	endSlot, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return err
+	}
+
+	// Blocks from forks are stored as well, which is what allows to detect double proposals.
+	blks, roots, err := s.serviceCfg.BeaconDatabase.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve blocks")
+	}
+	if len(blks) == 0 {
+		return nil
+	}
+
+	attWrappers, err := s.backfillAttestations(ctx, blks, roots)
+	if err != nil {
+		return err
+	}
+
+	proposals := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(blks))
+	for i, blk := range blks {
+		header, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
+		if err != nil {
+			return errors.Wrapf(err, "could not get header of block %#x", roots[i])
+		}
+		proposals = append(proposals, &slashertypes.SignedBlockHeaderWrapper{
+			SignedBeaconBlockHeader: header,
+			HeaderRoot:              roots[i],
+		})
+	}
+
+	valid, _, _ := s.filterAttestations(attWrappers, epoch)
+	attSlashings, err := s.checkSlashableAttestations(ctx, epoch, valid)
+	if err != nil {
+		return errors.Wrap(err, couldNotCheckSlashableAtt)
+	}
+	if _, err := s.processAttesterSlashings(ctx, attSlashings); err != nil {
+		return errors.Wrap(err, couldNotProcessAttesterSlashings)
+	}
+
+	proposerSlashings, err := s.detectProposerSlashings(ctx, proposals)
+	if err != nil {
+		return errors.Wrap(err, "could not detect proposer slashings")
+	}
+	if err := s.processProposerSlashings(ctx, proposerSlashings); err != nil {
+		return errors.Wrap(err, "could not process proposer slashings")
+	}
+
+	log.WithFields(logrus.Fields{
+		"epoch":                epoch,
+		"numBlocks":            len(blks),
+		"numAtts":              len(valid),
+		"numAttesterSlashings": len(attSlashings),
+		"numProposerSlashings": len(proposerSlashings),
+	}).Debug("Backfilled epoch")
+
+	return nil
+}
+
+// backfillAttestations converts the attestations included in the given blocks to indexed attestations.
+// Blocks of an epoch only include attestations for the current and previous epochs, so the committees
+// can all be computed from the post state of the latest block.
+func (s *Service) backfillAttestations(
+	ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, roots [][32]byte,
+) ([]*slashertypes.IndexedAttestationWrapper, error) {
+	latest, attsCount := 0, 0
+	for i, blk := range blks {
+		if blk.Block().Slot() > blks[latest].Block().Slot() {
+			latest = i
+		}
+		attsCount += len(blk.Block().Body().Attestations())
+	}
+	if attsCount == 0 {
+		return nil, nil
+	}
+	st, err := s.serviceCfg.StateGen.StateByRoot(ctx, roots[latest])
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state of block %#x", roots[latest])
+	}
+
+	attWrappers := make([]*slashertypes.IndexedAttestationWrapper, 0, attsCount)
+	for _, blk := range blks {
+		for _, att := range blk.Block().Body().Attestations() {
+			committees, err := helpers.AttestationCommittees(ctx, st, att)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get attestation committees")
+			}
+			indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committees...)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not convert to indexed attestation")
+			}
+			dataRoot, err := indexedAtt.GetData().HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get hash tree root of attestation")
+			}
+			attWrappers = append(attWrappers, &slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: indexedAtt,
+				DataRoot:           dataRoot,
+			})
+		}
+	}
+	return attWrappers, nil
+}
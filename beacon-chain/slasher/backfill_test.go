@@ -0,0 +1,73 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	slashingsmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_backfill_DoubleProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	slasherDB := dbtest.SetupSlasherDB(t)
+
+	beaconState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	stateGen := stategen.New(beaconDB, doublylinkedtree.New())
+	parentRoot := bytesutil.ToBytes32([]byte("parent"))
+	require.NoError(t, stateGen.SaveState(ctx, parentRoot, beaconState))
+
+	slot := params.BeaconConfig().SlotsPerEpoch + 1
+	for _, graffiti := range []string{"a", "b"} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = 1
+		b.Block.ParentRoot = parentRoot[:]
+		copy(b.Block.Body.Graffiti, graffiti)
+		util.SaveBlock(t, ctx, beaconDB, b)
+	}
+
+	s, err := New(ctx, &ServiceConfig{
+		Database:             slasherDB,
+		BeaconDatabase:       beaconDB,
+		HeadStateFetcher:     &mock.ChainService{State: beaconState},
+		StateGen:             stateGen,
+		SlashingPoolInserter: &slashingsmock.PoolMock{},
+		Backfill:             &BackfillConfig{StartEpoch: 0},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.backfill(ctx, 2))
+	require.LogsContain(t, hook, "Backfilling slasher with historical blocks")
+	// The blocks are not signed, but the double proposal was found.
+	require.LogsContain(t, hook, "Invalid signature for block header in detected slashing offense")
+	require.LogsContain(t, hook, "Finished backfilling slasher")
+}
+
+func TestService_backfill_SkipsEpochsAlreadyWritten(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	s, err := New(ctx, &ServiceConfig{
+		Database:       dbtest.SetupSlasherDB(t),
+		BeaconDatabase: dbtest.SetupDB(t),
+		Backfill:       &BackfillConfig{StartEpoch: 1, EndEpoch: 4},
+	})
+	require.NoError(t, err)
+	s.latestEpochUpdatedForValidator[0] = 4
+
+	require.NoError(t, s.backfill(ctx, 10))
+	require.LogsContain(t, hook, "Nothing to backfill")
+	require.LogsDoNotContain(t, hook, "Backfilling slasher with historical blocks")
+}
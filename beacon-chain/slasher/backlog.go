@@ -0,0 +1,27 @@
+package slasher
+
+import "time"
+
+// BacklogFetcher retrieves how far behind the slasher is in processing the attestations and blocks it receives.
+type BacklogFetcher interface {
+	Backlog() Backlog
+}
+
+var _ BacklogFetcher = (*Service)(nil)
+
+// Backlog of the slasher. Queued attestations and blocks are processed once per slot, so a slasher
+// whose processing of the queued attestations takes longer than a slot falls behind.
+type Backlog struct {
+	QueuedAttestations         int
+	QueuedBlocks               int
+	LastAttestationsProcessing time.Duration
+}
+
+// Backlog returns the current backlog of the slasher.
+func (s *Service) Backlog() Backlog {
+	return Backlog{
+		QueuedAttestations:         s.attsQueue.size(),
+		QueuedBlocks:               s.blksQueue.size(),
+		LastAttestationsProcessing: time.Duration(s.lastAttsProcessing.Load()),
+	}
+}
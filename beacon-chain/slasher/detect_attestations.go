@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"golang.org/x/exp/maps"
+	"golang.org/x/sync/errgroup"
 )
 
 // Takes in a list of indexed attestation wrappers and returns any
@@ -51,7 +53,18 @@ func (s *Service) checkSlashableAttestations(
 	return slashings, nil
 }
 
+// validatorChunkSpans holds the updated min and max spans, as well as the slashings found,
+// for a single validator chunk index.
+type validatorChunkSpans struct {
+	validatorChunkIndex  uint64
+	minChunkByChunkIndex map[uint64]Chunker
+	maxChunkByChunkIndex map[uint64]Chunker
+	slashings            map[[fieldparams.RootLength]byte]ethpb.AttSlashing
+}
+
 // Check for surrounding and surrounded votes in our database given a list of incoming attestations.
+// Validator chunk indexes are processed concurrently by a pool of workers, while the updated chunks
+// are collected here and written to disk in batches.
 func (s *Service) checkSurroundVotes(
 	ctx context.Context,
 	attWrappers []*slashertypes.IndexedAttestationWrapper,
@@ -62,6 +75,9 @@ func (s *Service) checkSurroundVotes(
 	// 25_600 chunks * 8KB = 200MB
 	const maxChunkBeforeFlush = 25_600
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	slashings := map[[fieldparams.RootLength]byte]ethpb.AttSlashing{}
 
 	// Group attestation wrappers by validator chunk index.
@@ -73,54 +89,69 @@ func (s *Service) checkSurroundVotes(
 
 	chunksCounts := 0
 
-	for validatorChunkIndex, attWrappers := range attWrappersByValidatorChunkIndex {
-		minChunkByChunkIndex, err := s.updatedChunkByChunkIndex(ctx, slashertypes.MinSpan, currentEpoch, validatorChunkIndex)
-		if err != nil {
-			return nil, errors.Wrap(err, "could not update updatedMinChunks")
-		}
-
-		maxChunkByChunkIndex, err := s.updatedChunkByChunkIndex(ctx, slashertypes.MaxSpan, currentEpoch, validatorChunkIndex)
-		if err != nil {
-			return nil, errors.Wrap(err, "could not update updatedMaxChunks")
+	// Workers only read the latest updated epochs, which are updated once all of them are done.
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(s.workerCount())
+	results := make(chan *validatorChunkSpans, s.workerCount())
+	var workersErr error
+	go func() {
+		defer close(results)
+		for validatorChunkIndex, attWrappers := range attWrappersByValidatorChunkIndex {
+			g.Go(func() error {
+				spans, err := s.updateSpansForValidatorChunk(gctx, validatorChunkIndex, attWrappers, currentEpoch)
+				if err != nil {
+					return err
+				}
+				select {
+				case results <- spans:
+					return nil
+				case <-gctx.Done():
+					return gctx.Err()
+				}
+			})
 		}
-
-		chunksCounts += len(minChunkByChunkIndex) + len(maxChunkByChunkIndex)
-
-		// Group (already grouped by validator chunk index) attestation wrappers by chunk index.
-		attWrappersByChunkIndex := s.groupByChunkIndex(attWrappers)
-
-		// Check for surrounding votes.
-		surroundingSlashings, err := s.updateSpans(ctx, minChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MinSpan, validatorChunkIndex, currentEpoch)
-		if err != nil {
-			return nil, errors.Wrapf(err, "could not update min attestation spans for validator chunk index %d", validatorChunkIndex)
+		workersErr = g.Wait()
+	}()
+
+	// flush saves the memoized chunks to disk in a single batch per chunk kind.
+	flush := func() error {
+		start := time.Now()
+		defer func() {
+			chunksFlushDuration.Observe(float64(time.Since(start).Milliseconds()))
+		}()
+		if err := s.saveChunksToDisk(ctx, slashertypes.MinSpan, minChunkByChunkIndexByValidatorChunkIndex); err != nil {
+			return errors.Wrap(err, "could not save updated min chunks to disk")
 		}
-
-		for root, slashing := range surroundingSlashings {
-			slashings[root] = slashing
+		if err := s.saveChunksToDisk(ctx, slashertypes.MaxSpan, maxChunkByChunkIndexByValidatorChunkIndex); err != nil {
+			return errors.Wrap(err, "could not save updated max chunks to disk")
 		}
+		return nil
+	}
 
-		// Check for surrounded votes.
-		surroundedSlashings, err := s.updateSpans(ctx, maxChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MaxSpan, validatorChunkIndex, currentEpoch)
-		if err != nil {
-			return nil, errors.Wrapf(err, "could not update max attestation spans for validator chunk index %d", validatorChunkIndex)
+	updatedValidatorChunkIndexes := make([]uint64, 0, attWrappersByValidatorChunkIndexCount)
+	var flushErr error
+	for spans := range results {
+		if flushErr != nil {
+			// Drain the results so the workers can exit.
+			continue
 		}
 
-		for root, slashing := range surroundedSlashings {
+		for root, slashing := range spans.slashings {
 			slashings[root] = slashing
 		}
 
 		// Memoize the updated chunks for the current validator chunk index.
-		minChunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex] = minChunkByChunkIndex
-		maxChunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex] = maxChunkByChunkIndex
+		minChunkByChunkIndexByValidatorChunkIndex[spans.validatorChunkIndex] = spans.minChunkByChunkIndex
+		maxChunkByChunkIndexByValidatorChunkIndex[spans.validatorChunkIndex] = spans.maxChunkByChunkIndex
+		chunksCounts += len(spans.minChunkByChunkIndex) + len(spans.maxChunkByChunkIndex)
+		updatedValidatorChunkIndexes = append(updatedValidatorChunkIndexes, spans.validatorChunkIndex)
 
 		if chunksCounts >= maxChunkBeforeFlush {
 			// Save the updated chunks to disk if we have reached the maximum number of chunks to store in memory.
-			if err := s.saveChunksToDisk(ctx, slashertypes.MinSpan, minChunkByChunkIndexByValidatorChunkIndex); err != nil {
-				return nil, errors.Wrap(err, "could not save updated min chunks to disk")
-			}
-
-			if err := s.saveChunksToDisk(ctx, slashertypes.MaxSpan, maxChunkByChunkIndexByValidatorChunkIndex); err != nil {
-				return nil, errors.Wrap(err, "could not save updated max chunks to disk")
+			if err := flush(); err != nil {
+				flushErr = err
+				cancel()
+				continue
 			}
 
 			// Reset the chunks counts.
@@ -130,24 +161,81 @@ func (s *Service) checkSurroundVotes(
 			minChunkByChunkIndexByValidatorChunkIndex = make(map[uint64]map[uint64]Chunker, attWrappersByValidatorChunkIndexCount)
 			maxChunkByChunkIndexByValidatorChunkIndex = make(map[uint64]map[uint64]Chunker, attWrappersByValidatorChunkIndexCount)
 		}
+	}
+	if flushErr != nil {
+		return nil, flushErr
+	}
+	if workersErr != nil {
+		return nil, workersErr
+	}
+
+	// Save the updated chunks to disk.
+	if err := flush(); err != nil {
+		return nil, err
+	}
 
-		// Update the latest updated epoch for all validators involved to the current chunk.
+	// Update the latest updated epoch for all validators involved to the current chunk.
+	for _, validatorChunkIndex := range updatedValidatorChunkIndexes {
 		indexes := s.params.ValidatorIndexesInChunk(validatorChunkIndex)
 		for _, index := range indexes {
 			s.latestEpochUpdatedForValidator[index] = currentEpoch
 		}
 	}
 
-	// Save the updated chunks to disk.
-	if err := s.saveChunksToDisk(ctx, slashertypes.MinSpan, minChunkByChunkIndexByValidatorChunkIndex); err != nil {
-		return nil, errors.Wrap(err, "could not save updated min chunks to disk")
+	return slashings, nil
+}
+
+// updateSpansForValidatorChunk loads and updates the min and max spans of the validators in the given
+// validator chunk index with the given attestations, detecting surrounding and surrounded votes on the way.
+// It does not write anything to disk.
+func (s *Service) updateSpansForValidatorChunk(
+	ctx context.Context,
+	validatorChunkIndex uint64,
+	attWrappers []*slashertypes.IndexedAttestationWrapper,
+	currentEpoch primitives.Epoch,
+) (*validatorChunkSpans, error) {
+	activeSpanWorkers.Inc()
+	defer activeSpanWorkers.Dec()
+
+	minChunkByChunkIndex, err := s.updatedChunkByChunkIndex(ctx, slashertypes.MinSpan, currentEpoch, validatorChunkIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not update updatedMinChunks")
+	}
+
+	maxChunkByChunkIndex, err := s.updatedChunkByChunkIndex(ctx, slashertypes.MaxSpan, currentEpoch, validatorChunkIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not update updatedMaxChunks")
 	}
 
-	if err := s.saveChunksToDisk(ctx, slashertypes.MaxSpan, maxChunkByChunkIndexByValidatorChunkIndex); err != nil {
-		return nil, errors.Wrap(err, "could not save updated max chunks to disk")
+	// Group (already grouped by validator chunk index) attestation wrappers by chunk index.
+	attWrappersByChunkIndex := s.groupByChunkIndex(attWrappers)
+
+	// Check for surrounding votes.
+	surroundingSlashings, err := s.updateSpans(ctx, minChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MinSpan, validatorChunkIndex, currentEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not update min attestation spans for validator chunk index %d", validatorChunkIndex)
 	}
 
-	return slashings, nil
+	// Check for surrounded votes.
+	surroundedSlashings, err := s.updateSpans(ctx, maxChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MaxSpan, validatorChunkIndex, currentEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not update max attestation spans for validator chunk index %d", validatorChunkIndex)
+	}
+
+	slashings := make(map[[fieldparams.RootLength]byte]ethpb.AttSlashing, len(surroundingSlashings)+len(surroundedSlashings))
+	for root, slashing := range surroundingSlashings {
+		slashings[root] = slashing
+	}
+	for root, slashing := range surroundedSlashings {
+		slashings[root] = slashing
+	}
+
+	return &validatorChunkSpans{
+		validatorChunkIndex:  validatorChunkIndex,
+		minChunkByChunkIndex: minChunkByChunkIndex,
+		maxChunkByChunkIndex: maxChunkByChunkIndex,
+		slashings:            slashings,
+	}, nil
 }
 
 // Check for double votes in our database given a list of incoming attestations.
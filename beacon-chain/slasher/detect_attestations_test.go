@@ -1500,6 +1500,40 @@ func runAttestationsBenchmark(b *testing.B, s *Service, numAtts, numValidators u
 	}
 }
 
+func Test_checkSurroundVotes_MultipleValidatorChunksConcurrently(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+
+	// Use two validators per chunk, so that the 8 validators below span 4 validator chunk indexes.
+	s, err := New(ctx, &ServiceConfig{Database: slasherDB, MaxWorkers: 4})
+	require.NoError(t, err)
+	s.params = NewParams(16, 2, 4096)
+
+	const validatorsCount = 8
+	currentEpoch := primitives.Epoch(5)
+
+	surrounded := make([]*slashertypes.IndexedAttestationWrapper, 0, validatorsCount)
+	surrounding := make([]*slashertypes.IndexedAttestationWrapper, 0, validatorsCount)
+	for i := uint64(0); i < validatorsCount; i++ {
+		surrounded = append(surrounded, createAttestationWrapperEmptySig(t, 2, 3, []uint64{i}, []byte{1}))
+		surrounding = append(surrounding, createAttestationWrapperEmptySig(t, 1, 4, []uint64{i}, []byte{2}))
+	}
+
+	slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, surrounded)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slashings))
+
+	slashings, err = s.checkSlashableAttestations(ctx, currentEpoch, surrounding)
+	require.NoError(t, err)
+	require.Equal(t, validatorsCount, len(slashings))
+
+	for i := primitives.ValidatorIndex(0); i < validatorsCount; i++ {
+		epoch, ok := s.latestEpochUpdatedForValidator[i]
+		require.Equal(t, true, ok)
+		require.Equal(t, currentEpoch, epoch)
+	}
+}
+
 func Benchmark_checkSurroundVotes(b *testing.B) {
 	const (
 		// Approximately the number of Holesky active validators on 2024-02-16
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
@@ -244,6 +245,29 @@ func GetChunkFromDatabase(
 	return lastEpochForValidatorIndex, chunkIndex, validatorChunkIndex, chunk, nil
 }
 
+// ExportDatabase writes the content of the slasher database found in dbPath to w.
+// It returns the number of exported records.
+func ExportDatabase(ctx context.Context, dbPath string, w io.Writer) (uint64, error) {
+	d, err := slasherkv.NewKVStore(ctx, dbPath)
+	if err != nil {
+		return 0, fmt.Errorf("could not open database at path %s: %w", dbPath, err)
+	}
+	defer closeDB(d)
+	return d.Export(ctx, w)
+}
+
+// ImportDatabase loads an export produced by ExportDatabase into the slasher database found in dbPath,
+// creating it if needed. The database must not contain any slasher data yet.
+// It returns the number of imported records.
+func ImportDatabase(ctx context.Context, dbPath string, r io.Reader) (uint64, error) {
+	d, err := slasherkv.NewKVStore(ctx, dbPath)
+	if err != nil {
+		return 0, fmt.Errorf("could not open database at path %s: %w", dbPath, err)
+	}
+	defer closeDB(d)
+	return d.Import(ctx, r)
+}
+
 func closeDB(d *slasherkv.Store) {
 	if err := d.Close(); err != nil {
 		log.WithError(err).Error("could not close database")
@@ -0,0 +1,162 @@
+package slasher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/slice"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// maxDetectedOffenses is the number of detected offenses kept in memory, the oldest ones being dropped first.
+const maxDetectedOffenses = 1024
+
+// OffenseKind is the kind of a detected slashable offense.
+type OffenseKind string
+
+const (
+	AttesterOffense OffenseKind = "attester"
+	ProposerOffense OffenseKind = "proposer"
+)
+
+// DetectedOffense is a slashable offense found by the slasher since the node started.
+type DetectedOffense struct {
+	Kind OffenseKind
+	// ValidatorIndices are the slashable validators.
+	ValidatorIndices []primitives.ValidatorIndex
+	// Epoch is the target epoch of the attestations, or the epoch of the proposals.
+	Epoch        primitives.Epoch
+	SlashingRoot [32]byte
+	DetectedAt   time.Time
+}
+
+// ValidatorHistory holds the records of the slasher for a validator over a range of epochs.
+type ValidatorHistory struct {
+	Attestations []*slashertypes.IndexedAttestationWrapper
+	Proposals    []*slashertypes.SignedBlockHeaderWrapper
+	Offenses     []DetectedOffense
+}
+
+// HistoryFetcher retrieves the records of the slasher for a given validator.
+type HistoryFetcher interface {
+	ValidatorHistory(ctx context.Context, validatorIndex primitives.ValidatorIndex, startEpoch, endEpoch primitives.Epoch) (*ValidatorHistory, error)
+}
+
+var _ HistoryFetcher = (*Service)(nil)
+
+// detectedOffenses keeps the most recent offenses found by the slasher. Its zero value is ready to use.
+type detectedOffenses struct {
+	sync.RWMutex
+	offenses []DetectedOffense
+}
+
+func (d *detectedOffenses) add(offense DetectedOffense) {
+	d.Lock()
+	defer d.Unlock()
+	if len(d.offenses) == maxDetectedOffenses {
+		d.offenses = d.offenses[1:]
+	}
+	d.offenses = append(d.offenses, offense)
+}
+
+// forValidator returns the offenses of the given validator whose epoch is between start and end (inclusive).
+func (d *detectedOffenses) forValidator(validatorIndex primitives.ValidatorIndex, start, end primitives.Epoch) []DetectedOffense {
+	d.RLock()
+	defer d.RUnlock()
+	var offenses []DetectedOffense
+	for _, o := range d.offenses {
+		if o.Epoch < start || o.Epoch > end {
+			continue
+		}
+		for _, idx := range o.ValidatorIndices {
+			if idx == validatorIndex {
+				offenses = append(offenses, o)
+				break
+			}
+		}
+	}
+	return offenses
+}
+
+func (s *Service) recordAttesterOffense(root [32]byte, slashing ethpb.AttSlashing) {
+	first, second := slashing.FirstAttestation(), slashing.SecondAttestation()
+	indices := slice.IntersectionUint64(first.GetAttestingIndices(), second.GetAttestingIndices())
+	validatorIndices := make([]primitives.ValidatorIndex, len(indices))
+	for i, idx := range indices {
+		validatorIndices[i] = primitives.ValidatorIndex(idx)
+	}
+	s.offenses.add(DetectedOffense{
+		Kind:             AttesterOffense,
+		ValidatorIndices: validatorIndices,
+		Epoch:            second.GetData().Target.Epoch,
+		SlashingRoot:     root,
+		DetectedAt:       time.Now(),
+	})
+}
+
+func (s *Service) recordProposerOffense(slashing *ethpb.ProposerSlashing) {
+	root, err := slashing.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Error("Could not compute proposer slashing root")
+		return
+	}
+	header := slashing.Header_1.Header
+	s.offenses.add(DetectedOffense{
+		Kind:             ProposerOffense,
+		ValidatorIndices: []primitives.ValidatorIndex{header.ProposerIndex},
+		Epoch:            slots.ToEpoch(header.Slot),
+		SlashingRoot:     root,
+		DetectedAt:       time.Now(),
+	})
+}
+
+// ValidatorHistory returns the attestation and proposal records stored in the slasher database for the
+// given validator between startEpoch and endEpoch (inclusive), as well as the offenses detected for it
+// since the node started. Attestations are looked up by target epoch.
+func (s *Service) ValidatorHistory(
+	ctx context.Context, validatorIndex primitives.ValidatorIndex, startEpoch, endEpoch primitives.Epoch,
+) (*ValidatorHistory, error) {
+	if startEpoch > endEpoch {
+		return nil, errors.Errorf("start epoch %d is greater than end epoch %d", startEpoch, endEpoch)
+	}
+
+	history := &ValidatorHistory{}
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		att, err := s.serviceCfg.Database.AttestationRecordForValidator(ctx, validatorIndex, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get attestation record for epoch %d", epoch)
+		}
+		if att != nil {
+			history.Attestations = append(history.Attestations, att)
+		}
+
+		start, err := slots.EpochStart(epoch)
+		if err != nil {
+			return nil, err
+		}
+		end, err := slots.EpochEnd(epoch)
+		if err != nil {
+			return nil, err
+		}
+		for slot := start; slot <= end; slot++ {
+			proposal, err := s.serviceCfg.Database.BlockProposalForValidator(ctx, validatorIndex, slot)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get proposal record for slot %d", slot)
+			}
+			if proposal != nil {
+				history.Proposals = append(history.Proposals, proposal)
+			}
+		}
+	}
+
+	history.Offenses = s.offenses.forValidator(validatorIndex, startEpoch, endEpoch)
+	return history, nil
+}
@@ -0,0 +1,50 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_ValidatorHistory(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s, err := New(ctx, &ServiceConfig{Database: slasherDB})
+	require.NoError(t, err)
+
+	require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapperEmptySig(t, 1, 2, []uint64{1, 2}, nil),
+		createAttestationWrapperEmptySig(t, 4, 5, []uint64{1}, nil),
+	}))
+	require.NoError(t, slasherDB.SaveBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(t, 70, 1, nil),
+		createProposalWrapper(t, 71, 2, nil),
+	}))
+	s.recordProposerOffense(&ethpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, 70, 1, nil).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, 70, 1, []byte{1}).SignedBeaconBlockHeader,
+	})
+
+	history, err := s.ValidatorHistory(ctx, 1, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history.Attestations))
+	require.Equal(t, primitives.Epoch(2), history.Attestations[0].IndexedAttestation.GetData().Target.Epoch)
+	require.Equal(t, 1, len(history.Proposals))
+	require.Equal(t, primitives.Slot(70), history.Proposals[0].SignedBeaconBlockHeader.Header.Slot)
+	require.Equal(t, 1, len(history.Offenses))
+	require.Equal(t, ProposerOffense, history.Offenses[0].Kind)
+
+	history, err = s.ValidatorHistory(ctx, 2, 3, 4)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(history.Attestations))
+	require.Equal(t, 0, len(history.Proposals))
+	require.Equal(t, 0, len(history.Offenses))
+
+	_, err = s.ValidatorHistory(ctx, 1, 4, 3)
+	require.ErrorContains(t, "start epoch 4 is greater than end epoch 3", err)
+}
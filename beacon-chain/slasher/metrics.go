@@ -53,4 +53,34 @@ var (
 		Name: "slasher_surrounded_votes_total",
 		Help: "Total slashable surrounded votes successfully detected by slasher",
 	})
+	attestationsQueueSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_attestations_queue_size",
+		Help: "Number of attestations waiting in the slasher queue",
+	})
+	blocksQueueSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_blocks_queue_size",
+		Help: "Number of blocks waiting in the slasher queue",
+	})
+	attestationsProcessingDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "slasher_attestations_processing_milliseconds",
+		Help:    "Time it takes for slasher to process a batch of queued attestations",
+		Buckets: []float64{10, 50, 100, 250, 500, 1000, 2000, 4000, 8000, 12000, 30000},
+	})
+	chunksFlushDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "slasher_chunks_flush_milliseconds",
+		Help:    "Time it takes for slasher to write a batch of updated chunks to disk",
+		Buckets: []float64{1, 5, 10, 50, 100, 250, 500, 1000, 2000, 4000},
+	})
+	activeSpanWorkers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_active_span_workers",
+		Help: "Number of workers currently updating min and max spans",
+	})
+	backfilledEpochsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_backfilled_epochs_total",
+		Help: "Number of historical epochs checked for slashable offenses by slasher",
+	})
+	pendingSlashingsCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_pending_slashings",
+		Help: "Number of detected slashings waiting for operator approval",
+	})
 )
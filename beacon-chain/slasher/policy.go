@@ -0,0 +1,201 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// SlashingPolicy defines what the slasher does with the slashings it detects.
+type SlashingPolicy string
+
+const (
+	// PolicyBroadcast submits detected slashings to the operations pool right away, from where
+	// they are broadcast and included in blocks. This is the default.
+	PolicyBroadcast SlashingPolicy = "broadcast"
+	// PolicyApprove queues detected slashings until an operator approves or rejects them.
+	PolicyApprove SlashingPolicy = "approve"
+	// PolicyLogOnly only logs detected slashings.
+	PolicyLogOnly SlashingPolicy = "log-only"
+)
+
+// ErrPendingSlashingNotFound is returned when approving or rejecting a slashing which is not pending.
+var ErrPendingSlashingNotFound = errors.New("pending slashing not found")
+
+// ParseSlashingPolicy returns the slashing policy with the given name. An empty name is the default policy.
+func ParseSlashingPolicy(name string) (SlashingPolicy, error) {
+	switch p := SlashingPolicy(name); p {
+	case "":
+		return PolicyBroadcast, nil
+	case PolicyBroadcast, PolicyApprove, PolicyLogOnly:
+		return p, nil
+	default:
+		return "", errors.Errorf("unknown slashing policy %q, must be one of %s, %s or %s", name, PolicyBroadcast, PolicyApprove, PolicyLogOnly)
+	}
+}
+
+// PendingSlashing is a slashing waiting for an operator decision. Exactly one of
+// AttesterSlashing and ProposerSlashing is set.
+type PendingSlashing struct {
+	Root             [32]byte
+	AttesterSlashing ethpb.AttSlashing
+	ProposerSlashing *ethpb.ProposerSlashing
+	DetectedAt       time.Time
+}
+
+// SlashingApprover gives access to the slashings waiting for an operator decision.
+type SlashingApprover interface {
+	PendingSlashings() []PendingSlashing
+	ApproveSlashing(ctx context.Context, root [32]byte) error
+	RejectSlashing(root [32]byte) error
+}
+
+var _ SlashingApprover = (*Service)(nil)
+
+// maxPendingSlashings bounds the number of slashings waiting for approval, so that a flood of slashings, or an
+// operator never deciding, does not grow the queue without limit. The oldest slashing is dropped first.
+const maxPendingSlashings = 1024
+
+// pendingSlashings keeps the slashings waiting for approval. Its zero value is ready to use.
+type pendingSlashings struct {
+	sync.Mutex
+	slashings map[[32]byte]PendingSlashing
+}
+
+func (p *pendingSlashings) add(pending PendingSlashing) {
+	p.Lock()
+	defer p.Unlock()
+	if p.slashings == nil {
+		p.slashings = make(map[[32]byte]PendingSlashing)
+	}
+	if _, ok := p.slashings[pending.Root]; ok {
+		return
+	}
+	if len(p.slashings) >= maxPendingSlashings {
+		var oldest PendingSlashing
+		for _, s := range p.slashings {
+			if oldest.DetectedAt.IsZero() || s.DetectedAt.Before(oldest.DetectedAt) {
+				oldest = s
+			}
+		}
+		delete(p.slashings, oldest.Root)
+		log.WithField("slashingRoot", fmt.Sprintf("%#x", oldest.Root)).Warn("Too many slashings waiting for approval, dropped the oldest one")
+	}
+	p.slashings[pending.Root] = pending
+	pendingSlashingsCount.Set(float64(len(p.slashings)))
+}
+
+func (p *pendingSlashings) get(root [32]byte) (PendingSlashing, bool) {
+	p.Lock()
+	defer p.Unlock()
+	pending, ok := p.slashings[root]
+	return pending, ok
+}
+
+func (p *pendingSlashings) remove(root [32]byte) bool {
+	p.Lock()
+	defer p.Unlock()
+	_, ok := p.slashings[root]
+	delete(p.slashings, root)
+	pendingSlashingsCount.Set(float64(len(p.slashings)))
+	return ok
+}
+
+// Policy returns the slashing policy of the slasher.
+func (s *Service) Policy() SlashingPolicy {
+	if s.serviceCfg.Policy == "" {
+		return PolicyBroadcast
+	}
+	return s.serviceCfg.Policy
+}
+
+// submitAttesterSlashing applies the slashing policy to a verified attester slashing.
+func (s *Service) submitAttesterSlashing(ctx context.Context, st state.ReadOnlyBeaconState, root [32]byte, slashing ethpb.AttSlashing) {
+	switch s.Policy() {
+	case PolicyApprove:
+		s.pending.add(PendingSlashing{Root: root, AttesterSlashing: slashing, DetectedAt: time.Now()})
+		log.WithField("slashingRoot", fmt.Sprintf("%#x", root)).Warn("Attester slashing queued for operator approval")
+	case PolicyLogOnly:
+	default:
+		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(ctx, st, slashing); err != nil {
+			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+		}
+	}
+}
+
+// SubmitProposerSlashing applies the slashing policy to a verified proposer slashing, which is also used for the
+// proposer equivocations detected on gossip. It reports whether the slashing was inserted into the operations pool.
+func (s *Service) SubmitProposerSlashing(ctx context.Context, st state.ReadOnlyBeaconState, slashing *ethpb.ProposerSlashing) bool {
+	switch s.Policy() {
+	case PolicyApprove:
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			log.WithError(err).Error("Could not compute proposer slashing root")
+			return false
+		}
+		s.pending.add(PendingSlashing{Root: root, ProposerSlashing: slashing, DetectedAt: time.Now()})
+		log.WithField("slashingRoot", fmt.Sprintf("%#x", root)).Warn("Proposer slashing queued for operator approval")
+		return false
+	case PolicyLogOnly:
+		return false
+	default:
+		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, st, slashing); err != nil {
+			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
+			return false
+		}
+		return true
+	}
+}
+
+// PendingSlashings returns the slashings waiting for an operator decision, oldest first.
+func (s *Service) PendingSlashings() []PendingSlashing {
+	s.pending.Lock()
+	defer s.pending.Unlock()
+	pending := make([]PendingSlashing, 0, len(s.pending.slashings))
+	for _, p := range s.pending.slashings {
+		pending = append(pending, p)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].DetectedAt.Before(pending[j].DetectedAt)
+	})
+	return pending
+}
+
+// ApproveSlashing submits a pending slashing to the operations pool. The slashing stays pending until it is
+// inserted, so that the approval can be retried on failure.
+func (s *Service) ApproveSlashing(ctx context.Context, root [32]byte) error {
+	pending, ok := s.pending.get(root)
+	if !ok {
+		return ErrPendingSlashingNotFound
+	}
+	st, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if pending.AttesterSlashing != nil {
+		err = s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(ctx, st, pending.AttesterSlashing)
+	} else {
+		err = s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, st, pending.ProposerSlashing)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not insert slashing into operations pool")
+	}
+	s.pending.remove(root)
+	log.WithField("slashingRoot", fmt.Sprintf("%#x", root)).Info("Slashing approved by operator")
+	return nil
+}
+
+// RejectSlashing drops a pending slashing.
+func (s *Service) RejectSlashing(root [32]byte) error {
+	if !s.pending.remove(root) {
+		return ErrPendingSlashingNotFound
+	}
+	log.WithField("slashingRoot", fmt.Sprintf("%#x", root)).Info("Slashing rejected by operator")
+	return nil
+}
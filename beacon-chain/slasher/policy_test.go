@@ -0,0 +1,136 @@
+package slasher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	slashingsmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestParseSlashingPolicy(t *testing.T) {
+	p, err := ParseSlashingPolicy("")
+	require.NoError(t, err)
+	require.Equal(t, PolicyBroadcast, p)
+	p, err = ParseSlashingPolicy("log-only")
+	require.NoError(t, err)
+	require.Equal(t, PolicyLogOnly, p)
+	_, err = ParseSlashingPolicy("slash-everyone")
+	require.ErrorContains(t, "unknown slashing policy", err)
+}
+
+func TestService_SlashingPolicy(t *testing.T) {
+	ctx := context.Background()
+	beaconState, err := util.NewBeaconState()
+	require.NoError(t, err)
+
+	attSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+		Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+	}
+	attRoot, err := attSlashing.HashTreeRoot()
+	require.NoError(t, err)
+	propSlashing := &ethpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+	}
+	propRoot, err := propSlashing.HashTreeRoot()
+	require.NoError(t, err)
+
+	newService := func(policy SlashingPolicy) (*Service, *slashingsmock.PoolMock) {
+		pool := &slashingsmock.PoolMock{}
+		return &Service{
+			serviceCfg: &ServiceConfig{
+				SlashingPoolInserter: pool,
+				HeadStateFetcher:     &mock.ChainService{State: beaconState},
+				Policy:               policy,
+			},
+		}, pool
+	}
+
+	t.Run("broadcast", func(t *testing.T) {
+		s, pool := newService(PolicyBroadcast)
+		s.submitAttesterSlashing(ctx, beaconState, attRoot, attSlashing)
+		require.Equal(t, true, s.SubmitProposerSlashing(ctx, beaconState, propSlashing))
+		require.Equal(t, 1, len(pool.PendingAttSlashings))
+		require.Equal(t, 1, len(pool.PendingPropSlashings))
+		require.Equal(t, 0, len(s.PendingSlashings()))
+	})
+	t.Run("log-only", func(t *testing.T) {
+		s, pool := newService(PolicyLogOnly)
+		s.submitAttesterSlashing(ctx, beaconState, attRoot, attSlashing)
+		require.Equal(t, false, s.SubmitProposerSlashing(ctx, beaconState, propSlashing))
+		require.Equal(t, 0, len(pool.PendingAttSlashings))
+		require.Equal(t, 0, len(pool.PendingPropSlashings))
+		require.Equal(t, 0, len(s.PendingSlashings()))
+	})
+	t.Run("approve", func(t *testing.T) {
+		s, pool := newService(PolicyApprove)
+		s.submitAttesterSlashing(ctx, beaconState, attRoot, attSlashing)
+		require.Equal(t, false, s.SubmitProposerSlashing(ctx, beaconState, propSlashing))
+		// Submitting the same slashing again does not queue it twice.
+		s.SubmitProposerSlashing(ctx, beaconState, propSlashing)
+		require.Equal(t, 0, len(pool.PendingAttSlashings))
+		require.Equal(t, 0, len(pool.PendingPropSlashings))
+
+		pending := s.PendingSlashings()
+		require.Equal(t, 2, len(pending))
+		require.NotNil(t, pending[0].AttesterSlashing)
+		require.NotNil(t, pending[1].ProposerSlashing)
+
+		require.NoError(t, s.ApproveSlashing(ctx, attRoot))
+		require.Equal(t, 1, len(pool.PendingAttSlashings))
+		require.ErrorIs(t, s.ApproveSlashing(ctx, attRoot), ErrPendingSlashingNotFound)
+
+		require.NoError(t, s.RejectSlashing(propRoot))
+		require.Equal(t, 0, len(pool.PendingPropSlashings))
+		require.ErrorIs(t, s.RejectSlashing(propRoot), ErrPendingSlashingNotFound)
+		require.Equal(t, 0, len(s.PendingSlashings()))
+	})
+	t.Run("approve fails", func(t *testing.T) {
+		s, pool := newService(PolicyApprove)
+		s.serviceCfg.SlashingPoolInserter = &failingPoolInserter{PoolMock: pool}
+		s.submitAttesterSlashing(ctx, beaconState, attRoot, attSlashing)
+
+		require.ErrorContains(t, "could not insert slashing", s.ApproveSlashing(ctx, attRoot))
+		// The slashing is still pending, so that the approval can be retried.
+		require.Equal(t, 1, len(s.PendingSlashings()))
+
+		s.serviceCfg.SlashingPoolInserter = pool
+		require.NoError(t, s.ApproveSlashing(ctx, attRoot))
+		require.Equal(t, 1, len(pool.PendingAttSlashings))
+		require.Equal(t, 0, len(s.PendingSlashings()))
+	})
+}
+
+// failingPoolInserter fails to insert attester slashings.
+type failingPoolInserter struct {
+	*slashingsmock.PoolMock
+}
+
+func (*failingPoolInserter) InsertAttesterSlashing(context.Context, state.ReadOnlyBeaconState, ethpb.AttSlashing) error {
+	return errors.New("pool is full")
+}
+
+func TestPendingSlashings_Bound(t *testing.T) {
+	p := &pendingSlashings{}
+	start := time.Now()
+	root := func(i int) [32]byte {
+		return [32]byte{byte(i), byte(i >> 8)}
+	}
+	for i := 0; i <= maxPendingSlashings; i++ {
+		p.add(PendingSlashing{Root: root(i), DetectedAt: start.Add(time.Duration(i) * time.Second)})
+	}
+	require.Equal(t, maxPendingSlashings, len(p.slashings))
+	// The oldest slashing was dropped to make room for the newest one.
+	_, ok := p.get(root(0))
+	require.Equal(t, false, ok)
+	_, ok = p.get(root(maxPendingSlashings))
+	require.Equal(t, true, ok)
+}
@@ -10,8 +10,8 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
-// Verifies attester slashings, logs them, and submits them to the slashing operations pool
-// in the beacon node if they pass validation.
+// Verifies attester slashings, logs them, and submits them according to the
+// slashing policy if they pass validation.
 func (s *Service) processAttesterSlashings(
 	ctx context.Context, slashings map[[fieldparams.RootLength]byte]ethpb.AttSlashing,
 ) (map[[fieldparams.RootLength]byte]ethpb.AttSlashing, error) {
@@ -47,11 +47,10 @@ func (s *Service) processAttesterSlashings(
 			continue
 		}
 
-		// Log the slashing event and insert into the beacon node's operations pool.
+		// Log the slashing event and submit it according to the slashing policy.
 		logAttesterSlashing(slashing)
-		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(ctx, beaconState, slashing); err != nil {
-			log.WithError(err).Error("Could not insert attester slashing into operations pool")
-		}
+		s.recordAttesterOffense(root, slashing)
+		s.submitAttesterSlashing(ctx, beaconState, root, slashing)
 
 		processedSlashings[root] = slashing
 	}
@@ -59,8 +58,8 @@ func (s *Service) processAttesterSlashings(
 	return processedSlashings, nil
 }
 
-// Verifies proposer slashings, logs them, and submits them to the slashing operations pool
-// in the beacon node if they pass validation.
+// Verifies proposer slashings, logs them, and submits them according to the
+// slashing policy if they pass validation.
 func (s *Service) processProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
 	// If no slashings, return early.
 	if len(slashings) == 0 {
@@ -92,11 +91,10 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 			continue
 		}
 
-		// Log the slashing event and insert into the beacon node's operations pool.
+		// Log the slashing event and submit it according to the slashing policy.
 		logProposerSlashing(slashing)
-		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
-			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
-		}
+		s.recordProposerOffense(slashing)
+		s.SubmitProposerSlashing(ctx, beaconState, slashing)
 	}
 
 	return nil
@@ -98,6 +98,9 @@ func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-ch
 
 			// Process the retrieved attestations.
 			s.processAttestations(ctx, attestations, currentSlot)
+
+			// The attestations valid in the future were queued again by the processing.
+			attestationsQueueSize.Set(float64(s.attsQueue.size()))
 		case <-ctx.Done():
 			return
 		}
@@ -157,6 +160,8 @@ func (s *Service) processAttestations(
 	}
 
 	end := time.Since(start)
+	s.lastAttsProcessing.Store(int64(end))
+	attestationsProcessingDuration.Observe(float64(end.Milliseconds()))
 	log.WithField("elapsed", end).Info("Done processing queued attestations")
 
 	if len(slashings) > 0 {
@@ -176,6 +181,7 @@ func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan pri
 		case currentSlot := <-slotTicker:
 			blocks := s.blksQueue.dequeue()
 			currentEpoch := slots.ToEpoch(currentSlot)
+			blocksQueueSize.Set(float64(s.blksQueue.size()))
 
 			receivedBlocksTotal.Add(float64(len(blocks)))
 
@@ -6,7 +6,9 @@ package slasher
 
 import (
 	"context"
+	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/async/event"
@@ -42,6 +44,13 @@ type ServiceConfig struct {
 	HeadStateFetcher        blockchain.HeadFetcher
 	SyncChecker             beaconChainSync.Checker
 	ClockWaiter             startup.ClockWaiter
+	// MaxWorkers is the number of validator chunks processed concurrently. Defaults to GOMAXPROCS.
+	MaxWorkers int
+	// BeaconDatabase and Backfill are only required to check historical blocks on startup.
+	BeaconDatabase db.ReadOnlyDatabase
+	Backfill       *BackfillConfig
+	// Policy defines what to do with detected slashings. Defaults to PolicyBroadcast.
+	Policy SlashingPolicy
 }
 
 // Service defining a slasher implementation as part of
@@ -60,6 +69,9 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochUpdatedForValidator map[primitives.ValidatorIndex]primitives.Epoch
+	offenses                       detectedOffenses
+	pending                        pendingSlashings
+	lastAttsProcessing             atomic.Int64
 	wg                             sync.WaitGroup
 }
 
@@ -79,6 +91,14 @@ func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
 	}, nil
 }
 
+// workerCount returns the number of validator chunks to process concurrently.
+func (s *Service) workerCount() int {
+	if s.serviceCfg != nil && s.serviceCfg.MaxWorkers > 0 {
+		return s.serviceCfg.MaxWorkers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
 // Start listening for received indexed attestations and blocks
 // and perform slashing detection on them.
 func (s *Service) Start() {
@@ -147,6 +167,13 @@ func (s *Service) run() {
 	s.wg.Add(1)
 	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan)
 
+	// Attestations and blocks received meanwhile are queued, and processed once the backfill is done.
+	if s.serviceCfg.Backfill != nil {
+		if err := s.backfill(s.ctx, headEpoch); err != nil {
+			log.WithError(err).Error("Could not backfill slasher with historical blocks")
+		}
+	}
+
 	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
 	s.attsSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.blocksSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
@@ -181,7 +181,7 @@ func (f *FieldTrie) CopyTrie() *FieldTrie {
 	}
 	dstFieldTrie := make([][]*[32]byte, len(f.fieldLayers))
 	for i, layer := range f.fieldLayers {
-		dstFieldTrie[i] = make([]*[32]byte, len(layer))
+		dstFieldTrie[i] = getLayer(len(layer))
 		copy(dstFieldTrie[i], layer)
 	}
 	return &FieldTrie{
@@ -233,6 +233,25 @@ func (f *FieldTrie) TransferTrie() *FieldTrie {
 	return nTrie
 }
 
+// Release drops a reference to the trie. Once no state references the trie anymore, its layers
+// are handed over for reuse by future trie copies and the trie is empty afterwards.
+func (f *FieldTrie) Release() {
+	f.Lock()
+	defer f.Unlock()
+	// The reference is dropped and checked under the trie lock, so that the layers are
+	// only handed over once when several states release the trie concurrently.
+	if f.reference != nil {
+		f.reference.MinusRef()
+		if f.reference.Refs() > 0 {
+			return
+		}
+	}
+	for _, layer := range f.fieldLayers {
+		putLayer(layer)
+	}
+	f.fieldLayers = nil
+}
+
 // TrieRoot returns the corresponding root of the trie.
 func (f *FieldTrie) TrieRoot() ([32]byte, error) {
 	if f.Empty() {
@@ -266,10 +285,3 @@ func (f *FieldTrie) FieldReference() *stateutil.Reference {
 func (f *FieldTrie) Empty() bool {
 	return f == nil || len(f.fieldLayers) == 0 || f.isTransferred
 }
-
-// InsertFieldLayer manually inserts a field layer. This method
-// bypasses the normal method of field computation, it is only
-// meant to be used in tests.
-func (f *FieldTrie) InsertFieldLayer(layer [][]*[32]byte) {
-	f.fieldLayers = layer
-}
@@ -227,6 +227,56 @@ func TestFieldTrie_TransferTrie(t *testing.T) {
 	require.DeepEqual(t, oldRoot, newRoot)
 }
 
+func TestFieldTrie_Release(t *testing.T) {
+	newState, _ := util.DeterministicGenesisState(t, 32)
+	mixes := newState.RandaoMixes()
+	randaoMixes := make([][32]byte, len(mixes))
+	for i, r := range mixes {
+		randaoMixes[i] = [32]byte(r)
+	}
+	trie, err := NewFieldTrie(types.RandaoMixes, types.BasicArray, customtypes.RandaoMixes(randaoMixes), uint64(params.BeaconConfig().EpochsPerHistoricalVector))
+	require.NoError(t, err)
+	root, err := trie.TrieRoot()
+	require.NoError(t, err)
+
+	released := trie.CopyTrie()
+	released.Release()
+	require.Equal(t, true, released.Empty())
+
+	// The released layers are reused by the next copy, which must not affect the original trie.
+	newTrie := trie.CopyTrie()
+	newRoot, err := newTrie.TrieRoot()
+	require.NoError(t, err)
+	require.DeepEqual(t, root, newRoot)
+	randaoMixes[3] = [32]byte{'A', 'B'}
+	newRoot, err = newTrie.RecomputeTrie([]uint64{3}, customtypes.RandaoMixes(randaoMixes))
+	require.NoError(t, err)
+	require.DeepNotEqual(t, root, newRoot)
+	oldRoot, err := trie.TrieRoot()
+	require.NoError(t, err)
+	require.DeepEqual(t, root, oldRoot)
+}
+
+func BenchmarkFieldTrie_CopyTrie(b *testing.B) {
+	newState, _ := util.DeterministicGenesisState(b, 16000)
+	maxLength := (params.BeaconConfig().ValidatorRegistryLimit*8 + 31) / 32
+	trie, err := NewFieldTrie(types.Balances, types.CompressedArray, newState.Balances(), maxLength)
+	require.NoError(b, err)
+
+	b.Run("without release", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			trie.CopyTrie()
+		}
+	})
+	b.Run("with release", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			trie.CopyTrie().Release()
+		}
+	})
+}
+
 func FuzzFieldTrie(f *testing.F) {
 	newState, _ := util.DeterministicGenesisState(f, 40)
 	var data []byte
@@ -0,0 +1,39 @@
+package fieldtrie
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// minPooledLayer is the smallest layer length reused through the layer pools.
+// Smaller layers are cheap enough to allocate.
+const minPooledLayer = 64
+
+// layerPools holds the released trie layers by power of two capacity, so that the
+// validators trie copied on every late block reuses the layers of discarded states.
+var layerPools [64]sync.Pool
+
+// getLayer returns a layer of the given length, reusing a released layer when possible.
+func getLayer(length int) []*[32]byte {
+	if length < minPooledLayer {
+		return make([]*[32]byte, length)
+	}
+	class := bits.Len(uint(length - 1))
+	if l, ok := layerPools[class].Get().(*[]*[32]byte); ok {
+		return (*l)[:length]
+	}
+	return make([]*[32]byte, length, 1<<class)
+}
+
+// putLayer releases a layer which is no longer referenced anywhere, for it to be reused.
+func putLayer(layer []*[32]byte) {
+	if cap(layer) < minPooledLayer {
+		return
+	}
+	// Pool the layer in the largest class it can fully serve, and drop the nodes
+	// so that they can be garbage collected while the layer sits in the pool.
+	class := bits.Len(uint(cap(layer))) - 1
+	layer = layer[:cap(layer)]
+	clear(layer)
+	layerPools[class].Put(&layer)
+}
@@ -1052,6 +1052,24 @@ func TestValidatorReferences_ApplyValidator_BalancesRead(t *testing.T) {
 	}))
 }
 
+func TestCopyMerkleLayers(t *testing.T) {
+	layers := [][][]byte{
+		{{1, 1}, {2, 2}, {3, 3}, {4, 4}},
+		{{5, 5}, {6, 6}},
+		{{7, 7}},
+	}
+	copied := copyMerkleLayers(layers)
+	require.DeepEqual(t, layers, copied)
+
+	copied[0][1][0] = 9
+	assert.Equal(t, byte(2), layers[0][1][0], "Copy shares memory with the original layers")
+	// Appending to a node must not overwrite its neighbor in the copy.
+	_ = append(copied[0][0], 8)
+	assert.DeepEqual(t, []byte{9, 2}, copied[0][1])
+	_ = append(copied[0], []byte{8})
+	assert.DeepEqual(t, []byte{5, 5}, copied[1][0])
+}
+
 // assertRefCount checks whether reference count for a given state
 // at a given index is equal to expected amount.
 func assertRefCount(t *testing.T, b *BeaconState, idx types.FieldIndex, want uint) {
@@ -55,9 +55,7 @@ func TestBeaconState_NoDeadlock_Phase0(t *testing.T) {
 		for i := 0; i < 1000; i++ {
 			for _, f := range st.stateFieldLeaves {
 				f.Lock()
-				if f.Empty() {
-					f.InsertFieldLayer(make([][]*[32]byte, 10))
-				}
+				_ = f.Empty()
 				f.Unlock()
 				f.FieldReference().AddRef()
 			}
@@ -111,9 +109,7 @@ func TestBeaconState_NoDeadlock_Altair(t *testing.T) {
 		for i := 0; i < 1000; i++ {
 			for _, f := range s.stateFieldLeaves {
 				f.Lock()
-				if f.Empty() {
-					f.InsertFieldLayer(make([][]*[32]byte, 10))
-				}
+				_ = f.Empty()
 				f.Unlock()
 				f.FieldReference().AddRef()
 			}
@@ -167,9 +163,7 @@ func TestBeaconState_NoDeadlock_Bellatrix(t *testing.T) {
 		for i := 0; i < 1000; i++ {
 			for _, f := range s.stateFieldLeaves {
 				f.Lock()
-				if f.Empty() {
-					f.InsertFieldLayer(make([][]*[32]byte, 10))
-				}
+				_ = f.Empty()
 				f.Unlock()
 				f.FieldReference().AddRef()
 			}
@@ -223,9 +217,7 @@ func TestBeaconState_NoDeadlock_Capella(t *testing.T) {
 		for i := 0; i < 1000; i++ {
 			for _, f := range s.stateFieldLeaves {
 				f.Lock()
-				if f.Empty() {
-					f.InsertFieldLayer(make([][]*[32]byte, 10))
-				}
+				_ = f.Empty()
 				f.Unlock()
 				f.FieldReference().AddRef()
 			}
@@ -279,9 +271,7 @@ func TestBeaconState_NoDeadlock_Deneb(t *testing.T) {
 		for i := 0; i < 1000; i++ {
 			for _, f := range s.stateFieldLeaves {
 				f.Lock()
-				if f.Empty() {
-					f.InsertFieldLayer(make([][]*[32]byte, 10))
-				}
+				_ = f.Empty()
 				f.Unlock()
 				f.FieldReference().AddRef()
 			}
@@ -388,6 +378,33 @@ func TestCopyAllTries(t *testing.T) {
 	assert.NotEqual(t, rt, newRt)
 }
 
+func TestResetFieldTrie_Release(t *testing.T) {
+	newState := generateState(t)
+	_, err := newState.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	st, ok := newState.(*BeaconState)
+	require.Equal(t, true, ok)
+	cp, ok := st.Copy().(*BeaconState)
+	require.Equal(t, true, ok)
+	shared := st.stateFieldLeaves[types.Validators]
+	require.Equal(t, uint(2), shared.FieldReference().Refs())
+
+	// The copy still references the trie when the state rebuilds its own.
+	require.NoError(t, st.SetValidators(st.Validators()))
+	rt, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), shared.FieldReference().Refs())
+	assert.Equal(t, false, shared.Empty())
+
+	// The trie is released once the copy rebuilds its own too.
+	require.NoError(t, cp.SetValidators(cp.Validators()))
+	newRt, err := cp.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), shared.FieldReference().Refs())
+	assert.Equal(t, true, shared.Empty())
+	assert.Equal(t, rt, newRt)
+}
+
 func TestDuplicateDirtyIndices(t *testing.T) {
 	newState := &BeaconState{
 		rebuildTrie:  make(map[types.FieldIndex]bool),
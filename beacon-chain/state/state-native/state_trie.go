@@ -997,14 +997,7 @@ func (b *BeaconState) Copy() state.BeaconState {
 	}
 
 	if b.merkleLayers != nil {
-		dst.merkleLayers = make([][][]byte, len(b.merkleLayers))
-		for i, layer := range b.merkleLayers {
-			dst.merkleLayers[i] = make([][]byte, len(layer))
-			for j, content := range layer {
-				dst.merkleLayers[i][j] = make([]byte, len(content))
-				copy(dst.merkleLayers[i][j], content)
-			}
-		}
+		dst.merkleLayers = copyMerkleLayers(b.merkleLayers)
 	}
 
 	state.Count.Inc()
@@ -1013,6 +1006,30 @@ func (b *BeaconState) Copy() state.BeaconState {
 	return dst
 }
 
+// copyMerkleLayers deep copies the merkle layers of a state into a single buffer, rather than
+// allocating every node separately. The nodes are capped so that appending to one of them
+// never overwrites its neighbor in the buffer.
+func copyMerkleLayers(layers [][][]byte) [][][]byte {
+	size, count := 0, 0
+	for _, layer := range layers {
+		count += len(layer)
+		for _, content := range layer {
+			size += len(content)
+		}
+	}
+	buf := make([]byte, size)
+	nodes := make([][]byte, count)
+	dst := make([][][]byte, len(layers))
+	for i, layer := range layers {
+		dst[i], nodes = nodes[:len(layer):len(layer)], nodes[len(layer):]
+		for j, content := range layer {
+			n := copy(buf, content)
+			dst[i][j], buf = buf[:n:n], buf[n:]
+		}
+	}
+	return dst
+}
+
 // HashTreeRoot of the beacon state retrieves the Merkle root of the trie
 // representation of the beacon state based on the Ethereum Simple Serialize specification.
 func (b *BeaconState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
@@ -1332,14 +1349,11 @@ func (b *BeaconState) recomputeFieldTrie(index types.FieldIndex, elements interf
 	fTrieMutex.Lock()
 
 	if fTrie.Empty() {
-		err := b.resetFieldTrie(index, elements, fTrie.Length())
-		if err != nil {
-			fTrieMutex.Unlock()
+		// The trie is unlocked before it is reset, as resetting the trie releases it.
+		fTrieMutex.Unlock()
+		if err := b.resetFieldTrie(index, elements, fTrie.Length()); err != nil {
 			return [32]byte{}, err
 		}
-		// Reduce reference count as we are instantiating a new trie.
-		fTrie.FieldReference().MinusRef()
-		fTrieMutex.Unlock()
 		return b.stateFieldLeaves[index].TrieRoot()
 	}
 
@@ -1373,11 +1387,16 @@ func (b *BeaconState) recomputeFieldTrie(index types.FieldIndex, elements interf
 	return root, nil
 }
 
+// resetFieldTrie builds the trie of the field again from its elements. The previous trie of the state is released,
+// so that its layers are reused by future trie copies when no other state references it.
 func (b *BeaconState) resetFieldTrie(index types.FieldIndex, elements interface{}, length uint64) error {
 	fTrie, err := fieldtrie.NewFieldTrie(index, fieldMap[index], elements, length)
 	if err != nil {
 		return err
 	}
+	if oldTrie, ok := b.stateFieldLeaves[index]; ok && oldTrie != nil {
+		oldTrie.Release()
+	}
 	b.stateFieldLeaves[index] = fTrie
 	b.dirtyIndices[index] = []uint64{}
 	return nil
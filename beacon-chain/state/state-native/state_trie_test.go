@@ -522,6 +522,51 @@ func BenchmarkBeaconState(b *testing.B) {
 	})
 }
 
+func BenchmarkBeaconState_Copy(b *testing.B) {
+	testState, _ := util.DeterministicGenesisStateAltair(b, 16000)
+	_, err := testState.HashTreeRoot(context.Background())
+	require.NoError(b, err)
+
+	val, err := testState.ValidatorAtIndex(0)
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Modifying a validator of the copy copies the validators trie.
+		st := testState.Copy()
+		val.EffectiveBalance = uint64(i)
+		require.NoError(b, st.UpdateValidatorAtIndex(0, val))
+		_, err := st.HashTreeRoot(context.Background())
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkBeaconState_RebuildTrie(b *testing.B) {
+	testState, _ := util.DeterministicGenesisStateAltair(b, 16000)
+	_, err := testState.HashTreeRoot(context.Background())
+	require.NoError(b, err)
+
+	val, err := testState.ValidatorAtIndex(0)
+	require.NoError(b, err)
+	vals := testState.Validators()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// The validators trie copied when modifying a validator of the copy is released
+		// when the trie is rebuilt, for the copy of the next iteration to reuse it.
+		st := testState.Copy()
+		val.EffectiveBalance = uint64(i)
+		require.NoError(b, st.UpdateValidatorAtIndex(0, val))
+		_, err := st.HashTreeRoot(context.Background())
+		require.NoError(b, err)
+		require.NoError(b, st.SetValidators(vals))
+		_, err = st.HashTreeRoot(context.Background())
+		require.NoError(b, err)
+	}
+}
+
 func TestBeaconState_HashTreeRoot_FieldTrie(t *testing.T) {
 	testState, _ := util.DeterministicGenesisState(t, 64)
 
@@ -13,6 +13,9 @@ import (
 var (
 	// hotStateCacheSize defines the max number of hot state this can cache.
 	hotStateCacheSize = 32
+	// safeModeHotStateCacheSize defines the max number of hot state this can cache in safe mode, when finality
+	// has stalled and hot states are saved to the DB instead.
+	safeModeHotStateCacheSize = 8
 	// Metrics
 	hotStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "hot_state_cache_hit",
@@ -22,18 +25,30 @@ var (
 		Name: "hot_state_cache_miss",
 		Help: "The total number of cache misses on the hot state cache.",
 	})
+	hotStateSpilled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_spilled_total",
+		Help: "The total number of hot states evicted from the hot state cache which were saved to the DB.",
+	})
 )
 
 // hotStateCache is used to store the processed beacon state after finalized check point.
 type hotStateCache struct {
 	cache *lru.Cache
+	size  int
 	lock  sync.RWMutex
 }
 
+// evictedHotState is a hot state evicted from the cache to make room for other states.
+type evictedHotState struct {
+	root  [32]byte
+	state state.BeaconState
+}
+
 // newHotStateCache initializes the map and underlying cache.
 func newHotStateCache() *hotStateCache {
 	return &hotStateCache{
 		cache: lruwrpr.New(hotStateCacheSize),
+		size:  hotStateCacheSize,
 	}
 }
 
@@ -73,11 +88,16 @@ func (c *hotStateCache) getWithoutCopy(blockRoot [32]byte) state.BeaconState {
 	return nil
 }
 
-// put the response in the cache.
-func (c *hotStateCache) put(blockRoot [32]byte, state state.BeaconState) {
+// put the response in the cache. It returns the least recently used state if it was evicted to make room for it.
+func (c *hotStateCache) put(blockRoot [32]byte, state state.BeaconState) *evictedHotState {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	var evicted *evictedHotState
+	if c.cache.Len() >= c.size && !c.cache.Contains(blockRoot) {
+		evicted = c.oldest()
+	}
 	c.cache.Add(blockRoot, state)
+	return evicted
 }
 
 // has returns true if the key exists in the cache.
@@ -93,3 +113,33 @@ func (c *hotStateCache) delete(blockRoot [32]byte) bool {
 	defer c.lock.Unlock()
 	return c.cache.Remove(blockRoot)
 }
+
+// resize changes the max number of hot states in the cache, and returns the least recently used states evicted
+// to fit in the new size.
+func (c *hotStateCache) resize(size int) []*evictedHotState {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var evicted []*evictedHotState
+	for c.cache.Len() > size {
+		key, item, ok := c.cache.RemoveOldest()
+		if !ok {
+			break
+		}
+		if item != nil {
+			evicted = append(evicted, &evictedHotState{root: key.([32]byte), state: item.(state.BeaconState)})
+		}
+	}
+	c.cache.Resize(size)
+	c.size = size
+	return evicted
+}
+
+// oldest returns the least recently used state of the cache, if any.
+// The caller must hold the lock.
+func (c *hotStateCache) oldest() *evictedHotState {
+	key, item, ok := c.cache.GetOldest()
+	if !ok || item == nil {
+		return nil
+	}
+	return &evictedHotState{root: key.([32]byte), state: item.(state.BeaconState)}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -32,3 +33,40 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 	c.delete(root)
 	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
 }
+
+func TestHotStateCache_Resize(t *testing.T) {
+	c := newHotStateCache()
+	for i := 0; i < hotStateCacheSize; i++ {
+		s, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{Slot: 10})
+		require.NoError(t, err)
+		c.put([32]byte{byte(i)}, s)
+	}
+
+	evicted := c.resize(safeModeHotStateCacheSize)
+	require.Equal(t, hotStateCacheSize-safeModeHotStateCacheSize, len(evicted))
+	assert.Equal(t, [32]byte{0}, evicted[0].root)
+	assert.Equal(t, false, c.has([32]byte{0}), "Least recently used state not evicted")
+	assert.Equal(t, true, c.has([32]byte{byte(hotStateCacheSize - 1)}), "Most recently used state evicted")
+
+	assert.Equal(t, 0, len(c.resize(hotStateCacheSize)))
+	assert.Equal(t, safeModeHotStateCacheSize, c.cache.Len())
+}
+
+func TestHotStateCache_PutEvicts(t *testing.T) {
+	c := newHotStateCache()
+	c.resize(2)
+	for i := 0; i < 2; i++ {
+		s, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{Slot: primitives.Slot(i)})
+		require.NoError(t, err)
+		assert.Equal(t, (*evictedHotState)(nil), c.put([32]byte{byte(i)}, s))
+	}
+	// Putting a cached state again does not evict anything.
+	assert.Equal(t, (*evictedHotState)(nil), c.put([32]byte{0}, c.getWithoutCopy([32]byte{0})))
+
+	s, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{Slot: 2})
+	require.NoError(t, err)
+	evicted := c.put([32]byte{2}, s)
+	require.NotNil(t, evicted)
+	assert.Equal(t, [32]byte{1}, evicted.root)
+	assert.Equal(t, primitives.Slot(1), evicted.state.Slot())
+}
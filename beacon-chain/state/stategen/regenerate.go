@@ -0,0 +1,323 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// Locations of the copies of a state replaced by a regeneration.
+const (
+	LocationHotStateCache           = "hot_state_cache"
+	LocationEpochBoundaryStateCache = "epoch_boundary_state_cache"
+	LocationFinalizedState          = "finalized_state"
+	LocationDB                      = "db"
+)
+
+var errStateMismatch = errors.New("regenerated state does not match the block")
+
+// Regenerator forces the regeneration of the state of a block, to repair the copies of the state kept by the node.
+type Regenerator interface {
+	RegenerateState(ctx context.Context, blockRoot [32]byte) (*Regeneration, error)
+}
+
+// Regeneration describes the regeneration of the state of a block.
+type Regeneration struct {
+	BlockRoot [32]byte
+	Slot      primitives.Slot
+	StateRoot [32]byte
+	// BaseRoot and BaseSlot identify the verified state from which the blocks were replayed.
+	BaseRoot       [32]byte
+	BaseSlot       primitives.Slot
+	ReplayedBlocks int
+	// Replaced lists the locations of the copies of the state which were replaced, Corrupted the ones among them
+	// which did not match the regenerated state.
+	Replaced  []string
+	Corrupted []string
+}
+
+// RegenerateState regenerates the state of the given block root without using the hot state and epoch boundary
+// caches, by replaying blocks from the closest ancestor state which is stored in the DB or is the finalized or
+// genesis state, and which matches its block. Once the regenerated state is verified against the state root of the
+// block, it replaces every copy of the state kept in the caches, the finalized state and the DB, so that a corrupted
+// copy can be repaired without restarting or resyncing the node. Nothing is replaced if the regeneration fails.
+func (s *State) RegenerateState(ctx context.Context, blockRoot [32]byte) (*Regeneration, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.RegenerateState")
+	defer span.End()
+
+	b, err := s.beaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block")
+	}
+	if b == nil || b.IsNil() {
+		return nil, errUnknownBlock
+	}
+	baseRoot, base, err := s.regenerationBase(ctx, blockRoot, b)
+	if err != nil {
+		return nil, err
+	}
+	r := &Regeneration{
+		BlockRoot: blockRoot,
+		Slot:      b.Block().Slot(),
+		BaseRoot:  baseRoot,
+		BaseSlot:  base.Slot(),
+	}
+
+	st := base
+	if base.Slot() < r.Slot {
+		blks, err := s.loadBlocks(ctx, base.Slot()+1, r.Slot, blockRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load blocks")
+		}
+		st, err = s.replayBlocks(ctx, base, blks, r.Slot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not replay blocks")
+		}
+		r.ReplayedBlocks = len(blks)
+	}
+	ok, err := StateMatchesBlock(ctx, st, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Wrapf(errStateMismatch, "block root %#x", blockRoot)
+	}
+	r.StateRoot, err = st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state root")
+	}
+
+	if err := s.replaceState(ctx, r, st); err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"blockRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:])),
+		"slot":           r.Slot,
+		"baseSlot":       r.BaseSlot,
+		"replayedBlocks": r.ReplayedBlocks,
+		"replaced":       r.Replaced,
+		"corrupted":      r.Corrupted,
+	}).Info("Regenerated state")
+	return r, nil
+}
+
+// regenerationBase walks back the ancestors of the block, starting with the block itself, until it finds a state
+// which is stored in the DB or is the finalized or genesis state, and which matches its block. Stored states which do
+// not match their block are skipped.
+func (s *State) regenerationBase(ctx context.Context, blockRoot [32]byte, b interfaces.ReadOnlySignedBeaconBlock) ([32]byte, state.BeaconState, error) {
+	root := blockRoot
+	for {
+		if ctx.Err() != nil {
+			return [32]byte{}, nil, ctx.Err()
+		}
+		st, err := s.verifiedStoredState(ctx, root, b.Block().Slot(), root == blockRoot)
+		if err != nil {
+			return [32]byte{}, nil, err
+		}
+		if st != nil {
+			return root, st, nil
+		}
+
+		parentRoot := b.Block().ParentRoot()
+		if parentRoot == params.BeaconConfig().ZeroHash {
+			return [32]byte{}, nil, errors.Wrapf(errUnknownState, "no state matches the genesis block %#x", root)
+		}
+		// Return an error if slot hasn't been covered by checkpoint sync.
+		ps := b.Block().Slot() - 1
+		if !s.slotAvailable(ps) {
+			return [32]byte{}, nil, errors.Wrapf(ErrNoDataForSlot, "slot %d not in db due to checkpoint sync", ps)
+		}
+		b, err = s.beaconDB.Block(ctx, parentRoot)
+		if err != nil {
+			return [32]byte{}, nil, errors.Wrap(err, "failed to retrieve block from db")
+		}
+		if err := blocks.BeaconBlockIsNil(b); err != nil {
+			return [32]byte{}, nil, errUnknownBlock
+		}
+		root = parentRoot
+	}
+}
+
+// verifiedStoredState returns a copy of the finalized, genesis or DB state of the block root if one of them matches
+// the block, or nil. The states which do not match are logged and skipped. With atSlot, only the states at the slot
+// of the block are considered, as the states advanced to a later slot cannot be verified against the block.
+func (s *State) verifiedStoredState(ctx context.Context, root [32]byte, slot primitives.Slot, atSlot bool) (state.BeaconState, error) {
+	type candidate struct {
+		location string
+		st       state.BeaconState
+	}
+	var candidates []candidate
+	if s.isFinalizedRoot(root) {
+		if fs := s.finalizedState(); fs != nil && !fs.IsNil() {
+			candidates = append(candidates, candidate{location: LocationFinalizedState, st: fs})
+		}
+	}
+	if slot == params.BeaconConfig().GenesisSlot {
+		gs, err := s.beaconDB.GenesisState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get genesis state")
+		}
+		if gs != nil && !gs.IsNil() {
+			candidates = append(candidates, candidate{location: "genesis", st: gs})
+		}
+	}
+	if s.beaconDB.HasState(ctx, root) {
+		st, err := s.beaconDB.State(ctx, root)
+		if err != nil {
+			log.WithError(err).WithField("root", fmt.Sprintf("%#x", root)).Warn("Could not read state from DB, skipping it")
+		} else if st != nil && !st.IsNil() {
+			candidates = append(candidates, candidate{location: LocationDB, st: st})
+		}
+	}
+	for _, c := range candidates {
+		if atSlot && c.st.Slot() != slot {
+			continue
+		}
+		match, err := StateMatchesBlock(ctx, c.st, root)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			return c.st.Copy(), nil
+		}
+		log.WithFields(logrus.Fields{
+			"root":     fmt.Sprintf("%#x", root),
+			"location": c.location,
+		}).Warn("State does not match its block, skipping it")
+	}
+	return nil, nil
+}
+
+// replaceState replaces the copies of the state kept in the caches, the finalized state and the DB with the
+// regenerated state, and records which were replaced and which of them were corrupted. The epoch boundary states and
+// the archived points are kept under the root of their latest block at a later slot, so that the regenerated state is
+// advanced to the slot of each copy before comparing and replacing it.
+func (s *State) replaceState(ctx context.Context, r *Regeneration, st state.BeaconState) error {
+	// Prevent the migration to the cold section from deleting states concurrently.
+	s.migrationLock.Lock()
+	defer s.migrationLock.Unlock()
+
+	advanced := map[primitives.Slot]state.BeaconState{st.Slot(): st}
+	// replacement returns the regenerated state at the slot of the old copy, and records whether the old copy was
+	// corrupted. A missing copy, or a copy before the slot of the block, is replaced by the state of the block.
+	replacement := func(location string, old state.BeaconState) (state.BeaconState, error) {
+		r.Replaced = append(r.Replaced, location)
+		if old == nil || old.IsNil() || old.Slot() < st.Slot() {
+			r.Corrupted = append(r.Corrupted, location)
+			return st, nil
+		}
+		want, ok := advanced[old.Slot()]
+		if !ok {
+			var err error
+			want, err = ReplayProcessSlots(ctx, st.Copy(), old.Slot())
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not advance the regenerated state to the slot of the state in %s", location)
+			}
+			advanced[old.Slot()] = want
+		}
+		wantRoot, err := want.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute root of the regenerated state")
+		}
+		oldRoot, err := old.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute root of the state in %s", location)
+		}
+		if oldRoot != wantRoot {
+			r.Corrupted = append(r.Corrupted, location)
+		}
+		return want, nil
+	}
+
+	if s.hotStateCache.has(r.BlockRoot) {
+		want, err := replacement(LocationHotStateCache, s.hotStateCache.getWithoutCopy(r.BlockRoot))
+		if err != nil {
+			return err
+		}
+		// The state is replaced in place, so that no other state is evicted.
+		s.hotStateCache.put(r.BlockRoot, want.Copy())
+	}
+
+	cached, ok, err := s.epochBoundaryStateCache.getByBlockRoot(r.BlockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get epoch boundary state")
+	}
+	if ok {
+		want, err := replacement(LocationEpochBoundaryStateCache, cached.state)
+		if err != nil {
+			return err
+		}
+		if err := s.epochBoundaryStateCache.delete(r.BlockRoot); err != nil {
+			return errors.Wrap(err, "could not delete epoch boundary state")
+		}
+		if err := s.epochBoundaryStateCache.put(r.BlockRoot, want.Copy()); err != nil {
+			return errors.Wrap(err, "could not save epoch boundary state")
+		}
+	}
+
+	if s.isFinalizedRoot(r.BlockRoot) {
+		s.finalizedInfo.lock.Lock()
+		want, err := replacement(LocationFinalizedState, s.finalizedInfo.state)
+		if err == nil {
+			s.finalizedInfo.state = want.Copy()
+		}
+		s.finalizedInfo.lock.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.beaconDB.HasState(ctx, r.BlockRoot) {
+		old, err := s.beaconDB.State(ctx, r.BlockRoot)
+		if err != nil {
+			old = nil
+		}
+		want, err := replacement(LocationDB, old)
+		if err != nil {
+			return err
+		}
+		if err := s.beaconDB.SaveState(ctx, want.Copy(), r.BlockRoot); err != nil {
+			return errors.Wrap(err, "could not save state to DB")
+		}
+	}
+	return nil
+}
+
+// StateMatchesBlock returns true if the latest block header of the state, completed with the root of the state when
+// the state is at the slot of the block, is the header of the given block root. For a state at the slot of the block,
+// this verifies the whole state against the state root of the block.
+func StateMatchesBlock(ctx context.Context, st state.BeaconState, blockRoot [32]byte) (bool, error) {
+	h := st.LatestBlockHeader()
+	if h == nil {
+		return false, nil
+	}
+	header := &ethpb.BeaconBlockHeader{
+		Slot:          h.Slot,
+		ProposerIndex: h.ProposerIndex,
+		ParentRoot:    bytesutil.SafeCopyBytes(h.ParentRoot),
+		StateRoot:     bytesutil.SafeCopyBytes(h.StateRoot),
+		BodyRoot:      bytesutil.SafeCopyBytes(h.BodyRoot),
+	}
+	if bytesutil.ToBytes32(header.StateRoot) == params.BeaconConfig().ZeroHash {
+		root, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "could not compute state root")
+		}
+		header.StateRoot = root[:]
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "could not compute block header root")
+	}
+	return root == blockRoot, nil
+}
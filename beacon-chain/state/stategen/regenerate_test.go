@@ -0,0 +1,150 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// regenerationChain saves a genesis block and its state, and n blocks on top of it, returning the roots of the
+// blocks and their post states.
+func regenerationChain(t *testing.T, ctx context.Context, beaconDB db.Database, n int) ([][32]byte, []state.BeaconState) {
+	st, keys := util.DeterministicGenesisState(t, 32)
+	stRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	gBlk := blocks.NewGenesisBlock(stRoot[:])
+	util.SaveBlock(t, ctx, beaconDB, gBlk)
+	gRoot, err := gBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	roots := [][32]byte{gRoot}
+	states := []state.BeaconState{st.Copy()}
+	for i := 1; i <= n; i++ {
+		blk, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), primitives.Slot(i))
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		st, err = executeStateTransitionStateGen(ctx, st, wsb)
+		require.NoError(t, err)
+		stRoot, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		blk.Block.StateRoot = stRoot[:]
+		util.SaveBlock(t, ctx, beaconDB, blk)
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, root)
+		states = append(states, st.Copy())
+	}
+	return roots, states
+}
+
+func TestRegenerateState_ReplacesCorruptedCopies(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+	roots, states := regenerationChain(t, ctx, beaconDB, 3)
+
+	// The hot state cache holds a corrupted state, the epoch boundary cache a valid one.
+	service.hotStateCache.put(roots[3], states[1].Copy())
+	require.NoError(t, service.epochBoundaryStateCache.put(roots[3], states[3]))
+
+	r, err := service.RegenerateState(ctx, roots[3])
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], r.BaseRoot)
+	assert.Equal(t, primitives.Slot(0), r.BaseSlot)
+	assert.Equal(t, primitives.Slot(3), r.Slot)
+	assert.Equal(t, 3, r.ReplayedBlocks)
+	assert.DeepEqual(t, []string{LocationHotStateCache, LocationEpochBoundaryStateCache}, r.Replaced)
+	assert.DeepEqual(t, []string{LocationHotStateCache}, r.Corrupted)
+
+	wantRoot, err := states[3].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, r.StateRoot)
+	cachedRoot, err := service.hotStateCache.get(roots[3]).HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, cachedRoot)
+}
+
+func TestRegenerateState_ReplacesAdvancedCopies(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+	roots, states := regenerationChain(t, ctx, beaconDB, 3)
+
+	// Like the epoch boundary states and the archived points, the epoch boundary cache and the DB hold the state of
+	// the block advanced to later slots.
+	advanced, err := ReplayProcessSlots(ctx, states[3].Copy(), 5)
+	require.NoError(t, err)
+	require.NoError(t, service.epochBoundaryStateCache.put(roots[3], advanced))
+	archived, err := ReplayProcessSlots(ctx, states[3].Copy(), 6)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, archived, roots[3]))
+
+	r, err := service.RegenerateState(ctx, roots[3])
+	require.NoError(t, err)
+	// The advanced DB state is not a base for the state of the block itself.
+	assert.Equal(t, roots[0], r.BaseRoot)
+	assert.Equal(t, 3, r.ReplayedBlocks)
+	assert.DeepEqual(t, []string{LocationEpochBoundaryStateCache, LocationDB}, r.Replaced)
+	assert.Equal(t, 0, len(r.Corrupted))
+
+	cached, ok, err := service.epochBoundaryStateCache.getBySlot(5)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, roots[3], cached.root)
+	assert.Equal(t, primitives.Slot(5), cached.state.Slot())
+	saved, err := beaconDB.State(ctx, roots[3])
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(6), saved.Slot())
+}
+
+func TestRegenerateState_SkipsCorruptedDBState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+	roots, states := regenerationChain(t, ctx, beaconDB, 3)
+
+	// The DB holds a valid state for the first block and a corrupted one for the second.
+	require.NoError(t, beaconDB.SaveState(ctx, states[1], roots[1]))
+	require.NoError(t, beaconDB.SaveState(ctx, states[1], roots[2]))
+
+	r, err := service.RegenerateState(ctx, roots[3])
+	require.NoError(t, err)
+	assert.Equal(t, roots[1], r.BaseRoot)
+	assert.Equal(t, 2, r.ReplayedBlocks)
+	assert.Equal(t, 0, len(r.Replaced))
+
+	r, err = service.RegenerateState(ctx, roots[2])
+	require.NoError(t, err)
+	assert.Equal(t, roots[1], r.BaseRoot)
+	assert.DeepEqual(t, []string{LocationDB}, r.Replaced)
+	assert.DeepEqual(t, []string{LocationDB}, r.Corrupted)
+
+	wantRoot, err := states[2].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	saved, err := beaconDB.State(ctx, roots[2])
+	require.NoError(t, err)
+	savedRoot, err := saved.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, savedRoot)
+}
+
+func TestRegenerateState_UnknownBlock(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t), doublylinkedtree.New())
+
+	_, err := service.RegenerateState(ctx, [32]byte{'a'})
+	require.ErrorIs(t, err, errUnknownBlock)
+}
@@ -125,9 +125,8 @@ func (s *State) saveStateByRoot(ctx context.Context, blockRoot [32]byte, st stat
 		return err
 	}
 
-	// Store the copied state in the hot state cache.
-	s.hotStateCache.put(blockRoot, st)
-
+	// Store the copied state in the hot state cache, spilling the state it evicts to the DB during long non-finality.
+	s.spillHotStates(ctx, s.hotStateCache.put(blockRoot, st))
 	return nil
 }
 
@@ -148,6 +147,55 @@ func (s *State) EnableSaveHotStateToDB(_ context.Context) {
 	}).Warn("Entering mode to save hot states in DB")
 }
 
+// CapHotStateCache caps the hot state cache to a few states while finality has stalled, as the hot states are
+// saved to the DB then, or restores its usual size. The evicted states are spilled to the DB.
+func (s *State) CapHotStateCache(ctx context.Context, capped bool) {
+	size := hotStateCacheSize
+	if capped {
+		size = safeModeHotStateCacheSize
+	}
+	evicted := s.hotStateCache.resize(size)
+	log.WithFields(logrus.Fields{
+		"size":    size,
+		"evicted": len(evicted),
+	}).Info("Resized hot state cache")
+	s.spillHotStates(ctx, evicted...)
+}
+
+// spillHotStates saves the hot states evicted from the hot state cache to the DB while in the mode that saves hot
+// states to the DB. It follows the slot interval of the mode, like saveStateByRoot, so that the states cached before
+// entering the mode are kept on disk during long non-finality without writing a full state on every eviction. Like
+// the other hot states saved to the DB, they are deleted once the chain finalizes again. A state which cannot be
+// spilled is only logged, as it can still be regenerated.
+func (s *State) spillHotStates(ctx context.Context, evicted ...*evictedHotState) {
+	if len(evicted) == 0 {
+		return
+	}
+	// Duration can't be 0 to prevent panic for division.
+	duration := uint64(math.Max(float64(s.saveHotStateDB.duration), 1))
+
+	s.saveHotStateDB.lock.Lock()
+	defer s.saveHotStateDB.lock.Unlock()
+	if !s.saveHotStateDB.enabled {
+		return
+	}
+	for _, e := range evicted {
+		if e == nil || e.state.Slot().Mod(duration) != 0 || s.beaconDB.HasState(ctx, e.root) {
+			continue
+		}
+		if err := s.beaconDB.SaveState(ctx, e.state, e.root); err != nil {
+			log.WithError(err).WithField("slot", e.state.Slot()).Error("Could not spill evicted hot state to DB")
+			continue
+		}
+		s.saveHotStateDB.blockRootsOfSavedStates = append(s.saveHotStateDB.blockRootsOfSavedStates, e.root)
+		hotStateSpilled.Inc()
+		log.WithFields(logrus.Fields{
+			"slot":                   e.state.Slot(),
+			"totalHotStateSavedInDB": len(s.saveHotStateDB.blockRootsOfSavedStates),
+		}).Debug("Spilled evicted hot state to DB")
+	}
+}
+
 // DisableSaveHotStateToDB exits the mode that saves beacon state to DB for the hot states.
 // This usually gets triggered once there's finality after long duration since finality.
 func (s *State) DisableSaveHotStateToDB(ctx context.Context) error {
@@ -183,6 +183,61 @@ func TestSaveState_CanSaveHotStateToDB(t *testing.T) {
 	require.Equal(t, true, beaconDB.HasState(ctx, r))
 }
 
+func TestSaveState_SpillsEvictedHotStateToDB(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+	service.hotStateCache.resize(1)
+	service.saveHotStateDB.duration = 2
+
+	save := func(r [32]byte, slot primitives.Slot) {
+		beaconState, _ := util.DeterministicGenesisState(t, 32)
+		require.NoError(t, beaconState.SetSlot(slot))
+		require.NoError(t, service.saveStateByRoot(ctx, r, beaconState))
+	}
+
+	// Evicted hot states are dropped while finalizing.
+	save([32]byte{'A'}, 1)
+	save([32]byte{'B'}, 2)
+	require.Equal(t, false, beaconDB.HasState(ctx, [32]byte{'A'}))
+
+	// They are spilled to the DB during long non-finality, following the slot interval of the mode, and deleted
+	// once finalizing again.
+	service.EnableSaveHotStateToDB(ctx)
+	save([32]byte{'C'}, 3)
+	require.Equal(t, true, beaconDB.HasState(ctx, [32]byte{'B'}))
+	save([32]byte{'D'}, 5)
+	require.Equal(t, false, beaconDB.HasState(ctx, [32]byte{'C'}))
+	require.DeepEqual(t, [][32]byte{{'B'}}, service.saveHotStateDB.blockRootsOfSavedStates)
+	st, err := service.loadStateByRoot(ctx, [32]byte{'B'})
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(2), st.Slot())
+
+	require.NoError(t, service.DisableSaveHotStateToDB(ctx))
+	require.Equal(t, false, beaconDB.HasState(ctx, [32]byte{'B'}))
+}
+
+func TestCapHotStateCache(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+	service.saveHotStateDB.duration = 1
+	for i := 0; i < hotStateCacheSize; i++ {
+		beaconState, _ := util.DeterministicGenesisState(t, 1)
+		require.NoError(t, beaconState.SetSlot(primitives.Slot(i+1)))
+		service.hotStateCache.put([32]byte{byte(i)}, beaconState)
+	}
+	service.EnableSaveHotStateToDB(ctx)
+
+	service.CapHotStateCache(ctx, true)
+	require.Equal(t, safeModeHotStateCacheSize, service.hotStateCache.cache.Len())
+	require.Equal(t, hotStateCacheSize-safeModeHotStateCacheSize, len(service.saveHotStateDB.blockRootsOfSavedStates))
+	require.Equal(t, true, beaconDB.HasState(ctx, [32]byte{0}))
+
+	service.CapHotStateCache(ctx, false)
+	require.Equal(t, hotStateCacheSize, service.hotStateCache.size)
+}
+
 func TestEnableSaveHotStateToDB_Enabled(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
@@ -79,7 +79,8 @@ func OptimizedValidatorRoots(validators []*ethpb.Validator) ([][32]byte, error)
 	n := runtime.GOMAXPROCS(0)
 	rootsSize := len(validators) * validatorFieldRoots
 	groupSize := len(validators) / n
-	roots := make([][32]byte, rootsSize)
+	roots := htr.GetBuffer(rootsSize)
+	defer htr.PutBuffer(roots)
 	wg.Add(n - 1)
 	for j := 0; j < n-1; j++ {
 		go hashValidatorHelper(validators, roots, j, groupSize, &wg)
@@ -87,6 +88,8 @@ func OptimizedValidatorRoots(validators []*ethpb.Validator) ([][32]byte, error)
 	for i := (n - 1) * groupSize; i < len(validators); i++ {
 		fRoots, err := ValidatorFieldRoots(validators[i])
 		if err != nil {
+			// Wait for the helpers before the buffer goes back to the pool.
+			wg.Wait()
 			return [][32]byte{}, errors.Wrap(err, "could not compute validators merkleization")
 		}
 		for k, root := range fRoots {
@@ -98,10 +101,17 @@ func OptimizedValidatorRoots(validators []*ethpb.Validator) ([][32]byte, error)
 	// A validator's tree can represented with a depth of 3. As log2(8) = 3
 	// Using this property we can lay out all the individual fields of a
 	// validator and hash them in single level using our vectorized routine.
-	for i := 0; i < validatorTreeDepth; i++ {
-		// Overwrite input lists as we are hashing by level
-		// and only need the highest level to proceed.
-		roots = htr.VectorizedSha256(roots)
+	// The intermediate levels are hashed back and forth between pooled
+	// buffers, only the highest level is allocated as it is returned.
+	scratch := htr.GetBuffer(rootsSize / 2)
+	defer htr.PutBuffer(scratch)
+	layer := roots
+	for i := 0; i < validatorTreeDepth-1; i++ {
+		if i%2 == 0 {
+			layer = htr.VectorizedSha256Into(scratch, layer)
+		} else {
+			layer = htr.VectorizedSha256Into(roots, layer)
+		}
 	}
-	return roots, nil
+	return htr.VectorizedSha256(layer), nil
 }
@@ -238,14 +238,10 @@ func recomputeRootFromLayerVariable(idx int, item [32]byte, layers [][]*[32]byte
 // AddInMixin describes a method from which a length mixin is added to the
 // provided root.
 func AddInMixin(root [32]byte, length uint64) ([32]byte, error) {
-	rootBuf := new(bytes.Buffer)
-	if err := binary.Write(rootBuf, binary.LittleEndian, length); err != nil {
-		return [32]byte{}, errors.Wrap(err, "could not marshal eth1data votes length")
-	}
 	// We need to mix in the length of the slice.
-	rootBufRoot := make([]byte, 32)
-	copy(rootBufRoot, rootBuf.Bytes())
-	return ssz.MixInLength(root, rootBufRoot), nil
+	var lengthRoot [32]byte
+	binary.LittleEndian.PutUint64(lengthRoot[:], length)
+	return ssz.MixInLength(root, lengthRoot[:]), nil
 }
 
 // Merkleize 32-byte leaves into a Merkle trie for its adequate depth, returning
@@ -2,10 +2,12 @@ package sync
 
 import (
 	"context"
+	"runtime"
 	"time"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
@@ -18,12 +20,40 @@ const verifierLimit = 50
 type signatureVerifier struct {
 	set     *bls.SignatureBatch
 	resChan chan error
+	// urgent sets are verified right away along with the pending ones,
+	// instead of waiting for the batch to fill up or for the next tick.
+	urgent bool
+}
+
+// verifierWorkers returns the number of routines verifying signature batches concurrently.
+func verifierWorkers() int {
+	return max(1, runtime.GOMAXPROCS(0)/2)
 }
 
 // A routine that runs in the background to perform batch
-// verifications of incoming messages from gossip.
+// verifications of incoming messages from gossip. Batches are
+// handed over to a pool of workers so that they are verified in parallel.
 func (s *Service) verifierRoutine() {
-	verifierBatch := make([]*signatureVerifier, 0)
+	batches := make(chan []*signatureVerifier, verifierWorkers())
+	for i := 0; i < cap(batches); i++ {
+		go s.verifierWorker(batches)
+	}
+
+	verifierBatch := make([]*signatureVerifier, 0, verifierLimit)
+	dispatch := func() {
+		if len(verifierBatch) == 0 {
+			return
+		}
+		select {
+		case batches <- verifierBatch:
+		case <-s.ctx.Done():
+			for i := 0; i < len(verifierBatch); i++ {
+				verifierBatch[i].resChan <- s.ctx.Err()
+			}
+		}
+		verifierBatch = make([]*signatureVerifier, 0, verifierLimit)
+	}
+
 	ticker := time.NewTicker(signatureVerificationInterval)
 	for {
 		select {
@@ -36,72 +66,122 @@ func (s *Service) verifierRoutine() {
 			return
 		case sig := <-s.signatureChan:
 			verifierBatch = append(verifierBatch, sig)
-			if len(verifierBatch) >= verifierLimit {
-				verifyBatch(verifierBatch)
-				verifierBatch = []*signatureVerifier{}
+			if sig.urgent || len(verifierBatch) >= verifierLimit {
+				dispatch()
 			}
 		case <-ticker.C:
-			if len(verifierBatch) > 0 {
-				verifyBatch(verifierBatch)
-				verifierBatch = []*signatureVerifier{}
-			}
+			dispatch()
+		}
+	}
+}
+
+func (s *Service) verifierWorker(batches <-chan []*signatureVerifier) {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case batch := <-batches:
+			signatureBatchSize.Observe(float64(len(batch)))
+			verifyBatch(batch)
 		}
 	}
 }
 
 func (s *Service) validateWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureBatch) (pubsub.ValidationResult, error) {
+	return s.verifyWithBatchVerifier(ctx, message, set, false)
+}
+
+// validateUrgentWithBatchVerifier is like validateWithBatchVerifier, but the set is verified without
+// waiting for the batch to fill up. It is meant for latency sensitive messages such as blocks.
+func (s *Service) validateUrgentWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureBatch) (pubsub.ValidationResult, error) {
+	return s.verifyWithBatchVerifier(ctx, message, set, true)
+}
+
+func (s *Service) verifyWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureBatch, urgent bool) (pubsub.ValidationResult, error) {
 	_, span := trace.StartSpan(ctx, "sync.validateWithBatchVerifier")
 	defer span.End()
 
-	resChan := make(chan error)
-	verificationSet := &signatureVerifier{set: set.Copy(), resChan: resChan}
-	s.signatureChan <- verificationSet
-
-	resErr := <-resChan
-	close(resChan)
-	// If verification fails we fallback to individual verification
-	// of each signature set.
-	if resErr != nil {
-		log.WithError(resErr).Tracef("Could not perform batch verification of %s", message)
-		verified, err := set.Verify()
-		if err != nil {
-			verErr := errors.Wrapf(err, "Could not verify %s", message)
-			tracing.AnnotateError(span, verErr)
-			return pubsub.ValidationReject, verErr
+	var resErr error
+	if s.signatureChan == nil {
+		// The batch verifier is not running, verify the set on its own.
+		resErr = verifySet(set)
+	} else {
+		// The result channel is buffered so that the verifier never blocks on a caller which gave up.
+		resChan := make(chan error, 1)
+		verificationSet := &signatureVerifier{set: set.Copy(), resChan: resChan, urgent: urgent}
+		select {
+		case s.signatureChan <- verificationSet:
+		case <-ctx.Done():
+			return pubsub.ValidationIgnore, ctx.Err()
 		}
-		if !verified {
-			verErr := errors.Errorf("Verification of %s failed", message)
-			tracing.AnnotateError(span, verErr)
-			return pubsub.ValidationReject, verErr
+		select {
+		case resErr = <-resChan:
+		case <-ctx.Done():
+			return pubsub.ValidationIgnore, ctx.Err()
+		case <-s.ctx.Done():
+			return pubsub.ValidationIgnore, s.ctx.Err()
 		}
 	}
+	if resErr != nil {
+		if errors.Is(resErr, context.Canceled) || errors.Is(resErr, context.DeadlineExceeded) {
+			return pubsub.ValidationIgnore, resErr
+		}
+		verErr := errors.Wrapf(resErr, "Could not verify %s", message)
+		tracing.AnnotateError(span, verErr)
+		return pubsub.ValidationReject, verErr
+	}
 	return pubsub.ValidationAccept, nil
 }
 
+// verifyBatch verifies all the sets of the batch together. If that fails, the batch is split in
+// two halves which are verified separately, until the invalid sets are isolated. Each verifier
+// receives the result of the verification of its own set.
 func verifyBatch(verifierBatch []*signatureVerifier) {
 	if len(verifierBatch) == 0 {
 		return
 	}
-	aggSet := verifierBatch[0].set
+	if len(verifierBatch) == 1 {
+		verifierBatch[0].resChan <- verifySet(verifierBatch[0].set)
+		return
+	}
 
-	for i := 1; i < len(verifierBatch); i++ {
+	// Join into a new set, as joining and aggregating modify the sets in place
+	// and the original ones are still needed if the batch has to be split.
+	aggSet := bls.NewSet()
+	for i := 0; i < len(verifierBatch); i++ {
 		aggSet = aggSet.Join(verifierBatch[i].set)
 	}
-	var verificationErr error
-
-	aggSet, verificationErr = performBatchAggregation(aggSet)
+	verificationErr := verifyAggregated(aggSet)
 	if verificationErr == nil {
-		verified, err := aggSet.Verify()
-		switch {
-		case err != nil:
-			verificationErr = err
-		case !verified:
-			verificationErr = errors.New("batch signature verification failed")
+		for i := 0; i < len(verifierBatch); i++ {
+			verifierBatch[i].resChan <- nil
 		}
+		return
 	}
-	for i := 0; i < len(verifierBatch); i++ {
-		verifierBatch[i].resChan <- verificationErr
+
+	signatureBatchSplits.Inc()
+	mid := len(verifierBatch) / 2
+	verifyBatch(verifierBatch[:mid])
+	verifyBatch(verifierBatch[mid:])
+}
+
+func verifyAggregated(aggSet *bls.SignatureBatch) error {
+	aggSet, err := performBatchAggregation(aggSet)
+	if err != nil {
+		return err
+	}
+	return verifySet(aggSet)
+}
+
+func verifySet(set *bls.SignatureBatch) error {
+	verified, err := set.Verify()
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return signing.ErrSigFailedToVerify
 	}
+	return nil
 }
 
 func performBatchAggregation(aggSet *bls.SignatureBatch) (*bls.SignatureBatch, error) {
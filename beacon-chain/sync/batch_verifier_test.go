@@ -3,11 +3,13 @@ package sync
 import (
 	"context"
 	"testing"
+	"time"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
@@ -85,3 +87,61 @@ func TestValidateWithBatchVerifier(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyBatch_SplitsOnFailure(t *testing.T) {
+	_, keys, err := util.DeterministicDepositsAndKeys(8)
+	assert.NoError(t, err)
+	newSet := func(i int, valid bool) *bls.SignatureBatch {
+		msg := [32]byte{byte(i)}
+		signer := keys[i]
+		if !valid {
+			signer = keys[(i+1)%len(keys)]
+		}
+		return &bls.SignatureBatch{
+			Messages:     [][32]byte{msg},
+			PublicKeys:   []bls.PublicKey{keys[i].PublicKey()},
+			Signatures:   [][]byte{signer.Sign(msg[:]).Marshal()},
+			Descriptions: []string{signing.UnknownSignature},
+		}
+	}
+
+	batch := make([]*signatureVerifier, len(keys))
+	for i := range batch {
+		batch[i] = &signatureVerifier{set: newSet(i, i != 2 && i != 5), resChan: make(chan error, 1)}
+	}
+	verifyBatch(batch)
+	for i, v := range batch {
+		err := <-v.resChan
+		if i == 2 || i == 5 {
+			require.ErrorIs(t, err, signing.ErrSigFailedToVerify)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestValidateUrgentWithBatchVerifier(t *testing.T) {
+	_, keys, err := util.DeterministicDepositsAndKeys(1)
+	assert.NoError(t, err)
+	set := &bls.SignatureBatch{
+		Messages:     [][32]byte{{}},
+		PublicKeys:   []bls.PublicKey{keys[0].PublicKey()},
+		Signatures:   [][]byte{keys[0].Sign(make([]byte, 32)).Marshal()},
+		Descriptions: []string{signing.UnknownSignature},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	svc := &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	go svc.verifierRoutine()
+
+	start := time.Now()
+	got, err := svc.validateUrgentWithBatchVerifier(ctx, "block", set)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, got)
+	// Urgent sets do not wait for the next tick.
+	assert.Equal(t, true, time.Since(start) < signatureVerificationInterval)
+}
@@ -27,7 +27,8 @@ func NewAPIInitializer(beaconNodeHost string) (*APIInitializer, error) {
 }
 
 // Initialize downloads origin state and block for checkpoint sync and initializes database records to
-// prepare the node to begin syncing from that point.
+// prepare the node to begin syncing from that point. The deposit snapshot of the remote beacon node is
+// also saved when it matches the origin state, so that the deposit logs do not need to be replayed.
 func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	origin, err := d.OriginCheckpointBlockRoot(ctx)
 	if err == nil && origin != params.BeaconConfig().ZeroHash {
@@ -41,5 +42,16 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
-	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
+	if err := d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes()); err != nil {
+		return err
+	}
+	snapshot, err := dl.c.GetDepositSnapshot(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not retrieve deposit snapshot, deposit logs will be replayed from the execution client")
+		return nil
+	}
+	if err := saveDepositSnapshot(ctx, d, snapshot); err != nil {
+		log.WithError(err).Warn("Could not use deposit snapshot, deposit logs will be replayed from the execution client")
+	}
+	return nil
 }
@@ -0,0 +1,78 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+var errDepositSnapshotMismatch = errors.New("deposit snapshot does not match the checkpoint state")
+
+// saveDepositSnapshot verifies the EIP-4881 deposit snapshot against the origin checkpoint state, and saves it as the
+// execution chain data of the node. The node then follows the deposit logs from the execution block of the snapshot,
+// rather than replaying all the deposit logs since the deployment of the deposit contract.
+func saveDepositSnapshot(ctx context.Context, d db.Database, snapshot *ethpb.DepositSnapshot) error {
+	root, err := d.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	st, err := d.State(ctx, root)
+	if err != nil {
+		return errors.Wrapf(err, "could not get origin checkpoint state for block root %#x", root)
+	}
+	if st == nil || st.IsNil() {
+		return errors.Errorf("origin checkpoint state for block root %#x not found", root)
+	}
+	if err := verifyDepositSnapshot(st, snapshot); err != nil {
+		return err
+	}
+	if err := d.SaveExecutionChainData(ctx, &ethpb.ETH1ChainData{
+		CurrentEth1Data: &ethpb.LatestETH1Data{
+			BlockHeight:        snapshot.ExecutionDepth,
+			BlockHash:          snapshot.ExecutionHash,
+			LastRequestedBlock: snapshot.ExecutionDepth,
+		},
+		ChainstartData: &ethpb.ChainStartData{
+			Chainstarted:       true,
+			GenesisTime:        st.GenesisTime(),
+			Eth1Data:           &ethpb.Eth1Data{},
+			ChainstartDeposits: make([]*ethpb.Deposit, 0),
+		},
+		DepositSnapshot: snapshot,
+	}); err != nil {
+		return errors.Wrap(err, "could not save deposit snapshot")
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount":   snapshot.DepositCount,
+		"executionBlock": snapshot.ExecutionDepth,
+	}).Info("Saved deposit snapshot, deposit logs will be processed from its execution block")
+	return nil
+}
+
+// verifyDepositSnapshot checks that the deposit snapshot holds all the deposits of the eth1 data of the state,
+// which have all been processed, so that the deposits to come follow the execution block of the snapshot.
+func verifyDepositSnapshot(st state.ReadOnlyBeaconState, snapshot *ethpb.DepositSnapshot) error {
+	if _, err := depositsnapshot.DepositTreeFromSnapshotProto(snapshot); err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+	eth1Data := st.Eth1Data()
+	if snapshot.DepositCount != st.Eth1DepositIndex() || snapshot.DepositCount != eth1Data.DepositCount {
+		return errors.Wrapf(errDepositSnapshotMismatch, "snapshot deposit count %d, state eth1 deposit index %d, eth1 data deposit count %d",
+			snapshot.DepositCount, st.Eth1DepositIndex(), eth1Data.DepositCount)
+	}
+	if !bytes.Equal(snapshot.DepositRoot, eth1Data.DepositRoot) {
+		return errors.Wrapf(errDepositSnapshotMismatch, "snapshot deposit root %#x, state eth1 data deposit root %#x",
+			snapshot.DepositRoot, eth1Data.DepositRoot)
+	}
+	if !bytes.Equal(snapshot.ExecutionHash, eth1Data.BlockHash) {
+		return errors.Wrapf(errDepositSnapshotMismatch, "snapshot execution block hash %#x, state eth1 data block hash %#x",
+			snapshot.ExecutionHash, eth1Data.BlockHash)
+	}
+	return nil
+}
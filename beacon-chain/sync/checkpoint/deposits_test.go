@@ -0,0 +1,76 @@
+package checkpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// testDepositSnapshot returns a snapshot of the deposits, and a state having processed them.
+func testDepositSnapshot(t *testing.T, count int) (*ethpb.DepositSnapshot, state.BeaconState) {
+	tree := depositsnapshot.NewDepositTree()
+	for i := 0; i < count; i++ {
+		require.NoError(t, tree.Insert([]byte{byte(i), 31: 1}, i))
+	}
+	blockHash := [32]byte{'a'}
+	require.NoError(t, tree.Finalize(int64(count-1), blockHash, 100))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+	st, err := util.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.GenesisTime = 1000
+		s.Eth1Data = &ethpb.Eth1Data{DepositRoot: snapshot.DepositRoot, DepositCount: uint64(count), BlockHash: blockHash[:]}
+		s.Eth1DepositIndex = uint64(count)
+		return nil
+	})
+	require.NoError(t, err)
+	return snapshot, st
+}
+
+func TestVerifyDepositSnapshot(t *testing.T) {
+	snapshot, st := testDepositSnapshot(t, 4)
+	require.NoError(t, verifyDepositSnapshot(st, snapshot))
+
+	t.Run("pending deposits", func(t *testing.T) {
+		_, st := testDepositSnapshot(t, 4)
+		require.NoError(t, st.SetEth1DepositIndex(3))
+		require.ErrorIs(t, verifyDepositSnapshot(st, snapshot), errDepositSnapshotMismatch)
+	})
+	t.Run("other deposits", func(t *testing.T) {
+		other, _ := testDepositSnapshot(t, 5)
+		other.DepositCount = 4
+		require.ErrorContains(t, "invalid deposit snapshot", verifyDepositSnapshot(st, other))
+	})
+	t.Run("other execution block", func(t *testing.T) {
+		other, _ := testDepositSnapshot(t, 4)
+		other.ExecutionHash = make([]byte, 32)
+		require.ErrorIs(t, verifyDepositSnapshot(st, other), errDepositSnapshotMismatch)
+	})
+}
+
+func TestSaveDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	d, err := kv.NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, d.Close())
+	})
+	snapshot, st := testDepositSnapshot(t, 4)
+	root := [32]byte{'r'}
+	require.NoError(t, d.SaveState(ctx, st, root))
+	require.NoError(t, d.SaveOriginCheckpointBlockRoot(ctx, root))
+
+	require.NoError(t, saveDepositSnapshot(ctx, d, snapshot))
+	data, err := d.ExecutionChainData(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot, data.DepositSnapshot)
+	assert.Equal(t, uint64(100), data.CurrentEth1Data.LastRequestedBlock)
+	assert.Equal(t, true, data.ChainstartData.Chainstarted)
+	assert.Equal(t, uint64(1000), data.ChainstartData.GenesisTime)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 // Initializer describes a type that is able to obtain the checkpoint sync data (BeaconState and SignedBeaconBlock)
@@ -19,8 +20,9 @@ type Initializer interface {
 }
 
 // NewFileInitializer validates the given path information and creates an Initializer which will
-// use the provided state and block files to prepare the node for checkpoint sync.
-func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, error) {
+// use the provided state and block files to prepare the node for checkpoint sync. The deposit snapshot
+// file is optional, and left empty when the deposit logs are to be replayed from the execution client.
+func NewFileInitializer(blockPath string, statePath string, depositSnapshotPath string) (*FileInitializer, error) {
 	var err error
 	if err = existsAndIsFile(blockPath); err != nil {
 		return nil, err
@@ -28,15 +30,21 @@ func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, e
 	if err = existsAndIsFile(statePath); err != nil {
 		return nil, err
 	}
+	if depositSnapshotPath != "" {
+		if err = existsAndIsFile(depositSnapshotPath); err != nil {
+			return nil, err
+		}
+	}
 	// stat just to make sure it actually exists and is a file
-	return &FileInitializer{blockPath: blockPath, statePath: statePath}, nil
+	return &FileInitializer{blockPath: blockPath, statePath: statePath, depositSnapshotPath: depositSnapshotPath}, nil
 }
 
 // FileInitializer initializes a beacon-node database to use checkpoint sync,
-// using ssz-encoded block and state data stored in files on the local filesystem.
+// using ssz-encoded block, state and deposit snapshot data stored in files on the local filesystem.
 type FileInitializer struct {
-	blockPath string
-	statePath string
+	blockPath           string
+	statePath           string
+	depositSnapshotPath string
 }
 
 // Initialize is called in the BeaconNode db startup code if an Initializer is present.
@@ -59,7 +67,27 @@ func (fi *FileInitializer) Initialize(ctx context.Context, d db.Database) error
 	if err != nil {
 		return errors.Wrapf(err, "error reading state file %s for checkpoint sync init", fi.blockPath)
 	}
-	return d.SaveOrigin(ctx, serState, serBlock)
+	var snapshot *ethpb.DepositSnapshot
+	if fi.depositSnapshotPath != "" {
+		serSnapshot, err := file.ReadFileAsBytes(fi.depositSnapshotPath)
+		if err != nil {
+			return errors.Wrapf(err, "error reading deposit snapshot file %s for checkpoint sync init", fi.depositSnapshotPath)
+		}
+		snapshot = &ethpb.DepositSnapshot{}
+		if err := snapshot.UnmarshalSSZ(serSnapshot); err != nil {
+			return errors.Wrapf(err, "error unmarshaling deposit snapshot file %s for checkpoint sync init", fi.depositSnapshotPath)
+		}
+	}
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return nil
+	}
+	if err := saveDepositSnapshot(ctx, d, snapshot); err != nil {
+		log.WithError(err).Warn("Could not use deposit snapshot, deposit logs will be replayed from the execution client")
+	}
+	return nil
 }
 
 var _ Initializer = &FileInitializer{}
@@ -0,0 +1,212 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// EquivocationPolicy defines what the node does with the proposer equivocations it detects on gossip.
+type EquivocationPolicy string
+
+const (
+	// EquivocationPolicyBroadcast submits the proposer slashing of the equivocation to the operations pool and
+	// broadcasts it. This is the default.
+	EquivocationPolicyBroadcast EquivocationPolicy = "broadcast"
+	// EquivocationPolicyPool only submits the proposer slashing to the operations pool, so that it is included in
+	// the blocks proposed by the node.
+	EquivocationPolicyPool EquivocationPolicy = "pool"
+	// EquivocationPolicyLogOnly only logs the equivocation.
+	EquivocationPolicyLogOnly EquivocationPolicy = "log-only"
+)
+
+// ParseEquivocationPolicy returns the equivocation policy with the given name. An empty name is the default policy.
+func ParseEquivocationPolicy(name string) (EquivocationPolicy, error) {
+	switch p := EquivocationPolicy(name); p {
+	case "":
+		return EquivocationPolicyBroadcast, nil
+	case EquivocationPolicyBroadcast, EquivocationPolicyPool, EquivocationPolicyLogOnly:
+		return p, nil
+	default:
+		return "", errors.Errorf("unknown equivocation policy %q, must be one of %s, %s or %s",
+			name, EquivocationPolicyBroadcast, EquivocationPolicyPool, EquivocationPolicyLogOnly)
+	}
+}
+
+// recordBlockHeader keeps the signed header of the first block processed for the proposer and the slot, so that a
+// different block of the same proposer for the same slot can be turned into a proposer slashing.
+func (s *Service) recordBlockHeader(blk interfaces.ReadOnlySignedBeaconBlock) {
+	if s.seenBlockHeaderCache == nil {
+		return
+	}
+	header, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
+	if err != nil {
+		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not extract block header")
+		return
+	}
+	s.seenBlockHeaderLock.Lock()
+	defer s.seenBlockHeaderLock.Unlock()
+	s.seenBlockHeaderCache.ContainsOrAdd(blockHeaderKey(header.Header), header)
+}
+
+const (
+	// equivocationWorkers is the number of workers verifying and handling the equivocations detected on gossip.
+	equivocationWorkers = 2
+	// equivocationQueueSize bounds the number of equivocations waiting for a worker. The equivocations detected
+	// while the queue is full are dropped, the slasher still detects them if enabled.
+	equivocationQueueSize = 16
+	// seenEquivocationRootSize bounds the number of block roots remembered as already checked for an equivocation.
+	seenEquivocationRootSize = 1000
+)
+
+// ProposerSlashingSubmitter applies the slashing policy of the slasher to a proposer slashing. It reports whether
+// the slashing was inserted into the operations pool.
+type ProposerSlashingSubmitter interface {
+	SubmitProposerSlashing(ctx context.Context, st state.ReadOnlyBeaconState, slashing *ethpb.ProposerSlashing) bool
+}
+
+// checkEquivocation is called for a gossip block of a proposer and a slot for which a block was already processed.
+// If the blocks are different and the proposer signature of the block is valid, the proposer equivocated: the
+// proposer slashing is built right away, without waiting for the slasher, and returned. Each block root is only
+// checked once.
+func (s *Service) checkEquivocation(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock) *ethpb.ProposerSlashing {
+	if s.seenBlockHeaderCache == nil {
+		return nil
+	}
+	header, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
+	if err != nil {
+		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not extract block header")
+		return nil
+	}
+	if s.hasSeenProposerSlashingIndex(header.Header.ProposerIndex) {
+		return nil
+	}
+	root, err := header.Header.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute block header root")
+		return nil
+	}
+	s.seenEquivocationRootLock.Lock()
+	seen, _ := s.seenEquivocationRootCache.ContainsOrAdd(root, true)
+	s.seenEquivocationRootLock.Unlock()
+	if seen {
+		return nil
+	}
+	s.seenBlockHeaderLock.Lock()
+	v, ok := s.seenBlockHeaderCache.Get(blockHeaderKey(header.Header))
+	s.seenBlockHeaderLock.Unlock()
+	if !ok {
+		return nil
+	}
+	first, ok := v.(*ethpb.SignedBeaconBlockHeader)
+	if !ok {
+		log.Errorf("Invalid type retrieved from the cache: %T", v)
+		return nil
+	}
+	firstRoot, err := first.Header.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute block header root")
+		return nil
+	}
+	if firstRoot == root {
+		return nil
+	}
+	// The block did not go through the gossip validation, so that its signature is not verified yet.
+	headState, err := s.cfg.chain.HeadStateReadOnly(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get head state")
+		return nil
+	}
+	if err := blocks.VerifyBlockSignatureUsingCurrentFork(headState, blk, root); err != nil {
+		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not verify the signature of an equivocating block")
+		return nil
+	}
+	return &ethpb.ProposerSlashing{Header_1: first, Header_2: header}
+}
+
+// queueEquivocation hands the proposer slashing of an equivocation to the equivocation workers, or drops it when
+// too many equivocations are waiting already.
+func (s *Service) queueEquivocation(slashing *ethpb.ProposerSlashing) {
+	select {
+	case s.equivocations <- slashing:
+	default:
+		log.WithFields(logrus.Fields{
+			"slot":          slashing.Header_1.Header.Slot,
+			"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+		}).Debug("Too many proposer equivocations waiting to be handled, dropped one")
+	}
+}
+
+// handleEquivocations runs the equivocation workers until the service stops.
+func (s *Service) handleEquivocations() {
+	for i := 0; i < equivocationWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-s.ctx.Done():
+					return
+				case slashing := <-s.equivocations:
+					if err := s.handleEquivocation(s.ctx, slashing); err != nil {
+						log.WithError(err).WithFields(logrus.Fields{
+							"slot":          slashing.Header_1.Header.Slot,
+							"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+						}).Debug("Could not handle proposer equivocation")
+					}
+				}
+			}
+		}()
+	}
+}
+
+// handleEquivocation verifies the proposer slashing built from an equivocation, and submits it to the operations
+// pool and broadcasts it as allowed by the equivocation policy. When the slasher is enabled, the slashing goes
+// through its slashing policy, so that it is only submitted and broadcast once approved by the slasher.
+func (s *Service) handleEquivocation(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	headState, err := s.cfg.chain.HeadStateReadOnly(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if err := blocks.VerifyProposerSlashing(headState, slashing); err != nil {
+		return errors.Wrap(err, "could not verify proposer slashing")
+	}
+	s.setProposerSlashingIndexSeen(slashing.Header_1.Header.ProposerIndex)
+	gossipEquivocationsCounter.Inc()
+
+	policy := s.cfg.equivocationPolicy
+	log.WithFields(logrus.Fields{
+		"slot":          slashing.Header_1.Header.Slot,
+		"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+		"policy":        policy,
+	}).Warn("Detected proposer equivocation on gossip")
+	if policy == EquivocationPolicyLogOnly {
+		return nil
+	}
+
+	if s.cfg.slashingSubmitter != nil {
+		if !s.cfg.slashingSubmitter.SubmitProposerSlashing(ctx, headState, slashing) {
+			return nil
+		}
+	} else if err := s.cfg.slashingPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+		return errors.Wrap(err, "could not insert proposer slashing into pool")
+	}
+	gossipEquivocationSlashingsCounter.WithLabelValues("pool").Inc()
+	if policy == EquivocationPolicyPool || features.Get().DisableBroadcastSlashings {
+		return nil
+	}
+	if err := s.cfg.p2p.Broadcast(ctx, slashing); err != nil {
+		return errors.Wrap(err, "could not broadcast proposer slashing")
+	}
+	gossipEquivocationSlashingsCounter.WithLabelValues("broadcast").Inc()
+	return nil
+}
+
+func blockHeaderKey(header *ethpb.BeaconBlockHeader) string {
+	return string(append(bytesutil.Bytes32(uint64(header.Slot)), bytesutil.Bytes32(uint64(header.ProposerIndex))...))
+}
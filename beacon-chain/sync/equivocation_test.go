@@ -0,0 +1,192 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func setupEquivocatingBlocks(t *testing.T) (interfaces.ReadOnlySignedBeaconBlock, interfaces.ReadOnlySignedBeaconBlock, state.BeaconState) {
+	_, st := setupValidProposerSlashing(t)
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	val, err := st.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	val.PublicKey = privKey.PublicKey().Marshal()
+	require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+
+	signed := make([]interfaces.ReadOnlySignedBeaconBlock, 2)
+	for i := range signed {
+		b := util.NewBeaconBlock()
+		b.Block.ProposerIndex = 1
+		b.Block.ParentRoot = bytesutil.PadTo([]byte{byte(i + 1)}, 32)
+		b.Signature, err = signing.ComputeDomainAndSign(st, coreTime.CurrentEpoch(st), b.Block, params.BeaconConfig().DomainBeaconProposer, privKey)
+		require.NoError(t, err)
+		signed[i], err = blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+	}
+	return signed[0], signed[1], st
+}
+
+func newEquivocationTestService(t *testing.T, st state.BeaconState, policy EquivocationPolicy) (*Service, *p2ptest.TestP2P) {
+	p := p2ptest.NewTestP2P(t)
+	return &Service{
+		cfg: &config{
+			p2p:                p,
+			chain:              &mock.ChainService{State: st},
+			slashingPool:       slashings.NewPool(),
+			equivocationPolicy: policy,
+		},
+		seenBlockHeaderCache:      lruwrpr.New(10),
+		seenProposerSlashingCache: lruwrpr.New(10),
+		seenEquivocationRootCache: lruwrpr.New(10),
+	}, p
+}
+
+// checkAndHandleEquivocation handles the equivocation of the block, if any, synchronously.
+func checkAndHandleEquivocation(t *testing.T, s *Service, blk interfaces.ReadOnlySignedBeaconBlock) {
+	if slashing := s.checkEquivocation(context.Background(), blk); slashing != nil {
+		require.NoError(t, s.handleEquivocation(context.Background(), slashing))
+	}
+}
+
+type mockSlashingSubmitter struct {
+	submitted []*ethpb.ProposerSlashing
+	insert    bool
+}
+
+func (m *mockSlashingSubmitter) SubmitProposerSlashing(_ context.Context, _ state.ReadOnlyBeaconState, slashing *ethpb.ProposerSlashing) bool {
+	m.submitted = append(m.submitted, slashing)
+	return m.insert
+}
+
+func TestCheckEquivocation_Broadcast(t *testing.T) {
+	first, second, st := setupEquivocatingBlocks(t)
+	s, p := newEquivocationTestService(t, st, EquivocationPolicyBroadcast)
+	ctx := context.Background()
+
+	s.recordBlockHeader(first)
+	// The same block again is not an equivocation.
+	assert.Equal(t, true, s.checkEquivocation(ctx, first) == nil)
+
+	checkAndHandleEquivocation(t, s, second)
+	pending := s.cfg.slashingPool.PendingProposerSlashings(ctx, st, true)
+	require.Equal(t, 1, len(pending))
+	header1, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(first)
+	require.NoError(t, err)
+	assert.DeepEqual(t, header1, pending[0].Header_1)
+	assert.Equal(t, true, p.BroadcastCalled.Load())
+	assert.Equal(t, true, s.hasSeenProposerSlashingIndex(1))
+}
+
+func TestCheckEquivocation_Policies(t *testing.T) {
+	ctx := context.Background()
+	for _, policy := range []EquivocationPolicy{EquivocationPolicyPool, EquivocationPolicyLogOnly} {
+		t.Run(string(policy), func(t *testing.T) {
+			first, second, st := setupEquivocatingBlocks(t)
+			s, p := newEquivocationTestService(t, st, policy)
+			s.recordBlockHeader(first)
+			checkAndHandleEquivocation(t, s, second)
+
+			want := 1
+			if policy == EquivocationPolicyLogOnly {
+				want = 0
+			}
+			assert.Equal(t, want, len(s.cfg.slashingPool.PendingProposerSlashings(ctx, st, true)))
+			assert.Equal(t, false, p.BroadcastCalled.Load())
+			assert.Equal(t, true, s.hasSeenProposerSlashingIndex(1))
+		})
+	}
+}
+
+func TestCheckEquivocation_InvalidSignature(t *testing.T) {
+	first, second, st := setupEquivocatingBlocks(t)
+	s, p := newEquivocationTestService(t, st, EquivocationPolicyBroadcast)
+	ctx := context.Background()
+
+	s.recordBlockHeader(first)
+	b := util.NewBeaconBlock()
+	b.Block.ProposerIndex = 1
+	unsigned, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	assert.Equal(t, true, s.checkEquivocation(ctx, unsigned) == nil)
+	assert.Equal(t, 0, len(s.cfg.slashingPool.PendingProposerSlashings(ctx, st, true)))
+	assert.Equal(t, false, p.BroadcastCalled.Load())
+	assert.Equal(t, false, s.hasSeenProposerSlashingIndex(1))
+
+	// A block without a known first block is not an equivocation.
+	s.seenBlockHeaderCache.Purge()
+	assert.Equal(t, true, s.checkEquivocation(ctx, second) == nil)
+}
+
+func TestCheckEquivocation_CheckedOnce(t *testing.T) {
+	first, second, st := setupEquivocatingBlocks(t)
+	s, _ := newEquivocationTestService(t, st, EquivocationPolicyBroadcast)
+	ctx := context.Background()
+
+	s.recordBlockHeader(first)
+	require.NotNil(t, s.checkEquivocation(ctx, second))
+	// The same block root is not checked again, even if the equivocation was not handled.
+	assert.Equal(t, true, s.checkEquivocation(ctx, second) == nil)
+}
+
+func TestQueueEquivocation_Bounded(t *testing.T) {
+	first, second, st := setupEquivocatingBlocks(t)
+	s, _ := newEquivocationTestService(t, st, EquivocationPolicyBroadcast)
+	s.equivocations = make(chan *ethpb.ProposerSlashing, 1)
+
+	s.recordBlockHeader(first)
+	slashing := s.checkEquivocation(context.Background(), second)
+	require.NotNil(t, slashing)
+	s.queueEquivocation(slashing)
+	// The queue is full, the equivocation is dropped instead of blocking.
+	s.queueEquivocation(slashing)
+	assert.Equal(t, 1, len(s.equivocations))
+}
+
+func TestCheckEquivocation_SlasherPolicy(t *testing.T) {
+	ctx := context.Background()
+	for _, insert := range []bool{false, true} {
+		t.Run(fmt.Sprintf("inserted=%v", insert), func(t *testing.T) {
+			first, second, st := setupEquivocatingBlocks(t)
+			s, p := newEquivocationTestService(t, st, EquivocationPolicyBroadcast)
+			submitter := &mockSlashingSubmitter{insert: insert}
+			s.cfg.slashingSubmitter = submitter
+			s.recordBlockHeader(first)
+			checkAndHandleEquivocation(t, s, second)
+
+			// The slashing goes through the slasher instead of the pool, and is only broadcast once inserted.
+			assert.Equal(t, 1, len(submitter.submitted))
+			assert.Equal(t, 0, len(s.cfg.slashingPool.PendingProposerSlashings(ctx, st, true)))
+			assert.Equal(t, insert, p.BroadcastCalled.Load())
+		})
+	}
+}
+
+func TestParseEquivocationPolicy(t *testing.T) {
+	p, err := ParseEquivocationPolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, EquivocationPolicyBroadcast, p)
+	p, err = ParseEquivocationPolicy("log-only")
+	require.NoError(t, err)
+	assert.Equal(t, EquivocationPolicyLogOnly, p)
+	_, err = ParseEquivocationPolicy("slash")
+	assert.ErrorContains(t, "unknown equivocation policy", err)
+}
@@ -1,7 +1,6 @@
 package sync
 
 import (
-	"bytes"
 	"errors"
 	"io"
 
@@ -23,8 +22,8 @@ var responseCodeInvalidRequest = byte(0x01)
 var responseCodeServerError = byte(0x02)
 var responseCodeResourceUnavailable = byte(0x03)
 
-func (s *Service) generateErrorResponse(code byte, reason string) ([]byte, error) {
-	return createErrorResponse(code, reason, s.cfg.p2p)
+func (s *Service) generateErrorResponse(stream network.Stream, code byte, reason string) ([]byte, error) {
+	return createErrorResponse(stream, code, reason, s.cfg.p2p)
 }
 
 // ReadStatusCode response from a RPC stream.
@@ -55,7 +54,7 @@ func ReadStatusCode(stream network.Stream, encoding encoder.NetworkEncoding) (ui
 }
 
 func writeErrorResponseToStream(responseCode byte, reason string, stream libp2pcore.Stream, encoder p2p.EncodingProvider) {
-	resp, err := createErrorResponse(responseCode, reason, encoder)
+	resp, err := createErrorResponse(stream, responseCode, reason, encoder)
 	if err != nil {
 		log.WithError(err).Debug("Could not generate a response error")
 	} else if _, err := stream.Write(resp); err != nil {
@@ -66,10 +65,14 @@ func writeErrorResponseToStream(responseCode byte, reason string, stream libp2pc
 	}
 }
 
-func createErrorResponse(code byte, reason string, encoder p2p.EncodingProvider) ([]byte, error) {
-	buf := bytes.NewBuffer([]byte{code})
+// createErrorResponse encodes the error response for the stream, so that it is compressed as negotiated for it.
+func createErrorResponse(stream network.Stream, code byte, reason string, provider p2p.EncodingProvider) ([]byte, error) {
+	buf := &encoder.BufferedStream{Stream: stream}
+	if err := buf.WriteByte(code); err != nil {
+		return nil, err
+	}
 	errMsg := types.ErrorMessage(reason)
-	if _, err := encoder.Encoding().EncodeWithMaxLength(buf, &errMsg); err != nil {
+	if _, err := provider.Encoding().EncodeWithMaxLength(buf, &errMsg); err != nil {
 		return nil, err
 	}
 
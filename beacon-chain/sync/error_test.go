@@ -14,7 +14,7 @@ func TestRegularSync_generateErrorResponse(t *testing.T) {
 	r := &Service{
 		cfg: &config{p2p: p2ptest.NewTestP2P(t)},
 	}
-	data, err := r.generateErrorResponse(responseCodeServerError, "something bad happened")
+	data, err := r.generateErrorResponse(nil, responseCodeServerError, "something bad happened")
 	require.NoError(t, err)
 
 	buf := bytes.NewBuffer(data)
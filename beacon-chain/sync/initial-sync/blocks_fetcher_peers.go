@@ -100,6 +100,16 @@ func (f *blocksFetcher) filterPeers(ctx context.Context, peers []peer.ID, peersP
 		return peers
 	}
 
+	// Skip the peers banned for serving invalid data.
+	invalidDataScorer := f.p2p.Peers().Scorers().InvalidDataScorer()
+	allowed := make([]peer.ID, 0, len(peers))
+	for _, pid := range peers {
+		if invalidDataScorer.IsBadPeer(pid) == nil {
+			allowed = append(allowed, pid)
+		}
+	}
+	peers = allowed
+
 	// Sort peers using both block provider score and, custom, capacity based score (see
 	// peerFilterCapacityWeight if you want to give different weights to provider's and capacity
 	// scores).
@@ -0,0 +1,37 @@
+package initialsync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	beaconsync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/sirupsen/logrus"
+)
+
+// isInvalidData returns true when the error is caused by blocks or blobs served by a peer failing verification,
+// as opposed to a failure of the node itself.
+func isInvalidData(err error) bool {
+	return blockchain.IsInvalidBlock(err) ||
+		errors.Is(err, verification.ErrBlobInvalid) ||
+		errors.Is(err, verification.ErrBatchSignatureMismatch) ||
+		errors.Is(err, verification.ErrBatchBlockRootMismatch) ||
+		errors.Is(err, beaconsync.ErrInvalidFetchedData)
+}
+
+// banPeer temporarily bans the peer which served invalid data, so that sync does not keep on requesting batches
+// from it. The ban of repeat offenders is longer each time.
+func banPeer(p p2p.P2P, pid peer.ID, err error) {
+	if pid == "" {
+		return
+	}
+	duration, persistErr := p.Peers().Scorers().InvalidDataScorer().Ban(pid)
+	if persistErr != nil {
+		log.WithError(persistErr).Error("Could not persist offense records of peers serving invalid data")
+	}
+	log.WithError(err).WithFields(logrus.Fields{
+		"pid":      pid,
+		"duration": duration,
+	}).Warn("Banned peer for serving invalid data")
+}
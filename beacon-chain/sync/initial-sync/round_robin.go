@@ -156,6 +156,9 @@ func (s *Service) processFetchedData(
 	// Use Batch Block Verify to process and verify batches directly.
 	if err := s.processBatchedBlocks(ctx, genesis, data.bwb, s.cfg.Chain.ReceiveBlockBatch); err != nil {
 		log.WithError(err).Warn("Skip processing batched blocks")
+		if isInvalidData(err) {
+			banPeer(s.cfg.P2P, data.pid, err)
+		}
 	}
 }
 
@@ -181,6 +184,9 @@ func (s *Service) processFetchedDataRegSync(
 	for _, b := range bwb {
 		if err := avs.Persist(s.clock.CurrentSlot(), b.Blobs...); err != nil {
 			log.WithError(err).WithFields(batchFields).WithFields(syncFields(b.Block)).Warn("Batch failure due to BlobSidecar issues")
+			if isInvalidData(err) {
+				banPeer(s.cfg.P2P, data.pid, err)
+			}
 			return
 		}
 		if err := s.processBlock(ctx, genesis, b, s.cfg.Chain.ReceiveBlock, avs); err != nil {
@@ -191,6 +197,9 @@ func (s *Service) processFetchedDataRegSync(
 				return
 			default:
 				log.WithError(err).WithFields(batchFields).WithFields(syncFields(b.Block)).Warn("Block processing failure")
+				if isInvalidData(err) {
+					banPeer(s.cfg.P2P, data.pid, err)
+				}
 				return
 			}
 		}
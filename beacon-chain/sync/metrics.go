@@ -75,6 +75,19 @@ var (
 			Buckets: []float64{10, 50, 100, 200, 400, 800, 1600, 3200},
 		},
 	)
+	signatureBatchSize = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "gossip_signature_batch_size",
+			Help:    "Number of gossip messages whose signatures are verified together in a batch.",
+			Buckets: []float64{1, 2, 5, 10, 20, 30, 40, 50},
+		},
+	)
+	signatureBatchSplits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gossip_signature_batch_splits_total",
+			Help: "Count the number of times a signature batch failed verification and was split in two.",
+		},
+	)
 	rpcBlocksByRangeResponseLatency = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "rpc_blocks_by_range_response_latency_milliseconds",
@@ -184,6 +197,21 @@ var (
 			Help: "Count the number of times blobs have been found in the database.",
 		},
 	)
+
+	gossipEquivocationsCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gossip_block_equivocations_total",
+			Help: "The number of proposer equivocations detected on gossip, with a valid proposer slashing.",
+		},
+	)
+
+	gossipEquivocationSlashingsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gossip_equivocation_slashings_total",
+			Help: "The number of proposer slashings built from gossip equivocations which were submitted to the pool or broadcast.",
+		},
+		[]string{"action"},
+	)
 )
 
 func (s *Service) updateMetrics() {
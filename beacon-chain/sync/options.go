@@ -2,6 +2,8 @@ package sync
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/clockskew"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -180,3 +182,37 @@ func WithAvailableBlocker(avb coverage.AvailableBlocker) Option {
 		return nil
 	}
 }
+
+// WithBadBlockCache sets the cache of blocks deemed invalid, shared with the API so entries can be inspected
+// and edited at runtime.
+func WithBadBlockCache(c *cache.BadBlockCache) Option {
+	return func(s *Service) error {
+		s.badBlockCache = c
+		return nil
+	}
+}
+
+// WithClockSkewRecorder sets the recorder of the gossip block arrivals the skew of the local clock is estimated from.
+func WithClockSkewRecorder(r clockskew.ArrivalRecorder) Option {
+	return func(s *Service) error {
+		s.cfg.clockSkewRecorder = r
+		return nil
+	}
+}
+
+// WithEquivocationPolicy sets what the sync service does with the proposer equivocations it detects on gossip.
+func WithEquivocationPolicy(policy EquivocationPolicy) Option {
+	return func(s *Service) error {
+		s.cfg.equivocationPolicy = policy
+		return nil
+	}
+}
+
+// WithSlashingSubmitter sets the slasher the proposer slashings of the equivocations detected on gossip are submitted
+// through, so that they follow its slashing policy.
+func WithSlashingSubmitter(submitter ProposerSlashingSubmitter) Option {
+	return func(s *Service) error {
+		s.cfg.slashingSubmitter = submitter
+		return nil
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -225,6 +226,7 @@ func (s *Service) processAndBroadcastBlock(ctx context.Context, b interfaces.Rea
 	}
 
 	s.setSeenBlockIndexSlot(b.Block().Slot(), b.Block().ProposerIndex())
+	s.recordBlockHeader(b)
 
 	pb, err := b.Proto()
 	if err != nil {
@@ -242,7 +244,7 @@ func (s *Service) processAndBroadcastBlock(ctx context.Context, b interfaces.Rea
 // handleBlockProcessingError handles errors during block processing.
 func (s *Service) handleBlockProcessingError(ctx context.Context, err error, b interfaces.ReadOnlySignedBeaconBlock, blkRoot [32]byte) {
 	if blockchain.IsInvalidBlock(err) {
-		s.setBadBlock(ctx, blkRoot)
+		s.setBadBlock(ctx, blkRoot, badBlockReason(err))
 	}
 	log.WithError(err).WithField("slot", b.Block().Slot()).Debug("Could not process block")
 }
@@ -266,7 +268,7 @@ func (s *Service) checkIfBlockIsBad(
 	if parentIsBad || blockIsBad {
 		// Set block as bad if its parent block is bad too.
 		if parentIsBad {
-			s.setBadBlock(ctx, blkRoot)
+			s.setBadBlock(ctx, blkRoot, cache.BadBlockInvalidParent)
 		}
 		// Remove block from queue.
 		s.pendingQueueLock.Lock()
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -39,14 +40,6 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	addEncoding := func(topic string) string {
 		return topic + p2pProvider.Encoding().ProtocolSuffix()
 	}
-	// Initialize block limits.
-	allowedBlocksPerSecond := float64(flags.Get().BlockBatchLimit)
-	allowedBlocksBurst := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
-
-	// Initialize blob limits.
-	allowedBlobsPerSecond := float64(flags.Get().BlobBatchLimit)
-	allowedBlobsBurst := int64(flags.Get().BlobBatchLimitBurstFactor * flags.Get().BlobBatchLimit)
-
 	// Set topic map for all rpc topics.
 	topicMap := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
 	// Goodbye Message
@@ -59,6 +52,25 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	// Status Message
 	topicMap[addEncoding(p2p.RPCStatusTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
 
+	setBatchCollectors(topicMap, addEncoding)
+
+	// General topic for all rpc requests.
+	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+
+	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+}
+
+// Sets the collectors of the block and blob requests, which are limited by the batch limits of the
+// global flags.
+func setBatchCollectors(topicMap map[string]*leakybucket.Collector, addEncoding func(string) string) {
+	// Initialize block limits.
+	allowedBlocksPerSecond := float64(flags.Get().BlockBatchLimit)
+	allowedBlocksBurst := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
+
+	// Initialize blob limits.
+	allowedBlobsPerSecond := float64(flags.Get().BlobBatchLimit)
+	allowedBlobsBurst := int64(flags.Get().BlobBatchLimitBurstFactor * flags.Get().BlobBatchLimit)
+
 	// Use a single collector for block requests
 	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
 	// Collector for V2
@@ -79,11 +91,33 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	topicMap[addEncoding(p2p.RPCBlobSidecarsByRootTopicV1)] = blobCollector
 	// BlobSidecarsByRangeV1
 	topicMap[addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1)] = blobCollector
+}
 
-	// General topic for all rpc requests.
-	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+// Replaces the collectors of the block and blob requests after the batch limits of the global flags
+// changed at runtime. The buckets of the peers start over.
+func (l *limiter) updateBatchLimits() {
+	l.Lock()
+	defer l.Unlock()
+	old := make(map[*leakybucket.Collector]bool)
+	for _, c := range l.limiterMap {
+		old[c] = true
+	}
+	setBatchCollectors(l.limiterMap, func(topic string) string {
+		return topic + l.p2p.Encoding().ProtocolSuffix()
+	})
+	for _, c := range l.limiterMap {
+		delete(old, c)
+	}
+	// Release the replaced collectors.
+	for c := range old {
+		c.Free()
+	}
+}
 
-	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+// UpdateRateLimits applies the block and blob batch limits of the global flags to the requests of the peers,
+// after they were changed at runtime.
+func (s *Service) UpdateRateLimits() {
+	s.rateLimiter.updateBatchLimits()
 }
 
 // Returns the current topic collector for the provided topic.
@@ -98,7 +132,7 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 	l.RLock()
 	defer l.RUnlock()
 
-	topic := string(stream.Protocol())
+	topic := l.streamTopic(stream)
 	remotePeer := stream.Conn().RemotePeer()
 
 	collector, err := l.retrieveCollector(topic)
@@ -147,7 +181,7 @@ func (l *limiter) add(stream network.Stream, amt int64) {
 	l.Lock()
 	defer l.Unlock()
 
-	topic := string(stream.Protocol())
+	topic := l.streamTopic(stream)
 	log := l.topicLogger(topic)
 
 	collector, err := l.retrieveCollector(topic)
@@ -198,6 +232,18 @@ func (l *limiter) free() {
 	}
 }
 
+// Returns the topic of the collector of the stream, the protocols of all the encodings share the
+// collectors of the default one.
+func (l *limiter) streamTopic(stream network.Stream) string {
+	topic := string(stream.Protocol())
+	for _, suffix := range l.p2p.Encoding().ReqRespProtocolSuffixes() {
+		if strings.HasSuffix(topic, suffix) {
+			return strings.TrimSuffix(topic, suffix) + l.p2p.Encoding().ProtocolSuffix()
+		}
+	}
+	return topic
+}
+
 // not to be used outside the rate limiter file as it is unsafe for concurrent usage
 // and is protected by a lock on all of its usages here.
 func (l *limiter) retrieveCollector(topic string) (*leakybucket.Collector, error) {
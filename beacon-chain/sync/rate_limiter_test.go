@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -27,6 +28,29 @@ func TestNewRateLimiter_FreeCorrectly(t *testing.T) {
 	assert.Equal(t, len(rlimiter.limiterMap), 0, "rate limiter not freed correctly")
 }
 
+func TestRateLimiter_UpdateBatchLimits(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{BlockBatchLimit: 64, BlockBatchLimitBurstFactor: 2, BlobBatchLimit: 32, BlobBatchLimitBurstFactor: 2})
+	defer flags.Init(resetFlags)
+
+	p := mockp2p.NewTestP2P(t)
+	rlimiter := newRateLimiter(p)
+	topic := p2p.RPCBlocksByRangeTopicV2 + p.Encoding().ProtocolSuffix()
+	collector, err := rlimiter.topicCollector(topic)
+	require.NoError(t, err)
+	assert.Equal(t, int64(128), collector.Capacity())
+
+	flags.Init(&flags.GlobalFlags{BlockBatchLimit: 16, BlockBatchLimitBurstFactor: 2, BlobBatchLimit: 8, BlobBatchLimitBurstFactor: 2})
+	rlimiter.updateBatchLimits()
+	assert.Equal(t, 12, len(rlimiter.limiterMap))
+	collector, err = rlimiter.topicCollector(topic)
+	require.NoError(t, err)
+	assert.Equal(t, int64(32), collector.Capacity())
+	collector, err = rlimiter.topicCollector(p2p.RPCBlobSidecarsByRangeTopicV1 + p.Encoding().ProtocolSuffix())
+	require.NoError(t, err)
+	assert.Equal(t, int64(16), collector.Capacity())
+}
+
 func TestRateLimiter_ExceedCapacity(t *testing.T) {
 	p1 := mockp2p.NewTestP2P(t)
 	p2 := mockp2p.NewTestP2P(t)
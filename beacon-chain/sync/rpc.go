@@ -113,18 +113,26 @@ func (s *Service) registerRPCHandlersDeneb() {
 // Remove all v1 Stream handlers that are no longer supported
 // from altair onwards.
 func (s *Service) unregisterPhase0Handlers() {
-	fullBlockRangeTopic := p2p.RPCBlocksByRangeTopicV1 + s.cfg.p2p.Encoding().ProtocolSuffix()
-	fullBlockRootTopic := p2p.RPCBlocksByRootTopicV1 + s.cfg.p2p.Encoding().ProtocolSuffix()
-	fullMetadataTopic := p2p.RPCMetaDataTopicV1 + s.cfg.p2p.Encoding().ProtocolSuffix()
+	for _, suffix := range s.cfg.p2p.Encoding().ReqRespProtocolSuffixes() {
+		fullBlockRangeTopic := p2p.RPCBlocksByRangeTopicV1 + suffix
+		fullBlockRootTopic := p2p.RPCBlocksByRootTopicV1 + suffix
+		fullMetadataTopic := p2p.RPCMetaDataTopicV1 + suffix
 
-	s.cfg.p2p.Host().RemoveStreamHandler(protocol.ID(fullBlockRangeTopic))
-	s.cfg.p2p.Host().RemoveStreamHandler(protocol.ID(fullBlockRootTopic))
-	s.cfg.p2p.Host().RemoveStreamHandler(protocol.ID(fullMetadataTopic))
+		s.cfg.p2p.Host().RemoveStreamHandler(protocol.ID(fullBlockRangeTopic))
+		s.cfg.p2p.Host().RemoveStreamHandler(protocol.ID(fullBlockRootTopic))
+		s.cfg.p2p.Host().RemoveStreamHandler(protocol.ID(fullMetadataTopic))
+	}
 }
 
-// registerRPC for a given topic with an expected protobuf message type.
+// registerRPC for a given topic with an expected protobuf message type, for each of the protocols
+// supported by the encoding.
 func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
-	topic := baseTopic + s.cfg.p2p.Encoding().ProtocolSuffix()
+	for _, suffix := range s.cfg.p2p.Encoding().ReqRespProtocolSuffixes() {
+		s.registerRPCProtocol(baseTopic, baseTopic+suffix, handle)
+	}
+}
+
+func (s *Service) registerRPCProtocol(baseTopic, topic string, handle rpcHandler) {
 	log := log.WithField("topic", topic)
 	s.cfg.p2p.SetStreamHandler(topic, func(stream network.Stream) {
 		defer func() {
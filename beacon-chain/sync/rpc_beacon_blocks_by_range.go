@@ -55,7 +55,7 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 		return nil
 	}
 
-	blockLimiter, err := s.rateLimiter.topicCollector(string(stream.Protocol()))
+	blockLimiter, err := s.rateLimiter.topicCollector(s.rateLimiter.streamTopic(stream))
 	if err != nil {
 		return err
 	}
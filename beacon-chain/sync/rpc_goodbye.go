@@ -3,6 +3,7 @@ package sync
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	libp2pcore "github.com/libp2p/go-libp2p/core"
@@ -74,6 +75,21 @@ func (s *Service) sendGoodbye(ctx context.Context, id peer.ID) error {
 	return s.sendGoodByeAndDisconnect(ctx, p2ptypes.GoodbyeCodeGenericError, id)
 }
 
+// DisconnectPeers sends a goodbye message with the code to all the connected peers, and disconnects them.
+func (s *Service) DisconnectPeers(ctx context.Context, code p2ptypes.RPCGoodbyeCode) {
+	var wg sync.WaitGroup
+	for _, id := range s.cfg.p2p.Peers().Connected() {
+		wg.Add(1)
+		go func(id peer.ID) {
+			defer wg.Done()
+			if err := s.sendGoodByeAndDisconnect(ctx, code, id); err != nil {
+				log.WithError(err).WithField("peer", id).Debug("Could not disconnect peer")
+			}
+		}(id)
+	}
+	wg.Wait()
+}
+
 func (s *Service) sendGoodByeAndDisconnect(ctx context.Context, code p2ptypes.RPCGoodbyeCode, id peer.ID) error {
 	lock := async.NewMultilock(id.String())
 	lock.Lock()
@@ -10,6 +10,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/protocol"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
@@ -238,3 +239,46 @@ func TestSendGoodbye_DisconnectWithPeer(t *testing.T) {
 	}
 
 }
+
+func TestDisconnectPeers(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p3 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Connect(p3)
+	p1.Peers().SetConnectionState(p2.PeerID(), peers.Connected)
+	p1.Peers().SetConnectionState(p3.PeerID(), peers.Connected)
+	assert.Equal(t, 2, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+
+	chain := &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{}}
+	r := &Service{
+		cfg: &config{
+			beaconDB: db.SetupDB(t),
+			p2p:      p1,
+			chain:    chain,
+			clock:    startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	code := p2ptypes.GoodbyeCodeClientShutdown
+
+	pcl := protocol.ID("/eth2/beacon_chain/req/goodbye/1/ssz_snappy")
+	var wg sync.WaitGroup
+	for _, p := range []*p2ptest.TestP2P{p2, p3} {
+		wg.Add(1)
+		p.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+			defer wg.Done()
+			out := new(primitives.SSZUint64)
+			assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+			assert.Equal(t, code, *out)
+			assert.NoError(t, stream.Close())
+		})
+	}
+
+	r.DisconnectPeers(context.Background(), code)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive goodbye streams within 1 sec")
+	}
+	assert.Equal(t, 0, len(p1.BHost.Network().ConnsToPeer(p2.BHost.ID())))
+	assert.Equal(t, 0, len(p1.BHost.Network().ConnsToPeer(p3.BHost.ID())))
+}
@@ -35,7 +35,7 @@ func (s *Service) metaDataHandler(_ context.Context, _ interface{}, stream libp2
 	if metadata == nil || metadata.IsNil() {
 		nilErr := errors.New("nil metadata stored for host")
 
-		resp, err := s.generateErrorResponse(responseCodeServerError, types.ErrGeneric.Error())
+		resp, err := s.generateErrorResponse(stream, responseCodeServerError, types.ErrGeneric.Error())
 		if err != nil {
 			log.WithError(err).Debug("Could not generate a response error")
 			return nilErr
@@ -53,7 +53,7 @@ func (s *Service) metaDataHandler(_ context.Context, _ interface{}, stream libp2
 	if err != nil {
 		wrappedErr := errors.Wrap(err, "topic deconstructor")
 
-		resp, genErr := s.generateErrorResponse(responseCodeServerError, types.ErrGeneric.Error())
+		resp, genErr := s.generateErrorResponse(stream, responseCodeServerError, types.ErrGeneric.Error())
 		if genErr != nil {
 			log.WithError(genErr).Debug("Could not generate a response error")
 			return wrappedErr
@@ -241,7 +241,7 @@ func (s *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 		}
 
 		originalErr := err
-		resp, err := s.generateErrorResponse(respCode, err.Error())
+		resp, err := s.generateErrorResponse(stream, respCode, err.Error())
 		if err != nil {
 			log.WithError(err).Debug("Could not generate a response error")
 		} else if _, err := stream.Write(resp); err != nil && !isUnwantedError(err) {
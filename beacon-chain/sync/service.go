@@ -21,6 +21,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/async/abool"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/clockskew"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -33,6 +35,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/backfill/coverage"
@@ -61,7 +64,6 @@ const (
 	seenSyncContributionSize        = 512  // Maximum of SYNC_COMMITTEE_SIZE as specified by the spec.
 	seenExitSize                    = 100
 	seenProposerSlashingSize        = 100
-	badBlockSize                    = 1000
 	syncMetricsInterval             = 10 * time.Second
 )
 
@@ -100,6 +102,9 @@ type config struct {
 	clock                   *startup.Clock
 	stateNotifier           statefeed.Notifier
 	blobStorage             *filesystem.BlobStorage
+	equivocationPolicy      EquivocationPolicy
+	slashingSubmitter       ProposerSlashingSubmitter
+	clockSkewRecorder       clockskew.ArrivalRecorder
 }
 
 // This defines the interface for interacting with block chain service
@@ -135,6 +140,8 @@ type Service struct {
 	rateLimiter                      *limiter
 	seenBlockLock                    sync.RWMutex
 	seenBlockCache                   *lru.Cache
+	seenBlockHeaderLock              sync.Mutex
+	seenBlockHeaderCache             *lru.Cache
 	seenBlobLock                     sync.RWMutex
 	seenBlobCache                    *lru.Cache
 	seenAggregatedAttestationLock    sync.RWMutex
@@ -145,14 +152,16 @@ type Service struct {
 	seenExitCache                    *lru.Cache
 	seenProposerSlashingLock         sync.RWMutex
 	seenProposerSlashingCache        *lru.Cache
+	seenEquivocationRootLock         sync.Mutex
+	seenEquivocationRootCache        *lru.Cache
+	equivocations                    chan *ethpb.ProposerSlashing
 	seenAttesterSlashingLock         sync.RWMutex
 	seenAttesterSlashingCache        map[uint64]bool
 	seenSyncMessageLock              sync.RWMutex
 	seenSyncMessageCache             *lru.Cache
 	seenSyncContributionLock         sync.RWMutex
 	seenSyncContributionCache        *lru.Cache
-	badBlockCache                    *lru.Cache
-	badBlockLock                     sync.RWMutex
+	badBlockCache                    *cache.BadBlockCache
 	syncContributionBitsOverlapLock  sync.RWMutex
 	syncContributionBitsOverlapCache *lru.Cache
 	signatureChan                    chan *signatureVerifier
@@ -171,11 +180,12 @@ func NewService(ctx context.Context, opts ...Option) *Service {
 		ctx:                  ctx,
 		cancel:               cancel,
 		chainStarted:         abool.New(),
-		cfg:                  &config{clock: startup.NewClock(time.Unix(0, 0), [32]byte{})},
+		cfg:                  &config{clock: startup.NewClock(time.Unix(0, 0), [32]byte{}), equivocationPolicy: EquivocationPolicyBroadcast},
 		slotToPendingBlocks:  gcache.New(pendingBlockExpTime /* exp time */, 0 /* disable janitor */),
 		seenPendingBlocks:    make(map[[32]byte]bool),
 		blkRootToPendingAtts: make(map[[32]byte][]ethpb.SignedAggregateAttAndProof),
 		signatureChan:        make(chan *signatureVerifier, verifierLimit),
+		equivocations:        make(chan *ethpb.ProposerSlashing, equivocationQueueSize),
 	}
 
 	for _, opt := range opts {
@@ -229,6 +239,7 @@ func (s *Service) Start() {
 
 	go s.verifierRoutine()
 	go s.startTasksPostInitialSync()
+	s.handleEquivocations()
 
 	s.cfg.p2p.AddConnectionHandler(s.reValidatePeer, s.sendGoodbye)
 	s.cfg.p2p.AddDisconnectionHandler(func(_ context.Context, _ peer.ID) error {
@@ -252,6 +263,9 @@ func (s *Service) Stop() error {
 			s.rateLimiter.free()
 		}
 	}()
+	// Say goodbye to our peers so they do not penalize us for dropping off, and
+	// do not keep dialing us while we are down.
+	s.DisconnectPeers(s.ctx, p2ptypes.GoodbyeCodeClientShutdown)
 	// Removing RPC Stream handlers.
 	for _, p := range s.cfg.p2p.Host().Mux().Protocols() {
 		s.cfg.p2p.Host().RemoveStreamHandler(p)
@@ -279,6 +293,7 @@ func (s *Service) Status() error {
 // and prevent DoS.
 func (s *Service) initCaches() {
 	s.seenBlockCache = lruwrpr.New(seenBlockSize)
+	s.seenBlockHeaderCache = lruwrpr.New(seenBlockSize)
 	s.seenBlobCache = lruwrpr.New(seenBlobSize)
 	s.seenAggregatedAttestationCache = lruwrpr.New(seenAggregatedAttSize)
 	s.seenUnAggregatedAttestationCache = lruwrpr.New(seenUnaggregatedAttSize)
@@ -288,7 +303,10 @@ func (s *Service) initCaches() {
 	s.seenExitCache = lruwrpr.New(seenExitSize)
 	s.seenAttesterSlashingCache = make(map[uint64]bool)
 	s.seenProposerSlashingCache = lruwrpr.New(seenProposerSlashingSize)
-	s.badBlockCache = lruwrpr.New(badBlockSize)
+	s.seenEquivocationRootCache = lruwrpr.New(seenEquivocationRootSize)
+	if s.badBlockCache == nil {
+		s.badBlockCache = cache.NewBadBlockCache(cache.BadBlockCacheSize)
+	}
 }
 
 func (s *Service) waitForChainStart() {
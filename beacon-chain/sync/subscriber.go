@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
 	"runtime/debug"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	"github.com/prysmaticlabs/prysm/v5/runtime/messagehandler"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
@@ -36,6 +38,22 @@ import (
 
 const pubsubMessageTimeout = 30 * time.Second
 
+// defaultValidationConcurrency is the default number of messages of a topic validated concurrently by the pubsub library.
+const defaultValidationConcurrency = 1024
+
+// gossipLogSampler samples the logs of rejected and ignored gossip messages, which can be numerous when
+// peers misbehave: the first 10 of a topic are logged every second, and then one in every 100.
+var gossipLogSampler = logging.NewEventSampler(10, 100, time.Second)
+
+// sampleGossipLog samples the debug log of a rejected or ignored gossip message of the topic. The sampler,
+// shared by all the gossip validations, is only consulted when debug logs are enabled.
+func sampleGossipLog(result, topic string) (bool, uint64) {
+	if logrus.GetLevel() < logrus.DebugLevel {
+		return false, 0
+	}
+	return gossipLogSampler.Sample(result + "/" + topic)
+}
+
 // wrappedVal represents a gossip validator which also returns an error along with the result.
 type wrappedVal func(context.Context, peer.ID, *pubsub.Message) (pubsub.ValidationResult, error)
 
@@ -165,6 +183,32 @@ func (s *Service) subscribe(topic string, validator wrappedVal, handle subHandle
 	return s.subscribeWithBase(s.addDigestToTopic(topic, digest), validator, handle)
 }
 
+// validationConcurrency returns the number of messages of the topic validated concurrently. Unless configured, the
+// concurrency of the blocks, attestations and blobs topics scales with the number of CPUs, so that large machines are
+// not bottlenecked by the default of the pubsub library and small machines do not thrash.
+func validationConcurrency(topic string) int {
+	cpus := runtime.NumCPU()
+	switch {
+	case strings.Contains(topic, p2p.GossipBlockMessage):
+		if c := flags.Get().BlockValidationConcurrency; c > 0 {
+			return c
+		}
+		return max(4, cpus)
+	case strings.Contains(topic, p2p.GossipAttestationMessage):
+		if c := flags.Get().AttestationValidationConcurrency; c > 0 {
+			return c
+		}
+		return min(max(128, 64*cpus), 4096)
+	case strings.Contains(topic, p2p.GossipBlobSidecarMessage):
+		if c := flags.Get().BlobValidationConcurrency; c > 0 {
+			return c
+		}
+		return max(4, cpus)
+	default:
+		return defaultValidationConcurrency
+	}
+}
+
 func (s *Service) subscribeWithBase(topic string, validator wrappedVal, handle subHandler) *pubsub.Subscription {
 	topic += s.cfg.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
@@ -176,7 +220,8 @@ func (s *Service) subscribeWithBase(topic string, validator wrappedVal, handle s
 		return nil
 	}
 
-	if err := s.cfg.p2p.PubSub().RegisterTopicValidator(s.wrapAndReportValidation(topic, validator)); err != nil {
+	topic, val := s.wrapAndReportValidation(topic, validator)
+	if err := s.cfg.p2p.PubSub().RegisterTopicValidator(topic, val, pubsub.WithValidatorConcurrency(validationConcurrency(topic))); err != nil {
 		log.WithError(err).Error("Could not register validator for topic")
 		return nil
 	}
@@ -264,6 +309,10 @@ func (s *Service) wrapAndReportValidation(topic string, v wrappedVal) (string, p
 		res = pubsub.ValidationIgnore // nolint:wastedassign
 		ctx, cancel := context.WithTimeout(ctx, pubsubMessageTimeout)
 		defer cancel()
+		// The span groups the spans of the validation, and correlates them with the logs of its result.
+		ctx, span := trace.StartSpan(ctx, "sync.validatePubsub")
+		defer span.End()
+		span.SetAttributes(trace.StringAttribute("topic", topic))
 		messageReceivedCounter.WithLabelValues(topic).Inc()
 		if msg.Topic == nil {
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
@@ -295,28 +344,39 @@ func (s *Service) wrapAndReportValidation(topic string, v wrappedVal) (string, p
 			b = pubsub.ValidationIgnore
 		}
 		if b == pubsub.ValidationReject {
-			fields := logrus.Fields{
-				"topic":        topic,
-				"multiaddress": multiAddr(pid, s.cfg.p2p.Peers()),
-				"peerID":       pid.String(),
-				"agent":        agentString(pid, s.cfg.p2p.Host()),
-				"gossipScore":  s.cfg.p2p.Peers().Scorers().GossipScorer().Score(pid),
-			}
-			if features.Get().EnableFullSSZDataLogging {
-				fields["message"] = hexutil.Encode(msg.Data)
+			if ok, dropped := sampleGossipLog("rejected", topic); ok {
+				fields := logrus.Fields{
+					"topic":        topic,
+					"multiaddress": multiAddr(pid, s.cfg.p2p.Peers()),
+					"peerID":       pid.String(),
+					"agent":        agentString(pid, s.cfg.p2p.Host()),
+					"gossipScore":  s.cfg.p2p.Peers().Scorers().GossipScorer().Score(pid),
+				}
+				if dropped > 0 {
+					fields["droppedLogs"] = dropped
+				}
+				if features.Get().EnableFullSSZDataLogging {
+					fields["message"] = hexutil.Encode(msg.Data)
+				}
+				log.WithContext(ctx).WithError(err).WithFields(fields).Debugf("Gossip message was rejected")
 			}
-			log.WithError(err).WithFields(fields).Debugf("Gossip message was rejected")
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
 		}
 		if b == pubsub.ValidationIgnore {
 			if err != nil && !errorIsIgnored(err) {
-				log.WithError(err).WithFields(logrus.Fields{
-					"topic":        topic,
-					"multiaddress": multiAddr(pid, s.cfg.p2p.Peers()),
-					"peerID":       pid.String(),
-					"agent":        agentString(pid, s.cfg.p2p.Host()),
-					"gossipScore":  fmt.Sprintf("%.2f", s.cfg.p2p.Peers().Scorers().GossipScorer().Score(pid)),
-				}).Debug("Gossip message was ignored")
+				if ok, dropped := sampleGossipLog("ignored", topic); ok {
+					fields := logrus.Fields{
+						"topic":        topic,
+						"multiaddress": multiAddr(pid, s.cfg.p2p.Peers()),
+						"peerID":       pid.String(),
+						"agent":        agentString(pid, s.cfg.p2p.Host()),
+						"gossipScore":  fmt.Sprintf("%.2f", s.cfg.p2p.Peers().Scorers().GossipScorer().Score(pid)),
+					}
+					if dropped > 0 {
+						fields["droppedLogs"] = dropped
+					}
+					log.WithContext(ctx).WithError(err).WithFields(fields).Debug("Gossip message was ignored")
+				}
 			}
 			messageIgnoredValidationCounter.WithLabelValues(topic).Inc()
 		}
@@ -369,6 +429,16 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator wrappedVal,
 					ticker.Done()
 					return
 				}
+				if !flags.Get().SubscribeToAllSubnets {
+					// The subnet strategy was changed at runtime, hand the subnets over to the dynamic subscription.
+					for i := uint64(0); i < subnetCount; i++ {
+						fullTopic := fmt.Sprintf(topic, digest, i) + s.cfg.p2p.Encoding().ProtocolSuffix()
+						s.unSubscribeFromTopic(fullTopic)
+					}
+					ticker.Done()
+					s.subscribeDynamicWithSubnets(topic, validator, handle, digest)
+					return
+				}
 				// Check every slot that there are enough peers
 				for i := uint64(0); i < subnetCount; i++ {
 					if !s.enoughPeersAreConnected(s.addDigestAndIndexToTopic(topic, digest, i)) {
@@ -434,6 +504,13 @@ func (s *Service) subscribeDynamicWithSubnets(
 					ticker.Done()
 					return
 				}
+				if flags.Get().SubscribeToAllSubnets {
+					// The subnet strategy was changed at runtime, hand the subnets over to the static subscription.
+					s.reValidateSubscriptions(subscriptions, []uint64{}, topicFormat, digest)
+					ticker.Done()
+					s.subscribeStaticWithSubnets(topicFormat, validate, handle, digest, params.BeaconConfig().AttestationSubnetCount)
+					return
+				}
 				wantedSubs := s.retrievePersistentSubs(currentSlot)
 				s.reValidateSubscriptions(subscriptions, wantedSubs, topicFormat, digest)
 
@@ -445,6 +522,11 @@ func (s *Service) subscribeDynamicWithSubnets(
 				for _, idx := range attesterSubs {
 					s.lookupAttesterSubnets(digest, idx)
 				}
+				// search ahead for peers on the subnets of the duties of the next epoch, so that they are
+				// connected by the time the first messages are published.
+				for _, idx := range slice.NotUint64(attesterSubs, s.upcomingAttesterSubnetIndices(currentSlot)) {
+					s.lookupAttesterSubnets(digest, idx)
+				}
 			}
 		}
 	}()
@@ -544,6 +626,16 @@ func (s *Service) subscribeStaticWithSyncSubnets(topic string, validator wrapped
 					ticker.Done()
 					return
 				}
+				if !flags.Get().SubscribeToAllSubnets {
+					// The subnet strategy was changed at runtime, hand the subnets over to the dynamic subscription.
+					for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+						fullTopic := fmt.Sprintf(topic, digest, i) + s.cfg.p2p.Encoding().ProtocolSuffix()
+						s.unSubscribeFromTopic(fullTopic)
+					}
+					ticker.Done()
+					s.subscribeDynamicWithSyncSubnets(topic, validator, handle, digest)
+					return
+				}
 				// Check every slot that there are enough peers
 				for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
 					if !s.enoughPeersAreConnected(s.addDigestAndIndexToTopic(topic, digest, i)) {
@@ -637,6 +729,18 @@ func (s *Service) subscribeToSyncSubnets(
 		}
 	}
 
+	// Search ahead for peers on the subnets the node joins in the next epoch, which are not subscribed yet.
+	for _, subnetIndex := range slice.NotUint64(wantedSubnetsIndex, s.retrieveActiveSyncSubnets(currentEpoch+1)) {
+		subnetTopic := fmt.Sprintf(topic, digest, subnetIndex)
+		if s.enoughPeersAreConnected(subnetTopic) {
+			continue
+		}
+		_, err := s.cfg.p2p.FindPeersWithSubnet(s.ctx, subnetTopic, subnetIndex, flags.Get().MinimumPeersPerSubnet)
+		if err != nil {
+			log.WithError(err).Debug("Could not search for peers")
+		}
+	}
+
 	return true
 }
 
@@ -685,6 +789,13 @@ func (s *Service) subscribeDynamicWithSyncSubnets(
 		for {
 			select {
 			case currentSlot := <-ticker.C():
+				if flags.Get().SubscribeToAllSubnets {
+					// The subnet strategy was changed at runtime, hand the subnets over to the static subscription.
+					s.reValidateSubscriptions(subscriptions, []uint64{}, topicFormat, digest)
+					ticker.Done()
+					s.subscribeStaticWithSyncSubnets(topicFormat, validate, handle, digest)
+					return
+				}
 				isDigestValid := s.subscribeToSyncSubnets(topicFormat, digest, genesisValidatorsRoot, genesisTime, subscriptions, currentSlot, validate, handle)
 
 				// Stop the ticker if the digest is not valid. Likely to happen after a hard fork.
@@ -65,3 +65,19 @@ func (*Service) attesterSubnetIndices(currentSlot primitives.Slot) []uint64 {
 	}
 	return slice.SetUint64(commIds)
 }
+
+// upcomingAttesterSubnetIndices returns the subnets of the attester and aggregator duties of the next epoch, which
+// are not needed before the next epoch but are known as soon as the validators subscribed to them.
+func (*Service) upcomingAttesterSubnetIndices(currentSlot primitives.Slot) []uint64 {
+	startSlot, err := slots.EpochStart(slots.ToEpoch(currentSlot) + 1)
+	if err != nil {
+		return nil
+	}
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch
+	var commIds []uint64
+	for i := startSlot; i < endSlot; i++ {
+		commIds = append(commIds, cache.SubnetIDs.GetAttesterSubnetIDs(i)...)
+		commIds = append(commIds, cache.SubnetIDs.GetAggregatorSubnetIDs(i)...)
+	}
+	return slice.SetUint64(commIds)
+}
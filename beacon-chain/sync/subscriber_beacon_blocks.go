@@ -7,7 +7,9 @@ import (
 	"path"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition/interop"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
@@ -27,6 +29,7 @@ func (s *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 	}
 
 	s.setSeenBlockIndexSlot(signed.Block().Slot(), signed.Block().ProposerIndex())
+	s.recordBlockHeader(signed)
 
 	block := signed.Block()
 
@@ -41,18 +44,18 @@ func (s *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 		if blockchain.IsInvalidBlock(err) {
 			r := blockchain.InvalidBlockRoot(err)
 			if r != [32]byte{} {
-				s.setBadBlock(ctx, r) // Setting head block as bad.
+				s.setBadBlock(ctx, r, badBlockReason(err)) // Setting head block as bad.
 			} else {
 				// TODO(13721): Remove this once we can deprecate the flag.
 				interop.WriteBlockToDisk(signed, true /*failed*/)
 
 				saveInvalidBlockToTemp(signed)
-				s.setBadBlock(ctx, root)
+				s.setBadBlock(ctx, root, badBlockReason(err))
 			}
 		}
 		// Set the returned invalid ancestors as bad.
 		for _, root := range blockchain.InvalidAncestorRoots(err) {
-			s.setBadBlock(ctx, root)
+			s.setBadBlock(ctx, root, cache.BadBlockExecutionInvalid)
 		}
 		return err
 	}
@@ -124,7 +127,7 @@ func (s *Service) reconstructAndBroadcastBlobs(ctx context.Context, block interf
 			blobExistedInDBTotal.Inc()
 			continue
 		}
-		if err := s.subscribeBlob(ctx, sidecar); err != nil {
+		if err := s.subscribeBlob(ctx, sidecar, das.SourceExecution); err != nil {
 			log.WithFields(blobFields(sidecar.ROBlob)).WithError(err).Error("Failed to receive blob")
 			continue
 		}
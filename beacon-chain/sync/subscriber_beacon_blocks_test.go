@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -108,10 +109,10 @@ func TestService_BeaconBlockSubscribe_ExecutionEngineTimesOut(t *testing.T) {
 			},
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 	require.ErrorIs(t, execution.ErrHTTPTimeout, s.beaconBlockSubscriber(context.Background(), util.NewBeaconBlock()))
-	require.Equal(t, 0, len(s.badBlockCache.Keys()))
+	require.Equal(t, 0, s.badBlockCache.Len())
 	require.Equal(t, 1, len(s.seenBlockCache.Keys()))
 }
 
@@ -126,10 +127,10 @@ func TestService_BeaconBlockSubscribe_UndefinedEeError(t *testing.T) {
 			},
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 	require.ErrorIs(t, s.beaconBlockSubscriber(context.Background(), util.NewBeaconBlock()), blockchain.ErrUndefinedExecutionEngineError)
-	require.Equal(t, 0, len(s.badBlockCache.Keys()))
+	require.Equal(t, 0, s.badBlockCache.Len())
 	require.Equal(t, 1, len(s.seenBlockCache.Keys()))
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	opfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"google.golang.org/protobuf/proto"
 )
@@ -16,13 +17,13 @@ func (s *Service) blobSubscriber(ctx context.Context, msg proto.Message) error {
 		return fmt.Errorf("message was not type blocks.VerifiedROBlob, type=%T", msg)
 	}
 
-	return s.subscribeBlob(ctx, b)
+	return s.subscribeBlob(ctx, b, das.SourceGossip)
 }
 
-func (s *Service) subscribeBlob(ctx context.Context, b blocks.VerifiedROBlob) error {
+func (s *Service) subscribeBlob(ctx context.Context, b blocks.VerifiedROBlob, source das.Source) error {
 	s.setSeenBlobIndex(b.Slot(), b.ProposerIndex(), b.Index)
 
-	if err := s.cfg.chain.ReceiveBlob(ctx, b); err != nil {
+	if err := s.cfg.chain.ReceiveBlob(ctx, b, source); err != nil {
 		return err
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"runtime"
 	"sync"
 	"testing"
 	"time"
@@ -34,6 +35,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/protobuf/proto"
 )
@@ -740,3 +742,53 @@ func createPeer(t *testing.T, topics ...string) *p2ptest.TestP2P {
 	}
 	return p
 }
+
+func TestValidationConcurrency(t *testing.T) {
+	resetFlags := flags.Get()
+	defer flags.Init(resetFlags)
+	blockTopic := fmt.Sprintf(p2p.BlockSubnetTopicFormat, [4]byte{}) + encoder.SszNetworkEncoder{}.ProtocolSuffix()
+	attTopic := fmt.Sprintf(p2p.AttestationSubnetTopicFormat, [4]byte{}, 3) + encoder.SszNetworkEncoder{}.ProtocolSuffix()
+	blobTopic := fmt.Sprintf(p2p.BlobSubnetTopicFormat, [4]byte{}, 1) + encoder.SszNetworkEncoder{}.ProtocolSuffix()
+	exitTopic := fmt.Sprintf(p2p.ExitSubnetTopicFormat, [4]byte{}) + encoder.SszNetworkEncoder{}.ProtocolSuffix()
+
+	flags.Init(&flags.GlobalFlags{})
+	cpus := runtime.NumCPU()
+	assert.Equal(t, max(4, cpus), validationConcurrency(blockTopic))
+	assert.Equal(t, min(max(128, 64*cpus), 4096), validationConcurrency(attTopic))
+	assert.Equal(t, max(4, cpus), validationConcurrency(blobTopic))
+	assert.Equal(t, defaultValidationConcurrency, validationConcurrency(exitTopic))
+
+	flags.Init(&flags.GlobalFlags{BlockValidationConcurrency: 2, AttestationValidationConcurrency: 16, BlobValidationConcurrency: 8})
+	assert.Equal(t, 2, validationConcurrency(blockTopic))
+	assert.Equal(t, 16, validationConcurrency(attTopic))
+	assert.Equal(t, 8, validationConcurrency(blobTopic))
+	assert.Equal(t, defaultValidationConcurrency, validationConcurrency(exitTopic))
+}
+
+func TestUpcomingAttesterSubnetIndices(t *testing.T) {
+	defer cache.SubnetIDs.EmptyAllCaches()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	currSlot := slotsPerEpoch + 3
+	cache.SubnetIDs.AddAttesterSubnetID(currSlot+1, 1)
+	cache.SubnetIDs.AddAttesterSubnetID(2*slotsPerEpoch, 2)
+	cache.SubnetIDs.AddAggregatorSubnetID(3*slotsPerEpoch-1, 3)
+	cache.SubnetIDs.AddAttesterSubnetID(3*slotsPerEpoch, 4)
+
+	s := &Service{}
+	assert.DeepEqual(t, []uint64{2, 3}, s.upcomingAttesterSubnetIndices(currSlot))
+}
+
+func TestSampleGossipLog(t *testing.T) {
+	level := logrus.GetLevel()
+	defer logrus.SetLevel(level)
+
+	topic := "/eth2/sample_gossip_log"
+	logrus.SetLevel(logrus.InfoLevel)
+	ok, _ := sampleGossipLog("rejected", topic)
+	assert.Equal(t, false, ok, "Gossip log sampled with debug logs disabled")
+
+	logrus.SetLevel(logrus.DebugLevel)
+	ok, dropped := sampleGossipLog("rejected", topic)
+	assert.Equal(t, true, ok, "First gossip log of the topic not sampled")
+	assert.Equal(t, uint64(0), dropped)
+}
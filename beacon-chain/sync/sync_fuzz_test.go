@@ -14,6 +14,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	gcache "github.com/patrickmn/go-cache"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -73,7 +74,7 @@ func FuzzValidateBeaconBlockPubSub_Phase0(f *testing.F) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -155,7 +156,7 @@ func FuzzValidateBeaconBlockPubSub_Altair(f *testing.F) {
 			clock:         startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot),
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -237,7 +238,7 @@ func FuzzValidateBeaconBlockPubSub_Bellatrix(f *testing.F) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -11,6 +11,7 @@ import (
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/prysmaticlabs/go-bitfield"
 	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -57,7 +58,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 	go s.verifierRoutine()
 
 	invalidRoot := [32]byte{'A', 'B', 'C', 'D'}
-	s.setBadBlock(ctx, invalidRoot)
+	s.setBadBlock(ctx, invalidRoot, cache.BadBlockConsensusInvalid)
 
 	digest, err := s.currentForkDigest()
 	require.NoError(t, err)
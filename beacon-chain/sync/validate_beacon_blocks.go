@@ -9,6 +9,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
@@ -21,6 +22,7 @@ import (
 	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
@@ -100,6 +102,11 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 
 	// Verify the block is the first block received for the proposer for the slot.
 	if s.hasSeenBlockIndexSlot(blk.Block().Slot(), blk.Block().ProposerIndex()) {
+		// A different block of the same proposer for the same slot is an equivocation. Its proposer slashing is
+		// verified and handled in the background.
+		if slashing := s.checkEquivocation(ctx, blk); slashing != nil {
+			s.queueEquivocation(slashing)
+		}
 		return pubsub.ValidationIgnore, nil
 	}
 
@@ -113,7 +120,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	}
 	// Check if parent is a bad block and then reject the block.
 	if s.hasBadBlock(blk.Block().ParentRoot()) {
-		s.setBadBlock(ctx, blockRoot)
+		s.setBadBlock(ctx, blockRoot, cache.BadBlockInvalidParent)
 		err := fmt.Errorf("received block with root %#x that has an invalid parent %#x", blockRoot, blk.Block().ParentRoot())
 		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Received block with an invalid parent")
 		return pubsub.ValidationReject, err
@@ -228,6 +235,9 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	log.WithFields(logFields).Debug("Received block")
 
 	blockArrivalGossipSummary.Observe(float64(sinceSlotStartTime.Milliseconds()))
+	if s.cfg.clockSkewRecorder != nil {
+		s.cfg.clockSkewRecorder.RecordBlockArrival(sinceSlotStartTime)
+	}
 	blockVerificationGossipSummary.Observe(float64(validationTime.Milliseconds()))
 	return pubsub.ValidationAccept, nil
 }
@@ -237,7 +247,7 @@ func (s *Service) validateBeaconBlock(ctx context.Context, blk interfaces.ReadOn
 	defer span.End()
 
 	if err := validateDenebBeaconBlock(blk.Block()); err != nil {
-		s.setBadBlock(ctx, blockRoot)
+		s.setBadBlock(ctx, blockRoot, cache.BadBlockConsensusInvalid)
 		return err
 	}
 
@@ -251,7 +261,7 @@ func (s *Service) validateBeaconBlock(ctx context.Context, blk interfaces.ReadOn
 			return err
 		}
 		// for other kinds of errors, set this block as a bad block.
-		s.setBadBlock(ctx, blockRoot)
+		s.setBadBlock(ctx, blockRoot, cache.BadBlockConsensusInvalid)
 		return err
 	}
 	return nil
@@ -263,7 +273,7 @@ func (s *Service) validateBeaconBlock(ctx context.Context, blk interfaces.ReadOn
 // - Validates that the proposer index is valid.
 func (s *Service) validatePhase0Block(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte) (state.BeaconState, error) {
 	if !s.cfg.chain.InForkchoice(blk.Block().ParentRoot()) {
-		s.setBadBlock(ctx, blockRoot)
+		s.setBadBlock(ctx, blockRoot, cache.BadBlockConsensusInvalid)
 		return nil, blockchain.ErrNotDescendantOfFinalized
 	}
 
@@ -272,7 +282,7 @@ func (s *Service) validatePhase0Block(ctx context.Context, blk interfaces.ReadOn
 		return nil, err
 	}
 
-	if err := blocks.VerifyBlockSignatureUsingCurrentFork(parentState, blk, blockRoot); err != nil {
+	if _, err := s.verifyBlockSignature(ctx, parentState, blk, blockRoot); err != nil {
 		return nil, err
 	}
 	// In the event the block is more than an epoch ahead from its
@@ -287,7 +297,7 @@ func (s *Service) validatePhase0Block(ctx context.Context, blk interfaces.ReadOn
 		return nil, err
 	}
 	if blk.Block().ProposerIndex() != idx {
-		s.setBadBlock(ctx, blockRoot)
+		s.setBadBlock(ctx, blockRoot, cache.BadBlockConsensusInvalid)
 		return nil, errors.New("incorrect proposer index")
 	}
 	return parentState, nil
@@ -373,11 +383,25 @@ func (s *Service) verifyPendingBlockSignature(ctx context.Context, blk interface
 	if err != nil {
 		return pubsub.ValidationIgnore, err
 	}
-	if err := blocks.VerifyBlockSignatureUsingCurrentFork(roState, blk, blkRoot); err != nil {
-		s.setBadBlock(ctx, blkRoot)
+	res, err := s.verifyBlockSignature(ctx, roState, blk, blkRoot)
+	if err != nil && res == pubsub.ValidationReject {
+		s.setBadBlock(ctx, blkRoot, cache.BadBlockConsensusInvalid)
+	}
+	return res, err
+}
+
+// Verifies the proposer signature of a block with the batch verifier, without waiting for the batch to fill up.
+func (s *Service) verifyBlockSignature(ctx context.Context, st state.ReadOnlyBeaconState, blk interfaces.ReadOnlySignedBeaconBlock, blkRoot [32]byte) (pubsub.ValidationResult, error) {
+	// Reject malformed signatures right away, they would only fail the whole batch.
+	sig := blk.Signature()
+	if _, err := bls.SignatureFromBytes(sig[:]); err != nil {
 		return pubsub.ValidationReject, err
 	}
-	return pubsub.ValidationAccept, nil
+	set, err := blocks.BlockSignatureBatchUsingCurrentFork(st, blk, blkRoot)
+	if err != nil {
+		return pubsub.ValidationReject, err
+	}
+	return s.validateUrgentWithBatchVerifier(ctx, "block signature", set)
 }
 
 // Returns true if the block is not the first block proposed for the proposer for the slot.
@@ -399,21 +423,27 @@ func (s *Service) setSeenBlockIndexSlot(slot primitives.Slot, proposerIdx primit
 
 // Returns true if the block is marked as a bad block.
 func (s *Service) hasBadBlock(root [32]byte) bool {
-	s.badBlockLock.RLock()
-	defer s.badBlockLock.RUnlock()
-	_, seen := s.badBlockCache.Get(string(root[:]))
-	return seen
+	return s.badBlockCache.Has(root)
 }
 
 // Set bad block in the cache.
-func (s *Service) setBadBlock(ctx context.Context, root [32]byte) {
-	s.badBlockLock.Lock()
-	defer s.badBlockLock.Unlock()
+func (s *Service) setBadBlock(ctx context.Context, root [32]byte, reason cache.BadBlockReason) {
 	if ctx.Err() != nil { // Do not mark block as bad if it was due to context error.
 		return
 	}
-	log.WithField("root", fmt.Sprintf("%#x", root)).Debug("Inserting in invalid block cache")
-	s.badBlockCache.Add(string(root[:]), true)
+	log.WithFields(logrus.Fields{
+		"root":   fmt.Sprintf("%#x", root),
+		"reason": reason,
+	}).Debug("Inserting in invalid block cache")
+	s.badBlockCache.Add(root, reason)
+}
+
+// badBlockReason classifies a block processing error for the bad block cache.
+func badBlockReason(err error) cache.BadBlockReason {
+	if blockchain.IsInvalidPayload(err) {
+		return cache.BadBlockExecutionInvalid
+	}
+	return cache.BadBlockConsensusInvalid
 }
 
 // This captures metrics for block arrival time by subtracts slot start time.
@@ -14,6 +14,7 @@ import (
 	gcache "github.com/patrickmn/go-cache"
 	"github.com/prysmaticlabs/prysm/v5/async/abool"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
@@ -85,7 +86,7 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -128,7 +129,7 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -191,7 +192,7 @@ func TestValidateBeaconBlockPubSub_CanRecoverStateSummary(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -257,7 +258,7 @@ func TestValidateBeaconBlockPubSub_IsInCache(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -323,7 +324,7 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -391,7 +392,7 @@ func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 		subHandler:          newSubTopicHandler(),
@@ -460,7 +461,7 @@ func TestValidateBeaconBlockPubSub_AdvanceEpochsForState(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -568,7 +569,7 @@ func TestValidateBeaconBlockPubSub_IgnoreAndQueueBlocksFromNearFuture(t *testing
 		},
 		chainStarted:        abool.New(),
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -619,7 +620,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 		},
 		chainStarted:        abool.New(),
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -672,7 +673,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromThePast(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -730,7 +731,7 @@ func TestValidateBeaconBlockPubSub_SeenProposerSlot(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -782,7 +783,7 @@ func TestValidateBeaconBlockPubSub_FilterByFinalizedEpoch(t *testing.T) {
 			initialSync:   &mockSync.Sync{IsSyncing: false},
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 
 	b := util.NewBeaconBlock()
@@ -867,7 +868,7 @@ func TestValidateBeaconBlockPubSub_ParentNotFinalizedDescendant(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -933,7 +934,7 @@ func TestValidateBeaconBlockPubSub_InvalidParentBlock(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -1027,7 +1028,7 @@ func TestValidateBeaconBlockPubSub_InsertValidPendingBlock(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
@@ -1110,11 +1111,11 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromBadParent(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
+		badBlockCache:       cache.NewBadBlockCache(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 	}
-	r.setBadBlock(ctx, bytesutil.ToBytes32(msg.Block.ParentRoot))
+	r.setBadBlock(ctx, bytesutil.ToBytes32(msg.Block.ParentRoot), cache.BadBlockConsensusInvalid)
 
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, msg)
@@ -1141,7 +1142,7 @@ func TestService_setBadBlock_DoesntSetWithContextErr(t *testing.T) {
 	root := [32]byte{'b', 'a', 'd'}
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
-	s.setBadBlock(ctx, root)
+	s.setBadBlock(ctx, root, cache.BadBlockConsensusInvalid)
 	if s.hasBadBlock(root) {
 		t.Error("Set bad root with cancelled context")
 	}
@@ -1212,7 +1213,7 @@ func TestValidateBeaconBlockPubSub_ValidExecutionPayload(t *testing.T) {
 			clock:         startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot),
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -1284,7 +1285,7 @@ func TestValidateBeaconBlockPubSub_InvalidPayloadTimestamp(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -1328,7 +1329,7 @@ func Test_validateBellatrixBeaconBlock(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 
 	st, _ := util.DeterministicGenesisStateAltair(t, 1)
@@ -1390,7 +1391,7 @@ func Test_validateBellatrixBeaconBlockParentValidation(t *testing.T) {
 			stateGen:      stateGen,
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 	require.ErrorContains(t, "parent of the block is optimistic", r.validateBellatrixBeaconBlock(ctx, beaconState, blk.Block()))
 }
@@ -1444,7 +1445,7 @@ func Test_validateBeaconBlockProcessingWhenParentIsOptimistic(t *testing.T) {
 			clock:         startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot),
 		},
 		seenBlockCache: lruwrpr.New(10),
-		badBlockCache:  lruwrpr.New(10),
+		badBlockCache:  cache.NewBadBlockCache(10),
 	}
 
 	buf := new(bytes.Buffer)
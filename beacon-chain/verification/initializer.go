@@ -53,7 +53,7 @@ func (ini *Initializer) NewBlobVerifier(b blocks.ROBlob, reqs []Requirement) *RO
 		sharedResources:      ini.shared,
 		blob:                 b,
 		results:              newResults(reqs...),
-		verifyBlobCommitment: kzg.Verify,
+		verifyBlobCommitment: kzg.VerifyBatched,
 	}
 }
 
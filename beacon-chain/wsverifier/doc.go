@@ -0,0 +1,11 @@
+/*
+Package wsverifier defines a runtime service which verifies the finalized chain of
+the beacon node against independent checkpoint providers. Every epoch, it fetches
+the finalized checkpoint of each provider through the Beacon API, and checks that
+the checkpoint a quorum of them agree on is part of the local finalized chain.
+
+A divergence means that the node follows another chain than the providers, either
+because it was fed a long range attack, or because its database got corrupted. It
+is reported by a metric, and raises an alert through the alerts service.
+*/
+package wsverifier
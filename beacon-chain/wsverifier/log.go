@@ -0,0 +1,5 @@
+package wsverifier
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "ws-verifier")
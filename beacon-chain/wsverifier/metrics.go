@@ -0,0 +1,21 @@
+package wsverifier
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	divergenceGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "ws_checkpoint_divergence",
+		Help: "1 if the finalized chain of the node diverges from the checkpoint of the quorum of providers, 0 otherwise",
+	})
+	quorumEpochGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "ws_checkpoint_quorum_epoch",
+		Help: "The epoch of the latest finalized checkpoint a quorum of providers agreed on",
+	})
+	providerRequestsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ws_checkpoint_provider_requests_total",
+		Help: "The number of finalized checkpoint requests to the providers, by provider and result",
+	}, []string{"provider", "result"})
+)
@@ -0,0 +1,48 @@
+package wsverifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+const providerTimeout = 10 * time.Second
+
+// Provider reports the finalized checkpoint of an independent view of the chain.
+type Provider interface {
+	// Name identifies the provider in logs and metrics.
+	Name() string
+	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
+}
+
+// APIProvider is a provider fetching the finalized checkpoint of a beacon node through the Beacon API.
+type APIProvider struct {
+	name   string
+	client *beacon.Client
+}
+
+var _ Provider = (*APIProvider)(nil)
+
+// NewAPIProvider returns a provider for the beacon node at the URL.
+func NewAPIProvider(u string) (*APIProvider, error) {
+	c, err := beacon.NewClient(u, client.WithTimeout(providerTimeout))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid checkpoint provider URL %s", u)
+	}
+	// The name leaves out the path and credentials of the URL, which may hold API keys.
+	return &APIProvider{name: c.BaseURL().Host, client: c}, nil
+}
+
+// Name returns the host of the beacon node.
+func (p *APIProvider) Name() string {
+	return p.name
+}
+
+// FinalizedCheckpoint returns the finalized checkpoint of the head state of the beacon node.
+func (p *APIProvider) FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error) {
+	return p.client.GetFinalityCheckpoints(ctx, beacon.IdHead)
+}
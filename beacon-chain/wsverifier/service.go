@@ -0,0 +1,212 @@
+package wsverifier
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Config contains the providers to verify the finalized chain against, and the dependencies of the service.
+type Config struct {
+	Providers []Provider
+	// Quorum is the number of providers which must agree on a checkpoint. When zero, it is a majority of them.
+	Quorum              int
+	InitialSyncComplete chan struct{}
+	ClockWaiter         startup.ClockWaiter
+	FinalizationFetcher blockchain.FinalizationFetcher
+	// Alerter raises the checkpoint divergence alerts, none are raised if nil.
+	Alerter alerts.Alerter
+}
+
+// checkpoint is a finalized checkpoint, comparable to be counted in the votes of the providers.
+type checkpoint struct {
+	epoch primitives.Epoch
+	root  [32]byte
+}
+
+// Service verifies, every epoch, that the checkpoint a quorum of providers agree on is part of the finalized chain.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewService returns a weak subjectivity verification service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.Quorum == 0 {
+		cfg.Quorum = len(cfg.Providers)/2 + 1
+	}
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start the weak subjectivity verification service.
+func (s *Service) Start() {
+	names := make([]string, len(s.cfg.Providers))
+	for i, p := range s.cfg.Providers {
+		names[i] = p.Name()
+	}
+	log.WithFields(logrus.Fields{
+		"providers": names,
+		"quorum":    s.cfg.Quorum,
+	}).Info("Starting service")
+	go s.run()
+}
+
+// Stop the weak subjectivity verification service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the weak subjectivity verification service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// run verifies the finalized chain in the middle of every epoch once the node is synced, away from the epoch
+// boundary where the providers and the node may not have processed the latest finality update yet.
+func (s *Service) run() {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not wait for clock")
+		return
+	}
+	select {
+	case <-s.cfg.InitialSyncComplete:
+	case <-s.ctx.Done():
+		return
+	}
+
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if slot%params.BeaconConfig().SlotsPerEpoch != params.BeaconConfig().SlotsPerEpoch/2 {
+				continue
+			}
+			s.verify(s.ctx)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// verify compares the finalized chain with the checkpoint of the quorum of providers, and reports a divergence.
+func (s *Service) verify(ctx context.Context) {
+	quorum, votes, ok := s.quorumCheckpoint(ctx)
+	if !ok {
+		log.WithFields(logrus.Fields{
+			"providers": len(s.cfg.Providers),
+			"votes":     votes,
+			"quorum":    s.cfg.Quorum,
+		}).Warn("Checkpoint providers did not reach a quorum on a finalized checkpoint")
+		return
+	}
+	quorumEpochGauge.Set(float64(quorum.epoch))
+
+	local := s.cfg.FinalizationFetcher.FinalizedCheckpt()
+	if local == nil {
+		return
+	}
+	localRoot := bytesutil.ToBytes32(local.Root)
+	fields := logrus.Fields{
+		"quorumEpoch": quorum.epoch,
+		"quorumRoot":  fmt.Sprintf("%#x", quorum.root),
+		"localEpoch":  local.Epoch,
+		"localRoot":   fmt.Sprintf("%#x", localRoot),
+		"votes":       votes,
+	}
+
+	var diverged bool
+	switch {
+	case local.Epoch == quorum.epoch:
+		diverged = localRoot != quorum.root
+	case local.Epoch > quorum.epoch:
+		diverged = !s.cfg.FinalizationFetcher.IsFinalized(ctx, quorum.root)
+	case s.cfg.FinalizationFetcher.InForkchoice(quorum.root):
+		// The checkpoint descends from the local finalized checkpoint, the node has yet to finalize it.
+		diverged = false
+	default:
+		// The node is behind the providers and does not know the checkpoint yet, it cannot be verified.
+		log.WithFields(fields).Debug("Finalized chain is behind the checkpoint providers")
+		return
+	}
+
+	if diverged {
+		divergenceGauge.Set(1)
+		log.WithFields(fields).Error("Finalized chain diverges from the checkpoint of the quorum of checkpoint providers")
+	} else {
+		divergenceGauge.Set(0)
+		log.WithFields(fields).Debug("Verified finalized chain against checkpoint providers")
+	}
+	if s.cfg.Alerter == nil {
+		return
+	}
+	s.cfg.Alerter.Notify(&alerts.Alert{
+		Event:    alerts.CheckpointDivergence,
+		Severity: alerts.SeverityCritical,
+		Summary: fmt.Sprintf("Finalized chain diverges from the checkpoint of %d/%d checkpoint providers at epoch %d",
+			votes, len(s.cfg.Providers), quorum.epoch),
+		Details: map[string]string{
+			"quorumEpoch": fmt.Sprintf("%d", quorum.epoch),
+			"quorumRoot":  fmt.Sprintf("%#x", quorum.root),
+			"localEpoch":  fmt.Sprintf("%d", local.Epoch),
+			"localRoot":   fmt.Sprintf("%#x", localRoot),
+		},
+		Resolved: !diverged,
+	})
+}
+
+// quorumCheckpoint fetches the finalized checkpoints of the providers, and returns the one most of them agree on,
+// along with the number of providers which agree on it. It is only a quorum when enough providers agree on it.
+func (s *Service) quorumCheckpoint(ctx context.Context) (checkpoint, int, bool) {
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		got = make(map[checkpoint]int)
+	)
+	for _, p := range s.cfg.Providers {
+		wg.Add(1)
+		go func(p Provider) {
+			defer wg.Done()
+			cp, err := p.FinalizedCheckpoint(ctx)
+			if err != nil {
+				providerRequestsCounter.WithLabelValues(p.Name(), "failure").Inc()
+				log.WithError(err).WithField("provider", p.Name()).Warn("Could not fetch finalized checkpoint")
+				return
+			}
+			providerRequestsCounter.WithLabelValues(p.Name(), "success").Inc()
+			mu.Lock()
+			got[checkpoint{epoch: cp.Epoch, root: bytesutil.ToBytes32(cp.Root)}]++
+			mu.Unlock()
+		}(p)
+	}
+	wg.Wait()
+
+	var (
+		best  checkpoint
+		votes int
+	)
+	for cp, n := range got {
+		// Ties go to the most recent checkpoint, the older one belongs to the providers which lag behind.
+		if n > votes || (n == votes && cp.epoch > best.epoch) {
+			best, votes = cp, n
+		}
+	}
+	return best, votes, votes > 0 && votes >= s.cfg.Quorum
+}
@@ -0,0 +1,210 @@
+package wsverifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/alerts"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockProvider struct {
+	name string
+	cp   *ethpb.Checkpoint
+	err  error
+}
+
+func (p *mockProvider) Name() string {
+	return p.name
+}
+
+func (p *mockProvider) FinalizedCheckpoint(_ context.Context) (*ethpb.Checkpoint, error) {
+	return p.cp, p.err
+}
+
+type mockAlerter struct {
+	alerts []*alerts.Alert
+}
+
+func (m *mockAlerter) Notify(a *alerts.Alert) {
+	m.alerts = append(m.alerts, a)
+}
+
+func providers(cps ...*ethpb.Checkpoint) []Provider {
+	ps := make([]Provider, len(cps))
+	for i, cp := range cps {
+		p := &mockProvider{name: fmt.Sprintf("provider-%d", i), cp: cp}
+		if cp == nil {
+			p.err = errors.New("unavailable")
+		}
+		ps[i] = p
+	}
+	return ps
+}
+
+func cp(epoch primitives.Epoch, root byte) *ethpb.Checkpoint {
+	r := make([]byte, 32)
+	r[0] = root
+	return &ethpb.Checkpoint{Epoch: epoch, Root: r}
+}
+
+func TestService_QuorumCheckpoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		cps    []*ethpb.Checkpoint
+		quorum int
+		want   checkpoint
+		votes  int
+		ok     bool
+	}{
+		{
+			name:  "all agree",
+			cps:   []*ethpb.Checkpoint{cp(10, 1), cp(10, 1), cp(10, 1)},
+			want:  checkpoint{epoch: 10, root: [32]byte{1}},
+			votes: 3,
+			ok:    true,
+		},
+		{
+			name:  "majority",
+			cps:   []*ethpb.Checkpoint{cp(10, 1), cp(10, 2), cp(10, 1), nil},
+			want:  checkpoint{epoch: 10, root: [32]byte{1}},
+			votes: 2,
+		},
+		{
+			name:   "explicit quorum",
+			cps:    []*ethpb.Checkpoint{cp(10, 1), cp(10, 2), cp(10, 1), nil},
+			quorum: 2,
+			want:   checkpoint{epoch: 10, root: [32]byte{1}},
+			votes:  2,
+			ok:     true,
+		},
+		{
+			name:   "tie goes to the most recent",
+			cps:    []*ethpb.Checkpoint{cp(9, 1), cp(10, 2)},
+			quorum: 1,
+			want:   checkpoint{epoch: 10, root: [32]byte{2}},
+			votes:  1,
+			ok:     true,
+		},
+		{
+			name: "unavailable",
+			cps:  []*ethpb.Checkpoint{nil, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewService(context.Background(), &Config{Providers: providers(tt.cps...), Quorum: tt.quorum})
+			got, votes, ok := s.quorumCheckpoint(context.Background())
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.votes, votes)
+			if votes > 0 {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_Verify(t *testing.T) {
+	tests := []struct {
+		name         string
+		local        *ethpb.Checkpoint
+		finalized    map[[32]byte]bool
+		notFinalized bool
+		alert        bool
+		diverged     bool
+	}{
+		{
+			name:  "same checkpoint",
+			local: cp(10, 1),
+			alert: true,
+		},
+		{
+			name:     "conflicting checkpoint",
+			local:    cp(10, 2),
+			alert:    true,
+			diverged: true,
+		},
+		{
+			name:      "ahead, checkpoint finalized",
+			local:     cp(12, 3),
+			finalized: map[[32]byte]bool{{1}: true},
+			alert:     true,
+		},
+		{
+			name:     "ahead, checkpoint not finalized",
+			local:    cp(12, 3),
+			alert:    true,
+			diverged: true,
+		},
+		{
+			name:  "behind, checkpoint descends from the finalized checkpoint",
+			local: cp(9, 3),
+			alert: true,
+		},
+		{
+			name:         "behind, checkpoint unknown",
+			local:        cp(9, 3),
+			notFinalized: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerter := &mockAlerter{}
+			s := NewService(context.Background(), &Config{
+				Providers: providers(cp(10, 1), cp(10, 1), cp(10, 2)),
+				FinalizationFetcher: &mock.ChainService{
+					FinalizedCheckPoint: tt.local,
+					FinalizedRoots:      tt.finalized,
+					NotFinalized:        tt.notFinalized,
+				},
+				Alerter: alerter,
+			})
+			s.verify(context.Background())
+			if !tt.alert {
+				require.Equal(t, 0, len(alerter.alerts))
+				return
+			}
+			require.Equal(t, 1, len(alerter.alerts))
+			a := alerter.alerts[0]
+			assert.Equal(t, alerts.CheckpointDivergence, a.Event)
+			assert.Equal(t, !tt.diverged, a.Resolved)
+			assert.Equal(t, "10", a.Details["quorumEpoch"])
+		})
+	}
+
+	t.Run("no quorum", func(t *testing.T) {
+		alerter := &mockAlerter{}
+		s := NewService(context.Background(), &Config{
+			Providers:           providers(cp(10, 1), cp(10, 2), nil),
+			FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: cp(10, 2)},
+			Alerter:             alerter,
+		})
+		s.verify(context.Background())
+		require.Equal(t, 0, len(alerter.alerts))
+	})
+}
+
+func TestAPIProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/beacon/states/head/finality_checkpoints", r.URL.Path)
+		_, err := fmt.Fprintf(w, `{"data":{"finalized":{"epoch":"10","root":"%#x"}}}`, [32]byte{1})
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	p, err := NewAPIProvider(srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, srv.Listener.Addr().String(), p.Name())
+	got, err := p.FinalizedCheckpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(10), got.Epoch)
+	assert.DeepEqual(t, cp(10, 1).Root, got.Root)
+}
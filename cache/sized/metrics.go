@@ -0,0 +1,33 @@
+package sized
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	cacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sized_cache_hit_total",
+		Help: "The number of requests of a cache that are present in the cache.",
+	}, []string{"cache"})
+	cacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sized_cache_miss_total",
+		Help: "The number of requests of a cache that aren't present in the cache.",
+	}, []string{"cache"})
+	cacheEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sized_cache_eviction_total",
+		Help: "The number of values evicted from a cache to fit its size limit.",
+	}, []string{"cache"})
+	cacheSizeBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sized_cache_size_bytes",
+		Help: "The estimated size of the values held by a cache, in bytes.",
+	}, []string{"cache"})
+	cacheLimitBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sized_cache_limit_bytes",
+		Help: "The size limit of a cache, in bytes.",
+	}, []string{"cache"})
+	cacheEntries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sized_cache_entries",
+		Help: "The number of values held by a cache.",
+	}, []string{"cache"})
+)
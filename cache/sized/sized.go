@@ -0,0 +1,182 @@
+// Package sized implements a least recently used cache bounded by the size in bytes of its values, rather than by
+// their number, so that the memory used by a cache does not grow with the size of the validator set.
+package sized
+
+import (
+	"container/list"
+	"sync"
+)
+
+// SizeFn estimates the memory used by a value, in bytes.
+type SizeFn[V any] func(V) uint64
+
+// Cache is a least recently used cache bounded by the size in bytes of its values. Once the limit is exceeded, the
+// least recently used values are evicted, the most recently added value being always kept even if it exceeds the
+// limit on its own. The hits, misses, evictions and size of the cache are reported under its name.
+// It is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	name     string
+	sizeFn   SizeFn[V]
+	lock     sync.Mutex
+	maxBytes uint64
+	bytes    uint64
+	entries  *list.List
+	items    map[K]*list.Element
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	size  uint64
+}
+
+// New creates a cache of the given name, holding values up to maxBytes as estimated by sizeFn.
+func New[K comparable, V any](name string, maxBytes uint64, sizeFn SizeFn[V]) *Cache[K, V] {
+	cacheLimitBytes.WithLabelValues(name).Set(float64(maxBytes))
+	cacheSizeBytes.WithLabelValues(name).Set(0)
+	return &Cache[K, V]{
+		name:     name,
+		sizeFn:   sizeFn,
+		maxBytes: maxBytes,
+		entries:  list.New(),
+		items:    make(map[K]*list.Element),
+	}
+}
+
+// Get returns the value of the key, marking it as the most recently used.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		cacheMisses.WithLabelValues(c.name).Inc()
+		var zero V
+		return zero, false
+	}
+	cacheHits.WithLabelValues(c.name).Inc()
+	c.entries.MoveToFront(e)
+	return e.Value.(*entry[K, V]).value, true
+}
+
+// Contains returns true if the cache holds the key, without marking it as used nor counting a hit or a miss.
+func (c *Cache[K, V]) Contains(key K) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, ok := c.items[key]
+	return ok
+}
+
+// Add sets the value of the key, evicting the least recently used values if the cache exceeds its limit.
+func (c *Cache[K, V]) Add(key K, value V) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	size := c.sizeFn(value)
+	if e, ok := c.items[key]; ok {
+		ent := e.Value.(*entry[K, V])
+		c.bytes = c.bytes - ent.size + size
+		ent.value = value
+		ent.size = size
+		c.entries.MoveToFront(e)
+	} else {
+		c.items[key] = c.entries.PushFront(&entry[K, V]{key: key, value: value, size: size})
+		c.bytes += size
+	}
+	c.evict()
+}
+
+// Remove deletes the key from the cache.
+func (c *Cache[K, V]) Remove(key K) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.remove(e)
+		c.report()
+	}
+}
+
+// RemoveFunc deletes the keys for which fn returns true.
+func (c *Cache[K, V]) RemoveFunc(fn func(K, V) bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for e := c.entries.Front(); e != nil; {
+		next := e.Next()
+		ent := e.Value.(*entry[K, V])
+		if fn(ent.key, ent.value) {
+			c.remove(e)
+		}
+		e = next
+	}
+	c.report()
+}
+
+// Keys returns the keys of the cache, from the least to the most recently used.
+func (c *Cache[K, V]) Keys() []K {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	keys := make([]K, 0, len(c.items))
+	for e := c.entries.Back(); e != nil; e = e.Prev() {
+		keys = append(keys, e.Value.(*entry[K, V]).key)
+	}
+	return keys
+}
+
+// Len returns the number of values in the cache.
+func (c *Cache[K, V]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.items)
+}
+
+// Bytes returns the estimated size of the values in the cache, in bytes.
+func (c *Cache[K, V]) Bytes() uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.bytes
+}
+
+// MaxBytes returns the limit of the cache, in bytes.
+func (c *Cache[K, V]) MaxBytes() uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.maxBytes
+}
+
+// Resize sets the limit of the cache, evicting the least recently used values if the cache exceeds it.
+func (c *Cache[K, V]) Resize(maxBytes uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.maxBytes = maxBytes
+	cacheLimitBytes.WithLabelValues(c.name).Set(float64(maxBytes))
+	c.evict()
+}
+
+// Purge deletes all the values of the cache.
+func (c *Cache[K, V]) Purge() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries.Init()
+	c.items = make(map[K]*list.Element)
+	c.bytes = 0
+	c.report()
+}
+
+// evict removes the least recently used values until the cache fits its limit, always keeping the most recently
+// used value.
+func (c *Cache[K, V]) evict() {
+	for c.bytes > c.maxBytes && c.entries.Len() > 1 {
+		c.remove(c.entries.Back())
+		cacheEvictions.WithLabelValues(c.name).Inc()
+	}
+	c.report()
+}
+
+func (c *Cache[K, V]) remove(e *list.Element) {
+	ent := c.entries.Remove(e).(*entry[K, V])
+	delete(c.items, ent.key)
+	c.bytes -= ent.size
+}
+
+func (c *Cache[K, V]) report() {
+	cacheSizeBytes.WithLabelValues(c.name).Set(float64(c.bytes))
+	cacheEntries.WithLabelValues(c.name).Set(float64(len(c.items)))
+}
@@ -0,0 +1,73 @@
+package sized
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func lenSize(v []byte) uint64 {
+	return uint64(len(v))
+}
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := New[string, []byte]("test", 10, lenSize)
+	c.Add("a", make([]byte, 4))
+	c.Add("b", make([]byte, 4))
+	_, ok := c.Get("a")
+	require.Equal(t, true, ok)
+
+	c.Add("c", make([]byte, 4))
+	assert.DeepEqual(t, []string{"a", "c"}, c.Keys())
+	assert.Equal(t, uint64(8), c.Bytes())
+	_, ok = c.Get("b")
+	assert.Equal(t, false, ok)
+
+	// Replacing a value accounts for its new size.
+	c.Add("a", make([]byte, 2))
+	assert.Equal(t, uint64(6), c.Bytes())
+	assert.DeepEqual(t, []string{"c", "a"}, c.Keys())
+}
+
+func TestCache_KeepsNewestValueAboveLimit(t *testing.T) {
+	c := New[string, []byte]("test", 10, lenSize)
+	c.Add("a", make([]byte, 4))
+	c.Add("b", make([]byte, 12))
+	assert.DeepEqual(t, []string{"b"}, c.Keys())
+	assert.Equal(t, uint64(12), c.Bytes())
+}
+
+func TestCache_Resize(t *testing.T) {
+	c := New[string, []byte]("test", 10, lenSize)
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		c.Add(k, make([]byte, 2))
+	}
+	assert.Equal(t, 5, c.Len())
+
+	c.Resize(4)
+	assert.Equal(t, uint64(4), c.MaxBytes())
+	assert.DeepEqual(t, []string{"d", "e"}, c.Keys())
+
+	c.Resize(10)
+	c.Add("f", make([]byte, 2))
+	assert.Equal(t, 3, c.Len())
+}
+
+func TestCache_Remove(t *testing.T) {
+	c := New[int, []byte]("test", 100, lenSize)
+	for i := 0; i < 10; i++ {
+		c.Add(i, make([]byte, i))
+	}
+	c.Remove(9)
+	assert.Equal(t, false, c.Contains(9))
+	c.RemoveFunc(func(k int, _ []byte) bool {
+		return k%2 == 0
+	})
+	assert.DeepEqual(t, []int{1, 3, 5, 7}, c.Keys())
+	assert.Equal(t, uint64(16), c.Bytes())
+
+	c.Purge()
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, uint64(0), c.Bytes())
+}
@@ -27,6 +27,9 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 		execution.WithHttpEndpoint(endpoint),
 		execution.WithEth1HeaderRequestLimit(c.Uint64(flags.Eth1HeaderReqLimit.Name)),
 		execution.WithHeaders(headers),
+		execution.WithEngineAPIRecordFile(c.String(flags.EngineAPIRecordFile.Name)),
+		execution.WithHistoricalPayloadReconstruction(c.Bool(flags.ReconstructHistoricalPayloads.Name)),
+		execution.WithGetPayloadTimeout(c.Duration(flags.GetPayloadTimeout.Name)),
 	}
 	if len(jwtSecret) > 0 {
 		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
@@ -4,6 +4,7 @@ package flags
 
 import (
 	"strings"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -86,6 +87,43 @@ var (
 			"This is not required if using an IPC connection.",
 		Value: "",
 	}
+	// EngineAPIRecordFile specifies a file to which every Engine API request and response is recorded.
+	EngineAPIRecordFile = &cli.StringFlag{
+		Name: "engine-api-record-file",
+		Usage: "Debugging: records every engine_newPayload, engine_forkchoiceUpdated and engine_getPayload request and response, " +
+			"with timings and truncated payloads, to the given file. The file is rotated once it grows past 100MB.",
+	}
+	// HeadSnapshotDirFlag defines the directory the head snapshots are written to.
+	HeadSnapshotDirFlag = &cli.StringFlag{
+		Name: "head-snapshot-dir",
+		Usage: "Debugging: directory the /prysm/v1/debug/head/snapshot endpoint writes the head state and block to. " +
+			"Defaults to the head-snapshots directory in the data directory.",
+	}
+	// ReconstructHistoricalPayloads enables replacing blinded historical blocks in the db with full blocks.
+	ReconstructHistoricalPayloads = &cli.BoolFlag{
+		Name: "reconstruct-historical-payloads",
+		Usage: "Reconstructs the execution payloads of finalized blinded blocks in the background, using payload bodies " +
+			"from the execution client, and stores the full blocks in the db. Useful after checkpoint sync on nodes serving history. " +
+			"This uses significantly more disk space.",
+	}
+	// GetPayloadTimeout specifies how long to wait on engine_getPayload before giving up.
+	GetPayloadTimeout = &cli.DurationFlag{
+		Name:  "get-payload-timeout",
+		Usage: "The maximum time to wait for the execution client to respond to engine_getPayload when proposing a block.",
+		Value: time.Second,
+	}
+	// GetPayloadSlotOffset specifies the earliest point into the proposal slot at which engine_getPayload is called.
+	GetPayloadSlotOffset = &cli.DurationFlag{
+		Name: "get-payload-slot-offset",
+		Usage: "The earliest time into the proposal slot at which the local payload is requested from the execution client. " +
+			"A later offset gives the execution client longer to build a more valuable payload, at the risk of a late block.",
+	}
+	// GetPayloadRetryCachedID retries engine_getPayload with the cached payload ID after a timeout.
+	GetPayloadRetryCachedID = &cli.BoolFlag{
+		Name: "get-payload-retry-cached-id",
+		Usage: "Retries engine_getPayload once with the previously prepared payload ID when it times out, " +
+			"before asking the execution client to build a new payload.",
+	}
 	// JwtId is the id field of the JWT claims. The consensus layer client MAY use this to communicate a unique identifier for the individual consensus layer client
 	JwtId = &cli.StringFlag{
 		Name:  "jwt-id",
@@ -204,11 +242,58 @@ var (
 		Usage: "The factor by which blob batch limit may increase on burst.",
 		Value: 2,
 	}
+	// BlockValidationConcurrency specifies the number of gossip blocks validated concurrently.
+	BlockValidationConcurrency = &cli.IntFlag{
+		Name:  "block-validation-concurrency",
+		Usage: "The number of gossip blocks validated concurrently. Defaults to a value scaled on the number of CPUs.",
+	}
+	// AttestationValidationConcurrency specifies the number of gossip attestations validated concurrently per subnet.
+	AttestationValidationConcurrency = &cli.IntFlag{
+		Name: "attestation-validation-concurrency",
+		Usage: "The number of gossip attestations validated concurrently on each attestation subnet. Defaults to a value " +
+			"scaled on the number of CPUs.",
+	}
+	// BlobValidationConcurrency specifies the number of gossip blob sidecars validated concurrently per subnet.
+	BlobValidationConcurrency = &cli.IntFlag{
+		Name: "blob-validation-concurrency",
+		Usage: "The number of gossip blob sidecars validated concurrently on each blob subnet. Defaults to a value " +
+			"scaled on the number of CPUs.",
+	}
+	// PersistCommitteeShuffles persists the computed committee shuffles in the data directory.
+	PersistCommitteeShuffles = &cli.BoolFlag{
+		Name:  "persist-committee-shuffles",
+		Usage: "Persists the committee shuffles of the current and next epochs in the data directory, so that they are not computed again after a restart.",
+	}
+	// CommitteeCacheSize sets the memory limit of the committee cache.
+	CommitteeCacheSize = &cli.Uint64Flag{
+		Name: "committee-cache-size-mb",
+		Usage: "The memory limit of the committee shuffling cache, in megabytes. The limit is raised 8 times while the " +
+			"chain is not finalizing.",
+		Value: 128,
+	}
+	// ProposerIndicesCacheSize sets the memory limit of the proposer indices cache.
+	ProposerIndicesCacheSize = &cli.Uint64Flag{
+		Name:  "proposer-indices-cache-size-mb",
+		Usage: "The memory limit of the proposer indices cache, in megabytes.",
+		Value: 1,
+	}
+	// SyncCommitteeCacheSize sets the memory limit of the sync committee cache.
+	SyncCommitteeCacheSize = &cli.Uint64Flag{
+		Name:  "sync-committee-cache-size-mb",
+		Usage: "The memory limit of the cache of the validator positions in the sync committees, in megabytes.",
+		Value: 1,
+	}
 	// DisableDebugRPCEndpoints disables the debug Beacon API namespace.
 	DisableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "disable-debug-rpc-endpoints",
 		Usage: "Disables the debug Beacon API namespace.",
 	}
+	// EnableAdminRPCEndpoints enables the Prysm API endpoints which change the state of the node.
+	EnableAdminRPCEndpoints = &cli.BoolFlag{
+		Name: "enable-admin-rpc-endpoints",
+		Usage: "Enables the Prysm admin API endpoints, which change the state of the node, such as entering maintenance mode or changing the runtime configuration. " +
+			"These endpoints are not authenticated: only enable them when untrusted clients cannot reach the API.",
+	}
 	// SubscribeToAllSubnets defines a flag to specify whether to subscribe to all possible attestation/sync subnets or not.
 	SubscribeToAllSubnets = &cli.BoolFlag{
 		Name:  "subscribe-all-subnets",
@@ -296,4 +381,266 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// SlasherMaxWorkersFlag defines how many validator chunks the slasher updates concurrently.
+	SlasherMaxWorkersFlag = &cli.IntFlag{
+		Name:  "slasher-max-workers",
+		Usage: "Number of validator chunks the slasher processes concurrently. Defaults to the number of available CPUs.",
+	}
+	// SlasherBackfillStartEpochFlag enables checking the blocks stored in the beacon database for slashable offenses from the given epoch.
+	SlasherBackfillStartEpochFlag = &cli.Uint64Flag{
+		Name: "slasher-backfill-start-epoch",
+		Usage: "Checks the blocks stored in the beacon database for slashable offenses, starting from this epoch, when the slasher starts. " +
+			"Useful after enabling the slasher on an existing node. Epochs older than the slasher history length are skipped.",
+	}
+	// SlasherBackfillEndEpochFlag defines the last epoch checked by the slasher backfill.
+	SlasherBackfillEndEpochFlag = &cli.Uint64Flag{
+		Name:  "slasher-backfill-end-epoch",
+		Usage: "Last epoch (inclusive) checked when --slasher-backfill-start-epoch is set. Defaults to the head epoch.",
+	}
+	// SlasherPolicyFlag defines what the slasher does with the slashings it detects.
+	SlasherPolicyFlag = &cli.StringFlag{
+		Name: "slasher-policy",
+		Usage: "What to do with detected slashings: broadcast submits them to the operations pool right away, " +
+			"approve queues them until an operator approves them through the /prysm/v1/debug/slasher/pending_slashings endpoints, " +
+			"log-only only logs them.",
+		Value: "broadcast",
+	}
+	// AlertWebhookURLFlag defines the webhook to which alerts are posted.
+	AlertWebhookURLFlag = &cli.StringFlag{
+		Name: "alert-webhook-url",
+		Usage: "Posts alerts to this webhook when the node or its tracked validators need attention. " +
+			"The payload is compatible with Slack incoming webhooks and the PagerDuty events API.",
+	}
+	// AlertWebhookRoutingKeyFlag defines the PagerDuty integration key sent along the alerts.
+	AlertWebhookRoutingKeyFlag = &cli.StringFlag{
+		Name:  "alert-webhook-routing-key",
+		Usage: "PagerDuty integration key sent as the routing key of the alerts posted to --alert-webhook-url.",
+	}
+	// AlertEventsFlag defines the events which raise alerts.
+	AlertEventsFlag = &cli.StringSliceFlag{
+		Name: "alert-events",
+		Usage: "Events raising alerts, among missed-proposal, attestation-inclusion-streak, finality-stall, " +
+			"low-peer-count, disk-nearly-full, checkpoint-divergence, fee-recipient-mismatch and optimistic-window. Proposals and attestations " +
+			"are those of the --monitor-indices validators, fee recipients those of the validators attached to the node.",
+		Value: cli.NewStringSlice("missed-proposal", "attestation-inclusion-streak", "finality-stall", "low-peer-count", "disk-nearly-full", "checkpoint-divergence", "fee-recipient-mismatch", "optimistic-window"),
+	}
+	// AlertAttestationStreakFlag defines the number of epochs without included attestations which raises an alert.
+	AlertAttestationStreakFlag = &cli.Uint64Flag{
+		Name:  "alert-attestation-streak",
+		Usage: "Number of epochs in a row without an included attestation of a tracked validator which raises an alert.",
+		Value: 3,
+	}
+	// AlertFinalityStallEpochsFlag defines the number of epochs without finality which raises an alert.
+	AlertFinalityStallEpochsFlag = &cli.Uint64Flag{
+		Name: "alert-finality-stall-epochs",
+		Usage: "Number of epochs since the finalized checkpoint past which a finality stall alert is raised. The alert " +
+			"escalates to an error past twice as many epochs, and to critical past four times as many.",
+		Value: 4,
+	}
+	// SafeModeStallEpochsFlag defines the number of epochs without finality which enters safe mode.
+	SafeModeStallEpochsFlag = &cli.Uint64Flag{
+		Name: "safe-mode-finality-stall-epochs",
+		Usage: "Number of epochs since the finalized checkpoint from which the node enters safe mode: hot states are " +
+			"saved to the DB, the hot state cache is capped and states are not pruned for disk usage. 0 disables safe mode.",
+		Value: 16,
+	}
+	// MaxOptimisticWindowFlag defines how long the head may stay optimistic before an alert is raised.
+	MaxOptimisticWindowFlag = &cli.DurationFlag{
+		Name: "max-optimistic-window",
+		Usage: "Duration the head of the node may stay optimistic, i.e. not validated by the execution client, past " +
+			"which an error is logged and an optimistic-window alert is raised. 0 disables the check.",
+		Value: 30 * time.Minute,
+	}
+	// OptimisticServeDutiesFlag defines whether duties-critical endpoints are served while the node is optimistic.
+	OptimisticServeDutiesFlag = &cli.BoolFlag{
+		Name: "optimistic-serve-duties",
+		Usage: "Serves attestation data, aggregates and sync committee contributions while the head of the node is " +
+			"optimistic, with a warning, instead of rejecting the requests. Block proposals are always rejected.",
+	}
+	// AlertMinPeersFlag defines the peer count below which an alert is raised.
+	AlertMinPeersFlag = &cli.IntFlag{
+		Name:  "alert-min-peers",
+		Usage: "Number of connected peers below which a low peer count alert is raised.",
+		Value: 10,
+	}
+	// AlertMinDiskFreePercentFlag defines the free disk space below which an alert is raised.
+	AlertMinDiskFreePercentFlag = &cli.Uint64Flag{
+		Name:  "alert-min-disk-free-percent",
+		Usage: "Percentage of free space on the disk of the data directory below which a disk nearly full alert is raised.",
+		Value: 10,
+	}
+	// MonitorInclusionCSVFlag defines the CSV file the attestation inclusions of the tracked validators are exported to.
+	MonitorInclusionCSVFlag = &cli.StringFlag{
+		Name: "monitor-inclusion-csv",
+		Usage: "Appends the slot, inclusion slot, inclusion distance and timely flags of each included attestation of the " +
+			"--monitor-indices validators to this CSV file.",
+	}
+	// MonitorInclusionInfluxDBURLFlag defines the InfluxDB endpoint the attestation inclusions are exported to.
+	MonitorInclusionInfluxDBURLFlag = &cli.StringFlag{
+		Name: "monitor-inclusion-influxdb-url",
+		Usage: "InfluxDB write endpoint, such as http://localhost:8086/write?db=prysm, to which each included attestation " +
+			"of the --monitor-indices validators is written as an attestation_inclusion point.",
+	}
+	// MonitorInclusionRemoteWriteURLFlag defines the Prometheus remote write endpoint the attestation inclusions are exported to.
+	MonitorInclusionRemoteWriteURLFlag = &cli.StringFlag{
+		Name: "monitor-inclusion-remote-write-url",
+		Usage: "Prometheus remote write endpoint to which the inclusion distance and timely flags of each included " +
+			"attestation of the --monitor-indices validators are sent.",
+	}
+	// CheckpointProvidersFlag defines the beacon nodes whose finalized checkpoints the local chain is verified against.
+	CheckpointProvidersFlag = &cli.StringSliceFlag{
+		Name: "checkpoint-providers",
+		Usage: "Beacon API URLs of independent beacon nodes, whose finalized checkpoints are compared every epoch with " +
+			"the finalized chain of this node. A divergence from the checkpoint a quorum of them agree on is reported " +
+			"by the ws_checkpoint_divergence metric and raises a checkpoint-divergence alert.",
+	}
+	// CheckpointProviderQuorumFlag defines the number of checkpoint providers which must agree on a checkpoint.
+	CheckpointProviderQuorumFlag = &cli.IntFlag{
+		Name:  "checkpoint-provider-quorum",
+		Usage: "Number of --checkpoint-providers which must agree on a finalized checkpoint for it to be verified. Defaults to a majority of them.",
+	}
+	// DiskUsageThresholdFlag defines the size of the data directory the disk usage forecast is made for.
+	DiskUsageThresholdFlag = &cli.Uint64Flag{
+		Name: "disk-usage-threshold-gb",
+		Usage: "Size of the data directory, in gigabytes, for which the time it is reached is forecast. " +
+			"Defaults to the size the data directory would have if it used up all the free space of its disk.",
+	}
+	// DiskUsagePruneActionsFlag defines the pruning actions run when the data directory nears the threshold.
+	DiskUsagePruneActionsFlag = &cli.StringSliceFlag{
+		Name: "disk-usage-prune-actions",
+		Usage: "Pruning actions run when the data directory is forecast to reach the disk usage threshold within " +
+			"the prune horizon. The supported action is blobs, which removes the blobs outside of the retention period.",
+	}
+	// DiskUsagePruneHorizonFlag defines how soon the threshold must be forecast to be reached to run the pruning actions.
+	DiskUsagePruneHorizonFlag = &cli.DurationFlag{
+		Name:  "disk-usage-prune-horizon",
+		Usage: "The pruning actions run when the data directory is forecast to reach the disk usage threshold within this duration.",
+		Value: 24 * time.Hour,
+	}
+	// ContinuousProfilingFlag enables the periodic capture of profiles.
+	ContinuousProfilingFlag = &cli.BoolFlag{
+		Name:  "continuous-profiling",
+		Usage: "Periodically captures profiles of a slot, with one CPU profile for each phase of the slot.",
+	}
+	// ContinuousProfilingIntervalFlag defines the time between two captures of profiles.
+	ContinuousProfilingIntervalFlag = &cli.DurationFlag{
+		Name:  "continuous-profiling-interval",
+		Usage: "Time between two captures of profiles. Must be longer than two slots.",
+		Value: 15 * time.Minute,
+	}
+	// ContinuousProfilingTypesFlag defines the types of profiles captured.
+	ContinuousProfilingTypesFlag = &cli.StringSliceFlag{
+		Name:  "continuous-profiling-types",
+		Usage: "Types of profiles captured: cpu, or the name of a runtime profile such as heap, allocs, goroutine, block or mutex.",
+		Value: cli.NewStringSlice("cpu", "heap", "goroutine"),
+	}
+	// ContinuousProfilingDirFlag defines the directory the profiles are written to.
+	ContinuousProfilingDirFlag = &cli.StringFlag{
+		Name:  "continuous-profiling-dir",
+		Usage: "Directory the profiles are written to. Defaults to the profiles directory in the data directory.",
+	}
+	// ContinuousProfilingRetentionFlag defines how long the profiles are kept.
+	ContinuousProfilingRetentionFlag = &cli.DurationFlag{
+		Name:  "continuous-profiling-retention",
+		Usage: "How long the profiles are kept in the profiles directory.",
+		Value: 24 * time.Hour,
+	}
+	// ContinuousProfilingPushURLFlag defines the profiling server the profiles are pushed to.
+	ContinuousProfilingPushURLFlag = &cli.StringFlag{
+		Name:  "continuous-profiling-push-url",
+		Usage: "URL of a profiling server supporting the Pyroscope ingest API, such as Grafana Pyroscope, the profiles are pushed to.",
+	}
+	// ReorgHeadWeightThreshold overrides REORG_HEAD_WEIGHT_THRESHOLD.
+	ReorgHeadWeightThreshold = &cli.Uint64Flag{
+		Name: "reorg-head-weight-threshold",
+		Usage: "Percentage of the committee weight below which a late head block is considered weak, and may be orphaned " +
+			"by a proposer served by this node.",
+	}
+	// ReorgParentWeightThreshold overrides REORG_PARENT_WEIGHT_THRESHOLD.
+	ReorgParentWeightThreshold = &cli.Uint64Flag{
+		Name:  "reorg-parent-weight-threshold",
+		Usage: "Percentage of the committee weight the parent of a late block needs for the late block to be orphaned.",
+	}
+	// ReorgMaxEpochsSinceFinalization overrides REORG_MAX_EPOCHS_SINCE_FINALIZATION.
+	ReorgMaxEpochsSinceFinalization = &cli.Uint64Flag{
+		Name:  "reorg-max-epochs-since-finalization",
+		Usage: "Late blocks are only orphaned when the chain has finalized within this many epochs.",
+	}
+	// ReorgProposerCutoffSeconds overrides how late into its slot a proposer may still orphan a late block.
+	ReorgProposerCutoffSeconds = &cli.Uint64Flag{
+		Name:  "reorg-proposer-cutoff-seconds",
+		Usage: "Proposers only attempt to orphan a late block when proposing within this many seconds of the start of their slot.",
+	}
+	// ShutdownTimeoutFlag bounds how long the node may take to shut down gracefully.
+	ShutdownTimeoutFlag = &cli.DurationFlag{
+		Name: "shutdown-timeout",
+		Usage: "The maximum time the beacon node waits for its services to persist their state and disconnect from peers " +
+			"on shutdown before exiting anyway. A zero value waits indefinitely.",
+		Value: time.Minute,
+	}
+	// BalanceArchiveFlag enables the archive of the balances of the validators at every epoch.
+	BalanceArchiveFlag = &cli.BoolFlag{
+		Name: "balance-archive",
+		Usage: "Archives the balances of all the validators at the start of every epoch in the data directory, to serve " +
+			"the balance history of validators.",
+	}
+	// BalanceArchiveRetentionEpochsFlag defines the number of epochs the archived balances are kept for.
+	BalanceArchiveRetentionEpochsFlag = &cli.Uint64Flag{
+		Name:  "balance-archive-retention-epochs",
+		Usage: "Number of epochs the archived balances are kept for. 0 keeps them forever.",
+	}
+	// ExitPoolMaxSizeFlag defines the maximum number of voluntary exits in the operations pool.
+	ExitPoolMaxSizeFlag = &cli.IntFlag{
+		Name:  "exit-pool-max-size",
+		Usage: "Maximum number of voluntary exits in the operations pool, the oldest being evicted first. 0 means no limit.",
+		Value: 65536,
+	}
+	// SlashingPoolMaxSizeFlag defines the maximum number of attester slashings, and of proposer slashings, in the
+	// operations pool.
+	SlashingPoolMaxSizeFlag = &cli.IntFlag{
+		Name: "slashing-pool-max-size",
+		Usage: "Maximum number of attester slashings, and of proposer slashings, in the operations pool, the oldest " +
+			"being evicted first. 0 means no limit.",
+		Value: 4096,
+	}
+	// BLSToExecPoolMaxSizeFlag defines the maximum number of BLS to execution changes in the operations pool.
+	BLSToExecPoolMaxSizeFlag = &cli.IntFlag{
+		Name: "bls-to-exec-pool-max-size",
+		Usage: "Maximum number of BLS to execution changes in the operations pool, the oldest being evicted first. " +
+			"0 means no limit.",
+		Value: 131072,
+	}
+	// OperationsPoolExpiryEpochsFlag defines the number of epochs after which pending exits, slashings and BLS to
+	// execution changes are evicted from the operations pool.
+	OperationsPoolExpiryEpochsFlag = &cli.Uint64Flag{
+		Name: "operations-pool-expiry-epochs",
+		Usage: "Number of epochs after which voluntary exits, slashings and BLS to execution changes still pending " +
+			"inclusion are evicted from the operations pool. 0 means they never expire.",
+	}
+	// GossipEquivocationPolicyFlag defines what the node does with the proposer equivocations detected on gossip.
+	GossipEquivocationPolicyFlag = &cli.StringFlag{
+		Name: "gossip-equivocation-policy",
+		Usage: "What to do with the proposer slashings built from blocks equivocating on gossip: broadcast submits them " +
+			"to the operations pool and broadcasts them, pool only submits them to the operations pool, log-only only logs them. " +
+			"When the slasher is enabled, the slashings are submitted to the operations pool according to --slasher-policy.",
+		Value: "broadcast",
+	}
+	// ClockSkewThresholdFlag defines the skew of the local clock above which the node warns.
+	ClockSkewThresholdFlag = &cli.DurationFlag{
+		Name: "clock-skew-threshold",
+		Usage: "The skew of the local clock, estimated from the arrival times of the gossip blocks or from the NTP servers, " +
+			"above which the node warns that its validators risk missing their duties or being slashed.",
+		Value: time.Second,
+	}
+	// ClockSkewNTPServersFlag defines the NTP servers queried to estimate the skew of the local clock.
+	ClockSkewNTPServersFlag = &cli.StringSliceFlag{
+		Name: "clock-skew-ntp-server",
+		Usage: "NTP server, as a host with an optional port, queried to estimate the skew of the local clock. " +
+			"Can be set multiple times. The skew is estimated from the arrival times of the gossip blocks when none is set or answers.",
+	}
+	// ClockSkewHoldDutiesFlag makes the node hold the duties of its validators while the local clock is skewed.
+	ClockSkewHoldDutiesFlag = &cli.BoolFlag{
+		Name:  "clock-skew-hold-duties",
+		Usage: "Rejects the requests of the validators for the data of their duties while the skew of the local clock exceeds --clock-skew-threshold.",
+	}
 )
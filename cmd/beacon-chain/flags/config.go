@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"sync"
+
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/urfave/cli/v2"
 )
@@ -16,12 +18,20 @@ type GlobalFlags struct {
 	BlockBatchLimitBurstFactor int
 	BlobBatchLimit             int
 	BlobBatchLimitBurstFactor  int
+	// The validation concurrency of gossip topics, zero values are scaled on the number of CPUs.
+	BlockValidationConcurrency       int
+	AttestationValidationConcurrency int
+	BlobValidationConcurrency        int
 }
 
 var globalConfig *GlobalFlags
+var globalConfigLock sync.RWMutex
 
-// Get retrieves the global config.
+// Get retrieves the global config. The returned config must not be modified, use Update instead.
 func Get() *GlobalFlags {
+	globalConfigLock.RLock()
+	defer globalConfigLock.RUnlock()
+
 	if globalConfig == nil {
 		return &GlobalFlags{}
 	}
@@ -30,9 +40,26 @@ func Get() *GlobalFlags {
 
 // Init sets the global config equal to the config that is passed in.
 func Init(c *GlobalFlags) {
+	globalConfigLock.Lock()
+	defer globalConfigLock.Unlock()
+
 	globalConfig = c
 }
 
+// Update applies a change to a copy of the global config and sets it as the global config, so that the flags can be
+// changed at runtime while other goroutines read the previous config.
+func Update(f func(c *GlobalFlags)) {
+	globalConfigLock.Lock()
+	defer globalConfigLock.Unlock()
+
+	cfg := &GlobalFlags{}
+	if globalConfig != nil {
+		*cfg = *globalConfig
+	}
+	f(cfg)
+	globalConfig = cfg
+}
+
 // ConfigureGlobalFlags initializes the global config.
 // based on the provided cli context.
 func ConfigureGlobalFlags(ctx *cli.Context) {
@@ -45,6 +72,9 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	cfg.BlobBatchLimit = ctx.Int(BlobBatchLimit.Name)
 	cfg.BlobBatchLimitBurstFactor = ctx.Int(BlobBatchLimitBurstFactor.Name)
+	cfg.BlockValidationConcurrency = ctx.Int(BlockValidationConcurrency.Name)
+	cfg.AttestationValidationConcurrency = ctx.Int(AttestationValidationConcurrency.Name)
+	cfg.BlobValidationConcurrency = ctx.Int(BlobValidationConcurrency.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
 	cfg.MaxConcurrentDials = ctx.Int(MaxConcurrentDials.Name)
 	configureMinimumPeers(ctx, cfg)
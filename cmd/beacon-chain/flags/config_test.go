@@ -0,0 +1,22 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	resetFlags := Get()
+	defer Init(resetFlags)
+
+	previous := &GlobalFlags{BlockBatchLimit: 64, BlobBatchLimit: 32}
+	Init(previous)
+	Update(func(c *GlobalFlags) {
+		c.BlockBatchLimit = 128
+	})
+	assert.Equal(t, 128, Get().BlockBatchLimit)
+	assert.Equal(t, 32, Get().BlobBatchLimit)
+	// The previous config is left untouched for the goroutines still reading it.
+	assert.Equal(t, 64, previous.BlockBatchLimit)
+}
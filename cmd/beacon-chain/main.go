@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	jwtcommands "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/jwt"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/network"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/storage"
 	backfill "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill"
 	bflags "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill/flags"
@@ -31,6 +32,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/journald"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
 	"github.com/prysmaticlabs/prysm/v5/runtime/fdlimits"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	prefixed "github.com/prysmaticlabs/prysm/v5/runtime/logging/logrus-prefixed-formatter"
 	_ "github.com/prysmaticlabs/prysm/v5/runtime/maxprocs"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
@@ -44,6 +46,16 @@ var appFlags = []cli.Flag{
 	flags.ExecutionEngineEndpoint,
 	flags.ExecutionEngineHeaders,
 	flags.ExecutionJWTSecretFlag,
+	flags.EngineAPIRecordFile,
+	flags.HeadSnapshotDirFlag,
+	flags.ReconstructHistoricalPayloads,
+	flags.GetPayloadTimeout,
+	flags.GetPayloadSlotOffset,
+	flags.GetPayloadRetryCachedID,
+	flags.ReorgHeadWeightThreshold,
+	flags.ReorgParentWeightThreshold,
+	flags.ReorgMaxEpochsSinceFinalization,
+	flags.ReorgProposerCutoffSeconds,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -59,9 +71,17 @@ var appFlags = []cli.Flag{
 	flags.BlockBatchLimitBurstFactor,
 	flags.BlobBatchLimit,
 	flags.BlobBatchLimitBurstFactor,
+	flags.BlockValidationConcurrency,
+	flags.AttestationValidationConcurrency,
+	flags.BlobValidationConcurrency,
+	flags.PersistCommitteeShuffles,
+	flags.CommitteeCacheSize,
+	flags.ProposerIndicesCacheSize,
+	flags.SyncCommitteeCacheSize,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.SlotsPerArchivedPoint,
 	flags.DisableDebugRPCEndpoints,
+	flags.EnableAdminRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
@@ -101,16 +121,24 @@ var appFlags = []cli.Flag{
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
+	cmd.P2PCompression,
+	cmd.P2PLocalCompression,
+	cmd.P2PExperimentalZstd,
 	cmd.PubsubQueueSize,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
+	cmd.TracingExporterFlag,
 	cmd.TraceSampleFractionFlag,
+	cmd.TraceFamilySampleFractionsFlag,
 	cmd.MonitoringHostFlag,
 	flags.MonitoringPortFlag,
 	cmd.DisableMonitoringFlag,
+	cmd.MetricsDisabledFamiliesFlag,
+	cmd.MetricsAggregatedLabelsFlag,
+	cmd.MetricsMaxSeriesPerFamilyFlag,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
 	cmd.LogFormat,
@@ -123,9 +151,21 @@ var appFlags = []cli.Flag{
 	debug.TraceFlag,
 	debug.BlockProfileRateFlag,
 	debug.MutexProfileFractionFlag,
+	flags.ContinuousProfilingFlag,
+	flags.ContinuousProfilingIntervalFlag,
+	flags.ContinuousProfilingTypesFlag,
+	flags.ContinuousProfilingDirFlag,
+	flags.ContinuousProfilingRetentionFlag,
+	flags.ContinuousProfilingPushURLFlag,
 	cmd.LogFileName,
+	cmd.LogFileMaxSizeFlag,
+	cmd.LogFileRotationIntervalFlag,
+	cmd.LogFileMaxBackupsFlag,
+	cmd.LogFileCompressFlag,
 	cmd.EnableUPnPFlag,
 	cmd.ConfigFileFlag,
+	cmd.ProfileFlag,
+	cmd.ProfilesFileFlag,
 	cmd.ChainConfigFileFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
 	cmd.AcceptTosFlag,
@@ -135,10 +175,46 @@ var appFlags = []cli.Flag{
 	cmd.ApiTimeoutFlag,
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
+	checkpoint.DepositSnapshotPath,
 	checkpoint.RemoteURL,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
+	network.ConfigURL,
+	network.ConfigChecksum,
 	flags.SlasherDirFlag,
+	flags.SlasherMaxWorkersFlag,
+	flags.SlasherBackfillStartEpochFlag,
+	flags.SlasherBackfillEndEpochFlag,
+	flags.SlasherPolicyFlag,
+	flags.AlertWebhookURLFlag,
+	flags.AlertWebhookRoutingKeyFlag,
+	flags.AlertEventsFlag,
+	flags.AlertAttestationStreakFlag,
+	flags.AlertFinalityStallEpochsFlag,
+	flags.SafeModeStallEpochsFlag,
+	flags.MaxOptimisticWindowFlag,
+	flags.OptimisticServeDutiesFlag,
+	flags.AlertMinPeersFlag,
+	flags.AlertMinDiskFreePercentFlag,
+	flags.MonitorInclusionCSVFlag,
+	flags.MonitorInclusionInfluxDBURLFlag,
+	flags.MonitorInclusionRemoteWriteURLFlag,
+	flags.CheckpointProvidersFlag,
+	flags.CheckpointProviderQuorumFlag,
+	flags.DiskUsageThresholdFlag,
+	flags.DiskUsagePruneActionsFlag,
+	flags.DiskUsagePruneHorizonFlag,
+	flags.ShutdownTimeoutFlag,
+	flags.BalanceArchiveFlag,
+	flags.BalanceArchiveRetentionEpochsFlag,
+	flags.ExitPoolMaxSizeFlag,
+	flags.SlashingPoolMaxSizeFlag,
+	flags.BLSToExecPoolMaxSizeFlag,
+	flags.OperationsPoolExpiryEpochsFlag,
+	flags.GossipEquivocationPolicyFlag,
+	flags.ClockSkewThresholdFlag,
+	flags.ClockSkewNTPServersFlag,
+	flags.ClockSkewHoldDutiesFlag,
 	flags.JwtId,
 	storage.BlobStoragePathFlag,
 	storage.BlobRetentionEpochFlag,
@@ -153,6 +229,10 @@ func init() {
 }
 
 func before(ctx *cli.Context) error {
+	// Load flags from profile, if specified.
+	if err := cmd.LoadFlagsFromProfile(ctx, appFlags); err != nil {
+		return errors.Wrap(err, "failed to load flags from profile")
+	}
 	// Load flags from config file, if specified.
 	if err := cmd.LoadFlagsFromConfig(ctx, appFlags); err != nil {
 		return errors.Wrap(err, "failed to load flags from config file")
@@ -180,6 +260,8 @@ func before(ctx *cli.Context) error {
 		logrus.SetFormatter(f)
 	case "json":
 		logrus.SetFormatter(&logrus.JSONFormatter{})
+	case "json-structured":
+		logrus.SetFormatter(&logging.JSONFormatter{})
 	case "journald":
 		if err := journald.Enable(); err != nil {
 			return err
@@ -190,7 +272,7 @@ func before(ctx *cli.Context) error {
 
 	logFileName := ctx.String(cmd.LogFileName.Name)
 	if logFileName != "" {
-		if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+		if err := cmd.ConfigurePersistentLogging(ctx, logFileName); err != nil {
 			log.WithError(err).Error("Failed to configuring logging to disk.")
 		}
 	}
@@ -215,6 +297,10 @@ func before(ctx *cli.Context) error {
 		return errors.Wrap(err, "provided multiple network flags")
 	}
 
+	if err := network.Configure(ctx); err != nil {
+		return errors.Wrap(err, "failed to load network config bundle")
+	}
+
 	return cmd.ValidateNoArgs(ctx)
 }
 
@@ -271,7 +357,8 @@ func startNode(ctx *cli.Context, cancel context.CancelFunc) error {
 	if err != nil {
 		return err
 	}
-	logrus.SetLevel(level)
+	// The level can be changed at runtime, along with the levels of single modules, through the node API.
+	logs.SetLevel(level)
 	// Set libp2p logger to only panic logs for the info level.
 	golog.SetAllLoggers(golog.LevelPanic)
 
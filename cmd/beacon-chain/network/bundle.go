@@ -0,0 +1,333 @@
+package network
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+// Files of a network config bundle, named as in the eth-clients and ethpandaops network config repositories.
+const (
+	configFile      = "config.yaml"
+	genesisFile     = "genesis.ssz"
+	deployBlockFile = "deploy_block.txt"
+	bootnodesFile   = "bootstrap_nodes.txt"
+	bootENRFile     = "boot_enr.yaml"
+)
+
+var bundleFiles = []string{configFile, genesisFile, deployBlockFile, bootnodesFile, bootENRFile}
+
+const (
+	// bundleDownloadTimeout bounds each request fetching a remote network config bundle.
+	bundleDownloadTimeout = 10 * time.Minute
+	// maxBundleFileSize bounds the size of each file of a network config bundle, and of a remote archive.
+	maxBundleFileSize = 1 << 30
+)
+
+var bundleClient = &http.Client{Timeout: bundleDownloadTimeout}
+
+// bundle holds the paths and values read from a network config bundle.
+type bundle struct {
+	dir            string
+	configPath     string
+	genesisPath    string
+	deployBlock    uint64
+	hasDeployBlock bool
+	bootnodes      []string
+}
+
+// fetchBundle returns the local directory holding the files of the network config bundle at location. Local
+// directories are used in place, archives and remote bundles are materialized in dst first. When checksum is set,
+// the bundle must be an archive whose hex encoded SHA-256 digest is checksum.
+func fetchBundle(ctx context.Context, location, dst, checksum string) (string, error) {
+	u, err := url.Parse(location)
+	if err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		if checksum != "" && !isArchive(u.Path) {
+			return "", fmt.Errorf("network config bundle %s must be an archive to verify its checksum", location)
+		}
+		return dst, materializeBundle(dst, func(dir string) error {
+			if isArchive(u.Path) {
+				body, err := download(ctx, location)
+				if err != nil {
+					return err
+				}
+				if body == nil {
+					return fmt.Errorf("network config archive not found at %s", location)
+				}
+				defer func() {
+					_ = body.Close()
+				}()
+				return extractArchive(body, u.Path, dir, checksum)
+			}
+			for _, name := range bundleFiles {
+				if err := downloadBundleFile(ctx, u.JoinPath(name).String(), filepath.Join(dir, name)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	path, err := file.ExpandPath(strings.TrimPrefix(location, "file://"))
+	if err != nil {
+		return "", err
+	}
+	isDir, err := file.HasDir(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read network config bundle at %s", path)
+	}
+	if isDir {
+		if checksum != "" {
+			return "", fmt.Errorf("network config bundle %s must be an archive to verify its checksum", path)
+		}
+		return path, nil
+	}
+	if !isArchive(path) {
+		return "", fmt.Errorf("network config bundle %s is neither a directory nor a .tar, .tar.gz or .tgz archive", path)
+	}
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not open network config archive %s", path)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return dst, materializeBundle(dst, func(dir string) error {
+		return extractArchive(f, path, dir, checksum)
+	})
+}
+
+// materializeBundle calls write with a temporary directory next to dst, and replaces dst with that directory once
+// write succeeds. If it fails, the bundle materialized by a previous start is left as is.
+func materializeBundle(dst string, write func(dir string) error) error {
+	if err := file.MkdirAll(filepath.Dir(dst)); err != nil {
+		return errors.Wrapf(err, "could not create directory %s", filepath.Dir(dst))
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(dst), filepath.Base(dst)+"-*")
+	if err != nil {
+		return errors.Wrap(err, "could not create temporary network config directory")
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+	if err := write(tmp); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dst); err != nil {
+		return errors.Wrapf(err, "could not remove previous network config directory %s", dst)
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return errors.Wrapf(err, "could not move network config directory to %s", dst)
+	}
+	return nil
+}
+
+func isArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz") || strings.HasSuffix(path, ".tar")
+}
+
+// extractArchive writes the bundle files found in the tar archive to dst. The files are matched by name anywhere in
+// the archive, so that bundles packaged under a top-level directory are supported, and the first match is used. When
+// checksum is set, the SHA-256 digest of the whole archive must match it.
+func extractArchive(r io.Reader, name, dst, checksum string) error {
+	var digest hash.Hash
+	if checksum != "" {
+		digest = sha256.New()
+		r = io.TeeReader(r, digest)
+	}
+	archive := r
+	if !strings.HasSuffix(name, ".tar") {
+		gz, err := gzip.NewReader(archive)
+		if err != nil {
+			return errors.Wrap(err, "could not decompress network config archive")
+		}
+		defer func() {
+			_ = gz.Close()
+		}()
+		archive = gz
+	}
+	tr := tar.NewReader(archive)
+	written := make(map[string]bool)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not read network config archive")
+		}
+		base := filepath.Base(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg || written[base] || !isBundleFile(base) {
+			continue
+		}
+		if err := writeBundleFile(filepath.Join(dst, base), tr); err != nil {
+			return err
+		}
+		written[base] = true
+	}
+	if digest == nil {
+		return nil
+	}
+	// Read what follows the end of the tar stream, so that the digest covers the whole archive.
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return errors.Wrap(err, "could not read network config archive")
+	}
+	got := hex.EncodeToString(digest.Sum(nil))
+	if got != strings.ToLower(strings.TrimPrefix(checksum, "0x")) {
+		return fmt.Errorf("network config archive SHA-256 digest %s does not match %s", got, checksum)
+	}
+	return nil
+}
+
+func isBundleFile(name string) bool {
+	for _, f := range bundleFiles {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// download returns the body of the response to a GET request of the URL, or nil if there is nothing at the URL.
+// The body is cut one byte past maxBundleFileSize, so that writeBundleFile rejects larger files.
+func download(ctx context.Context, u string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := bundleClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not download %s", u)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		_ = resp.Body.Close()
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("could not download %s: %s", u, resp.Status)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(resp.Body, maxBundleFileSize+1), resp.Body}, nil
+}
+
+// downloadBundleFile writes the file at the URL to path, the file is skipped if there is nothing at the URL.
+func downloadBundleFile(ctx context.Context, u, path string) error {
+	body, err := download(ctx, u)
+	if err != nil || body == nil {
+		return err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	return writeBundleFile(path, body)
+}
+
+// writeBundleFile writes the content of r to path, refusing content larger than maxBundleFileSize.
+func writeBundleFile(path string, r io.Reader) error {
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrapf(err, "could not create network config file %s", path)
+	}
+	n, err := io.Copy(f, io.LimitReader(r, maxBundleFileSize+1))
+	if err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "could not write network config file %s", path)
+	}
+	if n > maxBundleFileSize {
+		_ = f.Close()
+		return fmt.Errorf("network config file %s is larger than %d bytes", filepath.Base(path), maxBundleFileSize)
+	}
+	return f.Close()
+}
+
+// readBundle reads the network config bundle in dir. The chain config is required, the other files are optional.
+func readBundle(dir string) (*bundle, error) {
+	b := &bundle{dir: dir}
+	exists := func(name string) (string, bool, error) {
+		path := filepath.Join(dir, name)
+		ok, err := file.Exists(path, file.Regular)
+		if err != nil {
+			return "", false, errors.Wrapf(err, "could not check network config file %s", path)
+		}
+		return path, ok, nil
+	}
+
+	path, ok, err := exists(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("network config bundle %s does not have a %s", dir, configFile)
+	}
+	b.configPath = path
+
+	if path, ok, err = exists(genesisFile); err != nil {
+		return nil, err
+	} else if ok {
+		b.genesisPath = path
+	}
+
+	if path, ok, err = exists(deployBlockFile); err != nil {
+		return nil, err
+	} else if ok {
+		content, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read %s", path)
+		}
+		b.deployBlock, err = strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid deposit contract deployment block in %s", path)
+		}
+		b.hasDeployBlock = true
+	}
+
+	if path, ok, err = exists(bootnodesFile); err != nil {
+		return nil, err
+	} else if ok {
+		f, err := os.Open(filepath.Clean(path))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read %s", path)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			b.bootnodes = append(b.bootnodes, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, errors.Wrapf(err, "could not read %s", path)
+		}
+	}
+	// YAML lists of bootnodes are read by the p2p service when passed as a bootstrap node.
+	if path, ok, err = exists(bootENRFile); err != nil {
+		return nil, err
+	} else if ok {
+		b.bootnodes = append(b.bootnodes, path)
+	}
+	return b, nil
+}
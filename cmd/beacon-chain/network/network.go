@@ -0,0 +1,101 @@
+package network
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/genesis"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// bundleDirName is the directory of the data directory where remote and archived bundles are materialized.
+const bundleDirName = "network-config"
+
+// ConfigURL defines a flag to bootstrap a custom network from a bundle of its configuration files.
+var ConfigURL = &cli.StringFlag{
+	Name: "network-config-url",
+	Usage: "URL or local path of a network config bundle to run a custom network. The bundle is a directory, or a " +
+		".tar, .tar.gz or .tgz archive of one, holding config.yaml, and optionally genesis.ssz, deploy_block.txt, " +
+		"bootstrap_nodes.txt and boot_enr.yaml. The files are used in place of the --chain-config-file, " +
+		"--genesis-state, --contract-deployment-block and --bootstrap-node flags, which take precedence when set.",
+}
+
+// ConfigChecksum defines a flag to verify the archive of the network config bundle.
+var ConfigChecksum = &cli.StringFlag{
+	Name:  "network-config-sha256",
+	Usage: "Hex encoded SHA-256 digest the network config bundle archive of --network-config-url must match.",
+}
+
+// Configure loads the network config bundle of the --network-config-url flag, if set, and sets the flags it
+// provides values for. It must run before the node reads its configuration from the flags.
+func Configure(c *cli.Context) error {
+	location := c.String(ConfigURL.Name)
+	if location == "" {
+		return nil
+	}
+	for _, f := range features.NetworkFlags {
+		if c.IsSet(f.Names()[0]) {
+			return fmt.Errorf("--%s cannot be used with --%s", ConfigURL.Name, f.Names()[0])
+		}
+	}
+	dst := filepath.Join(c.String(cmd.DataDirFlag.Name), bundleDirName)
+	dir, err := fetchBundle(c.Context, location, dst, c.String(ConfigChecksum.Name))
+	if err != nil {
+		return errors.Wrapf(err, "could not fetch network config bundle %s", location)
+	}
+	b, err := readBundle(dir)
+	if err != nil {
+		return err
+	}
+	if err := b.apply(c); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"bundle":    location,
+		"dir":       dir,
+		"genesis":   b.genesisPath != "",
+		"bootnodes": len(b.bootnodes),
+	}).Info("Loaded network config bundle")
+	return nil
+}
+
+// apply sets the flags the bundle provides values for, leaving the flags set by the user untouched.
+func (b *bundle) apply(c *cli.Context) error {
+	set := func(name string, values ...string) error {
+		if c.IsSet(name) {
+			log.Infof("Using --%s over the network config bundle", name)
+			return nil
+		}
+		for _, v := range values {
+			if err := c.Set(name, v); err != nil {
+				return errors.Wrapf(err, "could not set --%s from the network config bundle", name)
+			}
+		}
+		return nil
+	}
+	if err := set(cmd.ChainConfigFileFlag.Name, b.configPath); err != nil {
+		return err
+	}
+	if b.genesisPath != "" {
+		if err := set(genesis.StatePath.Name, b.genesisPath); err != nil {
+			return err
+		}
+	}
+	if b.hasDeployBlock {
+		if err := set(flags.ContractDeploymentBlock.Name, strconv.FormatUint(b.deployBlock, 10)); err != nil {
+			return err
+		}
+	}
+	if len(b.bootnodes) > 0 {
+		if err := set(cmd.BootstrapNode.Name, b.bootnodes...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
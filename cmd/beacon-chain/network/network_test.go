@@ -0,0 +1,209 @@
+package network
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/genesis"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+var testBundle = map[string]string{
+	configFile:      "PRESET_BASE: 'minimal'\nCONFIG_NAME: 'devnet'\n",
+	genesisFile:     "genesis",
+	deployBlockFile: "42\n",
+	bootnodesFile:   "# bootnodes\nenr:-first\n\nenr:-second\n",
+}
+
+func writeBundleDir(t *testing.T) string {
+	dir := t.TempDir()
+	for name, content := range testBundle {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	return dir
+}
+
+func bundleArchive(t *testing.T, prefix string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: prefix, Typeflag: tar.TypeDir, Mode: 0700}))
+	for name, content := range testBundle {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: prefix + name, Typeflag: tar.TypeReg, Mode: 0600, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func requireTestBundle(t *testing.T, dir string) {
+	b, err := readBundle(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, configFile), b.configPath)
+	assert.Equal(t, filepath.Join(dir, genesisFile), b.genesisPath)
+	assert.Equal(t, true, b.hasDeployBlock)
+	assert.Equal(t, uint64(42), b.deployBlock)
+	assert.DeepEqual(t, []string{"enr:-first", "enr:-second"}, b.bootnodes)
+}
+
+func TestFetchBundle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("local directory", func(t *testing.T) {
+		dir := writeBundleDir(t)
+		got, err := fetchBundle(ctx, dir, filepath.Join(t.TempDir(), bundleDirName), "")
+		require.NoError(t, err)
+		assert.Equal(t, dir, got)
+		requireTestBundle(t, got)
+	})
+	t.Run("local archive", func(t *testing.T) {
+		archive := filepath.Join(t.TempDir(), "devnet.tar.gz")
+		require.NoError(t, os.WriteFile(archive, bundleArchive(t, "devnet/metadata/"), 0600))
+		dst := filepath.Join(t.TempDir(), bundleDirName)
+		got, err := fetchBundle(ctx, "file://"+archive, dst, "")
+		require.NoError(t, err)
+		assert.Equal(t, dst, got)
+		requireTestBundle(t, got)
+	})
+	t.Run("remote directory", func(t *testing.T) {
+		srv := httptest.NewServer(http.StripPrefix("/devnet", http.FileServer(http.Dir(writeBundleDir(t)))))
+		defer srv.Close()
+		dst := filepath.Join(t.TempDir(), bundleDirName)
+		// A stale file of a previous bundle must not be picked up.
+		require.NoError(t, os.MkdirAll(dst, 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(dst, bootENRFile), []byte("- enr:-stale\n"), 0600))
+		got, err := fetchBundle(ctx, srv.URL+"/devnet", dst, "")
+		require.NoError(t, err)
+		requireTestBundle(t, got)
+	})
+	t.Run("remote archive", func(t *testing.T) {
+		archive := bundleArchive(t, "")
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/devnet.tgz" {
+				http.NotFound(w, r)
+				return
+			}
+			_, err := w.Write(archive)
+			require.NoError(t, err)
+		}))
+		defer srv.Close()
+		got, err := fetchBundle(ctx, srv.URL+"/devnet.tgz", filepath.Join(t.TempDir(), bundleDirName), "")
+		require.NoError(t, err)
+		requireTestBundle(t, got)
+
+		_, err = fetchBundle(ctx, srv.URL+"/missing.tgz", filepath.Join(t.TempDir(), bundleDirName), "")
+		require.ErrorContains(t, "not found", err)
+	})
+	t.Run("checksum", func(t *testing.T) {
+		archive := bundleArchive(t, "")
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := w.Write(archive)
+			require.NoError(t, err)
+		}))
+		defer srv.Close()
+		digest := sha256.Sum256(archive)
+		dst := filepath.Join(t.TempDir(), bundleDirName)
+		got, err := fetchBundle(ctx, srv.URL+"/devnet.tgz", dst, hex.EncodeToString(digest[:]))
+		require.NoError(t, err)
+		requireTestBundle(t, got)
+
+		// A bundle failing to fetch leaves the one of the previous start in place.
+		_, err = fetchBundle(ctx, srv.URL+"/devnet.tgz", dst, hex.EncodeToString(make([]byte, 32)))
+		require.ErrorContains(t, "does not match", err)
+		requireTestBundle(t, dst)
+		_, err = fetchBundle(ctx, srv.URL+"/devnet", dst, hex.EncodeToString(digest[:]))
+		require.ErrorContains(t, "must be an archive", err)
+		requireTestBundle(t, dst)
+	})
+	t.Run("not a bundle", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte{}, 0600))
+		_, err := fetchBundle(ctx, path, filepath.Join(t.TempDir(), bundleDirName), "")
+		require.ErrorContains(t, "neither a directory nor", err)
+	})
+}
+
+func TestReadBundle(t *testing.T) {
+	dir := t.TempDir()
+	_, err := readBundle(dir)
+	require.ErrorContains(t, "does not have a config.yaml", err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, configFile), []byte{}, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, bootENRFile), []byte("- enr:-first\n"), 0600))
+	b, err := readBundle(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "", b.genesisPath)
+	assert.Equal(t, false, b.hasDeployBlock)
+	assert.DeepEqual(t, []string{filepath.Join(dir, bootENRFile)}, b.bootnodes)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, deployBlockFile), []byte("block"), 0600))
+	_, err = readBundle(dir)
+	require.ErrorContains(t, "invalid deposit contract deployment block", err)
+}
+
+func TestConfigure(t *testing.T) {
+	newContext := func(t *testing.T, args ...string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		for _, f := range append([]cli.Flag{
+			ConfigURL,
+			cmd.DataDirFlag,
+			cmd.ChainConfigFileFlag,
+			cmd.BootstrapNode,
+			genesis.StatePath,
+			flags.ContractDeploymentBlock,
+		}, features.NetworkFlags...) {
+			require.NoError(t, f.Apply(set))
+		}
+		require.NoError(t, set.Parse(args))
+		return cli.NewContext(&cli.App{}, set, nil)
+	}
+
+	t.Run("unset", func(t *testing.T) {
+		c := newContext(t)
+		require.NoError(t, Configure(c))
+		assert.Equal(t, false, c.IsSet(cmd.ChainConfigFileFlag.Name))
+	})
+	t.Run("sets flags", func(t *testing.T) {
+		dir := writeBundleDir(t)
+		c := newContext(t, "--"+ConfigURL.Name, dir, "--"+cmd.DataDirFlag.Name, t.TempDir())
+		require.NoError(t, Configure(c))
+		assert.Equal(t, filepath.Join(dir, configFile), c.String(cmd.ChainConfigFileFlag.Name))
+		assert.Equal(t, filepath.Join(dir, genesisFile), c.Path(genesis.StatePath.Name))
+		assert.Equal(t, 42, c.Int(flags.ContractDeploymentBlock.Name))
+		assert.DeepEqual(t, []string{"enr:-first", "enr:-second"}, c.StringSlice(cmd.BootstrapNode.Name))
+	})
+	t.Run("flags take precedence", func(t *testing.T) {
+		dir := writeBundleDir(t)
+		c := newContext(t,
+			"--"+ConfigURL.Name, dir,
+			"--"+cmd.DataDirFlag.Name, t.TempDir(),
+			"--"+flags.ContractDeploymentBlock.Name, "7",
+			"--"+cmd.BootstrapNode.Name, "enr:-mine",
+		)
+		require.NoError(t, Configure(c))
+		assert.Equal(t, filepath.Join(dir, configFile), c.String(cmd.ChainConfigFileFlag.Name))
+		assert.Equal(t, 7, c.Int(flags.ContractDeploymentBlock.Name))
+		assert.DeepEqual(t, []string{"enr:-mine"}, c.StringSlice(cmd.BootstrapNode.Name))
+	})
+	t.Run("network flag", func(t *testing.T) {
+		c := newContext(t, "--"+ConfigURL.Name, writeBundleDir(t), "--"+features.HoleskyTestnet.Name)
+		require.ErrorContains(t, "cannot be used with --holesky", Configure(c))
+	})
+}
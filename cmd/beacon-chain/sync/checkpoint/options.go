@@ -22,6 +22,13 @@ var (
 		Usage: "Rather than syncing from genesis, you can start processing from a ssz-serialized BeaconState+Block." +
 			" This flag allows you to specify a local file containing the checkpoint Block to load.",
 	}
+	// DepositSnapshotPath optionally provides the EIP-4881 deposit snapshot matching the StatePath checkpoint state.
+	DepositSnapshotPath = &cli.PathFlag{
+		Name: "checkpoint-deposit-snapshot",
+		Usage: "Rather than replaying all the deposit logs from the execution client, you can start processing them from a " +
+			"ssz-serialized EIP-4881 deposit snapshot. This flag allows you to specify a local file containing the " +
+			"deposit snapshot matching the checkpoint BeaconState to load.",
+	}
 	RemoteURL = &cli.StringFlag{
 		Name: "checkpoint-sync-url",
 		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. " +
@@ -36,6 +43,7 @@ var (
 func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
+	depositSnapshotPath := c.Path(DepositSnapshotPath.Name)
 	remoteURL := c.String(RemoteURL.Name)
 	if remoteURL != "" {
 		opt := func(node *node.BeaconNode) error {
@@ -50,6 +58,9 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	}
 
 	if blockPath == "" && statePath == "" {
+		if depositSnapshotPath != "" {
+			return nil, fmt.Errorf("--checkpoint-deposit-snapshot specified, but not --checkpoint-state and --checkpoint-block")
+		}
 		return nil, nil
 	}
 	if blockPath != "" && statePath == "" {
@@ -60,7 +71,7 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	}
 
 	opt := func(node *node.BeaconNode) (err error) {
-		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath)
+		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath, depositSnapshotPath)
 		if err != nil {
 			return errors.Wrap(err, "error preparing to initialize checkpoint from local ssz files")
 		}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/network"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/storage"
 	backfill "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill/flags"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/checkpoint"
@@ -62,14 +63,21 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
+			cmd.TracingExporterFlag,
 			cmd.TraceSampleFractionFlag,
+			cmd.TraceFamilySampleFractionsFlag,
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
 			cmd.DisableMonitoringFlag,
+			cmd.MetricsDisabledFamiliesFlag,
+			cmd.MetricsAggregatedLabelsFlag,
+			cmd.MetricsMaxSeriesPerFamilyFlag,
 			cmd.MaxGoroutines,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
 			cmd.ConfigFileFlag,
+			cmd.ProfileFlag,
+			cmd.ProfilesFileFlag,
 			cmd.ChainConfigFileFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
 			cmd.AcceptTosFlag,
@@ -90,6 +98,12 @@ var appHelpFlagGroups = []flagGroup{
 			debug.TraceFlag,
 			debug.BlockProfileRateFlag,
 			debug.MutexProfileFractionFlag,
+			flags.ContinuousProfilingFlag,
+			flags.ContinuousProfilingIntervalFlag,
+			flags.ContinuousProfilingTypesFlag,
+			flags.ContinuousProfilingDirFlag,
+			flags.ContinuousProfilingRetentionFlag,
+			flags.ContinuousProfilingPushURLFlag,
 		},
 	},
 	{
@@ -109,13 +123,31 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ExecutionEngineEndpoint,
 			flags.ExecutionEngineHeaders,
 			flags.ExecutionJWTSecretFlag,
+			flags.EngineAPIRecordFile,
+			flags.HeadSnapshotDirFlag,
+			flags.ReconstructHistoricalPayloads,
+			flags.GetPayloadTimeout,
+			flags.GetPayloadSlotOffset,
+			flags.GetPayloadRetryCachedID,
+			flags.ReorgHeadWeightThreshold,
+			flags.ReorgParentWeightThreshold,
+			flags.ReorgMaxEpochsSinceFinalization,
+			flags.ReorgProposerCutoffSeconds,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.BlobBatchLimit,
 			flags.BlobBatchLimitBurstFactor,
+			flags.BlockValidationConcurrency,
+			flags.AttestationValidationConcurrency,
+			flags.BlobValidationConcurrency,
+			flags.PersistCommitteeShuffles,
+			flags.CommitteeCacheSize,
+			flags.ProposerIndicesCacheSize,
+			flags.SyncCommitteeCacheSize,
 			flags.DisableDebugRPCEndpoints,
+			flags.EnableAdminRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
@@ -129,15 +161,51 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.SlasherMaxWorkersFlag,
+			flags.SlasherBackfillStartEpochFlag,
+			flags.SlasherBackfillEndEpochFlag,
+			flags.SlasherPolicyFlag,
+			flags.AlertWebhookURLFlag,
+			flags.AlertWebhookRoutingKeyFlag,
+			flags.AlertEventsFlag,
+			flags.AlertAttestationStreakFlag,
+			flags.AlertFinalityStallEpochsFlag,
+			flags.SafeModeStallEpochsFlag,
+			flags.MaxOptimisticWindowFlag,
+			flags.OptimisticServeDutiesFlag,
+			flags.AlertMinPeersFlag,
+			flags.AlertMinDiskFreePercentFlag,
+			flags.MonitorInclusionCSVFlag,
+			flags.MonitorInclusionInfluxDBURLFlag,
+			flags.MonitorInclusionRemoteWriteURLFlag,
+			flags.CheckpointProvidersFlag,
+			flags.CheckpointProviderQuorumFlag,
+			flags.DiskUsageThresholdFlag,
+			flags.DiskUsagePruneActionsFlag,
+			flags.DiskUsagePruneHorizonFlag,
+			flags.ShutdownTimeoutFlag,
+			flags.BalanceArchiveFlag,
+			flags.BalanceArchiveRetentionEpochsFlag,
+			flags.ExitPoolMaxSizeFlag,
+			flags.SlashingPoolMaxSizeFlag,
+			flags.BLSToExecPoolMaxSizeFlag,
+			flags.OperationsPoolExpiryEpochsFlag,
+			flags.GossipEquivocationPolicyFlag,
+			flags.ClockSkewThresholdFlag,
+			flags.ClockSkewNTPServersFlag,
+			flags.ClockSkewHoldDutiesFlag,
 			flags.LocalBlockValueBoost,
 			flags.MinBuilderBid,
 			flags.MinBuilderDiff,
 			flags.JwtId,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
+			checkpoint.DepositSnapshotPath,
 			checkpoint.RemoteURL,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
+			network.ConfigURL,
+			network.ConfigChecksum,
 			storage.BlobStoragePathFlag,
 			storage.BlobRetentionEpochFlag,
 			backfill.EnableExperimentalBackfill,
@@ -167,6 +235,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
+			cmd.P2PCompression,
+			cmd.P2PLocalCompression,
+			cmd.P2PExperimentalZstd,
 			cmd.PubsubQueueSize,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
@@ -178,6 +249,10 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogFileMaxSizeFlag,
+			cmd.LogFileRotationIntervalFlag,
+			cmd.LogFileMaxBackupsFlag,
+			cmd.LogFileCompressFlag,
 		},
 	},
 	{
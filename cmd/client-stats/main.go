@@ -84,7 +84,7 @@ func main() {
 
 		logFileName := ctx.String(cmd.LogFileName.Name)
 		if logFileName != "" {
-			if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+			if err := logs.ConfigurePersistentLogging(logFileName, 0, 0); err != nil {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
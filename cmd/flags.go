@@ -63,12 +63,18 @@ var (
 		Name:  "tracing-process-name",
 		Usage: "Name to apply to tracing tag `process_name`.",
 	}
-	// TracingEndpointFlag flag defines the http endpoint for serving traces to Jaeger.
+	// TracingEndpointFlag flag defines the endpoint traces are exported to.
 	TracingEndpointFlag = &cli.StringFlag{
 		Name:  "tracing-endpoint",
-		Usage: "Tracing endpoint defines where beacon chain traces are exposed to Jaeger.",
+		Usage: "Tracing endpoint defines where traces are exported to, Jaeger or an OTLP collector depending on --tracing-exporter.",
 		Value: "http://127.0.0.1:14268/api/traces",
 	}
+	// TracingExporterFlag defines the exporter traces are sent with.
+	TracingExporterFlag = &cli.StringFlag{
+		Name:  "tracing-exporter",
+		Usage: "Exporter sending the traces to the tracing endpoint: jaeger, otlp-grpc or otlp-http.",
+		Value: "jaeger",
+	}
 	// TraceSampleFractionFlag defines a flag to indicate what fraction of p2p
 	// messages are sampled for tracing.
 	TraceSampleFractionFlag = &cli.Float64Flag{
@@ -76,12 +82,35 @@ var (
 		Usage: "Indicates what fraction of p2p messages are sampled for tracing.",
 		Value: 0.20,
 	}
+	// TraceFamilySampleFractionsFlag defines the fraction of traces sampled per span family.
+	TraceFamilySampleFractionsFlag = &cli.StringSliceFlag{
+		Name: "trace-family-sample-fractions",
+		Usage: "Fraction of traces sampled for span families, given as span name prefix=fraction pairs, " +
+			"e.g. blockChain.=1.0 to sample all block processing traces. Other traces are sampled with --trace-sample-fraction.",
+	}
 	// MonitoringHostFlag defines the host used to serve prometheus metrics.
 	MonitoringHostFlag = &cli.StringFlag{
 		Name:  "monitoring-host",
 		Usage: "Host used for listening and responding metrics for prometheus.",
 		Value: "127.0.0.1",
 	}
+	// MetricsDisabledFamiliesFlag defines the metric families which are not exported.
+	MetricsDisabledFamiliesFlag = &cli.StringSliceFlag{
+		Name:  "metrics-disabled-families",
+		Usage: "Metric families which are not exported. A trailing * matches all the families with the prefix, e.g. monitor_*.",
+	}
+	// MetricsAggregatedLabelsFlag defines the labels aggregated away from the exported metrics.
+	MetricsAggregatedLabelsFlag = &cli.StringSliceFlag{
+		Name: "metrics-aggregated-labels",
+		Usage: "Labels removed from the exported metrics, whose series are summed over the values of the label, e.g. validator_index. " +
+			"A label given as family:label is only removed from that family.",
+	}
+	// MetricsMaxSeriesPerFamilyFlag defines the number of series of a metric family past which it is aggregated.
+	MetricsMaxSeriesPerFamilyFlag = &cli.IntFlag{
+		Name: "metrics-max-series-per-family",
+		Usage: "Number of series of a metric family past which its per-validator, per-peer and per-endpoint labels are aggregated. " +
+			"The family is not exported if it still has too many series. 0 means no limit.",
+	}
 	// DisableMonitoringFlag defines a flag to disable the metrics collection.
 	DisableMonitoringFlag = &cli.BoolFlag{
 		Name:  "disable-monitoring",
@@ -186,6 +215,25 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PCompression defines the compression level of the req/resp messages written to the peers.
+	P2PCompression = &cli.StringFlag{
+		Name: "p2p-compression",
+		Usage: "The compression level of the req/resp messages written to the peers: default, none, better or best. " +
+			"The better and best levels save bandwidth at the cost of CPU.",
+		Value: "default",
+	}
+	// P2PLocalCompression defines the compression level of the req/resp messages written to the peers on local networks.
+	P2PLocalCompression = &cli.StringFlag{
+		Name: "p2p-local-compression",
+		Usage: "The compression level of the req/resp messages written to the peers on loopback or private networks, " +
+			"such as none to save CPU when peering locally. Defaults to the level of --p2p-compression.",
+	}
+	// P2PExperimentalZstd enables the experimental zstd compression of the req/resp messages.
+	P2PExperimentalZstd = &cli.BoolFlag{
+		Name: "p2p-experimental-zstd",
+		Usage: "(Experimental) Negotiates the zstd compression of the req/resp messages with the peers supporting the " +
+			"ssz_zstd extension protocol of Prysm, and falls back to snappy with the other peers.",
+	}
 	PubsubQueueSize = &cli.IntFlag{
 		Name:  "pubsub-queue-size",
 		Usage: "The size of the pubsub validation and outbound queue for the node.",
@@ -204,7 +252,7 @@ var (
 	// LogFormat specifies the log output format.
 	LogFormat = &cli.StringFlag{
 		Name:  "log-format",
-		Usage: "Specifies log formatting. Supports: text, json, fluentd, journald.",
+		Usage: "Specifies log formatting. Supports: text, json, json-structured, fluentd, journald. The json-structured format has stable field names, nests the fields of the entries under a fields key, and carries the trace and span IDs of the entries logged within traces.",
 		Value: "text",
 	}
 	// MaxGoroutines specifies the maximum amount of goroutines tolerated, before a status check fails.
@@ -218,6 +266,29 @@ var (
 		Name:  "log-file",
 		Usage: "Specifies log file name, relative or absolute.",
 	}
+	// LogFileMaxSizeFlag specifies the size at which the log file is rotated.
+	LogFileMaxSizeFlag = &cli.Uint64Flag{
+		Name:  "log-file-max-size",
+		Usage: "Rotates the log file once it grows beyond this size in megabytes. The log file is not rotated by size if zero.",
+		Value: 0,
+	}
+	// LogFileRotationIntervalFlag specifies the interval at which the log file is rotated.
+	LogFileRotationIntervalFlag = &cli.DurationFlag{
+		Name:  "log-file-rotation-interval",
+		Usage: "Rotates the log file once it has been written to for this duration, e.g. 24h. The log file is not rotated by time if zero.",
+		Value: 0,
+	}
+	// LogFileMaxBackupsFlag specifies the number of rotated log files which are kept.
+	LogFileMaxBackupsFlag = &cli.IntFlag{
+		Name:  "log-file-max-backups",
+		Usage: "Number of rotated log files to keep, the oldest ones being deleted. With 0, the log file is truncated instead of rotated.",
+		Value: 10,
+	}
+	// LogFileCompressFlag specifies whether rotated log files are compressed.
+	LogFileCompressFlag = &cli.BoolFlag{
+		Name:  "log-file-compress",
+		Usage: "Compresses the rotated log files with gzip.",
+	}
 	// EnableUPnPFlag specifies if UPnP should be enabled or not. The default value is false.
 	EnableUPnPFlag = &cli.BoolFlag{
 		Name:  "enable-upnp",
@@ -228,6 +299,19 @@ var (
 		Name:  "config-file",
 		Usage: "Filepath to a yaml file with flag values.",
 	}
+	// ProfileFlag specifies the name of the profile to load flag values from.
+	ProfileFlag = &cli.StringFlag{
+		Name: "profile",
+		Usage: "Name of a profile of --profiles-file bundling the network, data directory and flag values of an " +
+			"instance, e.g. holesky-1, to run several instances on one machine. Flags set on the command line " +
+			"take precedence over the profile, which takes precedence over --config-file.",
+	}
+	// ProfilesFileFlag specifies the filepath of the profiles.
+	ProfilesFileFlag = &cli.StringFlag{
+		Name:  "profiles-file",
+		Usage: "Path to a YAML file with named profiles.",
+		Value: DefaultProfilesFile(),
+	}
 	// ChainConfigFileFlag specifies the filepath to load flag values.
 	ChainConfigFileFlag = &cli.StringFlag{
 		Name:  "chain-config-file",
@@ -251,8 +335,9 @@ var (
 	// ValidatorMonitorIndicesFlag specifies a list of validator indices to
 	// track for performance updates
 	ValidatorMonitorIndicesFlag = &cli.IntSliceFlag{
-		Name:  "monitor-indices",
-		Usage: "List of validator indices to track performance",
+		Name: "monitor-indices",
+		Usage: "List of validator indices to track performance. More validators can be tracked at runtime " +
+			"through the /prysm/v1/validators/monitor endpoint, which persists them in the data directory.",
 	}
 
 	// RestoreSourceFileFlag specifies the filepath to the backed-up database file
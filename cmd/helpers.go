@@ -9,6 +9,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -102,3 +104,28 @@ func ExpandSingleEndpointIfFile(ctx *cli.Context, flag *cli.StringFlag) error {
 	}
 	return nil
 }
+
+// TracingOptions returns the tracing setup options given by the tracing flags.
+func TracingOptions(ctx *cli.Context) ([]tracing.Option, error) {
+	fractions, err := tracing.ParseFamilySampleFractions(ctx.StringSlice(TraceFamilySampleFractionsFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	return []tracing.Option{
+		tracing.WithExporter(ctx.String(TracingExporterFlag.Name)),
+		tracing.WithFamilySampleFractions(fractions),
+	}, nil
+}
+
+// ConfigurePersistentLogging writes the logs to the log file as well, rotating it as set by the log file flags.
+func ConfigurePersistentLogging(ctx *cli.Context, logFileName string) error {
+	var opts []logs.RotateOption
+	if interval := ctx.Duration(LogFileRotationIntervalFlag.Name); interval > 0 {
+		opts = append(opts, logs.WithRotationInterval(interval))
+	}
+	if ctx.Bool(LogFileCompressFlag.Name) {
+		opts = append(opts, logs.WithCompression())
+	}
+	maxSize := int64(ctx.Uint64(LogFileMaxSizeFlag.Name)) * 1024 * 1024
+	return logs.ConfigurePersistentLogging(logFileName, maxSize, ctx.Int(LogFileMaxBackupsFlag.Name), opts...)
+}
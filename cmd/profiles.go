@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/urfave/cli/v2"
+	"github.com/urfave/cli/v2/altsrc"
+	"gopkg.in/yaml.v2"
+)
+
+// ProfilesFileName is the name of the profiles file in the default data directory.
+const ProfilesFileName = "profiles.yaml"
+
+// ProfileNetworks are the networks a profile can run on, named after their flags.
+var ProfileNetworks = []string{"mainnet", "holesky", "sepolia"}
+
+// Profile bundles the network, data directory and flag values of an instance, so that several instances can be run
+// on one machine with consistent settings. A profile is used by both the beacon node and the validator client, each
+// ignoring the flags it does not define.
+type Profile struct {
+	Name    string                      `yaml:"-"`
+	Network string                      `yaml:"network"`
+	DataDir string                      `yaml:"datadir"`
+	Flags   map[interface{}]interface{} `yaml:"flags"`
+}
+
+type profilesFile struct {
+	Profiles map[string]*Profile `yaml:"profiles"`
+}
+
+// DefaultProfilesFile returns the path of the profiles file in the default data directory.
+func DefaultProfilesFile() string {
+	return filepath.Join(DefaultDataDir(), ProfilesFileName)
+}
+
+// LoadProfiles reads the profiles of a file, sorted by name.
+//
+// Example file:
+//
+//	profiles:
+//	  holesky-1:
+//	    network: holesky
+//	    datadir: /data/holesky-1
+//	    flags:
+//	      p2p-tcp-port: 13000
+//	      http-port: 3500
+func LoadProfiles(path string) ([]*Profile, error) {
+	path, err := file.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read profiles file %s", path)
+	}
+	f := &profilesFile{}
+	if err := yaml.UnmarshalStrict(b, f); err != nil {
+		return nil, errors.Wrapf(err, "could not parse profiles file %s", path)
+	}
+	profiles := make([]*Profile, 0, len(f.Profiles))
+	for name, p := range f.Profiles {
+		if p == nil {
+			p = &Profile{}
+		}
+		p.Name = name
+		if p.Network != "" && !slices.Contains(ProfileNetworks, p.Network) {
+			return nil, fmt.Errorf("profile %s has unknown network %s, expected one of %s", name, p.Network, strings.Join(ProfileNetworks, ", "))
+		}
+		profiles = append(profiles, p)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	return profiles, nil
+}
+
+// LoadProfile reads the profile with the given name from a file.
+func LoadProfile(path, name string) (*Profile, error) {
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("no profile %s in profiles file %s", name, path)
+}
+
+// Values returns the flag values of the profile, including its network and data directory.
+func (p *Profile) Values() map[interface{}]interface{} {
+	values := make(map[interface{}]interface{}, len(p.Flags)+2)
+	for k, v := range p.Flags {
+		values[fmt.Sprint(k)] = v
+	}
+	if p.Network != "" {
+		values[p.Network] = true
+	}
+	if p.DataDir != "" {
+		values[DataDirFlag.Name] = p.DataDir
+	}
+	return values
+}
+
+// ProfileConflicts returns a description of each data directory and port shared by several profiles, as instances
+// running on the same machine cannot share them.
+func ProfileConflicts(profiles []*Profile) []string {
+	used := make(map[string][]string)
+	var keys []string
+	// use records that a profile uses a data directory or a port, once per profile.
+	use := func(key, name string) {
+		names, ok := used[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		if len(names) > 0 && names[len(names)-1] == name {
+			return
+		}
+		used[key] = append(names, name)
+	}
+	for _, p := range profiles {
+		dataDir := p.DataDir
+		if dataDir == "" {
+			dataDir = DefaultDataDir()
+		}
+		use(fmt.Sprintf("data directory %s", filepath.Clean(dataDir)), p.Name)
+		for k, v := range p.Values() {
+			if flag := fmt.Sprint(k); strings.HasSuffix(flag, "port") {
+				use(fmt.Sprintf("port %v", v), p.Name)
+			}
+		}
+	}
+	var conflicts []string
+	for _, k := range keys {
+		if names := used[k]; len(names) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("%s is used by profiles %s", k, strings.Join(names, ", ")))
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+
+// LoadFlagsFromProfile sets flags values from a profile if ProfileFlag is set. Flags set on the command line are not
+// overridden.
+func LoadFlagsFromProfile(cliCtx *cli.Context, flags []cli.Flag) error {
+	if !cliCtx.IsSet(ProfileFlag.Name) {
+		return nil
+	}
+	path := cliCtx.String(ProfilesFileFlag.Name)
+	p, err := LoadProfile(path, cliCtx.String(ProfileFlag.Name))
+	if err != nil {
+		return err
+	}
+	source := func(*cli.Context) (altsrc.InputSourceContext, error) {
+		return altsrc.NewMapInputSource(path, p.Values()), nil
+	}
+	if err := altsrc.InitInputSourceWithContext(flags, source)(cliCtx); err != nil {
+		return errors.Wrapf(err, "could not load profile %s", p.Name)
+	}
+	log.WithField("profile", p.Name).Info("Loaded flags from profile")
+	return nil
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+const testProfiles = `profiles:
+  holesky-1:
+    network: holesky
+    datadir: /data/holesky-1
+    flags:
+      testflag: 100
+      http-port: 3500
+  holesky-2:
+    network: holesky
+    datadir: /data/holesky-2
+    flags:
+      testflag: 200
+      http-port: 3500
+`
+
+func TestLoadProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ProfilesFileName)
+	require.NoError(t, os.WriteFile(path, []byte(testProfiles), 0600))
+
+	profiles, err := LoadProfiles(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(profiles))
+	assert.Equal(t, "holesky-1", profiles[0].Name)
+	assert.Equal(t, "holesky-2", profiles[1].Name)
+	values := profiles[0].Values()
+	assert.Equal(t, true, values["holesky"])
+	assert.Equal(t, "/data/holesky-1", values[DataDirFlag.Name])
+	assert.Equal(t, 100, values["testflag"])
+
+	assert.DeepEqual(t, []string{"port 3500 is used by profiles holesky-1, holesky-2"}, ProfileConflicts(profiles))
+
+	_, err = LoadProfile(path, "holesky-3")
+	assert.ErrorContains(t, "no profile holesky-3", err)
+
+	require.NoError(t, os.WriteFile(path, []byte("profiles:\n  foo:\n    network: bar\n"), 0600))
+	_, err = LoadProfiles(path)
+	assert.ErrorContains(t, "unknown network bar", err)
+}
+
+func TestLoadFlagsFromProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ProfilesFileName)
+	require.NoError(t, os.WriteFile(path, []byte(testProfiles), 0600))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	context := cli.NewContext(&app, set, nil)
+	require.NoError(t, set.Parse([]string{
+		"test-command",
+		"--" + ProfileFlag.Name, "holesky-2",
+		"--" + ProfilesFileFlag.Name, path,
+		"--http-port", "3600",
+	}))
+	comFlags := WrapFlags([]cli.Flag{
+		ProfileFlag,
+		ProfilesFileFlag,
+		DataDirFlag,
+		&cli.BoolFlag{Name: "holesky"},
+		&cli.IntFlag{Name: "testflag"},
+		&cli.IntFlag{Name: "http-port"},
+	})
+	command := &cli.Command{
+		Name:  "test-command",
+		Flags: comFlags,
+		Before: func(cliCtx *cli.Context) error {
+			return LoadFlagsFromProfile(cliCtx, comFlags)
+		},
+		Action: func(cliCtx *cli.Context) error {
+			assert.Equal(t, true, cliCtx.Bool("holesky"))
+			assert.Equal(t, "/data/holesky-2", cliCtx.String(DataDirFlag.Name))
+			assert.Equal(t, 200, cliCtx.Int("testflag"))
+			// Flags set on the command line take precedence over the profile.
+			assert.Equal(t, 3600, cliCtx.Int("http-port"))
+			return nil
+		},
+	}
+	require.NoError(t, command.Run(context, context.Args().Slice()...))
+}
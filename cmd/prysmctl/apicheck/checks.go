@@ -0,0 +1,191 @@
+package apicheck
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// unknownBlockRoot is a block root no node knows of, used to check the not found responses.
+var unknownBlockRoot = "0x" + strings.Repeat("ab", 32)
+
+// check is a request to the Beacon API, and the response expected from a compliant node.
+type check struct {
+	name string
+	// path is the path of the request, relative to the path of the node URL, with its query if any.
+	path string
+	// statuses are the accepted status codes, 200 when empty.
+	statuses []int
+	// ssz requests the SSZ encoding of the response instead of JSON.
+	ssz bool
+	// schema is the type of the JSON response, of which the response must have every field not marked omitempty.
+	// A nil schema only checks the status code.
+	schema interface{}
+	// versioned returns, for the consensus version of the response, the type of its versioned part: the SSZ type of
+	// the whole response, or the JSON type of the value at versionedPath.
+	versioned     func(v int) interface{}
+	versionedPath []string
+	// stateDownload marks the checks downloading a full state, which only run when requested.
+	stateDownload bool
+}
+
+func (c *check) encoding() string {
+	if c.ssz {
+		return "ssz"
+	}
+	return "json"
+}
+
+func (c *check) accepts(status int) bool {
+	if len(c.statuses) == 0 {
+		return status == http.StatusOK
+	}
+	for _, s := range c.statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// checks covers the standard Beacon API endpoints which can be queried without knowing anything about the node.
+var checks = []*check{
+	{name: "genesis", path: "/eth/v1/beacon/genesis", schema: &structs.GetGenesisResponse{}},
+	{name: "state root", path: "/eth/v1/beacon/states/head/root", schema: &structs.GetStateRootResponse{}},
+	{name: "state fork", path: "/eth/v1/beacon/states/head/fork", schema: &structs.GetStateForkResponse{}},
+	{name: "finality checkpoints", path: "/eth/v1/beacon/states/head/finality_checkpoints", schema: &structs.GetFinalityCheckpointsResponse{}},
+	{name: "validators", path: "/eth/v1/beacon/states/head/validators?id=0", schema: &structs.GetValidatorsResponse{}},
+	{name: "validator balances", path: "/eth/v1/beacon/states/head/validator_balances?id=0", schema: &structs.GetValidatorBalancesResponse{}},
+	{name: "committees", path: "/eth/v1/beacon/states/head/committees?index=0", schema: &structs.GetCommitteesResponse{}},
+	{name: "randao", path: "/eth/v1/beacon/states/head/randao", schema: &structs.GetRandaoResponse{}},
+	{name: "block header", path: "/eth/v1/beacon/headers/head", schema: &structs.GetBlockHeaderResponse{}},
+	{
+		name:          "block",
+		path:          "/eth/v2/beacon/blocks/head",
+		schema:        &structs.GetBlockV2Response{},
+		versioned:     jsonBlock,
+		versionedPath: []string{"data", "message"},
+	},
+	{name: "block root", path: "/eth/v1/beacon/blocks/head/root", schema: &structs.BlockRootResponse{}},
+	{name: "block attestations", path: "/eth/v2/beacon/blocks/head/attestations", schema: &structs.GetBlockAttestationsV2Response{}},
+	{name: "proposer slashings pool", path: "/eth/v1/beacon/pool/proposer_slashings", schema: &structs.GetProposerSlashingsResponse{}},
+	{name: "voluntary exits pool", path: "/eth/v1/beacon/pool/voluntary_exits", schema: &structs.ListVoluntaryExitsResponse{}},
+	{name: "spec", path: "/eth/v1/config/spec", schema: &structs.GetSpecResponse{}},
+	{name: "fork schedule", path: "/eth/v1/config/fork_schedule", schema: &structs.GetForkScheduleResponse{}},
+	{name: "deposit contract", path: "/eth/v1/config/deposit_contract", schema: &structs.GetDepositContractResponse{}},
+	{name: "node version", path: "/eth/v1/node/version", schema: &structs.GetVersionResponse{}},
+	{name: "node syncing", path: "/eth/v1/node/syncing", schema: &structs.SyncStatusResponse{}},
+	{name: "node identity", path: "/eth/v1/node/identity", schema: &structs.GetIdentityResponse{}},
+	{name: "node peer count", path: "/eth/v1/node/peer_count", schema: &structs.GetPeerCountResponse{}},
+	{name: "node health", path: "/eth/v1/node/health", statuses: []int{http.StatusOK, http.StatusPartialContent}},
+	{
+		name:     "invalid state id",
+		path:     "/eth/v1/beacon/states/invalid/root",
+		statuses: []int{http.StatusBadRequest},
+		schema:   &httputil.DefaultJsonError{},
+	},
+	{
+		name:     "unknown block",
+		path:     "/eth/v1/beacon/blocks/" + unknownBlockRoot + "/root",
+		statuses: []int{http.StatusNotFound},
+		schema:   &httputil.DefaultJsonError{},
+	},
+	{name: "block", path: "/eth/v2/beacon/blocks/head", ssz: true, versioned: sszBlock},
+	{
+		name:          "state",
+		path:          "/eth/v2/debug/beacon/states/head",
+		schema:        &structs.GetBeaconStateV2Response{},
+		versioned:     jsonState,
+		versionedPath: []string{"data"},
+		stateDownload: true,
+	},
+	{name: "state", path: "/eth/v2/debug/beacon/states/head", ssz: true, versioned: sszState, stateDownload: true},
+}
+
+// selectChecks returns the checks to run, with or without the SSZ responses and the state downloads.
+func selectChecks(ssz, state bool) []*check {
+	selected := make([]*check, 0, len(checks))
+	for _, c := range checks {
+		if (c.ssz && !ssz) || (c.stateDownload && !state) {
+			continue
+		}
+		selected = append(selected, c)
+	}
+	return selected
+}
+
+func jsonBlock(v int) interface{} {
+	switch v {
+	case version.Phase0:
+		return &structs.BeaconBlock{}
+	case version.Altair:
+		return &structs.BeaconBlockAltair{}
+	case version.Bellatrix:
+		return &structs.BeaconBlockBellatrix{}
+	case version.Capella:
+		return &structs.BeaconBlockCapella{}
+	case version.Deneb:
+		return &structs.BeaconBlockDeneb{}
+	case version.Electra:
+		return &structs.BeaconBlockElectra{}
+	}
+	return nil
+}
+
+func jsonState(v int) interface{} {
+	switch v {
+	case version.Phase0:
+		return &structs.BeaconState{}
+	case version.Altair:
+		return &structs.BeaconStateAltair{}
+	case version.Bellatrix:
+		return &structs.BeaconStateBellatrix{}
+	case version.Capella:
+		return &structs.BeaconStateCapella{}
+	case version.Deneb:
+		return &structs.BeaconStateDeneb{}
+	case version.Electra:
+		return &structs.BeaconStateElectra{}
+	}
+	return nil
+}
+
+func sszBlock(v int) interface{} {
+	switch v {
+	case version.Phase0:
+		return &ethpb.SignedBeaconBlock{}
+	case version.Altair:
+		return &ethpb.SignedBeaconBlockAltair{}
+	case version.Bellatrix:
+		return &ethpb.SignedBeaconBlockBellatrix{}
+	case version.Capella:
+		return &ethpb.SignedBeaconBlockCapella{}
+	case version.Deneb:
+		return &ethpb.SignedBeaconBlockDeneb{}
+	case version.Electra:
+		return &ethpb.SignedBeaconBlockElectra{}
+	}
+	return nil
+}
+
+func sszState(v int) interface{} {
+	switch v {
+	case version.Phase0:
+		return &ethpb.BeaconState{}
+	case version.Altair:
+		return &ethpb.BeaconStateAltair{}
+	case version.Bellatrix:
+		return &ethpb.BeaconStateBellatrix{}
+	case version.Capella:
+		return &ethpb.BeaconStateCapella{}
+	case version.Deneb:
+		return &ethpb.BeaconStateDeneb{}
+	case version.Electra:
+		return &ethpb.BeaconStateElectra{}
+	}
+	return nil
+}
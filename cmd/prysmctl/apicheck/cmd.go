@@ -0,0 +1,93 @@
+package apicheck
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var apiCheckFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	SkipSSZ        bool
+	CheckState     bool
+}{}
+
+var Commands = []*cli.Command{
+	{
+		Name: "apicheck",
+		Usage: "Run a suite of requests covering the standard Beacon API against a running beacon node, e.g. behind " +
+			"a proxy, and verify the status codes and response schemas of the JSON and SSZ responses",
+		Action: func(cliCtx *cli.Context) error {
+			if err := cliActionAPICheck(cliCtx); err != nil {
+				log.WithError(err).Fatal("Beacon API check failed")
+			}
+			return nil
+		},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "beacon-node-host",
+				Usage:       "host:port or URL of the beacon node API to check, including the path prefix of a proxy if any",
+				Destination: &apiCheckFlags.BeaconNodeHost,
+				Value:       "http://localhost:3500",
+			},
+			&cli.DurationFlag{
+				Name:        "http-timeout",
+				Usage:       "timeout for each http request made to the beacon node (uses duration format, ex: 2m31s). default: 1m",
+				Destination: &apiCheckFlags.Timeout,
+				Value:       time.Minute,
+			},
+			&cli.BoolFlag{
+				Name:        "skip-ssz",
+				Usage:       "only check the JSON responses, for deployments which do not serve SSZ",
+				Destination: &apiCheckFlags.SkipSSZ,
+			},
+			&cli.BoolFlag{
+				Name:        "check-state",
+				Usage:       "also download the head state, in JSON and SSZ, which may take a while on mainnet",
+				Destination: &apiCheckFlags.CheckState,
+			},
+		},
+	},
+}
+
+func cliActionAPICheck(_ *cli.Context) error {
+	ctx := context.Background()
+	f := apiCheckFlags
+
+	c, err := client.NewClient(f.BeaconNodeHost, client.WithTimeout(f.Timeout))
+	if err != nil {
+		return err
+	}
+	results := run(ctx, c, selectChecks(!f.SkipSSZ, f.CheckState))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	failed := 0
+	for _, r := range results {
+		status := "PASS"
+		detail := ""
+		if r.err != nil {
+			status = "FAIL"
+			detail = r.err.Error()
+			failed++
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", status, r.check.name, r.check.encoding(), r.duration.Round(time.Millisecond), detail); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return errors.Errorf("%d of %d checks failed against %s", failed, len(results), c.NodeURL())
+	}
+	log.WithField("checks", len(results)).Infof("All checks passed against %s", c.NodeURL())
+	return nil
+}
@@ -0,0 +1,221 @@
+package apicheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxErrBodyLength is the length of the response body shown when the status code is unexpected.
+const maxErrBodyLength = 256
+
+type result struct {
+	check    *check
+	duration time.Duration
+	err      error
+}
+
+type sszUnmarshaler interface {
+	UnmarshalSSZ([]byte) error
+}
+
+// run runs the checks one after the other against the node.
+func run(ctx context.Context, c *client.Client, checks []*check) []*result {
+	results := make([]*result, len(checks))
+	for i, ch := range checks {
+		start := time.Now()
+		err := execute(ctx, c, ch)
+		results[i] = &result{check: ch, duration: time.Since(start), err: err}
+	}
+	return results
+}
+
+// execute sends the request of the check, and verifies the status code, headers and body of the response.
+func execute(ctx context.Context, c *client.Client, ch *check) error {
+	ref, err := url.Parse(ch.path)
+	if err != nil {
+		return errors.Wrap(err, "invalid path")
+	}
+	// The path is joined to the path of the node URL rather than resolved, to keep the prefix of a proxy.
+	u := *c.BaseURL()
+	u.Path = strings.TrimSuffix(u.Path, "/") + ref.Path
+	u.RawQuery = ref.RawQuery
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	mediaType := api.JsonMediaType
+	if ch.ssz {
+		mediaType = api.OctetStreamMediaType
+	}
+	req.Header.Set("Accept", mediaType)
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, client.MaxBodySizeState))
+	if err != nil {
+		return errors.Wrap(err, "could not read response body")
+	}
+	if !ch.accepts(resp.StatusCode) {
+		if len(body) > maxErrBodyLength {
+			body = body[:maxErrBodyLength]
+		}
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if ch.schema == nil && ch.versioned == nil {
+		return nil
+	}
+	if ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err != nil || ct != mediaType {
+		return fmt.Errorf("unexpected content type %q, expected %s", resp.Header.Get("Content-Type"), mediaType)
+	}
+	if ch.ssz {
+		return verifySSZ(resp.Header.Get(api.VersionHeader), body, ch.versioned)
+	}
+	if err := verifySchema(body, ch.schema); err != nil {
+		return err
+	}
+	if ch.versioned == nil {
+		return nil
+	}
+	return verifyVersioned(resp.Header.Get(api.VersionHeader), body, ch)
+}
+
+// verifySSZ verifies that the SSZ response decodes as the type of the consensus version of its header.
+func verifySSZ(header string, body []byte, versioned func(int) interface{}) error {
+	if header == "" {
+		return fmt.Errorf("missing %s header", api.VersionHeader)
+	}
+	v, err := version.FromString(header)
+	if err != nil {
+		return errors.Wrapf(err, "invalid %s header", api.VersionHeader)
+	}
+	u, ok := versioned(v).(sszUnmarshaler)
+	if !ok {
+		return fmt.Errorf("no SSZ type for version %s", header)
+	}
+	if err := u.UnmarshalSSZ(body); err != nil {
+		return errors.Wrapf(err, "could not decode %s SSZ response", header)
+	}
+	return nil
+}
+
+// verifyVersioned verifies the versioned part of a JSON response against the type of its consensus version, which
+// must match the version header when the node sets it.
+func verifyVersioned(header string, body []byte, ch *check) error {
+	var resp struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return errors.Wrap(err, "could not decode version")
+	}
+	if header != "" && header != resp.Version {
+		return fmt.Errorf("%s header %q does not match the version %q of the response", api.VersionHeader, header, resp.Version)
+	}
+	v, err := version.FromString(resp.Version)
+	if err != nil {
+		return errors.Wrap(err, "invalid version")
+	}
+	schema := ch.versioned(v)
+	if schema == nil {
+		return fmt.Errorf("no schema for version %s", resp.Version)
+	}
+	raw := json.RawMessage(body)
+	for _, key := range ch.versionedPath {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return errors.Wrapf(err, "could not decode %s", key)
+		}
+		raw = obj[key]
+	}
+	return errors.Wrap(verifySchema(raw, schema), strings.Join(ch.versionedPath, "."))
+}
+
+// verifySchema verifies that the JSON value decodes into the schema, and has every field of the schema which is not
+// marked omitempty.
+func verifySchema(b []byte, schema interface{}) error {
+	typ := reflect.TypeOf(schema).Elem()
+	if err := json.Unmarshal(b, reflect.New(typ).Interface()); err != nil {
+		return errors.Wrap(err, "could not decode response")
+	}
+	return requiredFields(b, typ, "")
+}
+
+func requiredFields(raw json.RawMessage, typ reflect.Type, path string) error {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return fmt.Errorf("%s is not an object", fieldName(path))
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			key, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if key == "-" {
+				continue
+			}
+			if key == "" {
+				key = f.Name
+			}
+			value, ok := obj[key]
+			if !ok {
+				if strings.Contains(opts, "omitempty") {
+					continue
+				}
+				return fmt.Errorf("missing field %s", fieldName(path+"."+key))
+			}
+			if string(value) == "null" {
+				continue
+			}
+			if err := requiredFields(value, f.Type, path+"."+key); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		// Byte slices are raw JSON values or base64 strings, whose content is not checked.
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return fmt.Errorf("%s is not an array", fieldName(path))
+		}
+		for i, item := range items {
+			if err := requiredFields(item, typ.Elem(), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func fieldName(path string) string {
+	if path == "" {
+		return "response"
+	}
+	return strings.TrimPrefix(path, ".")
+}
@@ -0,0 +1,81 @@
+package apicheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestVerifySchema(t *testing.T) {
+	require.NoError(t, verifySchema([]byte(`{"data":{"genesis_time":"1","genesis_validators_root":"0x00","genesis_fork_version":"0x00"}}`), &structs.GetGenesisResponse{}))
+	err := verifySchema([]byte(`{"data":{"genesis_time":"1","genesis_fork_version":"0x00"}}`), &structs.GetGenesisResponse{})
+	require.ErrorContains(t, "missing field data.genesis_validators_root", err)
+	err = verifySchema([]byte(`{"data":[{"version":"0x00"}]}`), &structs.GetForkScheduleResponse{})
+	require.ErrorContains(t, "missing field data[0].previous_version", err)
+	err = verifySchema([]byte(`{"data":"1"}`), &structs.GetGenesisResponse{})
+	require.ErrorContains(t, "could not decode response", err)
+}
+
+func TestRun(t *testing.T) {
+	blk, err := util.NewBeaconBlockDeneb().MarshalSSZ()
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/proxy/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", api.JsonMediaType)
+		_, err := w.Write([]byte(`{"data":{"genesis_time":"1","genesis_validators_root":"0x00","genesis_fork_version":"0x00"}}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/proxy/eth/v1/node/health", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusPartialContent)
+	})
+	mux.HandleFunc("/proxy/eth/v2/beacon/blocks/head", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, api.OctetStreamMediaType, r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", api.OctetStreamMediaType)
+		w.Header().Set(api.VersionHeader, "deneb")
+		_, err := w.Write(blk)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/proxy/eth/v1/node/version", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, err := w.Write([]byte(`{"data":{"version":"Prysm"}}`))
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c, err := client.NewClient(srv.URL + "/proxy")
+	require.NoError(t, err)
+
+	byName := make(map[string]*check)
+	for _, ch := range checks {
+		byName[ch.name+"/"+ch.encoding()] = ch
+	}
+	results := run(context.Background(), c, []*check{
+		byName["genesis/json"],
+		byName["node health/json"],
+		byName["block/ssz"],
+		byName["node version/json"],
+		byName["node syncing/json"],
+	})
+	require.Equal(t, 5, len(results))
+	require.NoError(t, results[0].err)
+	require.NoError(t, results[1].err)
+	require.NoError(t, results[2].err)
+	assert.ErrorContains(t, "unexpected content type", results[3].err)
+	assert.ErrorContains(t, "unexpected status code 404", results[4].err)
+}
+
+func TestSelectChecks(t *testing.T) {
+	for _, c := range selectChecks(false, false) {
+		assert.Equal(t, false, c.ssz)
+		assert.Equal(t, false, c.stateDownload)
+	}
+	assert.Equal(t, len(checks), len(selectChecks(true, true)))
+}
@@ -0,0 +1,100 @@
+package benchmark
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/urfave/cli/v2"
+)
+
+var Commands = []*cli.Command{
+	{
+		Name: "benchmark",
+		Usage: "benchmark state transition, epoch processing and hashing against real states and blocks, read from " +
+			"SSZ files or from a beacon db",
+		Subcommands: []*cli.Command{
+			stateTransitionCmd,
+			epochProcessingCmd,
+			hashingCmd,
+		},
+	},
+}
+
+var benchmarkFlags = struct {
+	StatePath        string
+	BlockPaths       cli.StringSlice
+	DBPath           string
+	Slot             uint64
+	NumBlocks        uint64
+	Iterations       uint64
+	VerifySignatures bool
+	Format           string
+}{}
+
+var (
+	stateFlag = &cli.StringFlag{
+		Name:        "state",
+		Usage:       "path to the SSZ file of the state to benchmark against",
+		Destination: &benchmarkFlags.StatePath,
+	}
+	blocksFlag = &cli.StringSliceFlag{
+		Name:        "block",
+		Usage:       "path to the SSZ file of a block to apply on top of the state, can be repeated",
+		Destination: &benchmarkFlags.BlockPaths,
+	}
+	dbPathFlag = &cli.StringFlag{
+		Name: "db-path",
+		Usage: "path to directory containing beaconchain.db, to read the state and blocks from instead of files. " +
+			"The beacon node using the db must be stopped.",
+		Destination: &benchmarkFlags.DBPath,
+	}
+	slotFlag = &cli.Uint64Flag{
+		Name:        "slot",
+		Usage:       "with --db-path, use the state saved for the latest block at or before this slot",
+		Destination: &benchmarkFlags.Slot,
+	}
+	numBlocksFlag = &cli.Uint64Flag{
+		Name:        "num-blocks",
+		Usage:       "with --db-path, number of blocks following the state to apply",
+		Destination: &benchmarkFlags.NumBlocks,
+		Value:       32,
+	}
+	iterationsFlag = &cli.Uint64Flag{
+		Name:        "iterations",
+		Usage:       "number of times each benchmark is run",
+		Destination: &benchmarkFlags.Iterations,
+		Value:       5,
+	}
+	formatFlag = &cli.StringFlag{
+		Name:        "format",
+		Usage:       "format of the report, text or json",
+		Destination: &benchmarkFlags.Format,
+		Value:       formatText,
+	}
+)
+
+// inputFlags are the flags shared by every benchmark, which select the input data and the network it belongs to.
+var inputFlags = []cli.Flag{
+	stateFlag,
+	dbPathFlag,
+	slotFlag,
+	iterationsFlag,
+	formatFlag,
+	cmd.ChainConfigFileFlag,
+	features.Mainnet,
+	features.SepoliaTestnet,
+	features.HoleskyTestnet,
+}
+
+func configureNetwork(cliCtx *cli.Context) error {
+	if err := features.ValidateNetworkFlags(cliCtx); err != nil {
+		return err
+	}
+	if err := features.ConfigureBeaconChain(cliCtx); err != nil {
+		return err
+	}
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		return params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name), nil)
+	}
+	return nil
+}
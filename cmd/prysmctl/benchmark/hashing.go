@@ -0,0 +1,80 @@
+package benchmark
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var hashingCmd = &cli.Command{
+	Name: "hashing",
+	Usage: "compute the hash tree root of a state without any cached intermediate roots, and of blocks, along with " +
+		"the SSZ encoding and decoding of the state, e.g. prysmctl benchmark hashing --state state.ssz",
+	Before: configureNetwork,
+	Action: func(cliCtx *cli.Context) error {
+		if err := hashingAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not run hashing benchmark")
+		}
+		return nil
+	},
+	Flags: append([]cli.Flag{blocksFlag, numBlocksFlag}, inputFlags...),
+}
+
+func hashingAction(_ *cli.Context) error {
+	ctx := context.Background()
+	in, err := loadInputs(ctx, len(benchmarkFlags.BlockPaths.Value()) > 0 || benchmarkFlags.DBPath != "")
+	if err != nil {
+		return err
+	}
+
+	var marshal, unmarshal, stateRoot []time.Duration
+	for i := uint64(0); i < benchmarkFlags.Iterations; i++ {
+		start := time.Now()
+		enc, err := in.state.MarshalSSZ()
+		if err != nil {
+			return errors.Wrap(err, "could not marshal state")
+		}
+		marshal = append(marshal, time.Since(start))
+
+		vu, err := detect.FromState(enc)
+		if err != nil {
+			return errors.Wrap(err, "could not detect chain config of state")
+		}
+		start = time.Now()
+		// A freshly decoded state has no cached field roots, so its hash tree root is computed from scratch.
+		st, err := vu.UnmarshalBeaconState(enc)
+		if err != nil {
+			return errors.Wrap(err, "could not unmarshal state")
+		}
+		unmarshal = append(unmarshal, time.Since(start))
+
+		start = time.Now()
+		if _, err := st.HashTreeRoot(ctx); err != nil {
+			return errors.Wrap(err, "could not compute state root")
+		}
+		stateRoot = append(stateRoot, time.Since(start))
+	}
+
+	var blockRoot []time.Duration
+	for i := uint64(0); i < benchmarkFlags.Iterations; i++ {
+		for _, blk := range in.blocks {
+			start := time.Now()
+			if _, err := blk.Block().HashTreeRoot(); err != nil {
+				return errors.Wrap(err, "could not compute block root")
+			}
+			blockRoot = append(blockRoot, time.Since(start))
+		}
+	}
+
+	r := newReport(in.state)
+	r.add("state_marshal_ssz", marshal)
+	r.add("state_unmarshal_ssz", unmarshal)
+	r.add("state_hash_tree_root", stateRoot)
+	r.add("block_hash_tree_root", blockRoot)
+	return r.write(os.Stdout, benchmarkFlags.Format)
+}
@@ -0,0 +1,165 @@
+package benchmark
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	log "github.com/sirupsen/logrus"
+)
+
+// inputs are the state and blocks a benchmark runs against.
+type inputs struct {
+	state  state.BeaconState
+	blocks []interfaces.ReadOnlySignedBeaconBlock
+}
+
+// loadInputs reads the state, and the blocks if withBlocks is set, from files or from the db.
+func loadInputs(ctx context.Context, withBlocks bool) (*inputs, error) {
+	f := benchmarkFlags
+	if (f.StatePath == "") == (f.DBPath == "") {
+		return nil, errors.New("exactly one of --state or --db-path must be given")
+	}
+	if f.StatePath != "" {
+		st, err := readState(f.StatePath)
+		if err != nil {
+			return nil, err
+		}
+		in := &inputs{state: st}
+		if withBlocks {
+			if in.blocks, err = readBlocks(f.BlockPaths.Value()); err != nil {
+				return nil, err
+			}
+		}
+		return in, nil
+	}
+
+	db, err := kv.NewKVStore(ctx, f.DBPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open db")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	st, err := dbState(ctx, db, primitives.Slot(f.Slot))
+	if err != nil {
+		return nil, err
+	}
+	in := &inputs{state: st}
+	if withBlocks {
+		if in.blocks, err = dbBlocks(ctx, db, st, f.NumBlocks); err != nil {
+			return nil, err
+		}
+	}
+	return in, nil
+}
+
+func readState(path string) (state.BeaconState, error) {
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state file")
+	}
+	vu, err := detect.FromState(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect chain config of state")
+	}
+	return vu.UnmarshalBeaconState(b)
+}
+
+// readBlocks reads the block files and sorts the blocks by slot, so that they can be applied in order.
+func readBlocks(paths []string) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(paths))
+	for _, p := range paths {
+		b, err := os.ReadFile(filepath.Clean(p))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read block file %s", p)
+		}
+		vu, err := detect.FromBlock(b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not detect chain config of block %s", p)
+		}
+		blk, err := vu.UnmarshalBeaconBlock(b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal block %s", p)
+		}
+		blks = append(blks, blk)
+	}
+	sort.Slice(blks, func(i, j int) bool { return blks[i].Block().Slot() < blks[j].Block().Slot() })
+	return blks, nil
+}
+
+// dbState returns the state saved for the latest block at or before the slot.
+func dbState(ctx context.Context, db *kv.Store, slot primitives.Slot) (state.BeaconState, error) {
+	for above := slot + 1; above > 0; {
+		s, roots, err := db.HighestRootsBelowSlot(ctx, above)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get blocks below slot %d", above)
+		}
+		for _, r := range roots {
+			if db.HasState(ctx, r) {
+				return db.State(ctx, r)
+			}
+		}
+		if len(roots) == 0 || s == 0 {
+			break
+		}
+		above = s
+	}
+	return nil, errors.Errorf("no state saved at or before slot %d, prysmctl db replay-states can save one", slot)
+}
+
+// dbBlocks follows the chain of the state in the db and returns up to n of the blocks which descend from it.
+// When a block has several children, the finalized one is preferred.
+func dbBlocks(ctx context.Context, db *kv.Store, st state.BeaconState, n uint64) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	root, err := latestBlockRoot(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	for uint64(len(blks)) < n {
+		children, roots, err := db.Blocks(ctx, filters.NewFilter().SetParentRoot(root[:]))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get child blocks")
+		}
+		if len(children) == 0 {
+			break
+		}
+		next := 0
+		for i := range roots {
+			if db.IsFinalizedBlock(ctx, roots[i]) {
+				next = i
+				break
+			}
+		}
+		blks = append(blks, children[next])
+		root = roots[next]
+	}
+	if len(blks) == 0 {
+		return nil, errors.Errorf("no block descending from the state at slot %d in the db", st.Slot())
+	}
+	return blks, nil
+}
+
+// latestBlockRoot returns the root of the latest block applied to the state.
+func latestBlockRoot(ctx context.Context, st state.BeaconState) ([32]byte, error) {
+	h := st.LatestBlockHeader()
+	if bytesutil.ZeroRoot(h.StateRoot) {
+		sr, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		h.StateRoot = sr[:]
+	}
+	return h.HashTreeRoot()
+}
@@ -0,0 +1,120 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+// report holds the results of a benchmark run along with what is needed to compare it with other runs: the
+// machine, the build and the input state.
+type report struct {
+	Version    string    `json:"version"`
+	GoVersion  string    `json:"go_version"`
+	OS         string    `json:"os"`
+	Arch       string    `json:"arch"`
+	CPUs       int       `json:"cpus"`
+	Network    string    `json:"network"`
+	Slot       uint64    `json:"slot"`
+	Fork       string    `json:"fork"`
+	Validators int       `json:"validators"`
+	Results    []*result `json:"results"`
+}
+
+// result summarizes the samples of a single benchmark. Durations are in nanoseconds in the json report.
+type result struct {
+	Name    string        `json:"name"`
+	Samples int           `json:"samples"`
+	Mean    time.Duration `json:"mean"`
+	Min     time.Duration `json:"min"`
+	P50     time.Duration `json:"p50"`
+	P99     time.Duration `json:"p99"`
+	Max     time.Duration `json:"max"`
+}
+
+func newReport(st state.ReadOnlyBeaconState) *report {
+	return &report{
+		Version:    version.Version(),
+		GoVersion:  runtime.Version(),
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		CPUs:       runtime.NumCPU(),
+		Network:    params.BeaconConfig().ConfigName,
+		Slot:       uint64(st.Slot()),
+		Fork:       version.String(st.Version()),
+		Validators: st.NumValidators(),
+	}
+}
+
+func (r *report) add(name string, samples []time.Duration) {
+	if len(samples) == 0 {
+		return
+	}
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var total time.Duration
+	for _, s := range sorted {
+		total += s
+	}
+	r.Results = append(r.Results, &result{
+		Name:    name,
+		Samples: len(sorted),
+		Mean:    total / time.Duration(len(sorted)),
+		Min:     sorted[0],
+		P50:     percentile(sorted, 50),
+		P99:     percentile(sorted, 99),
+		Max:     sorted[len(sorted)-1],
+	})
+}
+
+// percentile returns the nearest-rank percentile of the sorted samples.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func (r *report) write(w io.Writer, format string) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	case formatText:
+		if _, err := fmt.Fprintf(w, "%s, %s, %s/%s, %d CPUs\n", r.Version, r.GoVersion, r.OS, r.Arch, r.CPUs); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s state at slot %d, fork %s, %d validators\n\n", r.Network, r.Slot, r.Fork, r.Validators); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%-28s %8s %12s %12s %12s %12s %12s\n", "benchmark", "samples", "mean", "min", "p50", "p99", "max"); err != nil {
+			return err
+		}
+		for _, res := range r.Results {
+			if _, err := fmt.Fprintf(w, "%-28s %8d %12s %12s %12s %12s %12s\n", res.Name, res.Samples,
+				res.Mean.Round(time.Microsecond), res.Min.Round(time.Microsecond), res.P50.Round(time.Microsecond),
+				res.P99.Round(time.Microsecond), res.Max.Round(time.Microsecond)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unknown report format %s", format)
+	}
+}
@@ -0,0 +1,52 @@
+package benchmark
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestReport(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 16)
+	r := newReport(st)
+	assert.Equal(t, 16, r.Validators)
+	assert.Equal(t, "phase0", r.Fork)
+
+	samples := make([]time.Duration, 0, 100)
+	for i := 100; i > 0; i-- {
+		samples = append(samples, time.Duration(i)*time.Millisecond)
+	}
+	r.add("bench", samples)
+	r.add("empty", nil)
+	require.Equal(t, 1, len(r.Results))
+	res := r.Results[0]
+	assert.Equal(t, 100, res.Samples)
+	assert.Equal(t, 1*time.Millisecond, res.Min)
+	assert.Equal(t, 100*time.Millisecond, res.Max)
+	assert.Equal(t, 50*time.Millisecond, res.P50)
+	assert.Equal(t, 99*time.Millisecond, res.P99)
+	assert.Equal(t, 50500*time.Microsecond, res.Mean)
+
+	var buf bytes.Buffer
+	require.NoError(t, r.write(&buf, formatJSON))
+	decoded := &report{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), decoded))
+	assert.DeepEqual(t, r, decoded)
+
+	buf.Reset()
+	require.NoError(t, r.write(&buf, formatText))
+	assert.StringContains(t, "bench", buf.String())
+	require.ErrorContains(t, "unknown report format", r.write(&buf, "csv"))
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3}
+	assert.Equal(t, time.Duration(1), percentile(sorted, 0))
+	assert.Equal(t, time.Duration(2), percentile(sorted, 50))
+	assert.Equal(t, time.Duration(3), percentile(sorted, 99))
+}
@@ -0,0 +1,130 @@
+package benchmark
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var stateTransitionCmd = &cli.Command{
+	Name: "state-transition",
+	Usage: "apply blocks on top of a state, e.g. prysmctl benchmark state-transition --state state.ssz " +
+		"--block block_1.ssz --block block_2.ssz",
+	Before: configureNetwork,
+	Action: func(cliCtx *cli.Context) error {
+		if err := stateTransitionAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not run state transition benchmark")
+		}
+		return nil
+	},
+	Flags: append([]cli.Flag{
+		blocksFlag,
+		numBlocksFlag,
+		&cli.BoolFlag{
+			Name:        "verify-signatures",
+			Usage:       "also verify the signatures of the blocks, as when processing blocks from the network",
+			Destination: &benchmarkFlags.VerifySignatures,
+		},
+	}, inputFlags...),
+}
+
+var epochProcessingCmd = &cli.Command{
+	Name:   "epoch-processing",
+	Usage:  "process the epoch transition following a state, e.g. prysmctl benchmark epoch-processing --state state.ssz",
+	Before: configureNetwork,
+	Action: func(cliCtx *cli.Context) error {
+		if err := epochProcessingAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not run epoch processing benchmark")
+		}
+		return nil
+	},
+	Flags: inputFlags,
+}
+
+func stateTransitionAction(_ *cli.Context) error {
+	ctx := context.Background()
+	in, err := loadInputs(ctx, true)
+	if err != nil {
+		return err
+	}
+	if len(in.blocks) == 0 {
+		return errors.New("no blocks to apply, use --block or --db-path")
+	}
+	disableCaches()
+
+	var perBlock, perRun []time.Duration
+	for i := uint64(0); i < benchmarkFlags.Iterations; i++ {
+		st := in.state.Copy()
+		helpers.ClearCache()
+		var run time.Duration
+		for _, blk := range in.blocks {
+			start := time.Now()
+			if benchmarkFlags.VerifySignatures {
+				st, err = transition.ExecuteStateTransition(ctx, st, blk)
+			} else {
+				_, st, err = transition.ExecuteStateTransitionNoVerifyAnySig(ctx, st, blk)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "could not apply block at slot %d", blk.Block().Slot())
+			}
+			d := time.Since(start)
+			perBlock = append(perBlock, d)
+			run += d
+		}
+		perRun = append(perRun, run)
+	}
+
+	r := newReport(in.state)
+	r.add("state_transition_block", perBlock)
+	r.add("state_transition_all_blocks", perRun)
+	return r.write(os.Stdout, benchmarkFlags.Format)
+}
+
+func epochProcessingAction(_ *cli.Context) error {
+	ctx := context.Background()
+	in, err := loadInputs(ctx, false)
+	if err != nil {
+		return err
+	}
+	disableCaches()
+
+	// The state is advanced to the last slot of its epoch outside of the measurement, so that only the slot
+	// crossing the epoch boundary, which runs the epoch processing, is measured.
+	boundary, err := slots.EpochStart(slots.ToEpoch(in.state.Slot()) + 1)
+	if err != nil {
+		return err
+	}
+	base := in.state
+	if base.Slot()+1 < boundary {
+		if base, err = transition.ProcessSlots(ctx, base.Copy(), boundary-1); err != nil {
+			return errors.Wrap(err, "could not advance state to the end of its epoch")
+		}
+	}
+
+	var samples []time.Duration
+	for i := uint64(0); i < benchmarkFlags.Iterations; i++ {
+		st := base.Copy()
+		helpers.ClearCache()
+		start := time.Now()
+		if _, err := transition.ProcessSlots(ctx, st, boundary); err != nil {
+			return errors.Wrap(err, "could not process epoch")
+		}
+		samples = append(samples, time.Since(start))
+	}
+
+	r := newReport(in.state)
+	r.add("epoch_processing", samples)
+	return r.write(os.Stdout, benchmarkFlags.Format)
+}
+
+// disableCaches turns off the caches which would let later iterations reuse the work of the earlier ones.
+func disableCaches() {
+	transition.SkipSlotCache.Disable()
+}
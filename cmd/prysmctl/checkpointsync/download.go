@@ -2,11 +2,14 @@ package checkpointsync
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"path"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/api/client"
 	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -19,7 +22,7 @@ var downloadFlags = struct {
 var downloadCmd = &cli.Command{
 	Name:    "download",
 	Aliases: []string{"dl"},
-	Usage:   "Download the latest finalized state and the most recent block it integrates. To be used for checkpoint sync.",
+	Usage:   "Download the latest finalized state, the most recent block it integrates and the deposit snapshot. To be used for checkpoint sync.",
 	Action: func(cliCtx *cli.Context) error {
 		if err := cliActionDownload(cliCtx); err != nil {
 			log.WithError(err).Fatal("Could not download checkpoint-sync data")
@@ -74,5 +77,20 @@ func cliActionDownload(_ *cli.Context) error {
 	}
 	log.Printf("saved ssz-encoded state to %s", statePath)
 
+	snapshot, err := client.GetDepositSnapshot(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not download deposit snapshot, skipping")
+		return nil
+	}
+	sb, err := snapshot.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	snapshotPath := path.Join(cwd, fmt.Sprintf("deposit_snapshot_%d.ssz", snapshot.DepositCount))
+	if err := file.WriteFile(snapshotPath, sb); err != nil {
+		return err
+	}
+	log.Printf("saved ssz-encoded deposit snapshot to %s", snapshotPath)
+
 	return nil
 }
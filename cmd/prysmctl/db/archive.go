@@ -0,0 +1,215 @@
+package db
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	log "github.com/sirupsen/logrus"
+)
+
+// Exported objects are written one per file, with names that describe them so that the files of a directory or
+// archive can be imported without any other metadata.
+const (
+	blockFilePrefix        = "block_"
+	blindedBlockFilePrefix = "blinded_block_"
+	blobFilePrefix         = "blob_"
+	sszFileExt             = ".ssz"
+)
+
+func blockFileName(slot primitives.Slot, root [32]byte, blinded bool) string {
+	prefix := blockFilePrefix
+	if blinded {
+		prefix = blindedBlockFilePrefix
+	}
+	return fmt.Sprintf("%s%d_%#x%s", prefix, slot, root, sszFileExt)
+}
+
+func blobFileName(slot primitives.Slot, root [32]byte, index uint64) string {
+	return fmt.Sprintf("%s%d_%#x_%d%s", blobFilePrefix, slot, root, index, sszFileExt)
+}
+
+// sszFileSlot parses the slot of an exported file name, so that the files can be imported in slot order.
+func sszFileSlot(name string) (primitives.Slot, error) {
+	for _, prefix := range []string{blindedBlockFilePrefix, blockFilePrefix, blobFilePrefix} {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		s, _, _ := strings.Cut(strings.TrimPrefix(name, prefix), "_")
+		slot, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not parse slot of %s", name)
+		}
+		return primitives.Slot(slot), nil
+	}
+	return 0, errors.Errorf("%s is not an exported block or blob", name)
+}
+
+// sszSink receives the exported files, either into a directory or into a gzipped tar archive.
+type sszSink interface {
+	put(name string, data []byte) error
+	Close() error
+}
+
+func newSSZSink(dir, archive string) (sszSink, error) {
+	if (dir == "") == (archive == "") {
+		return nil, errors.New("exactly one of an output directory or an archive must be given")
+	}
+	if dir != "" {
+		if err := file.MkdirAll(dir); err != nil {
+			return nil, err
+		}
+		return &dirSink{dir: dir}, nil
+	}
+	f, err := os.OpenFile(filepath.Clean(archive), os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create archive")
+	}
+	gz := gzip.NewWriter(f)
+	return &tarSink{f: f, gz: gz, tw: tar.NewWriter(gz)}, nil
+}
+
+type dirSink struct {
+	dir string
+}
+
+func (s *dirSink) put(name string, data []byte) error {
+	return file.WriteFile(filepath.Join(s.dir, name), data)
+}
+
+func (*dirSink) Close() error {
+	return nil
+}
+
+type tarSink struct {
+	f  *os.File
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func (s *tarSink) put(name string, data []byte) error {
+	if err := s.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    int64(params.BeaconIoConfig().ReadWritePermissions),
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err := s.tw.Write(data)
+	return err
+}
+
+func (s *tarSink) Close() error {
+	if err := s.tw.Close(); err != nil {
+		return err
+	}
+	if err := s.gz.Close(); err != nil {
+		return err
+	}
+	return s.f.Close()
+}
+
+// readSSZFiles calls fn for every exported file of the directory or gzipped tar archive at source. Files which
+// were not exported by prysmctl are skipped.
+func readSSZFiles(source string, fn func(name string, data []byte) error) error {
+	isDir, err := file.HasDir(source)
+	if err != nil {
+		return err
+	}
+	if isDir {
+		return readSSZDir(source, fn)
+	}
+	return readSSZArchive(source, fn)
+}
+
+func readSSZDir(dir string, fn func(name string, data []byte) error) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	type entry struct {
+		name string
+		slot primitives.Slot
+	}
+	files := make([]entry, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != sszFileExt {
+			continue
+		}
+		slot, err := sszFileSlot(e.Name())
+		if err != nil {
+			log.WithError(err).Warn("Skipping file")
+			continue
+		}
+		files = append(files, entry{name: e.Name(), slot: slot})
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		if files[i].slot != files[j].slot {
+			return files[i].slot < files[j].slot
+		}
+		return files[i].name < files[j].name
+	})
+	for _, f := range files {
+		data, err := file.ReadFileAsBytes(filepath.Join(dir, f.name))
+		if err != nil {
+			return err
+		}
+		if err := fn(f.name, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSSZArchive(archive string, fn func(name string, data []byte) error) error {
+	f, err := os.Open(filepath.Clean(archive))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close archive")
+		}
+	}()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return errors.Wrap(err, "could not read gzipped archive")
+	}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not read archive")
+		}
+		name := filepath.Base(h.Name)
+		if h.Typeflag != tar.TypeReg || filepath.Ext(name) != sszFileExt {
+			continue
+		}
+		if _, err := sszFileSlot(name); err != nil {
+			log.WithError(err).Warn("Skipping file")
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return errors.Wrapf(err, "could not read %s from archive", name)
+		}
+		if err := fn(name, data); err != nil {
+			return err
+		}
+	}
+}
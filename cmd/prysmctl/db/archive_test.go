@@ -0,0 +1,69 @@
+package db
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSSZFileSlot(t *testing.T) {
+	root := [32]byte{'a'}
+	for name, want := range map[string]primitives.Slot{
+		blockFileName(12, root, false): 12,
+		blockFileName(13, root, true):  13,
+		blobFileName(14, root, 3):      14,
+	} {
+		slot, err := sszFileSlot(name)
+		require.NoError(t, err)
+		require.Equal(t, want, slot)
+	}
+	_, err := sszFileSlot("state_12.ssz")
+	require.ErrorContains(t, "not an exported block or blob", err)
+}
+
+func TestSSZSink_RoundTrip(t *testing.T) {
+	root := [32]byte{'a'}
+	files := []string{
+		blockFileName(9, root, false),
+		blobFileName(9, root, 0),
+		blockFileName(10, root, true),
+		blockFileName(100, root, false),
+	}
+	write := func(t *testing.T, dir, archive string) {
+		sink, err := newSSZSink(dir, archive)
+		require.NoError(t, err)
+		for _, name := range files {
+			require.NoError(t, sink.put(name, []byte(name)))
+		}
+		require.NoError(t, sink.Close())
+	}
+	read := func(t *testing.T, source string) []string {
+		var names []string
+		require.NoError(t, readSSZFiles(source, func(name string, data []byte) error {
+			require.Equal(t, name, string(data))
+			names = append(names, name)
+			return nil
+		}))
+		return names
+	}
+
+	t.Run("directory", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "export")
+		write(t, dir, "")
+		names := read(t, dir)
+		require.Equal(t, len(files), len(names))
+		// Files are read in slot order, even though slot 100 sorts before slot 9 by name.
+		require.Equal(t, files[3], names[3])
+	})
+	t.Run("archive", func(t *testing.T) {
+		archive := filepath.Join(t.TempDir(), "export.tar.gz")
+		write(t, "", archive)
+		require.DeepEqual(t, files, read(t, archive))
+	})
+	t.Run("no destination", func(t *testing.T) {
+		_, err := newSSZSink("", "")
+		require.ErrorContains(t, "exactly one", err)
+	})
+}
@@ -0,0 +1,386 @@
+package db
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// importBatchSize is the number of blocks saved to the db in a single transaction during an import.
+const importBatchSize = 64
+
+var exportBlocksFlags = struct {
+	Path      string
+	BlobPath  string
+	StartSlot uint64
+	EndSlot   uint64
+	Output    string
+	Archive   string
+}{}
+
+var importBlocksFlags = struct {
+	Path     string
+	BlobPath string
+	Input    string
+}{}
+
+var exportBlocksCmd = &cli.Command{
+	Name: "export-blocks",
+	Usage: "export the canonical blocks of a slot range, and their blobs, from a beacon db to SSZ files in a " +
+		"directory or in a gzipped tar archive. The beacon node using the db must be stopped.",
+	Before: configureNetwork,
+	Action: func(cliCtx *cli.Context) error {
+		if err := exportBlocksAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export blocks")
+		}
+		return nil
+	},
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &exportBlocksFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "blob-path",
+			Usage:       "path to the blob storage directory of the node, to export the blobs of the blocks",
+			Destination: &exportBlocksFlags.BlobPath,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "first slot of the exported range",
+			Destination: &exportBlocksFlags.StartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "last slot of the exported range",
+			Destination: &exportBlocksFlags.EndSlot,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "directory to write one SSZ file per block and blob to",
+			Destination: &exportBlocksFlags.Output,
+		},
+		&cli.StringFlag{
+			Name:        "archive",
+			Usage:       "gzipped tar archive to write the SSZ files to, instead of a directory",
+			Destination: &exportBlocksFlags.Archive,
+		},
+	}, networkFlags...),
+}
+
+var importBlocksCmd = &cli.Command{
+	Name: "import-blocks",
+	Usage: "import blocks and blobs exported with export-blocks into a beacon db. Blob inclusion proofs are " +
+		"checked, block signatures are not. The beacon node using the db must be stopped.",
+	Before: configureNetwork,
+	Action: func(cliCtx *cli.Context) error {
+		if err := importBlocksAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import blocks")
+		}
+		return nil
+	},
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &importBlocksFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "blob-path",
+			Usage:       "path to the blob storage directory of the node, to import blobs",
+			Destination: &importBlocksFlags.BlobPath,
+		},
+		&cli.StringFlag{
+			Name:        "input",
+			Usage:       "directory or gzipped tar archive written by export-blocks",
+			Destination: &importBlocksFlags.Input,
+			Required:    true,
+		},
+	}, networkFlags...),
+}
+
+func exportBlocksAction(_ *cli.Context) error {
+	flags := exportBlocksFlags
+	start, end := primitives.Slot(flags.StartSlot), primitives.Slot(flags.EndSlot)
+	if start > end {
+		return errors.Errorf("start slot %d is after end slot %d", start, end)
+	}
+	ctx := context.Background()
+	db, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	var bs *filesystem.BlobStorage
+	if flags.BlobPath != "" {
+		bs, err = filesystem.NewBlobStorage(filesystem.WithBasePath(flags.BlobPath))
+		if err != nil {
+			return err
+		}
+	}
+
+	blks, roots, err := canonicalBlocks(ctx, db, start, end)
+	if err != nil {
+		return err
+	}
+	sink, err := newSSZSink(flags.Output, flags.Archive)
+	if err != nil {
+		return err
+	}
+	var exportedBlobs int
+	for i, blk := range blks {
+		slot := blk.Block().Slot()
+		enc, err := blk.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal block at slot %d", slot)
+		}
+		if err := sink.put(blockFileName(slot, roots[i], blk.IsBlinded()), enc); err != nil {
+			return errors.Wrapf(err, "could not write block at slot %d", slot)
+		}
+		if bs == nil || blk.Version() < version.Deneb {
+			continue
+		}
+		n, err := exportBlobs(sink, bs, slot, roots[i])
+		if err != nil {
+			return err
+		}
+		exportedBlobs += n
+	}
+	if err := sink.Close(); err != nil {
+		return errors.Wrap(err, "could not close export")
+	}
+	log.WithFields(log.Fields{
+		"blocks": len(blks),
+		"blobs":  exportedBlobs,
+	}).Info("Exported blocks")
+	return nil
+}
+
+func exportBlobs(sink sszSink, bs *filesystem.BlobStorage, slot primitives.Slot, root [32]byte) (int, error) {
+	indices, err := bs.Indices(root)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not get blob indices of block at slot %d", slot)
+	}
+	var n int
+	for idx, ok := range indices {
+		if !ok {
+			continue
+		}
+		sc, err := bs.Get(root, uint64(idx))
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not get blob %d of block at slot %d", idx, slot)
+		}
+		enc, err := sc.MarshalSSZ()
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not marshal blob %d of block at slot %d", idx, slot)
+		}
+		if err := sink.put(blobFileName(slot, root, uint64(idx)), enc); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// canonicalBlocks returns the canonical blocks of the slot range in slot order, with their roots. Without fork
+// choice, the blocks up to the finalized checkpoint are the ones in the finalized index of the db, and the later
+// ones are the ancestors of the head block saved by the node.
+func canonicalBlocks(ctx context.Context, db *kv.Store, start, end primitives.Slot) ([]interfaces.ReadOnlySignedBeaconBlock, [][32]byte, error) {
+	var finalizedSlot primitives.Slot
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	fBlk, err := db.Block(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get finalized block")
+	}
+	if fBlk != nil && !fBlk.IsNil() {
+		finalizedSlot = fBlk.Block().Slot()
+	}
+
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	var roots [][32]byte
+	if start <= finalizedSlot {
+		f := filters.NewFilter().SetStartSlot(start).SetEndSlot(min(end, finalizedSlot))
+		fBlks, fRoots, err := db.Blocks(ctx, f)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get finalized blocks")
+		}
+		for i := range fBlks {
+			if db.IsFinalizedBlock(ctx, fRoots[i]) {
+				blks = append(blks, fBlks[i])
+				roots = append(roots, fRoots[i])
+			}
+		}
+	}
+	if end > finalizedSlot {
+		hBlks, hRoots, err := headAncestors(ctx, db, max(start, finalizedSlot+1), end)
+		if err != nil {
+			return nil, nil, err
+		}
+		blks = append(blks, hBlks...)
+		roots = append(roots, hRoots...)
+	}
+
+	idx := make([]int, len(blks))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return blks[idx[i]].Block().Slot() < blks[idx[j]].Block().Slot() })
+	sortedBlks := make([]interfaces.ReadOnlySignedBeaconBlock, len(blks))
+	sortedRoots := make([][32]byte, len(roots))
+	for i, j := range idx {
+		sortedBlks[i], sortedRoots[i] = blks[j], roots[j]
+	}
+	return sortedBlks, sortedRoots, nil
+}
+
+// headAncestors walks back from the head block of the db and returns its ancestors within the slot range.
+func headAncestors(ctx context.Context, db *kv.Store, start, end primitives.Slot) ([]interfaces.ReadOnlySignedBeaconBlock, [][32]byte, error) {
+	blk, err := db.HeadBlock(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get head block")
+	}
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	var roots [][32]byte
+	for blk != nil && !blk.IsNil() && blk.Block().Slot() >= start {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+		if blk.Block().Slot() <= end {
+			root, err := blk.Block().HashTreeRoot()
+			if err != nil {
+				return nil, nil, err
+			}
+			blks = append(blks, blk)
+			roots = append(roots, root)
+		}
+		if blk.Block().Slot() == 0 {
+			break
+		}
+		blk, err = db.Block(ctx, blk.Block().ParentRoot())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get parent block")
+		}
+	}
+	return blks, roots, nil
+}
+
+func importBlocksAction(_ *cli.Context) error {
+	flags := importBlocksFlags
+	ctx := context.Background()
+	db, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	var bs *filesystem.BlobStorage
+	if flags.BlobPath != "" {
+		bs, err = filesystem.NewBlobStorage(filesystem.WithBasePath(flags.BlobPath))
+		if err != nil {
+			return err
+		}
+	}
+
+	var importedBlocks, importedBlobs, skippedBlobs int
+	batch := make([]interfaces.ReadOnlySignedBeaconBlock, 0, importBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := db.SaveBlocks(ctx, batch); err != nil {
+			return errors.Wrap(err, "could not save blocks")
+		}
+		importedBlocks += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+	if err := readSSZFiles(flags.Input, func(name string, data []byte) error {
+		if strings.HasPrefix(name, blobFilePrefix) {
+			if bs == nil {
+				skippedBlobs++
+				return nil
+			}
+			if err := importBlob(bs, data); err != nil {
+				return errors.Wrapf(err, "could not import %s", name)
+			}
+			importedBlobs++
+			return nil
+		}
+		blk, err := decodeBlock(data, strings.HasPrefix(name, blindedBlockFilePrefix))
+		if err != nil {
+			return errors.Wrapf(err, "could not import %s", name)
+		}
+		batch = append(batch, blk)
+		if len(batch) == importBatchSize {
+			return flush()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if skippedBlobs > 0 {
+		log.WithField("blobs", skippedBlobs).Warn("Skipped blobs, use --blob-path to import them")
+	}
+	log.WithFields(log.Fields{
+		"blocks": importedBlocks,
+		"blobs":  importedBlobs,
+	}).Info("Imported blocks")
+	return nil
+}
+
+func decodeBlock(data []byte, blinded bool) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	unmarshaler, err := detect.FromBlock(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect block version")
+	}
+	if blinded {
+		return unmarshaler.UnmarshalBlindedBeaconBlock(data)
+	}
+	return unmarshaler.UnmarshalBeaconBlock(data)
+}
+
+func importBlob(bs *filesystem.BlobStorage, data []byte) error {
+	sc := &ethpb.BlobSidecar{}
+	if err := sc.UnmarshalSSZ(data); err != nil {
+		return errors.Wrap(err, "could not unmarshal blob")
+	}
+	ro, err := blocks.NewROBlob(sc)
+	if err != nil {
+		return err
+	}
+	if err := blocks.VerifyKZGInclusionProof(ro); err != nil {
+		return errors.Wrap(err, "invalid blob inclusion proof")
+	}
+	return bs.Save(blocks.NewVerifiedROBlob(ro))
+}
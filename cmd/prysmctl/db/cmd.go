@@ -1,6 +1,11 @@
 package db
 
-import "github.com/urfave/cli/v2"
+import (
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/urfave/cli/v2"
+)
 
 var Commands = []*cli.Command{
 	{
@@ -10,6 +15,31 @@ var Commands = []*cli.Command{
 			queryCmd,
 			bucketsCmd,
 			spanCmd,
+			inspectCmd,
+			replayStatesCmd,
+			exportBlocksCmd,
+			importBlocksCmd,
 		},
 	},
 }
+
+// networkFlags select the network of the db, whose fork schedule is needed to decode blocks and replay states.
+var networkFlags = []cli.Flag{
+	cmd.ChainConfigFileFlag,
+	features.Mainnet,
+	features.SepoliaTestnet,
+	features.HoleskyTestnet,
+}
+
+func configureNetwork(cliCtx *cli.Context) error {
+	if err := features.ValidateNetworkFlags(cliCtx); err != nil {
+		return err
+	}
+	if err := features.ConfigureBeaconChain(cliCtx); err != nil {
+		return err
+	}
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		return params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name), nil)
+	}
+	return nil
+}
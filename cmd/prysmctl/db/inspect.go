@@ -0,0 +1,222 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+// maxGapsShown is the number of slot coverage gaps which are printed, starting with the most recent ones.
+const maxGapsShown = 20
+
+var inspectFlags = struct {
+	Path                  string
+	BlobPath              string
+	MinGap                uint64
+	SlotsPerArchivedPoint uint64
+}{}
+
+var inspectCmd = &cli.Command{
+	Name: "inspect",
+	Usage: "open a beacon db read-only and report the sizes of its buckets, gaps in its slot coverage of blocks and " +
+		"blobs and its last archived state",
+	Action: func(cliCtx *cli.Context) error {
+		if err := inspectAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not inspect db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &inspectFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "blob-path",
+			Usage:       "path to the blob storage directory of the node, to report the slot coverage of blobs",
+			Destination: &inspectFlags.BlobPath,
+		},
+		&cli.Uint64Flag{
+			Name:        "min-gap",
+			Usage:       "minimum number of consecutive slots without blocks or blobs which is reported as a gap",
+			Destination: &inspectFlags.MinGap,
+			Value:       uint64(params.BeaconConfig().SlotsPerEpoch),
+		},
+		&cli.Uint64Flag{
+			Name:        "slots-per-archive-point",
+			Usage:       "number of slots between the archived points of the node, to find its last archived state",
+			Destination: &inspectFlags.SlotsPerArchivedPoint,
+			Value:       uint64(params.BeaconConfig().SlotsPerArchivedPoint),
+		},
+	},
+}
+
+func inspectAction(_ *cli.Context) error {
+	flags := inspectFlags
+	db, err := openReadOnly(kv.StoreDatafilePath(flags.Path))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	minGap := primitives.Slot(flags.MinGap)
+
+	stats, err := bucketsStats(db)
+	if err != nil {
+		return err
+	}
+	printBucketsStats(stats)
+
+	blockSlots, err := kv.BlockSlots(db)
+	if err != nil {
+		return errors.Wrap(err, "could not read block slots")
+	}
+	printCoverage("Blocks", blockSlots, minGap)
+
+	if flags.BlobPath != "" {
+		byRoot, err := filesystem.BlobSlots(flags.BlobPath)
+		if err != nil {
+			return errors.Wrap(err, "could not read blob slots")
+		}
+		printCoverage("Blobs", uniqueSlots(byRoot), minGap)
+	}
+
+	slot, root, saved, err := kv.LastArchivedState(db, primitives.Slot(flags.SlotsPerArchivedPoint))
+	if err != nil {
+		return errors.Wrap(err, "could not read last archived state")
+	}
+	fmt.Printf("\nLast archived state: slot %d, block root %#x, state saved: %t\n", slot, root, saved)
+	return nil
+}
+
+func openReadOnly(path string) (*bolt.DB, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.Wrapf(err, "could not find db at %s", path)
+	}
+	db, err := bolt.Open(path, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout:  1 * time.Second,
+		ReadOnly: true,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	return db, nil
+}
+
+// bucketStats describes the contents of a top level bucket of the db.
+type bucketStats struct {
+	name           string
+	keys           int
+	bytes          int
+	largestKey     []byte
+	largestValSize int
+}
+
+// bucketsStats walks every top level bucket of the db, including the buckets no longer used by the node.
+func bucketsStats(db *bolt.DB) ([]*bucketStats, error) {
+	var stats []*bucketStats
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			s := &bucketStats{name: string(name)}
+			if err := b.ForEach(func(k, v []byte) error {
+				s.keys++
+				s.bytes += len(k) + len(v)
+				if s.largestKey == nil || len(v) > s.largestValSize {
+					s.largestKey = append([]byte{}, k...)
+					s.largestValSize = len(v)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			stats = append(stats, s)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read buckets")
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].bytes > stats[j].bytes })
+	return stats, nil
+}
+
+func printBucketsStats(stats []*bucketStats) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"Bucket", "Keys", "Size (bytes)", "Largest value (bytes)", "Largest value key"})
+	for _, s := range stats {
+		key := ""
+		if s.largestKey != nil {
+			key = fmt.Sprintf("%#x", s.largestKey)
+		}
+		t.AppendRow(table.Row{s.name, s.keys, s.bytes, s.largestValSize, key})
+	}
+	t.Render()
+}
+
+// slotGap is a range of slots, bounds included, without any object.
+type slotGap struct {
+	start primitives.Slot
+	end   primitives.Slot
+}
+
+// slotGaps returns the ranges of at least minGap slots between consecutive slots of the sorted slots.
+func slotGaps(slots []primitives.Slot, minGap primitives.Slot) []slotGap {
+	var gaps []slotGap
+	for i := 1; i < len(slots); i++ {
+		missing := slots[i] - slots[i-1] - 1
+		if missing > 0 && missing >= minGap {
+			gaps = append(gaps, slotGap{start: slots[i-1] + 1, end: slots[i] - 1})
+		}
+	}
+	return gaps
+}
+
+func uniqueSlots(byRoot map[[32]byte]primitives.Slot) []primitives.Slot {
+	seen := make(map[primitives.Slot]bool, len(byRoot))
+	slots := make([]primitives.Slot, 0, len(byRoot))
+	for _, s := range byRoot {
+		if !seen[s] {
+			seen[s] = true
+			slots = append(slots, s)
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	return slots
+}
+
+func printCoverage(kind string, slots []primitives.Slot, minGap primitives.Slot) {
+	if len(slots) == 0 {
+		fmt.Printf("\n%s: none\n", kind)
+		return
+	}
+	gaps := slotGaps(slots, minGap)
+	fmt.Printf("\n%s: %d slots between %d and %d, %d gaps of %d slots or more\n",
+		kind, len(slots), slots[0], slots[len(slots)-1], len(gaps), minGap)
+	for i := len(gaps) - 1; i >= 0 && i >= len(gaps)-maxGapsShown; i-- {
+		g := gaps[i]
+		fmt.Printf("  slots %d-%d (%d slots)\n", g.start, g.end, g.end-g.start+1)
+	}
+	if len(gaps) > maxGapsShown {
+		fmt.Printf("  ... and %d older gaps\n", len(gaps)-maxGapsShown)
+	}
+}
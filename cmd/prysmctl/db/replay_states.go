@@ -0,0 +1,176 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var replayStatesFlags = struct {
+	Path      string
+	StartSlot uint64
+	EndSlot   uint64
+	Interval  uint64
+}{}
+
+var replayStatesCmd = &cli.Command{
+	Name: "replay-states",
+	Usage: "replay the finalized blocks of a beacon db to regenerate and save the states at every interval slots of a " +
+		"slot range. States which are already saved are skipped, so the command can be interrupted and resumed. " +
+		"The beacon node using the db must be stopped.",
+	Before: configureNetwork,
+	Action: func(cliCtx *cli.Context) error {
+		if err := replayStatesAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not replay states")
+		}
+		return nil
+	},
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &replayStatesFlags.Path,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "first slot of the range, the states of the slots before it must be reachable by replay",
+			Destination: &replayStatesFlags.StartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "last slot of the range, which is capped to the finalized slot of the db (default: finalized slot)",
+			Destination: &replayStatesFlags.EndSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "interval",
+			Usage:       "number of slots between two saved states (default: slots per archived point of the network)",
+			Destination: &replayStatesFlags.Interval,
+		},
+	}, networkFlags...),
+}
+
+func replayStatesAction(_ *cli.Context) error {
+	flags := replayStatesFlags
+	ctx := context.Background()
+	db, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalized, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return err
+	}
+	start, end := primitives.Slot(flags.StartSlot), primitives.Slot(flags.EndSlot)
+	if end == 0 || end > finalized {
+		end = finalized
+	}
+	interval := primitives.Slot(flags.Interval)
+	if interval == 0 {
+		interval = params.BeaconConfig().SlotsPerArchivedPoint
+	}
+	if start > end {
+		return errors.Errorf("start slot %d is after end slot %d", start, end)
+	}
+	// Start at the first multiple of the interval, so that the saved states line up with the archived points.
+	if rem := start % interval; rem != 0 {
+		start += interval - rem
+	}
+
+	log.WithFields(log.Fields{
+		"startSlot": start,
+		"endSlot":   end,
+		"interval":  interval,
+	}).Info("Replaying states")
+	saved, skipped, err := replayStates(ctx, db, start, end, interval)
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"saved":   saved,
+		"skipped": skipped,
+	}).Info("Finished replaying states")
+	return nil
+}
+
+// replayStates saves the state of the canonical block at every interval slots between start and end. States are
+// keyed by block root, so the state saved for a slot is the post state of its block: for a skipped slot, the state of
+// the latest block before the slot is saved, at the slot of that block.
+func replayStates(ctx context.Context, db *kv.Store, start, end, interval primitives.Slot) (saved, skipped int, err error) {
+	// Only finalized blocks are replayed: without fork choice, the finalized block index of the db is the
+	// only way to tell the canonical chain.
+	history := stategen.NewCanonicalHistory(db, &finalizedChecker{db: db}, &fixedSlotter{slot: end})
+	for slot := start; slot <= end; slot += interval {
+		if ctx.Err() != nil {
+			return saved, skipped, ctx.Err()
+		}
+		root, err := history.BlockRootForSlot(ctx, slot)
+		if err != nil {
+			return saved, skipped, errors.Wrapf(err, "could not get canonical block root for slot %d", slot)
+		}
+		if db.HasState(ctx, root) {
+			skipped++
+			continue
+		}
+		blk, err := db.Block(ctx, root)
+		if err != nil {
+			return saved, skipped, errors.Wrapf(err, "could not get block %#x", root)
+		}
+		if blk == nil || blk.IsNil() {
+			return saved, skipped, errors.Errorf("block %#x not found", root)
+		}
+		replayStart := time.Now()
+		st, err := history.ReplayerForSlot(blk.Block().Slot()).ReplayBlocks(ctx)
+		if err != nil {
+			return saved, skipped, errors.Wrapf(err, "could not replay state at slot %d", blk.Block().Slot())
+		}
+		if err := db.SaveState(ctx, st, root); err != nil {
+			return saved, skipped, errors.Wrapf(err, "could not save state at slot %d", st.Slot())
+		}
+		saved++
+		log.WithFields(log.Fields{
+			"slot":      slot,
+			"stateSlot": st.Slot(),
+			"root":      fmt.Sprintf("%#x", root),
+			"duration":  time.Since(replayStart),
+		}).Info("Saved state")
+	}
+	return saved, skipped, nil
+}
+
+// finalizedChecker considers the finalized blocks of the db as canonical.
+type finalizedChecker struct {
+	db *kv.Store
+}
+
+func (c *finalizedChecker) IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, error) {
+	return c.db.IsFinalizedBlock(ctx, blockRoot), nil
+}
+
+// fixedSlotter reports a fixed slot as the current slot, which bounds the slots that can be replayed.
+type fixedSlotter struct {
+	slot primitives.Slot
+}
+
+func (s *fixedSlotter) CurrentSlot() primitives.Slot {
+	return s.slot
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestReplayStates_SkippedSlot(t *testing.T) {
+	ctx := context.Background()
+	db, err := kv.NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	st, keys := util.DeterministicGenesisState(t, 64)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	util.SaveBlock(t, ctx, db, genesis)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	// Blocks at slots 1 and 3, slot 2 is skipped.
+	var roots [][32]byte
+	for _, slot := range []primitives.Slot{1, 3} {
+		b, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st.Copy(), wsb)
+		require.NoError(t, err)
+		util.SaveBlock(t, ctx, db, b)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: b.Block.Slot, Root: root[:]}))
+		roots = append(roots, root)
+	}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[1][:]}))
+
+	saved, skipped, err := replayStates(ctx, db, 0, 6, 2)
+	require.NoError(t, err)
+	// Slot 0 has the genesis state and slot 6 has the same block as slot 4.
+	require.Equal(t, 2, saved)
+	require.Equal(t, 2, skipped)
+	// The states are saved at the slots of their blocks, not at the skipped slots.
+	for i, slot := range []primitives.Slot{1, 3} {
+		replayed, err := db.State(ctx, roots[i])
+		require.NoError(t, err)
+		require.Equal(t, slot, replayed.Slot())
+	}
+}
@@ -0,0 +1,15 @@
+package debug
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "debug",
+		Usage: "commands for inspecting the internal state of a running beacon node",
+		Subcommands: []*cli.Command{
+			forkChoiceCmd,
+			headSnapshotCmd,
+			logLevelCmd,
+		},
+	},
+}
@@ -0,0 +1,105 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	forkChoiceJSONPath = "/eth/v1/debug/fork_choice"
+	forkChoiceDOTPath  = "/prysm/v1/debug/fork_choice/dot"
+)
+
+var forkChoiceFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	Format         string
+	Output         string
+}{}
+
+var forkChoiceCmd = &cli.Command{
+	Name:    "fork-choice",
+	Aliases: []string{"fc"},
+	Usage:   "Dump the fork choice tree of a beacon node as Graphviz DOT or JSON, e.g. prysmctl debug fc | dot -Tsvg > fc.svg",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionForkChoice(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not dump fork choice")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &forkChoiceFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 1m",
+			Destination: &forkChoiceFlags.Timeout,
+			Value:       time.Minute,
+		},
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "output format, either dot or json",
+			Destination: &forkChoiceFlags.Format,
+			Value:       "dot",
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file to write the dump to, the dump is written to stdout if unset",
+			Destination: &forkChoiceFlags.Output,
+		},
+	},
+}
+
+func cliActionForkChoice(_ *cli.Context) error {
+	ctx := context.Background()
+	f := forkChoiceFlags
+
+	c, err := client.NewClient(f.BeaconNodeHost, client.WithTimeout(f.Timeout), client.WithMaxBodySize(client.MaxBodySizeState))
+	if err != nil {
+		return err
+	}
+	var out []byte
+	switch f.Format {
+	case "dot":
+		out, err = c.Get(ctx, forkChoiceDOTPath)
+		if err != nil {
+			return errors.Wrap(err, "could not get fork choice graph")
+		}
+	case "json":
+		b, err := c.Get(ctx, forkChoiceJSONPath)
+		if err != nil {
+			return errors.Wrap(err, "could not get fork choice dump")
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, b, "", "  "); err != nil {
+			return errors.Wrap(err, "could not format fork choice dump")
+		}
+		out = append(buf.Bytes(), '\n')
+	default:
+		return fmt.Errorf("unsupported format %q, must be dot or json", f.Format)
+	}
+
+	if f.Output == "" {
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+	if err := file.WriteFile(f.Output, out); err != nil {
+		return errors.Wrapf(err, "could not write fork choice dump to %s", f.Output)
+	}
+	log.WithField("path", f.Output).Info("Wrote fork choice dump")
+	return nil
+}
@@ -0,0 +1,77 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const headSnapshotPath = "/prysm/v1/debug/head/snapshot"
+
+var headSnapshotFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+}{}
+
+var headSnapshotCmd = &cli.Command{
+	Name: "head-snapshot",
+	Usage: "Ask a beacon node to write its current head block and state to SSZ files, in a new directory of its " +
+		"--head-snapshot-dir, e.g. to start identical devnet replicas or to debug an issue with the head offline",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionHeadSnapshot(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not snapshot head")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &headSnapshotFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 1m",
+			Destination: &headSnapshotFlags.Timeout,
+			Value:       time.Minute,
+		},
+	},
+}
+
+func cliActionHeadSnapshot(_ *cli.Context) error {
+	ctx := context.Background()
+	f := headSnapshotFlags
+
+	c, err := client.NewClient(f.BeaconNodeHost, client.WithTimeout(f.Timeout))
+	if err != nil {
+		return err
+	}
+	b, err := post(ctx, c, headSnapshotPath, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not snapshot head")
+	}
+	resp := &structs.HeadSnapshotResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return errors.Wrap(err, "could not decode response")
+	}
+	if resp.Data == nil {
+		return errors.New("empty response")
+	}
+	d := resp.Data
+	log.WithFields(log.Fields{
+		"version":   d.Version,
+		"slot":      d.Slot,
+		"blockRoot": d.BlockRoot,
+		"stateRoot": d.StateRoot,
+	}).Info("Wrote head snapshot on the beacon node")
+	fmt.Printf("%s\n%s\n", d.BlockFile, d.StateFile)
+	return nil
+}
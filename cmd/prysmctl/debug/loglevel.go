@@ -0,0 +1,144 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const logLevelsPath = "/prysm/v1/node/log_levels"
+
+var logLevelFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	Level          string
+	Modules        *cli.StringSlice
+}{
+	Modules: cli.NewStringSlice(),
+}
+
+var logLevelCmd = &cli.Command{
+	Name:  "log-level",
+	Usage: "Show or change the log levels of a running beacon node, e.g. prysmctl debug log-level --module p2p=debug",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionLogLevel(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not change log levels")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &logLevelFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 1m",
+			Destination: &logLevelFlags.Timeout,
+			Value:       time.Minute,
+		},
+		&cli.StringFlag{
+			Name:        "level",
+			Usage:       "global log level to set, one of trace, debug, info, warn, error, fatal or panic",
+			Destination: &logLevelFlags.Level,
+		},
+		&cli.StringSliceFlag{
+			Name: "module",
+			Usage: "log level of a module to set as module=level, e.g. p2p=debug, sync=trace or state-gen=debug. " +
+				"An empty level, e.g. p2p=, makes the module log at the global level again",
+			Destination: logLevelFlags.Modules,
+		},
+	},
+}
+
+func cliActionLogLevel(_ *cli.Context) error {
+	ctx := context.Background()
+	f := logLevelFlags
+
+	c, err := client.NewClient(f.BeaconNodeHost, client.WithTimeout(f.Timeout))
+	if err != nil {
+		return err
+	}
+	var b []byte
+	if f.Level == "" && len(f.Modules.Value()) == 0 {
+		b, err = c.Get(ctx, logLevelsPath)
+		if err != nil {
+			return errors.Wrap(err, "could not get log levels")
+		}
+	} else {
+		req := &structs.SetLogLevelsRequest{Level: f.Level, Modules: make(map[string]string)}
+		for _, m := range f.Modules.Value() {
+			module, level, ok := strings.Cut(m, "=")
+			if !ok || module == "" {
+				return fmt.Errorf("invalid module log level %q, expected module=level", m)
+			}
+			req.Modules[module] = level
+		}
+		b, err = setLogLevels(ctx, c, req)
+		if err != nil {
+			return errors.Wrap(err, "could not set log levels")
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, b, "", "  "); err != nil {
+		return errors.Wrap(err, "could not format log levels")
+	}
+	_, err = os.Stdout.Write(append(buf.Bytes(), '\n'))
+	return err
+}
+
+func setLogLevels(ctx context.Context, c *client.Client, req *structs.SetLogLevelsRequest) ([]byte, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+	return post(ctx, c, logLevelsPath, body)
+}
+
+// post sends a POST request with the JSON body, which may be nil, to the path of the beacon node, and returns the
+// body of the response.
+func post(ctx context.Context, c *client.Client, path string, body []byte) ([]byte, error) {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: path})
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid format, failed to create new POST request object")
+	}
+	if body != nil {
+		r.Header.Set("Content-Type", api.JsonMediaType)
+	}
+	r.Header.Set("Accept", api.JsonMediaType)
+	resp, err := c.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("POST error %d: %s", resp.StatusCode, string(b))
+	}
+	return b, nil
+}
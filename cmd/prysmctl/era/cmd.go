@@ -0,0 +1,13 @@
+package era
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "era",
+		Usage: "commands to work with .era files of beacon chain history and .era1 files of pre-merge execution history",
+		Subcommands: []*cli.Command{
+			verifyCmd,
+		},
+	},
+}
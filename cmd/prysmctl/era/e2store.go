@@ -0,0 +1,171 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// Entry types of the e2store files which .era and .era1 files are made of. The type is stored as two little endian
+// bytes, so for instance the version entry starts with the bytes 'e2'.
+const (
+	typeVersion uint16 = 0x3265
+
+	// .era entries.
+	typeCompressedSignedBeaconBlock uint16 = 0x0001
+	typeCompressedBeaconState       uint16 = 0x0002
+	typeSlotIndex                   uint16 = 0x3269
+
+	// .era1 entries.
+	typeCompressedHeader   uint16 = 0x0003
+	typeCompressedBody     uint16 = 0x0004
+	typeCompressedReceipts uint16 = 0x0005
+	typeTotalDifficulty    uint16 = 0x0006
+	typeAccumulator        uint16 = 0x0007
+	typeBlockIndex         uint16 = 0x3266
+)
+
+// headerSize is the size of the header of an entry: a 2 bytes type, a 4 bytes length and 2 reserved bytes.
+const headerSize = 8
+
+// entry is the header of an e2store entry, with its position in the file.
+type entry struct {
+	typ    uint16
+	offset int64
+	length uint32
+}
+
+// dataOffset is the position of the data of the entry in the file.
+func (e entry) dataOffset() int64 {
+	return e.offset + headerSize
+}
+
+// e2store reads the entries of an e2store file.
+type e2store struct {
+	r       io.ReaderAt
+	entries []entry
+	// byOffset maps the position of each entry to its index in entries, so that the offsets of the indices can be
+	// resolved.
+	byOffset map[int64]int
+}
+
+// readE2Store reads the headers of all the entries of the file. The data of the entries is only read on demand.
+func readE2Store(r io.ReaderAt, size int64) (*e2store, error) {
+	s := &e2store{r: r, byOffset: make(map[int64]int)}
+	var h [headerSize]byte
+	for off := int64(0); off < size; {
+		if size-off < headerSize {
+			return nil, errors.Errorf("truncated entry header at offset %d", off)
+		}
+		if _, err := r.ReadAt(h[:], off); err != nil {
+			return nil, errors.Wrapf(err, "could not read entry header at offset %d", off)
+		}
+		e := entry{
+			typ:    binary.LittleEndian.Uint16(h[0:2]),
+			offset: off,
+			length: binary.LittleEndian.Uint32(h[2:6]),
+		}
+		if binary.LittleEndian.Uint16(h[6:8]) != 0 {
+			return nil, errors.Errorf("non zero reserved bytes in entry header at offset %d", off)
+		}
+		if e.dataOffset()+int64(e.length) > size {
+			return nil, errors.Errorf("entry at offset %d is longer than the file", off)
+		}
+		s.byOffset[off] = len(s.entries)
+		s.entries = append(s.entries, e)
+		off = e.dataOffset() + int64(e.length)
+	}
+	if len(s.entries) == 0 {
+		return nil, errors.New("empty file")
+	}
+	if s.entries[0].typ != typeVersion || s.entries[0].length != 0 {
+		return nil, errors.New("file does not start with a version entry")
+	}
+	return s, nil
+}
+
+// data reads the data of the entry.
+func (s *e2store) data(e entry) ([]byte, error) {
+	b := make([]byte, e.length)
+	if _, err := s.r.ReadAt(b, e.dataOffset()); err != nil {
+		return nil, errors.Wrapf(err, "could not read entry at offset %d", e.offset)
+	}
+	return b, nil
+}
+
+// decompressed reads the data of the entry and decompresses it from the snappy framing format.
+func (s *e2store) decompressed(e entry) ([]byte, error) {
+	b, err := s.data(e)
+	if err != nil {
+		return nil, err
+	}
+	d, err := io.ReadAll(snappy.NewReader(bytes.NewReader(b)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decompress entry at offset %d", e.offset)
+	}
+	return d, nil
+}
+
+// at returns the entry of the type starting at the offset of the file.
+func (s *e2store) at(off int64, typ uint16) (entry, error) {
+	i, ok := s.byOffset[off]
+	if !ok {
+		return entry{}, errors.Errorf("no entry starts at offset %d", off)
+	}
+	if s.entries[i].typ != typ {
+		return entry{}, errors.Errorf("entry at offset %d has type %#04x, expected %#04x", off, s.entries[i].typ, typ)
+	}
+	return s.entries[i], nil
+}
+
+// next returns the entry following the entry, which must be of the type.
+func (s *e2store) next(e entry, typ uint16) (entry, error) {
+	return s.at(e.dataOffset()+int64(e.length), typ)
+}
+
+// index is a slot index of an .era file or a block index of an .era1 file: the offsets of the entries of
+// consecutive slots or block numbers from start. The offsets are relative to the start of the index entry, and zero
+// for the slots without a block.
+type index struct {
+	entry   entry
+	start   uint64
+	offsets []int64
+}
+
+// readIndex reads the index stored in the entry, which must be of the type.
+func (s *e2store) readIndex(e entry, typ uint16) (*index, error) {
+	if e.typ != typ {
+		return nil, errors.Errorf("entry at offset %d has type %#04x, expected an index of type %#04x", e.offset, e.typ, typ)
+	}
+	b, err := s.data(e)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 16 {
+		return nil, errors.Errorf("index at offset %d is too short", e.offset)
+	}
+	count := binary.LittleEndian.Uint64(b[len(b)-8:])
+	if uint64(len(b)-16)/8 != count || len(b)%8 != 0 {
+		return nil, errors.Errorf("index at offset %d has %d bytes, which does not match its count %d", e.offset, len(b), count)
+	}
+	idx := &index{entry: e, start: binary.LittleEndian.Uint64(b[0:8]), offsets: make([]int64, count)}
+	for i := range idx.offsets {
+		idx.offsets[i] = int64(binary.LittleEndian.Uint64(b[8+8*i:]))
+	}
+	return idx, nil
+}
+
+// resolve returns the entry of the type which the i-th offset of the index points to, or false for an empty slot.
+func (s *e2store) resolve(idx *index, i int, typ uint16) (entry, bool, error) {
+	if idx.offsets[i] == 0 {
+		return entry{}, false, nil
+	}
+	e, err := s.at(idx.entry.offset+idx.offsets[i], typ)
+	if err != nil {
+		return entry{}, false, err
+	}
+	return e, true, nil
+}
@@ -0,0 +1,119 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// e2storeWriter builds e2store files for tests.
+type e2storeWriter struct {
+	buf bytes.Buffer
+}
+
+func newE2StoreWriter() *e2storeWriter {
+	w := &e2storeWriter{}
+	w.write(typeVersion, nil)
+	return w
+}
+
+// write appends an entry and returns its offset.
+func (w *e2storeWriter) write(typ uint16, data []byte) int64 {
+	off := int64(w.buf.Len())
+	var h [headerSize]byte
+	binary.LittleEndian.PutUint16(h[0:2], typ)
+	binary.LittleEndian.PutUint32(h[2:6], uint32(len(data)))
+	w.buf.Write(h[:])
+	w.buf.Write(data)
+	return off
+}
+
+func (w *e2storeWriter) writeCompressed(t *testing.T, typ uint16, data []byte) int64 {
+	var b bytes.Buffer
+	sw := snappy.NewBufferedWriter(&b)
+	_, err := sw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, sw.Close())
+	return w.write(typ, b.Bytes())
+}
+
+// writeIndex appends an index of the absolute offsets, zero for missing entries.
+func (w *e2storeWriter) writeIndex(typ uint16, start uint64, offsets []int64) int64 {
+	at := int64(w.buf.Len())
+	b := make([]byte, 16+8*len(offsets))
+	binary.LittleEndian.PutUint64(b, start)
+	for i, o := range offsets {
+		if o != 0 {
+			binary.LittleEndian.PutUint64(b[8+8*i:], uint64(o-at))
+		}
+	}
+	binary.LittleEndian.PutUint64(b[len(b)-8:], uint64(len(offsets)))
+	return w.write(typ, b)
+}
+
+func (w *e2storeWriter) store(t *testing.T) *e2store {
+	s, err := readE2Store(bytes.NewReader(w.buf.Bytes()), int64(w.buf.Len()))
+	require.NoError(t, err)
+	return s
+}
+
+func TestReadE2Store(t *testing.T) {
+	w := newE2StoreWriter()
+	first := w.writeCompressed(t, typeCompressedSignedBeaconBlock, []byte("first"))
+	second := w.writeCompressed(t, typeCompressedSignedBeaconBlock, []byte("second"))
+	w.writeIndex(typeSlotIndex, 32, []int64{first, 0, second})
+	s := w.store(t)
+	require.Equal(t, 4, len(s.entries))
+
+	idx, err := s.readIndex(s.entries[3], typeSlotIndex)
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), idx.start)
+	require.Equal(t, 3, len(idx.offsets))
+
+	e, ok, err := s.resolve(idx, 0, typeCompressedSignedBeaconBlock)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	b, err := s.decompressed(e)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("first"), b)
+
+	_, ok, err = s.resolve(idx, 1, typeCompressedSignedBeaconBlock)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
+
+	_, _, err = s.resolve(idx, 2, typeCompressedBeaconState)
+	require.ErrorContains(t, "has type", err)
+	next, err := s.next(e, typeCompressedSignedBeaconBlock)
+	require.NoError(t, err)
+	require.Equal(t, second, next.offset)
+
+	_, err = s.readIndex(s.entries[1], typeSlotIndex)
+	require.ErrorContains(t, "expected an index", err)
+}
+
+func TestReadE2Store_Invalid(t *testing.T) {
+	_, err := readE2Store(bytes.NewReader(nil), 0)
+	require.ErrorContains(t, "empty file", err)
+
+	w := &e2storeWriter{}
+	w.write(typeSlotIndex, nil)
+	_, err = readE2Store(bytes.NewReader(w.buf.Bytes()), int64(w.buf.Len()))
+	require.ErrorContains(t, "does not start with a version entry", err)
+
+	w = newE2StoreWriter()
+	w.write(typeCompressedBeaconState, []byte{1, 2, 3})
+	b := w.buf.Bytes()
+	_, err = readE2Store(bytes.NewReader(b[:len(b)-1]), int64(len(b)-1))
+	require.ErrorContains(t, "longer than the file", err)
+	_, err = readE2Store(bytes.NewReader(b[:len(b)-6]), int64(len(b)-6))
+	require.ErrorContains(t, "truncated entry header", err)
+
+	w = newE2StoreWriter()
+	w.write(typeSlotIndex, make([]byte, 20))
+	s := w.store(t)
+	_, err = s.readIndex(s.entries[1], typeSlotIndex)
+	require.ErrorContains(t, "does not match its count", err)
+}
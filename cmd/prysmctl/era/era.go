@@ -0,0 +1,269 @@
+package era
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// verifyEra verifies an .era file, which holds the blocks of an era followed by the state at its end:
+//   - the slot indices point to the blocks and the state, and every block is indexed at its slot,
+//   - the roots of the blocks match the block roots of the state, and each block is the child of the previous one,
+//   - the state roots of the blocks match the state roots of the state,
+//   - the roots of the era, computed from the block and state roots, match the historical accumulator of the state.
+func verifyEra(ctx context.Context, s *e2store, res *result) error {
+	n := len(s.entries)
+	stateIdx, err := s.readIndex(s.entries[n-1], typeSlotIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not read state index")
+	}
+	if len(stateIdx.offsets) != 1 {
+		return errors.Errorf("state index has %d entries, expected 1", len(stateIdx.offsets))
+	}
+	se, ok, err := s.resolve(stateIdx, 0, typeCompressedBeaconState)
+	if err != nil {
+		return errors.Wrap(err, "could not find indexed state")
+	}
+	if !ok {
+		return errors.New("state index does not point to a state")
+	}
+	st, cfg, err := readEraState(s, se)
+	if err != nil {
+		return err
+	}
+	if uint64(st.Slot()) != stateIdx.start {
+		return errors.Errorf("state is at slot %d but indexed at slot %d", st.Slot(), stateIdx.start)
+	}
+	perEra := uint64(cfg.SlotsPerHistoricalRoot)
+	if stateIdx.start%perEra != 0 {
+		return errors.Errorf("state slot %d is not at the start of an era", stateIdx.start)
+	}
+	res.era = stateIdx.start / perEra
+	res.network = cfg.ConfigName
+	if res.accumulators, err = eraRoots(st); err != nil {
+		return err
+	}
+
+	if res.era == 0 {
+		if n != 3 {
+			res.problem("the era 0 file holds %d entries, expected only the genesis state", n)
+		}
+		res.root = bytesutil.ToBytes32(st.GenesisValidatorsRoot())
+		return nil
+	}
+	if n < 3 {
+		return errors.New("no block index")
+	}
+	blockIdx, err := s.readIndex(s.entries[n-2], typeSlotIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not read block index")
+	}
+	if blockIdx.start != stateIdx.start-perEra || uint64(len(blockIdx.offsets)) != perEra {
+		return errors.Errorf("block index covers %d slots from slot %d, expected the %d slots of era %d",
+			len(blockIdx.offsets), blockIdx.start, perEra, res.era)
+	}
+	res.first, res.count = blockIdx.start, uint64(len(blockIdx.offsets))
+
+	if err := verifyEraBlocks(ctx, s, blockIdx, st, cfg, res); err != nil {
+		return err
+	}
+	root, err := verifyHistoricalRoot(st, res)
+	if err != nil {
+		return err
+	}
+	res.root = root
+	return nil
+}
+
+func readEraState(s *e2store, e entry) (state.BeaconState, *params.BeaconChainConfig, error) {
+	b, err := s.decompressed(e)
+	if err != nil {
+		return nil, nil, err
+	}
+	vu, err := detect.FromState(b)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not detect chain config of state")
+	}
+	st, err := vu.UnmarshalBeaconState(b)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	return st, vu.Config, nil
+}
+
+// verifyEraBlocks checks the blocks of the era against the block and state roots of the state at its end, which
+// hold the roots of every slot of the era.
+func verifyEraBlocks(ctx context.Context, s *e2store, idx *index, st state.BeaconState, cfg *params.BeaconChainConfig, res *result) error {
+	blockRoots, stateRoots := st.BlockRoots(), st.StateRoots()
+	schedule := forks.NewOrderedSchedule(cfg)
+	indexed := make(map[int64]bool)
+	var prev []byte
+	for i := range idx.offsets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		slot := idx.start + uint64(i)
+		r := slot % uint64(len(blockRoots))
+		e, ok, err := s.resolve(idx, i, typeCompressedSignedBeaconBlock)
+		if err != nil {
+			res.problem("slot %d: %v", slot, err)
+			continue
+		}
+		if !ok {
+			// Without a block, the block root of the slot is the one of the previous slot.
+			if prev != nil && !bytes.Equal(blockRoots[r], prev) {
+				res.problem("slot %d: empty slot with block root %#x, expected the previous block root %#x", slot, blockRoots[r], prev)
+			}
+			prev = blockRoots[r]
+			continue
+		}
+		indexed[e.offset] = true
+		blk, err := readEraBlock(s, e, schedule, slot)
+		if err != nil {
+			res.problem("slot %d: %v", slot, err)
+			prev = blockRoots[r]
+			continue
+		}
+		res.blocks++
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return errors.Wrapf(err, "could not compute root of block at slot %d", slot)
+		}
+		if !bytes.Equal(root[:], blockRoots[r]) {
+			res.problem("slot %d: block root %#x does not match the block root %#x of the state", slot, root, blockRoots[r])
+		}
+		if sr := blk.Block().StateRoot(); !bytes.Equal(sr[:], stateRoots[r]) {
+			res.problem("slot %d: block state root %#x does not match the state root %#x of the state", slot, sr, stateRoots[r])
+		}
+		if pr := blk.Block().ParentRoot(); prev != nil && !bytes.Equal(pr[:], prev) {
+			res.problem("slot %d: block parent root %#x does not match the previous block root %#x", slot, pr, prev)
+		}
+		prev = root[:]
+	}
+
+	// The latest block header of the state is the one of the last block of the era.
+	h := st.LatestBlockHeader()
+	hr, err := h.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute latest block header root")
+	}
+	if prev != nil && !bytes.Equal(hr[:], prev) {
+		res.problem("latest block header root %#x of the state does not match the last block root %#x", hr, prev)
+	}
+
+	for _, e := range s.entries {
+		if e.typ == typeCompressedSignedBeaconBlock && !indexed[e.offset] {
+			res.problem("block at offset %d is not indexed", e.offset)
+		}
+	}
+	return nil
+}
+
+// readEraBlock decodes the block expected at the slot, using the fork schedule of the network of the era state.
+func readEraBlock(s *e2store, e entry, schedule forks.OrderedSchedule, slot uint64) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	b, err := s.decompressed(e)
+	if err != nil {
+		return nil, err
+	}
+	v, err := schedule.VersionForEpoch(slots.ToEpoch(primitives.Slot(slot)))
+	if err != nil {
+		return nil, err
+	}
+	vu, err := detect.FromForkVersion(v)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := vu.UnmarshalBeaconBlock(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	if uint64(blk.Block().Slot()) != slot {
+		return nil, errors.Errorf("block is at slot %d", blk.Block().Slot())
+	}
+	return blk, nil
+}
+
+// verifyHistoricalRoot checks the root of the era, computed from the block and state roots of the state at its end,
+// against the historical roots, or the historical summaries from Capella, which the state accumulated.
+func verifyHistoricalRoot(st state.BeaconState, res *result) ([32]byte, error) {
+	blockRoots, stateRoots := st.BlockRoots(), st.StateRoots()
+	hr, err := st.HistoricalRoots()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	i := res.era - 1
+	if i < uint64(len(hr)) {
+		root, err := (&ethpb.HistoricalBatch{BlockRoots: blockRoots, StateRoots: stateRoots}).HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not compute historical batch root")
+		}
+		if !bytes.Equal(root[:], hr[i]) {
+			res.problem("historical batch root %#x does not match the historical root %#x of the state", root, hr[i])
+		}
+		return root, nil
+	}
+	if st.Version() < version.Capella {
+		return [32]byte{}, errors.Errorf("state does not hold a historical root for era %d", res.era)
+	}
+	summaries, err := st.HistoricalSummaries()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	i -= uint64(len(hr))
+	if i >= uint64(len(summaries)) {
+		return [32]byte{}, errors.Errorf("state does not hold a historical summary for era %d", res.era)
+	}
+	br, err := stateutil.ArraysRoot(blockRoots, uint64(len(blockRoots)))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	sr, err := stateutil.ArraysRoot(stateRoots, uint64(len(stateRoots)))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	summary := &ethpb.HistoricalSummary{BlockSummaryRoot: br[:], StateSummaryRoot: sr[:]}
+	if !bytes.Equal(br[:], summaries[i].BlockSummaryRoot) || !bytes.Equal(sr[:], summaries[i].StateSummaryRoot) {
+		res.problem("historical summary of the era does not match the historical summary of the state")
+	}
+	return summary.HashTreeRoot()
+}
+
+// eraRoots returns the roots of all the eras before the state: its historical roots followed by the roots of its
+// historical summaries.
+func eraRoots(st state.BeaconState) ([][32]byte, error) {
+	hr, err := st.HistoricalRoots()
+	if err != nil {
+		return nil, err
+	}
+	roots := make([][32]byte, 0, len(hr))
+	for _, r := range hr {
+		roots = append(roots, bytesutil.ToBytes32(r))
+	}
+	if st.Version() < version.Capella {
+		return roots, nil
+	}
+	summaries, err := st.HistoricalSummaries()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range summaries {
+		r, err := s.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, r)
+	}
+	return roots, nil
+}
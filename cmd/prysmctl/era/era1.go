@@ -0,0 +1,187 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+)
+
+// blocksPerEra1 is the maximum number of execution blocks of an .era1 file.
+const blocksPerEra1 = 8192
+
+// headerRecord is an entry of the accumulator of an .era1 file.
+type headerRecord struct {
+	blockHash       common.Hash
+	totalDifficulty [32]byte
+}
+
+// HashTreeRoot of the record, as an SSZ container of a Bytes32 and a little endian uint256.
+func (r *headerRecord) HashTreeRoot() ([32]byte, error) {
+	return hash.Hash(append(r.blockHash.Bytes(), r.totalDifficulty[:]...)), nil
+}
+
+// verifyEra1 verifies an .era1 file, which holds pre-merge execution blocks, each as a header, body, receipts and
+// total difficulty entries:
+//   - the block index points to the headers, followed by the other entries of the block, and every block is indexed
+//     at its number,
+//   - each header is the child of the previous one, and the total difficulty adds up the difficulty of the header,
+//   - the transactions and uncles of the body and the receipts match the roots of the header,
+//   - the accumulator root of the file matches the hash tree root of the header records of the blocks.
+func verifyEra1(ctx context.Context, s *e2store, res *result) error {
+	n := len(s.entries)
+	if n < 3 {
+		return errors.New("no accumulator and block index")
+	}
+	idx, err := s.readIndex(s.entries[n-1], typeBlockIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not read block index")
+	}
+	if len(idx.offsets) == 0 || len(idx.offsets) > blocksPerEra1 {
+		return errors.Errorf("block index holds %d blocks, expected between 1 and %d", len(idx.offsets), blocksPerEra1)
+	}
+	if idx.start%blocksPerEra1 != 0 {
+		return errors.Errorf("first block %d is not at the start of an era", idx.start)
+	}
+	acc := s.entries[n-2]
+	if acc.typ != typeAccumulator || acc.length != 32 {
+		return errors.New("no accumulator root before the block index")
+	}
+	accRoot, err := s.data(acc)
+	if err != nil {
+		return err
+	}
+	res.era = idx.start / blocksPerEra1
+	res.first, res.count = idx.start, uint64(len(idx.offsets))
+
+	indexed := make(map[int64]bool)
+	records := make([]*headerRecord, 0, len(idx.offsets))
+	var prev *gethtypes.Header
+	var prevTD *big.Int
+	for i := range idx.offsets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		number := idx.start + uint64(i)
+		he, ok, err := s.resolve(idx, i, typeCompressedHeader)
+		if err != nil {
+			return errors.Wrapf(err, "block %d", number)
+		}
+		if !ok {
+			return errors.Errorf("block %d is not indexed", number)
+		}
+		indexed[he.offset] = true
+		h, td, err := verifyEra1Block(s, he, number, res)
+		if err != nil {
+			return errors.Wrapf(err, "block %d", number)
+		}
+		res.blocks++
+		if prev != nil {
+			if h.ParentHash != prev.Hash() {
+				res.problem("block %d: parent hash %#x does not match the previous block hash %#x", number, h.ParentHash, prev.Hash())
+			}
+			if want := new(big.Int).Add(prevTD, h.Difficulty); want.Cmp(td) != 0 {
+				res.problem("block %d: total difficulty %d, expected %d", number, td, want)
+			}
+		}
+		r := &headerRecord{blockHash: h.Hash()}
+		copy(r.totalDifficulty[:], bytesutil.ReverseByteOrder(td.FillBytes(make([]byte, 32))))
+		records = append(records, r)
+		prev, prevTD = h, td
+	}
+
+	for _, e := range s.entries {
+		if e.typ == typeCompressedHeader && !indexed[e.offset] {
+			res.problem("header at offset %d is not indexed", e.offset)
+		}
+	}
+
+	root, err := ssz.MerkleizeListSSZ(records, blocksPerEra1)
+	if err != nil {
+		return errors.Wrap(err, "could not compute accumulator root")
+	}
+	if !bytes.Equal(root[:], accRoot) {
+		res.problem("accumulator root %#x does not match the root %#x of the blocks", accRoot, root)
+	}
+	res.root = root
+	return nil
+}
+
+// verifyEra1Block reads the entries of the block starting with its header, checks the body and receipts against the
+// header and returns the header and the total difficulty.
+func verifyEra1Block(s *e2store, he entry, number uint64, res *result) (*gethtypes.Header, *big.Int, error) {
+	be, err := s.next(he, typeCompressedBody)
+	if err != nil {
+		return nil, nil, err
+	}
+	re, err := s.next(be, typeCompressedReceipts)
+	if err != nil {
+		return nil, nil, err
+	}
+	te, err := s.next(re, typeTotalDifficulty)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := &gethtypes.Header{}
+	if err := decompressRLP(s, he, h); err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode header")
+	}
+	if h.Number == nil || !h.Number.IsUint64() || h.Number.Uint64() != number {
+		return nil, nil, errors.Errorf("header has number %v", h.Number)
+	}
+	body := &gethtypes.Body{}
+	if err := decompressRLP(s, be, body); err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode body")
+	}
+	var receipts []*gethtypes.ReceiptForStorage
+	if err := decompressRLP(s, re, &receipts); err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode receipts")
+	}
+	tdb, err := s.data(te)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tdb) != 32 {
+		return nil, nil, errors.Errorf("total difficulty has %d bytes", len(tdb))
+	}
+	td := new(big.Int).SetBytes(bytesutil.ReverseByteOrder(tdb))
+
+	txs := gethtypes.Transactions(body.Transactions)
+	if root := gethtypes.DeriveSha(txs, trie.NewStackTrie(nil)); root != h.TxHash {
+		res.problem("block %d: transactions root %#x does not match the header %#x", number, root, h.TxHash)
+	}
+	if uh := gethtypes.CalcUncleHash(body.Uncles); uh != h.UncleHash {
+		res.problem("block %d: uncles hash %#x does not match the header %#x", number, uh, h.UncleHash)
+	}
+	if len(receipts) != len(txs) {
+		res.problem("block %d: %d receipts for %d transactions", number, len(receipts), len(txs))
+		return h, td, nil
+	}
+	// The type of a receipt, which its root depends on, is not stored but is the one of its transaction.
+	rs := make(gethtypes.Receipts, len(receipts))
+	for i, r := range receipts {
+		rs[i] = (*gethtypes.Receipt)(r)
+		rs[i].Type = txs[i].Type()
+	}
+	if root := gethtypes.DeriveSha(rs, trie.NewStackTrie(nil)); root != h.ReceiptHash {
+		res.problem("block %d: receipts root %#x does not match the header %#x", number, root, h.ReceiptHash)
+	}
+	return h, td, nil
+}
+
+func decompressRLP(s *e2store, e entry, v interface{}) error {
+	b, err := s.decompressed(e)
+	if err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(b, v)
+}
@@ -0,0 +1,86 @@
+package era
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// writeEra1 builds an .era1 file of empty blocks from block number start, and returns the accumulator root of the
+// blocks. The modify function can change the headers before they are written.
+func writeEra1(t *testing.T, start uint64, n int, modify func(i int, h *gethtypes.Header)) (*e2storeWriter, [32]byte) {
+	w := newE2StoreWriter()
+	offsets := make([]int64, n)
+	records := make([]*headerRecord, n)
+	td := new(big.Int)
+	var parent *gethtypes.Header
+	for i := 0; i < n; i++ {
+		h := &gethtypes.Header{
+			Number:      new(big.Int).SetUint64(start + uint64(i)),
+			Difficulty:  big.NewInt(int64(10 + i)),
+			TxHash:      gethtypes.EmptyTxsHash,
+			UncleHash:   gethtypes.EmptyUncleHash,
+			ReceiptHash: gethtypes.EmptyReceiptsHash,
+		}
+		if parent != nil {
+			h.ParentHash = parent.Hash()
+		}
+		if modify != nil {
+			modify(i, h)
+		}
+		td.Add(td, h.Difficulty)
+
+		hb, err := rlp.EncodeToBytes(h)
+		require.NoError(t, err)
+		bb, err := rlp.EncodeToBytes(&gethtypes.Body{})
+		require.NoError(t, err)
+		rb, err := rlp.EncodeToBytes([]*gethtypes.ReceiptForStorage{})
+		require.NoError(t, err)
+		offsets[i] = w.writeCompressed(t, typeCompressedHeader, hb)
+		w.writeCompressed(t, typeCompressedBody, bb)
+		w.writeCompressed(t, typeCompressedReceipts, rb)
+		w.write(typeTotalDifficulty, bytesutil.ReverseByteOrder(td.FillBytes(make([]byte, 32))))
+
+		records[i] = &headerRecord{blockHash: h.Hash()}
+		copy(records[i].totalDifficulty[:], bytesutil.ReverseByteOrder(td.FillBytes(make([]byte, 32))))
+		parent = h
+	}
+	root, err := ssz.MerkleizeListSSZ(records, blocksPerEra1)
+	require.NoError(t, err)
+	w.write(typeAccumulator, root[:])
+	w.writeIndex(typeBlockIndex, start, offsets)
+	return w, root
+}
+
+func TestVerifyEra1(t *testing.T) {
+	w, root := writeEra1(t, 2*blocksPerEra1, 4, nil)
+	res := &result{}
+	require.NoError(t, verifyEra1(context.Background(), w.store(t), res))
+	require.Equal(t, 0, len(res.problems), res.problems)
+	require.Equal(t, uint64(2), res.era)
+	require.Equal(t, 4, res.blocks)
+	require.Equal(t, root, res.root)
+}
+
+func TestVerifyEra1_Problems(t *testing.T) {
+	w, _ := writeEra1(t, 0, 3, func(i int, h *gethtypes.Header) {
+		if i == 2 {
+			h.ParentHash[0] ^= 1
+			h.TxHash[0] ^= 1
+		}
+	})
+	res := &result{}
+	require.NoError(t, verifyEra1(context.Background(), w.store(t), res))
+	require.Equal(t, 2, len(res.problems), res.problems)
+	require.StringContains(t, "parent hash", res.problems[0])
+	require.StringContains(t, "transactions root", res.problems[1])
+
+	w, _ = writeEra1(t, 1, 3, nil)
+	require.ErrorContains(t, "not at the start of an era", verifyEra1(context.Background(), w.store(t), &result{}))
+}
@@ -0,0 +1,257 @@
+package era
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	extEra  = ".era"
+	extEra1 = ".era1"
+)
+
+var verifyFlags = struct {
+	MaxProblems uint64
+}{}
+
+var verifyCmd = &cli.Command{
+	Name:      "verify",
+	Usage:     "verify the integrity of .era and .era1 files, e.g. prysmctl era verify /path/to/era/dir mainnet-01000-a1b2c3d4.era",
+	ArgsUsage: "<file or directory>...",
+	Action: func(cliCtx *cli.Context) error {
+		if err := verifyAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not verify era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:        "max-problems",
+			Usage:       "maximum number of problems printed for each file",
+			Destination: &verifyFlags.MaxProblems,
+			Value:       10,
+		},
+	},
+}
+
+// result is the outcome of the verification of a file.
+type result struct {
+	path string
+	ext  string
+	// network is the config name of the state of an .era file.
+	network string
+	era     uint64
+	// first and count are the range of slots of an .era file, or of block numbers of an .era1 file.
+	first uint64
+	count uint64
+	// blocks is the number of blocks of the file.
+	blocks int
+	// root is the root of the era, which the short root of the file name is taken from: the historical root of an
+	// .era file, or its genesis validators root for era 0, and the accumulator root of an .era1 file.
+	root [32]byte
+	// accumulators are the roots of all the eras before the state of an .era file.
+	accumulators [][32]byte
+	problems     []string
+	// err is set when the file could not be verified to the end.
+	err error
+}
+
+func (r *result) problem(format string, args ...interface{}) {
+	r.problems = append(r.problems, fmt.Sprintf(format, args...))
+}
+
+func (r *result) ok() bool {
+	return r.err == nil && len(r.problems) == 0
+}
+
+func verifyAction(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	paths, err := eraFiles(cliCtx.Args().Slice())
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return errors.New("no .era or .era1 file to verify")
+	}
+
+	results := make([]*result, 0, len(paths))
+	for _, p := range paths {
+		log.WithField("file", p).Info("Verifying")
+		results = append(results, verifyFile(ctx, p))
+	}
+	verifyAgainstLatest(results)
+
+	printResults(results)
+	failed := 0
+	for _, r := range results {
+		if r.err != nil {
+			log.WithError(r.err).WithField("file", r.path).Error("Could not verify file")
+		}
+		for i, p := range r.problems {
+			if uint64(i) == verifyFlags.MaxProblems {
+				log.WithField("file", r.path).Errorf("%d more problems", len(r.problems)-i)
+				break
+			}
+			log.WithField("file", r.path).Error(p)
+		}
+		if !r.ok() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("%d of %d files failed verification", failed, len(results))
+	}
+	log.Infof("Verified %d files", len(results))
+	return nil
+}
+
+// eraFiles returns the .era and .era1 files of the paths, looking into the directories, sorted by name.
+func eraFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		for _, ext := range []string{extEra, extEra1} {
+			m, err := filepath.Glob(filepath.Join(p, "*"+ext))
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, m...)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func verifyFile(ctx context.Context, path string) *result {
+	res := &result{path: path, ext: filepath.Ext(path)}
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		res.err = err
+		return res
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).WithField("file", path).Error("Could not close file")
+		}
+	}()
+	fi, err := f.Stat()
+	if err != nil {
+		res.err = err
+		return res
+	}
+	s, err := readE2Store(f, fi.Size())
+	if err != nil {
+		res.err = err
+		return res
+	}
+	switch res.ext {
+	case extEra:
+		res.err = verifyEra(ctx, s, res)
+	case extEra1:
+		res.err = verifyEra1(ctx, s, res)
+	default:
+		res.err = errors.Errorf("unknown file extension %s", res.ext)
+	}
+	if res.err == nil {
+		verifyFileName(res)
+	}
+	return res
+}
+
+// eraFileName matches the <network>-<era number>-<short root>.era and .era1 file names, with the optional era
+// count of the first .era files.
+var eraFileName = regexp.MustCompile(`^(.+)-(\d{5})-(?:\d{5}-)?([0-9a-f]{8})\.era1?$`)
+
+// verifyFileName checks the era number and short root of the file name, which other tools rely on to find and
+// trust files, against the content of the file.
+func verifyFileName(res *result) {
+	name := filepath.Base(res.path)
+	m := eraFileName.FindStringSubmatch(name)
+	if m == nil {
+		log.WithField("file", res.path).Warn("File name does not follow the <network>-<era>-<short root> format, skipping its verification")
+		return
+	}
+	if res.network != "" && m[1] != res.network {
+		res.problem("file name network %s does not match the %s network of the state", m[1], res.network)
+	}
+	if era, err := strconv.ParseUint(m[2], 10, 64); err != nil || era != res.era {
+		res.problem("file name era %s does not match the era %d of the content", m[2], res.era)
+	}
+	if short := hex.EncodeToString(res.root[:4]); m[3] != short {
+		res.problem("file name short root %s does not match the root %#x of the era", m[3], res.root)
+	}
+}
+
+// verifyAgainstLatest checks the roots of the .era files against the historical accumulator of the latest era state,
+// which commits to the roots of all the eras before it. Once the latest file is trusted, so are the other files.
+func verifyAgainstLatest(results []*result) {
+	var latest *result
+	for _, r := range results {
+		if r.ext == extEra && r.err == nil && (latest == nil || r.era > latest.era) {
+			latest = r
+		}
+	}
+	if latest == nil {
+		return
+	}
+	for _, r := range results {
+		if r == latest || r.ext != extEra || r.err != nil || r.era == 0 {
+			continue
+		}
+		if r.network != latest.network {
+			r.problem("network %s differs from the %s network of the latest era file %s", r.network, latest.network, latest.path)
+			continue
+		}
+		if r.era == latest.era {
+			if r.root != latest.root {
+				r.problem("era root %#x differs from the root %#x of %s", r.root, latest.root, latest.path)
+			}
+			continue
+		}
+		if r.era > uint64(len(latest.accumulators)) {
+			r.problem("era is missing from the historical accumulator of %s", latest.path)
+			continue
+		}
+		if acc := latest.accumulators[r.era-1]; acc != r.root {
+			r.problem("era root %#x does not match the root %#x in the historical accumulator of %s", r.root, acc, latest.path)
+		}
+	}
+}
+
+func printResults(results []*result) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"File", "Era", "Range", "Blocks", "Status"})
+	for _, r := range results {
+		rng := ""
+		if r.count > 0 {
+			rng = fmt.Sprintf("%d-%d", r.first, r.first+r.count-1)
+		}
+		status := "ok"
+		if r.err != nil {
+			status = "error"
+		} else if len(r.problems) > 0 {
+			status = fmt.Sprintf("%d problems", len(r.problems))
+		}
+		t.AppendRow(table.Row{filepath.Base(r.path), r.era, rng, r.blocks, status})
+	}
+	t.Render()
+}
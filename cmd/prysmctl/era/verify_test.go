@@ -0,0 +1,47 @@
+package era
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestVerifyFileName(t *testing.T) {
+	root := [32]byte{0x4b, 0x36, 0x3d, 0xb9}
+	tests := []struct {
+		name     string
+		path     string
+		network  string
+		era      uint64
+		problems int
+	}{
+		{name: "valid", path: "mainnet-00010-4b363db9.era", network: "mainnet", era: 10},
+		{name: "valid with era count", path: "/data/mainnet-00010-00001-4b363db9.era", network: "mainnet", era: 10},
+		{name: "valid era1", path: "mainnet-00010-4b363db9.era1", era: 10},
+		{name: "wrong network", path: "sepolia-00010-4b363db9.era", network: "mainnet", era: 10, problems: 1},
+		{name: "wrong era", path: "mainnet-00011-4b363db9.era", network: "mainnet", era: 10, problems: 1},
+		{name: "wrong root", path: "mainnet-00010-00000000.era1", era: 10, problems: 1},
+		{name: "unknown format", path: "history.era", era: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &result{path: tt.path, network: tt.network, era: tt.era, root: root}
+			verifyFileName(res)
+			require.Equal(t, tt.problems, len(res.problems), res.problems)
+		})
+	}
+}
+
+func TestVerifyAgainstLatest(t *testing.T) {
+	latest := &result{path: "latest", ext: extEra, network: "mainnet", era: 3, accumulators: [][32]byte{{1}, {2}, {3}}}
+	good := &result{path: "good", ext: extEra, network: "mainnet", era: 2, root: [32]byte{2}}
+	bad := &result{path: "bad", ext: extEra, network: "mainnet", era: 1, root: [32]byte{2}}
+	other := &result{path: "other", ext: extEra, network: "sepolia", era: 1, root: [32]byte{1}}
+	era1 := &result{path: "era1", ext: extEra1, era: 1, root: [32]byte{9}}
+	verifyAgainstLatest([]*result{good, bad, latest, other, era1})
+	require.Equal(t, 0, len(good.problems))
+	require.Equal(t, 1, len(bad.problems))
+	require.Equal(t, 1, len(other.problems))
+	require.Equal(t, 0, len(era1.problems))
+	require.Equal(t, 0, len(latest.problems))
+}
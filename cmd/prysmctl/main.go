@@ -3,9 +3,16 @@ package main
 import (
 	"os"
 
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/apicheck"
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/benchmark"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/checkpointsync"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/db"
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/debug"
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/era"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/p2p"
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/profiles"
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/slasher"
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/state"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/testnet"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/validator"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/weaksubjectivity"
@@ -28,8 +35,15 @@ func main() {
 func init() {
 	prysmctlCommands = append(prysmctlCommands, checkpointsync.Commands...)
 	prysmctlCommands = append(prysmctlCommands, db.Commands...)
+	prysmctlCommands = append(prysmctlCommands, debug.Commands...)
+	prysmctlCommands = append(prysmctlCommands, era.Commands...)
 	prysmctlCommands = append(prysmctlCommands, p2p.Commands...)
+	prysmctlCommands = append(prysmctlCommands, slasher.Commands...)
+	prysmctlCommands = append(prysmctlCommands, state.Commands...)
+	prysmctlCommands = append(prysmctlCommands, benchmark.Commands...)
 	prysmctlCommands = append(prysmctlCommands, testnet.Commands...)
 	prysmctlCommands = append(prysmctlCommands, weaksubjectivity.Commands...)
 	prysmctlCommands = append(prysmctlCommands, validator.Commands...)
+	prysmctlCommands = append(prysmctlCommands, apicheck.Commands...)
+	prysmctlCommands = append(prysmctlCommands, profiles.Commands...)
 }
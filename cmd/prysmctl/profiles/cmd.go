@@ -0,0 +1,13 @@
+package profiles
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "profiles",
+		Usage: "commands dealing with the named profiles of the instances running on this machine",
+		Subcommands: []*cli.Command{
+			listCmd,
+		},
+	},
+}
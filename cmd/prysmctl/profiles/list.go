@@ -0,0 +1,77 @@
+package profiles
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var listFlags = struct {
+	ProfilesFile string
+}{}
+
+var listCmd = &cli.Command{
+	Name:  "list",
+	Usage: "List the profiles of a profiles file with their network, data directory and flags, and report the data directories and ports shared by several profiles.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionList(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not list profiles")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        cmd.ProfilesFileFlag.Name,
+			Usage:       cmd.ProfilesFileFlag.Usage,
+			Destination: &listFlags.ProfilesFile,
+			Value:       cmd.ProfilesFileFlag.Value,
+		},
+	},
+}
+
+func cliActionList(_ *cli.Context) error {
+	profiles, err := cmd.LoadProfiles(listFlags.ProfilesFile)
+	if err != nil {
+		return err
+	}
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"Profile", "Network", "Data directory", "Flags"})
+	for _, p := range profiles {
+		network := p.Network
+		if network == "" {
+			network = "mainnet"
+		}
+		dataDir := p.DataDir
+		if dataDir == "" {
+			dataDir = cmd.DefaultDataDir()
+		}
+		t.AppendRow(table.Row{p.Name, network, dataDir, formatFlags(p)})
+	}
+	t.Render()
+
+	conflicts := cmd.ProfileConflicts(profiles)
+	for _, c := range conflicts {
+		log.Warn(c)
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%d conflicts between profiles", len(conflicts))
+	}
+	return nil
+}
+
+// formatFlags returns the flags of a profile as they would be passed on the command line, sorted by name.
+func formatFlags(p *cmd.Profile) string {
+	flags := make([]string, 0, len(p.Flags))
+	for k, v := range p.Flags {
+		flags = append(flags, fmt.Sprintf("--%v=%v", k, v))
+	}
+	sort.Strings(flags)
+	return strings.Join(flags, " ")
+}
@@ -0,0 +1,14 @@
+package slasher
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "slasher",
+		Usage: "commands to work with the slasher database",
+		Subcommands: []*cli.Command{
+			exportCmd,
+			importCmd,
+		},
+	},
+}
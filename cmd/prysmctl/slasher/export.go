@@ -0,0 +1,90 @@
+package slasher
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportFlags = struct {
+	Path   string
+	Output string
+}{}
+
+var exportCmd = &cli.Command{
+	Name:  "export",
+	Usage: "Export the slasher database (spans, attestation and proposal records) to a file, e.g. to migrate a slasher to another machine. The beacon node must be stopped.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionExport(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export slasher database")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "db-path-directory",
+			Usage:       "path to directory containing slasher.db",
+			Destination: &exportFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file to write the export to, gzip compressed if the name ends with .gz",
+			Destination: &exportFlags.Output,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionExport(cliCtx *cli.Context) (err error) {
+	f := exportFlags
+
+	out, err := os.Create(f.Output) // #nosec G304 -- the output path is provided by the user.
+	if err != nil {
+		return errors.Wrapf(err, "could not create %s", f.Output)
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			// Do not leave a partial export behind.
+			if rmErr := os.Remove(f.Output); rmErr != nil {
+				log.WithError(rmErr).Error("Could not remove partial export")
+			}
+		}
+	}()
+
+	var w io.Writer = out
+	var gz *gzip.Writer
+	if isCompressed(f.Output) {
+		gz = gzip.NewWriter(out)
+		w = gz
+	}
+
+	count, err := slasher.ExportDatabase(cliCtx.Context, f.Path, w)
+	if err != nil {
+		return err
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return errors.Wrap(err, "could not compress export")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"path":    f.Output,
+		"records": count,
+	}).Info("Exported slasher database")
+	return nil
+}
+
+func isCompressed(path string) bool {
+	return strings.HasSuffix(path, ".gz")
+}
@@ -0,0 +1,81 @@
+package slasher
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var importFlags = struct {
+	Path  string
+	Input string
+}{}
+
+var importCmd = &cli.Command{
+	Name:  "import",
+	Usage: "Import a slasher database export into an empty slasher database. The beacon node must be stopped.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionImport(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import slasher database")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "db-path-directory",
+			Usage:       "path to directory containing slasher.db, the database is created if it does not exist",
+			Destination: &importFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "input",
+			Usage:       "export file to import, gzip compressed if the name ends with .gz",
+			Destination: &importFlags.Input,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionImport(cliCtx *cli.Context) error {
+	f := importFlags
+
+	in, err := os.Open(f.Input) // #nosec G304 -- the input path is provided by the user.
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s", f.Input)
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.WithError(err).Error("Could not close export file")
+		}
+	}()
+
+	var r io.Reader = in
+	if isCompressed(f.Input) {
+		gz, err := gzip.NewReader(in)
+		if err != nil {
+			return errors.Wrap(err, "could not decompress export")
+		}
+		defer func() {
+			if err := gz.Close(); err != nil {
+				log.WithError(err).Error("Could not close decompressor")
+			}
+		}()
+		r = gz
+	}
+
+	count, err := slasher.ImportDatabase(cliCtx.Context, f.Path, r)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"path":    f.Path,
+		"records": count,
+	}).Info("Imported slasher database")
+	return nil
+}
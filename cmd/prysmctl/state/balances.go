@@ -0,0 +1,95 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var balancesFlags = struct {
+	ActiveOnly bool
+}{}
+
+var balancesCmd = &cli.Command{
+	Name:      "balances",
+	Usage:     "Show the distribution of the effective balances of the validators of a state, e.g. prysmctl state balances head",
+	ArgsUsage: sourceUsage,
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionBalances(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not compute effective balance distribution")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		beaconNodeHostFlag,
+		timeoutFlag,
+		&cli.BoolFlag{
+			Name:        "active-only",
+			Usage:       "only count the validators active at the epoch of the state",
+			Destination: &balancesFlags.ActiveOnly,
+		},
+	},
+}
+
+func cliActionBalances(cliCtx *cli.Context) error {
+	if cliCtx.NArg() != 1 {
+		return errors.New("expected one state")
+	}
+	st, err := loadState(context.Background(), cliCtx.Args().First())
+	if err != nil {
+		return err
+	}
+	d, err := effectiveBalanceDistribution(st, balancesFlags.ActiveOnly)
+	if err != nil {
+		return err
+	}
+
+	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
+	fmt.Printf("Validators: %d, total effective balance: %.0f ETH\n", d.validators, float64(d.total)/gweiPerEth)
+	for _, b := range d.balances() {
+		fmt.Printf("%6.0f ETH  %d\n", float64(b)/gweiPerEth, d.counts[b])
+	}
+	return nil
+}
+
+// balanceDistribution counts the validators by effective balance, in Gwei.
+type balanceDistribution struct {
+	counts     map[uint64]int
+	validators int
+	total      uint64
+}
+
+// balances returns the effective balances of the distribution in increasing order.
+func (d *balanceDistribution) balances() []uint64 {
+	balances := make([]uint64, 0, len(d.counts))
+	for b := range d.counts {
+		balances = append(balances, b)
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i] < balances[j] })
+	return balances
+}
+
+func effectiveBalanceDistribution(st state.BeaconState, activeOnly bool) (*balanceDistribution, error) {
+	epoch := slots.ToEpoch(st.Slot())
+	d := &balanceDistribution{counts: make(map[uint64]int)}
+	if err := st.ReadFromEveryValidator(func(_ int, val state.ReadOnlyValidator) error {
+		if activeOnly && !helpers.IsActiveValidatorUsingTrie(val, epoch) {
+			return nil
+		}
+		d.counts[val.EffectiveBalance()]++
+		d.validators++
+		d.total += val.EffectiveBalance()
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not read validators")
+	}
+	return d, nil
+}
@@ -0,0 +1,101 @@
+package state
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/urfave/cli/v2"
+)
+
+var Commands = []*cli.Command{
+	{
+		Name:  "state",
+		Usage: "commands for analyzing beacon states, read from ssz files or from the API of a beacon node",
+		Subcommands: []*cli.Command{
+			diffCmd,
+			validatorsCmd,
+			balancesCmd,
+			verifyRootCmd,
+		},
+	},
+}
+
+var stateFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+}{}
+
+var (
+	beaconNodeHostFlag = &cli.StringFlag{
+		Name:        "beacon-node-host",
+		Usage:       "host:port for beacon node to query, for the states given as a state id",
+		Destination: &stateFlags.BeaconNodeHost,
+		Value:       "http://localhost:3500",
+	}
+	timeoutFlag = &cli.DurationFlag{
+		Name:        "http-timeout",
+		Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 4m",
+		Destination: &stateFlags.Timeout,
+		Value:       4 * time.Minute,
+	}
+)
+
+const sourceUsage = "<ssz file or state id>"
+
+// stateIdPattern matches the state ids of the beacon API: a named state, a slot or a state root.
+var stateIdPattern = regexp.MustCompile(`^(head|genesis|finalized|justified|[0-9]+|0x[0-9a-fA-F]{64})$`)
+
+// isStateId reports whether the source is a state id of the beacon API.
+func isStateId(source string) bool {
+	return stateIdPattern.MatchString(source)
+}
+
+// newClient returns a client of the beacon node API.
+func newClient() (*beacon.Client, error) {
+	return beacon.NewClient(stateFlags.BeaconNodeHost, client.WithTimeout(stateFlags.Timeout), client.WithMaxBodySize(client.MaxBodySizeState))
+}
+
+// loadState reads the ssz encoded state from the file at the source path if it exists, or downloads it from
+// the beacon node if the source is a state id.
+func loadState(ctx context.Context, source string) (state.BeaconState, error) {
+	exists, err := file.Exists(source, file.Regular)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if %s exists", source)
+	}
+	var enc []byte
+	switch {
+	case exists:
+		enc, err = file.ReadFileAsBytes(source)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read state from %s", source)
+		}
+	case isStateId(source):
+		c, err := newClient()
+		if err != nil {
+			return nil, err
+		}
+		enc, err = c.GetState(ctx, beacon.StateOrBlockId(source))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("%s is neither an existing file nor a state id", source)
+	}
+
+	unmarshaler, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not detect the fork of state %s", source)
+	}
+	st, err := unmarshaler.UnmarshalBeaconState(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal state %s", source)
+	}
+	return st, nil
+}
@@ -0,0 +1,184 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxDiffIndices is the number of differing indices of a list which are shown.
+const maxDiffIndices = 10
+
+var diffCmd = &cli.Command{
+	Name:      "diff",
+	Usage:     "Compare two states field by field, e.g. prysmctl state diff state.ssz head",
+	ArgsUsage: sourceUsage + " " + sourceUsage,
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionDiff(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not compare states")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{beaconNodeHostFlag, timeoutFlag},
+}
+
+func cliActionDiff(cliCtx *cli.Context) error {
+	if cliCtx.NArg() != 2 {
+		return errors.New("expected two states to compare")
+	}
+	ctx := context.Background()
+	a, err := loadState(ctx, cliCtx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	b, err := loadState(ctx, cliCtx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	diffs, err := diffStates(a, b)
+	if err != nil {
+		return err
+	}
+	if len(diffs) == 0 {
+		fmt.Println("States are identical")
+		return nil
+	}
+	for _, d := range diffs {
+		fmt.Printf("%s: %s\n", d.field, d.summary)
+	}
+	return nil
+}
+
+// fieldDiff describes how a field differs between two states.
+type fieldDiff struct {
+	field   string
+	summary string
+}
+
+// diffStates compares the fields of the states. The fields of nested containers, such as the checkpoints,
+// are compared one by one, while the lists are compared element by element.
+func diffStates(a, b state.BeaconState) ([]*fieldDiff, error) {
+	pa, ok := a.ToProtoUnsafe().(proto.Message)
+	if !ok {
+		return nil, errors.New("first state is not a protobuf message")
+	}
+	pb, ok := b.ToProtoUnsafe().(proto.Message)
+	if !ok {
+		return nil, errors.New("second state is not a protobuf message")
+	}
+	var diffs []*fieldDiff
+	if a.Version() != b.Version() {
+		diffs = append(diffs, &fieldDiff{field: "version", summary: fmt.Sprintf("%s != %s", version.String(a.Version()), version.String(b.Version()))})
+	}
+	return append(diffs, diffMessages("", pa.ProtoReflect(), pb.ProtoReflect())...), nil
+}
+
+// diffMessages compares the fields of the messages, which may be of different types, by name.
+func diffMessages(prefix string, a, b protoreflect.Message) []*fieldDiff {
+	var diffs []*fieldDiff
+	fieldsA, fieldsB := a.Descriptor().Fields(), b.Descriptor().Fields()
+	for i := 0; i < fieldsA.Len(); i++ {
+		fa := fieldsA.Get(i)
+		name := prefix + string(fa.Name())
+		fb := fieldsB.ByName(fa.Name())
+		if fb == nil {
+			diffs = append(diffs, &fieldDiff{field: name, summary: "only in the first state"})
+			continue
+		}
+		va, vb := a.Get(fa), b.Get(fb)
+		switch {
+		case fa.IsList():
+			if s := diffLists(fa, va.List(), vb.List()); s != "" {
+				diffs = append(diffs, &fieldDiff{field: name, summary: s})
+			}
+		case fa.Kind() == protoreflect.MessageKind:
+			diffs = append(diffs, diffMessages(name+".", va.Message(), vb.Message())...)
+		case fa.Kind() == protoreflect.BytesKind:
+			if s := diffBytes(va.Bytes(), vb.Bytes()); s != "" {
+				diffs = append(diffs, &fieldDiff{field: name, summary: s})
+			}
+		default:
+			if va.Interface() != vb.Interface() {
+				diffs = append(diffs, &fieldDiff{field: name, summary: fmt.Sprintf("%v != %v", va.Interface(), vb.Interface())})
+			}
+		}
+	}
+	for i := 0; i < fieldsB.Len(); i++ {
+		fb := fieldsB.Get(i)
+		if fieldsA.ByName(fb.Name()) == nil {
+			diffs = append(diffs, &fieldDiff{field: prefix + string(fb.Name()), summary: "only in the second state"})
+		}
+	}
+	return diffs
+}
+
+// diffLists compares the lists element by element, and returns an empty summary if they are equal.
+func diffLists(fd protoreflect.FieldDescriptor, a, b protoreflect.List) string {
+	n := min(a.Len(), b.Len())
+	var indices []int
+	for i := 0; i < n; i++ {
+		if !equalElements(fd, a.Get(i), b.Get(i)) {
+			indices = append(indices, i)
+		}
+	}
+	return summarizeIndices(indices, a.Len(), b.Len(), "elements")
+}
+
+// diffBytes compares the byte arrays. The values are shown when they differ, unless they are too long, in
+// which case the differing bytes are counted.
+func diffBytes(a, b []byte) string {
+	if bytes.Equal(a, b) {
+		return ""
+	}
+	if len(a) <= 48 && len(b) <= 48 {
+		return fmt.Sprintf("%#x != %#x", a, b)
+	}
+	n := min(len(a), len(b))
+	var indices []int
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			indices = append(indices, i)
+		}
+	}
+	return summarizeIndices(indices, len(a), len(b), "bytes")
+}
+
+func equalElements(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		return proto.Equal(a.Message().Interface(), b.Message().Interface())
+	case protoreflect.BytesKind:
+		return bytes.Equal(a.Bytes(), b.Bytes())
+	default:
+		return a.Interface() == b.Interface()
+	}
+}
+
+// summarizeIndices describes the differing indices of two sequences of the given lengths, or returns an empty
+// summary if they are equal.
+func summarizeIndices(indices []int, lenA, lenB int, unit string) string {
+	var parts []string
+	if lenA != lenB {
+		parts = append(parts, fmt.Sprintf("length %d != %d", lenA, lenB))
+	}
+	if len(indices) > 0 {
+		shown := make([]string, 0, maxDiffIndices)
+		for _, i := range indices[:min(len(indices), maxDiffIndices)] {
+			shown = append(shown, fmt.Sprintf("%d", i))
+		}
+		if len(indices) > maxDiffIndices {
+			shown = append(shown, "...")
+		}
+		parts = append(parts, fmt.Sprintf("%d of %d %s differ at indices %s", len(indices), min(lenA, lenB), unit, strings.Join(shown, ", ")))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,99 @@
+package state
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var verifyRootFlags = struct {
+	ExpectedRoot string
+}{}
+
+var verifyRootCmd = &cli.Command{
+	Name: "verify-root",
+	Usage: "Compute the hash tree root of a state and check it against the expected root, or against the root " +
+		"reported by the beacon node for a state id, e.g. prysmctl state verify-root finalized",
+	ArgsUsage: sourceUsage,
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionVerifyRoot(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not verify state root")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		beaconNodeHostFlag,
+		timeoutFlag,
+		&cli.StringFlag{
+			Name:        "expected-root",
+			Usage:       "hex encoded root the state is expected to have",
+			Destination: &verifyRootFlags.ExpectedRoot,
+		},
+	},
+}
+
+func cliActionVerifyRoot(cliCtx *cli.Context) error {
+	if cliCtx.NArg() != 1 {
+		return errors.New("expected one state to verify")
+	}
+	ctx := context.Background()
+	source := cliCtx.Args().First()
+	st, err := loadState(ctx, source)
+	if err != nil {
+		return err
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not compute state root")
+	}
+	fmt.Printf("State root: %#x\n", root)
+
+	expected, err := expectedStateRoot(ctx, source)
+	if err != nil {
+		return err
+	}
+	if expected == nil {
+		return nil
+	}
+	if *expected != root {
+		return errors.Errorf("state root %#x does not match the expected root %#x", root, *expected)
+	}
+	fmt.Println("State root matches the expected root")
+	return nil
+}
+
+// expectedStateRoot returns the root given with the expected root flag or, for a state id, the root reported
+// by the beacon node. It returns nil for a state file when no root is expected.
+func expectedStateRoot(ctx context.Context, source string) (*[32]byte, error) {
+	if verifyRootFlags.ExpectedRoot != "" {
+		r, err := hexutil.Decode(verifyRootFlags.ExpectedRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode expected root")
+		}
+		if len(r) != 32 {
+			return nil, errors.Errorf("expected root has %d bytes instead of 32", len(r))
+		}
+		root := bytesutil.ToBytes32(r)
+		return &root, nil
+	}
+	exists, err := file.Exists(source, file.Regular)
+	if err != nil || exists || !isStateId(source) {
+		return nil, err
+	}
+	c, err := newClient()
+	if err != nil {
+		return nil, err
+	}
+	root, err := c.GetStateRoot(ctx, beacon.StateOrBlockId(source))
+	if err != nil {
+		return nil, err
+	}
+	return &root, nil
+}
@@ -0,0 +1,120 @@
+package state
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestIsStateId(t *testing.T) {
+	for _, id := range []string{"head", "genesis", "finalized", "justified", "123", "0xabcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789"} {
+		assert.Equal(t, true, isStateId(id), id)
+	}
+	for _, id := range []string{"state.ssz", "0x1234", "-1", ""} {
+		assert.Equal(t, false, isStateId(id), id)
+	}
+}
+
+func TestLoadState(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 16)
+	enc, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "state.ssz")
+	require.NoError(t, file.WriteFile(path, enc))
+
+	loaded, err := loadState(context.Background(), path)
+	require.NoError(t, err)
+	want, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	got, err := loaded.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = loadState(context.Background(), filepath.Join(t.TempDir(), "missing.ssz"))
+	require.ErrorContains(t, "is neither an existing file nor a state id", err)
+}
+
+func TestDiffStates(t *testing.T) {
+	a, _ := util.DeterministicGenesisState(t, 16)
+	diffs, err := diffStates(a, a.Copy())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(diffs))
+
+	b := a.Copy()
+	require.NoError(t, b.SetSlot(5))
+	require.NoError(t, b.UpdateBalancesAtIndex(3, 1))
+	require.NoError(t, b.UpdateBalancesAtIndex(7, 1))
+	require.NoError(t, b.SetFork(&ethpb.Fork{
+		PreviousVersion: a.Fork().PreviousVersion,
+		CurrentVersion:  a.Fork().CurrentVersion,
+		Epoch:           2,
+	}))
+	diffs, err = diffStates(a, b)
+	require.NoError(t, err)
+	summaries := make(map[string]string)
+	for _, d := range diffs {
+		summaries[d.field] = d.summary
+	}
+	assert.DeepEqual(t, map[string]string{
+		"slot":       "0 != 5",
+		"fork.epoch": "0 != 2",
+		"balances":   "2 of 16 elements differ at indices 3, 7",
+	}, summaries)
+}
+
+func TestSummarizeValidators(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateElectra(t, 8)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*10))
+	farFuture := params.BeaconConfig().FarFutureEpoch
+
+	exiting, err := st.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	exiting.ExitEpoch = 20
+	exiting.WithdrawableEpoch = 30
+	require.NoError(t, st.UpdateValidatorAtIndex(1, exiting))
+	queued, err := st.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	queued.ActivationEligibilityEpoch = 9
+	queued.ActivationEpoch = farFuture
+	require.NoError(t, st.UpdateValidatorAtIndex(2, queued))
+
+	s, err := summarizeValidators(st)
+	require.NoError(t, err)
+	assert.Equal(t, 8, s.total)
+	assert.Equal(t, 6, s.statuses[validator.ActiveOngoing])
+	assert.Equal(t, 1, s.statuses[validator.ActiveExiting])
+	assert.Equal(t, 1, s.statuses[validator.PendingQueued])
+	assert.Equal(t, 1, s.activationQueue)
+	assert.Equal(t, 1, s.exitQueue)
+	assert.Equal(t, 0, s.pendingConsolidations)
+}
+
+func TestEffectiveBalanceDistribution(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 4)
+	val, err := st.ValidatorAtIndex(0)
+	require.NoError(t, err)
+	val.EffectiveBalance = 31 * params.BeaconConfig().GweiPerEth
+	val.ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+	require.NoError(t, st.UpdateValidatorAtIndex(0, val))
+
+	d, err := effectiveBalanceDistribution(st, false)
+	require.NoError(t, err)
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	assert.Equal(t, 4, d.validators)
+	assert.Equal(t, 3*maxBalance+31*params.BeaconConfig().GweiPerEth, d.total)
+	assert.DeepEqual(t, []uint64{31 * params.BeaconConfig().GweiPerEth, maxBalance}, d.balances())
+	assert.Equal(t, 3, d.counts[maxBalance])
+
+	d, err = effectiveBalanceDistribution(st, true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, d.validators)
+	assert.DeepEqual(t, []uint64{maxBalance}, d.balances())
+}
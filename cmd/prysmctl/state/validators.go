@@ -0,0 +1,123 @@
+package state
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// validatorStatuses are the sub-statuses of the validators, in the order of their lifecycle.
+var validatorStatuses = []validator.Status{
+	validator.PendingInitialized,
+	validator.PendingQueued,
+	validator.ActiveOngoing,
+	validator.ActiveExiting,
+	validator.ActiveSlashed,
+	validator.ExitedUnslashed,
+	validator.ExitedSlashed,
+	validator.WithdrawalPossible,
+	validator.WithdrawalDone,
+}
+
+var validatorsCmd = &cli.Command{
+	Name:      "validators",
+	Usage:     "Summarize the statuses of the validators of a state and the lengths of its queues, e.g. prysmctl state validators finalized",
+	ArgsUsage: sourceUsage,
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionValidators(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not summarize validators")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{beaconNodeHostFlag, timeoutFlag},
+}
+
+func cliActionValidators(cliCtx *cli.Context) error {
+	if cliCtx.NArg() != 1 {
+		return errors.New("expected one state to summarize")
+	}
+	st, err := loadState(context.Background(), cliCtx.Args().First())
+	if err != nil {
+		return err
+	}
+	s, err := summarizeValidators(st)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Slot: %d, epoch: %d, validators: %d\n", st.Slot(), s.epoch, s.total)
+	for _, status := range validatorStatuses {
+		fmt.Printf("%-20s %d\n", status.String(), s.statuses[status])
+	}
+	fmt.Printf("Activation queue: %d\n", s.activationQueue)
+	fmt.Printf("Exit queue: %d\n", s.exitQueue)
+	if st.Version() >= version.Electra {
+		fmt.Printf("Pending deposits: %d\n", s.pendingDeposits)
+		fmt.Printf("Pending partial withdrawals: %d\n", s.pendingPartialWithdrawals)
+		fmt.Printf("Pending consolidations: %d\n", s.pendingConsolidations)
+	}
+	return nil
+}
+
+// validatorSummary counts the validators of a state by status, and the entries of its queues.
+type validatorSummary struct {
+	epoch    primitives.Epoch
+	total    int
+	statuses map[validator.Status]int
+	// activationQueue counts the validators eligible for activation which are not activated yet.
+	activationQueue int
+	// exitQueue counts the validators which initiated their exit and are not exited yet.
+	exitQueue                 int
+	pendingDeposits           int
+	pendingPartialWithdrawals int
+	pendingConsolidations     int
+}
+
+func summarizeValidators(st state.BeaconState) (*validatorSummary, error) {
+	epoch := slots.ToEpoch(st.Slot())
+	s := &validatorSummary{
+		epoch:    epoch,
+		total:    st.NumValidators(),
+		statuses: make(map[validator.Status]int),
+	}
+	if err := st.ReadFromEveryValidator(func(_ int, val state.ReadOnlyValidator) error {
+		status, err := helpers.ValidatorSubStatus(val, epoch)
+		if err != nil {
+			return err
+		}
+		s.statuses[status]++
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not get validator statuses")
+	}
+	s.activationQueue = s.statuses[validator.PendingQueued]
+	s.exitQueue = s.statuses[validator.ActiveExiting] + s.statuses[validator.ActiveSlashed]
+
+	if st.Version() >= version.Electra {
+		deposits, err := st.PendingDeposits()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get pending deposits")
+		}
+		s.pendingDeposits = len(deposits)
+		withdrawals, err := st.NumPendingPartialWithdrawals()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get pending partial withdrawals")
+		}
+		s.pendingPartialWithdrawals = int(withdrawals)
+		consolidations, err := st.NumPendingConsolidations()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get pending consolidations")
+		}
+		s.pendingConsolidations = int(consolidations)
+	}
+	return s, nil
+}
@@ -23,6 +23,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/interop"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -32,6 +33,7 @@ var (
 		DepositJsonFile    string
 		ChainConfigFile    string
 		NumValidators      uint64
+		NumExecutionCreds  uint64
 		GenesisTime        uint64
 		GenesisTimeDelay   uint64
 		OutputSSZ          string
@@ -88,6 +90,11 @@ var (
 				Destination: &generateGenesisStateFlags.NumValidators,
 				Required:    true,
 			},
+			&cli.Uint64Flag{
+				Name:        "num-execution-credentials",
+				Usage:       "Number of the deterministically generated validators, starting from the first, which get 0x01 execution withdrawal credentials instead of BLS ones",
+				Destination: &generateGenesisStateFlags.NumExecutionCreds,
+			},
 			&cli.Uint64Flag{
 				Name:        "genesis-time",
 				Destination: &generateGenesisStateFlags.GenesisTime,
@@ -122,9 +129,8 @@ var (
 			},
 			flags.EnumValue{
 				Name:        "fork",
-				Usage:       fmt.Sprintf("Name of the BeaconState schema to use in output encoding [%s]", strings.Join(versionNames(), ",")),
+				Usage:       fmt.Sprintf("Name of the BeaconState schema to use in output encoding [%s]. If unset, defaults to the fork the chain config activates at genesis", strings.Join(versionNames(), ",")),
 				Enum:        versionNames(),
-				Value:       versionNames()[0],
 				Destination: &generateGenesisStateFlags.ForkName,
 			}.GenericFlag(),
 			outputSSZFlag,
@@ -143,6 +149,25 @@ func versionNames() []string {
 	return names
 }
 
+// genesisVersion returns the version of the genesis state, which must be the one of the fork the chain config
+// activates at genesis, so that post-merge networks start directly in their latest fork.
+func genesisVersion(forkName string) (int, error) {
+	atGenesis := slots.ToForkVersion(0)
+	if forkName == "" {
+		log.Infof("No fork specified, using %s which the chain config activates at genesis", version.String(atGenesis))
+		return atGenesis, nil
+	}
+	v, err := version.FromString(forkName)
+	if err != nil {
+		return 0, err
+	}
+	if v != atGenesis {
+		return 0, fmt.Errorf("the chain config activates %s at genesis, not %s: the fork epochs up to %s must be 0 and the later ones above 0",
+			version.String(atGenesis), forkName, forkName)
+	}
+	return v, nil
+}
+
 // Represents a json object of hex string and uint64 values for
 // validators on Ethereum. This file can be generated using the official staking-deposit-cli.
 type depositDataJSON struct {
@@ -222,7 +247,7 @@ func generateGenesis(ctx context.Context) (state.BeaconState, error) {
 	f.GenesisTime += f.GenesisTimeDelay
 	log.Infof("Genesis is now %v", f.GenesisTime)
 
-	v, err := version.FromString(f.ForkName)
+	v, err := genesisVersion(f.ForkName)
 	if err != nil {
 		return nil, err
 	}
@@ -296,8 +321,7 @@ func generateGenesis(ctx context.Context) (state.BeaconState, error) {
 
 	gb := gen.ToBlock()
 
-	// TODO: expose the PregenesisCreds option with a cli flag - for now defaulting to no withdrawal credentials at genesis
-	genesisState, err := interop.NewPreminedGenesis(ctx, f.GenesisTime, nv, 0, v, gb, opts...)
+	genesisState, err := interop.NewPreminedGenesis(ctx, f.GenesisTime, nv, f.NumExecutionCreds, v, gb, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/runtime/interop"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -23,6 +25,28 @@ func Test_genesisStateFromJSONValidators(t *testing.T) {
 	}
 }
 
+func Test_genesisVersion(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 0
+	cfg.ElectraForkEpoch = 10
+	params.OverrideBeaconConfig(cfg)
+
+	v, err := genesisVersion("")
+	require.NoError(t, err)
+	assert.Equal(t, version.Deneb, v)
+	v, err = genesisVersion("deneb")
+	require.NoError(t, err)
+	assert.Equal(t, version.Deneb, v)
+	_, err = genesisVersion("capella")
+	require.ErrorContains(t, "activates deneb at genesis, not capella", err)
+	_, err = genesisVersion("electra")
+	require.ErrorContains(t, "activates deneb at genesis, not electra", err)
+}
+
 func createGenesisDepositData(t *testing.T, numKeys int) []*depositDataJSON {
 	pubKeys := make([]bls.PublicKey, numKeys)
 	privKeys := make([]bls.SecretKey, numKeys)
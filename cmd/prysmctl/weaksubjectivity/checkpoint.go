@@ -3,10 +3,14 @@ package weaksubjectivity
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/client"
 	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -14,12 +18,15 @@ import (
 var checkpointFlags = struct {
 	BeaconNodeHost string
 	Timeout        time.Duration
+	StatePath      string
+	ComputePeriod  bool
 }{}
 
 var checkpointCmd = &cli.Command{
 	Name:    "checkpoint",
 	Aliases: []string{"cpt"},
-	Usage:   "Compute the latest weak subjectivity checkpoint (block_root:epoch) using trusted server data.",
+	Usage: "Compute the latest weak subjectivity checkpoint (block_root:epoch) using trusted server data, " +
+		"or a trusted state file.",
 	Action: func(cliCtx *cli.Context) error {
 		if err := cliActionCheckpoint(cliCtx); err != nil {
 			log.WithError(err).Fatal("Could not perform checkpoint-sync")
@@ -39,6 +46,17 @@ var checkpointCmd = &cli.Command{
 			Destination: &checkpointFlags.Timeout,
 			Value:       time.Minute * 2,
 		},
+		&cli.StringFlag{
+			Name: "state",
+			Usage: "path to a trusted, recent head or finalized state in SSZ format, to compute the checkpoint and the " +
+				"weak subjectivity period from instead of querying a beacon node",
+			Destination: &checkpointFlags.StatePath,
+		},
+		&cli.BoolFlag{
+			Name:        "compute-period",
+			Usage:       "also download the head state of the beacon node to compute the weak subjectivity period",
+			Destination: &checkpointFlags.ComputePeriod,
+		},
 	},
 }
 
@@ -46,18 +64,57 @@ func cliActionCheckpoint(_ *cli.Context) error {
 	ctx := context.Background()
 	f := checkpointFlags
 
-	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
-	client, err := beacon.NewClient(checkpointFlags.BeaconNodeHost, opts...)
-	if err != nil {
-		return err
+	var ws *beacon.WeakSubjectivityData
+	var err error
+	if f.StatePath != "" {
+		ws, err = checkpointFromStateFile(ctx, f.StatePath)
+	} else {
+		ws, err = checkpointFromBeaconNode(ctx, f.BeaconNodeHost, f.Timeout, f.ComputePeriod)
 	}
-
-	ws, err := beacon.ComputeWeakSubjectivityCheckpoint(ctx, client)
 	if err != nil {
 		return err
 	}
+	if ws.Period != 0 {
+		fmt.Printf("\nWeak subjectivity period: %d epochs\n", ws.Period)
+	}
 	fmt.Println("\nUse the following flag when starting a prysm Beacon Node to ensure the chain history " +
 		"includes the Weak Subjectivity Checkpoint: ")
 	fmt.Printf("--weak-subjectivity-checkpoint=%s\n\n", ws.CheckpointString())
 	return nil
 }
+
+func checkpointFromBeaconNode(ctx context.Context, host string, timeout time.Duration, computePeriod bool) (*beacon.WeakSubjectivityData, error) {
+	opts := []client.ClientOpt{client.WithTimeout(timeout)}
+	client, err := beacon.NewClient(host, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ws, err := beacon.ComputeWeakSubjectivityCheckpoint(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if computePeriod {
+		ws.Period, err = beacon.GetWeakSubjectivityPeriod(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ws, nil
+}
+
+func checkpointFromStateFile(ctx context.Context, path string) (*beacon.WeakSubjectivityData, error) {
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state file")
+	}
+	vu, err := detect.FromState(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect chain config of state")
+	}
+	st, err := vu.UnmarshalBeaconState(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	return beacon.WeakSubjectivityFromState(ctx, st, vu.Config)
+}
@@ -145,6 +145,72 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "import-remote-manifest",
+			Description: "imports in bulk the public keys of a manifest, held by the Web3Signer of a running validator " +
+				"client, through its keymanager API. Keys are validated one by one and a summary of the import is reported",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.RemoteKeysManifestFileFlag,
+				flags.HTTPServerHost,
+				flags.HTTPServerPort,
+				flags.AuthTokenPathFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsImportRemoteManifest(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not import remote keys manifest")
+				}
+				return nil
+			},
+		},
+		{
+			Name: "audit",
+			Description: "audits the keystores of Prysm wallets and of a directory of EIP-2335 keystores: verifies that " +
+				"each keystore decrypts with the provided password, flags deprecated or weak encryption parameters and " +
+				"keys held more than once, and cross-checks the keys against the slashing protection history of the " +
+				"validator data directory",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.AuditWalletDirsFlag,
+				flags.KeysDirFlag,
+				flags.AccountPasswordFileFlag,
+				cmd.DataDirFlag,
+				features.EnableMinimalSlashingProtection,
+				features.EnableSQLiteValidatorDB,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsAudit(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not audit accounts")
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
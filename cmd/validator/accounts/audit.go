@@ -0,0 +1,164 @@
+package accounts
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqlite"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+func accountsAudit(c *cli.Context) error {
+	ctx := c.Context
+	cfg := &accounts.AuditConfig{}
+
+	walletDirs := make([]string, 0)
+	exists, err := wallet.Exists(c.String(flags.WalletDirFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, wallet.CheckExistsErrMsg)
+	}
+	// The default wallet directory is only audited if a wallet was created there.
+	if exists || c.IsSet(flags.WalletDirFlag.Name) {
+		walletDirs = append(walletDirs, c.String(flags.WalletDirFlag.Name))
+	}
+	walletDirs = append(walletDirs, c.StringSlice(flags.AuditWalletDirsFlag.Name)...)
+	if len(walletDirs) > 0 {
+		password, err := wallet.InputPassword(c, flags.WalletPasswordFileFlag, wallet.PasswordPromptText, false, wallet.ValidateExistingPass)
+		if err != nil {
+			return err
+		}
+		for _, dir := range walletDirs {
+			w, err := auditWallet(c, dir, password)
+			if err != nil {
+				return errors.Wrapf(err, "could not read wallet at path %s", dir)
+			}
+			if w != nil {
+				cfg.Wallets = append(cfg.Wallets, w)
+			}
+		}
+	}
+
+	if c.IsSet(flags.KeysDirFlag.Name) {
+		if cfg.Keystores, err = accounts.ReadAuditKeystores(ctx, c.String(flags.KeysDirFlag.Name)); err != nil {
+			return errors.Wrap(err, "could not read keystores")
+		}
+		if len(cfg.Keystores) > 0 {
+			cfg.KeystoresPassword, err = wallet.InputPassword(c, flags.AccountPasswordFileFlag, "Enter the password of the keystores to audit", false, prompt.NotEmpty)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if len(cfg.Wallets) == 0 && len(cfg.Keystores) == 0 {
+		return errors.New("no wallet or keystore to audit, use --wallet-dir, --audit-wallet-dirs or --keys-dir")
+	}
+
+	if c.IsSet(cmd.DataDirFlag.Name) {
+		validatorDB, err := openAuditDB(c)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := validatorDB.Close(); err != nil {
+				log.WithError(err).Error("Could not close validator DB")
+			}
+		}()
+		cfg.DB = validatorDB
+	} else {
+		log.Warnf("Keys not cross-checked against slashing protection history, set --%s to the validator data directory", cmd.DataDirFlag.Name)
+	}
+
+	report, err := accounts.AuditAccounts(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	for _, f := range report.Findings {
+		l := log.WithField("source", f.Source)
+		if f.Pubkey != "" {
+			l = l.WithField("pubkey", f.Pubkey)
+		}
+		if f.Critical {
+			l.Error(f.Message)
+		} else {
+			l.Warn(f.Message)
+		}
+	}
+	critical := report.Critical()
+	log.WithFields(logrus.Fields{
+		"keys":     report.Keys,
+		"critical": critical,
+		"warnings": len(report.Findings) - critical,
+	}).Info("Audited accounts")
+	if critical > 0 {
+		return fmt.Errorf("%d critical issues found", critical)
+	}
+	return nil
+}
+
+// auditWallet reads the accounts keystore of the wallet, or returns nil for wallets which do not hold keystores.
+func auditWallet(c *cli.Context, dir, password string) (*accounts.AuditWallet, error) {
+	w, err := wallet.OpenWallet(c.Context, &wallet.Config{WalletDir: dir, WalletPassword: password})
+	if err != nil {
+		return nil, err
+	}
+	if w.KeymanagerKind() != keymanager.Local {
+		log.WithField("wallet", dir).Warnf("Skipping %s wallet, only local wallets hold keystores", w.KeymanagerKind())
+		return nil, nil
+	}
+	encoded, err := w.ReadFileAtPath(c.Context, local.AccountsPath, local.AccountsKeystoreFileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read keystore file for accounts %s", local.AccountsKeystoreFileName)
+	}
+	ks := &local.AccountsKeystoreRepresentation{}
+	if err := json.Unmarshal(encoded, ks); err != nil {
+		return nil, errors.Wrapf(err, "could not decode keystore file for accounts %s", local.AccountsKeystoreFileName)
+	}
+	return &accounts.AuditWallet{Dir: dir, Keystore: ks, Password: w.Password()}, nil
+}
+
+// openAuditDB opens the slashing protection database of the data directory.
+func openAuditDB(c *cli.Context) (iface.ValidatorDB, error) {
+	dataDir := c.String(cmd.DataDirFlag.Name)
+	if c.Bool(features.EnableSQLiteValidatorDB.Name) {
+		found, _, err := file.RecursiveFileFind(sqlite.DatabaseFileName, dataDir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+		}
+		if !found {
+			return nil, fmt.Errorf("%s (validator database) was not found at path %s", sqlite.DatabaseFileName, dataDir)
+		}
+		return sqlite.NewStore(c.Context, dataDir, nil)
+	}
+	if c.Bool(features.EnableMinimalSlashingProtection.Name) {
+		found, _, err := file.RecursiveDirFind(filesystem.DatabaseDirName, dataDir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+		}
+		if !found {
+			return nil, fmt.Errorf("%s (validator database) was not found at path %s", filesystem.DatabaseDirName, dataDir)
+		}
+		return filesystem.NewStore(dataDir, nil)
+	}
+	found, _, err := file.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+	}
+	if !found {
+		return nil, fmt.Errorf("%s (validator database) was not found at path %s", kv.ProtectionDbFileName, dataDir)
+	}
+	return kv.NewKVStore(c.Context, dataDir, nil)
+}
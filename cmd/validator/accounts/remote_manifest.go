@@ -0,0 +1,94 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/rpc"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const remoteKeysManifestPath = api.WebUrlPrefix + "remotekeys/manifest"
+
+// accountsImportRemoteManifest imports the remote keys of a manifest into a running validator client through
+// its keymanager API, and reports the outcome of the import.
+func accountsImportRemoteManifest(c *cli.Context) error {
+	manifestPath := c.String(flags.RemoteKeysManifestFileFlag.Name)
+	if manifestPath == "" {
+		return fmt.Errorf("--%s is required", flags.RemoteKeysManifestFileFlag.Name)
+	}
+	enc, err := os.ReadFile(filepath.Clean(manifestPath))
+	if err != nil {
+		return errors.Wrapf(err, "could not read remote keys manifest %s", manifestPath)
+	}
+	// Catch malformed manifests before sending them to the validator client.
+	manifest, err := remoteweb3signer.ParseManifest(enc)
+	if err != nil {
+		return err
+	}
+	token, err := rpc.ReadAuthToken(c.String(flags.AuthTokenPathFlag.Name))
+	if err != nil {
+		return err
+	}
+	addr := net.JoinHostPort(c.String(flags.HTTPServerHost.Name), strconv.Itoa(c.Int(flags.HTTPServerPort.Name)))
+	resp, err := importRemoteKeysManifest(c.Context, "http://"+addr, token, enc)
+	if err != nil {
+		return err
+	}
+	for i, status := range resp.Data {
+		if status.Status != keymanager.StatusError || i >= len(manifest.RemoteKeys) {
+			continue
+		}
+		log.WithField("pubkey", manifest.RemoteKeys[i].Pubkey).Warnf("Could not import remote key: %s", status.Message)
+	}
+	log.WithFields(logrus.Fields{
+		"imported":  resp.Imported,
+		"duplicate": resp.Duplicate,
+		"failed":    resp.Failed,
+	}).Info("Imported remote keys manifest")
+	return nil
+}
+
+func importRemoteKeysManifest(ctx context.Context, baseURL, token string, manifest []byte) (*rpc.ImportRemoteKeysManifestResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+remoteKeysManifestPath, bytes.NewReader(manifest))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", api.JsonMediaType)
+	req.Header.Set("Authorization", "Bearer "+token)
+	httpResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reach the validator client, is it running with web3signer flags?")
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response body")
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("validator client returned status %d: %s", httpResp.StatusCode, string(body))
+	}
+	resp := &rpc.ImportRemoteKeysManifestResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode response body")
+	}
+	return resp, nil
+}
@@ -0,0 +1,49 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/rpc"
+)
+
+func TestImportRemoteKeysManifest(t *testing.T) {
+	manifest := []byte(`{"url":"http://localhost:9000","remote_keys":[{"pubkey":"0xa"},{"pubkey":"0xb"}]}`)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, remoteKeysManifestPath, r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.DeepEqual(t, manifest, body)
+		require.NoError(t, json.NewEncoder(w).Encode(&rpc.ImportRemoteKeysManifestResponse{
+			Data: []*keymanager.KeyStatus{
+				{Status: keymanager.StatusImported},
+				{Status: keymanager.StatusError, Message: "invalid pubkey"},
+			},
+			Imported: 1,
+			Failed:   1,
+		}))
+	}))
+	defer srv.Close()
+
+	resp, err := importRemoteKeysManifest(context.Background(), srv.URL, "token", manifest)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, keymanager.StatusError, resp.Data[1].Status)
+	assert.Equal(t, 1, resp.Imported)
+	assert.Equal(t, 1, resp.Failed)
+
+	_, err = importRemoteKeysManifest(context.Background(), srv.URL, "wrong", manifest)
+	require.ErrorContains(t, "validator client returned status 401", err)
+}
@@ -125,6 +125,22 @@ var (
 		Value:   "http://localhost:7500,http://127.0.0.1:7500,http://0.0.0.0:7500,http://localhost:4242,http://127.0.0.1:4242,http://localhost:4200,http://0.0.0.0:4242,http://127.0.0.1:4200,http://0.0.0.0:4200,http://localhost:3000,http://0.0.0.0:3000,http://127.0.0.1:3000",
 		Aliases: []string{"grpc-gateway-corsdomain"},
 	}
+	// HTTPServerAdditionalHostsFlag specifies other interfaces the HTTP server listens on.
+	HTTPServerAdditionalHostsFlag = &cli.StringSliceFlag{
+		Name: "http-additional-hosts",
+		Usage: "Other interfaces the HTTP server listens on along with --http-host, as host or host:port, e.g. a " +
+			"private network interface for remote staking dashboards. Entries without a port use --http-port.",
+	}
+	// HTTPServerTLSCertFlag specifies the certificate used to serve the HTTP server over TLS.
+	HTTPServerTLSCertFlag = &cli.StringFlag{
+		Name:  "http-tls-cert",
+		Usage: "Path to a PEM certificate to serve the HTTP server over TLS on all its interfaces, requires --http-tls-key.",
+	}
+	// HTTPServerTLSKeyFlag specifies the private key of the certificate used to serve the HTTP server over TLS.
+	HTTPServerTLSKeyFlag = &cli.StringFlag{
+		Name:  "http-tls-key",
+		Usage: "Path to the PEM private key of --http-tls-cert.",
+	}
 	// MonitoringPortFlag defines the http port used to serve prometheus metrics.
 	MonitoringPortFlag = &cli.IntFlag{
 		Name:  "monitoring-port",
@@ -139,6 +155,12 @@ var (
 		Value:   filepath.Join(filepath.Join(DefaultValidatorDir(), WalletDefaultDirName), api.AuthTokenFileName),
 		Aliases: []string{"validator-api-bearer-file"},
 	}
+	// AdditionalAuthTokensFileFlag defines the path to a file of other auth tokens accepted by the validator api.
+	AdditionalAuthTokensFileFlag = &cli.StringFlag{
+		Name: "keymanager-additional-tokens-file",
+		Usage: "Path to a file of other auth tokens accepted by the validator apis, one per line, e.g. one per remote " +
+			"dashboard. The file is reloaded when it changes, so that tokens can be rotated without restarting.",
+	}
 	// WalletDirFlag defines the path to a wallet directory for Prysm accounts.
 	WalletDirFlag = &cli.StringFlag{
 		Name:  "wallet-dir",
@@ -180,6 +202,29 @@ var (
 		Name:  "mnemonic-language",
 		Usage: "Allows specifying mnemonic language. Supported languages are: english|chinese_traditional|chinese_simplified|czech|french|japanese|korean|italian|spanish.",
 	}
+	// KeystoreKDFFlag defines the key derivation function wallet keystores are encrypted with.
+	KeystoreKDFFlag = &cli.StringFlag{
+		Name:  "keystore-kdf",
+		Usage: "Key derivation function wallet keystores are encrypted with, either scrypt or pbkdf2.",
+	}
+	// KeystoreKDFCostFlag defines the cost of the key derivation function wallet keystores are encrypted with.
+	KeystoreKDFCostFlag = &cli.IntFlag{
+		Name: "keystore-kdf-cost",
+		Usage: "Cost of the key derivation function wallet keystores are encrypted with: the scrypt cost parameter n, " +
+			"a power of two of at least 16384, or the pbkdf2 iteration count c, of at least 65536. Defaults to 262144.",
+	}
+	// KeystoreScryptRFlag defines the scrypt block size parameter of wallet keystores.
+	KeystoreScryptRFlag = &cli.IntFlag{
+		Name:  "keystore-scrypt-r",
+		Usage: "Block size parameter r of scrypt when wallet keystores are encrypted with scrypt.",
+		Value: 8,
+	}
+	// KeystoreScryptPFlag defines the scrypt parallelization parameter of wallet keystores.
+	KeystoreScryptPFlag = &cli.IntFlag{
+		Name:  "keystore-scrypt-p",
+		Usage: "Parallelization parameter p of scrypt when wallet keystores are encrypted with scrypt.",
+		Value: 1,
+	}
 	// ShowPrivateKeysFlag for accounts.
 	ShowPrivateKeysFlag = &cli.BoolFlag{
 		Name:  "show-private-keys",
@@ -250,6 +295,17 @@ var (
 		Usage: "Path to a directory where accounts will be backed up into a zip file.",
 		Value: DefaultValidatorDir(),
 	}
+	// WalletBackupFileFlag is the path to an encrypted wallet backup to restore.
+	WalletBackupFileFlag = &cli.StringFlag{
+		Name:  "wallet-backup-file",
+		Usage: "Path to an encrypted wallet backup, as written by the wallet backup command, to restore.",
+	}
+	// RemoteKeysManifestFileFlag is the path to a manifest of remote keys to import in bulk.
+	RemoteKeysManifestFileFlag = &cli.StringFlag{
+		Name: "remote-keys-manifest-file",
+		Usage: "Path to a JSON manifest of the public keys held by a Web3Signer, to import into a running validator " +
+			"client. For example: {\"url\":\"http://localhost:9000\",\"remote_keys\":[{\"pubkey\":\"0x...\"}]}",
+	}
 	// SlashingProtectionJSONFileFlag is used to enter the file path of the slashing protection JSON.
 	SlashingProtectionJSONFileFlag = &cli.StringFlag{
 		Name:  "slashing-protection-json-file",
@@ -260,6 +316,11 @@ var (
 		Name:  "keys-dir",
 		Usage: "Path to a directory where keystores to be imported are stored.",
 	}
+	// AuditWalletDirsFlag defines the wallets audited along with the one of --wallet-dir.
+	AuditWalletDirsFlag = &cli.StringSliceFlag{
+		Name:  "audit-wallet-dirs",
+		Usage: "Paths to other wallets to audit along with the one of --wallet-dir, unlocked with the same wallet password.",
+	}
 	// RemoteSignerCertPathFlag defines the path to a client.crt file for a wallet to connect to
 	// a secure signer via TLS and gRPC.
 	RemoteSignerCertPathFlag = &cli.StringFlag{
@@ -290,6 +351,13 @@ var (
 		Value:   "",
 		Aliases: []string{"remote-signer-url"},
 	}
+	// Web3SignerSecondaryURLFlag defines the URL of a web3signer used when the primary one fails or misses a signing deadline.
+	Web3SignerSecondaryURLFlag = &cli.StringFlag{
+		Name: "validators-external-signer-secondary-url",
+		Usage: "URL of a secondary web3signer holding the same keys, used right away when the web3signer of " +
+			"--validators-external-signer-url fails or exceeds a signing deadline.",
+		Value: "",
+	}
 	// Web3SignerPublicValidatorKeysFlag defines a comma-separated list of hex string public keys or external url for web3signer to use for validator signing.
 	// example with external url: --validators-external-signer-public-keys= https://web3signer.com/api/v1/eth2/publicKeys
 	// example with public key: --validators-external-signer-public-keys=0xa99a...e44c,0xb89b...4a0b
@@ -383,12 +451,102 @@ var (
 		Usage: "Sets the maximum size for one batch of validator registrations. Use a non-positive value to disable batching.",
 		Value: 0,
 	}
+	// ValidatorsRegistrationRefreshEpochsFlag sets the number of epochs between two rebroadcasts of all the validator registrations.
+	ValidatorsRegistrationRefreshEpochsFlag = &cli.Uint64Flag{
+		Name: "validators-registration-refresh-epochs",
+		Usage: "Sets the number of epochs between two rebroadcasts of all the validator registrations to the builder. " +
+			"New and changed registrations are always sent right away.",
+		Value: 1,
+	}
+	// ValidatorsRegistrationJitterFlag sets the maximum random delay before sending validator registrations.
+	ValidatorsRegistrationJitterFlag = &cli.DurationFlag{
+		Name: "validators-registration-jitter",
+		Usage: "Sets the maximum random delay before sending validator registrations to the builder, to spread the " +
+			"load of many validator clients on the relays. Must be shorter than the refresh interval.",
+		Value: 0,
+	}
 	// EnableDistributed enables the usage of prysm validator client in a Distributed Validator Cluster.
 	EnableDistributed = &cli.BoolFlag{
 		Name:  "distributed",
 		Usage: "To enable the use of prysm validator client in Distributed Validator Cluster",
 		Value: false,
 	}
+	// DryRunFlag runs the validator client in rehearsal mode.
+	DryRunFlag = &cli.BoolFlag{
+		Name: "dry-run",
+		Usage: "Rehearses the duties of the validators without using their keys: blocks, attestations, aggregates and sync " +
+			"committee messages are produced through the beacon node and signed with ephemeral keys, but never " +
+			"submitted. The slashing protection history is kept in a temporary database discarded on exit. Validates " +
+			"a new setup end to end, for instance with only the public keys of --validators-external-signer-public-keys.",
+	}
+	// ExpectedGenesisValidatorsRootFlag defines the genesis validators root of the chain the validators are on.
+	ExpectedGenesisValidatorsRootFlag = &cli.StringFlag{
+		Name: "expected-genesis-validators-root",
+		Usage: "Hex encoded genesis validators root of the chain the validators are on. The validator client refuses " +
+			"to start against a beacon node on another chain. Without it, the genesis validators root saved in the " +
+			"validator database by a previous run is expected.",
+	}
+	// ExpectedConfigNameFlag defines the name of the config of the chain the validators are on.
+	ExpectedConfigNameFlag = &cli.StringFlag{
+		Name: "expected-config-name",
+		Usage: "Name of the config of the chain the validators are on, such as mainnet or holesky. The validator client " +
+			"refuses to start with another config, or against a beacon node with another config. Defaults to the " +
+			"config of the validator client.",
+	}
+	// AttestationDelayFlag defines how long after the start of a slot the validator waits for a block before attesting.
+	AttestationDelayFlag = &cli.DurationFlag{
+		Name: "attestation-delay",
+		Usage: "How long after the start of a slot to wait for the block of the slot before attesting and submitting " +
+			"sync committee messages, if the block has not been received earlier. Defaults to a third of the slot. " +
+			"A later delay gives blocks arriving late more time to be voted as head, at the cost of the propagation " +
+			"time left to the attestations. Bounded to half a slot.",
+	}
+	// AggregationDelayFlag defines how long after the start of a slot the validator waits before aggregating.
+	AggregationDelayFlag = &cli.DurationFlag{
+		Name: "aggregation-delay",
+		Usage: "How long after the start of a slot to wait for the attestations and sync committee messages of the " +
+			"slot before submitting their aggregates. Defaults to two thirds of the slot. Bounded to at least a sixth " +
+			"of a slot after --attestation-delay and at most five sixths of a slot.",
+	}
+	// OffloadAggregationFlag delegates the construction of aggregate and proofs to the beacon node.
+	OffloadAggregationFlag = &cli.BoolFlag{
+		Name: "offload-aggregation",
+		Usage: "Requests aggregate and proofs fully constructed by the beacon node from the selection proof of the " +
+			"aggregator, in a single request instead of three. Only applies to the beacon REST API, and requires a " +
+			"Prysm beacon node; the standard endpoints are used otherwise.",
+	}
+	// AttestationSigningDeadlineFlag defines how long the validator waits for the signature of an attestation.
+	AttestationSigningDeadlineFlag = &cli.DurationFlag{
+		Name: "attestation-signing-deadline",
+		Usage: "How long to wait for the signer to sign an attestation before cancelling the request and failing over " +
+			"to --validators-external-signer-secondary-url, if set. Disabled by default.",
+	}
+	// BlockSigningDeadlineFlag defines how long the validator waits for the signatures of a block proposal.
+	BlockSigningDeadlineFlag = &cli.DurationFlag{
+		Name: "block-signing-deadline",
+		Usage: "How long to wait for the signer to sign a block or its randao reveal before cancelling the request " +
+			"and failing over to --validators-external-signer-secondary-url, if set. Disabled by default.",
+	}
+	// AggregateSigningDeadlineFlag defines how long the validator waits for the signatures of an aggregation.
+	AggregateSigningDeadlineFlag = &cli.DurationFlag{
+		Name: "aggregate-signing-deadline",
+		Usage: "How long to wait for the signer to sign a selection proof or an aggregate and proof before " +
+			"cancelling the request and failing over to --validators-external-signer-secondary-url, if set. " +
+			"Disabled by default.",
+	}
+	LightClientVerificationFlag = &cli.BoolFlag{
+		Name: "light-client-verification",
+		Usage: "Runs an embedded light client, following the sync committee signatures served by the beacon node, " +
+			"and alerts when the beacon node serves block headers inconsistent with the finalized chain of the " +
+			"light client. Requires the light client API of the beacon node at --beacon-rest-api-provider.",
+	}
+	// LightClientTrustedBlockRootFlag defines the block root the embedded light client bootstraps from.
+	LightClientTrustedBlockRootFlag = &cli.StringFlag{
+		Name: "light-client-trusted-block-root",
+		Usage: "Hex encoded root of a recent finalized block, obtained from a source independent of the beacon node, " +
+			"to bootstrap the embedded light client of --light-client-verification from. When unset, the finalized " +
+			"block of the beacon node at startup is trusted.",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
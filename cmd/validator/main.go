@@ -20,9 +20,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/web"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
-	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/journald"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	prefixed "github.com/prysmaticlabs/prysm/v5/runtime/logging/logrus-prefixed-formatter"
 	_ "github.com/prysmaticlabs/prysm/v5/runtime/maxprocs"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
@@ -65,6 +65,9 @@ var appFlags = []cli.Flag{
 	flags.GRPCRetryDelayFlag,
 	flags.GRPCHeadersFlag,
 	flags.HTTPServerCorsDomain,
+	flags.HTTPServerAdditionalHostsFlag,
+	flags.HTTPServerTLSCertFlag,
+	flags.HTTPServerTLSKeyFlag,
 	flags.DisableAccountMetricsFlag,
 	flags.MonitoringPortFlag,
 	flags.SlasherRPCProviderFlag,
@@ -74,9 +77,22 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDistributed,
+	flags.DryRunFlag,
+	flags.ExpectedGenesisValidatorsRootFlag,
+	flags.ExpectedConfigNameFlag,
+	flags.AttestationDelayFlag,
+	flags.AggregationDelayFlag,
+	flags.OffloadAggregationFlag,
+	flags.AttestationSigningDeadlineFlag,
+	flags.BlockSigningDeadlineFlag,
+	flags.AggregateSigningDeadlineFlag,
+	flags.LightClientVerificationFlag,
+	flags.LightClientTrustedBlockRootFlag,
 	flags.AuthTokenPathFlag,
+	flags.AdditionalAuthTokensFileFlag,
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
+	flags.Web3SignerSecondaryURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
 	flags.Web3SignerKeyFileFlag,
 	flags.SuggestedFeeRecipientFlag,
@@ -85,8 +101,13 @@ var appFlags = []cli.Flag{
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
 	flags.ValidatorsRegistrationBatchSizeFlag,
+	flags.ValidatorsRegistrationRefreshEpochsFlag,
+	flags.ValidatorsRegistrationJitterFlag,
 	////////////////////
 	cmd.DisableMonitoringFlag,
+	cmd.MetricsDisabledFamiliesFlag,
+	cmd.MetricsAggregatedLabelsFlag,
+	cmd.MetricsMaxSeriesPerFamilyFlag,
 	cmd.MonitoringHostFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
@@ -99,10 +120,18 @@ var appFlags = []cli.Flag{
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
+	cmd.TracingExporterFlag,
 	cmd.TraceSampleFractionFlag,
+	cmd.TraceFamilySampleFractionsFlag,
 	cmd.LogFormat,
 	cmd.LogFileName,
+	cmd.LogFileMaxSizeFlag,
+	cmd.LogFileRotationIntervalFlag,
+	cmd.LogFileMaxBackupsFlag,
+	cmd.LogFileCompressFlag,
 	cmd.ConfigFileFlag,
+	cmd.ProfileFlag,
+	cmd.ProfilesFileFlag,
 	cmd.ChainConfigFileFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
 	cmd.ApiTimeoutFlag,
@@ -142,6 +171,10 @@ func main() {
 		},
 		Flags: appFlags,
 		Before: func(ctx *cli.Context) error {
+			// Load flags from profile, if specified.
+			if err := cmd.LoadFlagsFromProfile(ctx, appFlags); err != nil {
+				return err
+			}
 			// Load flags from config file, if specified.
 			if err := cmd.LoadFlagsFromConfig(ctx, appFlags); err != nil {
 				return err
@@ -167,6 +200,8 @@ func main() {
 				logrus.SetFormatter(f)
 			case "json":
 				logrus.SetFormatter(&logrus.JSONFormatter{})
+			case "json-structured":
+				logrus.SetFormatter(&logging.JSONFormatter{})
 			case "journald":
 				if err := journald.Enable(); err != nil {
 					return err
@@ -176,7 +211,7 @@ func main() {
 			}
 
 			if logFileName != "" {
-				if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+				if err := cmd.ConfigurePersistentLogging(ctx, logFileName); err != nil {
 					log.WithError(err).Error("Failed to configuring logging to disk.")
 				}
 			}
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqlite"
 	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 	"github.com/urfave/cli/v2"
@@ -45,8 +46,9 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 			"a file that can then be imported into any other Prysm setup across computers",
 	)
 
-	// Check if a minimal database is requested
+	// Check if a minimal or a SQLite database is requested
 	isDatabaseMinimal := cliCtx.Bool(features.EnableMinimalSlashingProtection.Name)
+	isDatabaseSQLite := cliCtx.Bool(features.EnableSQLiteValidatorDB.Name)
 
 	// Read the data directory from the CLI context.
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
@@ -58,9 +60,12 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	}
 
 	// Ensure that the database is found under the specified dir or its subdirectories
-	if isDatabaseMinimal {
+	switch {
+	case isDatabaseSQLite:
+		found, _, err = file.RecursiveFileFind(sqlite.DatabaseFileName, dataDir)
+	case isDatabaseMinimal:
 		found, _, err = file.RecursiveDirFind(filesystem.DatabaseDirName, dataDir)
-	} else {
+	default:
 		found, _, err = file.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
 	}
 
@@ -70,16 +75,21 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 
 	if !found {
 		databaseFileDir := kv.ProtectionDbFileName
-		if isDatabaseMinimal {
+		if isDatabaseSQLite {
+			databaseFileDir = sqlite.DatabaseFileName
+		} else if isDatabaseMinimal {
 			databaseFileDir = filesystem.DatabaseDirName
 		}
 		return fmt.Errorf("%s (validator database) was not found at path %s, so nothing to export", databaseFileDir, dataDir)
 	}
 
 	// Open the validator database.
-	if isDatabaseMinimal {
+	switch {
+	case isDatabaseSQLite:
+		validatorDB, err = sqlite.NewStore(cliCtx.Context, dataDir, nil)
+	case isDatabaseMinimal:
 		validatorDB, err = filesystem.NewStore(dataDir, nil)
-	} else {
+	default:
 		validatorDB, err = kv.NewKVStore(cliCtx.Context, dataDir, nil)
 	}
 
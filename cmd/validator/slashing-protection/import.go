@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqlite"
 	"github.com/urfave/cli/v2"
 )
 
@@ -32,8 +33,9 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 		err   error
 	)
 
-	// Check if a minimal database is requested
+	// Check if a minimal or a SQLite database is requested
 	isDatabaseMimimal := cliCtx.Bool(features.EnableMinimalSlashingProtection.Name)
+	isDatabaseSQLite := cliCtx.Bool(features.EnableSQLiteValidatorDB.Name)
 
 	// Get the data directory from the CLI context.
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
@@ -45,9 +47,12 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	}
 
 	// Ensure that the database is found under the specified directory or its subdirectories
-	if isDatabaseMimimal {
+	switch {
+	case isDatabaseSQLite:
+		found, _, err = file.RecursiveFileFind(sqlite.DatabaseFileName, dataDir)
+	case isDatabaseMimimal:
 		found, _, err = file.RecursiveDirFind(filesystem.DatabaseDirName, dataDir)
-	} else {
+	default:
 		found, _, err = file.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
 	}
 
@@ -63,9 +68,12 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	log.Infof(message, dataDir)
 
 	// Open the validator database.
-	if isDatabaseMimimal {
+	switch {
+	case isDatabaseSQLite:
+		valDB, err = sqlite.NewStore(cliCtx.Context, dataDir, nil)
+	case isDatabaseMimimal:
 		valDB, err = filesystem.NewStore(dataDir, nil)
-	} else {
+	default:
 		valDB, err = kv.NewKVStore(cliCtx.Context, dataDir, nil)
 	}
 
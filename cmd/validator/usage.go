@@ -65,13 +65,24 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
+			cmd.TracingExporterFlag,
 			cmd.TraceSampleFractionFlag,
+			cmd.TraceFamilySampleFractionsFlag,
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
 			cmd.DisableMonitoringFlag,
+			cmd.MetricsDisabledFamiliesFlag,
+			cmd.MetricsAggregatedLabelsFlag,
+			cmd.MetricsMaxSeriesPerFamilyFlag,
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogFileMaxSizeFlag,
+			cmd.LogFileRotationIntervalFlag,
+			cmd.LogFileMaxBackupsFlag,
+			cmd.LogFileCompressFlag,
 			cmd.ConfigFileFlag,
+			cmd.ProfileFlag,
+			cmd.ProfilesFileFlag,
 			cmd.ChainConfigFileFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
 			cmd.AcceptTosFlag,
@@ -104,6 +115,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCRetriesFlag,
 			flags.GRPCRetryDelayFlag,
 			flags.HTTPServerCorsDomain,
+			flags.HTTPServerAdditionalHostsFlag,
+			flags.HTTPServerTLSCertFlag,
+			flags.HTTPServerTLSKeyFlag,
 			flags.GRPCHeadersFlag,
 			flags.BeaconRESTApiProviderFlag,
 		},
@@ -117,6 +131,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EnableBuilderFlag,
 			flags.BuilderGasLimitFlag,
 			flags.ValidatorsRegistrationBatchSizeFlag,
+			flags.ValidatorsRegistrationRefreshEpochsFlag,
+			flags.ValidatorsRegistrationJitterFlag,
 			flags.GraffitiFlag,
 			flags.GraffitiFileFlag,
 		},
@@ -125,6 +141,7 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "remote signer",
 		Flags: []cli.Flag{
 			flags.Web3SignerURLFlag,
+			flags.Web3SignerSecondaryURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
 			flags.Web3SignerKeyFileFlag,
 		},
@@ -143,7 +160,19 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisablePenaltyRewardLogFlag,
 			flags.DisableAccountMetricsFlag,
 			flags.EnableDistributed,
+			flags.DryRunFlag,
+			flags.ExpectedGenesisValidatorsRootFlag,
+			flags.ExpectedConfigNameFlag,
+			flags.AttestationDelayFlag,
+			flags.AggregationDelayFlag,
+			flags.OffloadAggregationFlag,
+			flags.AttestationSigningDeadlineFlag,
+			flags.BlockSigningDeadlineFlag,
+			flags.AggregateSigningDeadlineFlag,
+			flags.LightClientVerificationFlag,
+			flags.LightClientTrustedBlockRootFlag,
 			flags.AuthTokenPathFlag,
+			flags.AdditionalAuthTokensFileFlag,
 		},
 	},
 	{
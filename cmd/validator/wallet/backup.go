@@ -0,0 +1,192 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	dbiface "github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqlite"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	walletBackupDirPromptText  = "Enter the directory where your wallet backup will be written to"
+	walletBackupFilePromptText = "Enter the path to your wallet backup"
+)
+
+func walletBackup(c *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(c, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(c.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	validatorDB, err := openValidatorDB(c, false /* create */)
+	if err != nil {
+		return err
+	}
+	defer closeValidatorDB(validatorDB)
+
+	backupDir, err := userprompt.InputDirectory(c, walletBackupDirPromptText, flags.BackupDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse backup directory")
+	}
+	backupPath := filepath.Join(backupDir, accounts.WalletBackupFileName)
+	exists, err := file.Exists(backupPath, file.Regular)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if file exists: %s", backupPath)
+	}
+	if exists {
+		return fmt.Errorf("wallet backup already exists at path %s", backupPath)
+	}
+	backupPassword, err := prompt.InputPassword(
+		c,
+		flags.BackupPasswordFileFlag,
+		"Enter a new password for your wallet backup",
+		"Confirm new password",
+		true, /* Should confirm password */
+		prompt.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not determine password for wallet backup")
+	}
+
+	backup, err := accounts.BackupWallet(c.Context, &accounts.WalletBackupConfig{
+		Keymanager: km,
+		DB:         validatorDB,
+		Password:   backupPassword,
+	})
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(backup, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal wallet backup")
+	}
+	if err := file.MkdirAll(backupDir); err != nil {
+		return errors.Wrapf(err, "could not create directory at path: %s", backupDir)
+	}
+	if err := file.WriteFile(backupPath, encoded); err != nil {
+		return errors.Wrapf(err, "could not write file to path %s", backupPath)
+	}
+	log.WithField("backupPath", backupPath).Info(
+		"Successfully wrote wallet backup. You can restore it using the wallet restore command in another machine",
+	)
+	return nil
+}
+
+func walletRestore(c *cli.Context) error {
+	backupPath, err := userprompt.InputDirectory(c, walletBackupFilePromptText, flags.WalletBackupFileFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not get wallet backup file")
+	}
+	encoded, err := file.ReadFileAsBytes(backupPath)
+	if err != nil {
+		return err
+	}
+	backup := &accounts.EncryptedWalletBackup{}
+	if err := json.Unmarshal(encoded, backup); err != nil {
+		return errors.Wrapf(err, "could not decode wallet backup %s", backupPath)
+	}
+	backupPassword, err := wallet.InputPassword(
+		c,
+		flags.BackupPasswordFileFlag,
+		"Enter the password of your wallet backup",
+		false, /* Do not confirm password */
+		prompt.NotEmpty,
+	)
+	if err != nil {
+		return err
+	}
+
+	w, err := wallet.OpenWalletOrElseCli(c, wallet.OpenOrCreateNewWallet)
+	if err != nil {
+		return errors.Wrap(err, "could not open or create wallet")
+	}
+	km, err := w.InitializeKeymanager(c.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	importer, ok := km.(keymanager.Importer)
+	if !ok {
+		return fmt.Errorf("keymanager of kind %s cannot import keystores", w.KeymanagerKind())
+	}
+	validatorDB, err := openValidatorDB(c, true /* create */)
+	if err != nil {
+		return err
+	}
+	defer closeValidatorDB(validatorDB)
+
+	imported, err := accounts.RestoreWallet(c.Context, &accounts.WalletRestoreConfig{
+		Importer: importer,
+		DB:       validatorDB,
+		Password: backupPassword,
+	}, backup)
+	if err != nil {
+		return errors.Wrap(err, "could not restore wallet backup")
+	}
+	log.WithField("walletDir", w.AccountsDir()).Infof("Successfully restored wallet backup with %d accounts", imported)
+	return nil
+}
+
+// openValidatorDB opens the validator database of the data directory, creating it when absent if create is set.
+func openValidatorDB(c *cli.Context, create bool) (dbiface.ValidatorDB, error) {
+	dataDir := c.String(cmd.DataDirFlag.Name)
+	isDatabaseMinimal := c.Bool(features.EnableMinimalSlashingProtection.Name)
+	isDatabaseSQLite := c.Bool(features.EnableSQLiteValidatorDB.Name)
+	var (
+		found bool
+		err   error
+	)
+	switch {
+	case isDatabaseSQLite:
+		found, _, err = file.RecursiveFileFind(sqlite.DatabaseFileName, dataDir)
+	case isDatabaseMinimal:
+		found, _, err = file.RecursiveDirFind(filesystem.DatabaseDirName, dataDir)
+	default:
+		found, _, err = file.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+	}
+	if !found && !create {
+		return nil, fmt.Errorf("validator database was not found at path %s", dataDir)
+	}
+	var validatorDB dbiface.ValidatorDB
+	switch {
+	case isDatabaseSQLite:
+		validatorDB, err = sqlite.NewStore(c.Context, dataDir, nil)
+	case isDatabaseMinimal:
+		validatorDB, err = filesystem.NewStore(dataDir, nil)
+	default:
+		validatorDB, err = kv.NewKVStore(c.Context, dataDir, nil)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	return validatorDB, nil
+}
+
+func closeValidatorDB(validatorDB dbiface.ValidatorDB) {
+	if err := validatorDB.Close(); err != nil {
+		log.WithError(err).Error("Could not close validator DB")
+	}
+}
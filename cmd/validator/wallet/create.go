@@ -80,6 +80,11 @@ func ConstructCLIManagerOpts(cliCtx *cli.Context, keymanagerKind keymanager.Kind
 	if cliCtx.IsSet(flags.MnemonicLanguageFlag.Name) {
 		cliOpts = append(cliOpts, accounts.WithMnemonicLanguage(cliCtx.String(flags.MnemonicLanguageFlag.Name)))
 	}
+	kdfParams, err := inputKDFParams(cliCtx)
+	if err != nil {
+		return []accounts.Option{}, err
+	}
+	cliOpts = append(cliOpts, accounts.WithKDFParams(kdfParams))
 
 	skipMnemonic25thWord := cliCtx.IsSet(flags.SkipMnemonic25thWordCheckFlag.Name)
 	has25thWordFile := cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name)
@@ -85,6 +85,11 @@ func walletRecover(c *cli.Context) error {
 	opts = append(opts, accounts.WithWalletDir(walletDir))
 	opts = append(opts, accounts.WithWalletPassword(walletPassword))
 	opts = append(opts, accounts.WithNumAccounts(int(numAccounts)))
+	kdfParams, err := inputKDFParams(c)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, accounts.WithKDFParams(kdfParams))
 
 	acc, err := accounts.NewCLIManager(opts...)
 	if err != nil {
@@ -0,0 +1,66 @@
+package wallet
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/urfave/cli/v2"
+)
+
+func walletReEncrypt(c *cli.Context) error {
+	kdfParams, err := inputKDFParams(c)
+	if err != nil {
+		return err
+	}
+	if kdfParams == nil {
+		if kdfParams, err = keymanager.DefaultKDFParams(keymanager.ScryptKDF); err != nil {
+			return err
+		}
+	}
+	w, err := wallet.OpenWalletOrElseCli(c, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWallet(w),
+		accounts.WithKDFParams(kdfParams),
+	)
+	if err != nil {
+		return err
+	}
+	return acc.WalletReEncrypt(c.Context)
+}
+
+// inputKDFParams returns the key derivation function parameters wallet keystores are encrypted with
+// according to the keystore flags, or nil when none is set.
+func inputKDFParams(c *cli.Context) (*keymanager.KDFParams, error) {
+	if !c.IsSet(flags.KeystoreKDFFlag.Name) && !c.IsSet(flags.KeystoreKDFCostFlag.Name) &&
+		!c.IsSet(flags.KeystoreScryptRFlag.Name) && !c.IsSet(flags.KeystoreScryptPFlag.Name) {
+		return nil, nil
+	}
+	function := keymanager.ScryptKDF
+	if c.IsSet(flags.KeystoreKDFFlag.Name) {
+		function = c.String(flags.KeystoreKDFFlag.Name)
+	}
+	params, err := keymanager.DefaultKDFParams(function)
+	if err != nil {
+		return nil, err
+	}
+	if function == keymanager.ScryptKDF {
+		if c.IsSet(flags.KeystoreKDFCostFlag.Name) {
+			params.ScryptN = c.Int(flags.KeystoreKDFCostFlag.Name)
+		}
+		params.ScryptR = c.Int(flags.KeystoreScryptRFlag.Name)
+		params.ScryptP = c.Int(flags.KeystoreScryptPFlag.Name)
+	} else if c.IsSet(flags.KeystoreKDFCostFlag.Name) {
+		params.PBKDF2C = c.Int(flags.KeystoreKDFCostFlag.Name)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid keystore key derivation function")
+	}
+	return params, nil
+}
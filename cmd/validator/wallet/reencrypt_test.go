@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/urfave/cli/v2"
+)
+
+func TestInputKDFParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]string
+		want    *keymanager.KDFParams
+		wantErr string
+	}{
+		{
+			name: "no flags",
+		},
+		{
+			name: "scrypt cost",
+			args: map[string]string{flags.KeystoreKDFCostFlag.Name: "1048576"},
+			want: &keymanager.KDFParams{Function: keymanager.ScryptKDF, ScryptN: 1 << 20, ScryptR: 8, ScryptP: 1},
+		},
+		{
+			name: "scrypt parameters",
+			args: map[string]string{
+				flags.KeystoreKDFFlag.Name:     keymanager.ScryptKDF,
+				flags.KeystoreScryptRFlag.Name: "16",
+				flags.KeystoreScryptPFlag.Name: "2",
+			},
+			want: &keymanager.KDFParams{Function: keymanager.ScryptKDF, ScryptN: keymanager.DefaultScryptN, ScryptR: 16, ScryptP: 2},
+		},
+		{
+			name: "pbkdf2",
+			args: map[string]string{flags.KeystoreKDFFlag.Name: keymanager.PBKDF2KDF},
+			want: &keymanager.KDFParams{Function: keymanager.PBKDF2KDF, PBKDF2C: keymanager.DefaultPBKDF2C},
+		},
+		{
+			name: "pbkdf2 cost",
+			args: map[string]string{flags.KeystoreKDFFlag.Name: keymanager.PBKDF2KDF, flags.KeystoreKDFCostFlag.Name: "1000000"},
+			want: &keymanager.KDFParams{Function: keymanager.PBKDF2KDF, PBKDF2C: 1000000},
+		},
+		{
+			name:    "weak cost",
+			args:    map[string]string{flags.KeystoreKDFCostFlag.Name: "1024"},
+			wantErr: "invalid keystore key derivation function",
+		},
+		{
+			name:    "unsupported function",
+			args:    map[string]string{flags.KeystoreKDFFlag.Name: "argon2"},
+			wantErr: "unsupported key derivation function",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			set.String(flags.KeystoreKDFFlag.Name, "", "")
+			set.Int(flags.KeystoreKDFCostFlag.Name, 0, "")
+			set.Int(flags.KeystoreScryptRFlag.Name, flags.KeystoreScryptRFlag.Value, "")
+			set.Int(flags.KeystoreScryptPFlag.Name, flags.KeystoreScryptPFlag.Value, "")
+			for name, value := range tt.args {
+				require.NoError(t, set.Set(name, value))
+			}
+			params, err := inputKDFParams(cli.NewContext(&cli.App{}, set, nil))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, params)
+		})
+	}
+}
@@ -30,6 +30,10 @@ var Commands = &cli.Command{
 				flags.WalletPasswordFileFlag,
 				flags.Mnemonic25thWordFileFlag,
 				flags.SkipMnemonic25thWordCheckFlag,
+				flags.KeystoreKDFFlag,
+				flags.KeystoreKDFCostFlag,
+				flags.KeystoreScryptRFlag,
+				flags.KeystoreScryptPFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
@@ -61,6 +65,10 @@ var Commands = &cli.Command{
 				flags.NumAccountsFlag,
 				flags.Mnemonic25thWordFileFlag,
 				flags.SkipMnemonic25thWordCheckFlag,
+				flags.KeystoreKDFFlag,
+				flags.KeystoreKDFCostFlag,
+				flags.KeystoreScryptRFlag,
+				flags.KeystoreScryptPFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
@@ -82,5 +90,103 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "backup",
+			Usage: "writes an encrypted backup of the keystores, slashing protection history and proposer settings " +
+				"of a validator, to be restored with the restore command",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.BackupDirFlag,
+				flags.BackupPasswordFileFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				features.EnableMinimalSlashingProtection,
+				features.EnableSQLiteValidatorDB,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := walletBackup(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not back up wallet")
+				}
+				return nil
+			},
+		},
+		{
+			Name: "restore",
+			Usage: "restores an encrypted wallet backup into a wallet, creating it if needed, " +
+				"and into the validator database",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.WalletBackupFileFlag,
+				flags.BackupPasswordFileFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				features.EnableMinimalSlashingProtection,
+				features.EnableSQLiteValidatorDB,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := walletRestore(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not restore wallet backup")
+				}
+				return nil
+			},
+		},
+		{
+			Name: "re-encrypt",
+			Usage: "encrypts the keystore of an existing wallet again with the desired key derivation function, " +
+				"such as to upgrade it to stronger parameters",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.KeystoreKDFFlag,
+				flags.KeystoreKDFCostFlag,
+				flags.KeystoreScryptRFlag,
+				flags.KeystoreScryptPFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := walletReEncrypt(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not re-encrypt wallet")
+				}
+				return nil
+			},
+		},
 	},
 }
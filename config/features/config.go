@@ -62,6 +62,7 @@ type Flags struct {
 	EnableSlasher                   bool // Enable slasher in the beacon node runtime.
 	EnableSlashingProtectionPruning bool // Enable slashing protection pruning for the validator client.
 	EnableMinimalSlashingProtection bool // Enable minimal slashing protection database for the validator client.
+	EnableSQLiteValidatorDB         bool // Enable the SQLite validator database for the validator client.
 
 	SaveFullExecutionPayloads bool // Save full beacon blocks with execution payloads in the database.
 	EnableStartOptimistic     bool // EnableStartOptimistic treats every block as optimistic at startup.
@@ -80,6 +81,10 @@ type Flags struct {
 
 	EnableDiscoveryReboot bool // EnableDiscoveryReboot allows the node to have its local listener to be rebooted in the event of discovery issues.
 
+	EnableBuilderSSZ bool // EnableBuilderSSZ prefers SSZ encoding for builder API requests and responses.
+
+	DisableReorgLateBlocks bool // DisableReorgLateBlocks disables proposer reorgs of late blocks.
+
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
 	KeystoreImportDebounceInterval time.Duration
@@ -254,6 +259,14 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(BlobSaveFsync)
 		cfg.BlobSaveFsync = true
 	}
+	if ctx.IsSet(EnableBuilderSSZ.Name) {
+		logEnabled(EnableBuilderSSZ)
+		cfg.EnableBuilderSSZ = true
+	}
+	if ctx.IsSet(DisableReorgLateBlocks.Name) {
+		logEnabled(DisableReorgLateBlocks)
+		cfg.DisableReorgLateBlocks = true
+	}
 	cfg.EnableQUIC = true
 	if ctx.IsSet(DisableQUIC.Name) {
 		logDisabled(DisableQUIC)
@@ -297,6 +310,10 @@ func ConfigureValidator(ctx *cli.Context) error {
 		logEnabled(EnableMinimalSlashingProtection)
 		cfg.EnableMinimalSlashingProtection = true
 	}
+	if ctx.Bool(EnableSQLiteValidatorDB.Name) {
+		logEnabled(EnableSQLiteValidatorDB)
+		cfg.EnableSQLiteValidatorDB = true
+	}
 	if ctx.Bool(enableDoppelGangerProtection.Name) {
 		logEnabled(enableDoppelGangerProtection)
 		cfg.EnableDoppelGanger = true
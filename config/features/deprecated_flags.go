@@ -24,11 +24,6 @@ var (
 		Usage:  deprecatedUsage,
 		Hidden: true,
 	}
-	deprecatedDisableReorgLateBlocks = &cli.BoolFlag{
-		Name:   "disable-reorg-late-blocks",
-		Usage:  deprecatedUsage,
-		Hidden: true,
-	}
 	deprecatedDisableOptionalEngineMethods = &cli.BoolFlag{
 		Name:   "disable-optional-engine-methods",
 		Usage:  deprecatedUsage,
@@ -105,7 +100,6 @@ var deprecatedFlags = []cli.Flag{
 	exampleDeprecatedFeatureFlag,
 	deprecatedEnableOptionalEngineMethods,
 	deprecatedDisableBuildBlockParallel,
-	deprecatedDisableReorgLateBlocks,
 	deprecatedDisableOptionalEngineMethods,
 	deprecatedDisableAggregateParallel,
 	deprecatedEnableEIP4881,
@@ -101,6 +101,10 @@ var (
 		Name:  "enable-minimal-slashing-protection",
 		Usage: "(Experimental): Enables the minimal slashing protection. See EIP-3076 for more details.",
 	}
+	EnableSQLiteValidatorDB = &cli.BoolFlag{
+		Name:  "enable-sqlite-validator-db",
+		Usage: "(Experimental): Stores the validator database and its slashing protection history in SQLite.",
+	}
 	enableDoppelGangerProtection = &cli.BoolFlag{
 		Name: "enable-doppelganger",
 		Usage: `Enables the validator to perform a doppelganger check. 
@@ -174,6 +178,16 @@ var (
 		Name:  "enable-discovery-reboot",
 		Usage: "Experimental: Enables the discovery listener to rebooted in the event of connectivity issues.",
 	}
+	// EnableBuilderSSZ prefers SSZ encoded bodies when talking to a builder relay.
+	EnableBuilderSSZ = &cli.BoolFlag{
+		Name:  "enable-builder-ssz",
+		Usage: "Enables SSZ encoded request and response bodies for builder API calls, falling back to JSON for relays that do not support it.",
+	}
+	// DisableReorgLateBlocks stops proposers from orphaning late blocks.
+	DisableReorgLateBlocks = &cli.BoolFlag{
+		Name:  "disable-reorg-late-blocks",
+		Usage: "Disables proposer reorgs of weak, late blocks. Proposers always build on the current head.",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -191,6 +205,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	attestTimely,
 	enableSlashingProtectionPruning,
 	EnableMinimalSlashingProtection,
+	EnableSQLiteValidatorDB,
 	enableDoppelGangerProtection,
 	EnableBeaconRESTApi,
 }...)
@@ -231,6 +246,8 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	DisableQUIC,
 	DisableCommitteeAwarePacking,
 	EnableDiscoveryReboot,
+	EnableBuilderSSZ,
+	DisableReorgLateBlocks,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -25,6 +25,11 @@ func isMinimal(lines []string) bool {
 	return false
 }
 
+// legacyConfigKeys maps config keys previously used by Prysm to their name in the consensus specs.
+var legacyConfigKeys = map[string]string{
+	"REORG_WEIGHT_THRESHOLD": "REORG_HEAD_WEIGHT_THRESHOLD",
+}
+
 func UnmarshalConfig(yamlFile []byte, conf *BeaconChainConfig) (*BeaconChainConfig, error) {
 	// To track if config name is defined inside config file.
 	hasConfigName := false
@@ -38,6 +43,13 @@ func UnmarshalConfig(yamlFile []byte, conf *BeaconChainConfig) (*BeaconChainConf
 			conf = MainnetConfig().Copy()
 		}
 	}
+	// Keys set in the file, so that a legacy key is ignored when the file also sets its new name.
+	keys := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		if key, _, ok := strings.Cut(line, ":"); ok && !strings.HasPrefix(line, "#") {
+			keys[strings.TrimSpace(key)] = true
+		}
+	}
 	for i, line := range lines {
 		// No need to convert the deposit contract address to byte array (as config expects a string).
 		if strings.HasPrefix(line, "DEPOSIT_CONTRACT_ADDRESS") {
@@ -46,12 +58,27 @@ func UnmarshalConfig(yamlFile []byte, conf *BeaconChainConfig) (*BeaconChainConf
 		if strings.HasPrefix(line, "CONFIG_NAME") {
 			hasConfigName = true
 		}
+		for legacy, key := range legacyConfigKeys {
+			if !strings.HasPrefix(line, legacy+":") {
+				continue
+			}
+			if keys[key] {
+				log.WithFields(log.Fields{
+					"legacyKey": legacy,
+					"key":       key,
+				}).Warn("Config file sets both a legacy key and its new name, ignoring the legacy key")
+				lines[i] = ""
+				continue
+			}
+			lines[i] = key + strings.TrimPrefix(line, legacy)
+		}
 		if !strings.HasPrefix(line, "#") && strings.Contains(line, "0x") {
 			parts := ReplaceHexStringWithYAMLFormat(line)
 			lines[i] = strings.Join(parts, "\n")
 		}
 	}
 	yamlFile = []byte(strings.Join(lines, "\n"))
+	base := conf.Copy()
 	if err := yaml.UnmarshalStrict(yamlFile, conf); err != nil {
 		var typeError *yaml.TypeError
 		if !errors.As(err, &typeError) {
@@ -65,10 +92,52 @@ func UnmarshalConfig(yamlFile []byte, conf *BeaconChainConfig) (*BeaconChainConf
 	}
 	// recompute SqrRootSlotsPerEpoch constant to handle non-standard values of SlotsPerEpoch
 	conf.SqrRootSlotsPerEpoch = primitives.Slot(math.IntegerSquareRoot(uint64(conf.SlotsPerEpoch)))
+	if err := validateForkChoiceConfig(conf); err != nil {
+		return nil, err
+	}
+	logForkChoiceOverrides(base, conf)
 	log.Debugf("Config file values: %+v", conf)
 	return conf, nil
 }
 
+// validateForkChoiceConfig rejects fork choice constants that would break the fork choice timing assumptions.
+func validateForkChoiceConfig(conf *BeaconChainConfig) error {
+	if conf.IntervalsPerSlot == 0 || conf.IntervalsPerSlot > conf.SecondsPerSlot {
+		return fmt.Errorf("INTERVALS_PER_SLOT must be between 1 and SECONDS_PER_SLOT (%d), got %d", conf.SecondsPerSlot, conf.IntervalsPerSlot)
+	}
+	if conf.ReorgProposerCutoffSeconds >= conf.SecondsPerSlot {
+		return fmt.Errorf("REORG_PROPOSER_CUTOFF_SECONDS must be lower than SECONDS_PER_SLOT (%d), got %d", conf.SecondsPerSlot, conf.ReorgProposerCutoffSeconds)
+	}
+	return nil
+}
+
+// logForkChoiceOverrides warns about the fork choice constants that a config file changed from the base config,
+// as nodes disagreeing on them may not agree on the canonical head.
+func logForkChoiceOverrides(base, conf *BeaconChainConfig) {
+	fields := log.Fields{}
+	if conf.ProposerScoreBoost != base.ProposerScoreBoost {
+		fields["proposerScoreBoost"] = conf.ProposerScoreBoost
+	}
+	if conf.ReorgWeightThreshold != base.ReorgWeightThreshold {
+		fields["reorgHeadWeightThreshold"] = conf.ReorgWeightThreshold
+	}
+	if conf.ReorgParentWeightThreshold != base.ReorgParentWeightThreshold {
+		fields["reorgParentWeightThreshold"] = conf.ReorgParentWeightThreshold
+	}
+	if conf.ReorgMaxEpochsSinceFinalization != base.ReorgMaxEpochsSinceFinalization {
+		fields["reorgMaxEpochsSinceFinalization"] = conf.ReorgMaxEpochsSinceFinalization
+	}
+	if conf.ReorgProposerCutoffSeconds != base.ReorgProposerCutoffSeconds {
+		fields["reorgProposerCutoffSeconds"] = conf.ReorgProposerCutoffSeconds
+	}
+	if conf.IntervalsPerSlot != base.IntervalsPerSlot {
+		fields["intervalsPerSlot"] = conf.IntervalsPerSlot
+	}
+	if len(fields) > 0 {
+		log.WithFields(fields).Warn("Fork choice constants overridden by config file")
+	}
+}
+
 func UnmarshalConfigFile(path string, conf *BeaconChainConfig) (*BeaconChainConfig, error) {
 	yamlFile, err := os.ReadFile(path) // #nosec G304
 	if err != nil {
@@ -233,6 +302,11 @@ func ConfigToYaml(cfg *BeaconChainConfig) []byte {
 		fmt.Sprintf("MESSAGE_DOMAIN_INVALID_SNAPPY:  %#x", cfg.MessageDomainInvalidSnappy),
 		fmt.Sprintf("MESSAGE_DOMAIN_VALID_SNAPPY: %#x", cfg.MessageDomainValidSnappy),
 		fmt.Sprintf("MIN_EPOCHS_FOR_BLOCK_REQUESTS: %d", int(cfg.MinEpochsForBlockRequests)),
+		fmt.Sprintf("PROPOSER_SCORE_BOOST: %d", cfg.ProposerScoreBoost),
+		fmt.Sprintf("REORG_HEAD_WEIGHT_THRESHOLD: %d", cfg.ReorgWeightThreshold),
+		fmt.Sprintf("REORG_PARENT_WEIGHT_THRESHOLD: %d", cfg.ReorgParentWeightThreshold),
+		fmt.Sprintf("REORG_MAX_EPOCHS_SINCE_FINALIZATION: %d", cfg.ReorgMaxEpochsSinceFinalization),
+		fmt.Sprintf("REORG_PROPOSER_CUTOFF_SECONDS: %d", cfg.ReorgProposerCutoffSeconds),
 	}
 
 	yamlFile := []byte(strings.Join(lines, "\n"))
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
 	"gopkg.in/yaml.v2"
 )
 
@@ -43,7 +44,6 @@ var placeholderFields = []string{
 	"MAX_REQUEST_BLOB_SIDECARS_EIP7594",
 	"MAX_REQUEST_PAYLOADS",         // Compile time constant on BeaconBlockBody.ExecutionRequests
 	"MAX_TRANSACTIONS_PER_PAYLOAD", // Compile time constant on ExecutionPayload.transactions.
-	"REORG_HEAD_WEIGHT_THRESHOLD",
 	"TARGET_NUMBER_OF_PEERS",
 	"UPDATE_TIMEOUT",
 	"WHISK_EPOCHS_PER_SHUFFLING_PHASE",
@@ -260,6 +260,47 @@ func TestLoadConfigFile_OverwriteCorrectly(t *testing.T) {
 	require.Equal(t, params.MinimalName, params.BeaconConfig().ConfigName)
 }
 
+func TestUnmarshalConfig_ForkChoiceOverrides(t *testing.T) {
+	y := []byte(strings.Join([]string{
+		"PRESET_BASE: 'mainnet'",
+		"PROPOSER_SCORE_BOOST: 70",
+		"REORG_PARENT_WEIGHT_THRESHOLD: 150",
+		"REORG_PROPOSER_CUTOFF_SECONDS: 3",
+		"INTERVALS_PER_SLOT: 4",
+	}, "\n"))
+	cfg, err := params.UnmarshalConfig(y, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(70), cfg.ProposerScoreBoost)
+	assert.Equal(t, uint64(150), cfg.ReorgParentWeightThreshold)
+	assert.Equal(t, uint64(3), cfg.ReorgProposerCutoffSeconds)
+	assert.Equal(t, uint64(4), cfg.IntervalsPerSlot)
+	assert.Equal(t, params.MainnetConfig().ReorgWeightThreshold, cfg.ReorgWeightThreshold)
+
+	t.Run("legacy reorg weight key", func(t *testing.T) {
+		cfg, err := params.UnmarshalConfig([]byte("REORG_WEIGHT_THRESHOLD: 30"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(30), cfg.ReorgWeightThreshold)
+		cfg, err = params.UnmarshalConfig([]byte("REORG_HEAD_WEIGHT_THRESHOLD: 35"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(35), cfg.ReorgWeightThreshold)
+	})
+	t.Run("legacy and new reorg weight keys", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		cfg, err := params.UnmarshalConfig([]byte("REORG_WEIGHT_THRESHOLD: 30\nREORG_HEAD_WEIGHT_THRESHOLD: 35"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(35), cfg.ReorgWeightThreshold)
+		require.LogsContain(t, hook, "ignoring the legacy key")
+	})
+	t.Run("invalid intervals per slot", func(t *testing.T) {
+		_, err := params.UnmarshalConfig([]byte("INTERVALS_PER_SLOT: 0"), nil)
+		require.ErrorContains(t, "INTERVALS_PER_SLOT", err)
+	})
+	t.Run("invalid proposer cutoff", func(t *testing.T) {
+		_, err := params.UnmarshalConfig([]byte("REORG_PROPOSER_CUTOFF_SECONDS: 12"), nil)
+		require.ErrorContains(t, "REORG_PROPOSER_CUTOFF_SECONDS", err)
+	})
+}
+
 func Test_replaceHexStringWithYAMLFormat(t *testing.T) {
 
 	testLines := []struct {
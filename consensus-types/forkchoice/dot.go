@@ -0,0 +1,78 @@
+package forkchoice
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// DOT renders the fork choice dump as a Graphviz digraph. Every node is labeled with its slot, root, weight and
+// validity, with an edge from each node to its parent. Invalid nodes are filled red, optimistic nodes yellow, and
+// the head, justified and finalized nodes are outlined so fork events can be spotted at a glance.
+func (d *Dump) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph forkchoice {\n")
+	b.WriteString("\trankdir=RL;\n")
+	b.WriteString("\tnode [shape=box, style=filled, fontname=monospace];\n")
+
+	known := make(map[string]bool, len(d.ForkChoiceNodes))
+	for _, n := range d.ForkChoiceNodes {
+		known[string(n.BlockRoot)] = true
+	}
+	for _, n := range d.ForkChoiceNodes {
+		attrs := []string{
+			fmt.Sprintf("label=\"slot %d\\n%#x\\nweight %d\\n%s\"", n.Slot, shortRoot(n.BlockRoot), n.Weight, n.Validity),
+			"fillcolor=" + validityColor(n.Validity),
+		}
+		var marks []string
+		if bytes.Equal(n.BlockRoot, d.HeadRoot) {
+			marks = append(marks, "head")
+			attrs = append(attrs, "penwidth=3")
+		}
+		justified := d.JustifiedCheckpoint != nil && bytes.Equal(n.BlockRoot, d.JustifiedCheckpoint.Root)
+		finalized := d.FinalizedCheckpoint != nil && bytes.Equal(n.BlockRoot, d.FinalizedCheckpoint.Root)
+		if justified {
+			marks = append(marks, "justified")
+		}
+		if finalized {
+			marks = append(marks, "finalized")
+			attrs = append(attrs, "shape=tripleoctagon")
+		} else if justified {
+			attrs = append(attrs, "shape=doubleoctagon")
+		}
+		if len(d.ProposerBoostRoot) > 0 && bytes.Equal(n.BlockRoot, d.ProposerBoostRoot) {
+			marks = append(marks, "boosted")
+		}
+		if len(marks) > 0 {
+			attrs = append(attrs, fmt.Sprintf("xlabel=\"%s\"", strings.Join(marks, ",")))
+		}
+		fmt.Fprintf(&b, "\t\"%#x\" [%s];\n", n.BlockRoot, strings.Join(attrs, ", "))
+	}
+	for _, n := range d.ForkChoiceNodes {
+		// The parent of the oldest node has already been pruned from fork choice.
+		if !known[string(n.ParentRoot)] {
+			continue
+		}
+		fmt.Fprintf(&b, "\t\"%#x\" -> \"%#x\";\n", n.BlockRoot, n.ParentRoot)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func shortRoot(root []byte) []byte {
+	if len(root) > 4 {
+		return root[:4]
+	}
+	return root
+}
+
+func validityColor(v NodeValidity) string {
+	switch v {
+	case Invalid:
+		return "tomato"
+	case Optimistic:
+		return "gold"
+	default:
+		return "palegreen"
+	}
+}
@@ -0,0 +1,38 @@
+package forkchoice
+
+import (
+	"strings"
+	"testing"
+
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestDump_DOT(t *testing.T) {
+	root := func(b byte) []byte {
+		r := make([]byte, 32)
+		r[0] = b
+		return r
+	}
+	d := &Dump{
+		JustifiedCheckpoint: &eth.Checkpoint{Root: root(1)},
+		FinalizedCheckpoint: &eth.Checkpoint{Root: root(1)},
+		HeadRoot:            root(2),
+		ForkChoiceNodes: []*Node{
+			{Slot: 1, BlockRoot: root(1), ParentRoot: root(0), Weight: 10, Validity: Valid},
+			{Slot: 2, BlockRoot: root(2), ParentRoot: root(1), Weight: 7, Validity: Optimistic},
+			{Slot: 3, BlockRoot: root(3), ParentRoot: root(1), Weight: 3, Validity: Invalid},
+		},
+	}
+	dot := d.DOT()
+	require.Equal(t, true, strings.HasPrefix(dot, "digraph forkchoice {\n"))
+	require.Equal(t, true, strings.HasSuffix(dot, "}\n"))
+
+	assert.StringContains(t, `label="slot 2\n0x02000000\nweight 7\noptimistic", fillcolor=gold, penwidth=3, xlabel="head"`, dot)
+	assert.StringContains(t, `fillcolor=tomato`, dot)
+	assert.StringContains(t, `xlabel="justified,finalized"`, dot)
+	// The pruned parent of the finalized node has no edge.
+	assert.Equal(t, 2, strings.Count(dot, "->"))
+	assert.StringContains(t, `"0x03`, dot)
+}
@@ -1,7 +1,6 @@
 package htr
 
 import (
-	"runtime"
 	"sync"
 
 	"github.com/prysmaticlabs/gohashtree"
@@ -9,8 +8,7 @@ import (
 
 const minSliceSizeToParallelize = 5000
 
-func hashParallel(inputList [][32]byte, outputList [][32]byte, wg *sync.WaitGroup) {
-	defer wg.Done()
+func hash(outputList [][32]byte, inputList [][32]byte) {
 	err := gohashtree.Hash(outputList, inputList)
 	if err != nil {
 		panic(err)
@@ -24,24 +22,33 @@ func hashParallel(inputList [][32]byte, outputList [][32]byte, wg *sync.WaitGrou
 // lists.
 func VectorizedSha256(inputList [][32]byte) [][32]byte {
 	outputList := make([][32]byte, len(inputList)/2)
+	return VectorizedSha256Into(outputList, inputList)
+}
+
+// VectorizedSha256Into is like VectorizedSha256, but writes the hashes into
+// the provided output list instead of allocating a new one. The output list
+// must hold at least len(inputList)/2 roots and must not overlap with the
+// input list. The part of the output list holding the hashes is returned.
+//
+// Large lists are split among the shared hashing workers.
+func VectorizedSha256Into(outputList [][32]byte, inputList [][32]byte) [][32]byte {
+	outputList = outputList[:len(inputList)/2]
 	if len(inputList) < minSliceSizeToParallelize {
-		err := gohashtree.Hash(outputList, inputList)
-		if err != nil {
-			panic(err)
-		}
+		hash(outputList, inputList)
 		return outputList
 	}
-	n := runtime.GOMAXPROCS(0) - 1
+	n := workerCount()
 	wg := sync.WaitGroup{}
-	wg.Add(n)
-	groupSize := len(inputList) / (2 * (n + 1))
-	for j := 0; j < n; j++ {
-		go hashParallel(inputList[j*2*groupSize:(j+1)*2*groupSize], outputList[j*groupSize:], &wg)
-	}
-	err := gohashtree.Hash(outputList[n*groupSize:], inputList[n*2*groupSize:])
-	if err != nil {
-		panic(err)
+	wg.Add(n - 1)
+	groupSize := len(inputList) / (2 * n)
+	for j := 0; j < n-1; j++ {
+		submit(hashJob{
+			output: outputList[j*groupSize : (j+1)*groupSize],
+			input:  inputList[j*2*groupSize : (j+1)*2*groupSize],
+			wg:     &wg,
+		})
 	}
+	hash(outputList[(n-1)*groupSize:], inputList[(n-1)*2*groupSize:])
 	wg.Wait()
 	return outputList
 }
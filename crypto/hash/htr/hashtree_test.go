@@ -4,6 +4,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/prysmaticlabs/gohashtree"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
@@ -25,3 +26,29 @@ func Test_VectorizedSha256(t *testing.T) {
 		require.Equal(t, r, hash2[i])
 	}
 }
+
+func Test_VectorizedSha256Into(t *testing.T) {
+	input := make([][32]byte, 4*minSliceSizeToParallelize)
+	for i := range input {
+		input[i][0] = byte(i)
+		input[i][1] = byte(i >> 8)
+	}
+	want := make([][32]byte, len(input)/2)
+	require.NoError(t, gohashtree.Hash(want, input))
+
+	output := GetBuffer(len(input))
+	defer PutBuffer(output)
+	got := VectorizedSha256Into(output, input)
+	require.Equal(t, len(want), len(got))
+	for i := range want {
+		require.Equal(t, want[i], got[i])
+	}
+}
+
+func Test_GetBuffer(t *testing.T) {
+	b := GetBuffer(16)
+	require.Equal(t, 16, len(b))
+	PutBuffer(b)
+	require.Equal(t, 8, len(GetBuffer(8)))
+	require.Equal(t, 32, len(GetBuffer(32)))
+}
@@ -0,0 +1,68 @@
+package htr
+
+import (
+	"runtime"
+	"sync"
+)
+
+type hashJob struct {
+	output [][32]byte
+	input  [][32]byte
+	wg     *sync.WaitGroup
+}
+
+var (
+	startWorkers sync.Once
+	workers      int
+	jobs         chan hashJob
+)
+
+// workerCount returns the number of shared hashing workers, starting them on first use.
+func workerCount() int {
+	startWorkers.Do(func() {
+		workers = max(1, runtime.GOMAXPROCS(0))
+		jobs = make(chan hashJob, workers)
+		for i := 0; i < workers; i++ {
+			go func() {
+				for job := range jobs {
+					hash(job.output, job.input)
+					job.wg.Done()
+				}
+			}()
+		}
+	})
+	return workers
+}
+
+// submit hands a job over to the shared workers. When they are all busy,
+// the job is run by the caller instead of waiting for one to be free.
+func submit(job hashJob) {
+	select {
+	case jobs <- job:
+	default:
+		hash(job.output, job.input)
+		job.wg.Done()
+	}
+}
+
+var buffers = sync.Pool{
+	New: func() interface{} {
+		return new([][32]byte)
+	},
+}
+
+// GetBuffer returns a list of n roots from a shared pool, to be used as
+// scratch space when hashing. Its content is undefined. The list must be
+// given back with PutBuffer once none of its roots are referenced anymore.
+func GetBuffer(n int) [][32]byte {
+	b, ok := buffers.Get().(*[][32]byte)
+	if !ok || cap(*b) < n {
+		return make([][32]byte, n)
+	}
+	return (*b)[:n]
+}
+
+// PutBuffer gives a list obtained with GetBuffer back to the shared pool.
+func PutBuffer(b [][32]byte) {
+	buffers.Put(&b)
+}
@@ -140,6 +140,14 @@ func MerkleizeVector(elements [][32]byte, length uint64) [32]byte {
 	if len(elements) == 0 {
 		return trie.ZeroHashes[depth]
 	}
+	if depth == 0 {
+		return elements[0]
+	}
+	// Intermediate layers are written alternately into two scratch buffers,
+	// each large enough to hold the first layer and its padding.
+	scratch := [2][][32]byte{htr.GetBuffer(len(elements)/2 + 2), htr.GetBuffer(len(elements)/2 + 2)}
+	defer htr.PutBuffer(scratch[0])
+	defer htr.PutBuffer(scratch[1])
 	for i := uint8(0); i < depth; i++ {
 		layerLen := len(elements)
 		oddNodeLength := layerLen%2 == 1
@@ -147,7 +155,7 @@ func MerkleizeVector(elements [][32]byte, length uint64) [32]byte {
 			zerohash := trie.ZeroHashes[i]
 			elements = append(elements, zerohash)
 		}
-		elements = htr.VectorizedSha256(elements)
+		elements = htr.VectorizedSha256Into(scratch[i%2], elements)
 	}
 	return elements[0]
 }
@@ -0,0 +1,65 @@
+// Package stream reads large SSZ encoded objects progressively, such as states and blocks received from peers or
+// through the API. The memory used grows with the bytes actually received rather than with the length declared by
+// the sender, and size limits are enforced as the bytes arrive rather than after buffering the whole object.
+package stream
+
+import (
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// readChunkSize is the size of the first read, the buffer then doubles with each read.
+const readChunkSize = 64 << 10 // 64 KiB
+
+// ErrTooLarge is returned when an object goes over the max size it is read with.
+var ErrTooLarge = errors.New("ssz object exceeds max size")
+
+// ReadFull reads exactly length bytes from r. Unlike io.ReadFull on a buffer of the given length, the buffer grows as
+// the bytes arrive, so a sender declaring a large length without sending the bytes cannot make the reader allocate
+// it. It returns io.EOF if no bytes were read and io.ErrUnexpectedEOF if fewer bytes than the length were read.
+func ReadFull(r io.Reader, length uint64) ([]byte, error) {
+	buf := make([]byte, 0, min(length, readChunkSize))
+	for uint64(len(buf)) < length {
+		if len(buf) == cap(buf) {
+			grown := make([]byte, len(buf), min(length, 2*uint64(cap(buf))))
+			copy(grown, buf)
+			buf = grown
+		}
+		n, err := io.ReadFull(r, buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+n]
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(buf) > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// ReadAll reads r until EOF. It stops reading and returns ErrTooLarge as soon as more than maxSize bytes are read.
+func ReadAll(r io.Reader, maxSize uint64) ([]byte, error) {
+	limit := int64(math.MaxInt64)
+	if maxSize < math.MaxInt64 {
+		limit = int64(maxSize) + 1
+	}
+	b, err := io.ReadAll(io.LimitReader(r, limit))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(b)) > maxSize {
+		return nil, errors.Wrapf(ErrTooLarge, "more than %d bytes", maxSize)
+	}
+	return b, nil
+}
+
+// CheckSize returns ErrTooLarge if the declared size of an object, e.g. the content length of a request, goes over
+// the max size, so that it can be rejected before reading it. A negative size is an unknown size.
+func CheckSize(size int64, maxSize uint64) error {
+	if size > 0 && uint64(size) > maxSize {
+		return errors.Wrapf(ErrTooLarge, "%d bytes > %d bytes", size, maxSize)
+	}
+	return nil
+}
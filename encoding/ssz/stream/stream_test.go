@@ -0,0 +1,71 @@
+package stream
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestReadFull(t *testing.T) {
+	data := make([]byte, 5*readChunkSize+123)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	t.Run("exact length", func(t *testing.T) {
+		b, err := ReadFull(iotest.HalfReader(bytes.NewReader(data)), uint64(len(data)))
+		require.NoError(t, err)
+		assert.DeepEqual(t, data, b)
+	})
+	t.Run("leaves the rest", func(t *testing.T) {
+		r := bytes.NewReader(data)
+		b, err := ReadFull(r, 10)
+		require.NoError(t, err)
+		assert.DeepEqual(t, data[:10], b)
+		assert.Equal(t, len(data)-10, r.Len())
+	})
+	t.Run("zero length", func(t *testing.T) {
+		b, err := ReadFull(bytes.NewReader(data), 0)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(b))
+	})
+	t.Run("no bytes", func(t *testing.T) {
+		_, err := ReadFull(bytes.NewReader(nil), 10)
+		require.ErrorIs(t, err, io.EOF)
+	})
+	t.Run("declared length not sent", func(t *testing.T) {
+		// The declared length is never allocated, as only the bytes sent are buffered.
+		_, err := ReadFull(bytes.NewReader(data), 1<<50)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+	t.Run("read error", func(t *testing.T) {
+		_, err := ReadFull(iotest.TimeoutReader(bytes.NewReader(data)), uint64(len(data)))
+		require.ErrorIs(t, err, iotest.ErrTimeout)
+	})
+}
+
+func TestReadAll(t *testing.T) {
+	data := make([]byte, 3*readChunkSize)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	b, err := ReadAll(bytes.NewReader(data), uint64(len(data)))
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, b)
+
+	r := bytes.NewReader(data)
+	_, err = ReadAll(r, readChunkSize)
+	require.ErrorIs(t, err, ErrTooLarge)
+	// Reading stops after the max size.
+	assert.Equal(t, len(data)-readChunkSize-1, r.Len())
+}
+
+func TestCheckSize(t *testing.T) {
+	require.NoError(t, CheckSize(-1, 10))
+	require.NoError(t, CheckSize(10, 10))
+	require.ErrorIs(t, CheckSize(11, 10), ErrTooLarge)
+}
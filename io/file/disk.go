@@ -0,0 +1,19 @@
+//go:build !windows
+
+package file
+
+import (
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// DiskUsage returns the bytes available to unprivileged users and the total bytes of the file system
+// holding the path.
+func DiskUsage(path string) (free uint64, total uint64, err error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, 0, errors.Wrapf(err, "could not stat file system of %s", path)
+	}
+	return uint64(st.Bavail) * uint64(st.Bsize), uint64(st.Blocks) * uint64(st.Bsize), nil
+}
@@ -0,0 +1,8 @@
+package file
+
+import "github.com/pkg/errors"
+
+// DiskUsage returns an error on Windows, where the disk usage is not supported.
+func DiskUsage(_ string) (uint64, uint64, error) {
+	return 0, 0, errors.New("disk usage is not supported on this platform")
+}
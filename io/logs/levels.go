@@ -0,0 +1,126 @@
+package logs
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// moduleField is the field naming the module which logs an entry, e.g. p2p, sync or state-gen.
+const moduleField = "prefix"
+
+var levels = &moduleLevels{global: logrus.InfoLevel, modules: make(map[string]logrus.Level)}
+
+// moduleLevels holds the global log level and the levels overriding it for some modules.
+type moduleLevels struct {
+	sync.RWMutex
+	global  logrus.Level
+	modules map[string]logrus.Level
+}
+
+// enabled reports whether an entry at the level is logged for the module.
+func (l *moduleLevels) enabled(module string, level logrus.Level) bool {
+	l.RLock()
+	defer l.RUnlock()
+	threshold, ok := l.modules[module]
+	if !ok {
+		threshold = l.global
+	}
+	return level <= threshold
+}
+
+// entryEnabled reports whether the entry is logged under the levels of its module.
+func entryEnabled(entry *logrus.Entry) bool {
+	module, _ := entry.Data[moduleField].(string)
+	return levels.enabled(module, entry.Level)
+}
+
+// SetLevel sets the global log level, which applies to the modules without an override of their own.
+func SetLevel(level logrus.Level) {
+	levels.Lock()
+	levels.global = level
+	levels.Unlock()
+	applyLevels()
+}
+
+// SetModuleLevel overrides the global log level for the module, e.g. to log the debug entries of p2p only.
+func SetModuleLevel(module string, level logrus.Level) {
+	levels.Lock()
+	levels.modules[module] = level
+	levels.Unlock()
+	applyLevels()
+}
+
+// ClearModuleLevel removes the log level override of the module, which falls back to the global level.
+func ClearModuleLevel(module string) {
+	levels.Lock()
+	delete(levels.modules, module)
+	levels.Unlock()
+	applyLevels()
+}
+
+// Levels returns the global log level and the log level overrides of the modules.
+func Levels() (logrus.Level, map[string]logrus.Level) {
+	levels.RLock()
+	defer levels.RUnlock()
+	modules := make(map[string]logrus.Level, len(levels.modules))
+	for module, level := range levels.modules {
+		modules[module] = level
+	}
+	return levels.global, modules
+}
+
+// applyLevels sets the level of the standard logger to the most verbose of the levels, so that the entries
+// of all modules reach it, and filters them by module before they are formatted or passed to the hooks.
+func applyLevels() {
+	levels.RLock()
+	max := levels.global
+	for _, level := range levels.modules {
+		if level > max {
+			max = level
+		}
+	}
+	levels.RUnlock()
+
+	logger := logrus.StandardLogger()
+	if _, ok := logger.Formatter.(*filteringFormatter); !ok {
+		logger.SetFormatter(&filteringFormatter{Formatter: logger.Formatter})
+	}
+	hooks := make(logrus.LevelHooks)
+	for level, levelHooks := range logger.Hooks {
+		for _, h := range levelHooks {
+			if _, ok := h.(*filteringHook); !ok {
+				h = &filteringHook{Hook: h}
+			}
+			hooks[level] = append(hooks[level], h)
+		}
+	}
+	logger.ReplaceHooks(hooks)
+	logger.SetLevel(max)
+}
+
+// filteringFormatter drops the entries which are not enabled for their module.
+type filteringFormatter struct {
+	logrus.Formatter
+}
+
+// Format --
+func (f *filteringFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if !entryEnabled(entry) {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
+
+// filteringHook only fires for the entries which are enabled for their module.
+type filteringHook struct {
+	logrus.Hook
+}
+
+// Fire --
+func (h *filteringHook) Fire(entry *logrus.Entry) error {
+	if !entryEnabled(entry) {
+		return nil
+	}
+	return h.Hook.Fire(entry)
+}
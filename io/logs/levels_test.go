@@ -0,0 +1,50 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestModuleLevels(t *testing.T) {
+	logger := logrus.StandardLogger()
+	formatter, out, level, hooks := logger.Formatter, logger.Out, logger.Level, logger.Hooks
+	t.Cleanup(func() {
+		logger.SetFormatter(formatter)
+		logger.SetOutput(out)
+		logger.ReplaceHooks(hooks)
+		logger.SetLevel(level)
+		levels.modules = make(map[string]logrus.Level)
+	})
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	logger.ReplaceHooks(make(logrus.LevelHooks))
+	hook := test.NewGlobal()
+
+	SetLevel(logrus.InfoLevel)
+	SetModuleLevel("p2p", logrus.DebugLevel)
+	SetModuleLevel("sync", logrus.WarnLevel)
+	assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+
+	logrus.WithField("prefix", "p2p").Debug("p2p debug")
+	logrus.WithField("prefix", "sync").Info("sync info")
+	logrus.WithField("prefix", "sync").Warn("sync warn")
+	logrus.WithField("prefix", "state-gen").Debug("state-gen debug")
+	logrus.WithField("prefix", "state-gen").Info("state-gen info")
+	assert.StringContains(t, "p2p debug", buf.String())
+	assert.StringContains(t, "sync warn", buf.String())
+	assert.StringContains(t, "state-gen info", buf.String())
+	assert.StringNotContains(t, "sync info", buf.String())
+	assert.StringNotContains(t, "state-gen debug", buf.String())
+	assert.Equal(t, 3, len(hook.AllEntries()))
+
+	ClearModuleLevel("p2p")
+	global, modules := Levels()
+	assert.Equal(t, logrus.InfoLevel, global)
+	assert.DeepEqual(t, map[string]logrus.Level{"sync": logrus.WarnLevel}, modules)
+	assert.Equal(t, logrus.InfoLevel, logger.GetLevel())
+}
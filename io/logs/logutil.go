@@ -5,12 +5,8 @@ package logs
 import (
 	"io"
 	"net/url"
-	"os"
-	"path/filepath"
 	"strings"
 
-	"github.com/prysmaticlabs/prysm/v5/config/params"
-	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,12 +16,11 @@ func addLogWriter(w io.Writer) {
 }
 
 // ConfigurePersistentLogging adds a log-to-file writer. File content is identical to stdout.
-func ConfigurePersistentLogging(logFileName string) error {
+// The file is rotated once it grows beyond maxSize bytes, unless maxSize is zero, keeping
+// maxBackups rotated files, and as set by the rotate options.
+func ConfigurePersistentLogging(logFileName string, maxSize int64, maxBackups int, opts ...RotateOption) error {
 	logrus.WithField("logFileName", logFileName).Info("Logs will be made persistent")
-	if err := file.MkdirAll(filepath.Dir(logFileName)); err != nil {
-		return err
-	}
-	f, err := os.OpenFile(logFileName, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	f, err := NewRotatingFile(logFileName, maxSize, maxBackups, opts...)
 	if err != nil {
 		return err
 	}
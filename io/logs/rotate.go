@@ -0,0 +1,271 @@
+package logs
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+// compressedSuffix is the suffix of the compressed backups.
+const compressedSuffix = ".gz"
+
+// RotatingFile is an io.WriteCloser which writes to a file on disk, rotating it once it grows
+// beyond a maximum size, or once it has been written to for a rotation interval. Rotated files
+// are suffixed with an increasing index, e.g. engine.log.1, and only the most recent maxBackups
+// of them are kept. They are compressed with gzip, e.g. to engine.log.1.gz, if compression is enabled.
+// With no backups, the file is truncated instead of rotated.
+type RotatingFile struct {
+	sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	interval   time.Duration
+	compress   bool
+	size       int64
+	rotateAt   time.Time
+	f          *os.File
+	// compressing tracks the compression of the last rotated file, which runs in the background.
+	compressing sync.WaitGroup
+}
+
+// RotateOption configures how a RotatingFile is rotated.
+type RotateOption func(*RotatingFile)
+
+// WithRotationInterval rotates the file once it has been written to for the interval, whatever its size.
+func WithRotationInterval(interval time.Duration) RotateOption {
+	return func(r *RotatingFile) {
+		r.interval = interval
+	}
+}
+
+// WithCompression compresses the rotated files with gzip.
+func WithCompression() RotateOption {
+	return func(r *RotatingFile) {
+		r.compress = true
+	}
+}
+
+// NewRotatingFile opens (or creates) the file at path for appending. A maxSize of zero disables rotation
+// by size.
+func NewRotatingFile(path string, maxSize int64, maxBackups int, opts ...RotateOption) (*RotatingFile, error) {
+	if maxSize < 0 || maxBackups < 0 {
+		return nil, errors.New("max size and max backups must not be negative")
+	}
+	if err := file.MkdirAll(filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+	r := &RotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	if r.interval < 0 {
+		return nil, errors.New("rotation interval must not be negative")
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write writes p to the current file, rotating first if p would push the file over the size limit,
+// or if the rotation interval of the file has elapsed.
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.f == nil {
+		return 0, os.ErrClosed
+	}
+	if r.size > 0 && r.shouldRotate(int64(len(p))) {
+		if err := r.rotate(); err != nil {
+			return 0, errors.Wrap(err, "could not rotate file")
+		}
+	}
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *RotatingFile) shouldRotate(n int64) bool {
+	if r.maxSize > 0 && r.size+n > r.maxSize {
+		return true
+	}
+	return r.interval > 0 && !time.Now().Before(r.rotateAt)
+}
+
+// Close closes the current file, once the compression of the last rotated file, if any, is done.
+func (r *RotatingFile) Close() error {
+	r.Lock()
+	defer r.Unlock()
+	r.compressing.Wait()
+	if r.f == nil {
+		return nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
+
+func (r *RotatingFile) open() error {
+	return r.openFile(os.O_CREATE | os.O_WRONLY | os.O_APPEND)
+}
+
+func (r *RotatingFile) openFile(flag int) error {
+	f, err := os.OpenFile(r.path, flag, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			return errors.Wrap(err, closeErr.Error())
+		}
+		return err
+	}
+	r.f = f
+	r.size = info.Size()
+	r.rotateAt = time.Now().Add(r.interval)
+	return nil
+}
+
+func (r *RotatingFile) rotate() error {
+	if err := r.f.Close(); err != nil {
+		return err
+	}
+	r.f = nil
+	if r.maxBackups == 0 {
+		// Without backups, the file is truncated in place rather than removed, so that the
+		// processes following it keep following the new content.
+		return r.openFile(os.O_CREATE | os.O_WRONLY | os.O_APPEND | os.O_TRUNC)
+	}
+	// The first backup may still be being compressed.
+	r.compressing.Wait()
+	backups, err := r.backups()
+	if err != nil {
+		return errors.Wrap(err, "could not list backups")
+	}
+	// The rotated file becomes the first backup, so only the most recent maxBackups-1 backups are kept.
+	// Backups are counted whether they are compressed or not, as compression may have been enabled
+	// after some of them were rotated, and backups left beyond the limit are removed as well.
+	keep := min(len(backups), r.maxBackups-1)
+	for _, b := range backups[keep:] {
+		if err := os.Remove(r.backupName(b.index) + b.suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	// Shift the kept backups up by one, from the oldest.
+	for i := keep - 1; i >= 0; i-- {
+		b := backups[i]
+		if err := os.Rename(r.backupName(b.index)+b.suffix, r.backupName(b.index+1)+b.suffix); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(r.path, r.backupName(1)); err != nil {
+		return err
+	}
+	if r.compress {
+		r.compressing.Add(1)
+		go func(path string) {
+			defer r.compressing.Done()
+			if err := compressFile(path); err != nil {
+				// Logging here would write to this very file, so the error goes to stderr.
+				fmt.Fprintf(os.Stderr, "Could not compress rotated file %s: %v\n", path, err)
+			}
+		}(r.backupName(1))
+	}
+	return r.open()
+}
+
+// backup is a rotated file, named after the file with its index and suffix, e.g. beacon.log.2.gz.
+type backup struct {
+	index  int
+	suffix string
+}
+
+// backups returns the rotated files of the file, from the most recent to the oldest.
+func (r *RotatingFile) backups() ([]backup, error) {
+	entries, err := os.ReadDir(filepath.Dir(r.path))
+	if err != nil {
+		return nil, err
+	}
+	prefix := filepath.Base(r.path) + "."
+	var backups []backup
+	for _, e := range entries {
+		name, ok := strings.CutPrefix(e.Name(), prefix)
+		if !ok || e.IsDir() {
+			continue
+		}
+		b := backup{}
+		if trimmed, ok := strings.CutSuffix(name, compressedSuffix); ok {
+			name, b.suffix = trimmed, compressedSuffix
+		}
+		// Files which are not backups, such as the temporary files of compression, are skipped.
+		b.index, err = strconv.Atoi(name)
+		if err != nil || b.index < 1 {
+			continue
+		}
+		backups = append(backups, b)
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		if backups[i].index != backups[j].index {
+			return backups[i].index < backups[j].index
+		}
+		return backups[i].suffix < backups[j].suffix
+	})
+	return backups, nil
+}
+
+// compressFile compresses the file with gzip, replacing it with the compressed file.
+func compressFile(path string) error {
+	in, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return err
+	}
+	tmp := path + compressedSuffix + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		if closeErr := in.Close(); closeErr != nil {
+			return errors.Wrap(err, closeErr.Error())
+		}
+		return err
+	}
+	zw := gzip.NewWriter(out)
+	_, err = io.Copy(zw, in)
+	if err == nil {
+		err = zw.Close()
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := in.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if removeErr := os.Remove(tmp); removeErr != nil && !os.IsNotExist(removeErr) {
+			return errors.Wrap(err, removeErr.Error())
+		}
+		return err
+	}
+	if err := os.Rename(tmp, path+compressedSuffix); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+func (r *RotatingFile) backupName(i int) string {
+	return fmt.Sprintf("%s.%d", r.path, i)
+}
@@ -0,0 +1,143 @@
+package logs
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rotate", "engine.log")
+	r, err := NewRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+
+	for _, s := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n", "dddddddd\n"} {
+		_, err := r.Write([]byte(s))
+		require.NoError(t, err)
+	}
+	require.NoError(t, r.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "dddddddd\n", string(b))
+	b, err = os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "cccccccc\n", string(b))
+	b, err = os.ReadFile(path + ".2")
+	require.NoError(t, err)
+	require.Equal(t, "bbbbbbbb\n", string(b))
+	_, err = os.Stat(path + ".3")
+	require.Equal(t, true, os.IsNotExist(err))
+
+	_, err = r.Write([]byte("x"))
+	require.ErrorIs(t, err, os.ErrClosed)
+}
+
+func TestRotatingFile_NoBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "engine.log")
+	r, err := NewRotatingFile(path, 4, 0)
+	require.NoError(t, err)
+	_, err = r.Write([]byte("abcd"))
+	require.NoError(t, err)
+	before, err := os.Stat(path)
+	require.NoError(t, err)
+	_, err = r.Write([]byte("ef"))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	// The file is truncated in place rather than replaced.
+	after, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, true, os.SameFile(before, after))
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "ef", string(b))
+	_, err = os.Stat(path + ".1")
+	require.Equal(t, true, os.IsNotExist(err))
+}
+
+func TestRotatingFile_BackupsAcrossSuffixes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacon.log")
+	// Backups compressed or not, and a backup left beyond the limit, e.g. by a larger limit before.
+	for name, content := range map[string]string{".1": "one", ".2.gz": "two", ".3": "three", ".7.gz": "seven", ".gz.tmp": "tmp"} {
+		require.NoError(t, os.WriteFile(path+name, []byte(content), 0600))
+	}
+	r, err := NewRotatingFile(path, 4, 3)
+	require.NoError(t, err)
+	for _, s := range []string{"aaaa", "bbbb"} {
+		_, err := r.Write([]byte(s))
+		require.NoError(t, err)
+	}
+	require.NoError(t, r.Close())
+
+	// Only three backups are kept in total, the most recent ones.
+	for name, want := range map[string]string{"": "bbbb", ".1": "aaaa", ".2": "one", ".3.gz": "two", ".gz.tmp": "tmp"} {
+		b, err := os.ReadFile(path + name)
+		require.NoError(t, err)
+		require.Equal(t, want, string(b))
+	}
+	for _, name := range []string{".3", ".4", ".7.gz", ".8.gz"} {
+		_, err := os.Stat(path + name)
+		require.Equal(t, true, os.IsNotExist(err), name)
+	}
+}
+
+func TestRotatingFile_Interval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacon.log")
+	r, err := NewRotatingFile(path, 0, 1, WithRotationInterval(time.Hour))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("abcd"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("ef"))
+	require.NoError(t, err)
+
+	r.rotateAt = time.Now().Add(-time.Second)
+	_, err = r.Write([]byte("gh"))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "gh", string(b))
+	b, err = os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "abcdef", string(b))
+}
+
+func TestRotatingFile_Compression(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacon.log")
+	// A backup rotated before compression was enabled is shifted along with the compressed ones.
+	require.NoError(t, os.WriteFile(path+".1", []byte("old"), 0600))
+	r, err := NewRotatingFile(path, 4, 3, WithCompression())
+	require.NoError(t, err)
+	for _, s := range []string{"aaaa", "bbbb", "cccc"} {
+		_, err := r.Write([]byte(s))
+		require.NoError(t, err)
+	}
+	require.NoError(t, r.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "cccc", string(b))
+	for i, want := range map[int]string{1: "bbbb", 2: "aaaa"} {
+		f, err := os.Open(fmt.Sprintf("%s.%d.gz", path, i))
+		require.NoError(t, err)
+		zr, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		b, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		require.Equal(t, want, string(b))
+		require.NoError(t, f.Close())
+		_, err = os.Stat(fmt.Sprintf("%s.%d", path, i))
+		require.Equal(t, true, os.IsNotExist(err))
+	}
+	b, err = os.ReadFile(path + ".3")
+	require.NoError(t, err)
+	require.Equal(t, "old", string(b))
+}
@@ -63,6 +63,10 @@ func (ss *StreamServer) LogsFeed() *event.Feed {
 
 // Write a binary message and send over the event feed.
 func (ss *StreamServer) Write(p []byte) (n int, err error) {
+	// Entries filtered out by the log level of their module are written as empty messages.
+	if len(p) == 0 {
+		return 0, nil
+	}
 	ss.feed.Send(p)
 	ss.cache.Add(rand.NewGenerator().Uint64(), p)
 	return len(p), nil
@@ -0,0 +1,194 @@
+package prometheus
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// HighCardinalityLabels are the labels aggregated away from the metric families which exceed the maximum
+// number of series, such as the per-validator, per-peer and per-endpoint labels.
+var HighCardinalityLabels = []string{"validator_index", "pubkey", "peer", "peer_id", "agent", "endpoint", "topic"}
+
+// CardinalityConfig limits the number of series exported for each metric family, so that large nodes do not
+// overwhelm the storage of Prometheus.
+type CardinalityConfig struct {
+	// DisabledFamilies are not exported. A trailing * matches all the families with the prefix.
+	DisabledFamilies []string
+	// AggregatedLabels are removed from the families, whose series are summed over the values of the label.
+	// A label given as family:label is only removed from that family.
+	AggregatedLabels []string
+	// MaxSeriesPerFamily is the number of series of a family past which its high cardinality labels are
+	// aggregated. The family is not exported if it still has too many series. Zero means no limit.
+	MaxSeriesPerFamily int
+}
+
+// cardinalityGatherer applies a cardinality config to the families of the underlying gatherer.
+type cardinalityGatherer struct {
+	gatherer prometheus.Gatherer
+	cfg      *CardinalityConfig
+	// Locks access to dropped.
+	sync.Mutex
+	dropped map[string]bool
+}
+
+// NewCardinalityGatherer returns a gatherer which applies the config to the families of the gatherer. The
+// gatherer is returned as is if the config sets no limit.
+func NewCardinalityGatherer(g prometheus.Gatherer, cfg *CardinalityConfig) prometheus.Gatherer {
+	if cfg == nil || (len(cfg.DisabledFamilies) == 0 && len(cfg.AggregatedLabels) == 0 && cfg.MaxSeriesPerFamily <= 0) {
+		return g
+	}
+	return &cardinalityGatherer{gatherer: g, cfg: cfg, dropped: make(map[string]bool)}
+}
+
+// Gather the families of the underlying gatherer, limiting their cardinality.
+func (c *cardinalityGatherer) Gather() ([]*dto.MetricFamily, error) {
+	// The underlying gatherer may return some families along with an error, which are exported anyway.
+	families, err := c.gatherer.Gather()
+	limited := make([]*dto.MetricFamily, 0, len(families))
+	for _, f := range families {
+		if c.disabled(f.GetName()) {
+			continue
+		}
+		if labels := c.aggregatedLabels(f.GetName()); len(labels) > 0 {
+			f = aggregate(f, labels)
+		}
+		if c.cfg.MaxSeriesPerFamily > 0 && len(f.Metric) > c.cfg.MaxSeriesPerFamily {
+			f = aggregate(f, HighCardinalityLabels)
+			if len(f.Metric) > c.cfg.MaxSeriesPerFamily {
+				c.warnDropped(f.GetName(), len(f.Metric))
+				continue
+			}
+		}
+		limited = append(limited, f)
+	}
+	return limited, err
+}
+
+// disabled reports whether the family is not exported.
+func (c *cardinalityGatherer) disabled(name string) bool {
+	for _, d := range c.cfg.DisabledFamilies {
+		if prefix, ok := strings.CutSuffix(d, "*"); ok {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if d == name {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregatedLabels returns the labels removed from the family.
+func (c *cardinalityGatherer) aggregatedLabels(name string) []string {
+	var labels []string
+	for _, l := range c.cfg.AggregatedLabels {
+		family, label, ok := strings.Cut(l, ":")
+		if !ok {
+			labels = append(labels, l)
+		} else if family == name {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// warnDropped logs, once per family, that the family has too many series to be exported.
+func (c *cardinalityGatherer) warnDropped(name string, series int) {
+	c.Lock()
+	defer c.Unlock()
+	if c.dropped[name] {
+		return
+	}
+	c.dropped[name] = true
+	log.WithField("family", name).WithField("series", series).
+		Warn("Metric family has too many series even after aggregating its high cardinality labels, it is not exported")
+}
+
+// aggregate removes the labels from the series of the family, summing the series which only differed by
+// these labels. The quantiles of summaries cannot be summed, and are removed from the aggregated series.
+func aggregate(f *dto.MetricFamily, labels []string) *dto.MetricFamily {
+	remove := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		remove[l] = true
+	}
+	hasLabel := false
+	for _, m := range f.Metric {
+		for _, lp := range m.Label {
+			if remove[lp.GetName()] {
+				hasLabel = true
+			}
+		}
+	}
+	if !hasLabel {
+		return f
+	}
+
+	groups := make(map[string]*dto.Metric)
+	aggregated := &dto.MetricFamily{Name: f.Name, Help: f.Help, Type: f.Type}
+	for _, m := range f.Metric {
+		kept := make([]*dto.LabelPair, 0, len(m.Label))
+		var key strings.Builder
+		for _, lp := range m.Label {
+			if remove[lp.GetName()] {
+				continue
+			}
+			kept = append(kept, lp)
+			key.WriteString(lp.GetName())
+			key.WriteByte(0)
+			key.WriteString(lp.GetValue())
+			key.WriteByte(0)
+		}
+		sum, ok := groups[key.String()]
+		if !ok {
+			sum = &dto.Metric{Label: kept}
+			groups[key.String()] = sum
+			aggregated.Metric = append(aggregated.Metric, sum)
+		}
+		addMetric(sum, m, f.GetType())
+	}
+	return aggregated
+}
+
+// addMetric adds the values of the series to the sum.
+func addMetric(sum, m *dto.Metric, t dto.MetricType) {
+	switch t {
+	case dto.MetricType_COUNTER:
+		v := sum.GetCounter().GetValue() + m.GetCounter().GetValue()
+		sum.Counter = &dto.Counter{Value: &v}
+	case dto.MetricType_GAUGE:
+		v := sum.GetGauge().GetValue() + m.GetGauge().GetValue()
+		sum.Gauge = &dto.Gauge{Value: &v}
+	case dto.MetricType_UNTYPED:
+		v := sum.GetUntyped().GetValue() + m.GetUntyped().GetValue()
+		sum.Untyped = &dto.Untyped{Value: &v}
+	case dto.MetricType_SUMMARY:
+		count := sum.GetSummary().GetSampleCount() + m.GetSummary().GetSampleCount()
+		s := sum.GetSummary().GetSampleSum() + m.GetSummary().GetSampleSum()
+		sum.Summary = &dto.Summary{SampleCount: &count, SampleSum: &s}
+	case dto.MetricType_HISTOGRAM:
+		count := sum.GetHistogram().GetSampleCount() + m.GetHistogram().GetSampleCount()
+		s := sum.GetHistogram().GetSampleSum() + m.GetHistogram().GetSampleSum()
+		buckets := make(map[float64]uint64)
+		for _, b := range sum.GetHistogram().GetBucket() {
+			buckets[b.GetUpperBound()] += b.GetCumulativeCount()
+		}
+		for _, b := range m.GetHistogram().GetBucket() {
+			buckets[b.GetUpperBound()] += b.GetCumulativeCount()
+		}
+		bounds := make([]float64, 0, len(buckets))
+		for bound := range buckets {
+			bounds = append(bounds, bound)
+		}
+		sort.Float64s(bounds)
+		h := &dto.Histogram{SampleCount: &count, SampleSum: &s, Bucket: make([]*dto.Bucket, len(bounds))}
+		for i, bound := range bounds {
+			bound, cumulative := bound, buckets[bound]
+			h.Bucket[i] = &dto.Bucket{UpperBound: &bound, CumulativeCount: &cumulative}
+		}
+		sum.Histogram = h
+	}
+}
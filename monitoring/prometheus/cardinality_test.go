@@ -0,0 +1,96 @@
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func testRegistry(t *testing.T) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_duties_total"}, []string{"validator_index", "duty"})
+	histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_latency_seconds", Buckets: []float64{1, 2}}, []string{"endpoint"})
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "other_gauge"})
+	require.NoError(t, registry.Register(counter))
+	require.NoError(t, registry.Register(histogram))
+	require.NoError(t, registry.Register(gauge))
+
+	counter.WithLabelValues("1", "attest").Add(2)
+	counter.WithLabelValues("2", "attest").Add(3)
+	counter.WithLabelValues("2", "propose").Add(1)
+	histogram.WithLabelValues("a").Observe(0.5)
+	histogram.WithLabelValues("b").Observe(1.5)
+	histogram.WithLabelValues("c").Observe(0.5)
+	gauge.Set(7)
+	return registry
+}
+
+func gather(t *testing.T, g prometheus.Gatherer) map[string]*dto.MetricFamily {
+	families, err := g.Gather()
+	require.NoError(t, err)
+	byName := make(map[string]*dto.MetricFamily, len(families))
+	for _, f := range families {
+		byName[f.GetName()] = f
+	}
+	return byName
+}
+
+func TestNewCardinalityGatherer_NoLimit(t *testing.T) {
+	registry := testRegistry(t)
+	assert.Equal(t, prometheus.Gatherer(registry), NewCardinalityGatherer(registry, nil))
+	assert.Equal(t, prometheus.Gatherer(registry), NewCardinalityGatherer(registry, &CardinalityConfig{}))
+}
+
+func TestCardinalityGatherer_DisabledFamilies(t *testing.T) {
+	families := gather(t, NewCardinalityGatherer(testRegistry(t), &CardinalityConfig{
+		DisabledFamilies: []string{"test_*"},
+	}))
+	assert.Equal(t, 1, len(families))
+	require.NotNil(t, families["other_gauge"])
+}
+
+func TestCardinalityGatherer_AggregatedLabels(t *testing.T) {
+	families := gather(t, NewCardinalityGatherer(testRegistry(t), &CardinalityConfig{
+		AggregatedLabels: []string{"validator_index", "test_latency_seconds:endpoint"},
+	}))
+
+	duties := families["test_duties_total"]
+	require.Equal(t, 2, len(duties.Metric))
+	values := make(map[string]float64)
+	for _, m := range duties.Metric {
+		require.Equal(t, 1, len(m.Label))
+		assert.Equal(t, "duty", m.Label[0].GetName())
+		values[m.Label[0].GetValue()] = m.GetCounter().GetValue()
+	}
+	assert.DeepEqual(t, map[string]float64{"attest": 5, "propose": 1}, values)
+
+	latency := families["test_latency_seconds"]
+	require.Equal(t, 1, len(latency.Metric))
+	h := latency.Metric[0].GetHistogram()
+	assert.Equal(t, uint64(3), h.GetSampleCount())
+	assert.Equal(t, 2.5, h.GetSampleSum())
+	require.Equal(t, 2, len(h.GetBucket()))
+	assert.Equal(t, uint64(2), h.GetBucket()[0].GetCumulativeCount())
+	assert.Equal(t, uint64(3), h.GetBucket()[1].GetCumulativeCount())
+}
+
+func TestCardinalityGatherer_MaxSeriesPerFamily(t *testing.T) {
+	families := gather(t, NewCardinalityGatherer(testRegistry(t), &CardinalityConfig{MaxSeriesPerFamily: 2}))
+
+	// The validator index is aggregated, which leaves two series.
+	require.NotNil(t, families["test_duties_total"])
+	assert.Equal(t, 2, len(families["test_duties_total"].Metric))
+	// The endpoint is aggregated, which leaves one series.
+	require.NotNil(t, families["test_latency_seconds"])
+	assert.Equal(t, 1, len(families["test_latency_seconds"].Metric))
+
+	families = gather(t, NewCardinalityGatherer(testRegistry(t), &CardinalityConfig{MaxSeriesPerFamily: 1}))
+	// Even without the validator index, there are two series.
+	_, ok := families["test_duties_total"]
+	assert.Equal(t, false, ok)
+	assert.Equal(t, 1, len(families["test_latency_seconds"].Metric))
+	assert.Equal(t, 1, len(families["other_gauge"].Metric))
+}
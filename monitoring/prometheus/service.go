@@ -36,11 +36,12 @@ type Handler struct {
 
 // NewService sets up a new instance for a given address host:port.
 // An empty host will match with any IP so an address like ":2121" is perfectly acceptable.
-func NewService(addr string, svcRegistry *runtime.ServiceRegistry, additionalHandlers ...Handler) *Service {
+// The cardinality config, if not nil, limits the series served on /metrics.
+func NewService(addr string, svcRegistry *runtime.ServiceRegistry, cardinality *CardinalityConfig, additionalHandlers ...Handler) *Service {
 	s := &Service{svcRegistry: svcRegistry}
 
 	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{
+	mux.Handle("/metrics", promhttp.HandlerFor(NewCardinalityGatherer(prometheus.DefaultGatherer, cardinality), promhttp.HandlerOpts{
 		MaxRequestsInFlight: 5,
 		Timeout:             30 * time.Second,
 	}))
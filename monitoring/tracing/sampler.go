@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+type spanFamily struct {
+	prefix  string
+	sampler trace.Sampler
+}
+
+// familySampler samples a trace with the fraction of the span family its root span belongs to.
+// The family of a span is the longest configured prefix of its name.
+type familySampler struct {
+	families       []spanFamily
+	defaultSampler trace.Sampler
+}
+
+func newFamilySampler(defaultFraction float64, fractions map[string]float64) trace.Sampler {
+	s := &familySampler{defaultSampler: trace.TraceIDRatioBased(defaultFraction)}
+	for prefix, fraction := range fractions {
+		s.families = append(s.families, spanFamily{prefix: prefix, sampler: trace.TraceIDRatioBased(fraction)})
+	}
+	sort.Slice(s.families, func(i, j int) bool {
+		return len(s.families[i].prefix) > len(s.families[j].prefix)
+	})
+	return s
+}
+
+// ShouldSample --
+func (s *familySampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	for _, f := range s.families {
+		if strings.HasPrefix(p.Name, f.prefix) {
+			return f.sampler.ShouldSample(p)
+		}
+	}
+	return s.defaultSampler.ShouldSample(p)
+}
+
+// Description --
+func (s *familySampler) Description() string {
+	descs := make([]string, 0, len(s.families)+1)
+	for _, f := range s.families {
+		descs = append(descs, f.prefix+"="+f.sampler.Description())
+	}
+	descs = append(descs, "default="+s.defaultSampler.Description())
+	return "FamilySampler{" + strings.Join(descs, ",") + "}"
+}
+
+// ParseFamilySampleFractions parses span family sample fractions given as prefix=fraction pairs,
+// e.g. blockChain.=1.0.
+func ParseFamilySampleFractions(pairs []string) (map[string]float64, error) {
+	fractions := make(map[string]float64, len(pairs))
+	for _, pair := range pairs {
+		prefix, value, ok := strings.Cut(pair, "=")
+		if !ok || prefix == "" {
+			return nil, fmt.Errorf("invalid span family sample fraction %q, expected prefix=fraction", pair)
+		}
+		fraction, err := strconv.ParseFloat(value, 64)
+		if err != nil || fraction < 0 || fraction > 1 {
+			return nil, fmt.Errorf("invalid sample fraction %q for span family %s, expected a number between 0 and 1", value, prefix)
+		}
+		fractions[prefix] = fraction
+	}
+	return fractions, nil
+}
@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestFamilySampler(t *testing.T) {
+	s := newFamilySampler(0, map[string]float64{
+		"blockChain.":         1,
+		"blockChain.onBlock.": 0,
+	})
+	sample := func(name string) trace.SamplingDecision {
+		return s.ShouldSample(trace.SamplingParameters{Name: name}).Decision
+	}
+	assert.Equal(t, trace.RecordAndSample, sample("blockChain.ReceiveBlock"))
+	assert.Equal(t, trace.Drop, sample("blockChain.onBlock.verify"))
+	assert.Equal(t, trace.Drop, sample("beacon.GetValidators"))
+}
+
+func TestParseFamilySampleFractions(t *testing.T) {
+	fractions, err := ParseFamilySampleFractions([]string{"blockChain.=1", "beacon.=0.05"})
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]float64{"blockChain.": 1, "beacon.": 0.05}, fractions)
+
+	_, err = ParseFamilySampleFractions([]string{"blockChain."})
+	require.ErrorContains(t, "expected prefix=fraction", err)
+	_, err = ParseFamilySampleFractions([]string{"blockChain.=2"})
+	require.ErrorContains(t, "between 0 and 1", err)
+}
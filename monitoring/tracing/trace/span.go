@@ -5,6 +5,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
 )
@@ -43,6 +44,24 @@ func FromContext(ctx context.Context) trace.Span {
 	return span
 }
 
+// WithBaggage returns a copy of the parent context carrying the given baggage member, which is
+// propagated along with the trace context in outgoing requests such as the Engine API calls.
+// It returns the parent context if tracing is disabled or the member is invalid.
+func WithBaggage(parent context.Context, key, value string) context.Context {
+	if !TracingEnabled {
+		return parent
+	}
+	m, err := baggage.NewMemberRaw(key, value)
+	if err != nil {
+		return parent
+	}
+	b, err := baggage.FromContext(parent).SetMember(m)
+	if err != nil {
+		return parent
+	}
+	return baggage.ContextWithBaggage(parent, b)
+}
+
 // AddMessageSendEvent adds a message send event to the provided span.
 // This function is useful for tracking the sending of messages within a trace.
 func AddMessageSendEvent(span trace.Span, iid int64, uncompressedLen int64, compressedLen int64) trace.Span {
@@ -1,9 +1,11 @@
-// Package tracing sets up jaeger as an opentracing tool
-// for services in Prysm.
+// Package tracing sets up opentelemetry tracing, exporting spans to Jaeger
+// or to an OTLP collector, for services in Prysm.
 package tracing
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	prysmTrace "github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
@@ -11,6 +13,9 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
@@ -19,8 +24,39 @@ import (
 
 var log = logrus.WithField("prefix", "tracing")
 
-// Setup creates and initializes a new Jaegar tracing configuration with opentelemetry.
-func Setup(serviceName, processName, endpoint string, sampleFraction float64, enable bool) error {
+// Supported span exporters.
+const (
+	JaegerExporter   = "jaeger"
+	OtlpGrpcExporter = "otlp-grpc"
+	OtlpHttpExporter = "otlp-http"
+)
+
+type config struct {
+	exporter        string
+	familyFractions map[string]float64
+}
+
+// Option configures the tracing setup.
+type Option func(*config)
+
+// WithExporter selects the exporter the spans are sent with, Jaeger by default.
+func WithExporter(exporter string) Option {
+	return func(c *config) {
+		c.exporter = exporter
+	}
+}
+
+// WithFamilySampleFractions sets the fraction of traces sampled for the span families, identified by
+// the prefix of their root span name, e.g. "blockChain." for block processing or "beacon." for the
+// beacon API. Traces whose root span is in none of the families are sampled with the default fraction.
+func WithFamilySampleFractions(fractions map[string]float64) Option {
+	return func(c *config) {
+		c.familyFractions = fractions
+	}
+}
+
+// Setup creates and initializes a new tracing configuration with opentelemetry.
+func Setup(serviceName, processName, endpoint string, sampleFraction float64, enable bool, opts ...Option) error {
 	if !enable {
 		otel.SetTracerProvider(noop.NewTracerProvider())
 		return nil
@@ -30,14 +66,17 @@ func Setup(serviceName, processName, endpoint string, sampleFraction float64, en
 	if serviceName == "" {
 		return errors.New("tracing service name cannot be empty")
 	}
+	cfg := &config{exporter: JaegerExporter}
+	for _, o := range opts {
+		o(cfg)
+	}
 
-	log.Infof("Starting Jaeger exporter endpoint at address = %s", endpoint)
-	exporter, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(endpoint)))
+	exporter, err := newExporter(cfg.exporter, endpoint)
 	if err != nil {
 		return err
 	}
 	tp := trace.NewTracerProvider(
-		trace.WithSampler(trace.TraceIDRatioBased(sampleFraction)),
+		trace.WithSampler(trace.ParentBased(newFamilySampler(sampleFraction, cfg.familyFractions))),
 		trace.WithBatcher(
 			exporter,
 			trace.WithMaxExportBatchSize(trace.DefaultMaxExportBatchSize),
@@ -54,5 +93,23 @@ func Setup(serviceName, processName, endpoint string, sampleFraction float64, en
 	)
 
 	otel.SetTracerProvider(tp)
+	// Propagate the trace context and the baggage in outgoing requests, such as the Engine API calls.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 	return nil
 }
+
+func newExporter(kind, endpoint string) (trace.SpanExporter, error) {
+	switch kind {
+	case JaegerExporter:
+		log.Infof("Starting Jaeger exporter endpoint at address = %s", endpoint)
+		return jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(endpoint)))
+	case OtlpGrpcExporter:
+		log.Infof("Starting OTLP gRPC exporter endpoint at address = %s", endpoint)
+		return otlptracegrpc.New(context.Background(), otlptracegrpc.WithEndpointURL(endpoint))
+	case OtlpHttpExporter:
+		log.Infof("Starting OTLP HTTP exporter endpoint at address = %s", endpoint)
+		return otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(endpoint))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, expected one of %s, %s or %s", kind, JaegerExporter, OtlpGrpcExporter, OtlpHttpExporter)
+	}
+}
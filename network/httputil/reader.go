@@ -56,3 +56,41 @@ func RespondWithSsz(req *http.Request) bool {
 func IsRequestSsz(req *http.Request) bool {
 	return req.Header.Get("Content-Type") == api.OctetStreamMediaType
 }
+
+// Content encodings of the compressed responses.
+const (
+	ZstdEncoding   = "zstd"
+	SnappyEncoding = "snappy"
+)
+
+// AcceptedEncoding returns the supported content encoding with the highest priority in the Accept-Encoding header
+// of the request, zstd being preferred over snappy for the same priority. An empty string means the response must
+// not be compressed.
+func AcceptedEncoding(req *http.Request) string {
+	currentEncoding, currentPriority := "", 0.0
+	for _, v := range req.Header.Values("Accept-Encoding") {
+		for _, e := range strings.Split(v, ",") {
+			values := strings.Split(e, ";")
+			name := strings.ToLower(strings.TrimSpace(values[0]))
+			if name != ZstdEncoding && name != SnappyEncoding {
+				continue
+			}
+			priority := 1.0
+			if len(values) > 1 {
+				match := priorityRegex.FindAllStringSubmatch(values[1], 1)
+				if len(match) != 1 {
+					continue
+				}
+				p, err := strconv.ParseFloat(match[0][1], 32)
+				if err != nil {
+					continue
+				}
+				priority = p
+			}
+			if priority > currentPriority || (priority == currentPriority && name == ZstdEncoding) {
+				currentEncoding, currentPriority = name, priority
+			}
+		}
+	}
+	return currentEncoding
+}
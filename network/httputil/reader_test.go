@@ -121,3 +121,27 @@ func TestIsRequestSsz(t *testing.T) {
 		assert.Equal(t, false, result)
 	})
 }
+
+func TestAcceptedEncoding(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: ""},
+		{header: "gzip, deflate", want: ""},
+		{header: "snappy", want: SnappyEncoding},
+		{header: "snappy, zstd", want: ZstdEncoding},
+		{header: "zstd;q=0.5, snappy", want: SnappyEncoding},
+		{header: "gzip, ZSTD;q=0.8", want: ZstdEncoding},
+		{header: "zstd;q=0", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+			if tt.header != "" {
+				request.Header.Set("Accept-Encoding", tt.header)
+			}
+			assert.Equal(t, tt.want, AcceptedEncoding(request))
+		})
+	}
+}
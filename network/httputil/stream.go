@@ -0,0 +1,412 @@
+package httputil
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	log "github.com/sirupsen/logrus"
+)
+
+// streamBufferSize is the size of the buffer in front of the response writer of streamed responses.
+const streamBufferSize = 64 * 1024
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// hasMarshaler reports whether values of the type encode themselves through a marshaler method.
+func hasMarshaler(t reflect.Type) bool {
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		return true
+	}
+	if t.Kind() != reflect.Pointer {
+		pt := reflect.PointerTo(t)
+		return pt.Implements(jsonMarshalerType) || pt.Implements(textMarshalerType)
+	}
+	return false
+}
+
+// WriteJsonStream writes the response message in JSON format, like WriteJson, but without holding the
+// whole encoded message in memory. Structs and slices are walked and written to the response as they
+// are encoded, each slice element being encoded on its own. It is meant for heavyweight responses such
+// as validator lists and beacon states, whose encoding would otherwise take hundreds of megabytes.
+func WriteJsonStream(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", api.JsonMediaType)
+	w.WriteHeader(http.StatusOK)
+	s := newJsonStreamer(w)
+	if err := s.encode(reflect.ValueOf(v)); err != nil {
+		log.WithError(err).Error("Could not write response message")
+		return
+	}
+	if err := s.buf.WriteByte('\n'); err != nil {
+		log.WithError(err).Error("Could not write response message")
+		return
+	}
+	if err := s.buf.Flush(); err != nil {
+		log.WithError(err).Error("Could not write response message")
+	}
+}
+
+// SszMarshaler is an object which can append its ssz encoding to a buffer.
+type SszMarshaler interface {
+	MarshalSSZTo(dst []byte) ([]byte, error)
+	SizeSSZ() int
+}
+
+// WriteSszStream writes the concatenated ssz encodings of the objects in ssz format, like WriteSsz, but
+// encoding the objects one at a time into the same buffer instead of holding the whole response in memory.
+// The headers are sent before the objects are encoded, so an encoding error truncates the response.
+func WriteSszStream[T SszMarshaler](w http.ResponseWriter, objs []T, fileName string) {
+	size := 0
+	maxSize := 0
+	for _, o := range objs {
+		s := o.SizeSSZ()
+		size += s
+		maxSize = max(maxSize, s)
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(size))
+	w.Header().Set("Content-Type", api.OctetStreamMediaType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+fileName)
+	w.WriteHeader(http.StatusOK)
+	buf := make([]byte, 0, maxSize)
+	for _, o := range objs {
+		enc, err := o.MarshalSSZTo(buf[:0])
+		if err != nil {
+			log.WithError(err).Error("Could not marshal response message")
+			return
+		}
+		if _, err := w.Write(enc); err != nil {
+			log.WithError(err).Error("Could not write response message")
+			return
+		}
+	}
+}
+
+type jsonStreamer struct {
+	buf *bufio.Writer
+	// scratch and enc encode values which are not walked, reusing the same memory for all of them.
+	scratch *bytes.Buffer
+	enc     *json.Encoder
+	// appended holds values encoded by compiled appenders, reusing the same memory for all of them.
+	appended []byte
+}
+
+func newJsonStreamer(w http.ResponseWriter) *jsonStreamer {
+	scratch := new(bytes.Buffer)
+	return &jsonStreamer{
+		buf:     bufio.NewWriterSize(w, streamBufferSize),
+		scratch: scratch,
+		enc:     json.NewEncoder(scratch),
+	}
+}
+
+func (s *jsonStreamer) encode(v reflect.Value) error {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && !v.IsNil() {
+		if hasMarshaler(v.Type()) {
+			return s.encodeValue(v)
+		}
+		v = v.Elem()
+	}
+	switch {
+	case !v.IsValid():
+		return s.encodeValue(v)
+	case hasMarshaler(v.Type()):
+		return s.encodeValue(v)
+	case v.Kind() == reflect.Struct:
+		fields, ok := streamedFields(v.Type())
+		if !ok {
+			return s.encodeValue(v)
+		}
+		return s.encodeStruct(v, fields)
+	case v.Kind() == reflect.Slice && !v.IsNil() && v.Type().Elem().Kind() != reflect.Uint8:
+		return s.encodeSlice(v)
+	default:
+		return s.encodeValue(v)
+	}
+}
+
+func (s *jsonStreamer) encodeStruct(v reflect.Value, fields []streamedField) error {
+	if err := s.buf.WriteByte('{'); err != nil {
+		return err
+	}
+	first := true
+	for _, f := range fields {
+		fv := v.Field(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		if !first {
+			if err := s.buf.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		first = false
+		if _, err := s.buf.Write(f.key); err != nil {
+			return err
+		}
+		if err := s.encode(fv); err != nil {
+			return err
+		}
+	}
+	return s.buf.WriteByte('}')
+}
+
+func (s *jsonStreamer) encodeSlice(v reflect.Value) error {
+	if err := s.buf.WriteByte('['); err != nil {
+		return err
+	}
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			if err := s.buf.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		// Elements are encoded as a whole, walking them does not save any memory.
+		if err := s.encodeValue(v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return s.buf.WriteByte(']')
+}
+
+// encodeValue encodes the value with its compiled appender if it has one, and with the
+// standard JSON encoder otherwise.
+func (s *jsonStreamer) encodeValue(v reflect.Value) error {
+	if v.IsValid() {
+		if appendValue := appenderFor(v.Type()); appendValue != nil {
+			s.appended = appendValue(s.appended[:0], v)
+			_, err := s.buf.Write(s.appended)
+			return err
+		}
+	}
+	s.scratch.Reset()
+	var val any
+	switch {
+	case !v.IsValid():
+	case v.Kind() != reflect.Pointer && v.CanAddr() && hasMarshaler(reflect.PointerTo(v.Type())):
+		// Like the standard encoder, use the pointer methods of addressable values.
+		val = v.Addr().Interface()
+	default:
+		val = v.Interface()
+	}
+	if err := s.enc.Encode(val); err != nil {
+		return err
+	}
+	// Drop the newline the encoder terminates every value with.
+	_, err := s.buf.Write(bytes.TrimSuffix(s.scratch.Bytes(), []byte{'\n'}))
+	return err
+}
+
+type streamedField struct {
+	index     int
+	key       []byte
+	omitEmpty bool
+}
+
+var streamedFieldsCache sync.Map
+
+type streamedFieldsEntry struct {
+	fields []streamedField
+	ok     bool
+}
+
+// streamedFields returns the JSON fields of the struct type. Structs relying on features of the
+// standard encoder which are not supported when walking them, such as embedded structs or the
+// string option, are not walked.
+func streamedFields(t reflect.Type) ([]streamedField, bool) {
+	if e, ok := streamedFieldsCache.Load(t); ok {
+		entry := e.(streamedFieldsEntry)
+		return entry.fields, entry.ok
+	}
+	fields, ok := parseStreamedFields(t)
+	streamedFieldsCache.Store(t, streamedFieldsEntry{fields: fields, ok: ok})
+	return fields, ok
+}
+
+func parseStreamedFields(t reflect.Type) ([]streamedField, bool) {
+	fields := make([]streamedField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous {
+			return nil, false
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		var omitEmpty bool
+		for _, opt := range strings.Split(opts, ",") {
+			switch opt {
+			case "omitempty":
+				omitEmpty = true
+			case "string":
+				return nil, false
+			}
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, false
+		}
+		fields = append(fields, streamedField{index: i, key: append(key, ':'), omitEmpty: omitEmpty})
+	}
+	return fields, true
+}
+
+// isEmptyValue reports whether the value is omitted by the standard encoder under the omitempty option.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
+
+// appendFunc appends the JSON encoding of a value to dst.
+type appendFunc func(dst []byte, v reflect.Value) []byte
+
+var appendersCache sync.Map
+
+// appenderFor returns an appender encoding values of the type exactly like the standard encoder,
+// without going through it. Appenders are only compiled for strings, booleans, integers, and
+// pointers, slices and structs of those, which covers the bulk of the API messages, and are
+// much faster to run than the standard encoder on many small values. It returns nil for other types.
+func appenderFor(t reflect.Type) appendFunc {
+	if a, ok := appendersCache.Load(t); ok {
+		return a.(appendFunc)
+	}
+	a := compileAppender(t, map[reflect.Type]bool{})
+	appendersCache.Store(t, a)
+	return a
+}
+
+func compileAppender(t reflect.Type, visiting map[reflect.Type]bool) appendFunc {
+	if hasMarshaler(t) || visiting[t] {
+		return nil
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	switch t.Kind() {
+	case reflect.String:
+		return func(dst []byte, v reflect.Value) []byte {
+			return appendJsonString(dst, v.String())
+		}
+	case reflect.Bool:
+		return func(dst []byte, v reflect.Value) []byte {
+			return strconv.AppendBool(dst, v.Bool())
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(dst []byte, v reflect.Value) []byte {
+			return strconv.AppendInt(dst, v.Int(), 10)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(dst []byte, v reflect.Value) []byte {
+			return strconv.AppendUint(dst, v.Uint(), 10)
+		}
+	case reflect.Pointer:
+		elem := compileAppender(t.Elem(), visiting)
+		if elem == nil {
+			return nil
+		}
+		return func(dst []byte, v reflect.Value) []byte {
+			if v.IsNil() {
+				return append(dst, "null"...)
+			}
+			return elem(dst, v.Elem())
+		}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		elem := compileAppender(t.Elem(), visiting)
+		if elem == nil {
+			return nil
+		}
+		return func(dst []byte, v reflect.Value) []byte {
+			if v.IsNil() {
+				return append(dst, "null"...)
+			}
+			dst = append(dst, '[')
+			for i := 0; i < v.Len(); i++ {
+				if i > 0 {
+					dst = append(dst, ',')
+				}
+				dst = elem(dst, v.Index(i))
+			}
+			return append(dst, ']')
+		}
+	case reflect.Struct:
+		fields, ok := parseStreamedFields(t)
+		if !ok {
+			return nil
+		}
+		appenders := make([]appendFunc, len(fields))
+		for i, f := range fields {
+			if appenders[i] = compileAppender(t.Field(f.index).Type, visiting); appenders[i] == nil {
+				return nil
+			}
+		}
+		return func(dst []byte, v reflect.Value) []byte {
+			dst = append(dst, '{')
+			first := true
+			for i, f := range fields {
+				fv := v.Field(f.index)
+				if f.omitEmpty && isEmptyValue(fv) {
+					continue
+				}
+				if !first {
+					dst = append(dst, ',')
+				}
+				first = false
+				dst = append(dst, f.key...)
+				dst = appenders[i](dst, fv)
+			}
+			return append(dst, '}')
+		}
+	default:
+		return nil
+	}
+}
+
+// appendJsonString appends the JSON encoding of the string to dst. Strings made of printable ASCII
+// characters only, such as the hex and decimal strings of the API, are quoted as they are; the other
+// ones go through the standard encoder for their escaping.
+func appendJsonString(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c > 0x7e || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			enc, err := json.Marshal(s)
+			if err != nil {
+				// Strings always encode.
+				return append(dst, `""`...)
+			}
+			return append(dst, enc...)
+		}
+	}
+	dst = append(dst, '"')
+	dst = append(dst, s...)
+	return append(dst, '"')
+}
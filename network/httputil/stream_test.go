@@ -0,0 +1,108 @@
+package httputil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type streamedItem struct {
+	Index   string   `json:"index"`
+	Balance string   `json:"balance,omitempty"`
+	Roots   []string `json:"roots"`
+}
+
+type streamedEmbedded struct {
+	streamedItem
+	Count uint64 `json:"count,string"`
+}
+
+type streamedMessage struct {
+	Version  string          `json:"version"`
+	Final    bool            `json:"finalized,omitempty"`
+	Items    []*streamedItem `json:"items"`
+	Missing  []*streamedItem `json:"missing"`
+	Empty    []string        `json:"empty,omitempty"`
+	Raw      json.RawMessage `json:"raw"`
+	Bytes    []byte          `json:"bytes"`
+	Data     interface{}     `json:"data"`
+	Nothing  interface{}     `json:"nothing"`
+	Embedded streamedEmbedded
+	Numbers  []int64 `json:"numbers"`
+	Escaped  string  `json:"escaped"`
+	Skipped  string  `json:"-"`
+	private  string
+}
+
+func TestWriteJsonStream(t *testing.T) {
+	msg := &streamedMessage{
+		Version: "deneb",
+		Items: []*streamedItem{
+			{Index: "1", Balance: "32", Roots: []string{"0x01", "0x02"}},
+			{Index: "2"},
+			nil,
+		},
+		Raw:      json.RawMessage(`{"a": 1}`),
+		Bytes:    []byte{1, 2, 3},
+		Data:     &streamedItem{Index: "<3>", Roots: []string{}},
+		Embedded: streamedEmbedded{streamedItem: streamedItem{Index: "4"}, Count: 5},
+		Numbers:  []int64{-1, 0, 1 << 40},
+		Escaped:  "<a href=\"x\">\u2028\n\xff</a>",
+		Skipped:  "skipped",
+		private:  "private",
+	}
+	want, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	WriteJsonStream(w, msg)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, api.JsonMediaType, w.Header().Get("Content-Type"))
+	assert.Equal(t, string(want)+"\n", w.Body.String())
+}
+
+type sszItem []byte
+
+func (i sszItem) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, i...), nil
+}
+
+func (i sszItem) SizeSSZ() int {
+	return len(i)
+}
+
+func TestWriteSszStream(t *testing.T) {
+	w := httptest.NewRecorder()
+	WriteSszStream(w, []sszItem{{1, 2, 3}, {}, {4, 5}}, "items.ssz")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, api.OctetStreamMediaType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "5", w.Header().Get("Content-Length"))
+	assert.Equal(t, "attachment; filename=items.ssz", w.Header().Get("Content-Disposition"))
+	assert.DeepEqual(t, []byte{1, 2, 3, 4, 5}, w.Body.Bytes())
+}
+
+func BenchmarkWriteJson(b *testing.B) {
+	items := make([]*streamedItem, 100000)
+	for i := range items {
+		items[i] = &streamedItem{Index: "1000000", Balance: "32000000000", Roots: []string{"0x0102030405060708090a0b0c0d0e0f10"}}
+	}
+	msg := &streamedMessage{Version: "deneb", Items: items}
+
+	b.Run("WriteJson", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			WriteJson(httptest.NewRecorder(), msg)
+		}
+	})
+	b.Run("WriteJsonStream", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			WriteJsonStream(httptest.NewRecorder(), msg)
+		}
+	})
+}
@@ -7,11 +7,19 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"sync"
+	"time"
 
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	log "github.com/sirupsen/logrus"
 )
 
+var zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+	return zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+})
+
 type HasStatusCode interface {
 	StatusCode() int
 }
@@ -49,6 +57,47 @@ func WriteSsz(w http.ResponseWriter, respSsz []byte, fileName string) {
 	}
 }
 
+// WriteSszContent writes the response message in ssz format like WriteSsz, but compresses it with the encoding
+// accepted by the request and serves the byte range requested, if any, so that a large download can be resumed.
+// The etag identifies the message, so that a range is only served while the message does not change.
+func WriteSszContent(w http.ResponseWriter, req *http.Request, respSsz []byte, fileName, etag string) {
+	body := respSsz
+	encoding := AcceptedEncoding(req)
+	switch encoding {
+	case ZstdEncoding:
+		enc, err := zstdEncoder()
+		if err != nil {
+			HandleError(w, "Could not create zstd encoder: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		body = enc.EncodeAll(respSsz, make([]byte, 0, len(respSsz)/2))
+	case SnappyEncoding:
+		body = snappy.Encode(nil, respSsz)
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", api.OctetStreamMediaType)
+	h.Set("Content-Disposition", "attachment; filename="+fileName)
+	h.Add("Vary", "Accept-Encoding")
+	if encoding != "" {
+		h.Set("Content-Encoding", encoding)
+		etag += "-" + encoding
+	}
+	if etag != "" {
+		h.Set("Etag", `"`+etag+`"`)
+	}
+	http.ServeContent(w, req, fileName, time.Time{}, bytes.NewReader(body))
+}
+
+// WriteRaw writes the response body as is, with the given content type.
+func WriteRaw(w http.ResponseWriter, contentType string, body []byte) {
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.Header().Set("Content-Type", contentType)
+	if _, err := w.Write(body); err != nil {
+		log.WithError(err).Error("Could not write response message")
+	}
+}
+
 // WriteError writes the error by manipulating headers and the body of the final response.
 func WriteError(w http.ResponseWriter, errJson HasStatusCode) {
 	j, err := json.Marshal(errJson)
@@ -0,0 +1,73 @@
+package httputil
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestWriteSszContent(t *testing.T) {
+	ssz := bytes.Repeat([]byte("beacon state"), 100)
+
+	t.Run("uncompressed", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		writer := httptest.NewRecorder()
+		WriteSszContent(writer, request, ssz, "state.ssz", "0x01")
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, "", writer.Header().Get("Content-Encoding"))
+		assert.Equal(t, `"0x01"`, writer.Header().Get("Etag"))
+		assert.DeepEqual(t, ssz, writer.Body.Bytes())
+	})
+	t.Run("zstd", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request.Header.Set("Accept-Encoding", "zstd")
+		writer := httptest.NewRecorder()
+		WriteSszContent(writer, request, ssz, "state.ssz", "0x01")
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, ZstdEncoding, writer.Header().Get("Content-Encoding"))
+		assert.Equal(t, `"0x01-zstd"`, writer.Header().Get("Etag"))
+		dec, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer dec.Close()
+		decoded, err := dec.DecodeAll(writer.Body.Bytes(), nil)
+		require.NoError(t, err)
+		assert.DeepEqual(t, ssz, decoded)
+	})
+	t.Run("snappy", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request.Header.Set("Accept-Encoding", "snappy")
+		writer := httptest.NewRecorder()
+		WriteSszContent(writer, request, ssz, "state.ssz", "0x01")
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, SnappyEncoding, writer.Header().Get("Content-Encoding"))
+		decoded, err := snappy.Decode(nil, writer.Body.Bytes())
+		require.NoError(t, err)
+		assert.DeepEqual(t, ssz, decoded)
+	})
+	t.Run("range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request.Header.Set("Range", "bytes=100-")
+		request.Header.Set("If-Range", `"0x01"`)
+		writer := httptest.NewRecorder()
+		WriteSszContent(writer, request, ssz, "state.ssz", "0x01")
+		require.Equal(t, http.StatusPartialContent, writer.Code)
+		assert.DeepEqual(t, ssz[100:], writer.Body.Bytes())
+	})
+	t.Run("range of a changed content", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request.Header.Set("Range", "bytes=100-")
+		request.Header.Set("If-Range", `"0x02"`)
+		writer := httptest.NewRecorder()
+		WriteSszContent(writer, request, ssz, "state.ssz", "0x01")
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.DeepEqual(t, ssz, writer.Body.Bytes())
+	})
+}
@@ -0,0 +1,33 @@
+package network
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// This creates a custom HTTP transport which injects the trace context and the baggage
+// of the request context into the request headers, so that the traces of the execution
+// client can be joined with the traces of the requests it serves.
+type tracingTransport struct {
+	underlyingTransport http.RoundTripper
+}
+
+// RoundTrip ensures our transport implements http.RoundTripper interface from the
+// standard library.
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	otel.GetTextMapPropagator().Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+	return t.underlyingTransport.RoundTrip(req)
+}
+
+// withTracing returns a copy of the client propagating the trace context of its requests.
+func withTracing(c *http.Client) *http.Client {
+	underlying := c.Transport
+	if underlying == nil {
+		underlying = http.DefaultTransport
+	}
+	traced := *c
+	traced.Transport = &tracingTransport{underlyingTransport: underlying}
+	return &traced
+}
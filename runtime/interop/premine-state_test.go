@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/time"
@@ -21,6 +22,14 @@ func TestPremineGenesis_Electra(t *testing.T) {
 		ExcessBlobGas: &one,
 		BlobGasUsed:   &one,
 	})
-	_, err := NewPreminedGenesis(context.Background(), genesis.Time(), 10, 10, version.Electra, genesis)
+	st, err := NewPreminedGenesis(context.Background(), genesis.Time(), 10, 5, version.Electra, genesis)
 	require.NoError(t, err)
+	require.Equal(t, version.Electra, st.Version())
+	startIndex, err := st.DepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().UnsetDepositRequestsStartIndex, startIndex)
+	vals := st.Validators()
+	require.Equal(t, 10, len(vals))
+	require.Equal(t, params.BeaconConfig().ETH1AddressWithdrawalPrefixByte, vals[4].WithdrawalCredentials[0])
+	require.Equal(t, params.BeaconConfig().BLSWithdrawalPrefixByte, vals[5].WithdrawalCredentials[0])
 }
@@ -0,0 +1,83 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The top level fields of the JSON log entries. Their names do not change across releases, so that
+// log pipelines such as Loki or Elastic can index them.
+const (
+	JsonTimestampKey = "timestamp"
+	JsonLevelKey     = "level"
+	JsonModuleKey    = "module"
+	JsonMessageKey   = "msg"
+	JsonErrorKey     = "error"
+	JsonTraceIdKey   = "trace_id"
+	JsonSpanIdKey    = "span_id"
+	JsonFieldsKey    = "fields"
+)
+
+// moduleField is the field naming the module which logs an entry.
+const moduleField = "prefix"
+
+// JSONFormatter formats log entries of the json-structured log format as JSON objects with a stable
+// schema. The timestamp, level, module, message and error of the entry are top level fields, as are the
+// IDs of the trace and span of the context of the entry, if any. The other fields of the entry are
+// nested under the fields key, so that they never collide with the top level ones. The json log format
+// keeps the flat schema of the logrus JSON formatter.
+type JSONFormatter struct{}
+
+// Format --
+func (f *JSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(map[string]interface{}, 8)
+	data[JsonTimestampKey] = entry.Time.UTC().Format(time.RFC3339Nano)
+	data[JsonLevelKey] = entry.Level.String()
+	data[JsonMessageKey] = entry.Message
+
+	fields := make(map[string]interface{}, len(entry.Data))
+	for k, v := range entry.Data {
+		switch {
+		case k == moduleField:
+			data[JsonModuleKey] = v
+		case k == logrus.ErrorKey:
+			data[JsonErrorKey] = jsonValue(v)
+		default:
+			fields[k] = jsonValue(v)
+		}
+	}
+	if len(fields) > 0 {
+		data[JsonFieldsKey] = fields
+	}
+	if entry.Context != nil {
+		if sc := trace.SpanContextFromContext(entry.Context); sc.IsValid() {
+			data[JsonTraceIdKey] = sc.TraceID().String()
+			data[JsonSpanIdKey] = sc.SpanID().String()
+		}
+	}
+
+	b := entry.Buffer
+	if b == nil {
+		b = &bytes.Buffer{}
+	}
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(data); err != nil {
+		return nil, fmt.Errorf("failed to marshal fields to JSON: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// jsonValue returns the value as it is encoded in the log entries. Errors, which would be encoded as
+// empty objects, are encoded with their message.
+func jsonValue(v interface{}) interface{} {
+	if err, ok := v.(error); ok {
+		return err.Error()
+	}
+	return v
+}
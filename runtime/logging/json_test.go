@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestJSONFormatter(t *testing.T) {
+	traceID := trace.TraceID{1, 2, 3}
+	spanID := trace.SpanID{4, 5, 6}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+	entry := logrus.WithFields(logrus.Fields{
+		"prefix": "sync",
+		"topic":  "/eth2/beacon_block",
+		"level":  "shadowed",
+	}).WithError(errors.New("invalid signature")).WithContext(ctx)
+	entry.Time = time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	entry.Level = logrus.DebugLevel
+	entry.Message = "Gossip message was rejected"
+
+	b, err := (&JSONFormatter{}).Format(entry)
+	require.NoError(t, err)
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.DeepEqual(t, map[string]interface{}{
+		"timestamp": "2024-01-02T03:04:05.000000006Z",
+		"level":     "debug",
+		"module":    "sync",
+		"msg":       "Gossip message was rejected",
+		"error":     "invalid signature",
+		"trace_id":  traceID.String(),
+		"span_id":   spanID.String(),
+		"fields": map[string]interface{}{
+			"topic": "/eth2/beacon_block",
+			"level": "shadowed",
+		},
+	}, got)
+}
@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"sync"
+	"time"
+)
+
+// EventSampler samples noisy log events, such as the rejections of gossip messages. In every period, the
+// first events of a kind are all logged, and then only one in every thereafter of them.
+type EventSampler struct {
+	first      uint64
+	thereafter uint64
+	period     time.Duration
+	now        func() time.Time
+
+	sync.Mutex
+	counters map[string]*eventCounter
+}
+
+type eventCounter struct {
+	reset   time.Time
+	count   uint64
+	dropped uint64
+}
+
+// NewEventSampler returns a sampler logging the first events of every kind in each period, and then one
+// in every thereafter of them.
+func NewEventSampler(first, thereafter uint64, period time.Duration) *EventSampler {
+	if thereafter == 0 {
+		thereafter = 1
+	}
+	return &EventSampler{
+		first:      first,
+		thereafter: thereafter,
+		period:     period,
+		now:        time.Now,
+		counters:   make(map[string]*eventCounter),
+	}
+}
+
+// Sample reports whether the event of the kind is to be logged. When it is, it also returns the number of
+// events of the kind which have been dropped since the last logged one.
+func (s *EventSampler) Sample(kind string) (bool, uint64) {
+	s.Lock()
+	defer s.Unlock()
+	now := s.now()
+	c, ok := s.counters[kind]
+	if !ok {
+		c = &eventCounter{reset: now.Add(s.period)}
+		s.counters[kind] = c
+	}
+	if !now.Before(c.reset) {
+		c.reset = now.Add(s.period)
+		c.count = 0
+	}
+	c.count++
+	if c.count <= s.first || (c.count-s.first)%s.thereafter == 0 {
+		dropped := c.dropped
+		c.dropped = 0
+		return true, dropped
+	}
+	c.dropped++
+	return false, 0
+}
@@ -0,0 +1,33 @@
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestEventSampler(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := NewEventSampler(2, 3, time.Second)
+	s.now = func() time.Time { return now }
+
+	var logged []uint64
+	for i := 0; i < 8; i++ {
+		if ok, dropped := s.Sample("rejected"); ok {
+			logged = append(logged, dropped)
+		}
+	}
+	// The 2 first events are logged, then one in 3 of them.
+	assert.DeepEqual(t, []uint64{0, 0, 2, 2}, logged)
+
+	// Events of other kinds are sampled on their own.
+	ok, _ := s.Sample("ignored")
+	assert.Equal(t, true, ok)
+
+	// All the events are logged again at the beginning of the next period.
+	now = now.Add(time.Second)
+	ok, dropped := s.Sample("rejected")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, uint64(0), dropped)
+}
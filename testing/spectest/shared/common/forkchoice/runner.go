@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/snappy"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
@@ -406,7 +407,7 @@ func runBlobStep(t *testing.T,
 			requireVerifyExpected(t, err)
 
 			if err == nil {
-				require.NoError(t, builder.service.ReceiveBlob(context.Background(), vsc))
+				require.NoError(t, builder.service.ReceiveBlob(context.Background(), vsc, das.SourceGossip))
 			}
 		}
 	}
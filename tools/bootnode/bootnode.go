@@ -70,7 +70,7 @@ func main() {
 	flag.Parse()
 
 	if *logFileName != "" {
-		if err := logs.ConfigurePersistentLogging(*logFileName); err != nil {
+		if err := logs.ConfigurePersistentLogging(*logFileName, 0, 0); err != nil {
 			log.WithError(err).Error("Failed to configuring logging to disk.")
 		}
 	}
@@ -0,0 +1,297 @@
+package accounts
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// Key derivation parameters below which a keystore is considered weakly protected. EIP-2335 keystores
+// are created with 2^18 pbkdf2 iterations or an scrypt cost of 2^18.
+const (
+	minPBKDF2Iterations = 1 << 18
+	minScryptCost       = 1 << 18
+)
+
+// AuditWallet is the accounts keystore of a Prysm wallet to audit.
+type AuditWallet struct {
+	Dir      string
+	Keystore *local.AccountsKeystoreRepresentation
+	Password string
+}
+
+// AuditKeystore is an EIP-2335 keystore to audit, along with the path of its file.
+type AuditKeystore struct {
+	Path     string
+	Keystore *keymanager.Keystore
+}
+
+// AuditConfig defines the keystores audited by AuditAccounts.
+type AuditConfig struct {
+	Wallets []*AuditWallet
+	// Keystores are unlocked with KeystoresPassword.
+	Keystores         []*AuditKeystore
+	KeystoresPassword string
+	// DB is the slashing protection database of the validator, the keys are not cross-checked against it if nil.
+	DB iface.ValidatorDB
+}
+
+// AuditFinding is an issue found by AuditAccounts in a keystore, or for a public key. Critical findings
+// are the ones which keep a validator from running, or risk getting it slashed.
+type AuditFinding struct {
+	Critical bool
+	Source   string
+	Pubkey   string
+	Message  string
+}
+
+// AuditReport holds the findings of AuditAccounts over the keys it audited.
+type AuditReport struct {
+	Keys     int
+	Findings []*AuditFinding
+}
+
+// Critical returns the number of critical findings of the report.
+func (r *AuditReport) Critical() int {
+	n := 0
+	for _, f := range r.Findings {
+		if f.Critical {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *AuditReport) add(critical bool, source, pubkey, format string, args ...interface{}) {
+	r.Findings = append(r.Findings, &AuditFinding{
+		Critical: critical,
+		Source:   source,
+		Pubkey:   pubkey,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// ReadAuditKeystores reads the EIP-2335 keystores of the directory and its subdirectories, skipping the files
+// which are not keystores.
+func ReadAuditKeystores(ctx context.Context, dir string) ([]*AuditKeystore, error) {
+	var keystores []*AuditKeystore
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		ks, err := readKeystoreFile(ctx, path)
+		if err != nil {
+			if strings.Contains(err.Error(), "could not decode keystore json") {
+				return nil
+			}
+			return errors.Wrapf(err, "could not read keystore at path: %s", path)
+		}
+		keystores = append(keystores, &AuditKeystore{Path: path, Keystore: ks})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keystores, nil
+}
+
+// AuditAccounts verifies that the wallets and keystores decrypt with their passwords into the keys they
+// advertise, flags weak or deprecated encryption parameters, keys held more than once, and cross-checks
+// the keys against the slashing protection history of the validator.
+func AuditAccounts(ctx context.Context, cfg *AuditConfig) (*AuditReport, error) {
+	report := &AuditReport{}
+	sources := make(map[[fieldparams.BLSPubkeyLength]byte][]string)
+
+	for _, w := range cfg.Wallets {
+		auditCrypto(report, w.Dir, "", w.Keystore.Version, w.Keystore.Crypto)
+		privateKeys, publicKeys, err := local.DecryptAccountsKeystore(w.Keystore, w.Password)
+		if err != nil {
+			report.add(true, w.Dir, "", "could not decrypt the accounts keystore of the wallet: %v", err)
+			continue
+		}
+		for i := range publicKeys {
+			pubkey := bytesutil.ToBytes48(publicKeys[i])
+			if err := auditSecretKey(privateKeys[i], pubkey); err != nil {
+				report.add(true, w.Dir, hexPubkey(pubkey), "%v", err)
+				continue
+			}
+			sources[pubkey] = append(sources[pubkey], w.Dir)
+		}
+	}
+
+	decryptor := keystorev4.New()
+	for _, k := range cfg.Keystores {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		ks := k.Keystore
+		auditCrypto(report, k.Path, ks.Pubkey, ks.Version, ks.Crypto)
+		pubkeyBytes, err := hex.DecodeString(strings.TrimPrefix(ks.Pubkey, "0x"))
+		if err != nil || len(pubkeyBytes) != fieldparams.BLSPubkeyLength {
+			report.add(true, k.Path, ks.Pubkey, "invalid public key")
+			continue
+		}
+		pubkey := bytesutil.ToBytes48(pubkeyBytes)
+		secret, err := decryptor.Decrypt(ks.Crypto, cfg.KeystoresPassword)
+		if err != nil {
+			report.add(true, k.Path, ks.Pubkey, "could not decrypt the keystore with the provided password: %v", err)
+			continue
+		}
+		if err := auditSecretKey(secret, pubkey); err != nil {
+			report.add(true, k.Path, ks.Pubkey, "%v", err)
+			continue
+		}
+		sources[pubkey] = append(sources[pubkey], k.Path)
+	}
+
+	pubkeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(sources))
+	for pubkey := range sources {
+		pubkeys = append(pubkeys, pubkey)
+	}
+	sort.Slice(pubkeys, func(i, j int) bool { return hexPubkey(pubkeys[i]) < hexPubkey(pubkeys[j]) })
+	report.Keys = len(pubkeys)
+
+	for _, pubkey := range pubkeys {
+		if s := sources[pubkey]; len(s) > 1 {
+			report.add(true, strings.Join(s, ", "), hexPubkey(pubkey),
+				"key held in %d places, running it from more than one validator client gets it slashed", len(s))
+		}
+	}
+
+	if cfg.DB == nil {
+		return report, nil
+	}
+	if err := auditSlashingProtection(ctx, report, cfg.DB, pubkeys); err != nil {
+		return nil, errors.Wrap(err, "could not cross-check keys with slashing protection history")
+	}
+	return report, nil
+}
+
+// auditSecretKey checks that the secret key decrypted from a keystore is the one of the public key.
+func auditSecretKey(secret []byte, pubkey [fieldparams.BLSPubkeyLength]byte) error {
+	sk, err := bls.SecretKeyFromBytes(secret)
+	if err != nil {
+		return errors.Wrap(err, "invalid secret key")
+	}
+	if bytesutil.ToBytes48(sk.PublicKey().Marshal()) != pubkey {
+		return errors.Errorf("secret key does not match the public key, it is the one of %#x", sk.PublicKey().Marshal())
+	}
+	return nil
+}
+
+// auditCrypto flags the keystore versions and encryption parameters which are deprecated, or weaker than the
+// ones EIP-2335 keystores are created with.
+func auditCrypto(report *AuditReport, source, pubkey string, version uint, crypto map[string]interface{}) {
+	if version != 4 {
+		report.add(false, source, pubkey, "deprecated keystore version %d, current EIP-2335 keystores are version 4", version)
+	}
+	kdf, _ := crypto["kdf"].(map[string]interface{})
+	params, _ := kdf["params"].(map[string]interface{})
+	switch function, _ := kdf["function"].(string); function {
+	case "pbkdf2":
+		if c, ok := auditNumber(params["c"]); !ok || c < minPBKDF2Iterations {
+			report.add(false, source, pubkey, "pbkdf2 with %v iterations, below the recommended %d", params["c"], minPBKDF2Iterations)
+		}
+		if prf, _ := params["prf"].(string); prf != "hmac-sha256" {
+			report.add(false, source, pubkey, "deprecated pbkdf2 pseudorandom function %q, expected hmac-sha256", prf)
+		}
+	case "scrypt":
+		if n, ok := auditNumber(params["n"]); !ok || n < minScryptCost {
+			report.add(false, source, pubkey, "scrypt with a cost of %v, below the recommended %d", params["n"], minScryptCost)
+		}
+	default:
+		report.add(false, source, pubkey, "unknown key derivation function %q", function)
+	}
+	if dklen, ok := auditNumber(params["dklen"]); !ok || dklen != 32 {
+		report.add(false, source, pubkey, "derived key length %v, expected 32", params["dklen"])
+	}
+	cipher, _ := crypto["cipher"].(map[string]interface{})
+	if function, _ := cipher["function"].(string); function != "aes-128-ctr" {
+		report.add(false, source, pubkey, "unknown cipher %q, expected aes-128-ctr", function)
+	}
+}
+
+// auditSlashingProtection flags the keys without slashing protection history, which must be imported from any
+// other validator client which used them before, the keys blacklisted by slashing protection imports, and the
+// history of keys without keystore.
+func auditSlashingProtection(ctx context.Context, report *AuditReport, db iface.ValidatorDB, pubkeys [][fieldparams.BLSPubkeyLength]byte) error {
+	proposed, err := db.ProposedPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+	attested, err := db.AttestedPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+	blacklisted, err := db.EIPImportBlacklistedPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+	protected := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, pubkey := range proposed {
+		protected[pubkey] = true
+	}
+	for _, pubkey := range attested {
+		protected[pubkey] = true
+	}
+	isBlacklisted := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, pubkey := range blacklisted {
+		isBlacklisted[pubkey] = true
+	}
+
+	held := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	source := db.DatabasePath()
+	for _, pubkey := range pubkeys {
+		held[pubkey] = true
+		if isBlacklisted[pubkey] {
+			report.add(true, source, hexPubkey(pubkey), "key blacklisted by a slashing protection import, which found slashable history")
+		}
+		if !protected[pubkey] {
+			report.add(false, source, hexPubkey(pubkey),
+				"no slashing protection history, import it from any validator client which used the key before")
+		}
+	}
+	orphans := make([]string, 0)
+	for pubkey := range protected {
+		if !held[pubkey] {
+			orphans = append(orphans, hexPubkey(pubkey))
+		}
+	}
+	sort.Strings(orphans)
+	for _, pubkey := range orphans {
+		report.add(false, source, pubkey, "slashing protection history for a key without keystore")
+	}
+	return nil
+}
+
+// auditNumber reads a number of the crypto parameters of a keystore, which are float64 once decoded from json.
+func auditNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+func hexPubkey(pubkey [fieldparams.BLSPubkeyLength]byte) string {
+	return fmt.Sprintf("%#x", pubkey)
+}
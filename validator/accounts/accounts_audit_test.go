@@ -0,0 +1,174 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func auditKeystore(t *testing.T, key bls.SecretKey, password string) *keymanager.Keystore {
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(key.Marshal(), password)
+	require.NoError(t, err)
+	return &keymanager.Keystore{
+		Crypto:  cryptoFields,
+		Pubkey:  fmt.Sprintf("%x", key.PublicKey().Marshal()),
+		Version: encryptor.Version(),
+	}
+}
+
+func TestAuditAccounts(t *testing.T) {
+	ctx := context.Background()
+	keys := make([]bls.SecretKey, 5)
+	pubkeys := make([][fieldparams.BLSPubkeyLength]byte, len(keys))
+	for i := range keys {
+		var err error
+		keys[i], err = bls.RandKey()
+		require.NoError(t, err)
+		pubkeys[i] = bytesutil.ToBytes48(keys[i].PublicKey().Marshal())
+	}
+
+	// The wallet holds keys 0 and 1.
+	encodedStore, err := json.Marshal(map[string][][]byte{
+		"private_keys": {keys[0].Marshal(), keys[1].Marshal()},
+		"public_keys":  {keys[0].PublicKey().Marshal(), keys[1].PublicKey().Marshal()},
+	})
+	require.NoError(t, err)
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(encodedStore, password)
+	require.NoError(t, err)
+	w := &AuditWallet{
+		Dir:      "wallet",
+		Keystore: &local.AccountsKeystoreRepresentation{Crypto: cryptoFields, Version: encryptor.Version()},
+		Password: password,
+	}
+
+	// The keystores hold key 1 again, key 2, and key 3 encrypted with another password.
+	keysDir := t.TempDir()
+	for i, ks := range []*keymanager.Keystore{
+		auditKeystore(t, keys[1], password),
+		auditKeystore(t, keys[2], password),
+		auditKeystore(t, keys[3], "another password"),
+	} {
+		encoded, err := json.Marshal(ks)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(keysDir, fmt.Sprintf("keystore-%d.json", i)), encoded, 0600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "deposit_data.json"), []byte("[]"), 0600))
+	keystores, err := ReadAuditKeystores(ctx, keysDir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(keystores))
+
+	// The slashing protection history holds key 0, key 4 without keystore, and blacklists key 2.
+	db := dbtest.SetupDB(t, nil, false)
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubkeys[0], 1, []byte{1}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubkeys[4], 1, []byte{1}))
+	require.NoError(t, db.SaveEIPImportBlacklistedPublicKeys(ctx, [][fieldparams.BLSPubkeyLength]byte{pubkeys[2]}))
+
+	report, err := AuditAccounts(ctx, &AuditConfig{
+		Wallets:           []*AuditWallet{w},
+		Keystores:         keystores,
+		KeystoresPassword: password,
+		DB:                db,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Keys)
+
+	findings := make(map[string][]*AuditFinding)
+	for _, f := range report.Findings {
+		findings[f.Pubkey] = append(findings[f.Pubkey], f)
+	}
+	hexKey := func(i int) string { return fmt.Sprintf("%#x", pubkeys[i]) }
+	assert.Equal(t, 0, len(findings[hexKey(0)]))
+	require.Equal(t, 2, len(findings[hexKey(1)]))
+	assert.Equal(t, true, findings[hexKey(1)][0].Critical)
+	assert.StringContains(t, "held in 2 places", findings[hexKey(1)][0].Message)
+	assert.StringContains(t, "no slashing protection history", findings[hexKey(1)][1].Message)
+	require.Equal(t, 2, len(findings[hexKey(2)]))
+	assert.StringContains(t, "blacklisted", findings[hexKey(2)][0].Message)
+	require.Equal(t, 1, len(findings[fmt.Sprintf("%x", pubkeys[3])]))
+	assert.StringContains(t, "could not decrypt", findings[fmt.Sprintf("%x", pubkeys[3])][0].Message)
+	require.Equal(t, 1, len(findings[hexKey(4)]))
+	assert.Equal(t, false, findings[hexKey(4)][0].Critical)
+	assert.StringContains(t, "without keystore", findings[hexKey(4)][0].Message)
+	assert.Equal(t, 3, report.Critical())
+
+	report, err = AuditAccounts(ctx, &AuditConfig{Wallets: []*AuditWallet{{Dir: "wallet", Keystore: w.Keystore, Password: "wrong"}}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Findings))
+	assert.StringContains(t, "could not decrypt the accounts keystore", report.Findings[0].Message)
+}
+
+func TestAuditCrypto(t *testing.T) {
+	crypto := func(kdf string, params map[string]interface{}, cipher string) map[string]interface{} {
+		return map[string]interface{}{
+			"kdf":    map[string]interface{}{"function": kdf, "params": params},
+			"cipher": map[string]interface{}{"function": cipher},
+		}
+	}
+	tests := []struct {
+		name     string
+		version  uint
+		crypto   map[string]interface{}
+		findings []string
+	}{
+		{
+			name:    "pbkdf2",
+			version: 4,
+			crypto:  crypto("pbkdf2", map[string]interface{}{"c": float64(262144), "dklen": float64(32), "prf": "hmac-sha256"}, "aes-128-ctr"),
+		},
+		{
+			name:    "scrypt",
+			version: 4,
+			crypto:  crypto("scrypt", map[string]interface{}{"n": float64(262144), "dklen": float64(32)}, "aes-128-ctr"),
+		},
+		{
+			name:     "weak pbkdf2",
+			version:  4,
+			crypto:   crypto("pbkdf2", map[string]interface{}{"c": float64(1024), "dklen": float64(32), "prf": "hmac-sha1"}, "aes-128-ctr"),
+			findings: []string{"pbkdf2 with 1024 iterations", "pseudorandom function"},
+		},
+		{
+			name:     "weak scrypt",
+			version:  4,
+			crypto:   crypto("scrypt", map[string]interface{}{"n": float64(2), "dklen": float64(16)}, "aes-128-ctr"),
+			findings: []string{"scrypt with a cost of 2", "derived key length 16"},
+		},
+		{
+			name:     "deprecated version and cipher",
+			version:  3,
+			crypto:   crypto("pbkdf2", map[string]interface{}{"c": float64(262144), "dklen": float64(32), "prf": "hmac-sha256"}, "aes-128-cbc"),
+			findings: []string{"keystore version 3", "unknown cipher"},
+		},
+		{
+			name:     "unknown kdf",
+			version:  4,
+			crypto:   crypto("argon2", map[string]interface{}{"dklen": float64(32)}, "aes-128-ctr"),
+			findings: []string{"unknown key derivation function"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &AuditReport{}
+			auditCrypto(report, "keystore.json", "", tt.version, tt.crypto)
+			require.Equal(t, len(tt.findings), len(report.Findings))
+			for i, f := range report.Findings {
+				assert.Equal(t, false, f.Critical)
+				assert.StringContains(t, tt.findings[i], f.Message)
+			}
+		})
+	}
+}
@@ -69,6 +69,7 @@ type CLIManager struct {
 	beaconApiEndpoint    string
 	beaconApiTimeout     time.Duration
 	inputReader          io.Reader
+	kdfParams            *keymanager.KDFParams
 }
 
 func (acm *CLIManager) prepareBeaconClients(ctx context.Context) (*iface.ValidatorClient, *iface.NodeClient, error) {
@@ -252,3 +252,16 @@ func WithCustomReader(reader io.Reader) Option {
 		return nil
 	}
 }
+
+// WithKDFParams specifies the key derivation function wallet keystores are encrypted with.
+func WithKDFParams(params *keymanager.KDFParams) Option {
+	return func(acc *CLIManager) error {
+		if params != nil {
+			if err := params.Validate(); err != nil {
+				return err
+			}
+		}
+		acc.kdfParams = params
+		return nil
+	}
+}
@@ -104,6 +104,7 @@ func (_ *Wallet) InitializeKeymanager(_ context.Context, _ iface.InitKeymanagerC
 
 type Validator struct {
 	Km               keymanager.IKeymanager
+	Schedule         *iface2.DutySchedule
 	graffiti         string
 	proposerSettings *proposer.Settings
 }
@@ -174,6 +175,10 @@ func (_ *Validator) LogSubmittedAtts(_ primitives.Slot) {
 	panic("implement me")
 }
 
+func (_ *Validator) PrepareProposals(_ context.Context, _ primitives.Slot) {
+	panic("implement me")
+}
+
 func (_ *Validator) UpdateDomainDataCaches(_ context.Context, _ primitives.Slot) {
 	panic("implement me")
 }
@@ -269,3 +274,8 @@ func (*Validator) Host() string {
 func (*Validator) ChangeHost() {
 	panic("implement me")
 }
+
+// DutySchedule for mocking
+func (m *Validator) DutySchedule() *iface2.DutySchedule {
+	return m.Schedule
+}
@@ -0,0 +1,251 @@
+package accounts
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const (
+	// WalletBackupFileName is the name of the file the encrypted wallet backup is written to.
+	WalletBackupFileName = "wallet-backup.json"
+
+	backupKeystoresDir               = "keystores"
+	backupSlashingProtectionFileName = "slashing_protection.json"
+	backupProposerSettingsFileName   = "proposer_settings.json"
+)
+
+// EncryptedWalletBackup is a zip archive of the keystores, slashing protection history and proposer
+// settings of a validator, encrypted as the secret of an EIP-2335 keystore.
+type EncryptedWalletBackup struct {
+	Crypto  map[string]interface{} `json:"crypto"`
+	ID      string                 `json:"uuid"`
+	Version uint                   `json:"version"`
+	Name    string                 `json:"name"`
+}
+
+// WalletBackupConfig defines the validator backed up by BackupWallet.
+type WalletBackupConfig struct {
+	Keymanager keymanager.IKeymanager
+	// DB is the database holding the slashing protection history and proposer settings of the validator.
+	DB iface.ValidatorDB
+	// Password encrypts both the archive and the keystores it holds.
+	Password string
+}
+
+// WalletRestoreConfig defines where RestoreWallet restores a backup to.
+type WalletRestoreConfig struct {
+	Importer keymanager.Importer
+	DB       iface.ValidatorDB
+	Password string
+}
+
+// BackupWallet archives the keystores of all the accounts of the keymanager along with their slashing
+// protection history and the proposer settings of the validator, and encrypts the archive.
+func BackupWallet(ctx context.Context, cfg *WalletBackupConfig) (*EncryptedWalletBackup, error) {
+	if cfg.Keymanager == nil || cfg.DB == nil {
+		return nil, errors.New("a keymanager and a validator database are required to back up a wallet")
+	}
+	pubKeys, err := cfg.Keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no accounts to back up")
+	}
+	publicKeys := make([]bls.PublicKey, len(pubKeys))
+	rawPubKeys := make([][]byte, len(pubKeys))
+	for i, pk := range pubKeys {
+		if publicKeys[i], err = bls.PublicKeyFromBytes(pk[:]); err != nil {
+			return nil, err
+		}
+		rawPubKeys[i] = pk[:]
+	}
+	keystores, err := cfg.Keymanager.ExtractKeystores(ctx, publicKeys, cfg.Password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not extract keys from keymanager")
+	}
+	slashingProtection, err := slashingprotection.ExportStandardProtectionJSON(ctx, cfg.DB, rawPubKeys...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not export slashing protection history")
+	}
+
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+	write := func(name string, v interface{}) error {
+		encoded, err := json.MarshalIndent(v, "", "\t")
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal %s", name)
+		}
+		f, err := writer.Create(name)
+		if err != nil {
+			return errors.Wrapf(err, "could not add %s to archive", name)
+		}
+		_, err = f.Write(encoded)
+		return err
+	}
+	for i, k := range keystores {
+		if err := write(path.Join(backupKeystoresDir, fmt.Sprintf("keystore-%d.json", i)), k); err != nil {
+			return nil, err
+		}
+	}
+	if err := write(backupSlashingProtectionFileName, slashingProtection); err != nil {
+		return nil, err
+	}
+	settingsExist, err := cfg.DB.ProposerSettingsExists(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for proposer settings")
+	}
+	if settingsExist {
+		settings, err := cfg.DB.ProposerSettings(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get proposer settings")
+		}
+		if err := write(backupProposerSettingsFileName, settings.ToConsensus()); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not close archive")
+	}
+
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(buf.Bytes(), cfg.Password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt archive")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("accounts", len(keystores)).Info("Backed up wallet")
+	return &EncryptedWalletBackup{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	}, nil
+}
+
+// RestoreWallet decrypts a wallet backup and restores it: the slashing protection history and the proposer
+// settings into the validator database first, then the keystores into the keymanager. It returns the number
+// of accounts imported, accounts already in the keymanager being skipped.
+func RestoreWallet(ctx context.Context, cfg *WalletRestoreConfig, backup *EncryptedWalletBackup) (int, error) {
+	if cfg.Importer == nil || cfg.DB == nil {
+		return 0, errors.New("a keymanager and a validator database are required to restore a wallet")
+	}
+	archive, err := keystorev4.New().Decrypt(backup.Crypto, cfg.Password)
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return 0, errors.Wrap(err, "wrong password for wallet backup entered")
+	} else if err != nil {
+		return 0, errors.Wrap(err, "could not decrypt wallet backup")
+	}
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not open archive")
+	}
+
+	var (
+		keystores          []*keymanager.Keystore
+		slashingProtection []byte
+		proposerSettings   []byte
+	)
+	for _, f := range reader.File {
+		contents, err := readArchiveFile(f)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case f.Name == backupSlashingProtectionFileName:
+			slashingProtection = contents
+		case f.Name == backupProposerSettingsFileName:
+			proposerSettings = contents
+		case path.Dir(f.Name) == backupKeystoresDir:
+			k := &keymanager.Keystore{}
+			if err := json.Unmarshal(contents, k); err != nil {
+				return 0, errors.Wrapf(err, "could not decode keystore %s", f.Name)
+			}
+			keystores = append(keystores, k)
+		default:
+			log.WithField("file", f.Name).Warn("Ignoring unknown file of wallet backup")
+		}
+	}
+	// Keys must never be restored without their slashing protection history.
+	if slashingProtection == nil {
+		return 0, errors.New("wallet backup has no slashing protection history")
+	}
+	if len(keystores) == 0 {
+		return 0, errors.New("wallet backup has no keystores")
+	}
+
+	if err := cfg.DB.ImportStandardProtectionJSON(ctx, bytes.NewReader(slashingProtection)); err != nil {
+		return 0, errors.Wrap(err, "could not import slashing protection history")
+	}
+	if proposerSettings != nil {
+		payload := &validatorpb.ProposerSettingsPayload{}
+		if err := json.Unmarshal(proposerSettings, payload); err != nil {
+			return 0, errors.Wrap(err, "could not decode proposer settings")
+		}
+		settings, err := proposer.SettingFromConsensus(payload)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not decode proposer settings")
+		}
+		if err := cfg.DB.SaveProposerSettings(ctx, settings); err != nil {
+			return 0, errors.Wrap(err, "could not save proposer settings")
+		}
+	}
+
+	passwords := make([]string, len(keystores))
+	for i := range passwords {
+		passwords[i] = cfg.Password
+	}
+	statuses, err := cfg.Importer.ImportKeystores(ctx, keystores, passwords)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not import keystores")
+	}
+	imported := 0
+	for i, status := range statuses {
+		switch status.Status {
+		case keymanager.StatusImported:
+			imported++
+		case keymanager.StatusDuplicate:
+			log.WithField("pubkey", keystores[i].Pubkey).Info("Account already in wallet, skipping")
+		default:
+			return imported, fmt.Errorf("could not import keystore of public key %s: %s", keystores[i].Pubkey, status.Message)
+		}
+	}
+	log.WithField("accounts", imported).Info("Restored wallet")
+	return imported, nil
+}
+
+func readArchiveFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open %s", f.Name)
+	}
+	defer func() {
+		if err := rc.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close %s", f.Name)
+		}
+	}()
+	contents, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", f.Name)
+	}
+	return contents, nil
+}
@@ -0,0 +1,119 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+)
+
+func newBackupTestKeymanager(t *testing.T, numAccounts int) (*wallet.Wallet, *local.Keymanager) {
+	ctx := context.Background()
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	acm, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Local),
+		WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	w, err := acm.WalletCreate(ctx)
+	require.NoError(t, err)
+	km, err := local.NewKeymanager(ctx, &local.SetupConfig{Wallet: w})
+	require.NoError(t, err)
+	if numAccounts == 0 {
+		return w, km
+	}
+	keystores := make([]*keymanager.Keystore, numAccounts)
+	passwords := make([]string, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		keystores[i] = createRandomKeystore(t, password)
+		passwords[i] = password
+	}
+	_, err = km.ImportKeystores(ctx, keystores, passwords)
+	require.NoError(t, err)
+	return w, km
+}
+
+func TestBackupWallet_Restore(t *testing.T) {
+	ctx := context.Background()
+	_, km := newBackupTestKeymanager(t, 3)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	db := dbtest.SetupDB(t, pubKeys, false)
+	require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, []byte{'a', 31: 0}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKeys[0], 10, []byte{'b', 31: 0}))
+	feeRecipient := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	require.NoError(t, db.SaveProposerSettings(ctx, &proposer.Settings{
+		DefaultConfig: &proposer.Option{
+			FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: feeRecipient},
+		},
+	}))
+
+	backup, err := BackupWallet(ctx, &WalletBackupConfig{Keymanager: km, DB: db, Password: "backupPassword1$"})
+	require.NoError(t, err)
+
+	// Restore into a new wallet and database.
+	local.ResetCaches()
+	w := wallet.New(&wallet.Config{
+		WalletDir:      t.TempDir(),
+		KeymanagerKind: keymanager.Local,
+		WalletPassword: password,
+	})
+	require.NoError(t, w.SaveWallet())
+	restoredKM, err := local.NewKeymanager(ctx, &local.SetupConfig{Wallet: w})
+	require.NoError(t, err)
+	restoredDB := dbtest.SetupDB(t, nil, false)
+	restoreCfg := &WalletRestoreConfig{Importer: restoredKM, DB: restoredDB, Password: "wrong"}
+
+	_, err = RestoreWallet(ctx, restoreCfg, backup)
+	require.ErrorContains(t, "wrong password for wallet backup entered", err)
+
+	restoreCfg.Password = "backupPassword1$"
+	imported, err := RestoreWallet(ctx, restoreCfg, backup)
+	require.NoError(t, err)
+	assert.Equal(t, 3, imported)
+
+	restoredKeys, err := restoredKM.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(pubKeys), len(restoredKeys))
+	wantKeys := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, pk := range pubKeys {
+		wantKeys[pk] = true
+	}
+	for _, pk := range restoredKeys {
+		assert.Equal(t, true, wantKeys[pk], "unexpected key %#x", pk)
+	}
+	gvr, err := restoredDB.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'a', 31: 0}, gvr)
+	proposed, err := restoredDB.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKeys[0]}, proposed)
+	settings, err := restoredDB.ProposerSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, feeRecipient, settings.DefaultConfig.FeeRecipientConfig.FeeRecipient)
+
+	// Restoring again skips the accounts already in the wallet.
+	imported, err = RestoreWallet(ctx, restoreCfg, backup)
+	require.NoError(t, err)
+	assert.Equal(t, 0, imported)
+}
+
+func TestBackupWallet_NoAccounts(t *testing.T) {
+	_, km := newBackupTestKeymanager(t, 0)
+	_, err := BackupWallet(context.Background(), &WalletBackupConfig{
+		Keymanager: km,
+		DB:         dbtest.SetupDB(t, nil, false),
+		Password:   password,
+	})
+	require.ErrorContains(t, "no accounts to back up", err)
+}
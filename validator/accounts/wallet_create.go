@@ -24,7 +24,7 @@ func (acm *CLIManager) WalletCreate(ctx context.Context) (*wallet.Wallet, error)
 		if err := w.SaveWallet(); err != nil {
 			return nil, errors.Wrap(err, "could not initialize wallet: could not save wallet to disk")
 		}
-		accountsKeystore, err := local.CreateEmptyKeyStoreRepresentationForNewWallet(ctx, w.Password())
+		accountsKeystore, err := local.CreateEmptyKeyStoreRepresentationForNewWallet(ctx, w.Password(), acm.kdfParams)
 		if err != nil {
 			return nil, err
 		}
@@ -47,6 +47,7 @@ func (acm *CLIManager) WalletCreate(ctx context.Context) (*wallet.Wallet, error)
 			acm.mnemonicLanguage,
 			acm.skipMnemonicConfirm,
 			acm.numAccounts,
+			acm.kdfParams,
 		); err != nil {
 			return nil, errors.Wrap(err, "could not initialize wallet")
 		}
@@ -68,6 +69,7 @@ func createDerivedKeymanagerWallet(
 	mnemonicLanguage string,
 	skipMnemonicConfirm bool,
 	numAccounts int,
+	kdfParams *keymanager.KDFParams,
 ) error {
 	if wallet == nil {
 		return errors.New("nil wallet")
@@ -78,6 +80,7 @@ func createDerivedKeymanagerWallet(
 	km, err := derived.NewKeymanager(ctx, &derived.SetupConfig{
 		Wallet:           wallet,
 		ListenForChanges: true,
+		KDFParams:        kdfParams,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize HD keymanager")
@@ -41,6 +41,7 @@ func (acm *CLIManager) WalletRecover(ctx context.Context) (*wallet.Wallet, error
 	km, err := derived.NewKeymanager(ctx, &derived.SetupConfig{
 		Wallet:           w,
 		ListenForChanges: false,
+		KDFParams:        acm.kdfParams,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "could not make keymanager for given phrase")
@@ -0,0 +1,88 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+)
+
+// WalletReEncrypt encrypts the accounts keystore of a wallet again with the configured key derivation
+// function, so that existing wallets can be upgraded to stronger parameters.
+func (acm *CLIManager) WalletReEncrypt(ctx context.Context) error {
+	if acm.wallet == nil {
+		return errors.New("nil wallet")
+	}
+	if acm.kdfParams == nil {
+		return errors.New("no key derivation function parameters to re-encrypt the wallet with")
+	}
+	switch acm.wallet.KeymanagerKind() {
+	case keymanager.Local, keymanager.Derived:
+	default:
+		return errors.Errorf("wallets of keymanager kind %s have no keystore to re-encrypt", acm.wallet.KeymanagerKind())
+	}
+	encoded, err := acm.wallet.ReadFileAtPath(ctx, local.AccountsPath, local.AccountsKeystoreFileName)
+	if err != nil {
+		return errors.Wrap(err, "could not read accounts keystore")
+	}
+	keystoreFile := &local.AccountsKeystoreRepresentation{}
+	if err := json.Unmarshal(encoded, keystoreFile); err != nil {
+		return errors.Wrap(err, "could not decode accounts keystore")
+	}
+	reEncrypted, err := local.ReEncryptAccountsKeystore(ctx, keystoreFile, acm.wallet.Password(), acm.kdfParams)
+	if err != nil {
+		return errors.Wrap(err, "could not re-encrypt accounts keystore")
+	}
+	encoded, err = json.MarshalIndent(reEncrypted, "", "\t")
+	if err != nil {
+		return err
+	}
+	// The keystore is replaced atomically, as a partially written keystore would lose the accounts.
+	keystorePath := filepath.Join(acm.wallet.AccountsDir(), local.AccountsPath, local.AccountsKeystoreFileName)
+	if err := replaceFile(keystorePath, encoded); err != nil {
+		return errors.Wrap(err, "could not write accounts keystore")
+	}
+	log.WithField("walletDir", acm.wallet.AccountsDir()).Infof(
+		"Successfully re-encrypted wallet with key derivation function %s", acm.kdfParams,
+	)
+	return nil
+}
+
+// replaceFile atomically replaces the file at path with data: the data is written to a temporary file in
+// the same directory and synced to disk before the temporary file is renamed over the file, so that the
+// file is never left partially written.
+func replaceFile(path string, data []byte) (err error) {
+	expanded, err := file.ExpandPath(path)
+	if err != nil {
+		return err
+	}
+	// CreateTemp assigns mode 0600.
+	f, err := os.CreateTemp(filepath.Dir(expanded), "."+filepath.Base(expanded)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rmErr := os.Remove(f.Name()); rmErr != nil && !os.IsNotExist(rmErr) {
+				log.WithError(rmErr).Error("Could not remove temporary file")
+			}
+		}
+	}()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), expanded)
+}
@@ -0,0 +1,85 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+)
+
+func readKDFParams(t *testing.T, acm *CLIManager) *keymanager.KDFParams {
+	encoded, err := acm.wallet.ReadFileAtPath(context.Background(), local.AccountsPath, local.AccountsKeystoreFileName)
+	require.NoError(t, err)
+	keystoreFile := &local.AccountsKeystoreRepresentation{}
+	require.NoError(t, json.Unmarshal(encoded, keystoreFile))
+	params, err := keymanager.KDFParamsFromCrypto(keystoreFile.Crypto)
+	require.NoError(t, err)
+	return params
+}
+
+func TestWalletReEncrypt(t *testing.T) {
+	ctx := context.Background()
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	created := &keymanager.KDFParams{Function: keymanager.PBKDF2KDF, PBKDF2C: keymanager.MinPBKDF2C}
+	acm, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Local),
+		WithWalletPassword(password),
+		WithKDFParams(created),
+	)
+	require.NoError(t, err)
+	w, err := acm.WalletCreate(ctx)
+	require.NoError(t, err)
+	acm.wallet = w
+	assert.DeepEqual(t, created, readKDFParams(t, acm))
+
+	// Import keys, which must keep the parameters of the wallet.
+	km, err := local.NewKeymanager(ctx, &local.SetupConfig{Wallet: w})
+	require.NoError(t, err)
+	keystores := []*keymanager.Keystore{createRandomKeystore(t, password), createRandomKeystore(t, password)}
+	_, err = km.ImportKeystores(ctx, keystores, []string{password, password})
+	require.NoError(t, err)
+	assert.DeepEqual(t, created, readKDFParams(t, acm))
+	wantKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	upgraded := &keymanager.KDFParams{Function: keymanager.ScryptKDF, ScryptN: keymanager.MinScryptN << 1, ScryptR: 8, ScryptP: 1}
+	acm.kdfParams = upgraded
+	require.NoError(t, acm.WalletReEncrypt(ctx))
+	assert.DeepEqual(t, upgraded, readKDFParams(t, acm))
+	// No temporary file is left next to the keystore.
+	entries, err := os.ReadDir(filepath.Join(w.AccountsDir(), local.AccountsPath))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, local.AccountsKeystoreFileName, entries[0].Name())
+
+	km, err = local.NewKeymanager(ctx, &local.SetupConfig{Wallet: w})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantKeys, keys)
+}
+
+func TestWalletReEncrypt_NoParams(t *testing.T) {
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	acm, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Local),
+		WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	acm.wallet, err = acm.WalletCreate(context.Background())
+	require.NoError(t, err)
+	require.ErrorContains(t, "no key derivation function parameters", acm.WalletReEncrypt(context.Background()))
+}
+
+func TestWithKDFParams_Invalid(t *testing.T) {
+	_, err := NewCLIManager(WithKDFParams(&keymanager.KDFParams{Function: keymanager.ScryptKDF, ScryptN: 3}))
+	require.ErrorContains(t, "must be a power of two", err)
+}
@@ -26,6 +26,15 @@ type beaconApiValidatorClient struct {
 	beaconBlockConverter    BeaconBlockConverter
 	prysmChainClient        iface.PrysmChainClient
 	isEventStreamRunning    bool
+	offloadAggregation      bool
+}
+
+// WithAggregationOffload makes the client request aggregate and proofs fully constructed by a Prysm beacon node,
+// instead of fetching the attestation data and the aggregate attestation separately.
+func WithAggregationOffload() ValidatorClientOpt {
+	return func(c *beaconApiValidatorClient) {
+		c.offloadAggregation = true
+	}
 }
 
 func NewBeaconApiValidatorClient(jsonRestHandler JsonRestHandler, opts ...ValidatorClientOpt) iface.ValidatorClient {
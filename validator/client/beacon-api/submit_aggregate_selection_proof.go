@@ -1,6 +1,7 @@
 package beacon_api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
@@ -16,12 +17,32 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
+const aggregateAndProofEndpoint = "/prysm/v1/validator/aggregate_and_proof"
+
 func (c *beaconApiValidatorClient) submitAggregateSelectionProof(
 	ctx context.Context,
 	in *ethpb.AggregateSelectionRequest,
 	index primitives.ValidatorIndex,
 	committeeLength uint64,
 ) (*ethpb.AggregateSelectionResponse, error) {
+	if c.offloadAggregation {
+		data, ok, err := c.produceAggregateAndProof(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			var aggAndProof *structs.AggregateAttestationAndProof
+			if err := json.Unmarshal(data, &aggAndProof); err != nil {
+				return nil, errors.Wrap(err, "failed to unmarshal aggregate and proof")
+			}
+			msg, err := aggAndProof.ToConsensus()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to convert aggregate and proof json to proto")
+			}
+			return &ethpb.AggregateSelectionResponse{AggregateAndProof: msg}, nil
+		}
+	}
+
 	attestationDataRoot, err := c.getAttestationDataRootFromRequest(ctx, in, committeeLength)
 	if err != nil {
 		return nil, err
@@ -57,6 +78,24 @@ func (c *beaconApiValidatorClient) submitAggregateSelectionProofElectra(
 	index primitives.ValidatorIndex,
 	committeeLength uint64,
 ) (*ethpb.AggregateSelectionElectraResponse, error) {
+	if c.offloadAggregation {
+		data, ok, err := c.produceAggregateAndProof(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			var aggAndProof *structs.AggregateAttestationAndProofElectra
+			if err := json.Unmarshal(data, &aggAndProof); err != nil {
+				return nil, errors.Wrap(err, "failed to unmarshal aggregate and proof electra")
+			}
+			msg, err := aggAndProof.ToConsensus()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to convert aggregate and proof json to proto")
+			}
+			return &ethpb.AggregateSelectionElectraResponse{AggregateAndProof: msg}, nil
+		}
+	}
+
 	attestationDataRoot, err := c.getAttestationDataRootFromRequest(ctx, in, committeeLength)
 	if err != nil {
 		return nil, err
@@ -86,6 +125,32 @@ func (c *beaconApiValidatorClient) submitAggregateSelectionProofElectra(
 	}, nil
 }
 
+// produceAggregateAndProof requests the aggregate and proof fully constructed by the beacon node from the
+// selection proof, in a single round trip. It returns false if the beacon node does not support it, in which
+// case the aggregate is fetched through the standard beacon API.
+func (c *beaconApiValidatorClient) produceAggregateAndProof(ctx context.Context, in *ethpb.AggregateSelectionRequest) (json.RawMessage, bool, error) {
+	body, err := json.Marshal(&structs.AggregateSelectionRequest{
+		Slot:           strconv.FormatUint(uint64(in.Slot), 10),
+		CommitteeIndex: strconv.FormatUint(uint64(in.CommitteeIndex), 10),
+		Pubkey:         hexutil.Encode(in.PublicKey),
+		SelectionProof: hexutil.Encode(in.SlotSignature),
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to marshal aggregate selection request")
+	}
+	resp := &structs.AggregateAndProofResponse{}
+	err = c.jsonRestHandler.Post(ctx, aggregateAndProofEndpoint, nil, bytes.NewBuffer(body), resp)
+	errJson := &httputil.DefaultJsonError{}
+	if errors.As(err, &errJson) && errJson.Code == http.StatusNotFound {
+		log.Debugf("Endpoint %s is not supported, falling back to the standard endpoints to get the aggregate attestation.", aggregateAndProofEndpoint)
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.Data, true, nil
+}
+
 func (c *beaconApiValidatorClient) getAttestationDataRootFromRequest(ctx context.Context, in *ethpb.AggregateSelectionRequest, committeeLength uint64) ([]byte, error) {
 	isOptimistic, err := c.isOptimistic(ctx)
 	if err != nil {
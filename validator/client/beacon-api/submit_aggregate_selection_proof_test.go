@@ -1,6 +1,7 @@
 package beacon_api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -479,3 +480,107 @@ func TestSubmitAggregateSelectionProofElectra(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitAggregateSelectionProof_Offload(t *testing.T) {
+	const (
+		pubkeyStr     = "0x8000091c2ae64ee414a54c1cc1fc67dec663408bc636cb86756e0200e41a75c8f86603f104f02c856983d2783116be13"
+		slotSignature = "0x8776a37d6802c4797d113169c5fcfda50e68a32058eb6356a6f00d06d7da64c841a00c7c38b9b94a204751eca53707bd03523ce4797827d9bacff116a6e776a20bbccff4b683bf5201b610797ed0502557a58a65c8395f8a1649b976c3112d15"
+		slot          = primitives.Slot(123)
+		committeeIdx  = primitives.CommitteeIndex(1)
+	)
+	pubkey, err := hexutil.Decode(pubkeyStr)
+	require.NoError(t, err)
+	slotSignatureBytes, err := hexutil.Decode(slotSignature)
+	require.NoError(t, err)
+	request := &ethpb.AggregateSelectionRequest{
+		Slot:           slot,
+		CommitteeIndex: committeeIdx,
+		PublicKey:      pubkey,
+		SlotSignature:  slotSignatureBytes,
+	}
+	body, err := json.Marshal(&structs.AggregateSelectionRequest{
+		Slot:           "123",
+		CommitteeIndex: "1",
+		Pubkey:         pubkeyStr,
+		SelectionProof: slotSignature,
+	})
+	require.NoError(t, err)
+	attestationData, err := generateValidAttestation(uint64(slot), uint64(committeeIdx)).Data.ToConsensus()
+	require.NoError(t, err)
+	aggregateAndProof := &ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: 55293,
+		Aggregate: &ethpb.Attestation{
+			AggregationBits: testhelpers.FillByteSlice(4, 74),
+			Data:            attestationData,
+			Signature:       testhelpers.FillByteSlice(96, 82),
+		},
+		SelectionProof: slotSignatureBytes,
+	}
+
+	t.Run("constructed by the beacon node", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		data, err := json.Marshal(structs.AggregateAttAndProofFromConsensus(aggregateAndProof))
+		require.NoError(t, err)
+		// Neither the attestation data nor the aggregate attestation are requested.
+		jsonRestHandler.EXPECT().Post(
+			gomock.Any(),
+			aggregateAndProofEndpoint,
+			nil,
+			bytes.NewBuffer(body),
+			&structs.AggregateAndProofResponse{},
+		).SetArg(
+			4,
+			structs.AggregateAndProofResponse{Version: "phase0", Data: data},
+		).Return(
+			nil,
+		).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler, offloadAggregation: true}
+		resp, err := validatorClient.submitAggregateSelectionProof(context.Background(), request, 55293, 1)
+		require.NoError(t, err)
+		assert.DeepEqual(t, aggregateAndProof, resp.AggregateAndProof)
+	})
+	t.Run("beacon node error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().Post(
+			gomock.Any(),
+			aggregateAndProofEndpoint,
+			nil,
+			bytes.NewBuffer(body),
+			&structs.AggregateAndProofResponse{},
+		).Return(
+			&httputil.DefaultJsonError{Code: http.StatusBadRequest, Message: "Validator is not an aggregator"},
+		).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler, offloadAggregation: true}
+		_, err := validatorClient.submitAggregateSelectionProof(context.Background(), request, 55293, 1)
+		require.ErrorContains(t, "Validator is not an aggregator", err)
+	})
+	t.Run("falls back when not supported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().Post(
+			gomock.Any(),
+			aggregateAndProofEndpoint,
+			nil,
+			bytes.NewBuffer(body),
+			&structs.AggregateAndProofResponse{},
+		).Return(
+			&httputil.DefaultJsonError{Code: http.StatusNotFound, Message: "Not found"},
+		).Times(1)
+		// The standard endpoints are used instead, starting with the node syncing endpoint.
+		jsonRestHandler.EXPECT().Get(
+			gomock.Any(),
+			"/eth/v1/node/syncing",
+			&structs.SyncStatusResponse{},
+		).Return(
+			errors.New("bad request"),
+		).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler, offloadAggregation: true}
+		_, err := validatorClient.submitAggregateSelectionProof(context.Background(), request, 55293, 1)
+		require.ErrorContains(t, "failed to get syncing status", err)
+	})
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// ErrChainIdentityMismatch is returned when the beacon node is not following the chain the validator client expects,
+// in which case the validator client refuses to perform its duties.
+var ErrChainIdentityMismatch = errors.New("beacon node is not following the expected chain")
+
+// verifyGenesisValidatorsRoot checks the genesis validators root of the beacon node against the expected one, if any.
+func (v *validator) verifyGenesisValidatorsRoot(genesisValidatorsRoot []byte) error {
+	if len(v.expectedGenesisValidatorsRoot) == 0 || bytes.Equal(v.expectedGenesisValidatorsRoot, genesisValidatorsRoot) {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrChainIdentityMismatch,
+		"genesis validators root from beacon node (%#x) does not match the expected root (%#x)",
+		genesisValidatorsRoot,
+		v.expectedGenesisValidatorsRoot,
+	)
+}
+
+// verifyConfigName checks the config name of the beacon node against the expected one, defaulting to the config of the
+// validator client. The config name is only served by the beacon API, so it is not verified when the beacon API of
+// the beacon node is not reachable.
+func (v *validator) verifyConfigName(ctx context.Context) error {
+	localName := params.BeaconConfig().ConfigName
+	expectedName := localName
+	if v.expectedConfigName != "" {
+		expectedName = v.expectedConfigName
+		if localName != expectedName {
+			return errors.Wrapf(
+				ErrChainIdentityMismatch,
+				"config name of the validator client (%s) does not match the expected config name (%s)",
+				localName,
+				expectedName,
+			)
+		}
+	}
+	if v.restHandler == nil {
+		return nil
+	}
+	resp := &structs.GetSpecResponse{}
+	if err := v.restHandler.Get(ctx, "/eth/v1/config/spec", resp); err != nil {
+		log.WithError(err).Warn("Could not get the config of the beacon node, not verifying its config name")
+		return nil
+	}
+	data, ok := resp.Data.(map[string]interface{})
+	if !ok {
+		return errors.New("could not decode the config of the beacon node")
+	}
+	name, ok := data["CONFIG_NAME"].(string)
+	if !ok {
+		return errors.New("config of the beacon node has no config name")
+	}
+	if name != expectedName {
+		return errors.Wrapf(
+			ErrChainIdentityMismatch,
+			"config name of the beacon node (%s) does not match the expected config name (%s)",
+			name,
+			expectedName,
+		)
+	}
+	return nil
+}
+
+// verifyForkDigest checks the fork digest of the signing domains served by the beacon node at the epoch against the
+// one computed from the config of the validator client and the genesis validators root of the chain.
+func (v *validator) verifyForkDigest(ctx context.Context, epoch primitives.Epoch) error {
+	want, err := forks.ForkDigestFromEpoch(epoch, v.genesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not compute fork digest")
+	}
+	res, err := v.validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  epoch,
+		Domain: params.BeaconConfig().DomainBeaconAttester[:],
+	})
+	if err != nil {
+		return errors.Wrap(client.ErrConnectionIssue, errors.Wrap(err, "could not get domain data").Error())
+	}
+	// A signing domain is the domain type followed by the fork data root, starting with the fork digest.
+	if len(res.SignatureDomain) != 32 {
+		return fmt.Errorf("signing domain of length %d from beacon node", len(res.SignatureDomain))
+	}
+	if got := res.SignatureDomain[4:8]; !bytes.Equal(got, want[:]) {
+		return errors.Wrapf(
+			ErrChainIdentityMismatch,
+			"fork digest of beacon node (%#x) at epoch %d does not match the expected fork digest (%#x)",
+			got,
+			epoch,
+			want,
+		)
+	}
+	return nil
+}
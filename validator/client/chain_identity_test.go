@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
+	"go.uber.org/mock/gomock"
+)
+
+// testDomainData returns the attester domain of the current epoch of a chain.
+func testDomainData(t *testing.T, genesisTime uint64, genesisValidatorsRoot []byte) *ethpb.DomainResponse {
+	return testDomainDataAtEpoch(t, slots.ToEpoch(slots.CurrentSlot(genesisTime)), genesisValidatorsRoot)
+}
+
+func testDomainDataAtEpoch(t *testing.T, epoch primitives.Epoch, genesisValidatorsRoot []byte) *ethpb.DomainResponse {
+	digest, err := forks.ForkDigestFromEpoch(epoch, genesisValidatorsRoot)
+	require.NoError(t, err)
+	domain := make([]byte, 32)
+	copy(domain, params.BeaconConfig().DomainBeaconAttester[:])
+	copy(domain[4:], digest[:])
+	return &ethpb.DomainResponse{SignatureDomain: domain}
+}
+
+func TestVerifyGenesisValidatorsRoot(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.verifyGenesisValidatorsRoot([]byte{'a', 31: 0}))
+
+	v.expectedGenesisValidatorsRoot = []byte{'a', 31: 0}
+	require.NoError(t, v.verifyGenesisValidatorsRoot([]byte{'a', 31: 0}))
+	require.ErrorIs(t, v.verifyGenesisValidatorsRoot([]byte{'b', 31: 0}), ErrChainIdentityMismatch)
+}
+
+func TestVerifyForkDigest(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := validatormock.NewMockValidatorClient(ctrl)
+	root := []byte{'a', 31: 0}
+	v := &validator{validatorClient: client, genesisValidatorsRoot: root}
+
+	client.EXPECT().DomainData(gomock.Any(), &ethpb.DomainRequest{
+		Epoch:  10,
+		Domain: params.BeaconConfig().DomainBeaconAttester[:],
+	}).Return(testDomainDataAtEpoch(t, 10, root), nil)
+	require.NoError(t, v.verifyForkDigest(ctx, 10))
+
+	// A beacon node on another chain serves domains of another genesis validators root.
+	client.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(testDomainDataAtEpoch(t, 10, []byte{'b', 31: 0}), nil)
+	require.ErrorIs(t, v.verifyForkDigest(ctx, 10), ErrChainIdentityMismatch)
+}
+
+func TestVerifyConfigName(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	handler := mock.NewMockJsonRestHandler(ctrl)
+	v := &validator{restHandler: handler}
+	local := params.BeaconConfig().ConfigName
+
+	handler.EXPECT().Get(gomock.Any(), "/eth/v1/config/spec", gomock.Any()).Return(nil).SetArg(
+		2,
+		structs.GetSpecResponse{Data: map[string]interface{}{"CONFIG_NAME": local}},
+	)
+	require.NoError(t, v.verifyConfigName(ctx))
+
+	handler.EXPECT().Get(gomock.Any(), "/eth/v1/config/spec", gomock.Any()).Return(nil).SetArg(
+		2,
+		structs.GetSpecResponse{Data: map[string]interface{}{"CONFIG_NAME": "other"}},
+	)
+	require.ErrorIs(t, v.verifyConfigName(ctx), ErrChainIdentityMismatch)
+
+	// The config of the validator client itself must be the expected one.
+	v.expectedConfigName = "other"
+	require.ErrorIs(t, v.verifyConfigName(ctx), ErrChainIdentityMismatch)
+}
+
+func TestUpdateDuties_ChainIdentityMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := validatormock.NewMockValidatorClient(ctrl)
+	v := &validator{
+		validatorClient:       client,
+		genesisValidatorsRoot: []byte{'a', 31: 0},
+		duties:                &ethpb.DutiesResponse{},
+	}
+	slot := params.BeaconConfig().SlotsPerEpoch
+	client.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(testDomainDataAtEpoch(t, 1, []byte{'b', 31: 0}), nil)
+
+	require.ErrorIs(t, v.UpdateDuties(context.Background(), slot), ErrChainIdentityMismatch)
+	require.IsNil(t, v.duties)
+}
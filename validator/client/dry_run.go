@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/sirupsen/logrus"
+)
+
+var dryRunLog = log.WithField("dryRun", true)
+
+// dryRunKeymanager signs with an ephemeral key generated for each public key of the underlying keymanager, so that
+// the validator client rehearses its duties without ever using the validator keys.
+type dryRunKeymanager struct {
+	keymanager.IKeymanager
+	keys map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+	lock sync.Mutex
+}
+
+func newDryRunKeymanager(km keymanager.IKeymanager) *dryRunKeymanager {
+	return &dryRunKeymanager{
+		IKeymanager: km,
+		keys:        make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey),
+	}
+}
+
+// Sign signs the request with the ephemeral key of its public key.
+func (km *dryRunKeymanager) Sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	key, ok := km.keys[pubKey]
+	if !ok {
+		var err error
+		key, err = bls.RandKey()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate ephemeral key")
+		}
+		km.keys[pubKey] = key
+	}
+	return key.Sign(req.SigningRoot), nil
+}
+
+// dryRunValidatorClient fetches duties and builds blocks, attestations and aggregates through the beacon node, but
+// never submits them nor anything else to be broadcast.
+type dryRunValidatorClient struct {
+	iface.ValidatorClient
+}
+
+// ProposeBeaconBlock does not submit the block, returning its root.
+func (c *dryRunValidatorClient) ProposeBeaconBlock(_ context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	blk, err := blocks.NewSignedBeaconBlock(in.Block)
+	if err != nil {
+		return nil, err
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	dryRunLog.WithFields(logrus.Fields{
+		"slot":      blk.Block().Slot(),
+		"blockRoot": fmt.Sprintf("%#x", root),
+	}).Info("Not submitting block")
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// ProposeAttestation does not submit the attestation, returning the root of its data.
+func (c *dryRunValidatorClient) ProposeAttestation(_ context.Context, in *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	return dryRunAttestResponse(in.Data)
+}
+
+// ProposeAttestationElectra does not submit the attestation, returning the root of its data.
+func (c *dryRunValidatorClient) ProposeAttestationElectra(_ context.Context, in *ethpb.AttestationElectra) (*ethpb.AttestResponse, error) {
+	return dryRunAttestResponse(in.Data)
+}
+
+func dryRunAttestResponse(data *ethpb.AttestationData) (*ethpb.AttestResponse, error) {
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	dryRunLog.WithFields(logrus.Fields{
+		"slot":            data.Slot,
+		"attestationRoot": fmt.Sprintf("%#x", root),
+	}).Info("Not submitting attestation")
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitSignedAggregateSelectionProof does not submit the aggregate.
+func (c *dryRunValidatorClient) SubmitSignedAggregateSelectionProof(_ context.Context, in *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	dryRunLog.WithField("slot", in.SignedAggregateAndProof.Message.Aggregate.Data.Slot).Info("Not submitting aggregate")
+	return &ethpb.SignedAggregateSubmitResponse{}, nil
+}
+
+// SubmitSignedAggregateSelectionProofElectra does not submit the aggregate.
+func (c *dryRunValidatorClient) SubmitSignedAggregateSelectionProofElectra(_ context.Context, in *ethpb.SignedAggregateSubmitElectraRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	dryRunLog.WithField("slot", in.SignedAggregateAndProof.Message.Aggregate.Data.Slot).Info("Not submitting aggregate")
+	return &ethpb.SignedAggregateSubmitResponse{}, nil
+}
+
+// SubmitSyncMessage does not submit the sync committee message.
+func (c *dryRunValidatorClient) SubmitSyncMessage(_ context.Context, in *ethpb.SyncCommitteeMessage) (*empty.Empty, error) {
+	dryRunLog.WithField("slot", in.Slot).Info("Not submitting sync committee message")
+	return &empty.Empty{}, nil
+}
+
+// SubmitSignedContributionAndProof does not submit the sync committee contribution.
+func (c *dryRunValidatorClient) SubmitSignedContributionAndProof(_ context.Context, in *ethpb.SignedContributionAndProof) (*empty.Empty, error) {
+	dryRunLog.WithField("slot", in.Message.Contribution.Slot).Info("Not submitting sync committee contribution")
+	return &empty.Empty{}, nil
+}
+
+// SubmitValidatorRegistrations does not register the validators to the builder network.
+func (c *dryRunValidatorClient) SubmitValidatorRegistrations(_ context.Context, in *ethpb.SignedValidatorRegistrationsV1) (*empty.Empty, error) {
+	dryRunLog.WithField("count", len(in.Messages)).Info("Not submitting validator registrations")
+	return &empty.Empty{}, nil
+}
+
+// ProposeExit does not submit the voluntary exit.
+func (c *dryRunValidatorClient) ProposeExit(_ context.Context, _ *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+	return nil, errors.New("voluntary exits are not submitted in dry run mode")
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDryRunKeymanager_SignsWithEphemeralKeys(t *testing.T) {
+	ctx := context.Background()
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], key.PublicKey().Marshal())
+	km := newDryRunKeymanager(newMockKeymanager(t, keypair{pub: pubKey, pri: key}))
+
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, keys)
+
+	root := []byte{'r', 31: 0}
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKey[:], SigningRoot: root})
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(key.PublicKey(), root))
+	// The same ephemeral key is used for every signature of a validator.
+	assert.Equal(t, true, sig.Verify(km.keys[pubKey].PublicKey(), root))
+	again, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKey[:], SigningRoot: root})
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig.Marshal(), again.Marshal())
+}
+
+func TestDryRunValidatorClient_DoesNotSubmit(t *testing.T) {
+	ctx := context.Background()
+	// The mock fails the test on any call reaching the beacon node.
+	c := &dryRunValidatorClient{ValidatorClient: validatormock.NewMockValidatorClient(gomock.NewController(t))}
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 5
+	resp, err := c.ProposeBeaconBlock(ctx, &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Phase0{Phase0: blk}})
+	require.NoError(t, err)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.BlockRoot)
+
+	att := util.HydrateAttestation(&ethpb.Attestation{})
+	attResp, err := c.ProposeAttestation(ctx, att)
+	require.NoError(t, err)
+	dataRoot, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, dataRoot[:], attResp.AttestationDataRoot)
+
+	_, err = c.SubmitSyncMessage(ctx, &ethpb.SyncCommitteeMessage{Slot: 5})
+	require.NoError(t, err)
+	_, err = c.SubmitValidatorRegistrations(ctx, &ethpb.SignedValidatorRegistrationsV1{})
+	require.NoError(t, err)
+	_, err = c.ProposeExit(ctx, &ethpb.SignedVoluntaryExit{})
+	require.ErrorContains(t, "not submitted in dry run mode", err)
+}
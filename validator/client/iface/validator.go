@@ -47,6 +47,7 @@ type Validator interface {
 	RolesAt(ctx context.Context, slot primitives.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]ValidatorRole, error) // validator pubKey -> roles
 	SubmitAttestation(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
 	ProposeBlock(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
+	PrepareProposals(ctx context.Context, slot primitives.Slot)
 	SubmitAggregateAndProof(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
 	SubmitSyncCommitteeMessage(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
 	SubmitSignedContributionAndProof(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
@@ -70,6 +71,17 @@ type Validator interface {
 	HealthTracker() *beacon.NodeHealthTracker
 	Host() string
 	ChangeHost()
+	DutySchedule() *DutySchedule
+}
+
+// DutySchedule is the schedule of duties of the validating keys, as last fetched from a beacon node.
+type DutySchedule struct {
+	// Epoch of the current epoch duties, the next epoch duties being those of the following epoch.
+	Epoch primitives.Epoch
+	// Host is the beacon node the duties were fetched from, at FetchedAt.
+	Host      string
+	FetchedAt time.Time
+	Duties    *ethpb.DutiesResponse
 }
 
 // SigningFunc interface defines a type for the function that signs a message
@@ -203,6 +203,31 @@ var (
 			"pubkey",
 		},
 	)
+	signingLatencyHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "signing_latency_seconds",
+			Help:      "Time taken by the signer to return a signature, by kind of signature and signer backend.",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 4},
+		},
+		[]string{"duty", "backend"},
+	)
+	signingDeadlineExceededTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "signing_deadline_exceeded_total",
+			Help:      "Number of signing requests cancelled for exceeding the signing deadline of their kind.",
+		},
+		[]string{"duty", "backend"},
+	)
+	signingFailoversTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "signing_failovers_total",
+			Help:      "Number of signatures returned by the secondary signer after the primary one failed.",
+		},
+		[]string{"duty"},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -61,7 +62,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 
 	// Sign randao reveal, it's used to request block from beacon node
 	epoch := primitives.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
-	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch, slot)
+	randaoReveal, err := v.randaoReveal(ctx, pubKey, epoch, slot)
 	if err != nil {
 		log.WithError(err).Error("Failed to sign randao reveal")
 		if v.emitAccountMetrics {
@@ -331,6 +332,64 @@ func CreateSignedVoluntaryExit(
 	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}, nil
 }
 
+// PrepareProposals signs ahead of time the randao reveals of the validators proposing at the
+// given slot, so that the signer is not on the critical path of their block proposals.
+func (v *validator) PrepareProposals(ctx context.Context, slot primitives.Slot) {
+	if slot == 0 {
+		return
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.PrepareProposals")
+	defer span.End()
+
+	var proposers [][fieldparams.BLSPubkeyLength]byte
+	v.dutiesLock.RLock()
+	if v.duties != nil {
+		for _, duty := range v.duties.CurrentEpochDuties {
+			if duty != nil && slices.Contains(duty.ProposerSlots, slot) {
+				proposers = append(proposers, bytesutil.ToBytes48(duty.PublicKey))
+			}
+		}
+	}
+	v.dutiesLock.RUnlock()
+
+	epoch := slots.ToEpoch(slot)
+	for _, pubKey := range proposers {
+		reveal, err := v.signRandaoReveal(ctx, pubKey, epoch, slot)
+		if err != nil {
+			log.WithError(err).WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).
+				Warn("Could not sign randao reveal ahead of proposal")
+			continue
+		}
+		v.randaoRevealsLock.Lock()
+		if v.randaoReveals == nil {
+			v.randaoReveals = make(map[randaoRevealKey][]byte)
+		}
+		for k := range v.randaoReveals {
+			if k.epoch < epoch {
+				delete(v.randaoReveals, k)
+			}
+		}
+		v.randaoReveals[randaoRevealKey{pubKey: pubKey, epoch: epoch}] = reveal
+		v.randaoRevealsLock.Unlock()
+	}
+}
+
+type randaoRevealKey struct {
+	pubKey [fieldparams.BLSPubkeyLength]byte
+	epoch  primitives.Epoch
+}
+
+// randaoReveal returns the randao reveal signed by PrepareProposals, or signs it if there is none.
+func (v *validator) randaoReveal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch, slot primitives.Slot) ([]byte, error) {
+	v.randaoRevealsLock.Lock()
+	reveal, ok := v.randaoReveals[randaoRevealKey{pubKey: pubKey, epoch: epoch}]
+	v.randaoRevealsLock.Unlock()
+	if ok {
+		return reveal, nil
+	}
+	return v.signRandaoReveal(ctx, pubKey, epoch, slot)
+}
+
 // Sign randao reveal with randao domain and private key.
 func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch, slot primitives.Slot) ([]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "validator.signRandaoReveal")
@@ -350,7 +409,7 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BL
 	if err != nil {
 		return nil, err
 	}
-	randaoReveal, err = v.km.Sign(ctx, &validatorpb.SignRequest{
+	randaoReveal, err = v.signWithDeadline(ctx, signingDutyBlock, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -385,7 +444,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [fieldparams.BLSPubkey
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
-	sig, err := v.km.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.signWithDeadline(ctx, signingDutyBlock, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     blockRoot[:],
 		SignatureDomain: domain.SignatureDomain,
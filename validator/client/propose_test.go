@@ -1273,3 +1273,31 @@ func Test_validator_SetGraffiti(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareProposals_SignsRandaoRevealAhead(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t, false)
+	defer finish()
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.duties = &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey[:], ProposerSlots: []primitives.Slot{2}},
+		},
+	}
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).
+		AnyTimes()
+
+	ctx := context.Background()
+	validator.PrepareProposals(ctx, 1)
+	require.Equal(t, 0, len(validator.randaoReveals))
+
+	validator.PrepareProposals(ctx, 2)
+	require.Equal(t, 1, len(validator.randaoReveals))
+	want, err := validator.signRandaoReveal(ctx, pubKey, 0, 2)
+	require.NoError(t, err)
+	got, err := validator.randaoReveal(ctx, pubKey, 0, 2)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, got)
+}
@@ -2,20 +2,56 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 )
 
+// validateRegistrationPolicy checks that the random delay before sending validator registrations is shorter than
+// the interval between two rebroadcasts of all the registrations. A zero refresh interval means every epoch.
+func validateRegistrationPolicy(refreshEpochs uint64, jitter time.Duration) error {
+	refreshEpochs = max(refreshEpochs, 1)
+	interval := time.Duration(refreshEpochs) * time.Duration(params.BeaconConfig().SlotsPerEpoch) *
+		time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	if jitter < 0 || jitter >= interval {
+		return fmt.Errorf("validator registrations jitter %s must be between 0 and the refresh interval (%s)", jitter, interval)
+	}
+	return nil
+}
+
+// isRegistrationRefreshSlot returns true at the start of the epochs at which all the validator registrations are
+// sent again, once every validatorsRegRefreshEpochs epochs.
+func (v *validator) isRegistrationRefreshSlot(slot primitives.Slot) bool {
+	if !slots.IsEpochStart(slot) {
+		return false
+	}
+	refreshEpochs := max(v.validatorsRegRefreshEpochs, 1)
+	return uint64(slots.ToEpoch(slot))%refreshEpochs == 0
+}
+
+// registrationJitter returns a random delay, up to the configured jitter, to wait for before sending validator
+// registrations, so that the registrations of many validator clients do not all reach the relays at once.
+func (v *validator) registrationJitter() time.Duration {
+	if v.validatorsRegJitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.NewGenerator().Int63n(int64(v.validatorsRegJitter)))
+}
+
 // SubmitValidatorRegistrations signs validator registration objects and submits it to the beacon node by batch of validatorRegsBatchSize size maximum.
 // If at least one error occurs during a registration call to the beacon node, the last error is returned.
 func SubmitValidatorRegistrations(
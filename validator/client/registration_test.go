@@ -398,3 +398,36 @@ func TestChunkSignedValidatorRegistrationV1(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRegistrationPolicy(t *testing.T) {
+	epoch := time.Duration(params.BeaconConfig().SlotsPerEpoch) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	require.NoError(t, validateRegistrationPolicy(0, 0))
+	require.NoError(t, validateRegistrationPolicy(1, epoch/2))
+	require.NoError(t, validateRegistrationPolicy(4, 3*epoch))
+	require.ErrorContains(t, "must be between 0 and the refresh interval", validateRegistrationPolicy(0, epoch))
+	require.ErrorContains(t, "must be between 0 and the refresh interval", validateRegistrationPolicy(2, -time.Second))
+}
+
+func TestValidator_isRegistrationRefreshSlot(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	v := &validator{}
+	require.Equal(t, true, v.isRegistrationRefreshSlot(slotsPerEpoch))
+	require.Equal(t, false, v.isRegistrationRefreshSlot(slotsPerEpoch+1))
+
+	v.validatorsRegRefreshEpochs = 4
+	require.Equal(t, true, v.isRegistrationRefreshSlot(0))
+	require.Equal(t, false, v.isRegistrationRefreshSlot(slotsPerEpoch))
+	require.Equal(t, true, v.isRegistrationRefreshSlot(4*slotsPerEpoch))
+	require.Equal(t, false, v.isRegistrationRefreshSlot(4*slotsPerEpoch+1))
+}
+
+func TestValidator_registrationJitter(t *testing.T) {
+	v := &validator{}
+	require.Equal(t, time.Duration(0), v.registrationJitter())
+
+	v.validatorsRegJitter = time.Second
+	for i := 0; i < 100; i++ {
+		jitter := v.registrationJitter()
+		require.Equal(t, true, jitter >= 0 && jitter < time.Second)
+	}
+}
@@ -53,10 +53,19 @@ type ValidatorService struct {
 	web3SignerConfig        *remoteweb3signer.SetupConfig
 	proposerSettings        *proposer.Settings
 	validatorsRegBatchSize  int
+	validatorsRegRefresh    uint64
+	validatorsRegJitter     time.Duration
 	useWeb                  bool
 	emitAccountMetrics      bool
 	logValidatorPerformance bool
 	distributed             bool
+	dryRun                  bool
+	expectedGenValRoot      []byte
+	expectedConfigName      string
+	attestationDelay        time.Duration
+	aggregationDelay        time.Duration
+	offloadAggregation      bool
+	signingDeadlines        SigningDeadlines
 }
 
 // Config for the validator service.
@@ -79,15 +88,33 @@ type Config struct {
 	Web3SignerConfig        *remoteweb3signer.SetupConfig
 	ProposerSettings        *proposer.Settings
 	ValidatorsRegBatchSize  int
+	ValidatorsRegRefresh    uint64
+	ValidatorsRegJitter     time.Duration
 	UseWeb                  bool
 	LogValidatorPerformance bool
 	EmitAccountMetrics      bool
 	Distributed             bool
+	DryRun                  bool
+	ExpectedGenValRoot      []byte
+	ExpectedConfigName      string
+	AttestationDelay        time.Duration
+	AggregationDelay        time.Duration
+	OffloadAggregation      bool
+	SigningDeadlines        SigningDeadlines
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
+	if err := validateSubmissionTiming(cfg.AttestationDelay, cfg.AggregationDelay); err != nil {
+		return nil, errors.Wrap(err, "invalid submission timing")
+	}
+	if err := validateRegistrationPolicy(cfg.ValidatorsRegRefresh, cfg.ValidatorsRegJitter); err != nil {
+		return nil, errors.Wrap(err, "invalid validator registration policy")
+	}
+	if err := validateSigningDeadlines(cfg.SigningDeadlines); err != nil {
+		return nil, errors.Wrap(err, "invalid signing deadlines")
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	s := &ValidatorService{
 		ctx:                     ctx,
@@ -102,10 +129,19 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		web3SignerConfig:        cfg.Web3SignerConfig,
 		proposerSettings:        cfg.ProposerSettings,
 		validatorsRegBatchSize:  cfg.ValidatorsRegBatchSize,
+		validatorsRegRefresh:    cfg.ValidatorsRegRefresh,
+		validatorsRegJitter:     cfg.ValidatorsRegJitter,
 		useWeb:                  cfg.UseWeb,
 		emitAccountMetrics:      cfg.EmitAccountMetrics,
 		logValidatorPerformance: cfg.LogValidatorPerformance,
 		distributed:             cfg.Distributed,
+		dryRun:                  cfg.DryRun,
+		expectedGenValRoot:      cfg.ExpectedGenValRoot,
+		expectedConfigName:      cfg.ExpectedConfigName,
+		attestationDelay:        cfg.AttestationDelay,
+		aggregationDelay:        cfg.AggregationDelay,
+		offloadAggregation:      cfg.OffloadAggregation,
+		signingDeadlines:        cfg.SigningDeadlines,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -177,7 +213,14 @@ func (v *ValidatorService) Start() {
 		hosts[0],
 	)
 
-	validatorClient := validatorclientfactory.NewValidatorClient(v.conn, restHandler)
+	var validatorClientOpts []beaconApi.ValidatorClientOpt
+	if v.offloadAggregation {
+		validatorClientOpts = append(validatorClientOpts, beaconApi.WithAggregationOffload())
+	}
+	validatorClient := validatorclientfactory.NewValidatorClient(v.conn, restHandler, validatorClientOpts...)
+	if v.dryRun {
+		validatorClient = &dryRunValidatorClient{ValidatorClient: validatorClient}
+	}
 
 	valStruct := &validator{
 		slotFeed:                       new(event.Feed),
@@ -203,6 +246,8 @@ func (v *ValidatorService) Start() {
 		proposerSettings:               v.proposerSettings,
 		signedValidatorRegistrations:   make(map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1),
 		validatorsRegBatchSize:         v.validatorsRegBatchSize,
+		validatorsRegRefreshEpochs:     v.validatorsRegRefresh,
+		validatorsRegJitter:            v.validatorsRegJitter,
 		interopKeysConfig:              v.interopKeysConfig,
 		attSelections:                  make(map[attSelectionKey]iface.BeaconCommitteeSelection),
 		aggregatedSlotCommitteeIDCache: aggregatedSlotCommitteeIDCache,
@@ -215,6 +260,13 @@ func (v *ValidatorService) Start() {
 		emitAccountMetrics:             v.emitAccountMetrics,
 		useWeb:                         v.useWeb,
 		distributed:                    v.distributed,
+		dryRun:                         v.dryRun,
+		expectedGenesisValidatorsRoot:  v.expectedGenValRoot,
+		expectedConfigName:             v.expectedConfigName,
+		restHandler:                    restHandler,
+		attDelay:                       v.attestationDelay,
+		aggDelay:                       v.aggregationDelay,
+		signingDeadlines:               v.signingDeadlines,
 	}
 
 	v.validator = valStruct
@@ -348,3 +400,12 @@ func (v *ValidatorService) DeleteGraffiti(ctx context.Context, pubKey [fieldpara
 	}
 	return v.validator.DeleteGraffiti(ctx, pubKey)
 }
+
+// DutySchedule returns the duties of the validating keys as last fetched from the beacon node, or nil if they are
+// not fetched yet.
+func (v *ValidatorService) DutySchedule() (*iface.DutySchedule, error) {
+	if v.validator == nil {
+		return nil, errors.New("validator is unavailable")
+	}
+	return v.validator.DutySchedule(), nil
+}
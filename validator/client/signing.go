@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/sirupsen/logrus"
+)
+
+// signingDuty is the kind of signature a signing deadline applies to.
+type signingDuty string
+
+const (
+	signingDutyAttestation signingDuty = "attestation"
+	signingDutyBlock       signingDuty = "block"
+	signingDutyAggregate   signingDuty = "aggregate"
+)
+
+// SigningDeadlines are how long the validator waits for the signer to return a signature of each kind before
+// cancelling the request and failing over to the secondary signer. A zero deadline stands for no deadline.
+type SigningDeadlines struct {
+	Attestation time.Duration
+	Block       time.Duration
+	Aggregate   time.Duration
+}
+
+func (d SigningDeadlines) deadline(duty signingDuty) time.Duration {
+	switch duty {
+	case signingDutyAttestation:
+		return d.Attestation
+	case signingDutyBlock:
+		return d.Block
+	case signingDutyAggregate:
+		return d.Aggregate
+	default:
+		return 0
+	}
+}
+
+// validateSigningDeadlines checks no signing deadline is negative.
+func validateSigningDeadlines(d SigningDeadlines) error {
+	for _, duty := range []signingDuty{signingDutyAttestation, signingDutyBlock, signingDutyAggregate} {
+		if d.deadline(duty) < 0 {
+			return errors.Errorf("%s signing deadline %s must not be negative", duty, d.deadline(duty))
+		}
+	}
+	return nil
+}
+
+// signerBackend is the name of the primary signer in the signing metrics.
+func (v *validator) signerBackend() string {
+	if v.web3SignerConfig != nil {
+		return "web3signer"
+	}
+	return "local"
+}
+
+// signWithDeadline signs the request within the signing deadline of the duty. When the primary signer fails or
+// exceeds the deadline, the request is cancelled and sent right away to the secondary signer of the keymanager,
+// if any, which is only bounded by the context of the duty.
+func (v *validator) signWithDeadline(ctx context.Context, duty signingDuty, req *validatorpb.SignRequest) (bls.Signature, error) {
+	signCtx := ctx
+	if deadline := v.signingDeadlines.deadline(duty); deadline > 0 {
+		var cancel context.CancelFunc
+		signCtx, cancel = context.WithTimeout(ctx, deadline)
+		defer cancel()
+	}
+	backend := v.signerBackend()
+	start := time.Now()
+	sig, err := v.km.Sign(signCtx, req)
+	signingLatencyHistogram.WithLabelValues(string(duty), backend).Observe(time.Since(start).Seconds())
+	if err == nil {
+		return sig, nil
+	}
+	exceeded := errors.Is(signCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil
+	if exceeded {
+		signingDeadlineExceededTotal.WithLabelValues(string(duty), backend).Inc()
+	}
+	secondary, ok := v.km.(keymanager.SecondarySigner)
+	if !ok || !secondary.HasSecondarySigner() || ctx.Err() != nil {
+		if exceeded {
+			return nil, errors.Wrapf(err, "%s signing deadline exceeded", duty)
+		}
+		return nil, err
+	}
+
+	log.WithError(err).WithFields(logrus.Fields{
+		"duty":             duty,
+		"deadlineExceeded": exceeded,
+	}).Warn("Primary signer failed, failing over to the secondary signer")
+	backend += "-secondary"
+	start = time.Now()
+	sig, err = secondary.SignWithSecondary(ctx, req)
+	signingLatencyHistogram.WithLabelValues(string(duty), backend).Observe(time.Since(start).Seconds())
+	if err != nil {
+		return nil, errors.Wrap(err, "secondary signer failed")
+	}
+	signingFailoversTotal.WithLabelValues(string(duty)).Inc()
+	return sig, nil
+}
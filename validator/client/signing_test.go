@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// slowKeymanager signs after a delay, and signs right away with its secondary signer if it has one.
+type slowKeymanager struct {
+	*mockKeymanager
+	delay          time.Duration
+	secondary      bool
+	secondaryCalls int
+}
+
+func (m *slowKeymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	select {
+	case <-time.After(m.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return m.mockKeymanager.Sign(ctx, req)
+}
+
+func (m *slowKeymanager) HasSecondarySigner() bool {
+	return m.secondary
+}
+
+func (m *slowKeymanager) SignWithSecondary(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	m.secondaryCalls++
+	return m.mockKeymanager.Sign(ctx, req)
+}
+
+func TestSignWithDeadline(t *testing.T) {
+	kp := randKeypair(t)
+	req := &validatorpb.SignRequest{PublicKey: kp.pub[:], SigningRoot: make([]byte, 32)}
+	want := kp.pri.Sign(req.SigningRoot).Marshal()
+	ctx := context.Background()
+
+	t.Run("within deadline", func(t *testing.T) {
+		km := &slowKeymanager{mockKeymanager: newMockKeymanager(t, kp), secondary: true}
+		v := &validator{km: km, signingDeadlines: SigningDeadlines{Attestation: time.Second}}
+		sig, err := v.signWithDeadline(ctx, signingDutyAttestation, req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, sig.Marshal())
+		assert.Equal(t, 0, km.secondaryCalls)
+	})
+	t.Run("deadline exceeded fails over", func(t *testing.T) {
+		km := &slowKeymanager{mockKeymanager: newMockKeymanager(t, kp), delay: time.Minute, secondary: true}
+		v := &validator{km: km, signingDeadlines: SigningDeadlines{Block: 10 * time.Millisecond}}
+		sig, err := v.signWithDeadline(ctx, signingDutyBlock, req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, sig.Marshal())
+		assert.Equal(t, 1, km.secondaryCalls)
+	})
+	t.Run("deadline exceeded without secondary", func(t *testing.T) {
+		km := &slowKeymanager{mockKeymanager: newMockKeymanager(t, kp), delay: time.Minute}
+		v := &validator{km: km, signingDeadlines: SigningDeadlines{Aggregate: 10 * time.Millisecond}}
+		_, err := v.signWithDeadline(ctx, signingDutyAggregate, req)
+		assert.ErrorContains(t, "aggregate signing deadline exceeded", err)
+		assert.Equal(t, 0, km.secondaryCalls)
+	})
+	t.Run("primary error fails over", func(t *testing.T) {
+		km := &slowKeymanager{mockKeymanager: newMockKeymanager(t), secondary: true}
+		v := &validator{km: km}
+		_, err := v.signWithDeadline(ctx, signingDutyAttestation, req)
+		assert.ErrorContains(t, "secondary signer failed", err)
+		assert.Equal(t, 1, km.secondaryCalls)
+	})
+}
+
+func TestValidateSigningDeadlines(t *testing.T) {
+	require.NoError(t, validateSigningDeadlines(SigningDeadlines{}))
+	require.NoError(t, validateSigningDeadlines(SigningDeadlines{Attestation: time.Second, Block: 2 * time.Second}))
+	err := validateSigningDeadlines(SigningDeadlines{Aggregate: -time.Second})
+	assert.ErrorContains(t, "aggregate signing deadline -1s must not be negative", err)
+}
@@ -63,6 +63,7 @@ type FakeValidator struct {
 	Tracker                           *beacon.NodeHealthTracker
 	AttSubmitted                      chan interface{}
 	BlockProposed                     chan interface{}
+	Schedule                          *iface.DutySchedule
 }
 
 // Done for mocking.
@@ -200,6 +201,9 @@ func (*FakeValidator) SubmitSyncCommitteeMessage(_ context.Context, _ primitives
 // LogSubmittedAtts for mocking.
 func (*FakeValidator) LogSubmittedAtts(_ primitives.Slot) {}
 
+// PrepareProposals for mocking.
+func (*FakeValidator) PrepareProposals(context.Context, primitives.Slot) {}
+
 // UpdateDomainDataCaches for mocking.
 func (*FakeValidator) UpdateDomainDataCaches(context.Context, primitives.Slot) {}
 
@@ -329,3 +333,8 @@ func (*FakeValidator) Host() string {
 func (fv *FakeValidator) ChangeHost() {
 	fv.Host()
 }
+
+// DutySchedule for mocking.
+func (fv *FakeValidator) DutySchedule() *iface.DutySchedule {
+	return fv.Schedule
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// defaultAttestationDelay is how long after the start of a slot the validator waits for a block before attesting,
+// as per the specification: one third of the slot.
+func defaultAttestationDelay() time.Duration {
+	return slots.DivideSlotBy(3 /* one third of slot duration */)
+}
+
+// defaultAggregationDelay is how long after the start of a slot the validator waits for the attestations of the
+// slot before aggregating them, as per the specification: two thirds of the slot.
+func defaultAggregationDelay() time.Duration {
+	return 2 * slots.DivideSlotBy(3 /* one third of slot duration */)
+}
+
+// validateSubmissionTiming checks the attestation and aggregation delays are within bounds leaving the beacon node
+// enough time to propagate the attestations before they are aggregated, and the aggregates before the end of the
+// slot. A zero delay stands for the default one.
+func validateSubmissionTiming(attestationDelay, aggregationDelay time.Duration) error {
+	if attestationDelay == 0 {
+		attestationDelay = defaultAttestationDelay()
+	}
+	if aggregationDelay == 0 {
+		aggregationDelay = defaultAggregationDelay()
+	}
+	sixth := slots.DivideSlotBy(6)
+	if attestationDelay < 0 || attestationDelay > 3*sixth {
+		return fmt.Errorf("attestation delay %s must be between 0 and half a slot (%s)", attestationDelay, 3*sixth)
+	}
+	if aggregationDelay < attestationDelay+sixth || aggregationDelay > 5*sixth {
+		return fmt.Errorf(
+			"aggregation delay %s must be at least a sixth of a slot after the attestation delay (%s) and at most five sixths of a slot (%s)",
+			aggregationDelay, attestationDelay+sixth, 5*sixth,
+		)
+	}
+	return nil
+}
+
+// attestationDelay returns how long after the start of a slot the validator waits for a block before attesting.
+func (v *validator) attestationDelay() time.Duration {
+	if v.attDelay > 0 {
+		return v.attDelay
+	}
+	return defaultAttestationDelay()
+}
+
+// aggregationDelay returns how long after the start of a slot the validator waits before aggregating.
+func (v *validator) aggregationDelay() time.Duration {
+	if v.aggDelay > 0 {
+		return v.aggDelay
+	}
+	return defaultAggregationDelay()
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestValidateSubmissionTiming(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig().Copy()
+	cfg.SecondsPerSlot = 12
+	params.OverrideBeaconConfig(cfg)
+
+	tests := []struct {
+		name        string
+		attestation time.Duration
+		aggregation time.Duration
+		wantErr     string
+	}{
+		{name: "defaults"},
+		{name: "later attestation", attestation: 6 * time.Second},
+		{name: "later attestation and aggregation", attestation: 6 * time.Second, aggregation: 10 * time.Second},
+		{name: "attestation after half a slot", attestation: 7 * time.Second, wantErr: "attestation delay 7s must be between 0 and half a slot"},
+		{name: "negative attestation", attestation: -time.Second, wantErr: "attestation delay -1s must be between 0 and half a slot"},
+		{name: "aggregation too close to the attestation", attestation: 6 * time.Second, aggregation: 7 * time.Second, wantErr: "aggregation delay 7s must be at least a sixth of a slot after the attestation delay (8s)"},
+		{name: "aggregation at the end of the slot", aggregation: 11 * time.Second, wantErr: "at most five sixths of a slot (10s)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSubmissionTiming(tt.attestation, tt.aggregation)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidator_SubmissionDelays(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig().Copy()
+	cfg.SecondsPerSlot = 12
+	params.OverrideBeaconConfig(cfg)
+
+	v := &validator{}
+	assert.Equal(t, 4*time.Second, v.attestationDelay())
+	assert.Equal(t, 8*time.Second, v.aggregationDelay())
+
+	v = &validator{attDelay: 5 * time.Second, aggDelay: 9 * time.Second}
+	assert.Equal(t, 5*time.Second, v.attestationDelay())
+	assert.Equal(t, 9*time.Second, v.aggregationDelay())
+}
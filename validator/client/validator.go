@@ -39,6 +39,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	accountsiface "github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
 	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
@@ -66,6 +67,9 @@ var (
 
 type validator struct {
 	duties                             *ethpb.DutiesResponse
+	dutiesEpoch                        primitives.Epoch
+	dutiesHost                         string
+	dutiesFetchedAt                    time.Time
 	ticker                             slots.Ticker
 	genesisTime                        uint64
 	highestValidSlot                   primitives.Slot
@@ -92,6 +96,8 @@ type validator struct {
 	proposerSettings                   *proposer.Settings
 	signedValidatorRegistrations       map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1
 	validatorsRegBatchSize             int
+	validatorsRegRefreshEpochs         uint64
+	validatorsRegJitter                time.Duration
 	interopKeysConfig                  *local.InteropKeymanagerConfig
 	attSelections                      map[attSelectionKey]iface.BeaconCommitteeSelection
 	aggregatedSlotCommitteeIDCache     *lru.Cache
@@ -100,10 +106,19 @@ type validator struct {
 	syncCommitteeStats                 syncCommitteeStats
 	submittedAtts                      map[submittedAttKey]*submittedAtt
 	submittedAggregates                map[submittedAttKey]*submittedAtt
+	randaoReveals                      map[randaoRevealKey][]byte
 	logValidatorPerformance            bool
 	emitAccountMetrics                 bool
 	useWeb                             bool
 	distributed                        bool
+	dryRun                             bool
+	genesisValidatorsRoot              []byte
+	expectedGenesisValidatorsRoot      []byte
+	expectedConfigName                 string
+	restHandler                        beaconApi.JsonRestHandler
+	attDelay                           time.Duration
+	aggDelay                           time.Duration
+	signingDeadlines                   SigningDeadlines
 	domainDataLock                     sync.RWMutex
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
@@ -112,6 +127,7 @@ type validator struct {
 	blacklistedPubkeysLock             sync.RWMutex
 	attSelectionLock                   sync.Mutex
 	dutiesLock                         sync.RWMutex
+	randaoRevealsLock                  sync.Mutex
 }
 
 type validatorStatus struct {
@@ -169,6 +185,10 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 		}
 	}
 	recheckKeys(ctx, v.db, v.km)
+	if v.dryRun {
+		log.Warn("Running in dry run mode: duties are signed with ephemeral keys and never submitted")
+		v.km = newDryRunKeymanager(v.km)
+	}
 	return nil
 }
 
@@ -278,6 +298,10 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 
 	v.genesisTime = chainStartRes.GenesisTime
 
+	if err := v.verifyGenesisValidatorsRoot(chainStartRes.GenesisValidatorsRoot); err != nil {
+		return err
+	}
+
 	curGenValRoot, err := v.db.GenesisValidatorsRoot(ctx)
 	if err != nil {
 		return errors.Wrap(err, "could not get current genesis validators root")
@@ -287,12 +311,7 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 		if err := v.db.SaveGenesisValidatorsRoot(ctx, chainStartRes.GenesisValidatorsRoot); err != nil {
 			return errors.Wrap(err, "could not save genesis validators root")
 		}
-
-		v.setTicker()
-		return nil
-	}
-
-	if !bytes.Equal(curGenValRoot, chainStartRes.GenesisValidatorsRoot) {
+	} else if !bytes.Equal(curGenValRoot, chainStartRes.GenesisValidatorsRoot) {
 		log.Errorf(`The genesis validators root received from the beacon node does not match what is in
 			your validator database. This could indicate that this is a database meant for another network. If
 			you were previously running this validator database on another network, please run --%s to
@@ -305,6 +324,14 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 			curGenValRoot,
 		)
 	}
+	v.genesisValidatorsRoot = chainStartRes.GenesisValidatorsRoot
+
+	if err := v.verifyConfigName(ctx); err != nil {
+		return err
+	}
+	if err := v.verifyForkDigest(ctx, slots.ToEpoch(slots.CurrentSlot(v.genesisTime))); err != nil {
+		return err
+	}
 
 	v.setTicker()
 	return nil
@@ -527,6 +554,17 @@ func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) erro
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateDuties")
 	defer span.End()
 
+	// Verify the beacon node still follows the expected chain, which may have changed since the connection.
+	if len(v.genesisValidatorsRoot) != 0 {
+		if err := v.verifyForkDigest(ctx, slots.ToEpoch(slot)); err != nil {
+			v.dutiesLock.Lock()
+			v.duties = nil // Clear assignments so that no duty is performed until the chain is verified again.
+			v.dutiesLock.Unlock()
+			log.WithError(err).Error("Could not verify the chain of the beacon node")
+			return err
+		}
+	}
+
 	validatingKeys, err := v.km.FetchValidatingPublicKeys(ctx)
 	if err != nil {
 		return err
@@ -562,8 +600,16 @@ func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) erro
 		return err
 	}
 
+	// The duties were fetched from the current beacon node, which only changes on failover.
+	var host string
+	if v.currentHostIndex < uint64(len(v.beaconNodeHosts)) {
+		host = v.beaconNodeHosts[v.currentHostIndex]
+	}
 	v.dutiesLock.Lock()
 	v.duties = resp
+	v.dutiesEpoch = req.Epoch
+	v.dutiesHost = host
+	v.dutiesFetchedAt = time.Now()
 	v.logDuties(slot, v.duties.CurrentEpochDuties, v.duties.NextEpochDuties)
 	v.dutiesLock.Unlock()
 
@@ -1124,6 +1170,13 @@ func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKey
 	signedRegReqs := v.buildSignedRegReqs(ctx, filteredKeys, km.Sign, slot, forceFullPush)
 	if len(signedRegReqs) > 0 {
 		go func() {
+			if jitter := v.registrationJitter(); jitter > 0 {
+				select {
+				case <-time.After(jitter):
+				case <-ctx.Done():
+					return
+				}
+			}
 			if err := SubmitValidatorRegistrations(ctx, v.validatorClient, signedRegReqs, v.validatorsRegBatchSize); err != nil {
 				log.WithError(errors.Wrap(ErrBuilderValidatorRegistration, err.Error())).Warn("failed to register validator on builder")
 			}
@@ -1187,6 +1240,22 @@ func (v *validator) ChangeHost() {
 	v.currentHostIndex = next
 }
 
+// DutySchedule returns the duties of the validating keys as last fetched from the beacon node, or nil if they are
+// not fetched yet.
+func (v *validator) DutySchedule() *iface.DutySchedule {
+	v.dutiesLock.RLock()
+	defer v.dutiesLock.RUnlock()
+	if v.duties == nil {
+		return nil
+	}
+	return &iface.DutySchedule{
+		Epoch:     v.dutiesEpoch,
+		Host:      v.dutiesHost,
+		FetchedAt: v.dutiesFetchedAt,
+		Duties:    v.duties,
+	}
+}
+
 func (v *validator) filterAndCacheActiveKeys(ctx context.Context, pubkeys [][fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "validator.filterAndCacheActiveKeys")
 	defer span.End()
@@ -1382,9 +1451,9 @@ func (v *validator) buildSignedRegReqs(
 			}).Warn("Fee recipient is burn address")
 		}
 
-		if slots.IsEpochStart(slot) || forceFullPush || !isCached {
-			// if epoch start (or forced to) send all validator registrations
-			// otherwise if slot is not epoch start then only send new non cached values
+		if v.isRegistrationRefreshSlot(slot) || forceFullPush || !isCached {
+			// if refresh epoch start (or forced to) send all validator registrations
+			// otherwise only send new non cached values
 			signedValRegRequests = append(signedValRegRequests, signedRequest)
 		}
 	}
@@ -177,6 +177,10 @@ func TestWaitForChainStart_SetsGenesisInfo(t *testing.T) {
 
 			genesis := uint64(time.Unix(1, 0).Unix())
 			genesisValidatorsRoot := bytesutil.ToBytes32([]byte("validators"))
+			client.EXPECT().DomainData(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(testDomainData(t, genesis, genesisValidatorsRoot[:]), nil).Times(2)
 			client.EXPECT().WaitForChainStart(
 				gomock.Any(),
 				&emptypb.Empty{},
@@ -221,6 +225,10 @@ func TestWaitForChainStart_SetsGenesisInfo_IncorrectSecondTry(t *testing.T) {
 			}
 			genesis := uint64(time.Unix(1, 0).Unix())
 			genesisValidatorsRoot := bytesutil.ToBytes32([]byte("validators"))
+			client.EXPECT().DomainData(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(testDomainData(t, genesis, genesisValidatorsRoot[:]), nil)
 			client.EXPECT().WaitForChainStart(
 				gomock.Any(),
 				&emptypb.Empty{},
@@ -461,7 +469,9 @@ func TestUpdateDuties_OK(t *testing.T) {
 	v := validator{
 		km:              newMockKeymanager(t, randKeypair(t)),
 		validatorClient: client,
+		beaconNodeHosts: []string{"localhost:4000", "localhost:4001"},
 	}
+	assert.Equal(t, true, v.DutySchedule() == nil)
 	client.EXPECT().Duties(
 		gomock.Any(),
 		gomock.Any(),
@@ -487,6 +497,13 @@ func TestUpdateDuties_OK(t *testing.T) {
 	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, v.duties.CurrentEpochDuties[0].AttesterSlot, "Unexpected validator assignments")
 	assert.Equal(t, resp.CurrentEpochDuties[0].CommitteeIndex, v.duties.CurrentEpochDuties[0].CommitteeIndex, "Unexpected validator assignments")
 	assert.Equal(t, resp.CurrentEpochDuties[0].ValidatorIndex, v.duties.CurrentEpochDuties[0].ValidatorIndex, "Unexpected validator assignments")
+
+	schedule := v.DutySchedule()
+	require.NotNil(t, schedule)
+	assert.Equal(t, primitives.Epoch(1), schedule.Epoch)
+	assert.Equal(t, "localhost:4000", schedule.Host)
+	assert.Equal(t, false, schedule.FetchedAt.IsZero())
+	assert.Equal(t, resp, schedule.Duties)
 }
 
 func TestUpdateDuties_OK_FilterBlacklistedPublicKeys(t *testing.T) {
@@ -0,0 +1,335 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+var (
+	doubleVoteMessage           = "double vote found, existing attestation at target epoch %d with conflicting signing root %#x"
+	surroundingVoteMessage      = "attestation with (source %d, target %d) surrounds another with (source %d, target %d)"
+	surroundedVoteMessage       = "attestation with (source %d, target %d) is surrounded by another with (source %d, target %d)"
+	failedAttLocalProtectionErr = "attempted to make slashable attestation, rejected by local slashing protection"
+)
+
+// AttestationHistoryForPubKey retrieves a list of attestation records for data
+// we have stored in the database for the given validator public key.
+func (s *Store) AttestationHistoryForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*common.AttestationRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AttestationHistoryForPubKey")
+	defer span.End()
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT source_epoch, target_epoch, signing_root FROM signed_attestations
+		WHERE public_key = ? ORDER BY source_epoch, target_epoch`, pubKey[:],
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation history")
+	}
+	defer closeRows(rows)
+	records := make([]*common.AttestationRecord, 0)
+	for rows.Next() {
+		var source, target int64
+		var signingRoot []byte
+		if err := rows.Scan(&source, &target, &signingRoot); err != nil {
+			return nil, errors.Wrap(err, "could not read attestation history")
+		}
+		record := &common.AttestationRecord{
+			PubKey: pubKey,
+			Source: primitives.Epoch(source),
+			Target: primitives.Epoch(target),
+		}
+		if len(signingRoot) != 0 {
+			record.SigningRoot = make([]byte, fieldparams.RootLength)
+			copy(record.SigningRoot, signingRoot)
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// SlashableAttestationCheck checks if an attestation is slashable by comparing it with the attesting
+// history for the given public key in our complete slashing protection database defined by EIP-3076.
+// If it is not, it updates the database. The check and the update are a single transaction.
+func (s *Store) SlashableAttestationCheck(
+	ctx context.Context,
+	indexedAtt ethpb.IndexedAtt,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signingRoot32 [32]byte,
+	emitAccountMetrics bool,
+	validatorAttestFailVec *prometheus.CounterVec,
+) error {
+	ctx, span := trace.StartSpan(ctx, "validator.postAttSignUpdate")
+	defer span.End()
+
+	signingRoot := signingRoot32[:]
+	data := indexedAtt.GetData()
+	return s.update(ctx, func(tx *sql.Tx) error {
+		// Based on EIP-3076, validator should refuse to sign any attestation with source epoch less
+		// than the minimum source epoch present in that signer’s attestations.
+		lowestSourceEpoch, exists, err := watermark(ctx, tx, pubKey, "lowest_source_epoch")
+		if err != nil {
+			return err
+		}
+		if exists && uint64(data.Source.Epoch) < lowestSourceEpoch {
+			return fmt.Errorf(
+				"could not sign attestation lower than lowest source epoch in db, %d < %d",
+				data.Source.Epoch,
+				lowestSourceEpoch,
+			)
+		}
+		existingSigningRoot, _, err := signingRootAtTargetEpoch(ctx, tx, pubKey, data.Target.Epoch)
+		if err != nil {
+			return err
+		}
+
+		// Based on EIP-3076, validator should refuse to sign any attestation with target epoch less
+		// than or equal to the minimum target epoch present in that signer’s attestations, except
+		// if it is a repeat signing as determined by the signingRoot.
+		lowestTargetEpoch, exists, err := watermark(ctx, tx, pubKey, "lowest_target_epoch")
+		if err != nil {
+			return err
+		}
+		if slashings.SigningRootsDiffer(existingSigningRoot, signingRoot) && exists && uint64(data.Target.Epoch) <= lowestTargetEpoch {
+			return fmt.Errorf(
+				"could not sign attestation lower than or equal to lowest target epoch in db if signing roots differ, %d <= %d",
+				data.Target.Epoch,
+				lowestTargetEpoch,
+			)
+		}
+
+		slashing, err := checkSlashableAttestation(ctx, tx, pubKey, signingRoot, indexedAtt)
+		if err != nil {
+			return err
+		}
+		if slashing != nil {
+			if emitAccountMetrics {
+				validatorAttestFailVec.WithLabelValues(fmt.Sprintf("%#x", pubKey[:])).Inc()
+			}
+			log.WithError(slashing).Warn("Attestation is slashable")
+			return errors.Wrap(slashing, failedAttLocalProtectionErr)
+		}
+
+		record := &common.AttestationRecord{PubKey: pubKey, Source: data.Source.Epoch, Target: data.Target.Epoch, SigningRoot: signingRoot}
+		if err := saveAttestationRecord(ctx, tx, record); err != nil {
+			return errors.Wrap(err, "could not save attestation history for validator public key")
+		}
+		return nil
+	})
+}
+
+// checkSlashableAttestation verifies an incoming attestation is
+// not a double vote for a validator public key nor a surround vote. The first error describes why the attestation is
+// slashable, the second one is a failure of the check.
+func checkSlashableAttestation(
+	ctx context.Context, q querier, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot []byte, att ethpb.IndexedAtt,
+) (error, error) {
+	data := att.GetData()
+
+	// First we check for double votes. An existing attestation without signing root is a double vote.
+	existingSigningRoot, exists, err := signingRootAtTargetEpoch(ctx, q, pubKey, data.Target.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if exists && (len(existingSigningRoot) == 0 || slashings.SigningRootsDiffer(existingSigningRoot, signingRoot)) {
+		return fmt.Errorf(doubleVoteMessage, data.Target.Epoch, existingSigningRoot), nil
+	}
+
+	// Is this attestation surrounding any other?
+	source, err := sqlInteger(data.Source.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	target, err := sqlInteger(data.Target.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	var existingSource, existingTarget int64
+	err = q.QueryRowContext(ctx, `
+		SELECT source_epoch, target_epoch FROM signed_attestations
+		WHERE public_key = ? AND source_epoch > ? AND target_epoch < ? LIMIT 1`,
+		pubKey[:], source, target,
+	).Scan(&existingSource, &existingTarget)
+	if err == nil {
+		return fmt.Errorf(surroundingVoteMessage, source, target, existingSource, existingTarget), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "could not check surrounding votes")
+	}
+
+	// Is this attestation surrounded by any other?
+	err = q.QueryRowContext(ctx, `
+		SELECT source_epoch, target_epoch FROM signed_attestations
+		WHERE public_key = ? AND source_epoch < ? AND target_epoch > ? LIMIT 1`,
+		pubKey[:], source, target,
+	).Scan(&existingSource, &existingTarget)
+	if err == nil {
+		return fmt.Errorf(surroundedVoteMessage, source, target, existingSource, existingTarget), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "could not check surrounded votes")
+	}
+	return nil, nil
+}
+
+// SaveAttestationsForPubKey stores a batch of attestations all at once.
+func (s *Store) SaveAttestationsForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoots [][]byte, atts []*ethpb.IndexedAttestation,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAttestationsForPubKey")
+	defer span.End()
+	if len(signingRoots) != len(atts) {
+		return fmt.Errorf(
+			"number of signing roots %d does not match number of attestations %d",
+			len(signingRoots),
+			len(atts),
+		)
+	}
+	return s.update(ctx, func(tx *sql.Tx) error {
+		for i, a := range atts {
+			record := &common.AttestationRecord{
+				PubKey:      pubKey,
+				Source:      a.Data.Source.Epoch,
+				Target:      a.Data.Target.Epoch,
+				SigningRoot: signingRoots[i],
+			}
+			if err := saveAttestationRecord(ctx, tx, record); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SaveAttestationForPubKey saves an attestation for a validator public
+// key for local validator slashing protection.
+func (s *Store) SaveAttestationForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [fieldparams.RootLength]byte, att ethpb.IndexedAtt,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAttestationForPubKey")
+	defer span.End()
+	return s.update(ctx, func(tx *sql.Tx) error {
+		return saveAttestationRecord(ctx, tx, &common.AttestationRecord{
+			PubKey:      pubKey,
+			Source:      att.GetData().Source.Epoch,
+			Target:      att.GetData().Target.Epoch,
+			SigningRoot: signingRoot[:],
+		})
+	})
+}
+
+func saveAttestationRecord(ctx context.Context, tx *sql.Tx, att *common.AttestationRecord) error {
+	source, err := sqlInteger(att.Source)
+	if err != nil {
+		return errors.Wrap(err, "could not save attestation source")
+	}
+	target, err := sqlInteger(att.Target)
+	if err != nil {
+		return errors.Wrap(err, "could not save attestation target")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO signed_attestations (public_key, source_epoch, target_epoch, signing_root) VALUES (?, ?, ?, ?)
+		ON CONFLICT (public_key, source_epoch, target_epoch) DO UPDATE SET signing_root = excluded.signing_root`,
+		att.PubKey[:], source, target, nonNil(att.SigningRoot),
+	); err != nil {
+		return errors.Wrapf(err, "could not save attestation with source %d and target %d", att.Source, att.Target)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO watermarks (public_key, lowest_source_epoch, lowest_target_epoch) VALUES (?, ?, ?)
+		ON CONFLICT (public_key) DO UPDATE SET
+			lowest_source_epoch = MIN(COALESCE(lowest_source_epoch, excluded.lowest_source_epoch), excluded.lowest_source_epoch),
+			lowest_target_epoch = MIN(COALESCE(lowest_target_epoch, excluded.lowest_target_epoch), excluded.lowest_target_epoch)`,
+		att.PubKey[:], source, target,
+	); err != nil {
+		return errors.Wrap(err, "could not update signed attestation epochs")
+	}
+	return nil
+}
+
+// AttestedPublicKeys retrieves all public keys that have attested.
+func (s *Store) AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AttestedPublicKeys")
+	defer span.End()
+	return publicKeys(ctx, s.db, `SELECT public_key FROM watermarks WHERE lowest_target_epoch IS NOT NULL ORDER BY public_key`)
+}
+
+// SigningRootAtTargetEpoch checks for an existing signing root at a specified
+// target epoch for a given validator public key.
+func (s *Store) SigningRootAtTargetEpoch(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, target primitives.Epoch) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.SigningRootAtTargetEpoch")
+	defer span.End()
+	signingRoot, _, err := signingRootAtTargetEpoch(ctx, s.db, pubKey, target)
+	return signingRoot, err
+}
+
+// signingRootAtTargetEpoch returns the signing root of the latest attestation saved at the target epoch, empty if it
+// has none, and whether there is an attestation at the target epoch.
+func signingRootAtTargetEpoch(ctx context.Context, q querier, pubKey [fieldparams.BLSPubkeyLength]byte, target primitives.Epoch) ([]byte, bool, error) {
+	targetEpoch, err := sqlInteger(target)
+	if err != nil {
+		return nil, false, err
+	}
+	var sr []byte
+	err = q.QueryRowContext(ctx, `
+		SELECT signing_root FROM signed_attestations WHERE public_key = ? AND target_epoch = ?
+		ORDER BY rowid DESC LIMIT 1`, pubKey[:], targetEpoch,
+	).Scan(&sr)
+	if errors.Is(err, sql.ErrNoRows) {
+		return make([]byte, 0, fieldparams.RootLength), false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, "could not get signing root")
+	}
+	signingRoot := make([]byte, 0, fieldparams.RootLength)
+	return append(signingRoot, sr...), true, nil
+}
+
+// LowestSignedSourceEpoch returns the lowest signed source epoch for a validator public key.
+// If no data exists, returning 0 is a sensible default.
+func (s *Store) LowestSignedSourceEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedSourceEpoch")
+	defer span.End()
+	epoch, exists, err := watermark(ctx, s.db, publicKey, "lowest_source_epoch")
+	return primitives.Epoch(epoch), exists, err
+}
+
+// LowestSignedTargetEpoch returns the lowest signed target epoch for a validator public key.
+// If no data exists, returning 0 is a sensible default.
+func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedTargetEpoch")
+	defer span.End()
+	epoch, exists, err := watermark(ctx, s.db, publicKey, "lowest_target_epoch")
+	return primitives.Epoch(epoch), exists, err
+}
+
+// EIPImportBlacklistedPublicKeys returns keys that were marked as blacklisted during EIP-3076 slashing
+// protection imports, ensuring that we can prevent these keys from having duties at runtime.
+func (s *Store) EIPImportBlacklistedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.EIPImportBlacklistedPublicKeys")
+	defer span.End()
+	return publicKeys(ctx, s.db, `SELECT public_key FROM blacklisted_public_keys ORDER BY public_key`)
+}
+
+// SaveEIPImportBlacklistedPublicKeys stores a list of blacklisted public keys that
+// were determined during EIP-3076 slashing protection imports.
+func (s *Store) SaveEIPImportBlacklistedPublicKeys(ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveEIPImportBlacklistedPublicKeys")
+	defer span.End()
+	return s.update(ctx, func(tx *sql.Tx) error {
+		for _, pubKey := range publicKeys {
+			if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO blacklisted_public_keys (public_key) VALUES (?)`, pubKey[:]); err != nil {
+				return errors.Wrap(err, "could not save blacklisted public key")
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,135 @@
+package sqlite
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	valtest "github.com/prysmaticlabs/prysm/v5/validator/testing"
+)
+
+func TestStore_SlashableAttestationCheck(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	pubKey := pubKeys[0]
+	s := setupDB(t, pubKeys)
+
+	att := createAttestation(2, 4)
+	require.NoError(t, s.SlashableAttestationCheck(ctx, att, pubKey, [32]byte{1}, false, nil))
+
+	// Signing the same attestation again is allowed.
+	require.NoError(t, s.SlashableAttestationCheck(ctx, att, pubKey, [32]byte{1}, false, nil))
+
+	tests := []struct {
+		name        string
+		source      primitives.Epoch
+		target      primitives.Epoch
+		signingRoot [32]byte
+		wantErr     string
+	}{
+		{
+			name:        "double vote",
+			source:      3,
+			target:      4,
+			signingRoot: [32]byte{2},
+			wantErr:     "could not sign attestation lower than or equal to lowest target epoch",
+		},
+		{
+			name:        "lower source epoch",
+			source:      1,
+			target:      6,
+			signingRoot: [32]byte{3},
+			wantErr:     "could not sign attestation lower than lowest source epoch",
+		},
+		{
+			name:        "surrounded vote",
+			source:      2,
+			target:      5,
+			signingRoot: [32]byte{4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.SlashableAttestationCheck(ctx, createAttestation(tt.source, tt.target), pubKey, tt.signingRoot, false, nil)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// (2, 5) is saved, (3, 8) and (4, 7) are then signed: (4, 7) is surrounded by (3, 8)...
+	require.NoError(t, s.SlashableAttestationCheck(ctx, createAttestation(3, 8), pubKey, [32]byte{5}, false, nil))
+	err = s.SlashableAttestationCheck(ctx, createAttestation(4, 7), pubKey, [32]byte{6}, false, nil)
+	require.ErrorContains(t, "is surrounded by another", err)
+	// ...and (2, 9) surrounds (3, 8).
+	err = s.SlashableAttestationCheck(ctx, createAttestation(2, 9), pubKey, [32]byte{7}, false, nil)
+	require.ErrorContains(t, "surrounds another", err)
+
+	history, err := s.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(history))
+}
+
+func TestStore_SlashableAttestationCheck_OutOfRange(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	s := setupDB(t, pubKeys)
+
+	// Epochs which would wrap to negative SQLite integers are rejected instead of being compared wrongly.
+	att := createAttestation(2, math.MaxUint64)
+	require.ErrorIs(t, s.SlashableAttestationCheck(ctx, att, pubKeys[0], [32]byte{1}, false, nil), errOutOfRange)
+	require.ErrorIs(t, s.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{1}, att), errOutOfRange)
+	_, exists, err := s.LowestSignedTargetEpoch(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
+
+func TestStore_LowestSignedEpochs(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	s := setupDB(t, pubKeys)
+
+	_, exists, err := s.LowestSignedSourceEpoch(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	atts := []*ethpb.IndexedAttestation{createAttestation(5, 6), createAttestation(3, 7), createAttestation(6, 8)}
+	require.NoError(t, s.SaveAttestationsForPubKey(ctx, pubKeys[0], [][]byte{{1}, {2}, {3}}, atts))
+
+	source, exists, err := s.LowestSignedSourceEpoch(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, primitives.Epoch(3), source)
+	target, exists, err := s.LowestSignedTargetEpoch(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, primitives.Epoch(6), target)
+
+	attested, err := s.AttestedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, pubKeys[:1], attested)
+}
+
+func TestStore_EIPImportBlacklistedPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	s := setupDB(t, nil)
+
+	blacklisted, err := s.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(blacklisted))
+
+	require.NoError(t, s.SaveEIPImportBlacklistedPublicKeys(ctx, pubKeys))
+	blacklisted, err = s.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(blacklisted))
+}
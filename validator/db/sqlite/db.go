@@ -0,0 +1,164 @@
+// Package sqlite defines a SQLite backend for the validator client database, storing the complete slashing
+// protection history of EIP-3076 in tables which can be inspected and backed up with the standard SQLite tools.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	_ "modernc.org/sqlite" // Registers the sqlite driver.
+)
+
+const (
+	backupsDirectoryName = "backups"
+	// busyTimeout is how long a write waits for the write lock of another connection or process.
+	busyTimeout = 5 * time.Second
+)
+
+// DatabaseFileName is the name of the SQLite validator database file.
+var DatabaseFileName = "validator.sqlite"
+
+// Config represents store's config object.
+type Config struct {
+	PubKeys [][fieldparams.BLSPubkeyLength]byte
+}
+
+// Store is a SQLite implementation of the validator client database. The database is opened in WAL mode, so that
+// readers such as the slashing protection exports or external tools never block the signing of the validators.
+type Store struct {
+	db           *sql.DB
+	databasePath string
+}
+
+// Ensure the SQLite store implements the interface.
+var _ = iface.ValidatorDB(&Store{})
+
+// NewStore opens the SQLite database in the directory path specified, creating the directory and the tables if
+// needed.
+func NewStore(ctx context.Context, dirPath string, config *Config) (*Store, error) {
+	hasDir, err := file.HasDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		if err := file.MkdirAll(dirPath); err != nil {
+			return nil, err
+		}
+	}
+	// Writes are synchronous, as a signature must never be returned before its slashing protection record is
+	// durable. Transactions take the write lock right away, so that the checks and the writes of concurrent
+	// slashing protection transactions are serialized.
+	dsn := fmt.Sprintf(
+		"file:%s?_pragma=busy_timeout(%d)&_pragma=journal_mode(WAL)&_pragma=synchronous(FULL)&_txlock=immediate",
+		filepath.Join(dirPath, DatabaseFileName), busyTimeout.Milliseconds(),
+	)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open SQLite database")
+	}
+	s := &Store{db: db, databasePath: dirPath}
+	if err := s.createTables(ctx); err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close SQLite database")
+		}
+		return nil, errors.Wrap(err, "could not create SQLite tables")
+	}
+
+	// Initialize the required public keys into the DB to ensure they're not empty.
+	if config != nil {
+		if err := s.UpdatePublicKeysBuckets(config.PubKeys); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Close closes the underlying SQLite database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// ClearDB removes any previously stored data at the configured data directory.
+func (s *Store) ClearDB() error {
+	if err := s.Close(); err != nil {
+		return fmt.Errorf("failed to close db: %w", err)
+	}
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		if err := os.Remove(filepath.Join(s.databasePath, DatabaseFileName+suffix)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "could not remove %s", DatabaseFileName+suffix)
+		}
+	}
+	return nil
+}
+
+// DatabasePath at which this database writes files.
+func (s *Store) DatabasePath() string {
+	return s.databasePath
+}
+
+// UpdatePublicKeysBuckets saves the public keys in the proposal history, as the BoltDB implementation does.
+func (s *Store) UpdatePublicKeysBuckets(pubKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	return s.update(context.Background(), func(tx *sql.Tx) error {
+		for _, pubKey := range pubKeys {
+			if _, err := tx.Exec(`INSERT OR IGNORE INTO public_keys (public_key) VALUES (?)`, pubKey[:]); err != nil {
+				return errors.Wrap(err, "could not save public key")
+			}
+		}
+		return nil
+	})
+}
+
+// Backup writes a consistent copy of the database to the backup directory, without blocking the writers.
+// Example for backup: $DATADIR/backups/prysm_validatordb_1029019.sqlite
+func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride bool) error {
+	ctx, span := trace.StartSpan(ctx, "ValidatorDB.Backup")
+	defer span.End()
+
+	backupsDir := filepath.Join(s.databasePath, backupsDirectoryName)
+	if outputDir != "" {
+		var err error
+		backupsDir, err = file.ExpandPath(outputDir)
+		if err != nil {
+			return err
+		}
+	}
+	if err := file.HandleBackupDir(backupsDir, permissionOverride); err != nil {
+		return err
+	}
+	backupPath := filepath.Join(backupsDir, fmt.Sprintf("prysm_validatordb_%d.sqlite", time.Now().Unix()))
+	log.WithField("backup", backupPath).Info("Writing backup database")
+	if _, err := s.db.ExecContext(ctx, `VACUUM INTO ?`, backupPath); err != nil {
+		return errors.Wrap(err, "could not back up SQLite database")
+	}
+	return nil
+}
+
+// querier is implemented by the database and its transactions.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// update runs the function in a write transaction, committed if the function returns no error.
+func (s *Store) update(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not begin transaction")
+	}
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.WithError(rollbackErr).Error("Could not roll back transaction")
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,99 @@
+package sqlite
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	valtest "github.com/prysmaticlabs/prysm/v5/validator/testing"
+)
+
+func setupDB(t *testing.T, pubKeys [][fieldparams.BLSPubkeyLength]byte) *Store {
+	s, err := NewStore(context.Background(), t.TempDir(), &Config{PubKeys: pubKeys})
+	require.NoError(t, err, "NewStore should not return an error")
+	t.Cleanup(func() {
+		require.NoError(t, s.Close(), "Could not close the database")
+	})
+	return s
+}
+
+func TestStore_NewStore_Reopen(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	databasePath := t.TempDir()
+
+	s, err := NewStore(ctx, databasePath, &Config{PubKeys: pubKeys})
+	require.NoError(t, err)
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKeys[0], 5, []byte{1}))
+	require.NoError(t, s.Close())
+
+	// The tables already exist, the history is kept.
+	s, err = NewStore(ctx, databasePath, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+	keys, err := s.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(pubKeys), len(keys))
+	slot, exists, err := s.HighestSignedProposal(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, uint64(5), uint64(slot))
+}
+
+func TestStore_DatabasePath(t *testing.T) {
+	databasePath := t.TempDir()
+	s, err := NewStore(context.Background(), databasePath, nil)
+	require.NoError(t, err)
+	require.Equal(t, databasePath, s.DatabasePath())
+	require.NoError(t, s.Close())
+}
+
+func TestStore_ClearDB(t *testing.T) {
+	databasePath := t.TempDir()
+	s, err := NewStore(context.Background(), databasePath, nil)
+	require.NoError(t, err)
+
+	exists, err := file.Exists(filepath.Join(databasePath, DatabaseFileName), file.Regular)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+
+	require.NoError(t, s.ClearDB())
+	exists, err = file.Exists(filepath.Join(databasePath, DatabaseFileName), file.Regular)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
+
+func TestStore_Backup(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	s := setupDB(t, pubKeys)
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKeys[0], 10, []byte{1}))
+
+	backupsPath := t.TempDir()
+	require.NoError(t, s.Backup(ctx, backupsPath, true))
+
+	entries, err := os.ReadDir(backupsPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+
+	// The backup is a SQLite database with the same history.
+	backupDir := t.TempDir()
+	require.NoError(t, os.Rename(filepath.Join(backupsPath, entries[0].Name()), filepath.Join(backupDir, DatabaseFileName)))
+	backup, err := NewStore(ctx, backupDir, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, backup.Close())
+	}()
+	proposals, err := backup.ProposalHistoryForPubKey(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	require.Equal(t, uint64(10), uint64(proposals[0].Slot))
+}
@@ -0,0 +1,196 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+// importedHistory is the signing history of a public key in an EIP-3076 JSON file.
+type importedHistory struct {
+	proposals    []common.Proposal
+	attestations []*common.AttestationRecord
+}
+
+// ImportStandardProtectionJSON takes in EIP-3076 compliant JSON file used for slashing protection
+// by Ethereum validators and imports its data into the complete slashing protection history of the database.
+// The public keys whose history is slashable, within the file or with respect to the database, are blacklisted.
+// The whole import is a single transaction.
+func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error {
+	encodedJSON, err := io.ReadAll(r)
+	if err != nil {
+		return errors.Wrap(err, "could not read slashing protection JSON file")
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
+		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+	if interchangeJSON.Data == nil {
+		log.Warn("No slashing protection data to import")
+		return nil
+	}
+	// We validate the `MetadataV0` field of the slashing protection JSON file.
+	if err := helpers.ValidateMetadata(ctx, s, interchangeJSON); err != nil {
+		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+	}
+
+	// A public key can appear several times in the file, with different histories.
+	histories := make(map[[fieldparams.BLSPubkeyLength]byte]*importedHistory)
+	for _, item := range interchangeJSON.Data {
+		if item == nil {
+			continue
+		}
+		pubKey, err := helpers.PubKeyFromHex(item.Pubkey)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid public key: %w", item.Pubkey, err)
+		}
+		history, ok := histories[pubKey]
+		if !ok {
+			history = &importedHistory{}
+			histories[pubKey] = history
+		}
+		if err := history.add(pubKey, item); err != nil {
+			return errors.Wrapf(err, "could not parse history in JSON file for key %#x", pubKey)
+		}
+	}
+
+	return s.update(ctx, func(tx *sql.Tx) error {
+		for pubKey, history := range histories {
+			slashable, err := history.slashable(ctx, tx, pubKey)
+			if err != nil {
+				return err
+			}
+			if slashable {
+				if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO blacklisted_public_keys (public_key) VALUES (?)`, pubKey[:]); err != nil {
+					return errors.Wrap(err, "could not save slashable public key")
+				}
+			}
+			for _, proposal := range history.proposals {
+				if err := saveProposal(ctx, tx, pubKey, proposal.Slot, proposal.SigningRoot); err != nil {
+					return errors.Wrap(err, "could not save proposal history from imported JSON to database")
+				}
+			}
+			for _, att := range history.attestations {
+				if err := saveAttestationRecord(ctx, tx, att); err != nil {
+					return errors.Wrap(err, "could not save attestations from imported JSON to database")
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (h *importedHistory) add(pubKey [fieldparams.BLSPubkeyLength]byte, item *format.ProtectionData) error {
+	for _, blk := range item.SignedBlocks {
+		if blk == nil {
+			continue
+		}
+		slot, err := helpers.SlotFromString(blk.Slot)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid slot: %w", blk.Slot, err)
+		}
+		// Signing roots are optional in the standard JSON file.
+		signingRoot, err := optionalRoot(blk.SigningRoot)
+		if err != nil {
+			return err
+		}
+		h.proposals = append(h.proposals, common.Proposal{Slot: slot, SigningRoot: signingRoot})
+	}
+	for _, att := range item.SignedAttestations {
+		if att == nil {
+			continue
+		}
+		source, err := helpers.EpochFromString(att.SourceEpoch)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid epoch: %w", att.SourceEpoch, err)
+		}
+		target, err := helpers.EpochFromString(att.TargetEpoch)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid epoch: %w", att.TargetEpoch, err)
+		}
+		signingRoot, err := optionalRoot(att.SigningRoot)
+		if err != nil {
+			return err
+		}
+		h.attestations = append(h.attestations, &common.AttestationRecord{
+			PubKey:      pubKey,
+			Source:      source,
+			Target:      target,
+			SigningRoot: signingRoot,
+		})
+	}
+	return nil
+}
+
+// slashable returns whether the imported history is slashable within itself, or with respect to the attestations of
+// the database.
+func (h *importedHistory) slashable(ctx context.Context, q querier, pubKey [fieldparams.BLSPubkeyLength]byte) (bool, error) {
+	// Given signing roots are optional in the EIP standard, two blocks at the same slot are slashable
+	// unless they have the same signing root.
+	signingRootsBySlot := make(map[primitives.Slot][]byte)
+	for _, blk := range h.proposals {
+		if signingRoot, ok := signingRootsBySlot[blk.Slot]; ok && (len(signingRoot) == 0 || !bytes.Equal(signingRoot, blk.SigningRoot)) {
+			return true, nil
+		}
+		signingRootsBySlot[blk.Slot] = blk.SigningRoot
+	}
+
+	signingRootsByTarget := make(map[primitives.Epoch][]byte)
+	for i, att := range h.attestations {
+		if sr, ok := signingRootsByTarget[att.Target]; ok && slashings.SigningRootsDiffer(sr, att.SigningRoot) {
+			return true, nil
+		}
+		b := createAttestation(att.Source, att.Target)
+		for _, previous := range h.attestations[:i] {
+			a := createAttestation(previous.Source, previous.Target)
+			if slashings.IsSurround(a, b) || slashings.IsSurround(b, a) {
+				return true, nil
+			}
+		}
+		signingRootsByTarget[att.Target] = att.SigningRoot
+	}
+
+	for _, att := range h.attestations {
+		slashing, err := checkSlashableAttestation(ctx, q, pubKey, att.SigningRoot, createAttestation(att.Source, att.Target))
+		if err != nil {
+			return false, err
+		}
+		if slashing != nil {
+			log.WithError(slashing).WithField("publicKey", fmt.Sprintf("%#x", pubKey)).Warn("Imported attestation is slashable")
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func optionalRoot(root string) ([]byte, error) {
+	if root == "" {
+		return make([]byte, 0, fieldparams.RootLength), nil
+	}
+	root32, err := helpers.RootFromHex(root)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid root: %w", root, err)
+	}
+	return root32[:], nil
+}
+
+func createAttestation(source, target primitives.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: source},
+			Target: &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
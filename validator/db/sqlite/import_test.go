@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	valtest "github.com/prysmaticlabs/prysm/v5/validator/testing"
+)
+
+func TestStore_ImportInterchangeData_BadJSON(t *testing.T) {
+	s := setupDB(t, nil)
+	err := s.ImportStandardProtectionJSON(context.Background(), bytes.NewBufferString("helloworld"))
+	require.ErrorContains(t, "could not unmarshal slashing protection JSON file", err)
+}
+
+func TestStore_ImportInterchangeData_BadFormat_PreventsDBWrites(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(10)
+	require.NoError(t, err)
+	s := setupDB(t, publicKeys)
+
+	attestingHistory, proposalHistory := valtest.MockAttestingAndProposalHistories(publicKeys)
+	standardProtectionFormat, err := valtest.MockSlashingProtectionJSON(publicKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	standardProtectionFormat.Data[len(publicKeys)-1].SignedBlocks[0].Slot = "BadSlot"
+	blob, err := json.Marshal(standardProtectionFormat)
+	require.NoError(t, err)
+
+	require.NotNil(t, s.ImportStandardProtectionJSON(ctx, bytes.NewBuffer(blob)))
+
+	// The import is atomic, nothing was saved.
+	for _, pubKey := range publicKeys {
+		proposals, err := s.ProposalHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		require.DeepEqual(t, make([]*common.Proposal, 0), proposals)
+	}
+}
+
+func TestStore_ImportInterchangeData_OK(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(10)
+	require.NoError(t, err)
+	s := setupDB(t, publicKeys)
+
+	attestingHistory, proposalHistory := valtest.MockAttestingAndProposalHistories(publicKeys)
+	standardProtectionFormat, err := valtest.MockSlashingProtectionJSON(publicKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	blob, err := json.Marshal(standardProtectionFormat)
+	require.NoError(t, err)
+	require.NoError(t, s.ImportStandardProtectionJSON(ctx, bytes.NewBuffer(blob)))
+
+	for i, pubKey := range publicKeys {
+		history, err := s.AttestationHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, len(attestingHistory[i]), len(history))
+
+		for _, proposal := range proposalHistory[i].Proposals {
+			signingRoot, exists, _, err := s.ProposalHistoryForSlot(ctx, pubKey, proposal.Slot)
+			require.NoError(t, err)
+			require.Equal(t, true, exists)
+			require.DeepEqual(t, proposal.SigningRoot, signingRoot[:])
+		}
+
+		// Signing an imported attestation with another signing root is slashable.
+		for _, att := range attestingHistory[i] {
+			err := s.SlashableAttestationCheck(ctx, createAttestation(att.Source, att.Target), pubKey, [fieldparams.RootLength]byte{}, false, nil)
+			require.ErrorContains(t, "could not sign attestation", err)
+		}
+	}
+
+	blacklisted, err := s.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(blacklisted))
+}
+
+func TestStore_ImportInterchangeData_SlashableKeyIsBlacklisted(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	s := setupDB(t, publicKeys)
+
+	attestingHistory, proposalHistory := valtest.MockAttestingAndProposalHistories(publicKeys)
+	standardProtectionFormat, err := valtest.MockSlashingProtectionJSON(publicKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+
+	// The first key signed two different blocks at the same slot.
+	item := standardProtectionFormat.Data[0]
+	conflicting := *item.SignedBlocks[0]
+	conflicting.SigningRoot = "0x0400000000000000000000000000000000000000000000000000000000000000"
+	item.SignedBlocks = append(item.SignedBlocks, &conflicting)
+
+	blob, err := json.Marshal(standardProtectionFormat)
+	require.NoError(t, err)
+	require.NoError(t, s.ImportStandardProtectionJSON(ctx, bytes.NewBuffer(blob)))
+
+	blacklisted, err := s.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, publicKeys[:1], blacklisted)
+}
@@ -0,0 +1,161 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNoProposerSettingsFound is an error thrown when no settings are found.
+var ErrNoProposerSettingsFound = errors.New("no proposer settings found in bucket")
+
+// metadata returns the value of the key in the metadata table, nil if there is none.
+func metadata(ctx context.Context, q querier, key string) ([]byte, error) {
+	var value []byte
+	err := q.QueryRowContext(ctx, `SELECT value FROM metadata WHERE key = ?`, key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get %s", key)
+	}
+	return value, nil
+}
+
+func saveMetadata(ctx context.Context, tx *sql.Tx, key string, value []byte) error {
+	if _, err := tx.ExecContext(
+		ctx, `INSERT INTO metadata (key, value) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value`, key, value,
+	); err != nil {
+		return errors.Wrapf(err, "could not save %s", key)
+	}
+	return nil
+}
+
+// SaveGenesisValidatorsRoot saves the genesis validators root to db.
+func (s *Store) SaveGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error {
+	return s.update(ctx, func(tx *sql.Tx) error {
+		enc, err := metadata(ctx, tx, genesisValidatorsRootKey)
+		if err != nil {
+			return err
+		}
+		if len(enc) != 0 && !bytes.Equal(enc, genValRoot) {
+			return fmt.Errorf("cannot overwrite existing genesis validators root: %#x", enc)
+		}
+		return saveMetadata(ctx, tx, genesisValidatorsRootKey, nonNil(genValRoot))
+	})
+}
+
+// GenesisValidatorsRoot retrieves the genesis validators root from db.
+func (s *Store) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	enc, err := metadata(ctx, s.db, genesisValidatorsRootKey)
+	if err != nil || len(enc) == 0 {
+		return nil, err
+	}
+	return enc, nil
+}
+
+// SaveGraffitiOrderedIndex writes the current graffiti index to the db
+func (s *Store) SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error {
+	return s.update(ctx, func(tx *sql.Tx) error {
+		return saveMetadata(ctx, tx, graffitiOrderedIndexKey, bytesutil.Uint64ToBytesBigEndian(index))
+	})
+}
+
+// GraffitiOrderedIndex fetches the ordered index, resetting if the file hash changed
+func (s *Store) GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error) {
+	orderedIndex := uint64(0)
+	err := s.update(ctx, func(tx *sql.Tx) error {
+		dbFileHash, err := metadata(ctx, tx, graffitiFileHashKey)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(dbFileHash, fileHash[:]) {
+			indexBytes, err := metadata(ctx, tx, graffitiOrderedIndexKey)
+			if err != nil {
+				return err
+			}
+			orderedIndex = bytesutil.BytesToUint64BigEndian(indexBytes)
+			return nil
+		}
+		if err := saveMetadata(ctx, tx, graffitiOrderedIndexKey, bytesutil.Uint64ToBytesBigEndian(0)); err != nil {
+			return err
+		}
+		return saveMetadata(ctx, tx, graffitiFileHashKey, fileHash[:])
+	})
+	return orderedIndex, err
+}
+
+// GraffitiFileHash fetches the graffiti file hash.
+func (s *Store) GraffitiFileHash() ([32]byte, bool, error) {
+	var fileHash [32]byte
+	dbFileHash, err := metadata(context.Background(), s.db, graffitiFileHashKey)
+	if err != nil || dbFileHash == nil {
+		return fileHash, false, err
+	}
+	copy(fileHash[:], dbFileHash)
+	return fileHash, true, nil
+}
+
+// ProposerSettings gets the current proposer settings
+func (s *Store) ProposerSettings(ctx context.Context) (*proposer.Settings, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.db.Settings")
+	defer span.End()
+	b, err := metadata(ctx, s.db, proposerSettingsKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, ErrNoProposerSettingsFound
+	}
+	to := &validatorpb.ProposerSettingsPayload{}
+	if err := proto.Unmarshal(b, to); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal proposer settings")
+	}
+	return proposer.SettingFromConsensus(to)
+}
+
+// ProposerSettingsExists returns true or false if the settings exist or not
+func (s *Store) ProposerSettingsExists(ctx context.Context) (bool, error) {
+	ps, err := s.ProposerSettings(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNoProposerSettingsFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return ps != nil, nil
+}
+
+// SaveProposerSettings saves the entire proposer setting overriding the existing settings
+func (s *Store) SaveProposerSettings(ctx context.Context, settings *proposer.Settings) error {
+	ctx, span := trace.StartSpan(ctx, "validator.db.SaveProposerSettings")
+	defer span.End()
+	// nothing to save
+	if !settings.ShouldBeSaved() {
+		log.Warn("proposer settings are empty, nothing has been saved")
+		return nil
+	}
+	m, err := proto.Marshal(settings.ToConsensus())
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal proposer settings")
+	}
+	return s.update(ctx, func(tx *sql.Tx) error {
+		return saveMetadata(ctx, tx, proposerSettingsKey, m)
+	})
+}
+
+// nonNil returns an empty slice for a nil one, as a nil slice is stored as NULL.
+func nonNil(b []byte) []byte {
+	if b == nil {
+		return []byte{}
+	}
+	return b
+}
@@ -0,0 +1,96 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_GenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	s := setupDB(t, nil)
+
+	root, err := s.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(root))
+
+	genValRoot := []byte{1, 2, 3}
+	require.NoError(t, s.SaveGenesisValidatorsRoot(ctx, genValRoot))
+	root, err = s.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, genValRoot, root)
+
+	// Saving the same root again is a no-op, a different root is rejected.
+	require.NoError(t, s.SaveGenesisValidatorsRoot(ctx, genValRoot))
+	require.ErrorContains(t, "cannot overwrite existing genesis validators root", s.SaveGenesisValidatorsRoot(ctx, []byte{4}))
+}
+
+func TestStore_GraffitiOrderedIndex(t *testing.T) {
+	ctx := context.Background()
+	s := setupDB(t, nil)
+
+	_, exists, err := s.GraffitiFileHash()
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	fileHash := [32]byte{1}
+	index, err := s.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+
+	require.NoError(t, s.SaveGraffitiOrderedIndex(ctx, 3))
+	index, err = s.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), index)
+
+	hash, exists, err := s.GraffitiFileHash()
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, fileHash, hash)
+
+	// A new graffiti file resets the index.
+	index, err = s.GraffitiOrderedIndex(ctx, [32]byte{2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+}
+
+func TestStore_ProposerSettings(t *testing.T) {
+	ctx := context.Background()
+	s := setupDB(t, nil)
+
+	_, err := s.ProposerSettings(ctx)
+	require.ErrorIs(t, err, ErrNoProposerSettingsFound)
+	exists, err := s.ProposerSettingsExists(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	pubKeyBytes, err := hexutil.Decode("0xb3533c600c6c22aa5177f295667deacffde243980d3c04da4057ab0941dcca1dff83ae8e6534bedd2d23d83446e604d6")
+	require.NoError(t, err)
+	customFeeRecipient, err := hexutil.Decode("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+	require.NoError(t, err)
+	defaultFeeRecipient, err := hexutil.Decode("0xC771172AE08B5FC37B3AC3D445225928DE883876")
+	require.NoError(t, err)
+	settings := &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			bytesutil.ToBytes48(pubKeyBytes): {
+				FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: bytesutil.ToBytes20(customFeeRecipient)},
+			},
+		},
+		DefaultConfig: &proposer.Option{
+			FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: bytesutil.ToBytes20(defaultFeeRecipient)},
+		},
+	}
+	require.NoError(t, s.SaveProposerSettings(ctx, settings))
+
+	exists, err = s.ProposerSettingsExists(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	received, err := s.ProposerSettings(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, settings, received)
+}
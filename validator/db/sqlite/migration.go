@@ -0,0 +1,13 @@
+package sqlite
+
+import "context"
+
+// RunUpMigrations only exists to satisfy the interface, the tables are created with their latest schema.
+func (*Store) RunUpMigrations(_ context.Context) error {
+	return nil
+}
+
+// RunDownMigrations only exists to satisfy the interface.
+func (*Store) RunDownMigrations(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,258 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// ProposedPublicKeys retrieves all public keys in our proposals history.
+// Warning: A public key in this history does not necessarily mean it has signed a block.
+func (s *Store) ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposedPublicKeys")
+	defer span.End()
+	return publicKeys(ctx, s.db, `SELECT public_key FROM public_keys ORDER BY public_key`)
+}
+
+// ProposalHistoryForSlot accepts a validator public key and returns the corresponding signing root as well
+// as a boolean that tells us if we have a proposal history stored at the slot and a boolean that tells us if we have
+// a signed root at the slot.
+func (s *Store) ProposalHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([32]byte, bool, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForSlot")
+	defer span.End()
+	return proposalHistoryForSlot(ctx, s.db, publicKey, slot)
+}
+
+func proposalHistoryForSlot(ctx context.Context, q querier, publicKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([32]byte, bool, bool, error) {
+	var signingRoot [32]byte
+	sqlSlot, err := sqlInteger(slot)
+	if err != nil {
+		return signingRoot, false, false, err
+	}
+	var signingRootBytes []byte
+	err = q.QueryRowContext(
+		ctx, `SELECT signing_root FROM signed_blocks WHERE public_key = ? AND slot = ?`, publicKey[:], sqlSlot,
+	).Scan(&signingRootBytes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return signingRoot, false, false, nil
+	}
+	if err != nil {
+		return signingRoot, false, false, errors.Wrap(err, "could not get proposal history")
+	}
+	if len(signingRootBytes) == 0 {
+		return signingRoot, true, false, nil
+	}
+	copy(signingRoot[:], signingRootBytes)
+	return signingRoot, true, true, nil
+}
+
+// ProposalHistoryForPubKey returns the entire proposal history for a given public key.
+func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*common.Proposal, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForPubKey")
+	defer span.End()
+
+	rows, err := s.db.QueryContext(
+		ctx, `SELECT slot, signing_root FROM signed_blocks WHERE public_key = ? ORDER BY slot`, publicKey[:],
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposal history")
+	}
+	defer closeRows(rows)
+	proposals := make([]*common.Proposal, 0)
+	for rows.Next() {
+		var slot int64
+		var signingRoot []byte
+		if err := rows.Scan(&slot, &signingRoot); err != nil {
+			return nil, errors.Wrap(err, "could not read proposal history")
+		}
+		sr := make([]byte, fieldparams.RootLength)
+		copy(sr, signingRoot)
+		proposals = append(proposals, &common.Proposal{Slot: primitives.Slot(slot), SigningRoot: sr})
+	}
+	return proposals, rows.Err()
+}
+
+// SaveProposalHistoryForSlot saves the proposal history for the requested validator public key.
+// We also update the lowest and highest signed proposal slots of the validator.
+func (s *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveProposalHistoryForEpoch")
+	defer span.End()
+	return s.update(ctx, func(tx *sql.Tx) error {
+		return saveProposal(ctx, tx, pubKey, slot, signingRoot)
+	})
+}
+
+func saveProposal(ctx context.Context, tx *sql.Tx, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot []byte) error {
+	sqlSlot, err := sqlInteger(slot)
+	if err != nil {
+		return errors.Wrap(err, "could not save proposal slot")
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO public_keys (public_key) VALUES (?)`, pubKey[:]); err != nil {
+		return errors.Wrap(err, "could not save public key")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO signed_blocks (public_key, slot, signing_root) VALUES (?, ?, ?)
+		ON CONFLICT (public_key, slot) DO UPDATE SET signing_root = excluded.signing_root`,
+		pubKey[:], sqlSlot, nonNil(signingRoot),
+	); err != nil {
+		return errors.Wrapf(err, "could not save proposal at slot %d", slot)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO watermarks (public_key, lowest_proposal_slot, highest_proposal_slot) VALUES (?, ?, ?)
+		ON CONFLICT (public_key) DO UPDATE SET
+			lowest_proposal_slot = MIN(COALESCE(lowest_proposal_slot, excluded.lowest_proposal_slot), excluded.lowest_proposal_slot),
+			highest_proposal_slot = MAX(COALESCE(highest_proposal_slot, excluded.highest_proposal_slot), excluded.highest_proposal_slot)`,
+		pubKey[:], sqlSlot, sqlSlot,
+	); err != nil {
+		return errors.Wrap(err, "could not update signed proposal slots")
+	}
+
+	// Only prune the epochs that are older than the weak subjectivity period.
+	newestEpoch := slots.ToEpoch(slot)
+	if newestEpoch < params.BeaconConfig().WeakSubjectivityPeriod {
+		return nil
+	}
+	oldestKept := slots.UnsafeEpochStart(newestEpoch - params.BeaconConfig().WeakSubjectivityPeriod + 1)
+	if _, err := tx.ExecContext(
+		ctx, `DELETE FROM signed_blocks WHERE public_key = ? AND slot < ?`, pubKey[:], int64(oldestKept),
+	); err != nil {
+		return errors.Wrap(err, "could not prune proposal history")
+	}
+	return nil
+}
+
+// LowestSignedProposal returns the lowest signed proposal slot for a validator public key.
+// If no data exists, a boolean of value false is returned.
+func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedProposal")
+	defer span.End()
+	slot, exists, err := watermark(ctx, s.db, publicKey, "lowest_proposal_slot")
+	return primitives.Slot(slot), exists, err
+}
+
+// HighestSignedProposal returns the highest signed proposal slot for a validator public key.
+// If no data exists, a boolean of value false is returned.
+func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.HighestSignedProposal")
+	defer span.End()
+	slot, exists, err := watermark(ctx, s.db, publicKey, "highest_proposal_slot")
+	return primitives.Slot(slot), exists, err
+}
+
+// SlashableProposalCheck checks if a block proposal is slashable by comparing it with the
+// block proposals history for the given public key in our complete slashing protection database defined by EIP-3076.
+// If it is not, we then update the history. The check and the update are a single transaction.
+func (s *Store) SlashableProposalCheck(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signedBlock interfaces.ReadOnlySignedBeaconBlock,
+	signingRoot [fieldparams.RootLength]byte,
+	emitAccountMetrics bool,
+	validatorProposeFailVec *prometheus.CounterVec,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SlashableProposalCheck")
+	defer span.End()
+
+	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	slot := signedBlock.Block().Slot()
+	err := s.update(ctx, func(tx *sql.Tx) error {
+		prevSigningRoot, proposalAtSlotExists, prevSigningRootExists, err := proposalHistoryForSlot(ctx, tx, pubKey, slot)
+		if err != nil {
+			return errors.Wrap(err, "failed to get proposal history")
+		}
+		lowest, lowestProposalExists, err := watermark(ctx, tx, pubKey, "lowest_proposal_slot")
+		if err != nil {
+			return err
+		}
+		lowestSignedProposalSlot := primitives.Slot(lowest)
+
+		// Based on EIP-3076 - Condition 2
+		// -------------------------------
+		if lowestProposalExists {
+			// If the block slot is (strictly) less than the lowest signed proposal slot in the DB, we consider it slashable.
+			if slot < lowestSignedProposalSlot {
+				return fmt.Errorf(
+					"could not sign block with slot < lowest signed slot in db, block slot: %d < lowest signed slot: %d",
+					slot,
+					lowestSignedProposalSlot,
+				)
+			}
+			// If the block slot is equal to the lowest signed proposal slot, it is only allowed as a repeat signing.
+			isRepeat := proposalAtSlotExists && prevSigningRootExists && prevSigningRoot == signingRoot
+			if slot == lowestSignedProposalSlot && !isRepeat {
+				return fmt.Errorf(
+					"could not sign block with slot == lowest signed slot in db if it is not a repeat signing, block slot: %d == slowest signed slot: %d",
+					slot,
+					lowestSignedProposalSlot,
+				)
+			}
+		}
+
+		// Based on EIP-3076 - Condition 1
+		// -------------------------------
+		// If there is a signed proposal in the DB for this slot and
+		// - there is no associated signing root, or
+		// - the signing root differs,
+		// ==> we consider it slashable.
+		if proposalAtSlotExists && (!prevSigningRootExists || prevSigningRoot != signingRoot) {
+			return errors.New(common.FailedBlockSignLocalErr)
+		}
+
+		if err := saveProposal(ctx, tx, pubKey, slot, signingRoot[:]); err != nil {
+			return errors.Wrap(err, "failed to save updated proposal history")
+		}
+		return nil
+	})
+	if err != nil && emitAccountMetrics {
+		validatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+	}
+	return err
+}
+
+// watermark returns the column of the watermarks of the public key, and whether it is set.
+func watermark(ctx context.Context, q querier, publicKey [fieldparams.BLSPubkeyLength]byte, column string) (uint64, bool, error) {
+	var value sql.NullInt64
+	err := q.QueryRowContext(ctx, `SELECT `+column+` FROM watermarks WHERE public_key = ?`, publicKey[:]).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "could not get %s", column)
+	}
+	return uint64(value.Int64), value.Valid, nil
+}
+
+func publicKeys(ctx context.Context, q querier, query string) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get public keys")
+	}
+	defer closeRows(rows)
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for rows.Next() {
+		var key []byte
+		if err := rows.Scan(&key); err != nil {
+			return nil, errors.Wrap(err, "could not read public key")
+		}
+		var pubKey [fieldparams.BLSPubkeyLength]byte
+		copy(pubKey[:], key)
+		keys = append(keys, pubKey)
+	}
+	return keys, rows.Err()
+}
+
+func closeRows(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		log.WithError(err).Error("Could not close rows")
+	}
+}
@@ -0,0 +1,129 @@
+package sqlite
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	valtest "github.com/prysmaticlabs/prysm/v5/validator/testing"
+)
+
+func TestStore_SaveProposalHistoryForSlot(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	pubKey := pubKeys[0]
+	s := setupDB(t, pubKeys)
+
+	_, exists, signed, err := s.ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+	require.Equal(t, false, signed)
+
+	// A proposal imported without signing root.
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKey, 10, nil))
+	_, exists, signed, err = s.ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, false, signed)
+
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKey, 12, []byte{1}))
+	signingRoot, exists, signed, err := s.ProposalHistoryForSlot(ctx, pubKey, 12)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, true, signed)
+	require.Equal(t, [32]byte{1}, signingRoot)
+
+	lowest, exists, err := s.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, primitives.Slot(10), lowest)
+	highest, exists, err := s.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, primitives.Slot(12), highest)
+}
+
+func TestStore_SaveProposalHistoryForSlot_OutOfRange(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	s := setupDB(t, pubKeys)
+
+	require.ErrorIs(t, s.SaveProposalHistoryForSlot(ctx, pubKeys[0], math.MaxUint64, []byte{1}), errOutOfRange)
+	_, _, _, err = s.ProposalHistoryForSlot(ctx, pubKeys[0], math.MaxUint64)
+	require.ErrorIs(t, err, errOutOfRange)
+	_, exists, err := s.HighestSignedProposal(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
+
+func TestStore_SaveProposalHistoryForSlot_Prunes(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	pubKey := pubKeys[0]
+	s := setupDB(t, pubKeys)
+
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKey, 1, []byte{1}))
+	wsPeriodSlot, err := params.BeaconConfig().SlotsPerEpoch.SafeMul(uint64(params.BeaconConfig().WeakSubjectivityPeriod))
+	require.NoError(t, err)
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKey, wsPeriodSlot+1, []byte{2}))
+
+	proposals, err := s.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	require.Equal(t, wsPeriodSlot+1, proposals[0].Slot)
+
+	// The lowest signed proposal is kept after pruning.
+	lowest, exists, err := s.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, primitives.Slot(1), lowest)
+}
+
+func TestStore_SlashableProposalCheck(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	pubKey := pubKeys[0]
+	s := setupDB(t, pubKeys)
+
+	blk := util.HydrateSignedBeaconBlock(&ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot: 10,
+			Body: &ethpb.BeaconBlockBody{},
+		},
+		Signature: params.BeaconConfig().EmptySignature[:],
+	})
+	sBlock, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SlashableProposalCheck(ctx, pubKey, sBlock, [32]byte{1}, false, nil))
+	// A repeat signing is allowed, a different signing root at the same slot is not.
+	require.NoError(t, s.SlashableProposalCheck(ctx, pubKey, sBlock, [32]byte{1}, false, nil))
+	err = s.SlashableProposalCheck(ctx, pubKey, sBlock, [32]byte{2}, false, nil)
+	require.ErrorContains(t, "could not sign block with slot == lowest signed slot", err)
+
+	// A block lower than the lowest signed proposal is slashable.
+	blk.Block.Slot = 9
+	sBlock, err = blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	err = s.SlashableProposalCheck(ctx, pubKey, sBlock, [32]byte{3}, false, nil)
+	require.ErrorContains(t, "could not sign block with slot < lowest signed slot", err)
+
+	// A block imported without signing root is slashable.
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKey, 11, nil))
+	blk.Block.Slot = 11
+	sBlock, err = blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	err = s.SlashableProposalCheck(ctx, pubKey, sBlock, [32]byte{4}, false, nil)
+	require.ErrorContains(t, common.FailedBlockSignLocalErr, err)
+}
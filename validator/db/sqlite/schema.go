@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// Keys of the metadata table.
+const (
+	genesisValidatorsRootKey = "genesis_validators_root"
+	graffitiOrderedIndexKey  = "graffiti_ordered_index"
+	graffitiFileHashKey      = "graffiti_file_hash"
+	proposerSettingsKey      = "proposer_settings"
+)
+
+// errOutOfRange is returned for the slots and epochs which do not fit a SQLite integer.
+var errOutOfRange = errors.New("value does not fit a SQLite integer")
+
+// sqlInteger converts a slot or an epoch to a SQLite integer. The values above math.MaxInt64 are rejected instead of
+// wrapping to negative integers, which would break the ordering the slashing protection checks rely on.
+func sqlInteger[T ~uint64](v T) (int64, error) {
+	if uint64(v) > math.MaxInt64 {
+		return 0, errors.Wrapf(errOutOfRange, "%d", uint64(v))
+	}
+	return int64(v), nil
+}
+
+// The slots and epochs are stored as SQLite integers, which are signed 64 bits integers, see sqlInteger.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS metadata (
+		key   TEXT PRIMARY KEY,
+		value BLOB NOT NULL
+	)`,
+	// The public keys of the proposal history, including the ones which did not propose a block yet.
+	`CREATE TABLE IF NOT EXISTS public_keys (
+		public_key BLOB PRIMARY KEY
+	)`,
+	// An empty signing root is a block or an attestation imported without its signing root.
+	`CREATE TABLE IF NOT EXISTS signed_blocks (
+		public_key   BLOB NOT NULL,
+		slot         INTEGER NOT NULL,
+		signing_root BLOB NOT NULL,
+		PRIMARY KEY (public_key, slot)
+	)`,
+	`CREATE TABLE IF NOT EXISTS signed_attestations (
+		public_key   BLOB NOT NULL,
+		source_epoch INTEGER NOT NULL,
+		target_epoch INTEGER NOT NULL,
+		signing_root BLOB NOT NULL,
+		PRIMARY KEY (public_key, source_epoch, target_epoch)
+	)`,
+	`CREATE INDEX IF NOT EXISTS signed_attestations_target ON signed_attestations (public_key, target_epoch)`,
+	// The lowest and highest signed slots and epochs of EIP-3076, kept when the history is pruned.
+	`CREATE TABLE IF NOT EXISTS watermarks (
+		public_key            BLOB PRIMARY KEY,
+		lowest_source_epoch   INTEGER,
+		lowest_target_epoch   INTEGER,
+		lowest_proposal_slot  INTEGER,
+		highest_proposal_slot INTEGER
+	)`,
+	// Public keys found slashable in an EIP-3076 import.
+	`CREATE TABLE IF NOT EXISTS blacklisted_public_keys (
+		public_key BLOB PRIMARY KEY
+	)`,
+}
+
+func (s *Store) createTables(ctx context.Context) error {
+	return s.update(ctx, func(tx *sql.Tx) error {
+		for _, stmt := range schema {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -30,6 +30,8 @@ const (
 type SetupConfig struct {
 	Wallet           iface.Wallet
 	ListenForChanges bool
+	// KDFParams configures the key derivation function the accounts keystore is encrypted with.
+	KDFParams *keymanager.KDFParams
 }
 
 // Keymanager implementation for derived, HD keymanager using EIP-2333 and EIP-2334.
@@ -45,6 +47,7 @@ func NewKeymanager(
 	localKM, err := local.NewKeymanager(ctx, &local.SetupConfig{
 		Wallet:           cfg.Wallet,
 		ListenForChanges: cfg.ListenForChanges,
+		KDFParams:        cfg.KDFParams,
 	})
 	if err != nil {
 		return nil, err
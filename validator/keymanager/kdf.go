@@ -0,0 +1,275 @@
+package keymanager
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// ScryptKDF is the name of the scrypt key derivation function of EIP-2335 keystores.
+	ScryptKDF = "scrypt"
+	// PBKDF2KDF is the name of the PBKDF2 key derivation function of EIP-2335 keystores.
+	PBKDF2KDF = "pbkdf2"
+
+	// DefaultScryptN is the default scrypt cost parameter, as used by the EIP-2335 test vectors.
+	DefaultScryptN = 1 << 18
+	// DefaultScryptR is the default scrypt block size parameter.
+	DefaultScryptR = 8
+	// DefaultScryptP is the default scrypt parallelization parameter.
+	DefaultScryptP = 1
+	// DefaultPBKDF2C is the default PBKDF2 iteration count, as used by the EIP-2335 test vectors.
+	DefaultPBKDF2C = 1 << 18
+
+	// MinScryptN is the lowest scrypt cost parameter accepted for keystores.
+	MinScryptN = 1 << 14
+	// MinPBKDF2C is the lowest PBKDF2 iteration count accepted for keystores.
+	MinPBKDF2C = 1 << 16
+
+	kdfSaltLength = 32
+	kdfKeyLength  = 32
+)
+
+// KDFParams defines the key derivation function used to encrypt keystores and its parameters.
+type KDFParams struct {
+	Function string
+	ScryptN  int
+	ScryptR  int
+	ScryptP  int
+	PBKDF2C  int
+}
+
+// DefaultKDFParams returns the parameters of the given key derivation function used by default.
+func DefaultKDFParams(function string) (*KDFParams, error) {
+	switch function {
+	case ScryptKDF:
+		return &KDFParams{Function: ScryptKDF, ScryptN: DefaultScryptN, ScryptR: DefaultScryptR, ScryptP: DefaultScryptP}, nil
+	case PBKDF2KDF:
+		return &KDFParams{Function: PBKDF2KDF, PBKDF2C: DefaultPBKDF2C}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function %q, expected %s or %s", function, ScryptKDF, PBKDF2KDF)
+	}
+}
+
+// Validate checks the parameters are supported and strong enough to protect keystores.
+func (p *KDFParams) Validate() error {
+	switch p.Function {
+	case ScryptKDF:
+		if p.ScryptN < MinScryptN || p.ScryptN&(p.ScryptN-1) != 0 {
+			return fmt.Errorf("scrypt cost %d must be a power of two of at least %d", p.ScryptN, MinScryptN)
+		}
+		if p.ScryptR < 1 {
+			return fmt.Errorf("scrypt block size %d must be positive", p.ScryptR)
+		}
+		if p.ScryptP < 1 {
+			return fmt.Errorf("scrypt parallelization %d must be positive", p.ScryptP)
+		}
+		// Bounds enforced by the scrypt implementation.
+		if uint64(p.ScryptR)*uint64(p.ScryptP) >= 1<<30 {
+			return fmt.Errorf("scrypt block size %d and parallelization %d are too large", p.ScryptR, p.ScryptP)
+		}
+		return nil
+	case PBKDF2KDF:
+		if p.PBKDF2C < MinPBKDF2C {
+			return fmt.Errorf("pbkdf2 iteration count %d must be at least %d", p.PBKDF2C, MinPBKDF2C)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key derivation function %q, expected %s or %s", p.Function, ScryptKDF, PBKDF2KDF)
+	}
+}
+
+// String describes the key derivation function and its parameters.
+func (p *KDFParams) String() string {
+	if p.Function == ScryptKDF {
+		return fmt.Sprintf("scrypt (n=%d, r=%d, p=%d)", p.ScryptN, p.ScryptR, p.ScryptP)
+	}
+	return fmt.Sprintf("%s (c=%d)", p.Function, p.PBKDF2C)
+}
+
+// KDFParamsFromCrypto returns the key derivation function and parameters of the crypto fields of an EIP-2335 keystore.
+func KDFParamsFromCrypto(cryptoFields map[string]interface{}) (*KDFParams, error) {
+	kdf, ok := cryptoFields["kdf"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("keystore has no key derivation function")
+	}
+	function, ok := kdf["function"].(string)
+	if !ok {
+		return nil, errors.New("keystore has no key derivation function name")
+	}
+	params, ok := kdf["params"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("keystore has no key derivation function parameters")
+	}
+	// Numbers decoded from JSON are float64 whereas numbers of freshly encrypted keystores are int.
+	param := func(name string) int {
+		switch v := params[name].(type) {
+		case float64:
+			return int(v)
+		case int:
+			return v
+		default:
+			return 0
+		}
+	}
+	switch function {
+	case ScryptKDF:
+		return &KDFParams{Function: ScryptKDF, ScryptN: param("n"), ScryptR: param("r"), ScryptP: param("p")}, nil
+	case PBKDF2KDF:
+		return &KDFParams{Function: PBKDF2KDF, PBKDF2C: param("c")}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function %q", function)
+	}
+}
+
+// Encryptor encrypts secrets into the crypto fields of an EIP-2335 keystore.
+type Encryptor interface {
+	Encrypt(secret []byte, password string) (map[string]interface{}, error)
+	Name() string
+	Version() uint
+}
+
+// NewEncryptor returns an EIP-2335 encryptor deriving keys with the given parameters, or with the
+// default parameters of the keystore library when nil. The keystore library is used as is for its
+// default parameters, as it does not let them be changed.
+func NewEncryptor(p *KDFParams) (Encryptor, error) {
+	if p == nil {
+		return keystorev4.New(), nil
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	if defaults, err := DefaultKDFParams(p.Function); err == nil && *p == *defaults {
+		return keystorev4.New(keystorev4.WithCipher(p.Function)), nil
+	}
+	cp := *p
+	return &kdfEncryptor{params: &cp}, nil
+}
+
+type kdfEncryptor struct {
+	params *KDFParams
+}
+
+// Name of the encryptor, matching the one of the keystore library.
+func (*kdfEncryptor) Name() string {
+	return keystorev4.New().Name()
+}
+
+// Version of the encryptor, matching the one of the keystore library.
+func (*kdfEncryptor) Version() uint {
+	return keystorev4.New().Version()
+}
+
+// Encrypt encrypts the secret as specified by EIP-2335, with an AES-128-CTR cipher keyed by the
+// configured key derivation function. The keystore is decrypted with the keystore library before
+// being returned, so that a keystore the library cannot decrypt is never written.
+func (e *kdfEncryptor) Encrypt(secret []byte, password string) (map[string]interface{}, error) {
+	cryptoFields, err := e.encrypt(secret, password)
+	if err != nil {
+		return nil, err
+	}
+	decrypted, err := keystorev4.New().Decrypt(cryptoFields, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt the encrypted keystore with the keystore library")
+	}
+	if !bytes.Equal(decrypted, secret) {
+		return nil, errors.New("the encrypted keystore does not decrypt to the secret with the keystore library")
+	}
+	return cryptoFields, nil
+}
+
+func (e *kdfEncryptor) encrypt(secret []byte, password string) (map[string]interface{}, error) {
+	salt := make([]byte, kdfSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "could not generate salt")
+	}
+	normedPassword := []byte(normPassword(password))
+
+	var (
+		decryptionKey []byte
+		kdfParams     map[string]interface{}
+		err           error
+	)
+	switch e.params.Function {
+	case ScryptKDF:
+		decryptionKey, err = scrypt.Key(normedPassword, salt, e.params.ScryptN, e.params.ScryptR, e.params.ScryptP, kdfKeyLength)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not derive key with scrypt")
+		}
+		kdfParams = map[string]interface{}{
+			"dklen": kdfKeyLength,
+			"n":     e.params.ScryptN,
+			"r":     e.params.ScryptR,
+			"p":     e.params.ScryptP,
+			"salt":  hex.EncodeToString(salt),
+		}
+	case PBKDF2KDF:
+		decryptionKey = pbkdf2.Key(normedPassword, salt, e.params.PBKDF2C, kdfKeyLength, sha256.New)
+		kdfParams = map[string]interface{}{
+			"dklen": kdfKeyLength,
+			"c":     e.params.PBKDF2C,
+			"prf":   "hmac-sha256",
+			"salt":  hex.EncodeToString(salt),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function %q", e.params.Function)
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, errors.Wrap(err, "could not generate initialization vector")
+	}
+	block, err := aes.NewCipher(decryptionKey[:16])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create cipher")
+	}
+	cipherMsg := make([]byte, len(secret))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherMsg, secret)
+
+	h := sha256.New()
+	h.Write(decryptionKey[16:32])
+	h.Write(cipherMsg)
+	checksum := h.Sum(nil)
+
+	return map[string]interface{}{
+		"kdf": map[string]interface{}{
+			"function": e.params.Function,
+			"params":   kdfParams,
+			"message":  "",
+		},
+		"checksum": map[string]interface{}{
+			"function": "sha256",
+			"params":   map[string]interface{}{},
+			"message":  hex.EncodeToString(checksum),
+		},
+		"cipher": map[string]interface{}{
+			"function": "aes-128-ctr",
+			"params": map[string]interface{}{
+				"iv": hex.EncodeToString(iv),
+			},
+			"message": hex.EncodeToString(cipherMsg),
+		},
+	}, nil
+}
+
+// normPassword normalizes the password as specified by EIP-2335: NFKD normalization, stripping
+// the C0 and C1 control codes and delete.
+func normPassword(password string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password))
+}
@@ -0,0 +1,129 @@
+package keymanager_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestKDFParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *keymanager.KDFParams
+		wantErr string
+	}{
+		{
+			name:   "scrypt",
+			params: &keymanager.KDFParams{Function: keymanager.ScryptKDF, ScryptN: 1 << 20, ScryptR: 8, ScryptP: 1},
+		},
+		{
+			name:   "pbkdf2",
+			params: &keymanager.KDFParams{Function: keymanager.PBKDF2KDF, PBKDF2C: 1 << 20},
+		},
+		{
+			name:    "scrypt cost too low",
+			params:  &keymanager.KDFParams{Function: keymanager.ScryptKDF, ScryptN: 1 << 10, ScryptR: 8, ScryptP: 1},
+			wantErr: "must be a power of two",
+		},
+		{
+			name:    "scrypt cost not a power of two",
+			params:  &keymanager.KDFParams{Function: keymanager.ScryptKDF, ScryptN: 1<<18 + 1, ScryptR: 8, ScryptP: 1},
+			wantErr: "must be a power of two",
+		},
+		{
+			name:    "scrypt block size",
+			params:  &keymanager.KDFParams{Function: keymanager.ScryptKDF, ScryptN: 1 << 18, ScryptP: 1},
+			wantErr: "block size",
+		},
+		{
+			name:    "pbkdf2 iterations too low",
+			params:  &keymanager.KDFParams{Function: keymanager.PBKDF2KDF, PBKDF2C: 1000},
+			wantErr: "iteration count",
+		},
+		{
+			name:    "unsupported function",
+			params:  &keymanager.KDFParams{Function: "argon2"},
+			wantErr: "unsupported key derivation function",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewEncryptor_RoundTrip(t *testing.T) {
+	secret := []byte("secret")
+	password := "password"
+	for _, params := range []*keymanager.KDFParams{
+		{Function: keymanager.ScryptKDF, ScryptN: keymanager.MinScryptN, ScryptR: 8, ScryptP: 2},
+		{Function: keymanager.PBKDF2KDF, PBKDF2C: keymanager.MinPBKDF2C},
+	} {
+		t.Run(params.Function, func(t *testing.T) {
+			encryptor, err := keymanager.NewEncryptor(params)
+			require.NoError(t, err)
+			assert.Equal(t, keystorev4.New().Name(), encryptor.Name())
+			assert.Equal(t, keystorev4.New().Version(), encryptor.Version())
+			cryptoFields, err := encryptor.Encrypt(secret, password)
+			require.NoError(t, err)
+
+			// Keystores must be decrypted by the keystore library, also once written to disk.
+			encoded, err := json.Marshal(cryptoFields)
+			require.NoError(t, err)
+			decoded := make(map[string]interface{})
+			require.NoError(t, json.Unmarshal(encoded, &decoded))
+			decrypted, err := keystorev4.New().Decrypt(decoded, password)
+			require.NoError(t, err)
+			assert.DeepEqual(t, secret, decrypted)
+			_, err = keystorev4.New().Decrypt(decoded, "wrong")
+			require.ErrorContains(t, keymanager.IncorrectPasswordErrMsg, err)
+
+			got, err := keymanager.KDFParamsFromCrypto(decoded)
+			require.NoError(t, err)
+			assert.DeepEqual(t, params, got)
+			got, err = keymanager.KDFParamsFromCrypto(cryptoFields)
+			require.NoError(t, err)
+			assert.DeepEqual(t, params, got)
+		})
+	}
+}
+
+func TestNewEncryptor_DefaultParams(t *testing.T) {
+	params, err := keymanager.DefaultKDFParams(keymanager.PBKDF2KDF)
+	require.NoError(t, err)
+	encryptor, err := keymanager.NewEncryptor(params)
+	require.NoError(t, err)
+	_, ok := encryptor.(*keystorev4.Encryptor)
+	assert.Equal(t, true, ok)
+	cryptoFields, err := encryptor.Encrypt([]byte("secret"), "password")
+	require.NoError(t, err)
+	got, err := keymanager.KDFParamsFromCrypto(cryptoFields)
+	require.NoError(t, err)
+	assert.DeepEqual(t, params, got)
+}
+
+func TestNewEncryptor_InvalidParams(t *testing.T) {
+	_, err := keymanager.NewEncryptor(&keymanager.KDFParams{Function: keymanager.PBKDF2KDF, PBKDF2C: 1})
+	require.ErrorContains(t, "iteration count", err)
+}
+
+func TestKDFParamsFromCrypto_DefaultEncryptor(t *testing.T) {
+	encryptor, err := keymanager.NewEncryptor(nil)
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt([]byte("secret"), "password")
+	require.NoError(t, err)
+	got, err := keymanager.KDFParamsFromCrypto(cryptoFields)
+	require.NoError(t, err)
+	assert.Equal(t, keymanager.ScryptKDF, got.Function)
+	require.NoError(t, got.Validate())
+}
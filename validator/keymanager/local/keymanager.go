@@ -44,6 +44,7 @@ type Keymanager struct {
 	wallet              iface.Wallet
 	accountsStore       *accountStore
 	accountsChangedFeed *event.Feed
+	kdfParams           *keymanager.KDFParams
 }
 
 // SetupConfig includes configuration values for initializing
@@ -51,6 +52,9 @@ type Keymanager struct {
 type SetupConfig struct {
 	Wallet           iface.Wallet
 	ListenForChanges bool
+	// KDFParams configures the key derivation function the accounts keystore is encrypted with.
+	// When nil, the parameters of the existing accounts keystore are kept.
+	KDFParams *keymanager.KDFParams
 }
 
 // Defines a struct containing 1-to-1 corresponding
@@ -91,6 +95,7 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		wallet:              cfg.Wallet,
 		accountsStore:       &accountStore{},
 		accountsChangedFeed: new(event.Feed),
+		kdfParams:           cfg.KDFParams,
 	}
 
 	if err := k.initializeAccountKeystore(ctx); err != nil {
@@ -234,34 +239,74 @@ func (km *Keymanager) initializeAccountKeystore(ctx context.Context) error {
 	if err := json.Unmarshal(encoded, keystoreFile); err != nil {
 		return errors.Wrapf(err, "could not decode keystore file for accounts %s", AccountsKeystoreFileName)
 	}
+	if km.kdfParams == nil {
+		// Keep encrypting the accounts keystore as strongly as it was.
+		if params, err := keymanager.KDFParamsFromCrypto(keystoreFile.Crypto); err == nil && params.Validate() == nil {
+			km.kdfParams = params
+		}
+	}
+	store, err := decryptAccountsStore(keystoreFile, km.wallet.Password())
+	if err != nil {
+		return err
+	}
+	if len(store.PublicKeys) == 0 {
+		return nil
+	}
+	km.accountsStore = store
+	err = km.initializeKeysCachesFromKeystore()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize keys caches")
+	}
+	return err
+}
+
+// DecryptAccountsKeystore decrypts the accounts keystore of a wallet with the wallet password,
+// and returns the private and public keys of the accounts it holds.
+func DecryptAccountsKeystore(keystoreFile *AccountsKeystoreRepresentation, password string) (privateKeys, publicKeys [][]byte, err error) {
+	store, err := decryptAccountsStore(keystoreFile, password)
+	if err != nil {
+		return nil, nil, err
+	}
+	return store.PrivateKeys, store.PublicKeys, nil
+}
+
+// ReEncryptAccountsKeystore decrypts the accounts keystore of a wallet with the wallet password, and encrypts the
+// accounts it holds again with the given key derivation function parameters.
+func ReEncryptAccountsKeystore(
+	ctx context.Context, keystoreFile *AccountsKeystoreRepresentation, password string, kdfParams *keymanager.KDFParams,
+) (*AccountsKeystoreRepresentation, error) {
+	store, err := decryptAccountsStore(keystoreFile, password)
+	if err != nil {
+		return nil, err
+	}
+	reEncrypted, err := CreateAccountsKeystoreRepresentation(ctx, store, password, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+	reEncrypted.ID = keystoreFile.ID
+	return reEncrypted, nil
+}
+
+func decryptAccountsStore(keystoreFile *AccountsKeystoreRepresentation, password string) (*accountStore, error) {
 	// We extract the validator signing private key from the keystore
 	// by utilizing the password and initialize a new BLS secret key from
 	// its raw bytes.
-	password := km.wallet.Password()
 	decryptor := keystorev4.New()
 	enc, err := decryptor.Decrypt(keystoreFile.Crypto, password)
 	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
-		return errors.Wrap(err, "wrong password for wallet entered")
+		return nil, errors.Wrap(err, "wrong password for wallet entered")
 	} else if err != nil {
-		return errors.Wrap(err, "could not decrypt keystore")
+		return nil, errors.Wrap(err, "could not decrypt keystore")
 	}
 
 	store := &accountStore{}
 	if err := json.Unmarshal(enc, store); err != nil {
-		return err
+		return nil, err
 	}
 	if len(store.PublicKeys) != len(store.PrivateKeys) {
-		return errors.New("unequal number of public keys and private keys")
+		return nil, errors.New("unequal number of public keys and private keys")
 	}
-	if len(store.PublicKeys) == 0 {
-		return nil
-	}
-	km.accountsStore = store
-	err = km.initializeKeysCachesFromKeystore()
-	if err != nil {
-		return errors.Wrap(err, "failed to initialize keys caches")
-	}
-	return err
+	return store, nil
 }
 
 // CreateAccountsKeystore creates a new keystore holding the provided keys.
@@ -269,13 +314,13 @@ func (km *Keymanager) CreateAccountsKeystore(ctx context.Context, privateKeys []
 	if err := km.CreateOrUpdateInMemoryAccountsStore(ctx, privateKeys, publicKeys); err != nil {
 		return nil, err
 	}
-	return CreateAccountsKeystoreRepresentation(ctx, km.accountsStore, km.wallet.Password())
+	return CreateAccountsKeystoreRepresentation(ctx, km.accountsStore, km.wallet.Password(), km.kdfParams)
 }
 
 // SaveStoreAndReInitialize saves the store to disk and re-initializes the account keystore from file
 func (km *Keymanager) SaveStoreAndReInitialize(ctx context.Context, store *accountStore) error {
 	// Save the copy to disk
-	accountsKeystore, err := CreateAccountsKeystoreRepresentation(ctx, store, km.wallet.Password())
+	accountsKeystore, err := CreateAccountsKeystoreRepresentation(ctx, store, km.wallet.Password(), km.kdfParams)
 	if err != nil {
 		return err
 	}
@@ -309,12 +354,17 @@ func (km *Keymanager) SaveStoreAndReInitialize(ctx context.Context, store *accou
 }
 
 // CreateAccountsKeystoreRepresentation is a pure function that takes an accountStore and wallet password and returns the encrypted formatted json version for local writing.
+// The accounts are encrypted with the given key derivation function parameters, or the default ones when nil.
 func CreateAccountsKeystoreRepresentation(
 	_ context.Context,
 	store *accountStore,
 	walletPW string,
+	kdfParams *keymanager.KDFParams,
 ) (*AccountsKeystoreRepresentation, error) {
-	encryptor := keystorev4.New()
+	encryptor, err := keymanager.NewEncryptor(kdfParams)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid key derivation function parameters")
+	}
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -336,10 +386,12 @@ func CreateAccountsKeystoreRepresentation(
 }
 
 // CreateEmptyKeyStoreRepresentationForNewWallet creates a placeholder accounts keystore for a new Prysm Local Wallet.
-func CreateEmptyKeyStoreRepresentationForNewWallet(ctx context.Context, walletPassword string) (*AccountsKeystoreRepresentation, error) {
+func CreateEmptyKeyStoreRepresentationForNewWallet(
+	ctx context.Context, walletPassword string, kdfParams *keymanager.KDFParams,
+) (*AccountsKeystoreRepresentation, error) {
 	// make sure everything is clean when creating this.
 	ResetCaches()
-	return CreateAccountsKeystoreRepresentation(ctx, &accountStore{}, walletPassword)
+	return CreateAccountsKeystoreRepresentation(ctx, &accountStore{}, walletPassword, kdfParams)
 }
 
 // CreateOrUpdateInMemoryAccountsStore will set or update the local accounts store and update the local cache.
@@ -45,6 +45,10 @@ type SetupConfig struct {
 	BaseEndpoint          string
 	GenesisValidatorsRoot []byte
 
+	// SecondaryBaseEndpoint is an optional web3signer used in place of the one of BaseEndpoint when it does not
+	// sign within the deadline of a duty.
+	SecondaryBaseEndpoint string
+
 	// Either URL or keylist must be set.
 	// If the URL is set, the keymanager will fetch the public keys from the URL.
 	// caution: this option is susceptible to slashing if the web3signer's validator keys are shared across validators
@@ -59,6 +63,7 @@ type SetupConfig struct {
 // Keymanager defines the web3signer keymanager.
 type Keymanager struct {
 	client                internal.HttpSignerClient
+	secondaryClient       internal.HttpSignerClient
 	genesisValidatorsRoot []byte
 	providedPublicKeys    [][48]byte          // (source of truth) flag loaded + file loaded + api loaded keys
 	flagLoadedKeysMap     map[string][48]byte // stores what was provided from flag ( as opposed to from file )
@@ -66,6 +71,7 @@ type Keymanager struct {
 	validator             *validator.Validate
 	retriesRemaining      int
 	keyFilePath           string
+	baseEndpoint          string
 	lock                  sync.RWMutex
 }
 
@@ -88,6 +94,14 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		validator:             validator.New(),
 		retriesRemaining:      maxRetries,
 		keyFilePath:           cfg.KeyFilePath,
+		baseEndpoint:          cfg.BaseEndpoint,
+	}
+	if cfg.SecondaryBaseEndpoint != "" {
+		secondaryClient, err := internal.NewApiClient(cfg.SecondaryBaseEndpoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create secondary apiClient")
+		}
+		km.secondaryClient = secondaryClient
 	}
 
 	keyFileExists := false
@@ -376,12 +390,30 @@ func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldpara
 
 // Sign signs the message by using a remote web3signer server.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
+	return km.sign(ctx, km.client, request)
+}
+
+// HasSecondarySigner returns whether a secondary web3signer is configured.
+func (km *Keymanager) HasSecondarySigner() bool {
+	return km.secondaryClient != nil
+}
+
+// SignWithSecondary signs the message by using the secondary web3signer server.
+func (km *Keymanager) SignWithSecondary(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
+	if km.secondaryClient == nil {
+		return nil, errors.New("no secondary web3signer configured")
+	}
+	secondarySignRequestsTotal.Inc()
+	return km.sign(ctx, km.secondaryClient, request)
+}
+
+func (km *Keymanager) sign(ctx context.Context, client internal.HttpSignerClient, request *validatorpb.SignRequest) (bls.Signature, error) {
 	signRequest, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
 	if err != nil {
 		erroredResponsesTotal.Inc()
 		return nil, err
 	}
-	signature, err := km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
+	signature, err := client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
 	if err != nil {
 		erroredResponsesTotal.Inc()
 		return nil, errors.Wrap(err, "failed to sign the request")
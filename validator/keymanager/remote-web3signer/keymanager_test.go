@@ -493,6 +493,39 @@ func TestKeymanager_Sign(t *testing.T) {
 
 }
 
+func TestKeymanager_SignWithSecondary(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    []string{"0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, km.HasSecondarySigner())
+	_, err = km.SignWithSecondary(ctx, mock.GetMockSignRequest("ATTESTATION"))
+	require.ErrorContains(t, "no secondary web3signer configured", err)
+
+	km, err = NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		SecondaryBaseEndpoint: "http://secondary.example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    []string{"0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, true, km.HasSecondarySigner())
+	secondary := &MockClient{
+		Signature: "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9",
+	}
+	km.secondaryClient = secondary
+	desiredSigBytes, err := hexutil.Decode(secondary.Signature)
+	require.NoError(t, err)
+	got, err := km.SignWithSecondary(ctx, mock.GetMockSignRequest("ATTESTATION"))
+	require.NoError(t, err)
+	require.DeepEqual(t, desiredSigBytes, got.Marshal())
+}
+
 func TestKeymanager_FetchValidatingPublicKeys_HappyPath_WithKeyList(t *testing.T) {
 	ctx := context.Background()
 	decodedKey, err := hexutil.Decode("0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820")
@@ -0,0 +1,97 @@
+package remote_web3signer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/sirupsen/logrus"
+)
+
+// Manifest maps remote public keys to the web3signer holding their private keys, to import them in bulk.
+// The URL of a key defaults to the URL of the manifest.
+type Manifest struct {
+	URL        string         `json:"url"`
+	RemoteKeys []*ManifestKey `json:"remote_keys"`
+}
+
+// ManifestKey is a remote public key of a manifest.
+type ManifestKey struct {
+	Pubkey string `json:"pubkey"`
+	URL    string `json:"url,omitempty"`
+}
+
+// ManifestReport summarizes the import of a manifest, with the status of each of its keys in order.
+type ManifestReport struct {
+	Statuses  []*keymanager.KeyStatus
+	Imported  int
+	Duplicate int
+	Failed    int
+}
+
+// ParseManifest decodes a JSON manifest.
+func ParseManifest(enc []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := json.Unmarshal(enc, m); err != nil {
+		return nil, errors.Wrap(err, "could not decode remote keys manifest")
+	}
+	if len(m.RemoteKeys) == 0 {
+		return nil, errors.New("remote keys manifest has no keys")
+	}
+	return m, nil
+}
+
+// ImportManifest imports the keys of the manifest signed by the web3signer of the keymanager. Keys signed by
+// another web3signer, and malformed or duplicate keys, are reported and skipped, the other keys being imported
+// in a single update.
+func (km *Keymanager) ImportManifest(m *Manifest) (*ManifestReport, error) {
+	report := &ManifestReport{Statuses: make([]*keymanager.KeyStatus, len(m.RemoteKeys))}
+	pubKeys := make([]string, 0, len(m.RemoteKeys))
+	indices := make([]int, 0, len(m.RemoteKeys))
+	for i, k := range m.RemoteKeys {
+		url := k.URL
+		if url == "" {
+			url = m.URL
+		}
+		if url != "" && !sameEndpoint(url, km.baseEndpoint) {
+			report.Statuses[i] = &keymanager.KeyStatus{
+				Status:  keymanager.StatusError,
+				Message: fmt.Sprintf("url %s does not match the url of the remote signer %s", url, km.baseEndpoint),
+			}
+			continue
+		}
+		pubKeys = append(pubKeys, k.Pubkey)
+		indices = append(indices, i)
+	}
+	if len(pubKeys) > 0 {
+		statuses, err := km.AddPublicKeys(pubKeys)
+		if err != nil {
+			return nil, err
+		}
+		for j, status := range statuses {
+			report.Statuses[indices[j]] = status
+		}
+	}
+	for _, status := range report.Statuses {
+		switch status.Status {
+		case keymanager.StatusImported:
+			report.Imported++
+		case keymanager.StatusDuplicate:
+			report.Duplicate++
+		default:
+			report.Failed++
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"imported":  report.Imported,
+		"duplicate": report.Duplicate,
+		"failed":    report.Failed,
+	}).Info("Imported remote keys manifest")
+	return report, nil
+}
+
+func sameEndpoint(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
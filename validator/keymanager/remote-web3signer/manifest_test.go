@@ -0,0 +1,64 @@
+package remote_web3signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest([]byte(`{"url":"http://example.com","remote_keys":[{"pubkey":"0xa2"},{"pubkey":"0xb3","url":"http://other.com"}]}`))
+	require.NoError(t, err)
+	require.Equal(t, "http://example.com", m.URL)
+	require.Equal(t, 2, len(m.RemoteKeys))
+	require.Equal(t, "http://other.com", m.RemoteKeys[1].URL)
+
+	_, err = ParseManifest([]byte(`{"url":"http://example.com"}`))
+	require.ErrorContains(t, "no keys", err)
+	_, err = ParseManifest([]byte(`not json`))
+	require.ErrorContains(t, "could not decode remote keys manifest", err)
+}
+
+func TestKeymanager_ImportManifest(t *testing.T) {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+	})
+	require.NoError(t, err)
+	key1 := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	key2 := "0x8000091c2ae64ee414a54c1cc1fc67dec663408bc636cb86756e0200e41a75c8f86603f104f02c856983d2783116be13"
+	key3 := "0x8ac10fcb8a59c0b7ad12da0a2fbe3d91d7c47b2b3e96ae7b6cae4e0d0a1c5a0fc55f3b5c30e0ad2b5b1f4e6e0e1d4f3a"
+	m := &Manifest{
+		URL: "http://example.com/",
+		RemoteKeys: []*ManifestKey{
+			{Pubkey: key1},
+			{Pubkey: key2, URL: "http://example.com"},
+			{Pubkey: key3, URL: "http://other.com"},
+			{Pubkey: "0xa2b5"},
+			{Pubkey: key1},
+		},
+	}
+	report, err := km.ImportManifest(m)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Imported)
+	require.Equal(t, 1, report.Duplicate)
+	require.Equal(t, 2, report.Failed)
+	wantStatuses := []keymanager.KeyStatusType{
+		keymanager.StatusImported,
+		keymanager.StatusImported,
+		keymanager.StatusError,
+		keymanager.StatusError,
+		keymanager.StatusDuplicate,
+	}
+	for i, status := range report.Statuses {
+		require.Equal(t, wantStatuses[i], status.Status)
+	}
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+}
@@ -10,6 +10,10 @@ var (
 		Name: "remote_web3signer_sign_requests_total",
 		Help: "Total number of sign requests",
 	})
+	secondarySignRequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_web3signer_secondary_sign_requests_total",
+		Help: "Total number of sign requests sent to the secondary web3signer",
+	})
 	erroredResponsesTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "remote_web3signer_errored_responses_total",
 		Help: "Total number of errored responses when calling web3signer",
@@ -37,6 +37,13 @@ type Signer interface {
 	Sign(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
 }
 
+// SecondarySigner can sign with a secondary signer, used in place of the primary one when it is too slow or
+// unavailable.
+type SecondarySigner interface {
+	HasSecondarySigner() bool
+	SignWithSecondary(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
+}
+
 // Importer can import new keystores into the keymanager.
 type Importer interface {
 	ImportKeystores(
@@ -0,0 +1,147 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+const (
+	getGenesisPath             = "/eth/v1/beacon/genesis"
+	getBootstrapPath           = "/eth/v1/beacon/light_client/bootstrap/%#x"
+	getUpdatesPath             = "/eth/v1/beacon/light_client/updates"
+	getFinalityUpdatePath      = "/eth/v1/beacon/light_client/finality_update"
+	getBlockHeaderPath         = "/eth/v1/beacon/headers/%d"
+	apiTimeout                 = 30 * time.Second
+	maxRequestLightClientCount = 128
+)
+
+// api fetches the light client data and the block headers of the beacon node through its Beacon API.
+type api struct {
+	c *beacon.Client
+}
+
+func newAPI(url string) (*api, error) {
+	c, err := beacon.NewClient(url, client.WithTimeout(apiTimeout))
+	if err != nil {
+		return nil, err
+	}
+	return &api{c: c}, nil
+}
+
+func (a *api) get(ctx context.Context, path string, v interface{}, opts ...client.ReqOption) error {
+	b, err := a.c.Get(ctx, path, opts...)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errors.Wrapf(err, "could not decode response of %s", path)
+	}
+	return nil
+}
+
+// genesis returns the genesis time and the genesis validators root of the chain of the beacon node.
+func (a *api) genesis(ctx context.Context) (uint64, []byte, error) {
+	resp := &structs.GetGenesisResponse{}
+	if err := a.get(ctx, getGenesisPath, resp); err != nil {
+		return 0, nil, err
+	}
+	if resp.Data == nil {
+		return 0, nil, errors.New("empty genesis response")
+	}
+	genesisTime, err := strconv.ParseUint(resp.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "invalid genesis time")
+	}
+	gvr, err := hexutil.Decode(resp.Data.GenesisValidatorsRoot)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "invalid genesis validators root")
+	}
+	return genesisTime, gvr, nil
+}
+
+// finalizedRoot returns the root of the finalized checkpoint of the head of the beacon node.
+func (a *api) finalizedRoot(ctx context.Context) ([32]byte, error) {
+	cp, err := a.c.GetFinalityCheckpoints(ctx, beacon.IdHead)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return bytesutil.ToBytes32(cp.Root), nil
+}
+
+func (a *api) bootstrap(ctx context.Context, root [32]byte) (*structs.LightClientBootstrap, error) {
+	resp := &structs.LightClientBootstrapResponse{}
+	if err := a.get(ctx, fmt.Sprintf(getBootstrapPath, root), resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// updates returns the best light client updates of count sync committee periods, from the start period.
+func (a *api) updates(ctx context.Context, start, count uint64) ([]*structs.LightClientUpdate, error) {
+	var resp []*structs.LightClientUpdateResponse
+	query := func(req *http.Request) {
+		q := req.URL.Query()
+		q.Set("start_period", strconv.FormatUint(start, 10))
+		q.Set("count", strconv.FormatUint(min(count, maxRequestLightClientCount), 10))
+		req.URL.RawQuery = q.Encode()
+	}
+	if err := a.get(ctx, getUpdatesPath, &resp, query); err != nil {
+		return nil, err
+	}
+	updates := make([]*structs.LightClientUpdate, 0, len(resp))
+	for _, u := range resp {
+		if u != nil {
+			updates = append(updates, u.Data)
+		}
+	}
+	return updates, nil
+}
+
+func (a *api) finalityUpdate(ctx context.Context) (*structs.LightClientUpdate, error) {
+	resp := &structs.LightClientFinalityUpdateResponse{}
+	if err := a.get(ctx, getFinalityUpdatePath, resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty finality update response")
+	}
+	return &structs.LightClientUpdate{
+		AttestedHeader:  resp.Data.AttestedHeader,
+		FinalizedHeader: resp.Data.FinalizedHeader,
+		FinalityBranch:  resp.Data.FinalityBranch,
+		SyncAggregate:   resp.Data.SyncAggregate,
+		SignatureSlot:   resp.Data.SignatureSlot,
+	}, nil
+}
+
+// blockRoot returns the root of the canonical block of the beacon node at the slot, or ok false if the slot is
+// empty in its chain.
+func (a *api) blockRoot(ctx context.Context, slot primitives.Slot) (root [32]byte, ok bool, err error) {
+	resp := &structs.GetBlockHeaderResponse{}
+	if err := a.get(ctx, fmt.Sprintf(getBlockHeaderPath, slot), resp); err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return [32]byte{}, false, nil
+		}
+		return [32]byte{}, false, err
+	}
+	if resp.Data == nil {
+		return [32]byte{}, false, errors.New("empty block header response")
+	}
+	r, err := hexutil.Decode(resp.Data.Root)
+	if err != nil {
+		return [32]byte{}, false, errors.Wrap(err, "invalid block root")
+	}
+	return bytesutil.ToBytes32(r), true, nil
+}
@@ -0,0 +1,13 @@
+/*
+Package lightclient defines a runtime service of the validator client which verifies its
+beacon node with an embedded light client. The light client bootstraps from a trusted
+block root, and follows the finalized headers signed by a supermajority of the sync
+committee, using the light client data served by the beacon node.
+
+Every epoch, the service checks that the beacon node serves the finalized header of the
+light client as the block of its canonical chain at that slot. A beacon node serving
+light client data which fails verification, or a chain inconsistent with the light
+client, is reported by a metric and an error log, as the duties it serves can not be
+trusted.
+*/
+package lightclient
@@ -0,0 +1,19 @@
+package lightclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	finalizedSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "validator",
+		Name:      "light_client_finalized_slot",
+		Help:      "The slot of the finalized header of the embedded light client",
+	})
+	inconsistentGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "validator",
+		Name:      "light_client_beacon_node_inconsistent",
+		Help:      "1 if the beacon node serves data inconsistent with the embedded light client, 0 otherwise",
+	})
+)
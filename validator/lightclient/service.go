@@ -0,0 +1,202 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Config contains the beacon node to verify, and the block root the light client bootstraps from.
+type Config struct {
+	BeaconApiEndpoint string
+	// TrustedBlockRoot is the root of the bootstrap block. When zero, the finalized block of the beacon node is trusted.
+	TrustedBlockRoot [32]byte
+}
+
+// Service follows the finalized chain with a light client, and verifies every epoch that the beacon node is
+// consistent with it.
+type Service struct {
+	cfg          *Config
+	ctx          context.Context
+	cancel       context.CancelFunc
+	api          *api
+	genesisTime  uint64
+	store        *store
+	inconsistent atomic.Bool
+}
+
+// NewService returns a light client verification service of the beacon node at the Beacon API endpoint.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	a, err := newAPI(cfg.BeaconApiEndpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create beacon API client")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+		api:    a,
+	}, nil
+}
+
+// Start the light client verification service.
+func (s *Service) Start() {
+	log.WithField("beaconNode", s.cfg.BeaconApiEndpoint).Info("Starting light client verification of the beacon node")
+	go s.run()
+}
+
+// Stop the light client verification service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the light client verification service, reporting a beacon node inconsistent with the light client.
+func (s *Service) Status() error {
+	if s.inconsistent.Load() {
+		return errors.New("beacon node is inconsistent with the light client")
+	}
+	return nil
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot*uint64(params.BeaconConfig().SlotsPerEpoch)) * time.Second)
+	defer ticker.Stop()
+	for {
+		s.verify(s.ctx)
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// verify brings the light client up to date with the beacon node, and checks that the beacon node serves the
+// finalized header of the light client in its canonical chain.
+func (s *Service) verify(ctx context.Context) {
+	if err := s.sync(ctx); err != nil {
+		if errors.Is(err, errInvalidUpdate) {
+			s.report(err.Error())
+			return
+		}
+		log.WithError(err).Warn("Could not sync the light client with the beacon node")
+		return
+	}
+	want, err := s.store.finalized.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Error("Could not compute the finalized header root")
+		return
+	}
+	root, ok, err := s.api.blockRoot(ctx, s.store.finalized.Slot)
+	if err != nil {
+		log.WithError(err).Warn("Could not get the block header of the beacon node")
+		return
+	}
+	switch {
+	case !ok:
+		s.report("no block at the slot of the light client finalized header")
+	case root != want:
+		s.report("the block at the slot of the light client finalized header has another root")
+	default:
+		s.resolve()
+	}
+}
+
+// sync bootstraps the light client on first use, and applies the light client updates of the beacon node.
+func (s *Service) sync(ctx context.Context) error {
+	if s.store == nil {
+		if err := s.bootstrap(ctx); err != nil {
+			return err
+		}
+	}
+	current := max(period(slots.CurrentSlot(s.genesisTime)), s.store.period())
+	if s.store.next == nil || s.store.period() < current {
+		updates, err := s.api.updates(ctx, s.store.period(), current-s.store.period()+1)
+		if err != nil {
+			return errors.Wrap(err, "could not get light client updates")
+		}
+		for _, u := range updates {
+			if err := s.process(u); err != nil {
+				return err
+			}
+		}
+	}
+	u, err := s.api.finalityUpdate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get light client finality update")
+	}
+	if err := s.process(u); err != nil {
+		return err
+	}
+	finalizedSlotGauge.Set(float64(s.store.finalized.Slot))
+	return nil
+}
+
+func (s *Service) process(u *structs.LightClientUpdate) error {
+	d, err := decodeUpdate(u)
+	if err != nil {
+		return errors.Wrap(err, "could not decode light client update")
+	}
+	if err := s.store.process(d); err != nil {
+		if errors.Is(err, errInvalidUpdate) {
+			return err
+		}
+		log.WithError(err).Debug("Skipping light client update")
+	}
+	return nil
+}
+
+func (s *Service) bootstrap(ctx context.Context) error {
+	genesisTime, gvr, err := s.api.genesis(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis")
+	}
+	root := s.cfg.TrustedBlockRoot
+	if root == [32]byte{} {
+		if root, err = s.api.finalizedRoot(ctx); err != nil {
+			return errors.Wrap(err, "could not get the finalized checkpoint of the beacon node")
+		}
+		log.WithField("root", fmt.Sprintf("%#x", root)).Warn("Bootstrapping the light client from the finalized block of the beacon node, " +
+			"which is only verified against itself. Set a trusted block root obtained from an independent source")
+	}
+	b, err := s.api.bootstrap(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get light client bootstrap")
+	}
+	st, err := newStore(root, gvr, b)
+	if err != nil {
+		return err
+	}
+	s.genesisTime, s.store = genesisTime, st
+	log.WithFields(logrus.Fields{
+		"root": fmt.Sprintf("%#x", root),
+		"slot": st.finalized.Slot,
+	}).Info("Bootstrapped light client")
+	return nil
+}
+
+func (s *Service) report(reason string) {
+	inconsistentGauge.Set(1)
+	s.inconsistent.Store(true)
+	fields := logrus.Fields{"reason": reason}
+	if s.store != nil {
+		fields["lightClientFinalizedSlot"] = s.store.finalized.Slot
+	}
+	log.WithFields(fields).Error("Beacon node serves data inconsistent with the light client, its duties can not be trusted")
+}
+
+func (s *Service) resolve() {
+	inconsistentGauge.Set(0)
+	if s.inconsistent.Swap(false) {
+		log.WithField("lightClientFinalizedSlot", s.store.finalized.Slot).Info("Beacon node is consistent with the light client again")
+	}
+}
@@ -0,0 +1,97 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// testBeaconNode serves the light client data of the test chain, and the given root as its block at slot 96.
+func testBeaconNode(t *testing.T, c *testChain, root [32]byte, update *structs.LightClientUpdate) *httptest.Server {
+	trusted, bootstrap := c.bootstrap(64)
+	genesis := time.Now().Add(-130 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second).Unix()
+	responses := map[string]interface{}{
+		getGenesisPath: &structs.GetGenesisResponse{Data: &structs.Genesis{
+			GenesisTime:           strconv.FormatInt(genesis, 10),
+			GenesisValidatorsRoot: hexutil.Encode(testGVR),
+		}},
+		"/eth/v1/beacon/states/head/finality_checkpoints": &structs.GetFinalityCheckpointsResponse{Data: &structs.FinalityCheckpoints{
+			Finalized: &structs.Checkpoint{Epoch: "2", Root: hexutil.Encode(trusted[:])},
+		}},
+		fmt.Sprintf(getBootstrapPath, trusted): &structs.LightClientBootstrapResponse{Data: bootstrap},
+		getUpdatesPath:                         []*structs.LightClientUpdateResponse{{Data: update}},
+		getFinalityUpdatePath: &structs.LightClientFinalityUpdateResponse{Data: &structs.LightClientFinalityUpdate{
+			AttestedHeader:  update.AttestedHeader,
+			FinalizedHeader: update.FinalizedHeader,
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   update.SyncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		}},
+		fmt.Sprintf(getBlockHeaderPath, 96): &structs.GetBlockHeaderResponse{Data: &structs.SignedBeaconBlockHeaderContainer{
+			Root: hexutil.Encode(root[:]),
+		}},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestService_Verify(t *testing.T) {
+	c := newTestChain(t)
+	update := c.update(96, 128, c.keys, params.BeaconConfig().SyncCommitteeSize)
+	d, err := decodeUpdate(update)
+	require.NoError(t, err)
+	finalizedRoot, err := d.finalized.HashTreeRoot()
+	require.NoError(t, err)
+
+	t.Run("consistent", func(t *testing.T) {
+		srv := testBeaconNode(t, c, finalizedRoot, update)
+		s, err := NewService(context.Background(), &Config{BeaconApiEndpoint: srv.URL})
+		require.NoError(t, err)
+		s.verify(context.Background())
+		require.NotNil(t, s.store)
+		assert.Equal(t, d.finalized.Slot, s.store.finalized.Slot)
+		require.NoError(t, s.Status())
+	})
+	t.Run("inconsistent block", func(t *testing.T) {
+		srv := testBeaconNode(t, c, [32]byte{1}, update)
+		s, err := NewService(context.Background(), &Config{BeaconApiEndpoint: srv.URL})
+		require.NoError(t, err)
+		s.verify(context.Background())
+		require.ErrorContains(t, "inconsistent with the light client", s.Status())
+	})
+	t.Run("invalid update", func(t *testing.T) {
+		forged := c.update(96, 128, c.keys[:1], params.BeaconConfig().SyncCommitteeSize)
+		srv := testBeaconNode(t, c, finalizedRoot, forged)
+		s, err := NewService(context.Background(), &Config{BeaconApiEndpoint: srv.URL})
+		require.NoError(t, err)
+		s.verify(context.Background())
+		require.ErrorContains(t, "inconsistent with the light client", s.Status())
+	})
+	t.Run("untrusted bootstrap", func(t *testing.T) {
+		srv := testBeaconNode(t, c, finalizedRoot, update)
+		s, err := NewService(context.Background(), &Config{BeaconApiEndpoint: srv.URL, TrustedBlockRoot: [32]byte{1}})
+		require.NoError(t, err)
+		s.verify(context.Background())
+		assert.Equal(t, true, s.store == nil)
+		require.NoError(t, s.Status())
+	})
+}
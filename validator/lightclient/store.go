@@ -0,0 +1,320 @@
+package lightclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Generalized indices of the light client proofs in the beacon state, which gained fields in Electra.
+const (
+	currentSyncCommitteeIndex        = uint64(54)
+	nextSyncCommitteeIndex           = uint64(55)
+	finalizedRootIndex               = uint64(105)
+	currentSyncCommitteeIndexElectra = uint64(86)
+	nextSyncCommitteeIndexElectra    = uint64(87)
+	finalizedRootIndexElectra        = uint64(169)
+)
+
+// errInvalidUpdate is returned for light client data failing verification, which a beacon node following the
+// canonical chain does not serve.
+var errInvalidUpdate = errors.New("invalid light client data")
+
+// store is the view of the chain of the light client: the latest finalized header proven by a sync committee
+// supermajority, and the sync committees to verify the next headers with.
+type store struct {
+	gvr       []byte
+	finalized *ethpb.BeaconBlockHeader
+	current   *ethpb.SyncCommittee
+	// next is nil until an update of the current period proves it.
+	next *ethpb.SyncCommittee
+}
+
+// period returns the sync committee period of the finalized header.
+func (s *store) period() uint64 {
+	return period(s.finalized.Slot)
+}
+
+func period(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// newStore initializes a light client store from the bootstrap of the trusted block root, following
+// initialize_light_client_store of the light client specification.
+func newStore(trustedRoot [32]byte, gvr []byte, b *structs.LightClientBootstrap) (*store, error) {
+	if b == nil {
+		return nil, errors.New("empty light client bootstrap")
+	}
+	header, err := beaconHeader(b.Header)
+	if err != nil {
+		return nil, err
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if root != trustedRoot {
+		return nil, errors.Wrapf(errInvalidUpdate, "bootstrap header root %#x is not the trusted block root %#x", root, trustedRoot)
+	}
+	if b.CurrentSyncCommittee == nil {
+		return nil, errors.Wrap(errInvalidUpdate, "bootstrap has no current sync committee")
+	}
+	committee, err := b.CurrentSyncCommittee.ToConsensus()
+	if err != nil {
+		return nil, errors.Wrap(errInvalidUpdate, err.Error())
+	}
+	depth, index := uint64(fieldparams.SyncCommitteeBranchDepth), currentSyncCommitteeIndex
+	if isElectra(header.Slot) {
+		depth, index = fieldparams.SyncCommitteeBranchDepthElectra, currentSyncCommitteeIndexElectra
+	}
+	proof, err := decodeBranch(b.CurrentSyncCommitteeBranch)
+	if err != nil {
+		return nil, errors.Wrap(errInvalidUpdate, err.Error())
+	}
+	if err := verifyBranch(header.StateRoot, committee, proof, depth, index); err != nil {
+		return nil, errors.Wrap(err, "current sync committee")
+	}
+	return &store{gvr: gvr, finalized: header, current: committee}, nil
+}
+
+// update is a light client update decoded from the Beacon API. The finalized header and the next sync committee
+// are nil when the update does not prove them.
+type update struct {
+	attested      *ethpb.BeaconBlockHeader
+	finalized     *ethpb.BeaconBlockHeader
+	finalityProof [][]byte
+	next          *ethpb.SyncCommittee
+	nextProof     [][]byte
+	bits          []byte
+	signature     []byte
+	signatureSlot primitives.Slot
+}
+
+func decodeUpdate(u *structs.LightClientUpdate) (*update, error) {
+	if u == nil || u.SyncAggregate == nil {
+		return nil, errors.New("empty light client update")
+	}
+	var err error
+	d := &update{}
+	if d.attested, err = beaconHeader(u.AttestedHeader); err != nil {
+		return nil, errors.Wrap(err, "attested header")
+	}
+	if len(u.FinalizedHeader) != 0 {
+		if d.finalized, err = beaconHeader(u.FinalizedHeader); err != nil {
+			return nil, errors.Wrap(err, "finalized header")
+		}
+		if d.finalityProof, err = decodeBranch(u.FinalityBranch); err != nil {
+			return nil, errors.Wrap(err, "finality branch")
+		}
+	}
+	if u.NextSyncCommittee != nil {
+		if d.next, err = u.NextSyncCommittee.ToConsensus(); err != nil {
+			return nil, errors.Wrap(err, "next sync committee")
+		}
+		if d.nextProof, err = decodeBranch(u.NextSyncCommitteeBranch); err != nil {
+			return nil, errors.Wrap(err, "next sync committee branch")
+		}
+	}
+	if d.bits, err = hexutil.Decode(u.SyncAggregate.SyncCommitteeBits); err != nil {
+		return nil, errors.Wrap(err, "sync committee bits")
+	}
+	if d.signature, err = hexutil.Decode(u.SyncAggregate.SyncCommitteeSignature); err != nil {
+		return nil, errors.Wrap(err, "sync committee signature")
+	}
+	s, err := strconv.ParseUint(u.SignatureSlot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "signature slot")
+	}
+	d.signatureSlot = primitives.Slot(s)
+	return d, nil
+}
+
+// process verifies the update and applies it to the store. Unlike the light client specification, which also
+// follows optimistic headers, only the headers finalized with the signature of a sync committee supermajority are
+// applied, as the store serves to verify the finalized chain of the beacon node.
+func (s *store) process(u *update) error {
+	if err := s.verify(u); err != nil {
+		return err
+	}
+	if u.finalized == nil {
+		return nil
+	}
+	// Mirrors apply_light_client_update, the next sync committee of the update being relevant when it is of the
+	// period of the finalized header.
+	finalizedPeriod := period(u.finalized.Slot)
+	next := u.next
+	if period(u.attested.Slot) != finalizedPeriod {
+		next = nil
+	}
+	switch {
+	case s.next == nil:
+		if finalizedPeriod == s.period() && next != nil {
+			s.next = next
+		}
+	case finalizedPeriod == s.period()+1:
+		s.current, s.next = s.next, next
+	}
+	if u.finalized.Slot > s.finalized.Slot {
+		s.finalized = u.finalized
+	}
+	return nil
+}
+
+// verify follows validate_light_client_update of the light client specification, with the additional
+// requirement of a sync committee supermajority. Updates which cannot be verified by the store, without being
+// invalid, are rejected without errInvalidUpdate.
+func (s *store) verify(u *update) error {
+	cfg := params.BeaconConfig()
+	participants, err := participation(u.bits, cfg.SyncCommitteeSize)
+	if err != nil {
+		return errors.Wrap(errInvalidUpdate, err.Error())
+	}
+	if uint64(len(participants)) < cfg.MinSyncCommitteeParticipants || 3*uint64(len(participants)) < 2*cfg.SyncCommitteeSize {
+		return fmt.Errorf("insufficient sync committee participation %d/%d", len(participants), cfg.SyncCommitteeSize)
+	}
+	if u.finalized != nil && u.attested.Slot < u.finalized.Slot {
+		return errors.Wrapf(errInvalidUpdate, "attested slot %d is before finalized slot %d", u.attested.Slot, u.finalized.Slot)
+	}
+	if u.signatureSlot <= u.attested.Slot {
+		return errors.Wrapf(errInvalidUpdate, "signature slot %d is not after attested slot %d", u.signatureSlot, u.attested.Slot)
+	}
+
+	var committee *ethpb.SyncCommittee
+	switch period(u.signatureSlot) {
+	case s.period():
+		committee = s.current
+	case s.period() + 1:
+		committee = s.next
+	}
+	if committee == nil {
+		return fmt.Errorf("no known sync committee for the period of signature slot %d", u.signatureSlot)
+	}
+
+	electra := isElectra(u.attested.Slot)
+	if u.finalized != nil {
+		root, err := u.finalized.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		depth, index := uint64(fieldparams.FinalityBranchDepth), finalizedRootIndex
+		if electra {
+			depth, index = fieldparams.FinalityBranchDepthElectra, finalizedRootIndexElectra
+		}
+		if err := verifyProof(u.attested.StateRoot, root[:], u.finalityProof, depth, index); err != nil {
+			return errors.Wrap(err, "finality branch")
+		}
+	}
+	if u.next != nil {
+		depth, index := uint64(fieldparams.SyncCommitteeBranchDepth), nextSyncCommitteeIndex
+		if electra {
+			depth, index = fieldparams.SyncCommitteeBranchDepthElectra, nextSyncCommitteeIndexElectra
+		}
+		if err := verifyBranch(u.attested.StateRoot, u.next, u.nextProof, depth, index); err != nil {
+			return errors.Wrap(err, "next sync committee branch")
+		}
+	}
+
+	pubkeys := make([]bls.PublicKey, len(participants))
+	for i, p := range participants {
+		if pubkeys[i], err = bls.PublicKeyFromBytes(committee.Pubkeys[p]); err != nil {
+			return errors.Wrapf(errInvalidUpdate, "sync committee public key: %v", err)
+		}
+	}
+	sig, err := bls.SignatureFromBytes(u.signature)
+	if err != nil {
+		return errors.Wrapf(errInvalidUpdate, "sync committee signature: %v", err)
+	}
+	fork, err := forks.Fork(slots.ToEpoch(max(u.signatureSlot, 1) - 1))
+	if err != nil {
+		return err
+	}
+	domain, err := signing.ComputeDomain(cfg.DomainSyncCommittee, fork.CurrentVersion, s.gvr)
+	if err != nil {
+		return err
+	}
+	root, err := signing.ComputeSigningRoot(u.attested, domain)
+	if err != nil {
+		return err
+	}
+	if !sig.FastAggregateVerify(pubkeys, root) {
+		return errors.Wrap(errInvalidUpdate, "sync committee signature does not verify")
+	}
+	return nil
+}
+
+// participation returns the indices of the sync committee members set in the bitvector.
+func participation(bits []byte, size uint64) ([]uint64, error) {
+	if uint64(len(bits))*8 != size {
+		return nil, fmt.Errorf("sync committee bits of length %d, expected %d", len(bits)*8, size)
+	}
+	var participants []uint64
+	for i := uint64(0); i < size; i++ {
+		if bits[i/8]&(1<<(i%8)) != 0 {
+			participants = append(participants, i)
+		}
+	}
+	return participants, nil
+}
+
+func isElectra(slot primitives.Slot) bool {
+	return slots.ToForkVersion(slot) >= version.Electra
+}
+
+// beaconHeader decodes the beacon block header of a light client header, ignoring its execution payload header.
+func beaconHeader(raw json.RawMessage) (*ethpb.BeaconBlockHeader, error) {
+	h := &structs.LightClientHeader{}
+	if err := json.Unmarshal(raw, h); err != nil {
+		return nil, errors.Wrap(err, "could not decode light client header")
+	}
+	if h.Beacon == nil {
+		return nil, errors.New("light client header has no beacon block header")
+	}
+	return h.Beacon.ToConsensus()
+}
+
+func decodeBranch(branch []string) ([][]byte, error) {
+	proof := make([][]byte, len(branch))
+	for i, node := range branch {
+		b, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != fieldparams.RootLength {
+			return nil, fmt.Errorf("branch node of length %d", len(b))
+		}
+		proof[i] = b
+	}
+	return proof, nil
+}
+
+func verifyBranch(stateRoot []byte, committee *ethpb.SyncCommittee, proof [][]byte, depth, index uint64) error {
+	root, err := committee.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	return verifyProof(stateRoot, root[:], proof, depth, index)
+}
+
+func verifyProof(stateRoot, leaf []byte, proof [][]byte, depth, index uint64) error {
+	if uint64(len(proof)) != depth {
+		return errors.Wrapf(errInvalidUpdate, "merkle proof of length %d, expected %d", len(proof), depth)
+	}
+	if !trie.VerifyMerkleProof(stateRoot, leaf, index, proof) {
+		return errors.Wrap(errInvalidUpdate, "invalid merkle proof")
+	}
+	return nil
+}
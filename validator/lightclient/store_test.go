@@ -0,0 +1,200 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+var testGVR = make([]byte, 32)
+
+// testChain holds the keys of a sync committee, and builds the light client data it signs.
+type testChain struct {
+	t         *testing.T
+	keys      []bls.SecretKey
+	committee *ethpb.SyncCommittee
+	next      *ethpb.SyncCommittee
+}
+
+func newTestChain(t *testing.T) *testChain {
+	c := &testChain{t: t}
+	for i := 0; i < 4; i++ {
+		k, err := bls.RandKey()
+		require.NoError(t, err)
+		c.keys = append(c.keys, k)
+	}
+	c.committee = committee(t, c.keys)
+	other, err := bls.RandKey()
+	require.NoError(t, err)
+	c.next = committee(t, []bls.SecretKey{other})
+	return c
+}
+
+// committee returns a sync committee of the keys, each member appearing several times.
+func committee(t *testing.T, keys []bls.SecretKey) *ethpb.SyncCommittee {
+	sc := &ethpb.SyncCommittee{}
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSize; i++ {
+		sc.Pubkeys = append(sc.Pubkeys, keys[i%uint64(len(keys))].PublicKey().Marshal())
+	}
+	sc.AggregatePubkey = keys[0].PublicKey().Marshal()
+	return sc
+}
+
+// header returns a block header of a state at the slot, with the sync committees of the chain and the finalized root.
+func (c *testChain) header(slot primitives.Slot, finalizedRoot []byte) (*ethpb.BeaconBlockHeader, [][]byte, [][]byte, [][]byte) {
+	ctx := context.Background()
+	st, err := util.NewBeaconStateAltair(func(s *ethpb.BeaconStateAltair) error {
+		s.Slot = slot
+		s.CurrentSyncCommittee = c.committee
+		s.NextSyncCommittee = c.next
+		s.FinalizedCheckpoint = &ethpb.Checkpoint{Epoch: slots.ToEpoch(slot), Root: finalizedRoot}
+		return nil
+	})
+	require.NoError(c.t, err)
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(c.t, err)
+	current, err := st.CurrentSyncCommitteeProof(ctx)
+	require.NoError(c.t, err)
+	next, err := st.NextSyncCommitteeProof(ctx)
+	require.NoError(c.t, err)
+	finality, err := st.FinalizedRootProof(ctx)
+	require.NoError(c.t, err)
+	return &ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  root[:],
+		BodyRoot:   make([]byte, 32),
+	}, current, next, finality
+}
+
+func (c *testChain) bootstrap(slot primitives.Slot) ([32]byte, *structs.LightClientBootstrap) {
+	h, branch, _, _ := c.header(slot, make([]byte, 32))
+	root, err := h.HashTreeRoot()
+	require.NoError(c.t, err)
+	return root, &structs.LightClientBootstrap{
+		Header:                     lightClientHeader(c.t, h),
+		CurrentSyncCommittee:       structs.SyncCommitteeFromConsensus(c.committee),
+		CurrentSyncCommitteeBranch: encodeBranch(branch),
+	}
+}
+
+// update returns a light client update finalizing a header at the finalized slot, signed by the participants
+// among the keys.
+func (c *testChain) update(finalizedSlot, attestedSlot primitives.Slot, keys []bls.SecretKey, participants uint64) *structs.LightClientUpdate {
+	finalized, _, _, _ := c.header(finalizedSlot, make([]byte, 32))
+	finalizedRoot, err := finalized.HashTreeRoot()
+	require.NoError(c.t, err)
+	attested, _, next, finality := c.header(attestedSlot, finalizedRoot[:])
+
+	signatureSlot := attestedSlot + 1
+	fork, err := forks.Fork(slots.ToEpoch(signatureSlot - 1))
+	require.NoError(c.t, err)
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, fork.CurrentVersion, testGVR)
+	require.NoError(c.t, err)
+	signingRoot, err := signing.ComputeSigningRoot(attested, domain)
+	require.NoError(c.t, err)
+	bits := make([]byte, params.BeaconConfig().SyncCommitteeSize/8)
+	var sigs []bls.Signature
+	for i := uint64(0); i < participants; i++ {
+		bits[i/8] |= 1 << (i % 8)
+		sigs = append(sigs, keys[i%uint64(len(keys))].Sign(signingRoot[:]))
+	}
+	return &structs.LightClientUpdate{
+		AttestedHeader:          lightClientHeader(c.t, attested),
+		NextSyncCommittee:       structs.SyncCommitteeFromConsensus(c.next),
+		FinalizedHeader:         lightClientHeader(c.t, finalized),
+		SyncAggregate:           &structs.SyncAggregate{SyncCommitteeBits: hexutil.Encode(bits), SyncCommitteeSignature: hexutil.Encode(bls.AggregateSignatures(sigs).Marshal())},
+		NextSyncCommitteeBranch: encodeBranch(next),
+		FinalityBranch:          encodeBranch(finality),
+		SignatureSlot:           strconv.FormatUint(uint64(signatureSlot), 10),
+	}
+}
+
+func lightClientHeader(t *testing.T, h *ethpb.BeaconBlockHeader) json.RawMessage {
+	b, err := json.Marshal(&structs.LightClientHeader{Beacon: structs.BeaconBlockHeaderFromConsensus(h)})
+	require.NoError(t, err)
+	return b
+}
+
+func encodeBranch(branch [][]byte) []string {
+	s := make([]string, len(branch))
+	for i, b := range branch {
+		s[i] = hexutil.Encode(b)
+	}
+	return s
+}
+
+func TestNewStore(t *testing.T) {
+	c := newTestChain(t)
+	root, b := c.bootstrap(64)
+
+	s, err := newStore(root, testGVR, b)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(64), s.finalized.Slot)
+	assert.DeepEqual(t, c.committee, s.current)
+
+	_, err = newStore([32]byte{1}, testGVR, b)
+	require.ErrorIs(t, err, errInvalidUpdate)
+
+	b.CurrentSyncCommittee = structs.SyncCommitteeFromConsensus(c.next)
+	_, err = newStore(root, testGVR, b)
+	require.ErrorIs(t, err, errInvalidUpdate)
+}
+
+func TestStore_Process(t *testing.T) {
+	c := newTestChain(t)
+	size := params.BeaconConfig().SyncCommitteeSize
+	newTestStore := func() *store {
+		root, b := c.bootstrap(64)
+		s, err := newStore(root, testGVR, b)
+		require.NoError(t, err)
+		return s
+	}
+	process := func(s *store, u *structs.LightClientUpdate) error {
+		d, err := decodeUpdate(u)
+		require.NoError(t, err)
+		return s.process(d)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		s := newTestStore()
+		require.NoError(t, process(s, c.update(96, 128, c.keys, size)))
+		assert.Equal(t, primitives.Slot(96), s.finalized.Slot)
+		assert.DeepEqual(t, c.next, s.next)
+	})
+	t.Run("signed by another committee", func(t *testing.T) {
+		other, err := bls.RandKey()
+		require.NoError(t, err)
+		s := newTestStore()
+		require.ErrorIs(t, process(s, c.update(96, 128, []bls.SecretKey{other}, size)), errInvalidUpdate)
+		assert.Equal(t, primitives.Slot(64), s.finalized.Slot)
+	})
+	t.Run("tampered finalized header", func(t *testing.T) {
+		s := newTestStore()
+		u := c.update(96, 128, c.keys, size)
+		u.FinalizedHeader = c.update(95, 128, c.keys, size).FinalizedHeader
+		require.ErrorIs(t, process(s, u), errInvalidUpdate)
+	})
+	t.Run("insufficient participation", func(t *testing.T) {
+		s := newTestStore()
+		err := process(s, c.update(96, 128, c.keys, size/2))
+		require.ErrorContains(t, "insufficient sync committee participation", err)
+		assert.Equal(t, false, errors.Is(err, errInvalidUpdate))
+		assert.Equal(t, primitives.Slot(64), s.finalized.Slot)
+	})
+}
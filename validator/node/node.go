@@ -6,17 +6,20 @@ package node
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/middleware"
@@ -24,9 +27,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer/loader"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/prometheus"
@@ -41,9 +46,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqlite"
 	g "github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/lightclient"
 	"github.com/prysmaticlabs/prysm/v5/validator/rpc"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -61,17 +68,23 @@ type ValidatorClient struct {
 	wallet                *wallet.Wallet
 	walletInitializedFeed *event.Feed
 	stop                  chan struct{} // Channel to wait for termination notifications.
+	dryRunDataDir         string        // Temporary directory of the database in dry run mode.
 }
 
 // NewValidatorClient creates a new instance of the Prysm validator client.
 func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 	// TODO(#9883) - Maybe we can pass in a new validator client config instead of the cliCTX to abstract away the use of flags here .
+	tracingOpts, err := cmd.TracingOptions(cliCtx)
+	if err != nil {
+		return nil, err
+	}
 	if err := tracing.Setup(
 		"validator", // service name
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
 		cliCtx.Bool(cmd.EnableTracingFlag.Name),
+		tracingOpts...,
 	); err != nil {
 		return nil, err
 	}
@@ -176,6 +189,14 @@ func (c *ValidatorClient) Close() {
 	c.services.StopAll()
 	log.Info("Stopping Prysm validator")
 	c.cancel()
+	if c.dryRunDataDir != "" {
+		if err := c.db.Close(); err != nil {
+			log.WithError(err).Error("Could not close dry run database")
+		}
+		if err := os.RemoveAll(c.dryRunDataDir); err != nil {
+			log.WithError(err).Error("Could not remove dry run database")
+		}
+	}
 	close(c.stop)
 }
 
@@ -265,6 +286,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context, router *http.Se
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
+	if cliCtx.Bool(flags.LightClientVerificationFlag.Name) {
+		if err := c.registerLightClientService(cliCtx); err != nil {
+			return err
+		}
+	}
 	if cliCtx.Bool(flags.EnableRPCFlag.Name) {
 		if err := c.registerRPCService(router); err != nil {
 			return err
@@ -305,6 +331,11 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context, router *http.Ser
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
+	if cliCtx.Bool(flags.LightClientVerificationFlag.Name) {
+		if err := c.registerLightClientService(cliCtx); err != nil {
+			return err
+		}
+	}
 
 	if err := c.registerRPCService(router); err != nil {
 		return err
@@ -320,6 +351,10 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context, router *http.Ser
 }
 
 func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
+	if cliCtx.Bool(flags.DryRunFlag.Name) {
+		return c.initializeDryRunDB(cliCtx)
+	}
+
 	fileSystemDataDir := cliCtx.String(cmd.DataDirFlag.Name)
 	kvDataDir := cliCtx.String(cmd.DataDirFlag.Name)
 	kvDataFile := filepath.Join(kvDataDir, kv.ProtectionDbFileName)
@@ -345,6 +380,28 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	// Check if minimal slashing protection is requested.
 	isMinimalSlashingProtectionRequested := cliCtx.Bool(features.EnableMinimalSlashingProtection.Name)
 
+	if cliCtx.Bool(features.EnableSQLiteValidatorDB.Name) {
+		if isMinimalSlashingProtectionRequested {
+			return fmt.Errorf("--%s cannot be used with --%s", features.EnableSQLiteValidatorDB.Name, features.EnableMinimalSlashingProtection.Name)
+		}
+		return c.initializeSQLiteDB(cliCtx, cliCtx.String(cmd.DataDirFlag.Name), kvDataDir, clearFlag || forceClearFlag, forceClearFlag)
+	}
+
+	// A data directory holding a SQLite database is rejected, so that its slashing protection history is not silently
+	// replaced by an empty BoltDB or minimal database: it must first be exported and imported into the other one.
+	sqliteDatabasePath := path.Join(fileSystemDataDir, sqlite.DatabaseFileName)
+	sqliteDatabaseExists, err := file.Exists(sqliteDatabasePath, file.Regular)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if %s exists", sqliteDatabasePath)
+	}
+	if sqliteDatabaseExists {
+		return fmt.Errorf(
+			"a SQLite slashing protection database already exists at %s, run with --%s or export its history with "+
+				"`validator slashing-protection-history export --%s` and import it without --%s before removing it",
+			sqliteDatabasePath, features.EnableSQLiteValidatorDB.Name, features.EnableSQLiteValidatorDB.Name, features.EnableSQLiteValidatorDB.Name,
+		)
+	}
+
 	if clearFlag || forceClearFlag {
 		var err error
 
@@ -432,6 +489,64 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// initializeSQLiteDB opens the SQLite validator database of the data directory. A data directory still holding a
+// BoltDB or a minimal slashing protection database is rejected, so that no slashing protection history is silently
+// left behind: it must first be exported and imported into the SQLite database.
+func (c *ValidatorClient) initializeSQLiteDB(cliCtx *cli.Context, dataDir, kvDataDir string, clearRequested, forceClear bool) error {
+	if clearRequested {
+		if err := clearValidatorDB(dataDir, forceClear, func() (iface.ValidatorDB, error) {
+			return sqlite.NewStore(cliCtx.Context, dataDir, nil)
+		}); err != nil {
+			return errors.Wrap(err, "could not clear database")
+		}
+	}
+
+	for _, existing := range []struct {
+		path string
+		kind file.ObjType
+	}{
+		{path: path.Join(kvDataDir, kv.ProtectionDbFileName), kind: file.Regular},
+		{path: path.Join(dataDir, filesystem.DatabaseDirName), kind: file.Directory},
+	} {
+		exists, err := file.Exists(existing.path, existing.kind)
+		if err != nil {
+			return errors.Wrapf(err, "could not check if %s exists", existing.path)
+		}
+		if exists {
+			return fmt.Errorf(
+				"a slashing protection database already exists at %s, export its history with `validator slashing-protection-history export` "+
+					"and import it with --%s before removing it",
+				existing.path, features.EnableSQLiteValidatorDB.Name,
+			)
+		}
+	}
+
+	log.WithField("databasePath", dataDir).Info("Checking DB")
+	valDB, err := sqlite.NewStore(cliCtx.Context, dataDir, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not create validator database")
+	}
+	c.db = valDB
+	return nil
+}
+
+// initializeDryRunDB creates the database in a temporary directory, removed on exit, so that the duties rehearsed in
+// dry run mode never affect the slashing protection history of the validators.
+func (c *ValidatorClient) initializeDryRunDB(cliCtx *cli.Context) error {
+	dataDir, err := os.MkdirTemp("", "prysm-validator-dry-run")
+	if err != nil {
+		return errors.Wrap(err, "could not create dry run database directory")
+	}
+	log.WithField("databasePath", dataDir).Info("Using temporary database for dry run")
+	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not create validator database")
+	}
+	c.db = valDB
+	c.dryRunDataDir = dataDir
+	return nil
+}
+
 func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 	var additionalHandlers []prometheus.Handler
 	if cliCtx.IsSet(cmd.EnableBackupWebhookFlag.Name) {
@@ -446,6 +561,11 @@ func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 	service := prometheus.NewService(
 		fmt.Sprintf("%s:%d", c.cliCtx.String(cmd.MonitoringHostFlag.Name), c.cliCtx.Int(flags.MonitoringPortFlag.Name)),
 		c.services,
+		&prometheus.CardinalityConfig{
+			DisabledFamilies:   c.cliCtx.StringSlice(cmd.MetricsDisabledFamiliesFlag.Name),
+			AggregatedLabels:   c.cliCtx.StringSlice(cmd.MetricsAggregatedLabelsFlag.Name),
+			MaxSeriesPerFamily: c.cliCtx.Int(cmd.MetricsMaxSeriesPerFamilyFlag.Name),
+		},
 		additionalHandlers...,
 	)
 	logrus.AddHook(prometheus.NewLogrusCollector())
@@ -487,6 +607,14 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	var expectedGenValRoot []byte
+	if c.cliCtx.IsSet(flags.ExpectedGenesisValidatorsRootFlag.Name) {
+		expectedGenValRoot, err = hexutil.Decode(c.cliCtx.String(flags.ExpectedGenesisValidatorsRootFlag.Name))
+		if err != nil || len(expectedGenValRoot) != fieldparams.RootLength {
+			return fmt.Errorf("--%s must be a hex encoded 32 byte root", flags.ExpectedGenesisValidatorsRootFlag.Name)
+		}
+	}
+
 	validatorService, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		DB:                      c.db,
 		Wallet:                  c.wallet,
@@ -505,10 +633,23 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		Web3SignerConfig:        web3signerConfig,
 		ProposerSettings:        ps,
 		ValidatorsRegBatchSize:  c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
+		ValidatorsRegRefresh:    c.cliCtx.Uint64(flags.ValidatorsRegistrationRefreshEpochsFlag.Name),
+		ValidatorsRegJitter:     c.cliCtx.Duration(flags.ValidatorsRegistrationJitterFlag.Name),
 		UseWeb:                  c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		LogValidatorPerformance: !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
 		EmitAccountMetrics:      !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
 		Distributed:             c.cliCtx.Bool(flags.EnableDistributed.Name),
+		DryRun:                  c.cliCtx.Bool(flags.DryRunFlag.Name),
+		ExpectedGenValRoot:      expectedGenValRoot,
+		ExpectedConfigName:      c.cliCtx.String(flags.ExpectedConfigNameFlag.Name),
+		AttestationDelay:        c.cliCtx.Duration(flags.AttestationDelayFlag.Name),
+		AggregationDelay:        c.cliCtx.Duration(flags.AggregationDelayFlag.Name),
+		OffloadAggregation:      c.cliCtx.Bool(flags.OffloadAggregationFlag.Name),
+		SigningDeadlines: client.SigningDeadlines{
+			Attestation: c.cliCtx.Duration(flags.AttestationSigningDeadlineFlag.Name),
+			Block:       c.cliCtx.Duration(flags.BlockSigningDeadlineFlag.Name),
+			Aggregate:   c.cliCtx.Duration(flags.AggregateSigningDeadlineFlag.Name),
+		},
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -517,6 +658,24 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	return c.services.RegisterService(validatorService)
 }
 
+func (c *ValidatorClient) registerLightClientService(cliCtx *cli.Context) error {
+	cfg := &lightclient.Config{
+		BeaconApiEndpoint: cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+	}
+	if cliCtx.IsSet(flags.LightClientTrustedBlockRootFlag.Name) {
+		root, err := hexutil.Decode(cliCtx.String(flags.LightClientTrustedBlockRootFlag.Name))
+		if err != nil || len(root) != fieldparams.RootLength {
+			return fmt.Errorf("--%s must be a hex encoded 32 byte block root", flags.LightClientTrustedBlockRootFlag.Name)
+		}
+		cfg.TrustedBlockRoot = bytesutil.ToBytes32(root)
+	}
+	service, err := lightclient.NewService(cliCtx.Context, cfg)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize light client service")
+	}
+	return c.services.RegisterService(service)
+}
+
 func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error) {
 	var web3signerConfig *remoteweb3signer.SetupConfig
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
@@ -551,6 +710,17 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 		if cliCtx.IsSet(flags.Web3SignerKeyFileFlag.Name) {
 			web3signerConfig.KeyFilePath = cliCtx.String(flags.Web3SignerKeyFileFlag.Name)
 		}
+		if cliCtx.IsSet(flags.Web3SignerSecondaryURLFlag.Name) {
+			secondaryStr := cliCtx.String(flags.Web3SignerSecondaryURLFlag.Name)
+			su, err := url.ParseRequestURI(secondaryStr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "secondary web3signer url %s is invalid", secondaryStr)
+			}
+			if su.Scheme == "" || su.Host == "" {
+				return nil, fmt.Errorf("secondary web3signer url must be in the format of http(s)://host:port url used: %v", secondaryStr)
+			}
+			web3signerConfig.SecondaryBaseEndpoint = su.String()
+		}
 	}
 	return web3signerConfig, nil
 }
@@ -591,6 +761,18 @@ func (c *ValidatorClient) registerRPCService(router *http.ServeMux) error {
 		)
 	}
 	port := c.cliCtx.Int(flags.HTTPServerPort.Name)
+	additionalAddrs := httpAdditionalAddrs(c.cliCtx.StringSlice(flags.HTTPServerAdditionalHostsFlag.Name), port)
+	tlsCert := c.cliCtx.String(flags.HTTPServerTLSCertFlag.Name)
+	tlsKey := c.cliCtx.String(flags.HTTPServerTLSKeyFlag.Name)
+	if (tlsCert == "") != (tlsKey == "") {
+		return fmt.Errorf("--%s and --%s must be set together", flags.HTTPServerTLSCertFlag.Name, flags.HTTPServerTLSKeyFlag.Name)
+	}
+	if len(additionalAddrs) > 0 && tlsCert == "" {
+		log.WithField("hosts", additionalAddrs).Warn(
+			"The validator API listens on other interfaces without TLS, consider setting --" + flags.HTTPServerTLSCertFlag.Name +
+				" and --" + flags.HTTPServerTLSKeyFlag.Name,
+		)
+	}
 	var allowedOrigins []string
 	if c.cliCtx.IsSet(flags.HTTPServerCorsDomain.Name) {
 		allowedOrigins = strings.Split(c.cliCtx.String(flags.HTTPServerCorsDomain.Name), ",")
@@ -603,28 +785,50 @@ func (c *ValidatorClient) registerRPCService(router *http.ServeMux) error {
 		middleware.CorsHandler(allowedOrigins),
 	}
 	s := rpc.NewServer(c.cliCtx.Context, &rpc.Config{
-		HTTPHost:               host,
-		HTTPPort:               port,
-		GRPCMaxCallRecvMsgSize: c.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
-		GRPCRetries:            c.cliCtx.Uint(flags.GRPCRetriesFlag.Name),
-		GRPCRetryDelay:         c.cliCtx.Duration(flags.GRPCRetryDelayFlag.Name),
-		GRPCHeaders:            strings.Split(c.cliCtx.String(flags.GRPCHeadersFlag.Name), ","),
-		BeaconNodeGRPCEndpoint: c.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
-		BeaconApiEndpoint:      c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
-		BeaconApiTimeout:       time.Second * 30,
-		BeaconNodeCert:         c.cliCtx.String(flags.CertFlag.Name),
-		DB:                     c.db,
-		Wallet:                 c.wallet,
-		WalletDir:              walletDir,
-		WalletInitializedFeed:  c.walletInitializedFeed,
-		ValidatorService:       vs,
-		AuthTokenPath:          authTokenPath,
-		Middlewares:            middlewares,
-		Router:                 router,
+		HTTPHost:                 host,
+		HTTPPort:                 port,
+		HTTPAdditionalAddrs:      additionalAddrs,
+		TLSCertPath:              tlsCert,
+		TLSKeyPath:               tlsKey,
+		GRPCMaxCallRecvMsgSize:   c.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		GRPCRetries:              c.cliCtx.Uint(flags.GRPCRetriesFlag.Name),
+		GRPCRetryDelay:           c.cliCtx.Duration(flags.GRPCRetryDelayFlag.Name),
+		GRPCHeaders:              strings.Split(c.cliCtx.String(flags.GRPCHeadersFlag.Name), ","),
+		BeaconNodeGRPCEndpoint:   c.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
+		BeaconApiEndpoint:        c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		BeaconApiTimeout:         time.Second * 30,
+		BeaconNodeCert:           c.cliCtx.String(flags.CertFlag.Name),
+		DB:                       c.db,
+		Wallet:                   c.wallet,
+		WalletDir:                walletDir,
+		WalletInitializedFeed:    c.walletInitializedFeed,
+		ValidatorService:         vs,
+		AuthTokenPath:            authTokenPath,
+		AdditionalAuthTokensPath: c.cliCtx.String(flags.AdditionalAuthTokensFileFlag.Name),
+		Middlewares:              middlewares,
+		Router:                   router,
 	})
 	return c.services.RegisterService(s)
 }
 
+// httpAdditionalAddrs returns the host:port addresses of the other interfaces of the HTTP server, using the given
+// port for the hosts without one.
+func httpAdditionalAddrs(hosts []string, port int) []string {
+	addrs := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(h); err == nil {
+			addrs = append(addrs, h)
+			continue
+		}
+		addrs = append(addrs, net.JoinHostPort(strings.Trim(h, "[]"), strconv.Itoa(port)))
+	}
+	return addrs
+}
+
 func setWalletPasswordFilePath(cliCtx *cli.Context) error {
 	walletDir := cliCtx.String(flags.WalletDirFlag.Name)
 	defaultWalletPasswordFilePath := filepath.Join(walletDir, wallet.DefaultWalletPasswordFile)
@@ -655,10 +859,16 @@ func setWalletPasswordFilePath(cliCtx *cli.Context) error {
 }
 
 func clearDB(ctx context.Context, dataDir string, force bool, isDatabaseMinimal bool) error {
-	var (
-		valDB iface.ValidatorDB
-		err   error
-	)
+	return clearValidatorDB(dataDir, force, func() (iface.ValidatorDB, error) {
+		if isDatabaseMinimal {
+			return filesystem.NewStore(dataDir, nil)
+		}
+		return kv.NewKVStore(ctx, dataDir, nil)
+	})
+}
+
+func clearValidatorDB(dataDir string, force bool, openDB func() (iface.ValidatorDB, error)) error {
+	var err error
 
 	clearDBConfirmed := force
 
@@ -673,12 +883,7 @@ func clearDB(ctx context.Context, dataDir string, force bool, isDatabaseMinimal
 	}
 
 	if clearDBConfirmed {
-		if isDatabaseMinimal {
-			valDB, err = filesystem.NewStore(dataDir, nil)
-		} else {
-			valDB, err = kv.NewKVStore(ctx, dataDir, nil)
-		}
-
+		valDB, err := openDB()
 		if err != nil {
 			return errors.Wrap(err, "could not create validator database")
 		}
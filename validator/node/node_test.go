@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqlite"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
 	logtest "github.com/sirupsen/logrus/hooks/test"
@@ -207,6 +208,22 @@ func TestClearDB(t *testing.T) {
 	}
 }
 
+func TestInitializeDB_SQLiteDatabaseExists(t *testing.T) {
+	dataDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, sqlite.DatabaseFileName), []byte{}, os.ModePerm))
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	c := &ValidatorClient{}
+
+	// The SQLite database must not be silently replaced by an empty BoltDB.
+	err := c.initializeDB(cli.NewContext(&app, set, nil))
+	require.ErrorContains(t, "a SQLite slashing protection database already exists", err)
+	exists, err := file.Exists(filepath.Join(dataDir, kv.ProtectionDbFileName), file.Regular)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
+
 // TestWeb3SignerConfig tests the web3 signer config returns the correct values.
 func TestWeb3SignerConfig(t *testing.T) {
 	type args struct {
@@ -309,3 +326,8 @@ func TestWeb3SignerConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPAdditionalAddrs(t *testing.T) {
+	addrs := httpAdditionalAddrs([]string{"10.0.0.2", "10.0.0.3:7600", " ", "::1", "[::2]", "[::3]:7600"}, 7500)
+	assert.DeepEqual(t, []string{"10.0.0.2:7500", "10.0.0.3:7600", "[::1]:7500", "[::2]:7500", "[::3]:7600"}, addrs)
+}
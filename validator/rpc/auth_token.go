@@ -148,6 +148,21 @@ func saveAuthToken(tokenPath string, token string) error {
 	return nil
 }
 
+// ReadAuthToken reads the auth token of the validator apis from the file at the given path.
+func ReadAuthToken(tokenPath string) (string, error) {
+	f, err := os.Open(filepath.Clean(tokenPath))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not open auth token file %s", tokenPath)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error(err)
+		}
+	}()
+	_, token, err := readAuthTokenFile(f)
+	return token, err
+}
+
 func readAuthTokenFile(r io.Reader) ([]byte, string, error) {
 	scanner := bufio.NewScanner(r)
 	var lines []string
@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+)
+
+// validAuthToken returns true if the token is the auth token of the validator client, or one of the additional auth
+// tokens.
+func (s *Server) validAuthToken(token string) bool {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return false
+	}
+	if authToken := strings.TrimSpace(s.authToken); authToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(authToken)) == 1 {
+		return true
+	}
+	s.additionalAuthTokensLock.RLock()
+	defer s.additionalAuthTokensLock.RUnlock()
+	for _, t := range s.additionalAuthTokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// loadAdditionalAuthTokens reads the additional auth tokens from their file, replacing the ones previously loaded.
+func (s *Server) loadAdditionalAuthTokens() error {
+	f, err := os.Open(filepath.Clean(s.additionalAuthTokensPath))
+	if err != nil {
+		return errors.Wrapf(err, "could not open auth tokens file %s", s.additionalAuthTokensPath)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error(err)
+		}
+	}()
+	tokens, err := readAdditionalAuthTokens(f)
+	if err != nil {
+		return errors.Wrapf(err, "could not read auth tokens file %s", s.additionalAuthTokensPath)
+	}
+	s.setAdditionalAuthTokens(tokens)
+	log.WithField("tokens", len(tokens)).Infof("Loaded additional auth tokens from %s", s.additionalAuthTokensPath)
+	return nil
+}
+
+func (s *Server) setAdditionalAuthTokens(tokens []string) {
+	s.additionalAuthTokensLock.Lock()
+	defer s.additionalAuthTokensLock.Unlock()
+	s.additionalAuthTokens = tokens
+}
+
+// watchAdditionalAuthTokens reloads the additional auth tokens whenever their file changes, so that tokens can be
+// rotated without restarting the validator client. The directory of the file is watched rather than the file itself,
+// so that the file can also be replaced atomically by renaming a new file over it. The tokens are all revoked when the
+// file is removed.
+func (s *Server) watchAdditionalAuthTokens(ctx context.Context) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	path := filepath.Clean(s.additionalAuthTokensPath)
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		log.WithError(err).Errorf("Could not add directory of file %s to file watcher", path)
+		return
+	}
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != path {
+				continue
+			}
+			if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+				log.Warnf("Auth tokens file %s was removed, revoking the additional auth tokens", path)
+				s.setAdditionalAuthTokens(nil)
+				continue
+			}
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+				continue
+			}
+			if err := s.loadAdditionalAuthTokens(); err != nil {
+				log.WithError(err).Error("Could not reload additional auth tokens")
+			}
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// readAdditionalAuthTokens reads one auth token per line, ignoring empty lines and lines starting with #. Tokens
+// which are not hex-encoded and at least 256 bits long are rejected.
+func readAdditionalAuthTokens(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	var tokens []string
+	line := 0
+	for scanner.Scan() {
+		line++
+		token := strings.TrimSpace(scanner.Text())
+		if token == "" || strings.HasPrefix(token, "#") {
+			continue
+		}
+		if err := api.ValidateAuthToken(token); err != nil {
+			log.WithError(err).WithField("line", line).Warn("Ignoring invalid additional auth token, tokens can be " +
+				"generated through the `validator web generate-auth-token` command")
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
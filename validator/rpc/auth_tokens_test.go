@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestReadAdditionalAuthTokens(t *testing.T) {
+	token1, err := api.GenerateRandomHexString()
+	require.NoError(t, err)
+	token2, err := api.GenerateRandomHexString()
+	require.NoError(t, err)
+	content := strings.Join([]string{
+		"# dashboard",
+		token1,
+		"",
+		"  " + token2 + "  ",
+		"not-a-token",
+	}, "\n")
+
+	tokens, err := readAdditionalAuthTokens(strings.NewReader(content))
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{token1, token2}, tokens)
+}
+
+func TestServer_AdditionalAuthTokens(t *testing.T) {
+	token, err := api.GenerateRandomHexString()
+	require.NoError(t, err)
+	additional, err := api.GenerateRandomHexString()
+	require.NoError(t, err)
+	s := &Server{authToken: token}
+	s.setAdditionalAuthTokens([]string{additional})
+	testHandler := s.AuthTokenHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, tt := range []struct {
+		token string
+		code  int
+	}{
+		{token: token, code: http.StatusOK},
+		{token: additional, code: http.StatusOK},
+		{token: "cool-token", code: http.StatusForbidden},
+	} {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/eth/v1/keystores", http.NoBody)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+tt.token)
+		testHandler.ServeHTTP(rr, req)
+		assert.Equal(t, tt.code, rr.Code)
+	}
+
+	// The additional tokens are not accepted when no token is set.
+	assert.Equal(t, false, (&Server{}).validAuthToken(""))
+}
+
+func TestServer_RotateAdditionalAuthTokens(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tokens")
+	oldToken, err := api.GenerateRandomHexString()
+	require.NoError(t, err)
+	newToken, err := api.GenerateRandomHexString()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, []byte(oldToken+"\n"), 0600))
+	s := &Server{additionalAuthTokensPath: path}
+	require.NoError(t, s.loadAdditionalAuthTokens())
+	require.Equal(t, true, s.validAuthToken(oldToken))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.watchAdditionalAuthTokens(ctx)
+
+	// Wait for service to be ready.
+	time.Sleep(time.Millisecond * 250)
+
+	// Replace the file atomically with the new token.
+	tmp := filepath.Join(dir, "tokens.tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte(newToken+"\n"), 0600))
+	require.NoError(t, os.Rename(tmp, path))
+	time.Sleep(time.Millisecond * 500)
+	assert.Equal(t, false, s.validAuthToken(oldToken))
+	assert.Equal(t, true, s.validAuthToken(newToken))
+
+	// Removing the file revokes the tokens.
+	require.NoError(t, os.Remove(path))
+	time.Sleep(time.Millisecond * 500)
+	assert.Equal(t, false, s.validAuthToken(newToken))
+}
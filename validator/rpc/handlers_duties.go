@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// GetDutySchedule returns the duties of the validating keys for the current and next epochs, as last fetched by the
+// validator client, along with the beacon node they were fetched from. It lets operators confirm the duties were
+// fetched correctly, for example after a restart.
+func (s *Server) GetDutySchedule(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.web.GetDutySchedule")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	schedule, err := s.validatorService.DutySchedule()
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if schedule == nil || schedule.Duties == nil {
+		httputil.HandleError(w, "Duties are not fetched yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	httputil.WriteJson(w, &DutyScheduleResponse{
+		BeaconNodeEndpoint: schedule.Host,
+		FetchedAt:          schedule.FetchedAt.UTC().Format(time.RFC3339),
+		CurrentEpoch:       epochDutySchedule(schedule.Epoch, schedule.Duties.CurrentEpochDuties),
+		NextEpoch:          epochDutySchedule(schedule.Epoch+1, schedule.Duties.NextEpochDuties),
+	})
+}
+
+func epochDutySchedule(epoch primitives.Epoch, duties []*ethpb.DutiesResponse_Duty) *EpochDutySchedule {
+	period := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	periodStart := epoch - epoch%period
+
+	schedule := &EpochDutySchedule{
+		Epoch:  strconv.FormatUint(uint64(epoch), 10),
+		Duties: make([]*KeyDuties, len(duties)),
+	}
+	for i, duty := range duties {
+		d := &KeyDuties{
+			Pubkey:         hexutil.Encode(duty.PublicKey),
+			ValidatorIndex: strconv.FormatUint(uint64(duty.ValidatorIndex), 10),
+			Status:         duty.Status.String(),
+			ProposalSlots:  make([]string, len(duty.ProposerSlots)),
+		}
+		// Only validators part of a committee attest in the epoch.
+		if len(duty.Committee) > 0 {
+			d.Attestation = &AttestationDuty{
+				Slot:             strconv.FormatUint(uint64(duty.AttesterSlot), 10),
+				CommitteeIndex:   strconv.FormatUint(uint64(duty.CommitteeIndex), 10),
+				CommitteesAtSlot: strconv.FormatUint(duty.CommitteesAtSlot, 10),
+			}
+		}
+		for j, slot := range duty.ProposerSlots {
+			d.ProposalSlots[j] = strconv.FormatUint(uint64(slot), 10)
+		}
+		if duty.IsSyncCommittee {
+			d.SyncCommittee = &SyncCommitteeWindow{
+				StartEpoch: strconv.FormatUint(uint64(periodStart), 10),
+				EndEpoch:   strconv.FormatUint(uint64(periodStart+period-1), 10),
+			}
+		}
+		schedule.Duties[i] = d
+	}
+	return schedule
+}
@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+func TestServer_GetDutySchedule(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.EpochsPerSyncCommitteePeriod = 8
+	params.OverrideBeaconConfig(cfg)
+
+	m := &mock.Validator{}
+	vs, err := client.NewValidatorService(context.Background(), &client.Config{
+		Validator: m,
+	})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+	}
+
+	// Duties are not fetched yet.
+	req := httptest.NewRequest(http.MethodGet, "/v2/validator/duties", nil)
+	w := httptest.NewRecorder()
+	s.GetDutySchedule(w, req)
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	pubkey := bytesutil.PadTo([]byte{1}, 48)
+	fetchedAt := time.Unix(1700000000, 0)
+	m.Schedule = &iface.DutySchedule{
+		Epoch:     10,
+		Host:      "localhost:4000",
+		FetchedAt: fetchedAt,
+		Duties: &eth.DutiesResponse{
+			CurrentEpochDuties: []*eth.DutiesResponse_Duty{
+				{
+					PublicKey:        pubkey,
+					ValidatorIndex:   5,
+					Status:           eth.ValidatorStatus_ACTIVE,
+					Committee:        []primitives.ValidatorIndex{5, 6},
+					CommitteeIndex:   2,
+					CommitteesAtSlot: 4,
+					AttesterSlot:     330,
+					ProposerSlots:    []primitives.Slot{325},
+					IsSyncCommittee:  true,
+				},
+			},
+			NextEpochDuties: []*eth.DutiesResponse_Duty{
+				{
+					PublicKey:      pubkey,
+					ValidatorIndex: 5,
+					Status:         eth.ValidatorStatus_EXITING,
+				},
+			},
+		},
+	}
+	w = httptest.NewRecorder()
+	s.GetDutySchedule(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &DutyScheduleResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "localhost:4000", resp.BeaconNodeEndpoint)
+	assert.Equal(t, fetchedAt.UTC().Format(time.RFC3339), resp.FetchedAt)
+
+	require.Equal(t, "10", resp.CurrentEpoch.Epoch)
+	require.Equal(t, 1, len(resp.CurrentEpoch.Duties))
+	current := resp.CurrentEpoch.Duties[0]
+	assert.Equal(t, hexutil.Encode(pubkey), current.Pubkey)
+	assert.Equal(t, "5", current.ValidatorIndex)
+	assert.Equal(t, "ACTIVE", current.Status)
+	assert.DeepEqual(t, &AttestationDuty{Slot: "330", CommitteeIndex: "2", CommitteesAtSlot: "4"}, current.Attestation)
+	assert.DeepEqual(t, []string{"325"}, current.ProposalSlots)
+	assert.DeepEqual(t, &SyncCommitteeWindow{StartEpoch: "8", EndEpoch: "15"}, current.SyncCommittee)
+
+	require.Equal(t, "11", resp.NextEpoch.Epoch)
+	require.Equal(t, 1, len(resp.NextEpoch.Duties))
+	next := resp.NextEpoch.Duties[0]
+	assert.Equal(t, "EXITING", next.Status)
+	assert.Equal(t, true, next.Attestation == nil)
+	assert.Equal(t, 0, len(next.ProposalSlots))
+	assert.Equal(t, true, next.SyncCommittee == nil)
+}
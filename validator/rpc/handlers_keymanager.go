@@ -26,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
 	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -500,6 +501,67 @@ func (s *Server) ImportRemoteKeys(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, &RemoteKeysResponse{Data: ks})
 }
 
+// ImportRemoteKeysManifest imports in bulk the public keys of a manifest for web3signer keymanager type,
+// reporting the status of each key along with a summary of the import.
+func (s *Server) ImportRemoteKeysManifest(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ImportRemoteKeysManifest")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	if !s.walletInitialized {
+		httputil.HandleError(w, "Prysm Wallet not initialized. Please create a new wallet.", http.StatusServiceUnavailable)
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+		httputil.HandleError(w, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.", http.StatusInternalServerError)
+		return
+	}
+	importer, ok := km.(manifestImporter)
+	if !ok {
+		httputil.HandleError(w, "Keymanager kind cannot import remote keys manifest.", http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		httputil.HandleError(w, "Could not read request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	manifest, err := remoteweb3signer.ParseManifest(body)
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := importer.ImportManifest(manifest)
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &ImportRemoteKeysManifestResponse{
+		Data:      report.Statuses,
+		Imported:  report.Imported,
+		Duplicate: report.Duplicate,
+		Failed:    report.Failed,
+	})
+}
+
+type manifestImporter interface {
+	ImportManifest(m *remoteweb3signer.Manifest) (*remoteweb3signer.ManifestReport, error)
+}
+
 // DeleteRemoteKeys deletes a list of public keys defined for web3signer keymanager type.
 func (s *Server) DeleteRemoteKeys(w http.ResponseWriter, r *http.Request) {
 	_, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.DeleteRemoteKeys")
@@ -1449,6 +1449,81 @@ func TestServer_ImportRemoteKeys(t *testing.T) {
 	})
 }
 
+func TestServer_ImportRemoteKeysManifest(t *testing.T) {
+	ctx := context.Background()
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	newDir := filepath.Join(t.TempDir(), "new")
+	set.String(flags.WalletDirFlag.Name, newDir, "")
+	w := wallet.NewWalletForWeb3Signer(cli.NewContext(&app, set, nil))
+	root := make([]byte, fieldparams.RootLength)
+	root[0] = 1
+	config := &remoteweb3signer.SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    nil,
+	}
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false, Web3SignerConfig: config})
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Wallet: w,
+		Validator: &mock.Validator{
+			Km: km,
+		},
+		Web3SignerConfig: config,
+	})
+	require.NoError(t, err)
+	s := &Server{
+		walletInitialized: true,
+		wallet:            w,
+		validatorService:  vs,
+	}
+	pubkey := "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"
+
+	t.Run("imports keys and reports summary", func(t *testing.T) {
+		b, err := json.Marshal(&remoteweb3signer.Manifest{
+			URL: "http://example.com/",
+			RemoteKeys: []*remoteweb3signer.ManifestKey{
+				{Pubkey: pubkey},
+				{Pubkey: "0x1234"},
+				{Pubkey: pubkey, URL: "http://other.com"},
+			},
+		})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/validator/remotekeys/manifest", bytes.NewReader(b))
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.ImportRemoteKeysManifest(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &ImportRemoteKeysManifestResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, keymanager.StatusImported, resp.Data[0].Status)
+		assert.Equal(t, keymanager.StatusError, resp.Data[1].Status)
+		assert.Equal(t, keymanager.StatusError, resp.Data[2].Status)
+		assert.StringContains(t, "does not match the url of the remote signer", resp.Data[2].Message)
+		assert.Equal(t, 1, resp.Imported)
+		assert.Equal(t, 0, resp.Duplicate)
+		assert.Equal(t, 2, resp.Failed)
+	})
+	t.Run("no keys", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/validator/remotekeys/manifest", strings.NewReader(`{"url":"http://example.com"}`))
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.ImportRemoteKeysManifest(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.StringContains(t, "remote keys manifest has no keys", w.Body.String())
+	})
+	t.Run("no data", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v2/validator/remotekeys/manifest", nil)
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.ImportRemoteKeysManifest(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.StringContains(t, "No data submitted", w.Body.String())
+	})
+}
+
 func TestServer_DeleteRemoteKeys(t *testing.T) {
 	ctx := context.Background()
 	app := cli.App{}
@@ -52,7 +52,7 @@ func (s *Server) AuthTokenHandler(next http.Handler) http.Handler {
 			}
 
 			token := tokenParts[1]
-			if strings.TrimSpace(token) != s.authToken || strings.TrimSpace(s.authToken) == "" {
+			if !s.validAuthToken(token) {
 				httputil.HandleError(w, "Forbidden: token value is invalid", http.StatusForbidden)
 				return
 			}
@@ -76,7 +76,7 @@ func (s *Server) authorize(ctx context.Context) error {
 		return status.Error(codes.Unauthenticated, "Invalid auth header, needs Bearer {token}")
 	}
 	token := strings.Split(authHeader[0], "Bearer ")[1]
-	if strings.TrimSpace(token) != s.authToken || strings.TrimSpace(s.authToken) == "" {
+	if !s.validAuthToken(token) {
 		return status.Errorf(codes.Unauthenticated, "Forbidden: token value is invalid")
 	}
 	return nil
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -25,8 +26,13 @@ import (
 
 // Config options for the HTTP server.
 type Config struct {
-	HTTPHost               string
-	HTTPPort               int
+	HTTPHost string
+	HTTPPort int
+	// HTTPAdditionalAddrs are the host:port addresses of the other interfaces the HTTP server listens on.
+	HTTPAdditionalAddrs []string
+	// TLSCertPath and TLSKeyPath serve the HTTP server over TLS when set.
+	TLSCertPath            string
+	TLSKeyPath             string
 	GRPCMaxCallRecvMsgSize int
 	GRPCRetries            uint
 	GRPCRetryDelay         time.Duration
@@ -41,8 +47,10 @@ type Config struct {
 	WalletInitializedFeed  *event.Feed
 	ValidatorService       *client.ValidatorService
 	AuthTokenPath          string
-	Middlewares            []middleware.Middleware
-	Router                 *http.ServeMux
+	// AdditionalAuthTokensPath is the file of the auth tokens accepted along with the one of AuthTokenPath.
+	AdditionalAuthTokensPath string
+	Middlewares              []middleware.Middleware
+	Router                   *http.ServeMux
 }
 
 // Server defining a HTTP server for the remote signer API and registering clients
@@ -67,6 +75,9 @@ type Server struct {
 	jwtSecret                 []byte
 	authTokenPath             string
 	authToken                 string
+	additionalAuthTokensPath  string
+	additionalAuthTokens      []string
+	additionalAuthTokensLock  sync.RWMutex
 	db                        db.Database
 	walletDir                 string
 	wallet                    *wallet.Wallet
@@ -83,27 +94,28 @@ type Server struct {
 func NewServer(ctx context.Context, cfg *Config) *Server {
 	ctx, cancel := context.WithCancel(ctx)
 	server := &Server{
-		ctx:                    ctx,
-		cancel:                 cancel,
-		logStreamer:            logs.NewStreamServer(),
-		logStreamerBufferSize:  1000, // Enough to handle most bursts of logs in the validator client.
-		httpHost:               cfg.HTTPHost,
-		httpPort:               cfg.HTTPPort,
-		grpcMaxCallRecvMsgSize: cfg.GRPCMaxCallRecvMsgSize,
-		grpcRetries:            cfg.GRPCRetries,
-		grpcRetryDelay:         cfg.GRPCRetryDelay,
-		grpcHeaders:            cfg.GRPCHeaders,
-		validatorService:       cfg.ValidatorService,
-		authTokenPath:          cfg.AuthTokenPath,
-		db:                     cfg.DB,
-		walletDir:              cfg.WalletDir,
-		walletInitializedFeed:  cfg.WalletInitializedFeed,
-		walletInitialized:      cfg.Wallet != nil,
-		wallet:                 cfg.Wallet,
-		beaconApiTimeout:       cfg.BeaconApiTimeout,
-		beaconApiEndpoint:      cfg.BeaconApiEndpoint,
-		beaconNodeEndpoint:     cfg.BeaconNodeGRPCEndpoint,
-		router:                 cfg.Router,
+		ctx:                      ctx,
+		cancel:                   cancel,
+		logStreamer:              logs.NewStreamServer(),
+		logStreamerBufferSize:    1000, // Enough to handle most bursts of logs in the validator client.
+		httpHost:                 cfg.HTTPHost,
+		httpPort:                 cfg.HTTPPort,
+		grpcMaxCallRecvMsgSize:   cfg.GRPCMaxCallRecvMsgSize,
+		grpcRetries:              cfg.GRPCRetries,
+		grpcRetryDelay:           cfg.GRPCRetryDelay,
+		grpcHeaders:              cfg.GRPCHeaders,
+		validatorService:         cfg.ValidatorService,
+		authTokenPath:            cfg.AuthTokenPath,
+		additionalAuthTokensPath: cfg.AdditionalAuthTokensPath,
+		db:                       cfg.DB,
+		walletDir:                cfg.WalletDir,
+		walletInitializedFeed:    cfg.WalletInitializedFeed,
+		walletInitialized:        cfg.Wallet != nil,
+		wallet:                   cfg.Wallet,
+		beaconApiTimeout:         cfg.BeaconApiTimeout,
+		beaconApiEndpoint:        cfg.BeaconApiEndpoint,
+		beaconNodeEndpoint:       cfg.BeaconNodeGRPCEndpoint,
+		router:                   cfg.Router,
 	}
 
 	if server.authTokenPath == "" && server.walletDir != "" {
@@ -118,6 +130,12 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		logValidatorWebAuth(validatorWebAddr, server.authToken, server.authTokenPath)
 		go server.refreshAuthTokenFromFileChanges(server.ctx, server.authTokenPath)
 	}
+	if server.additionalAuthTokensPath != "" {
+		if err := server.loadAdditionalAuthTokens(); err != nil {
+			log.WithError(err).Error("Could not load additional auth tokens")
+		}
+		go server.watchAdditionalAuthTokens(server.ctx)
+	}
 	// Register a gRPC or HTTP client to the beacon node.
 	// Used for proxy calls to beacon node from validator REST handlers
 	if err := server.registerBeaconClient(); err != nil {
@@ -131,6 +149,12 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		httprest.WithHTTPAddr(net.JoinHostPort(server.httpHost, fmt.Sprintf("%d", server.httpPort))),
 		httprest.WithMiddlewares(cfg.Middlewares),
 	}
+	if len(cfg.HTTPAdditionalAddrs) > 0 {
+		opts = append(opts, httprest.WithAdditionalHTTPAddrs(cfg.HTTPAdditionalAddrs))
+	}
+	if cfg.TLSCertPath != "" || cfg.TLSKeyPath != "" {
+		opts = append(opts, httprest.WithTLS(cfg.TLSCertPath, cfg.TLSKeyPath))
+	}
 
 	if err := server.InitializeRoutesWithWebHandler(); err != nil {
 		log.WithError(err).Fatal("Could not initialize routes with web handler")
@@ -181,6 +205,7 @@ func (s *Server) InitializeRoutes() error {
 	s.router.HandleFunc("GET /eth/v1/remotekeys", s.ListRemoteKeys)
 	s.router.HandleFunc("POST /eth/v1/remotekeys", s.ImportRemoteKeys)
 	s.router.HandleFunc("DELETE /eth/v1/remotekeys", s.DeleteRemoteKeys)
+	s.router.HandleFunc("POST "+api.WebUrlPrefix+"remotekeys/manifest", s.ImportRemoteKeysManifest)
 	s.router.HandleFunc("GET /eth/v1/validator/{pubkey}/gas_limit", s.GetGasLimit)
 	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/gas_limit", s.SetGasLimit)
 	s.router.HandleFunc("DELETE /eth/v1/validator/{pubkey}/gas_limit", s.DeleteGasLimit)
@@ -208,6 +233,8 @@ func (s *Server) InitializeRoutes() error {
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"beacon/validators", s.GetValidators)
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"beacon/balances", s.GetValidatorBalances)
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"beacon/peers", s.GetPeers)
+	// web duties endpoint
+	s.router.HandleFunc("GET "+api.WebUrlPrefix+"duties", s.GetDutySchedule)
 	// web wallet endpoints
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"wallet", s.WalletConfig)
 	s.router.HandleFunc("POST "+api.WebUrlPrefix+"wallet/create", s.CreateWallet)
@@ -35,6 +35,7 @@ func TestServer_InitializeRoutes(t *testing.T) {
 		"/v2/validator/accounts/voluntary-exit":      {http.MethodPost},
 		"/v2/validator/beacon/balances":              {http.MethodGet},
 		"/v2/validator/beacon/peers":                 {http.MethodGet},
+		"/v2/validator/duties":                       {http.MethodGet},
 		"/v2/validator/beacon/status":                {http.MethodGet},
 		"/v2/validator/beacon/summary":               {http.MethodGet},
 		"/v2/validator/beacon/validators":            {http.MethodGet},
@@ -85,6 +85,13 @@ type RemoteKeysResponse struct {
 	Data []*keymanager.KeyStatus `json:"data"`
 }
 
+type ImportRemoteKeysManifestResponse struct {
+	Data      []*keymanager.KeyStatus `json:"data"`
+	Imported  int                     `json:"imported"`
+	Duplicate int                     `json:"duplicate"`
+	Failed    int                     `json:"failed"`
+}
+
 // Fee Recipient keymanager api
 type FeeRecipient struct {
 	Pubkey     string `json:"pubkey"`
@@ -109,6 +116,40 @@ type GraffitiData struct {
 	Graffiti string `json:"graffiti"`
 }
 
+// duty schedule api
+type DutyScheduleResponse struct {
+	BeaconNodeEndpoint string             `json:"beacon_node_endpoint"`
+	FetchedAt          string             `json:"fetched_at"`
+	CurrentEpoch       *EpochDutySchedule `json:"current_epoch"`
+	NextEpoch          *EpochDutySchedule `json:"next_epoch"`
+}
+
+type EpochDutySchedule struct {
+	Epoch  string       `json:"epoch"`
+	Duties []*KeyDuties `json:"duties"`
+}
+
+type KeyDuties struct {
+	Pubkey         string               `json:"pubkey"`
+	ValidatorIndex string               `json:"validator_index"`
+	Status         string               `json:"status"`
+	Attestation    *AttestationDuty     `json:"attestation,omitempty"`
+	ProposalSlots  []string             `json:"proposal_slots"`
+	SyncCommittee  *SyncCommitteeWindow `json:"sync_committee,omitempty"`
+}
+
+type AttestationDuty struct {
+	Slot             string `json:"slot"`
+	CommitteeIndex   string `json:"committee_index"`
+	CommitteesAtSlot string `json:"committees_at_slot"`
+}
+
+// SyncCommitteeWindow is the range of epochs of the sync committee period, both inclusive.
+type SyncCommitteeWindow struct {
+	StartEpoch string `json:"start_epoch"`
+	EndEpoch   string `json:"end_epoch"`
+}
+
 type BeaconStatusResponse struct {
 	BeaconNodeEndpoint     string     `json:"beacon_node_endpoint"`
 	Connected              bool       `json:"connected"`